@@ -19,203 +19,219 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+y9CXPbOLYo/Ffw8d6qJNOSvCVpx19NzVVsJ1F3ZDte0jfd8puGSEhCTAIMAFpW+qbq",
-	"/Yf3D98veYWNBElQezo9U5mp6ljEfnBwzsHZ8EcQ0iSlBBHBg6M/Ah5OUALVnyFl6J/3e0Mk4N4/aYoI",
-	"TPE/u0NO40ygCygmslKEeMhwKjAlwVFwiVKGuOwLQAKgqQtGOEYghWLSCVpBymiKmMBIDZJ6+7meoKK1",
-	"rAIEBVD3QwkQEwT4jAuUdMAZFQiICRQAkhlAD5gLTMa66hTHMRgiQO8RmzIsBCJyBugBJmmMgqNg5x6y",
-	"nZiOd2CadmI6DlqBmKWyhAuGyTj48iX/QocfUSiCL60GwKT4PWJczb+6nO5Fz5SBCI0wQVwt4V5/QxHQ",
-	"UAd0BMQEc8AsGKHsQH6GBOjxO+AKMdkQ8AnN4giElNwjJgBDIR0T/DnvjUuYyWFiKBAXABOBGIExuIdx",
-	"hloAkggkcAYYkv2CjDg9qCq8A/qUIYDJiB6BiRApP9rZGWPRuTvkHUx3QpokGcFithNSIhgeZoIyvhOh",
-	"exTvcDxuQxZOsEChyBjagSluq8kSuSjeSaL/YIjTjIWIq10hWRIc/RYYwAatYBTj8USEIpaDFZ+D2+ou",
-	"tYKHtmzevoeMwERi1m9BsSHv86bFt1e27x71FZ8mqZjJgR7aY9qu4EQjBqTXqqIPm2UXen8RgGka41Dt",
-	"rbtwdRA5ClrBpwxGMRKBHIgIiAliQSuYoDgJWsF9sjQA1HyO827Nh3d573mNYhDz6Y0ey/x6nwS3c1Zt",
-	"FyP7QURIAMA4Ph8FR7/9EfwnQ6PgKPiPnYLO7BgE3fF2+ArHyPb0pbVGB5cohgLfaxIle2DoU4YZiiRQ",
-	"FL25rR3qZZZ3grjs5UpA4dlkUwpiPELhLIwR4LIiGFGmaKF/z1lGiN5ALmiaomj5vfVN6zLvrqHClR1l",
-	"ifWekvv3kGkqXaLZqCiAUYRlXRhflKrUTkwZWKfkHjNKEkQEuIcMw2GMwB2atRXhASnEjLcAJnJzUASi",
-	"THYDWEYETlAHyAN1h2aKhOkWCIYTkGRcSHI/RGKKEAF7qsL+swMQTiCDoUBMUZrK3q9A4nPYXFAm6jgg",
-	"v4IEpqmcLSZy7xMowCCYUC5k4VF+nuWvQQAeo8640wKD4HD3cPfocHcQPClzKPNd8k0oJAEPjoL/NRhE",
-	"PxzJ//xnnWEtMXdG73GE2EvIPXh8TJOEElDsuMbgOHZRWJEzXufmkBCqmdYm2NFlQywYZDOQIAEjKCBw",
-	"Ou6AG46inJ3FMzCcKbKqmBCNQRpDgixkSzxkStldTGGkCPoTMJ0gAgSDhMuNkntWWyKAkrOSCDGgUC9o",
-	"BQzB6JzEs+BIsAx5cAcWfGBl2mV5iIZJidxsQFKbiNiX29YKVEyJJg6EWgBykFCuZA9ERDwDHAm7G5Ke",
-	"7XABmQARuschAt2LHu+ASwSjNiXx7AiEalfliZXtIsxQKPR2ylFm/z+Q1YARheR5kv3q3UBRaa94ikIQ",
-	"oRjfqyIj+MAxIqK+ZV9agaSjDVKn06uslROVvf/7v/9PmZSAmJJxC+g1TrGYAAhiJA8poAyQLBkipsUs",
-	"c+oBoWAqBSKewhB1fGeXIdXda0QQg8IrTB7TTKI+wCRkSFJQFFmYm9YOwDVhlKhblTyAkjNVfRT9FbYl",
-	"h4Y82WPEFGF2Wbc9V7fr0GtD865SFCqQYgnSBBMoKJMfDNXW9x4tMDWcMCNPOZ2X5LTGVqZCuZ2S6Rqa",
-	"SBmsXNvKhQ0NjGBXbnPf2P/7Uu9fclo+O1OnI4f3l1ZACVqX9njAtZZU51ncWv14N2Ktnqr7s1YnlU1Y",
-	"Ri67NLemtzjB+tJevYTrchCrCoZ2164dZc4dppmH1Fzc6E7kKZfT4h3wSss0knQwrKSzIZT8mJIa9yxL",
-	"MrudH5/5SF6CEspm9cH76rsZX5FXmmphAshL5wYz2X/2PNnopl/binm7EFLCBYOYLLsVcb6vmzD6Cpas",
-	"tTwpJWTcf6nVZUo3ATgm47jMXYyWRnMi98ZzwVAKzcXmSnIf/WdxczlljMqb6A25I3QqSZgkITESKFJN",
-	"9AXG/CWbrHxj0lN3J1IrdGZWK/NesnSRnXutoFhMrchdnWcedrn+IrX+JXbyhiNWv8ixjHS5Xw7KOFKw",
-	"szoLrW5Tn2uCoFVFDZG8ooFMSgLykoa5FDKkLKF6kL1BrflS3cgzjYlS2+Xsk3t0JOAxHtnfwxg96YAT",
-	"NIJZLHINl5kVFIV8IWfC5XCPx0qWkvcERql4AvBITUnKJniEUVSXw8panxsDCfdzm9/htG3pUTul6jZi",
-	"xMt1Ttp7GmdJRcyvSuZacQiVWBqBe9VCLl2Jf3UVQ3mr/RLvDcGfMgTcjXb7NTvkoVgewTWMIU4uaIzD",
-	"2aa0S0PjstRlVRpUC/KIgn9sIqz0EjhGevSSxLgWa+9LQX1bnamZNfZ4u5Tg4GlZIwl6+z1E4S3mQlF7",
-	"52CayhIhsEAJ386+B8UBgozBWbDBibqsomWkSYdi0JLkBK2GczahU4eaTCCJYnX6zPnQuoMJAnRKqpoD",
-	"dbNK6L2mLZbxmfFu19DY6LVosj+fE2+FKpzVyEHDkR8hhkiIfMKPKbIUOkJpTGcoAufHvbbEjBhDeXmU",
-	"WC1vy5LbjmAowBCGdxKec8f2kQJ3PuvcEPlVliSQzZaUecr6MN4s77xBMBaTWdAKTtCYwUgx87qMc0bd",
-	"uawu05SnXwzaWMWZTWMdjzhTruAVa8pVqgtr2opjiZUjWQ9d4bE8U5foU4a4R83aWLUwnMmTysxHpb8E",
-	"HI8JikBYtAUjRhO1a8ddjyqzZM9bg6rlzb+0gjtMovo6fsYkksQCAr3JRpedL8Keg8vTq2tgbWVa+tJ4",
-	"7ay3sAsmcAYwGVk5LV8kIpGSU7SuNMaSrPFsqK6IBlRSoOqA41z/k6URVNqhHgHHMEHxMeToq1sFleK1",
-	"LUHGO/4Lo1YKr7Uv5wpwfSSg7IobHri6FqEJBQ2HD3hOrLfYt+6zrhfLUc1gmgMjs8alKeL8hdVQ+BcG",
-	"0xRJ+k0zEgGoRPF2yJDEG3B8ddkCCY1QrK/ld9kQMYIE4gBThS8wxR3nSPLO/V5n7hTqBxU9pFjrSq9Q",
-	"SEnkvYur9tqSlNvU72GMIyxm+a3cmYgcRltvtELyYD+o6ydbAXoQDM6zdORiUQ2LyxJOzUAmOwZQ6POD",
-	"8mtRcdOxMFb0S8I5pWkWQ0cb3L3oAa6IgoS9qi9XDiXRSZJMyMuUxxymkctLeOXFcAg5ev60jUhIIxSB",
-	"i9N+8ffPx1f/sbcrp9MBfSjCiXF0kCjYyckxRnEE5MXPxYd5NF0TvtKWDGcC+WiDovLszCvW9Eikkcwo",
-	"rC1O6DZaU6+o8acMxupqqC5GctwEk7eIjMUkONrzjJphD3W/6Z38CbvmTILDsU9wv1Hf8/uuYjdIifJ3",
-	"aAZ0Kwca5r6HOc/K7LIk5S9EZ6s/mC9d/gmAqRBLi9slVNkCdWyQzQucg2nK6D2MdyJEMIx3RhDHGdP2",
-	"tSw/32rpjsmIN2wGwKPC38ljiHWq+o+x6bIuFbUKaAIqJfh8I5Y6gJIC49wAXLVc2TItOxcmIbMrHfCz",
-	"FCdB6FRkCHQV6FDUAieIYPmvhNAriGM9qfXvnvmMvHdOF2+cdS2PLXXTx9bsuCUj/loaBY+nx6b9NCg7",
-	"NlXINNnx1lOkkBiT5i5vv6ywvRZ5NtvVvJ98L1OPt8QmHWszXgWjhd+WWnMJyHspaFKEBMSxNmdQggCU",
-	"zEVYGhZmjKnLhfIcsD6Nknxf5qx8IUz9zinya0Ed5LUpU9cIMKJxTKfywvRzIVPIId27BbjhyBjq5W4p",
-	"n9JICq2GN4baS09esz1WGcjFNYOEa4jiJgcCWa8wuBdzFXlbFOlLmYScof5yJoSKCWIlIitvX23Zl/8a",
-	"xCXvrs/iTZZAAhiCkSLiph7AmhVJGNn9g0OaCTPjfHpe4YIOFeuN5jkmyNV37MWjM85rFpr5AhpTyLWz",
-	"iLLYZake1pW4nz/1StwMQe51sQWPhwyj0ROgaxRCvR3zEV9qpZtc3OxQDRc103XLh0v5yoqNXZ0QLdYQ",
-	"liDSUihIR+CaZagFXsGYoxYwOh1XhyXLg1agKjhaq+WUVJXZmb4qX23Xlc/5SAuX3uB5a7xuC8TDribD",
-	"WaKVMYJWcH3Rf4+YugBoxZYt0NKHAoQUPepVwxBxjocxqv6whO8CMq6NlzMSqj/ey0uorCEJWCZ6kh2N",
-	"GeISTW7SCBojpGROtmo/iwVOY3Q+JYhxNa97HKITFNIkwZxjSpY3i54SRuM4QUQYSdZZb62svNxGYdjp",
-	"orFODsvGGjmQG2uUp3OJUsqxoGzmBb2EeGNBbX/cwnyvXsUICbsL6odv1/RuOHunP7g7qL8su49zcH+E",
-	"x1VzzgbC1mssPH2uJWUVXPgKhQyJbclt25rfGyFSX1/zgF13G/r3luaVz8BXuhKUZStlBlrCiqTNRbkv",
-	"au414GXkKWU+7yjXR3t7lkvlD+5RgzDXQWgb7jx1qUIDzyvILyk+pJntvU+JpKCWlhTIXd6vRFdbHO1S",
-	"GC0oMI0Wq2jc3r0G/jUiQurLm3PUGSWnD6kk534HXEYJQHkF6+Uv0VLOIspiZbjBCeKdAZHgMDUwB7//",
-	"DZj//34E2qCPSSYQPwK//+13kBiN6W772YsOaIM3NGO1ov0DWXQCZxK8fUrEpFxjr32wJ2t4i/b2nca/",
-	"IHRX7f15Z0CuslQeHRQBueVQUDmJtqx4lCt1IZkZY5Vxs5fdYAImcsp5f+gesZn69kSO+3v79yNwCcm4",
-	"aLXbPvxdAW5vH3T7EksOQbeva7d+PwLK6cBW3mvt7ZvaXCgV0N6+mIBEwVC32fn9CFwJlBbT2rFt9GSq",
-	"La6051p5LYcFSCTVOXSaDMipdiOUkAO77cPW3vP2/oHZ0s7S4RjHGRc00Qy/R0Z0ng2hetFRJhYdRxiB",
-	"UHVkwzXMrnjnUdUKO51gojFU6VPVnbBsy16OjpygFJEIkXAmJSbNXS/RqLiU+Bc4UteOmhfGnL5cM2/J",
-	"93yEyRixlGFSWH3V/oaqAwklxYYecYAeTDRklI/k0aKWJISzxqAB13+qMpRGKlUyxgJQBt5cX1/YWoY6",
-	"yvZPvJvmrMg/tLtkM4McHKGOojNTkMMryVKAqzfdFuATuP/suWykZjSk0awFfj6U93IpseXKGmNb9M9P",
-	"3mRvtJW4K5ZRiLjzDSeSGETgMe6gjnUTMZuRT34KubVDP1lWOVLX3Va38XY9pN4CLvtRmM9IOGGU4M/6",
-	"FDpg0mq2Or5iZHx+9DltgRCmIlMhGLWYJR9aX6KRTzxCrK3L2zkKu3smya7aUb0naoSWUuXkHlEm+kRP",
-	"YUMRaz5NWd9RTFPelTfSLMxxMkknM45DtS+55491NC87kTSFM2vfENN5UHZviOEQxQpyI3ndlFhv/Nfy",
-	"IKZg9Hw/Gg2fjp5F+2E0HL44OHhx8Hx/+Gy0dzjaD9H+88Pox2fPn74YRuHh7u7uwWgX7T7df7EPf0Sj",
-	"w/BAAe2768t315flj6RVZ2yoLjUdreHUcrvaMa+5ztfdXrcUs4mSIYoi5EH4XyZITBDzReHZRtb0PqRU",
-	"hPqu6yDBkNIYQRI0Ri9W1P6uTLLYeRtGswbRRoUxGh2q9dGfTnA4UaZZ1RIs7SOuwg09XOcsH8XWAdbi",
-	"0BQz4zENbCmaAXPAMuXUayIZeiMwjCG5a3ljKDNioxpUhIPq03zQvsPVCIStBxxsdAr94T5fWs2e4IU1",
-	"wVTJ3Y2roNyyY/gcru91B5ZI7WBdqzDA5Oe0tXpUaY2mlP1dfTc2ritY7Jso79yKM73HhbiiJDPXxLmn",
-	"3r3JaSuf5aRKaCvFxX01O9h8r+oGq9iK8D+GKRziGOcEfHkxzm0qxQit7DBeRkZ4zWOUy3tAeZ9G63GJ",
-	"c9U0h8BKa1VibxN6HTvm9sLIZ9ahRfn6QuwdsTFl0aW9RJokRY39LnK3Ko9zu/LKOY292Vac4uplKjSf",
-	"Q0oICo3ZLz8XdWBwrcjrnfiZhykGvRPXqFwZwX+GdMu+I7VVSEOOtfkoecoLw1TlvI2z3t9L2VFCSJSg",
-	"yrUbFSZYYBjjz/qenecbQizBBMatfM6C2mYtgETYtIfl0PzSga2squUAcMX9dW1dvghgm/1D6aTsTRdE",
-	"ZQtZ7j1W21gB2RiJDYRTd37XqjO/K40eZ4PFO53X+W3uuakPoM7QUQVCgsSERuVj6hq4bwhS5lxlvg4F",
-	"ZbNLxEuTnmcmnjdjp+d51cqjzgdNT0p1DIvZ8QSFd02Ur7luTb1Soo3YtgChbAJSxOQp0z7qa7LgtpcF",
-	"F8rU6ph6RtvmvM0Q2SLrbex+gd/JCmAvkNbGF90Qbu0SrgNGbv9fBY19CyhGmlfHnUNzvXx2zVWKeS8J",
-	"60bXHiNaNmF4jR6kFduCGiVCRGAx2zLOSTxaWWotjoySWIuVLJBXZe0cqnU+jhN5DUhSC5BK5/eqZXFr",
-	"Wc4Fb3sn1YT06820NzCRJlvfke1SgPq0l6YBjYzKcenJD5KfDqx15ivnr+UvbzrCC4hFnU4sON9vbZ6t",
-	"taT7PEvXFm6LVbtfJQXY1+JVFQBskU35em7CTjc7pw+2dX6kfeMMipQdtspfVsTTyqyrmFYpLs3CU+6b",
-	"2oJqi3H2nPtjAd1SoIuGRmjVAjI4v8pvUI0ilt8V6LrUifYD0go9Bm4u3y6+iDZ5ySxa6TrH8vxq6XW9",
-	"L9+u7dq8Z02VnOBxY2hepMqqfRnzrzb3HsHdTqfzZFl4lQddEXq5D+VKMMw9phaJLiZj1Zp0pzy5POci",
-	"5ndb77RIcLXFbqsm7jQL8pHMOjbarvm+Xbzk3KU3sJzFuchA8AtkhvYcMyxwCGNPAoRVSGR5om5+hXpp",
-	"Mbiv1JmQr9hO0le20DPdMZM1UMoKnYSNmO5qiZdLzGJN91t0cCx7cNYcHbVCsHl2FbcYvnHcXt0N2Dcn",
-	"TmPUYBOKLdxCge8LlZ7RZW3st+CqL73h3mXOvB0dlQrV2WTGhrdXPVer+XmZdiHMKYBx2TS7bGLsNwRh",
-	"xWnTB0RtxotW1P9f5wbASAUUY8lsyg6qFXdXKMLJhU6/7LXvWFRSFYFJ1Fxefs0kpB3T7DwygoUSaFom",
-	"1aFOjyZFcp6NRvihBbQ/2wTFcZuLWYzAOKZDO5iavxodjiEmXNgovHgGYgojpIdQc0rggw1m33/2vJRY",
-	"+rfd9gvY/txt/3o0GLT/2Rmo//02GNz+f4NBezD422Dwj9sfHv/XcvWe/OPxYND5TVf0FXvTVy/26NG+",
-	"GRukGnNCNEw3GutXtMus6Q1WNHXVlP67GHdyOxn2AEzbBCqdCI61qTkUGYyLmMtNuYm1g7lZ/hw2vyll",
-	"rLtkeI43rNsRtzNkxWIrd7liTlyX4LvdLB8Nn6OBziimPB+sUVhupTeq1t3hrxIB7zL19RlgYblUXM91",
-	"59uCR6CraDQ6mO2p46xa8QohsoxvqzkzOrYVkTwTuuYx4PHZ+fXpkXaSyP21TYJMhkTGSCnZxbLersZL",
-	"5COnpI3HhDKUu4XkOoztKWi2IWHkHW0WHeO9ZUqpYGNSUSMPmk1bR/11ey06KcsufgpcEg22Q3v1DKIb",
-	"gkUz1TWejxsz1qhB5ezQxBJgy/Q+8JN/F2fcM5/TKoWdxSIKbHBPw4o34/X9exyqMIEsmkKGlAe1jqzB",
-	"ZGw90UovIW3f78fMwWYb+GqePx54bVGju1KGRr9x4VxFkfqTMV6iIaUmZveCThFD0floVLI+dKcQCxWA",
-	"bDw/dMj6KMahuIAZX1HZW1qQM7VamTNbT2lZT1EqctfkKS4t01NeVT+XCn3A8FSrwmfBHpco7XIhUec2",
-	"L7w5TE7aNPSQUl6wVe3WNSCnMJyo9FchZQzxlJJIJ+EobrD6VJmgi1w8nHUGZHFwlV5E6VCGNI71Y0a5",
-	"V3qjgC4n2eiYJcWOrvIrNZ5Z3jPsOpo39OHUaPCC8/Ys8cnnKfWSUgF6J6t0pWPX1uaqtRg6KZpYwqq3",
-	"wL/085z6Xlnqu+Scq07qLpRz0NRn0Srv6Ypkr3ZPXeAhlKqaytKSQALHOuOLYgCaLaqntcI4i2SJCtsx",
-	"351E7hGdEqM4kAzL5OvyOBCYelc68nU9SVOvMO8iF0K22umXdaAerWUA0rPfqlHWZeM29Oors/ESBLbI",
-	"xuv9rmCWLUCb22TTa3oCVTa680ycj8zfTgKOdYwMpUk6Q3hK3VG9jSuZQMqlC+0ImN8tDM/fTkR86y8W",
-	"5+8lckYZpaib7kDRN8zvdC7LVZ6Y1W9bUTbL35i1imzZfbnP+WtZ9VnJk2xeFq0EPuAkS4pstTCO6dSN",
-	"hdFezYKC0Lweoh9FzBsUhDx/5wJAFa9IJcm4N544iJnMaSZdvNZl6Od1ijwA+UeVjvEI/M51SD3X+XZb",
-	"4PdEf9BR8vLDRH9Q+QA65ccLH//j6Le99ovbwSD625N/DAbRbzyZ3C7/kuEpCankXMs4yyJTVyOqcpVW",
-	"OwsFrDyy61KUNNYPA+hUt0snUtJDXZjG9vdL00nzcipJluprqlWZk+fcZBmVqKG9ckt6wvVCU+tTLEci",
-	"mkDUvYPo+cF+dPj84MeDEEIUwedPI/h099n+6MWzH0cQ/vh0fxT+uPtsd3f/+Y9PD4fhjy92nz8LDw/3",
-	"XkR7w103KjHkLDgK2vJ/L09f987A8enlde9V77h7fQouT9/dnF5dq9IB6fd6L19+PH7J3vVedk9evu3f",
-	"3E0vpx9O3r97d3K6233o77/b73/+6e785MPns89nHz/88ir+9fXp/tnry8nZSXdvQPrJh2dn11Hy4ZfT",
-	"g7OTn5IPn8Pp2XV32v/44eDsZII/fA6f9U8+7H34PH7av47v+r/0pv1Xd9PT6Yc3P9NfewPy+ePucffd",
-	"h5789fnj7kn3XXjybtw9ffOyf3ywe3b50/VPB2e/nMcIv/jwy93L/k7/Mz07eT3rX/6cfT7d3RmQ8Oe7",
-	"2X+//wk9vPm0+9Aj+/sfjs/ODn49OXt4mP7y/G38bnyAP74m91fi3fnwebfb79LXx8efXl/1n7542e0f",
-	"D0h3d9ztn94c996dXLEH/PyORcc/h2+PJ1H/5cH0x96n5CT+dXJ5+nr4pn98evWePOf8otsb//r2h3fs",
-	"JzEdkMPLH9jTFMMP97/eCcbvDmbHvezzwaT3Y0w/JP99cRAd/n1AFNhPz07mbMn3mOLvMcUrdVOjMFsI",
-	"L673+Sekz29IpwfjJai6rVrkWfVfBXIa7xiBDLFXDyo3hsNAm5dvTprqqROnbBnLBHIwRIgA24E/LLlI",
-	"VrDmu79vVQeSdakE5aWgANCTUmYawxCZavIwwpgj8NjkRHjSAnoKKjI5QWxsnrDSah+bzyKytZyjXIOd",
-	"dzgVCuWOoQQKaIPOtCAGRliHcwmgrDHqcSDf+A2p950xS+8V+UMZ9ZPHxbbVAUCZXUj+jrZFILXKrwvD",
-	"VUGmdA3ekWRjbd7yol/9rViN6pufXMfKv/59q5ku1C86C2ayiDw4LgObEoqmlD7qQgCFyQPgkoop5CUq",
-	"sVwchW3xcrY4v5Kpu8T90um15S5piZzXi7ZgDb8ND+CLg7g8VvoVgN5qWhZyKurp1Oo+4ta1WvkWeDxt",
-	"OfPvi+/xD/eRAq7TtbqI5mEBZVeaDdOItAKlT7lcFN987SbK8sc4K9pmwi07Hzkl4LFNwNCUDGtDBti1",
-	"L3MYmpcnzrYuBFNs3h2b6ZSYmOdOBxNEgJQ9HTTD3MexCzq4vWj2kn2Xsw2obpO+taHiaiex1kkT6dPy",
-	"2vaovONotOhhDvdM1V/n6Kz85kY9GT/yw+Gv+orGKxyjY50Mzg8xmylObfEIx/6MZ83tlboHCPQgwOOb",
-	"61ftwydSaKq8c+QMotPYxY17IetZ7c+aaORouJa3W0hANacakKV5coE6hMaMZmlTisEYPeJA1Wg5+kSE",
-	"lcgJ7Zu0EmokSxDDIeidlF/pHQSMUjEI5ua+WZDkJjHEqnGGKWLGD1o9OtYBH2im7u96ztq2l8jb9ggm",
-	"OMaQARoKGBsBF8QIKmXhZ8SoTQa6+/zpU4UPUDPTECemgU5J4GvzdH/3SQdcIZHhaIcjMZb/CBzezcDQ",
-	"KFFBHiGoxOTSg8Q6zVBlMUq7J9cpKX4BVzk9fzakzLz43AgtOlWvXn3F/fyqjydLfN6CIcSlLmvlFi6d",
-	"uvWyZQ85jTOBLqCYqB5qhoWcrKxiYvBnc69lPhtjcSkn7BeQnATcELxWGUQdD3/zHtcqJhdraHFSpZpE",
-	"J0X+/obkYbZ48R2h6Kr0elzd1VyJvZfoHs8TEnWpnHTGnUdD5863lgYnn3xt1FaT8agp0duCjLNLv5dr",
-	"dn5pRvwGxcmfk/1+vezw4QQyUWSHn6A4WZiSTplvUhjODznNa/mS0Zm3jROTMCo3sQTJrA3TtF0M4Rlf",
-	"qajnXBl09p2a0sc5groHb5Y8a8KTHGeIBYMMxzNAzKuH9u0kXjEM5eB2T1xAxpg8KOQdB0fBXmd/Tztd",
-	"61cvjv4IEIHDWOKXnvKEcsEVZsi/giM7QiekiUF5XaxJRbBjPmqrXnDB0Ag/2IfNGVKLOqaZlOEOWoG5",
-	"DylSQ5kIjg53c+AexxkXiPUu/KKThpek2nOiHyxQFb+VtE9duYz6zNlvoPoxmRBjqAyrammu04x661KH",
-	"TLEIMTBEI6o1YazQcukRS1vxm5mrrBRlOsvUDCZxYBfRpveIMRwh3pklsTzIy77PuO0nBBqe06gxm4kQ",
-	"6ZLchriJshfxG7lRF16eI79aDqOvyo/0vlpnQUEdNZaV8qvu+GoqHN0j5ljWpwwLgcjG3IrVuZVlNrDI",
-	"Dg3m8DEdIuZbPMtvLDeXb81LmzSRKDsSRssvLzoqhh70hEoqpn3DEPiUIeUZwWCCBGIc8CycAMiPwCDY",
-	"kVi+I+iONYP9Q9X+u6rtEwvncsR8+/58JmgxcmlUn/si4Zw3M5blYufHPc+z+D6+k8LwbinnlI2Pt1pz",
-	"X1Jfz3Mu9ZwGqo4WlIrVJLK5Tk5eXDkkFs3JlbDec5EmWag6LsrAqdnGGt3pntTCGxMk6N5XA+VFFsdF",
-	"+GJu4gl6ozMqLrQ+K2g1eDmXL2KP3DaPOuCXCSJKiyjLuvEUzvijlvN4DuYgzeJYXvfUgxgqQ3y51Zks",
-	"KTVKMi4AjHWyX/XkbHPKLj1m0KouRvW6pC+NhE/ej/xR6Ut+Mv3NhbMfWwkVWrxxXdHUJn75qpi4WUaS",
-	"eoeetAJuzhXDSehI8tLz415bmdswJKJOXzw3uBKKrrd8B83V2g2lW0AEF89WPx7O0BhzwWYdcC3F/QTO",
-	"JFOGRZ5qp6F+WzN3V5RUyXbWkkw2pmQs/zUXXMoSXqfH3HVAXIbD2fUuv8dz36adx1x07Mic0HSXcRgp",
-	"Z2u5IQr9yQIxU89yE0bU9Ajc0eoQyYV7/fR4naKtD5tcN+UJr/u64kwjiBsAOvedvhpQtzV3eYGToy2r",
-	"eHLe9NUN/0X11xkRa95SSsZ280BPcRMxslWjhmPxnhVgXVVFUuhGFnf1r6uT9hw1V69TbG395PlbFwdg",
-	"6bPaV9mdvj/V54Jk2TvJGUxQZN4r0MelrFmQS/XIhUZGq8mFX+dKseJVwvG9rIdE5mVS4s9ziisFAIxj",
-	"kCLGsdIDFvnWlJQ/gfeoZWiNUQRw1UJPRr31wExdzTg9NnRCqCgSjKzpDFFU1i+Fl5Ip+F+lk/MxD2qr",
-	"ZK9zvJd0og3l9TuF3CxlBZelCMVonbFwHKvwOtl8lfHGcx5e7wKOPmWKXZrnWko+z8779s6j7Lmvi04n",
-	"rl1/wAVNsxg6oZCa/3TAJYJRm5J4tuQ77Yt9YZw0RM8PFobU9qFK3Ws8u+/QTKtYjaJWK7bKCfEpG0OC",
-	"P+uA+xAKNKZM/nzMQ5rqrxzFKBRPLG57kWo5/qnr17Ir+daleJxvEx2XdCgkK+TakGG/t6SEMFAuyjty",
-	"7EFgHhZtemzHfb7PMyABNIWfMmSBqobFKhV0HkCjFa6PeBEK7fhKQeKsu84olqRihSdRnYpd6QhWpNyZ",
-	"tE1/oInyIAA4d1q26S65qR6BewzNO0qUAZYmbcoFQ1afYcJdZWdGiVbqjlBdrz2EEpfKs8AcwHuIYzgs",
-	"p4a1+iDT4ZKaDr36nmmrf13YHppAdgFFOHGihfKLytw0VJWXTBoIlgld1vZOk6HdXSaMoiB3U1Z/JfRe",
-	"UaxyhlDnHWavxNsFP12dn4ELLWjlikC/gcw/VR3eIS8Ikevm3KnxVZrOM7LWkx55QP4ug1GMxPfHv7fx",
-	"+PcGT9TP1b5v8K58Y5e3yysnLo0F0H/FuyyH7Bljobrj+bXgTeem3taqLiyvPqPCMBFIjL5W0ndV3wog",
-	"9B4xx4BVWK05C3cwidBD5yPfgKxbOb4bIyYuTQx/2pzEo77OSflZjYofszKGyL79TsWN4bU28FbFc1gB",
-	"TQLDuV/De8QUm+BGY56/RWdMt2pgTMYd8Eox4KP5YbWP+KNyvOyj5FE5XvbR5FFjvOxgEP3QHCKbIhYi",
-	"IhqTZhflEmp6RdqtmOHxWIryPkhqMVIrau7R2lnVSkhwZXryZxKwwzh7V1pcWR68XQsNSzOoRw6b0hp2",
-	"WdbnTWSsEo8sx+sb51J03FjFGbGxjp7KIkjYdKpy/ViuP8EEmg8JTFPjtHp8cdO01cdp5rult4ITlT3b",
-	"36gpjUEr6Jv02P52zUqP4lI+0y9zl7QRUtBen800LHEtBjNvBeuEOzQAUvKpRtXMkkgxN7uMP/ECLHnY",
-	"VfQAlvbPyyGsKgEma3XAOYlngBJz+CTxApYyKMFb09Tt5BUuOJMvs7Bkh5iMe1I69YZv5oxkiMQUIZKn",
-	"jlBN5WL+BN6QJ1JoYhBz9HUtd388K16axjY+TaQjL7AWeETGjLOaWk0IYxvgElHyyLrcAG2ocy7kXzGM",
-	"PPQ6lF9l47F2kVNeP2ZeofXBVldyHVTSArsAG+dtrfF2FSMH+17FyPfY9a3GrnPuFX2WER/d1E0Sjvbm",
-	"2/REMvfLqQkMJ5igxqGmk1llALnRxtgzUG8cZQwNAvvMjgqblfU1CqgHolOhtHEM6Xyx5VCnXBsBuuBS",
-	"TROEMWRaiWP96LiNHY0QGGby0CGdedb6EAIsFqSRmpdpsQAeOCdS1DwCg+AqC0PE+SCQt3NnpV8dbXiK",
-	"wjYkUbvx5aIlcgDkjwUrMpFjQIF0yxNInXa1qx4akHBDzeaYCR5P2rFcqU78ql4nKPJvl1RO+uUCNbWY",
-	"qjAUZQHOP4/sI1a2E1UhQqWfCZTEiUBi1DkjhvhEF2Ur5c2qr7JrJ1IvunRmXC/tFWuoF+ZPczUMaBdW",
-	"Lz4pr7xeoV+ChW/WDnTqxQtzeZkmp8pVegEiaH/qctyewggbemaxwDpet+xfbZYRY0aIMblTRfoPpwTG",
-	"GHK1/VzX0H84NeTIONSPydgRMNEps4LcIKE+60x02jdrCCMHdVrBatjjgOY0X1dj2WU+2XqVt3bpTUXz",
-	"GncNdOolfQuvpqJ53V5ZkNaLTgog1wt7Bdjrha+djfAgmLM19dKX0N+qyPvqgb3kRgtx/C2F0QIMlxRg",
-	"CfzmIhtKDKYmAS6hoj2imaLRQxi1ORLmQCOTB1enrXCRey1Kli/hSs+g+vmtnVG14IyKV2aC1aKXMLrK",
-	"51sttHl8q9/7dj21ggoy5gXLUiInE3hNPQcLwrZJsvEq16ta9rxMsFl2s47QKkddSXF5niJydfXGmKxA",
-	"BFGi2bVjkNt9eugRcVCB3JustErWv2ik3bjf8lFS7v7DvFPfsZpqAaKlgGTcW6wFuBAicsCxjBArCxQm",
-	"2afliyZsf95tv2jf/uDVQsqB/LPJH7axwbGDgPNJ1DF2/EHwpKJ+dgoXim1q2DI+lXfT3YFWCaMdKC4t",
-	"x13jBP1KrVehddp+S7Verv7gLPhMCSosqoybm79C4F73rGvst6B7edrdeXt+3L3unZ+1wFRJ+vJjOdGf",
-	"lIYxUVHoDNAQQdJSZmzbMvebUokeIRM4zGLIAMcCqZBldemAAkCGYEsdJQN40FUuVXDnDE3/+YGyuxY4",
-	"zSQ12LmADFvFTEZgMsTjjGYcHLTDCWQwVFEkdq2V0GbweBC87l8PArnrN9fHZrOXS/N4U8sAXHV2HmFi",
-	"rdSmlk6BmAkqr0ZhnsNY6alI5Mt+LHBiS63zl1oOzXy5IdZz+TlmlJw+yH20igcuIBOvGQyRmwd0dVWg",
-	"bfylFQgHN1fuKEfs2r1IBN7ZLn1k3n+TiNE0G8aYTy4oE3OC/CaUi7ag7bHKxSNRFhgFeBFO+b7fASpZ",
-	"PCKCzbTbk3OIzfkdqCBHOdyR6kz+ZW+99ZKdlFFBQxoPAvM25yA43D3cPTrctY3Mzx0RpubQ5PrOymtd",
-	"tz8c6X8e7zwWYfo/WZT+Dw9F+mTd17X+Bc2zVY3M+z6YUnan5EvrEGLcmV6pRKfHIjZPAaisue/7IJLU",
-	"RPlO2ECCCMX4XmUHcnxQSREOsJNHI+jkENrz2utnD15RZsvzBxBNlgft62H86XTkgnF4fo+ZAPI/GYz7",
-	"WscEPnT7b7Udl2iHxkRFhPq94Ra5H9YORr/uF6kpqnGcXNZGrftJHZ/mIiZDpdowHEJ1rsluOfI12EEi",
-	"3FEhxztyOp3oiNF1czB/cV6hV/MI1dJRAnEcHAUCweS/3Py3gfX5UvKLRhhgEu2BawQTeZ9gsqlVXZVa",
-	"1zzXfit3cfvY1+yJUf6aPE8qSTEKY8i0qd/xP6IjMIoREkr1hqKxdRjX/nBigjDLsZ/r1ypiHCLCkZOq",
-	"t5vCcILAfme3tpjpdNqBqrhD2XjHtOU7b3vHp2dXp+39zm5nIpJYMx2htqsCpO5FL2gF93l2YZtQWL/P",
-	"IPEwOAoOOrudvSIS/I9gx0lzZBKHWV20jvP2pUY+1rEbEBwXja904yJXcmGfytWUvShv3Ngy0OiFuHhJ",
-	"o1kljZBzznc+Gs2wJmTryQiNk/hSRnMTS6AfLeH6FO7v7n3T2fm2JJK7/XR39+tOLE/pWpvFSxiBfJJy",
-	"JnvfaiY3BGZionxPDVAOvtVUXlE2xFGEiJ7Hi281j/LDQGoy+99sMteUgj4kM4suKk3bs2+3SVeaB9yQ",
-	"3LCj5TQ4VsqpRioZ3Mpqc6jozh+S+n9R0VJI+DzUYKSvU3n4TePBrxPT10jMo6RFGgElWc73pl5MzIGg",
-	"YKxtrlj2YNIkGfZmYmzKVLPlbFdVCZgR/ClDPS1j65if2xqR3f0LEdnzn79TtQaq9vRbzSNX+36nZ1uk",
-	"Z0a6NcRrx+ZsbaRir5EwWVVMcleTILVZDHyNhE0Xq3PJrkqudCtDksqD86rv1HYo1pd61uj8OVql+c9n",
-	"8L54JE4Nq9K0FON6k+XOG/dPJ4tmSxpp4L4+8NWjCJyg9L8UmfyW5AkU9OnbCX9/XbHPoUqaaPhJUOER",
-	"k0IR+qO5baS2k3z4ZBEdUs1K2azXo0OujKRmuC2ac7vKhbithv5hC3tYCnda6jr8jUnS92vvdwFxMQX+",
-	"LiFWJETQJCLmxLgVpJlH5Lsx72GuSnAvdcDglklu8ULln05zt0jXvpPYf0US+520LS/VFQ9lLG9mIMD3",
-	"FuN8+4Lv9cQ/7UjXB/8r2BMaZvXdjvDdjvBvcpX8S8tTNcrXSBEXmQxeqyfbViKKr5HwUcSVpK7m8bZq",
-	"F/gG2q6lKON35f/3u92/NS36ojP8W2KgHVR2YIp37vd0tlDdupYvyFIaDiip3s2Ui5EhBEYQrGvRyz00",
-	"0xm3s/oSvtx++X8BAAD//8YXgEdY9QAA",
+	"H4sIAAAAAAAC/+x9i3Lbtrbor+Byn5kku5L8SlLHd/bso8hO4jayHT/Sk1a+LURCEmoSYADQttLjmfsP",
+	"9w/vl5zBiwRJUO803XvcPbu1iPfCwsLCev4RhDRJKUFE8ODgj4CHE5RA9WdIGfr1dmeIBNz5laaIwBT/",
+	"2h1yGmcCnUExkZUixEOGU4EpCQ6Cc5QyxGVfABIATV0wwjECKRSTTtAKUkZTxARGapDU28/lBBWtZRUg",
+	"KIC6H0qAmCDAp1ygpANOqEBATKAAkEwBusdcYDLWVe9wHIMhAvQWsTuGhUBEzgDdwySNUXAQbN1CthXT",
+	"8RZM005Mx0ErENNUlnDBMBkHDw/5Fzr8HYUieGg1ACbFHxHjav7V5XTPjk0ZiNAIE8TVEm71NxQBDXVA",
+	"R0BMMAfMghHKDuRnSIAevwMuEJMNAZ/QLI5ASMktYgIwFNIxwV/y3riEmRwmhgJxATARiBEYg1sYZ6gF",
+	"IIlAAqeAIdkvyIjTg6rCO6BPGQKYjOgBmAiR8oOtrTEWnZt93sF0K6RJkhEsplshJYLhYSYo41sRukXx",
+	"FsfjNmThBAsUioyhLZjitposkYvinST6G0OcZixEXO0KyZLg4JfAADZoBaMYjyciFLEcrPgcXFd3qRXc",
+	"t2Xz9i1kBCYSs34Jig35mDctvr2xfR9TX/FRkoqpHOi+PabtCk40YkB6qSr6sFl2ofcXAZimMQ7V3roL",
+	"VweRo6AVfM5gFCMRyIGIgJggFrSCCYqToBXcJgsDQM2nl3drPnzIe89rFIOYT+/0WObXxyS4nrFquxjZ",
+	"DyJCAgDG8ekoOPjlj+A/GBoFB8Hftgo6s2UQdMvb4RscI9vTQ2uFDs5RDAW+1SRK9sDQ5wwzFEmgKHpz",
+	"XTvUiyzvEHHZy4WAwrPJphTEeITCaRgjwGVFMKJM0UL/nrOMEL2BXNA0RdHie+ub1nneXUOFCzvKAus9",
+	"IrcfIdNUukSzUVEAowjLujA+K1WpnZgysI7ILWaUJIgIcAsZhsMYgRs0bSvCA1KIGW8BTOTmoAhEmewG",
+	"sIwInKAOkAfqBk0VCdMtEAwnIMm4kOR+iMQdQgTsqAq7L/ZAOIEMhgIxRWkqe78Eic9hc0aZqOOA/AoS",
+	"mKZytpjIvU+gAINgQrmQhQf5eZa/BgF4ijrjTgsMgv3t/e2D/e1B8Kx8Q5nv8t6EQiAmh/k/g0H03YH8",
+	"13/UL6wF5s7oLY4Qew25B497NEkoAcWOawyOYxeFFTnj9dscEkL1pbUOdnTZEAsG2RQkSMAICgicjjvg",
+	"iqMov87iKRhOFVlVlxCNQRpDgixkS3fIHWU3MYWRIujPwN0EESAYJFxulNyz2hIBlDcriRADCvWCVsAQ",
+	"jE5JPA0OBMuQB3dgcQ8sTbvsHaJhUiI3a5DUJiL2cN1agoop1sSBUAtADhLKFe+BiIingCNhd0PSsy0u",
+	"IBMgQrc4RKB7dsw74BzBqE1JPD0AodpVeWJluwgzFAq9nXKU6f8GshowrJA8T7JfvRsoKu0VT1EIIhTj",
+	"W1VkGB84RkTUt+yhFUg62sB1Or3KWjlR2fn///f/lUkJiCkZt4Be4x0WEwBBjOQhBZQBkiVDxDSbZU49",
+	"IBTcTbBAPIUh6vjOLkOqu7eIIAaFl5ns0UyiPsAkZEhSUBRZmDNUBbgmjBJ1q5wHwNzWR9FfYVtyaGAi",
+	"0BgxRZjdq9ueq+tV6LWheRcpChVIsQRpggkUlMkPhmrrd49mmBpOmOGnnM5LfFpjK1Oh3E7xdA1NJA9W",
+	"rm35woYGhrErt7lt7P9jqfeHnJZPT9TpyOH90AooQavSHg+4VuLqPItbqR/vRqzUU3V/VuqksgmL8GXn",
+	"5tX0HidY8DqBsOUgVhUM7a49O8o3d5hmHlJzdqU7kadcTot3wBvN0zAkiZbizoZQ3seU1G7PMiez3fn+",
+	"hY/kJSihbFofvK++m/EVeaWpZiaAfHSuMZPdFy+TtV76ta2YtQshJVwwiMmiWxHn+7rORV/BkpWWJ7mE",
+	"jPsftbpMySYAx2Qcl28XI6XRN5H74jljKIXmYXMhbx/9Z/FyOWKMypfoFbkh9E6SMElCYiRQpJroB4z5",
+	"SzZZ+sWkp+5OpFbozKxW5n1k6SI791pBsZhakbs6zzzscv1Fav0L7OQVR6z+kGMZ6XI/H5RxpGBnZRZa",
+	"3KY+1xhBK4oaIvlEA5nkBOQjDXPJZBAqdA+yN6glX6obeaYxUWK7/PrkHhkJeIpH9vcwRs864BCNYBaL",
+	"XMJlZgVFwV/ImXA53NOx4qXkO4FRKp4BPFJT4ikK8QijqBP48KeQ+lwZSLif2/wGp21Lj9opVa8Rw16u",
+	"ctI+0jhLKmx+lTPXgkOo2NII3KoWcumK/auLGMpb7ed4rwj+nCHgbrTbr9khD8XyMK5hDHFyRmMcTtel",
+	"XRoa56Uuq9ygWpCHFfxjHWblOIFjpEcvcYwrXe19yahvqjM1s8YerxdiHDwtayRBb7+HKLzHXChq7xxM",
+	"U1kiBBYo4ZvZ96A4QJAxOA3WOFHnVbSMNOlQF7SAWFJk/zmb0DuHmkwgiWJ1+sz50LKDCQL0jlQlB+pl",
+	"ldBbTVvsxWfGu15BYqPXosn+7Jt4I1ThpEYOGo78CDFEQuRjfkyRpdARSmM6RRE47R23JWbEGBIBsMRq",
+	"+VqWt+0IhgIMYXgj4TlzbB8pcOezyguRX2RJAtl0QZ6nLA/jzfzOOwRjMZkGreAQjRmM1GVe53FOqDuX",
+	"5Xma8vSLQRurOLNprONhZ8oVvGxNuUp1YY1bIQTiWsTXm8A4RmTs2QFfLQD5jTyZRuohKPicUYEAFhyc",
+	"9c49YkpCvVgr+R75hHj5vI1ISCMUAVXT2VktDsIkjLNIDgw+ZzDGo6lEWSWoNMdGzUAOrEXAwUEwnArk",
+	"O0VpyBpYMEwidI9yjujiXbe9++KlWlK+yBLpzcfCROztBnWBioeuls6RAouZ0uJnqNiSD3JKMzftg94Z",
+	"uV16kxTV0rB9orcLcDwmhUwLFo2VzJ+O1MZenvXX31cD2TDHpCFStJzwO8Q09V5j/7QStYQRUY6Qq9+X",
+	"Z73zj7Ln+nZKAZF3AzwAuDzr777+tXt5eXRxCbhgmVLSAoZExgz0ZZVfPyyMyHLboOxk0fEvf704fnvS",
+	"vbw6P1r22DQgrl6+O5VlkbknkWkkqRW6wGN5s5+jzxniHmVPY9VCfS/RnJmPSoticTss2oIRo4lafK9b",
+	"x2hYsipYgbfKmz+0ghtMovo6fsQk0idSQ9do1PJF2Nv4XGKK1djrN6AGprPewjpBWhZgMrKvxXyRiETq",
+	"taR+hDFGRACeDZWgyoBKUrcO6OVS6CyNoJJRHxPQgwmKe5Cjr26boNQ/bQky3vGLrbRqaqV9OVWA6yMB",
+	"1ckxnPjysswmFDTvjIDnLOMG+9Z91qXzOaoZTHNgZNa4/jHM5fclFP6JwTRFkoukGYkAVAKBdsiQxBvQ",
+	"uzhvgYRGKNbCwZtsiBhBAnGAqcIXmOKOcyR553anM3MK9YOK7lOsNTYXKKQk8koEVXutz84te25hjCMs",
+	"prls0JlIiRI2X+roXjA4S9+aXzY1LK5eIBU1vexY3sDq/BR3WSFvsTBW9EvCOaVpFkNHJyWVUFwRBQl7",
+	"VV+uXKrFcJJkQop0PEp5jVxewuu5Tc6O+sXfP/Yu/razLafTAX0owokxt5Io2MnJMUaxVCID6OLDLJqu",
+	"Cd/CVyFiJ97H1TGJNJIZtZnFCd1G6wsNfyQ5SyyZFahfQQkm7xEZi0lwsOMZNcMe6n51fPgn7JozCQ7H",
+	"PvHBlfqeS93UdYOUQEEydbqVAw0jdcKcZ+XrssQ7zUVnK8Wc/cb9EwBTIZYWt0uosgHq2CAhKHAOpimj",
+	"tzDeihDBMN4aQRxLvo/nL9t86Y7imjdshpSl5laXnneWU7XhiaO7rHNFrQKaQLHq+UYsdAAlBca5GUpV",
+	"f27L9Au+UEybXemAH+WjFoRORYZAV4EORS1wiAhGkYbQG4hjFJWwchWVpB5o7gvNWdfi2FJXwG7MmqRk",
+	"SvTQWq8za2+2bj8NItd1xcJN1gSriXNJjElzl9cPS2yvRZ71djXvJ9/L1GOztU7H2piggtHCb9FRM0zK",
+	"eyloUoQExLFWqlKCAJSXi8gf9Rlj6nGh7JesZbUk3+f5VT4Xpn4TOfm1oA7O63lE45jeyQfTjwVPIYd0",
+	"3xbSfM2YC8ndUpbtkWRazd0YalthKezz6IYhF5cMEq4hipvMmGS9wuynmKvI26JIP8ok5Az1lzMhVEwQ",
+	"KxHZCArUln35n0Fc3t31WbzLEkgAQzBSRNzUk0IthfFknO8fHNJMmBnn0/MyF3Sort5olnmUXH3HPjw6",
+	"47xmoR8soHEHuTZZU3YDWUpJaeGYiJfPvRw3Q5B7Df3B0yHDaPQM6BoFU2/HfMIXWuk6Dzc7VMNDzXTd",
+	"8uFSvrJiY5cnRPP1FCWItBQK0hG4lEZg4A2MOWoBI1l2JemyPGgFqoIjO19MVF6Znemr8tV2XfmcjzR3",
+	"6Q32/8b2v0A87EoynCVaHiNoBZdn/Y+IqQdA0HILNPehAIFjX9UwRJzjYYyqPyzhO4OMq6oXUxKqPz7K",
+	"R6isIQlYJo7ldTRmiEs0uZLiF2OvkaLQVu1nscBpjE7vCGJczesWh+gQSckL5hxTsrhxxhFhNI4TRITh",
+	"ZJ311srKy21khp0uGuvksGyskQO5sUZ5OucopRwLyqZe0EuINxbU9sctzPfqTYyQsLugfvh2Te+Gs3f6",
+	"g7uD+sui+zgD90d4XFUqr8FsvcXC0+dKXFZxC1+gkCGxKb5tU/N7J0Tq62sWsOvGi//e3LyyXPpKT4Iy",
+	"b6WU0QvoslW9wiI+t13yXuQpZT4bTddTZHP2E7JXnxiEuWaKmzAqrHMVGnheRn5B9iHNbO99SiQFtbSk",
+	"QO7yfiW62nyfu0JpQYFpNF9E4/buNTNawS+tvrwZR51RcnSfMsT9PqWyHKC8gvU1kmgpZxFlsVLcYGmf",
+	"MyASHKYG5uC3vwPzv98OQBv0MckE4gfgt7//BhIjMd1uv3jVAW3wjmasVrS7J4sOoVLH9ikRk3KNnfbe",
+	"jqzhLdrZdRr/hNBNtfeXnQG5yFJ5dFAE5JZDQeUk2rLiQS7UlaInrawyzj6yG0zARE457w/dIjZV357J",
+	"cX9r/3YAziEZF6222/u/KcDt7IJuX2LJPuj2de3WbwdAmT7ZyjutnV1TmwslAtrZFROQKBjqNlu/HYAL",
+	"gdJiWlu2jZ5MtcWFtp8tr2W/AImkOvtOkwE50sbMEnJgu73f2nnZ3t0zW9pZ2Cmsl3FBE33hH5MRnaVD",
+	"qD50lIpFezNHIFQdWacxsyveeVSlwk4nmGgMVfJU9SYsW9QsRkcOUYpIhEg4lRyTvl3P0ah4lPgXOFLP",
+	"jpot2Iy+XDVvyQNmhMkYsZRhUmh91f6GqgOQmmvoCQfo3vhkR/lIHilqiUM4aXRdcq04K0NppFIlYywA",
+	"ZeDd5eWZrWWoo2z/zLtpzor8Q7tLpqMyOELty2umIIdXnKWQBi0twCdQGrXQkZ7RkEbTFvhxnwOuOLZc",
+	"WGN0i/75yZfsldYSd8UiAhF3vuFEEoMIPMUd1LHGamYz8slLSYHRQz9bVDhSl91Wt/F6NaTeAC77UZhP",
+	"SThhlOAv+hQ6YNJitjq+YsRdG54WCGEqMrnvdc9JH1qfo5GPPZLqW1XezlHY3TNJdtWO6j1RI7SUKCe3",
+	"y9TzMVNYk8WaTVNWN1fVlHfpjTQLc4xM0smU41Dti6WWubtL2YikKaiCtg0xnQdl84YYDlGsLcxipNzP",
+	"rBVt7koZjF7uRqPh89GLaDeMhsNXe3uv9l7uDl+MdvZHuyHafbkfff/i5fNXwyjc397e3htto+3nu692",
+	"4fdotB/uKaA9mr48mr4sfiStOGNNcanpaAWjluvljnnNgadufL8hz3GUDFEUIQ/C/zRBYoKYzxfYNrKq",
+	"9yGlItRvXQcJhpTGCJKg0Ye6IvZ3eZL5LiQwmjawNsqZ2shQrafQ3QSHE6WaVS3Bwp4qyunZc+uc5KPY",
+	"OsBqHJo89zyqgQ35VGEuXZgkBTL+VMcjMIwhuWn5dk/6XUFe+FmpPiF3PBiqflAbd3ta6xT6nQ4fWs3+",
+	"KIU2wVTJnR6qoNywe8qMW9/rlCCR2sG6VqGAyc9pa3nf9hpNKVvd+15sXFew2DdRPgIVlx6PI0NFSGae",
+	"iTNPvfuS01o+e5Mqps3F3a+nB5vt29GgFVsS/j2YwiGOcU7AF2fj3KaSjdDCjuHUgX4RKaG8B5T3abTa",
+	"LXGqmuYQWGqtiu1tQq+eo24vlHxmHZqVry/EvhEbA6edmwo2VFpjv/PMrcrjXC+9ck5jb8wnp7j6mArN",
+	"55ASgkKj9svPRR0YXAvyjg/9l4cpBseHrlK5MoL/DOmWfYdrq5CGHGvzUfLAO+ZSlfM2xnr/KMVoCiFR",
+	"jCrXZlSYYIFhjL/od3Ye9QyxBBMYt/I5C2qbtQASYdMelgOElA5sZVUtB4BL7q+r6/LFITCg0DKp3M8o",
+	"KmvIcuux2sYKyMZIrMGcuvO7VJ35TWn0OGss3um8ft/mlpv6AOo4QVUgJEhMaFQ+pq6C+4ogpc5V6utQ",
+	"qknPES9NepaaeNaMnZ5nVSuPOhs0x5KrY1hMexMU3jRRvua6NfFKiTZi2wKEsglIEZOnTNuor3gFt71X",
+	"cCFMrY6pZ7Tpm7cZIhu8ehu7n2N3sgTYC6S1Xo5XhFu9hGuAkev/l0Fj3wKKkWbVcefQXC+fXXOVYt4L",
+	"wrrRtMewlk0YTkczMVp/P44QEVhMN4xzEo+W5lqLI6M41mIlc/hVWTuHav0exwniAiapBUil81vVsni1",
+	"LGqCB7mUuL6mVHwF2G2OEOg+Da7YB55Ik41PerMEpj7thUlM4z3oWAzl59RPZlYiKZXj3fKXN1GIObSo",
+	"TobmkI/3NpjgSo+HPBThBh6jVbViJc7h17oKKwDY4C3o67kJO90QxD7Y1q87bXpnUKRsD1b+siSeVmZd",
+	"xbRKcWkWnnLf1OZUm4+zp9zvauiWAl00NDyx5r/B6UX+QGvk4PyWRpelTlQlI0Bl4Or8/fx3bpMRzryV",
+	"rnIsTy8WXtfH8uPdrs171lTJIR43ev5Fqqzal9Eua23yAdzudDrPFoVXedAloZebaC4Fw9wgax5nZMLy",
+	"rUh3ypPLA8tifrPxTosofhvstqpBT7MgH8msY63tmm06xku2Y3oDy6HqizArP0FmaE+PYSFVsZ4oL8uQ",
+	"yPJE3SAy9dJicF+pMyFfsZ2kr2yu4bujhWuglBU6CRsx3RVCLxZ9yloGbNB+smwgWrOjdH3Em+doo0y7",
+	"/oyuJibX9SrjoEbZuzJyYIigOw6GaESZEqlJZ8sUM7Temn8yU3SN2pvWrGSszautWBrxtV0h65bVvjlx",
+	"GqMGNVtscSUU+LaQkhrx4NqmIK5E2OtBX+ZGNiP2kz3TdWZs+JmqMXA18DrTVpm2jjWXNbtswhasCcKK",
+	"HawPiFozGi2pUrnMdaqR8tHG8oIt2/xWLIihCCdnOq6+V2VmUUlVBCYCf3n51SbG1s/OIyNYKCauZWLY",
+	"6riX8hnCs9EI37eANhGcoDhuczGNERjHdGgHU/NXo8MxxIQL69gYT4E8wUgPoeaUwHsbH2D3xctSxoBf",
+	"ttuvYPtLt/3zwWDQ/rUzUP/8Mhhc/6/BoD0Y/H0w+Of1d0//c7F6z/75dDDo/KIr+oq9eQnmG0lpc5c1",
+	"Ykg6Xi+mG431S6q6VjSwK5q6kl//+5M7QfvMlQhMW2k/JBjEsaoIQ5HBuHBjXfcGtZdLUbnE2qxLGetW",
+	"Lp7jDeuq2c0MWVGCy12uaGhXJfhuN4sHGMjRQG2lNiaxd73cSq+jsrvDXyWogHupr34BFspgdeu5FpIb",
+	"MLJ0ZbdG7rQ5EaSV1F4gRBYxFzZnRrsLI5KnuNB3DHh6cnp5dKDtTnITeBP52A2oZuKHLGpAbAxvfueU",
+	"tPGYUIZyS5tcbrM5odQmOIy8o/Ucjrwva8kVrE0qauRBX9PW92HVXotOyryLnwKXWIPN0F49g+iKYNFM",
+	"dY0x6doXa9QgZndoYgmwZXof+Mm/izPumc9plcLOYhEFNrinYUlpwOomUw5VmEAW3UGm32vaWUlqwjUA",
+	"QCnF3eZNqcwcbACHr2ZM5YHXBqXYS4Xe9StUTpVjrj/K7jkaUmrcoM+oCu15OhqVNC7dO4iF8uk2xjQ6",
+	"CsAoxqE4gxlfUsBdWpAztVqZM1tPaVk2Uypy1+QpLi3TU14VuZcKfcDwVKvCZ84elyjtYl5mpzbhhzlM",
+	"TiQ6dJ9SXlyr2lJuQI5gOFERxULKGOIpJZGOa1K8YPWpMn4sOXs47QzIfH81vYjSoQylbkJlH8kN/RsZ",
+	"dDnJRls3yXZ0xyojnq7iPcOu7X5DH06NBsNCb88Sn3zGZ1KtLK3OluhKuwOufKvW3BIla2IJq94C/9JP",
+	"bSVwYanvgnOu2v27UM5BU59Fq7ynS5K92jt1jtFVqmoqsWECCRzrIDrqAtDXIm+Z0NSyRHlCme9Oho6I",
+	"3hEjOJAXlgmB5rHJMPUutDPxapymXmHeRc6EbLTTh1WgHq2k9NKz36gi2r3GrTfbV77GSxDY4DVe73cJ",
+	"VXQB2lwPnV7SQ6gC/J1m4nRk/nZimqyiWClN0hnCU+qO6m1cCa5SLp2rO8H8Zm7Eg80EGWj9xUIneImc",
+	"EUYp6qY7UPQN8xsdHnSZ3OE6aSFl0zx5uOlSdV/uc/Zals0XfJjNCkyWwHucZEkRABjKgHGue5E2FBcU",
+	"hCYtlM52mzcoCHmewAhA5QJKOVb6DuOLZILR6bsPalmGzptWhFbIP6oIlwfgN66jFHAdwrgFfkv0Bx14",
+	"QH6Y6A8qxEKnnJX26T8Pftlpv7oeDKK/P/vnYBD9wpPJ9eIpao9ISOXNtYj9MTJ1NaIq63O1s1DASvZ0",
+	"l6Kksc74oqMHLxybSg91Zhrb369NJ83LqcStqq+pVmVG6HgTuFWihjZ0BnBtb9/6FMvOnca3d2cverm3",
+	"G+2/3Pt+L4QQRfDl8wg+336xO3r14vsRhN8/3x2F32+/2N7effn98/1h+P2r7Zcvwv39nVfRznDbdfQM",
+	"OQsOgrb85/XR2+MT0Ds6vzx+c9zrXh6B86MPV0cXl6p0QPrHx69f/957zT4cv+4evn7fv7q5O7/7dPjx",
+	"w4fDo+3ufX/3w27/yw83p4efvpx8Ofn9009v4p/fHu2evD2fnBx2dwakn3x6cXIZJZ9+Oto7Ofwh+fQl",
+	"vDu57N71f/+0d3I4wZ++hC/6h592Pn0ZP+9fxjf9n47v+m9u7o7uPr37kf58PCBfft/udT98Opa/vvy+",
+	"fdj9EB5+GHeP3r3u9/a2T85/uPxh7+Sn0xjhV59+unnd3+p/oSeHb6f98x+zL0fbWwMS/ngz/a+PP6D7",
+	"d5+374/J7u6n3snJ3s+HJ/f3dz+9fB9/GO/h39+S2wvx4XT4stvtd+nbXu/z24v+81evu/3egHS3x93+",
+	"0VXv+MPhBbvHL29Y1PsxfN+bRP3Xe3ffH39ODuOfJ+dHb4fv+r2ji4/kJedn3ePxz++/+8B+EHcDsn/+",
+	"HXueYvjp9ucbwfjN3rR3nH3Zmxx/H9NPyX+d7UX7/xgQBfajk8MZW/Lopv3opr1UNzUKswGP7Xqff0JG",
+	"goYIhTBegKrbqkXoWv9TIKfxjhIIoLy3Zg8jaEMdzoj8fee4ftuLZQI5GCJEgO3A7+ldxH9YMaH7e9WB",
+	"vLo4EhU/C+nXzFAawxCZavIwwpgj8NSEmXjWAnoKytk7QWxschNqsY8NERLZWs5RrsHOO5zyLnPHUAwF",
+	"tH58mhEDI6w95ARQ2hhJdbzjN2QzcMYsJaLze4fqXPbFttUBQJldiEooYZLQKQRSq/y6MFwWZErW4B1J",
+	"NlYA9aNf7VAbVF//5Dpa/tXfW429ex46c2Yyjzw4JgPrEoqmKEnqQQCFCa3gkgqp+3SpxGKuKbbF6+n8",
+	"kFWm7gLvS6fXlrukBcKIz9uCFew2PIAvDuLiWOkXAHqraV7IqainU6v7hFtzcjlrn3UxZ/598eVTcfM+",
+	"cB0B10U0zxVQNqVZMzJLK1DylPN5LuOXbuwxv9u4om3Gg7Uj1evgqY1p0RRfbM0LsGuTnRial8cityYE",
+	"d9gklJzqKKOY50YHE0RUxjsHzTD33dgFHdxcgICSfpezNahuk7y1oeJyJ7HWSRPpg/FKUJl3h5Rtg+ef",
+	"qXrCk87SaUzq+Q2QHw5/1cQkb3CMejq+nh9iNvie2uIRjv1B5JrbK3EPEOhegKdXl2/a+88k01RJHeUM",
+	"oiMDxo17IetZ6c+KaORIuB4elgFUc/QGWZrHa6hDaMxoljZFbYxl0k1Vo+XIExFWLCe0ycYl1EiWIIZD",
+	"cHxYTr8+CBilYhDMDCc0J25QYohV4wxTxIwdtMrj1gGfaKbe73rOWreXUIbACCY4xpABGgoYGwYXxAgq",
+	"YeEXxKiNr7r98vlzhQ9QX6YhTkwDHeXB1+b57vYzKUAQGY62OBJj+R+Bw5spGBohKsi9IhWbXMo0ryM3",
+	"VRajpHtynZLiF3CV0/MHmMpMKv9GaNE7lUjsK+7nV82KL/F5A4oQl7o8tFbsID91K/XQHXIaZwKdQTFR",
+	"PdQUCzlZWUbF4A+QXwsmN8biHI38mMLcmOYQvFVBWR0Lf5PibBmVi1W0ONFnTeyYIiVCQzw2Wzz/jVB0",
+	"VUrIV+tTs73n6BbPYhJ1qZx0xp08rDPnW4sslE++NmqrSXnUFDtvThDfhROhm51f+CJ+h+Lkz0kosFrA",
+	"/XACmSgC7k9QnMyN8idBwVMYznazzWv54vuZpPWJicGVq1iCZNqGadouhvCMr5M/Nz8ZdECjmtDHOYK6",
+	"B9/EchWevHGGWDDIcDwFxCSStOmoeEUxlIPbPXEBGWNyr5B3LFU9nd0dbXStE4kc/BEgIq0XIjvlCeWC",
+	"K8yQfwUHdoROSBOD8rpYk4pgy3zUWr3gjKERvlcZzoxUDIewRzMigoO9VmDeQ4rUUCaCg/3tHLi9OOMC",
+	"seMzP+uk4SWp9gzvBwtUWUvRPvXkMuIzZ7+B6scEl4yhUqyqpblGM5LAQe0yxSLErJeeioyeS7n0iKWt",
+	"+MXMVVaKMh24awoT6aFpCugtYgxHiHemSRxcOzz8fK+ajWZlaMhQUrtspH5jwduGuLHH5903cqPOvHeO",
+	"/GpvmDx/vKxujQUFdcRYlsuvmuOrqXB0i5ijWb9jWAhE1r6tWP22spcNLAJugxn3mHYR8y2e5S+Wq/P3",
+	"JnkpTSTKjoSR8suHjiztgGOh4rRp2zCZZR0pywgGEyQQ44Bn0r+MH4BBsCWxfEvQLasG+6eq/Q9V28cW",
+	"zrwR8+378y9Bi5ELo/rMJI8z0pAseoud9o5NrAL56JAPfxgK772TwvBmIeOUtY+3WnNfUl9Phpx6HAdV",
+	"RzNKxWoS2VzHey+eHBKLZsSHWC0Dpx7/Qh0XpeDU18YK3eme1MIbg0Lo3pcD5VkWx4X7Yq7iCY5HJ1Sc",
+	"aXlW0Gqwci4/xJ64bZ50wE8TRJQUUZZ14zs45U9aTj4izEGayTApJseICrpfbnUiS0qNkowLAGMdP1ll",
+	"8W2OgqbHDFrVxaheF7SlkfDJ+5E/Kn3JT6a/mXD2YyvR8a/KpmhqEx++KiauF4Wl3qEnlIIbZ8bcJFIq",
+	"RuQ5bCt1G4ZE1OmL5wVXQtHVlu+guVq7oXRziOD82ep87AyNMRdsKi3fsLbTGCIArXoMMaehTleamytK",
+	"qmQ7a8lLNqZSLcqBeeBSlvA6PeauAeIiN5xd7+J7PDPd76zLRTWc5ZruXhyGy9lYPIxCfjKHzdSzXOci",
+	"asqrd7A8RHLmXmdzr1O01WGTy6Y87nVfl51pBHErWD71YQ2om5p7K9BpexYVPBWzNPl+/kXl1xkRK75S",
+	"Ssp2DQPnJWJ4q0YJx/w9K8C6rIgkL16gq39dmbTnqLlynWJr6yfP37o4AAuf1b6KaPWY/dAFyaJvEplH",
+	"KzIpIPRxKUsW5FI9fKHh0Wp84dd5Uiz5lHBsL+sukXmZ5PjzMO1KAADjGKSIcazkgEWMOcXlT+Atahla",
+	"YwQBXLXQk1HpM5ipqy9Ojw6dECqKACMrGkMUlXXy9VIwBX+iPzkfk6Ncxc+dYb2kA23IlspmSS9lCZOl",
+	"CMVolbGkAYd0r5PNlxlvPCOXvTQb+Zyp69JkwCnZPMOccwVFL4Wti47Qrk1/wBlNsxg6rpD6/umAcwSj",
+	"NiXxdMHU9/NtYZwwRC/35rrU9qGKhqyLpeuuFrEaQa0WbJVzDFA2hkRmGJD1QijQmDL58ykPaaq/chSj",
+	"UDyzuO1FqsXuT12/Fl3Jty51x/k20TFJh0JehVwrMuz3luQQBspEeUuOPQhMrtam/EVuRkTPgATQFH7O",
+	"kAWqGhar6Nq5A40WuD7hhSu0YysFibPu+kWxIBUrLInqVOxCe7AiZc6kdfoDTZQHAcC50bIN8clN9Qjc",
+	"YmhSU1EGWJq0KRcMWXmGcXeVnRkhWqk7QnW99hBKXCrPAnMAbyGOpbbDlXtYeZDpcEFJh179sWmrf53Z",
+	"HppAdtY7/yjx3sNHmhKANVC0B4TcKnDWO7fIevGu25bZNIeQ3PicVCN078d4VWR7OeudV2nB3q6XFtz6",
+	"J3upQmXc5yY2dlr5pJ1R5gg41ZTtQAtfoGdQhBPH6yp/8M0M51WGFm0g/MYFXOuNTfIAF11gFAW5ubf6",
+	"K6G38g9Rji5bHGi/Tr0Lfrg4PQFnmmHNBap+RaN/qhreggIYuebinRqYaTpLWV0PHuUB+YcMRjESj3np",
+	"N5GXnhK0KthmajFWmu5s2ZVU5C56LM+NJtX/VD4vuz7qqvqt7NcmNJ2belsrArI8zwkV5jKGxMi95T2p",
+	"6ltGjt4i5igCC+0/Z+GWokud3/ka16N9D3VjxMS5iYWQNgdDqa9zUs74UrEHl+uFsm+/cXajm7J1YLY3",
+	"jWJ0JTAcOQW8RUxdt9xoHvI0iUYFrgbGZNwBb9QtcjDbPfkJf1L2O36SPCn7HT+ZPGn0Ox4Mou+aXY1T",
+	"xEJERGPA9aJcQk2vSKGGYHg8Rox7IanZcS3wukUrR6crIcGF6ckfkcEO4+xdaXFlvvp6JTQszaDugW1K",
+	"a9hlrz5vEGwVwGUxnqlxLkXHjVWcERvr6KnMg4QNSyvXj+X6E0yg+ZDANDXGv72zq6at7qWZT9rRCg5V",
+	"5HV/o6ZwEK2gb0Kr+9s1C48K4cZUJ40vSXUeWutcMw1LXOmCmbWCVdxGGgD5cF0+USUR14JIMTNKjz+A",
+	"BSxZKlbkKZb2z4rFrCoBJmt1gMyGBygxh08SL2Apg3rAaJq6mfjMxc3ki9Asr0NMxsdEIOZ1g80vkiES",
+	"dwgRCxSgmiL+p9wNeUCKpgtihtyz5e6PZ8UL09jGrFnqu9o3Y5hkWHa5mhDG1lEoouSJNV0CWuHpCDa+",
+	"ojt+6DXMv8jGY21qqKynzLxCa8uuRBvaOacFtgE2RvBac7DAo/IxBsBGYwBw7mV9FmEf3RBYmBcv36bs",
+	"3dzPpyYwnGCCGoe6m0wrA8iNNkqzgcqPlTEpyNHz0e7Hsv6tlYigJBWyD8TUT0LLLmO5VAd0pbiTUwLC",
+	"GDItDLP2iNz64EYIDDN56JCO4GttMQEWc8JxzYpYWQAPnBLJakpju4ssDBHng0C+zp2VfnW04SkK25BE",
+	"7casVwvEUsjzWCsykWNAgXSLE0gdvrarEjZIuKFmtdYEjyftWK5UB9BVWR6KOOYl0Z0q01OLqXLnUZr0",
+	"/PPIJkCznagKESr9TCAmAhFIjDhnxBCf6KJsqfhj9VV27UTqRefOjOulx8Ua6oV5WreGAe3C6sWHCM6u",
+	"0C/BwjdrBzr14rkx0UyTI2VyPgcRtF162f9RYYR14bNYYA3YW/avNsuIUcfEmNygKP/DKYExhlxtP9c1",
+	"9B9ODTkyDnUiIjsCJjr0WJArdtRnHdFP27gNYeSgTitYDnsc0Bzl62osO88nW6/y3i69qWhW466BTr2k",
+	"b+HVVDSr2wsL0nrRYQHkeuFxAfZ64VtnIzwI5mxNvfQ19Lcq4ud6YC9vo7k4/l7G1pyN4SqJ0Hz85iIb",
+	"SgymJpAwoaI9opmi0UMYtTkS5kAjE09Yh/9wkXslSpYv4ULPoPr5vZ1RteCEijdmgtWi1zC6yOdbLbTx",
+	"kKvf+3Y9tYIKMuYFi1IiJ6J6TTwHC8K2TtD26q1X1ZB6L8Fm3s0alKtYfyXB5WmKyMXFO6P6AxFECSUV",
+	"xeb2830Pi4MK5F5npVWy/qCRdu1+y0dJuU0M8059x+pOMxAtBSRjJmQ16QUTkQOOZYRYXqBQbT8vPzRh",
+	"+8t2+1X7+juvFFIO5J9NniDIOhkPAs4nUcfYQwyCZ+XJuIVz2TY1bBmfyrvp7kCrhNEOFBfm46TNxM/U",
+	"Wmda4/f3VMvl6rmQwRdKUKGZZty8/BUCH3dPukYPDrrnR92t96e97uXx6Ym0YkEMqY/lgIkhJQIT5c3P",
+	"AA0RJC1lDmBb5vZnsnIKmcBhFkMGOBZIuX5jYtQCDMGWOkoG8KCrTNPg1gm6+/UTZTctcJRJarB1Bhm2",
+	"gpmMwGSIxxnNONhrS7dFGCpvHLvWios4eDoI3vYvB4Hc9avLntnsxcJlXtUiKVeNxkeYWG2/qaWWBDNB",
+	"5dMozGNBKzkViXxRpAVObKk1opPfEM18MTZWM53qMUqO7uU+WsEDF5CJtwyGyI2nurwo0DaWAiwHN5fu",
+	"KEfs2rtIBN7ZLnxkPn4Tz9s0G8aYT84oEzOcJSeUi7ag7bGKaSRRFhgBeOGW+rHfASroPiKCTbX5mHOI",
+	"zfkdKGdROdyB6kz+ZV+99ZKtlFFBQxoPApPXdRDsb+9vH+xv20bm55YIU3NocnlnJevZ9XcH+j9Pt56K",
+	"MP3vLEr/m4cifbZqlrJ/QfVsVSLzsV8kqbSGNcYs7I0KGNsTsUmpoKIPf+yDSFITZTthHTIiFONbFWXJ",
+	"seUlhVvFVu7VoYNsaAt2r7+ClATb8jyRpImWoW1mjF2i9gAxhuMfMRNA/iuDcV/LmMCnbv+91uMSbRia",
+	"KM9av1XhPDPO2sHo1+1LNUU1BqiL6qh1P6ljG174tqiQJeaGUJ1rslv2IA62kAi3lOu2FAGNOtEBo6vG",
+	"sm4FS+UIXS4rXkMvpZSwbmSx2TlRWwBznhXYavigJ7zAZxVaiSkx7ChGSACBkjTW15qGdJJQYlIyHp5c",
+	"qL+0zeHxmbSUYYhzpEXDhbeCzZduQhaSyHReONHW914u66wxXgY0wUFKBgpmGOm6zLDQBqZu3CgXUoI2",
+	"8ApyfSeNxo080+JwBw65f8eccFQR4Sf6CVjjNXI4Cpq7GUOeDwZjZWSolDyqXoliz6HBEu9TrI/bhdYC",
+	"NTmXKMm8drrHkaOqduGGidUlaeKmEUbtt0KuCkKaEEJ8QplALO95QWXGDO8VVeSboMQumcJG+TF9S78W",
+	"nHbtcfBElnUPy1fZ9Rs03fDxSRm+lRCW0G04Pgt6QhXbpR6RnzONKCVPH7VzDboSRRJpkkCfrqurDqdE",
+	"A/l/HVhqnCXqkeOkkc6I9faXq9W6ZoLuypNz3zAqlewNqvmb6+lI/Qa4NBQSam0bukdhJg+UFq/F05ZS",
+	"iYgJo9l4YtPWLret+rzNIFD6PHryZVcPilTXqXBc1YSMd9rrmd1arWAeENLZGesHqvkcrb+I5DPORjpZ",
+	"wstJmX5/27O8Of8n5wZpFZdYcR49nlCSWJgUOIqTCRXzhBKI4+AgEAgm/+lmIgjsOQsuc5YTmJDH4BLB",
+	"REokmWxqlV+l1jUfgl/KXVw/9TV7ZtTHBkdUuggk9YCaQDmW4HSkb3elvEPR2B5offGLCcIs5ze4zhsW",
+	"4xARjpykCd0UhhMEdjvbtcXc3d11oCruUDbeMm351vvj3tHJxVF7t7PdmYgk1s9WoRi+CpC6Z8fSLDrP",
+	"82BTO+hMWQSmODgI9jrbnZ0iJs8fwZaDmiaEqz1cgYq440tS0dNetBC4LJxuXGStKCxcckXncZQ3bmwZ",
+	"aAREXLym0bQS0NEhUVu/G92yfgqtJmVonMRD+SCY20+njzN33+72zjednW9LIrnbz7e3v+7E8uD6tVm8",
+	"hhHIJylnsvOtZnJFYCYmygvIAGXvW03lDWVDHEWI6Hm8+lbzKKdoVJPZ/WaTuaQU9CGZWnRRAXNffLtN",
+	"utB3wBXJTUO0pAeOlXqrkUoG17LaDCq69Yek/g/Kbx0Jn407jDSnkjMCjQe/TkzfIjGLkhZvUSWbms3B",
+	"zifmkvMYa6stLHswASvN9WZ4hTLVbDnbVWVUNJd8rNlEzX1c14js9l+IyJ7++EjVGqja8281j1xx/EjP",
+	"NkjPDHdriNcWFAJxkSsk/FzhhbKGBBB8zqh65/A7pO2dCXA6AOEExjEiY+OsqGP6Kz56jIR5m/EsVs/X",
+	"POs3KMzSfOyk7qTrTHNJ4neYywn0VDdD4x4erv9EdtZZ/ge5BYtxsV+ZwNqcvWYfmzHWEtdH5tVD5r8l",
+	"eQUFff12zOtfl211qKqmmnNJ6FZOARu5wrdIEtK8XkGXdFx7BHkmZVdDSp10D5aWSm9Gpy3mxiAfRdL0",
+	"3pFGYm3ObIWzOiCbS6p1emlpJB0a+M/oTWcjyXNIgcuzvjKbVtJKp1Mp/MIcGPsyL09bI+e9HGJ/Jbr+",
+	"Z9JRLzAayeiuphjVswyc8G2P9O2Rvm2Qvll5+kyS5hO8N0sK3yJhczvpY7z64R9XB+dVB71NEYaWb1Iq",
+	"o7sid/kMjO9EPqyKqVyM681s9ZciSGZLHknQIwn6i5Cgwu0qhSL0h160YRWdTGGH8+iQalZKPbcaHXLF",
+	"aGqG3+SR2VZDf7eBPSzF1PnrvDUfH5ePMsR1KPCjELEiRARNUsScGLeCNPOwfNoefXmCe66jUm2Y5GpT",
+	"9n9FwZ5L1x5J7KP87t+DtDVxdUVW28UtUUg9z+lcE5Raiz/T9KQ++F/B5KRhVo+mJo+mJv8mT8m/ND9V",
+	"o3yNFHGeVYnSHyxHFN8i4aOIS3FdzeNt1HTkG0i7FqKMj/Yhj2+7f2ta9KDTcVpioG2Yt2CKt253dGof",
+	"OPbRiVNLaZTzVuVtpow6DCEwjOBDa3YPzXTG7ay+hIfrh/8ZAA8vs5jeDgEA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
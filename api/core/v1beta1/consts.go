@@ -18,6 +18,8 @@ const (
 	CertificateSigningRequestKind       = "CertificateSigningRequest"
 	CertificateSigningRequestListKind   = "CertificateSigningRequestList"
 
+	CertificateRevocationListKind = "CertificateRevocationList"
+
 	DeviceAPIVersion = "v1beta1"
 	DeviceKind       = "Device"
 	DeviceListKind   = "DeviceList"
@@ -33,6 +35,8 @@ const (
 	DeviceAnnotationAwaitingReconnect = "device-controller/awaitingReconnect"
 	// After restore when device has a new spec version than what we know,
 	DeviceAnnotationConflictPaused = "device-controller/conflictPaused"
+	// Base64-encoded TPM2B_PUBLIC of the attestation key a TPM-verified device enrolled with
+	DeviceAnnotationAttestationKey = "device-controller/attestationKey"
 	// This annotation is populated after a device was rolled out by the fleet-rollout task
 	DeviceAnnotationTemplateVersion = "fleet-controller/templateVersion"
 	// This annotation is populated after a device was rendered by the device-render task
@@ -42,12 +46,30 @@ const (
 	// When this annotation is present, it means that the device has been selected for rollout in a batch
 	DeviceAnnotationSelectedForRollout = "fleet-controller/selectedForRollout"
 	DeviceAnnotationLastRolloutError   = "fleet-controller/lastRolloutError"
+	// Time at which the device was first observed to meet the health criteria of its rollout batch
+	DeviceAnnotationHealthySince = "fleet-controller/healthySince"
+	// Name of the organization ResourceSync that manages the device's labels
+	DeviceAnnotationResourceSyncOwner = "resourcesync-controller/owner"
+	// Comma-separated keys of the device labels set by the managing ResourceSync
+	DeviceAnnotationResourceSyncManagedLabels = "resourcesync-controller/managedLabels"
 
 	// TODO: make configurable
 	// DeviceDisconnectedTimeout is the duration after which a device is considered to be not reporting and set to unknown status.
 	DeviceDisconnectedTimeout = 5 * time.Minute
 
 	DeviceQueryConsoleSessionMetadata = "metadata"
+	// Query parameters of the device port-forward endpoint
+	DeviceQueryPortForwardHost = "host"
+	DeviceQueryPortForwardPort = "port"
+	// DevicePortForwardProtocol is the WebSocket subprotocol of port-forward sessions. Each binary
+	// message carries raw bytes of the forwarded TCP connection.
+	DevicePortForwardProtocol = "portforward.flightctl.io"
+	// DeviceFileCopyProtocol is the WebSocket subprotocol of file-copy sessions. The first byte of
+	// each binary message is the channel: DeviceFileCopyDataChannel carries file content and
+	// DeviceFileCopyStatusChannel the final DeviceFileCopyStatus sent by the agent.
+	DeviceFileCopyProtocol      = "filecopy.flightctl.io"
+	DeviceFileCopyDataChannel   = byte(1)
+	DeviceFileCopyStatusChannel = byte(3)
 
 	EnrollmentRequestAPIVersion = "v1beta1"
 	EnrollmentRequestKind       = "EnrollmentRequest"
@@ -71,6 +93,12 @@ const (
 	FleetAnnotationLastBatchCompletionReport = "fleet-controller/lastBatchCompletionReport"
 	// A frozen digest of device selection definition during rollout
 	FleetAnnotationDeviceSelectionConfigDigest = "fleet-controller/deviceSelectionConfigDigest"
+	// The template version that was deployed before the current rollout started.  It is the target of a rollback
+	FleetAnnotationPreviousTemplateVersion = "fleet-controller/previousTemplateVersion"
+	// The status of the rollback of a failed rollout.  Contains a JSON encoded FleetRolloutRollbackStatus
+	FleetAnnotationRollback = "fleet-controller/rollback"
+	// The time at which the next maintenance window opens, if the rollout is waiting for one to start its next batch
+	FleetAnnotationNextMaintenanceWindow = "fleet-controller/nextMaintenanceWindow"
 	// Per-application fleet-level lifecycle default (desiredState only), as a JSON-encoded map keyed by application name
 	FleetAnnotationApplicationLifecycle = "fleet-controller/applicationLifecycle"
 	// The requestID related to an event
@@ -159,6 +187,8 @@ type DecommissionState string
 const (
 	// The agent has received the request to decommission from the service.
 	DecommissionStateStarted DecommissionState = "Started"
+	// The agent is performing one of the steps of a factory reset.
+	DecommissionStateInProgress DecommissionState = "InProgress"
 	// The agent has completed its decommissioning actions.
 	DecommissionStateComplete DecommissionState = "Completed"
 	// The agent has encoutered an error while decommissioning.
@@ -174,6 +204,10 @@ const (
 	RolloutSuspendedReason = "Suspended"
 	// Rollout is pending on user approval
 	RolloutWaitingReason = "Waiting"
+	// Rollout failed and was rolled back to the previous template version
+	RolloutRolledBackReason = "RolledBack"
+	// Rollout is waiting for a maintenance window to start the next batch
+	RolloutOutsideMaintenanceWindowReason = "OutsideMaintenanceWindow"
 
 	// The name of the preliminary batch
 	PreliminaryBatchName = "preliminary batch"
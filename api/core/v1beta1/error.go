@@ -65,6 +65,10 @@ func StatusResourceVersionConflict(message string) Status {
 	return NewFailureStatus(http.StatusConflict, http.StatusText(http.StatusConflict), message)
 }
 
+func StatusGone(message string) Status {
+	return NewFailureStatus(http.StatusGone, http.StatusText(http.StatusGone), message)
+}
+
 func StatusInternalServerError(message string) Status {
 	return NewFailureStatus(http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError), message)
 }
@@ -138,6 +138,14 @@ func (c *Condition) IsDecomStarted() bool {
 	return false
 }
 
+// IsDecomInProgress() is true if the Condition is a DeviceDecommissioning Condition Type with 'True' Status and 'InProgress' Reason.
+func (c *Condition) IsDecomInProgress() bool {
+	if c.Type == ConditionTypeDeviceDecommissioning && c.Status == ConditionStatusTrue && c.Reason == string(DecommissionStateInProgress) {
+		return true
+	}
+	return false
+}
+
 // IsDecomComplete() is true if the Condition is a DeviceDecommissioning Condition Type with 'True' Status and 'Complete' Reason.
 func (c *Condition) IsDecomComplete() bool {
 	if c.Type == ConditionTypeDeviceDecommissioning && c.Status == ConditionStatusTrue && c.Reason == string(DecommissionStateComplete) {
@@ -204,6 +212,33 @@ func (f *Fleet) IsRolloutBatchCompleted(oldFleet *Fleet) (bool, *RolloutBatchCom
 	return true, &report
 }
 
+// GetRolloutRollback returns the status of the rollback of a failed fleet rollout, or nil if the rollout was not rolled back.
+func (f *Fleet) GetRolloutRollback() *FleetRolloutRollbackStatus {
+	value, exists := f.GetAnnotation(FleetAnnotationRollback)
+	if !exists {
+		return nil
+	}
+	rollback := FleetRolloutRollbackStatus{}
+	if err := json.Unmarshal([]byte(value), &rollback); err != nil {
+		return nil
+	}
+	return &rollback
+}
+
+// GetRolloutNextMaintenanceWindow returns the time at which the next maintenance window opens if the fleet rollout is
+// waiting for one, or nil otherwise.
+func (f *Fleet) GetRolloutNextMaintenanceWindow() *time.Time {
+	value, exists := f.GetAnnotation(FleetAnnotationNextMaintenanceWindow)
+	if !exists {
+		return nil
+	}
+	nextWindow, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil
+	}
+	return &nextWindow
+}
+
 func (c ConditionType) IsServiceConditionType() bool {
 	switch c {
 	case ConditionTypeDeviceMultipleOwners, ConditionTypeDeviceSpecValid:
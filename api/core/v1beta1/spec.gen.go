@@ -18,483 +18,589 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+z9i3IbN9YoCr8KPn5fle0ZUjc7jqNdqdmyJDtKLEvRxdmeyH8G7AZJRN0NBkBLZrJV",
-	"9b/DecPzJKewcGl0N/pCSZbjhDNVsdi4LywsLKzrH4OIpXOWkUyKwfYfAxHNSIrhzx08P+bsisaEn85J",
-	"pD7FREScziVl2WC7WgHp0jERCGdoJxN0nBC0k0uWYtUCHSdYThhP0eOdneMnaG7aoohlEzrNOdRaGwwH",
-	"c87mhEtKYB54Ts95Uh/+bEYQzSThGU7Qzs4x2jk+QOcnb1QPcjEng+2BkJxm08HNcIBzOWOc/g5jNHZ3",
-	"tJPL2RYqVUYki+eMZrKx7yihJJMHcWufuhI62Gvp4pREnMg+3QioGewqpmKe4MVbnJJ6T9/lKc5GnOAY",
-	"q80xdVGGU4ImjCM5I25fgr2TTDU0S53gPJGDbclzMqwM9NOMyBlRHVIBm+N2mwpkOvEGGDOWEJypERif",
-	"4szAXi3imJMJ/VhfyhH8gRM0hwowfTWQ3x4WJtbQQRaxlGZT/RthThD5OGeCxAgL28E/oTS4ajv5MygI",
-	"bY9qgtgEUIdkkkZ6fB+WJMvTwfbPA4zngw+BQUTE5hrjy92/oUKqrg0G6GpIMsTJbzkRgAVUkhSa1no1",
-	"HzDneAG/2SXpPABQqQvxb4YDNQPKFTr8XIbR0J7awMnz5uCdncoZcOAoIMXGv5JIqjXsjAVLckmOsZzV",
-	"13FC5pwIRdAUHcKmLprQhKA5lrM6hZkH+1HwcK1VFQVzrPthGRwVsRCSpGvoLZMEyRmWCGcLRD5SIRW2",
-	"QdVrmiRoTBC7IvyaUykJ0DjyEafzRK1r/Qrz9YRN1/F8vpawaRDSdRjM6TvCBUy1RpiPD0wZismEZgpd",
-	"ZgRd6W8kRprKK6SC88ktxDTSKjTOkB5qDZ0SrhoiMWN5EitifUW4RJxEbJrR311vgJJqmARLImRBmq9w",
-	"kpMhwlmMUrxAnKh+UZ55PUAVsYYOGVc0fcK20UzKudheX59SuXb5QqxRth6xNM0zKhfrEcskp+NcMi7W",
-	"Y3JFknVBpyPMoxmVJJI5J+t4Tkcw2UwtSqyl8X9zIljOIyL843i1OSYSbw6Gg0lCpzMZyUQNVnyuH9bh",
-	"4ONINR9dYQ4URfVTbMg717T49sr2fcBCxfvpXC7UQB9HUzaqHeKd+byb9CjY4/k8MbTHXyPc8UIdy99y",
-	"HCdwvhQMMc0IHwwHM5Kkg+HgKu29VpjPruvWfPjR9e5qFIOYT9/pscyvd+ngg16gnbdqQjK4BXGSHE0G",
-	"2z//MfgfTiaD7cF/rxfcyrpBu/VXNCG20c2wve4JSbCkV5pyqMolCqY+1ulNZX57RKgGpxLLwIaYUpTQ",
-	"CYkWUUKQUBXhdlLUKLw/PM8yDWwh2XxO4v77EJrWieuuocKpHaW8tP3s6h3mmiSWCCQpCnAcU33xHpeq",
-	"1PmQElz2syvKWZYq5uUKcwrsxyVZjODoozmmXAwRzRTISYziXHWDeJ5JmpI1pPD8kiyAiOgWBEczlOZC",
-	"Kto6JvKakAxtQoWtr56iaIY5jiThwru57I6G6akDwxu7d7sznE1JvEckpkkALDiSQfqrZlsggK6lr4dr",
-	"LOy1rfkfiwFq32H7MVfHhxP919Jo4Oa+A6Oe6m7bKugBm2uc2KkoLno+D/OVasXARNbpELqeMUFQTK5o",
-	"REaJItYecNStyGlM1IYpWIdZWtiAbgqo68FZi6mqlNIMS8bRPOeKUJXofsuG3wHsJZSBGX+oMkreagqI",
-	"Di0yfWjHzWPGAw8E9RWleD5Xh4ZmCgIpluhiMGNCqsJtR+3Vr4sBekzWpmtDdDF4sfFiY/vFxsXgSZkr",
-	"Md8Vr4SluskH24P/38VF/M9t9Z//CW2TP03DDL7EIrBnuyxNNXNsDpMmj0lSwhvVvwg8B7OMaUblLvRo",
-	"h4+p5JgvUEokjrHEyOt4DZ2rt4FlYZIFGi8Ar4HxYAmaJzgjFoglvuGa8cuE4Rgu8SfoekYyJDnOhNoT",
-	"tT21JSKsuKlMvY2A2MHxx/FRlizs26qGEbhgCNquO8s36OWXrq1+d2vTvXfzYbjExQdMprfuoXp1pUwA",
-	"F0kymSyQINLCWJHCdaA5hmioh71YQycExyOWJYttFMFeKcqv2sWUk0jqTVKjLP4XUtWQYWrVgVD9ahir",
-	"J5+3A0ILLhJ6BUWGhcVTksn6RtwMB1kj+fN7BVJoL6fN//f///+UrySUsGw6RHqN11TOEEYJUacMMY6y",
-	"PB0Trhlmc2xRxtC1Ym3FHEfhJ6q5MV6TjGhBSujY5QqhEc0iTtRNTGILc9PaA7i+YBVC1gg6vBigPon/",
-	"DNvioKHO65Tw2tPUnpYu2lqVdvl3iPpgKKz603LUDefGcMZe5yWOu7GVqVBuB9x5QxPFTZdrWw6/oYFh",
-	"0cttrhr7f1fq/cYRYyNfcqC9GQ5YRnpQlABkupj2wJS7mgQh2dWoCsuu+hXYVJjpE/PYfENTKkVITKHL",
-	"UQIVnPit8kQoX37RPA+c6+Nz3Yk6UhHj6iX9SnMA6pxyCiz1GKsrjWW1C6h872+sff1ViL6kJGV8UR/8",
-	"EL6b8YGWMSuYU2/1O8xk66vnaV9ZSA3qbQCPWCYkxzTrC/XEbWHPu7Ky912TVndqLsL8rS4DmQwSNJsm",
-	"ZVpsBFGabvvs7TEnc2x4V2Dy9Z/F03Cfc6ae5efZZcauFRVQRzMhksTQRL8QzV+qydJMsZ66P5FaoTez",
-	"WlnwFauL7NxrBcViakX+6gLzsMsNF8H6y5t2LgivPwl5nu2IMIOQC8L9N5IWHsLnGodkpW1jot7AKFdX",
-	"pHoFU6FuX3XJQg+qN6yFe9CNOn80AyGku2xE6E32mE7s73FCnqyhPS3Md0I8Myssi4tXzUSo4R5PgclQ",
-	"bDFnTD5BdAJTUpc2ndDQI64s2Do3kPA/j8QlnY8s7RiB4FkBGPiujvPzjiV5WuFqq9ypFoNiYM1idAUt",
-	"1CqBBapLZsq7Gub6zjP6W15+9/r9ms0IUJcA8xYlmKbHLKHRYgk6oxd+UmpdZX5g7gHO54+eF/ZBiqdE",
-	"D1RikLpux0PFbd6iHYzX2PhD9ZoNVKodSr0rLaoV/2iYyiWtylLbUde69ELfkyoOOP3a4ISoozwYNiD1",
-	"jF17p3SGszgBVDfIqJ+gM4LYdVZ9gAIrn7KrsjDKjPeh/Y2vp62JZPu9dS+n7W3tmDUcpQnhJItIiAEw",
-	"RZbIxWSesAWJ0dHuwUhtbUKxepgoDFQvMXU3TXAk0RhHlwp0rWOHzp0/n47XhzjN0xTzRU9moCwsEc2M",
-	"wHcEJ3K2GAwHe2TKcQy3XP3yf8v8uSx/2ZenXwzaWMWbTWOdwD1frhC878tVqgtTUM/lbBeMDgIy3ZJe",
-	"rf3gu5ogX4HTaglRO/6aym3a4hpi+3ptse+r4UN691JtrfDWTbSorTRuWA9vJ9NGNhUSXmGagDS/YTFL",
-	"UNJczhz8QkS0/KZ30A8erFzO9hYZTml05IFiRwg6BS1EQGva1QRh+FMAcwScUhnKxbsmlzPPvEWR9YAg",
-	"U5P7RtXz96dHb53aGWSPqr7myQxzpzm/ksEDjdUWTCjhVjr588Vgylk+FxeDIboYbFwMPija9vPFIMqF",
-	"ZKn+zPj0YvDhyXK2BG2mGvbuqt1aZ2Bi4kw2aisAdsqJpxmfjoxsuvVEqOFP80m/4QVU7DP8COASHl52",
-	"6iNKHWOHRz51jjXCBe7aCr5LrS8okKYD609YQnpie7kqIh8lx5EUiDPFR0w4S4MYjXIB7ESBqXfHcTXk",
-	"OqCrQfc6En+AXzA394PgJP0FRxERBstt8ZIILdRT1Ur7CiTarmHRqa0ISMT4dFuNaPUujy19f7T96Mka",
-	"OgE4mjNr2Qg3FBBnMU9AfFOhKSMwgon1TtiO1LuC5bLSwzRhY5yA0BiErWCskiRlEnVLPIa1PRT+LkOu",
-	"G+h07DHGmlYDEutHdgmTFeT0wrSUuQatNhmwXXvLddZ+BQ0Hc8K1HKHlRtRVGrsQEsv2SZxCjYYO6iJd",
-	"uZQ8t8cA3R20g6lPD+1QumlCtvZmQZxrbYIiTrCE15c5npXrRZEL0KwovKzTyz43qmqp7qVRn6sVKhvB",
-	"TNR207leP/Vt23tGn/zutYevH+1qRKFGjt8vLWzstFVimFcum0Ijkc/nDOSjaMzkDB0d7O0ChddmmkFT",
-	"6Vs9Xi5pFnhL/ECzGFHAZYCLsbxxK7FX2cn+6RmytnWaymoQeYsu7AhTvEA0m1ihp6HMpLA21byuNnPO",
-	"x6AbMSYziudeQ7tOy5jPYww6yIMM7eKUJLtYkE9uRQhK+5ECWfg+tQYFXVtwBDA6JBID62EkV30fSFoc",
-	"1vwoMpvqTceM0YXH6nHXjsvw/AO8SOxD0L9Uxf3hpePcGt6ftWHv4Z25Og2f5TSoPdVnYTmc1jvehdR9",
-	"VPoYzxsxpuIKMxxcvhBNlX94ISqVmULUrUY6AMS82oTGjTydugaq1eckEzM6aVT7H81JdqoqVGTxVeav",
-	"ZMXfmwmszaiLZQusubNJwwo6znpl57rqVzdPcY6NXg5WltjnrV15ZPtPFP3Orj5FWh8u9/c0qcy9/3ui",
-	"0vD+3hG1jnu/H6otm6hC63sluHttLZxYUD2325+b4EBitPgaziU+tfs90G15W+J7r2dEYZWbl/VFsXj2",
-	"6Xhrg0V9xQK1dbZvXZ8DF6pZbJUFvyDSSjiEFZl0nryKyl+1bTICF65743umxoBJlEZb0ofrLhKbJXdG",
-	"ry60HS+xIKCs3c+ktgwK2+JOcCJq/oE7KFKvHGMNZFRuRHXkaRTAlAEd7R4gTqZUSL6oQ38Zd8cEj0mC",
-	"xIxdZ9b68PygeHDukkwenTY9OWGK4WEAClqO6Sn97ZyLASKSSSZGY8ZktO7/MGOm+OMbkk3lbLC99dVX",
-	"w0FKM/t7M3RQ8TSkeCUJiSSsV1UoDHA1jAuBqpCc4PQbLTDVPzY3ajJTb06bWy+qc/Jsw3++uLj+oP6z",
-	"Nvrwx8Zwc+vrm6CVeEqzA935ZoeKp4C4WWsYC2UUkC7DZ2DXM0QSsHZVWz6Gz0Ix0JlWkwYsvcJHK8Uf",
-	"aZqnxjwXMY7mhKtNBGXxxGhe4YBrTtyiGIyphup3ER67XuHqS2mmhvWh5cxcP4DIWm23ZgBa+WuF+6e2",
-	"smqYg7z8bMaJmLEk7urAn9dN00acGsg2bIgtLrklWiIJcNIAHBNEPpIol2CE37JfonG8nXK/ekTq5Lq9",
-	"Hooat9pxVjFMipBNO+12TliSsFye2upVdHf9hNB8V615QiMsySmdZjSbnuhXYMActKlqSQZlX5FaH4wM",
-	"3xkVbYu36O7OStL0N5M0NeKQfTYKZ/Zzu2508/uSXzWOExZmtVYvS7Yaqz6YkKt1Br3IWDPtWAm//qrC",
-	"r/YDXDcb4ng+B30oy7MYYa2l0cqsGO2engxRymKSaPuWy3xMeEYkEYgyACae0zXv7hBrV5trrVOoHx/y",
-	"cU613uOURCyLgxb8xnMWxVZFwiYKFWlM5cJpmLyJqGG0Ul7zTU+3BnU2ajgAk4c2b8L+b7OK27PqGGGp",
-	"kYs4W+zCvNrCGC5aBec5m+cJ9nyzdo4PkIATo2AP9eHdrU5kmubA6gecnDUiBTmEM+BKBXn+bESyiMUk",
-	"Rsf7h8XfP+ye/vfmhprOGjq0XNmMgIH3muMbKEmAO8M+PrQxH5oqlLZkvJAkdHCAHeENr60s1khmXlkW",
-	"J3Qb7TcHpOq3HCdgj+4Cu3Q8qHIaIH3nB3sPsGveJASehuQJ5/DdGdlrFTPcEJdkgXQrDxrm8UGFyMt8",
-	"3XKiBuu00G7P+ACAqRBGi9slVFmOEDYYLhfohedzzq5wsh6TjOJkfYJpknMtucvdUYZVer6aogHuiE6K",
-	"6DAhc8CiakOsK91lnVMfFoBDTL2uHMx7nTVFbKnzp666jNoybW1c+GLa4EPoh4xdZyjyKnKCdgB0JB6i",
-	"PZJR9a+C0CtMTdinfnyL7bPTGNRbQhAHZiS6PCFzJqhkfHEUUZDYeC+oJSRXlp5JhiLVL+wrcgYNR7sH",
-	"RtKiaBA8samVZbWIsVqkS7D30KM6iOvtYqYuKRPaZemYZsY9pdzBjCmybpmwAl6OdA8Nn8Z4qrF8kieJ",
-	"mZuzc3fz+C3HC+Cy7lPq1Sgi6rfvJ0SApcqSO64ambBIvjTSIMBjiaf6WMP6GTcgsbtPEyoXTwIPBocd",
-	"zXbc0m2+Ym7wtI5V/haGLbkJ54zvsjgkID07O7b0TF3+iBOZ86yg1qXlgieJP7pAALA1tDMGl27namJJ",
-	"pXFWwxlSI5noHzAfgyYZkdeMX4KjOculL3r1+TPV4pAIdcnVFwFeAijVxTYKoXqRXM8WQQDClNwyui8b",
-	"b6P6odkZnn4S4qIX4tBN9JKQf8GkhfsVH4S+gKS5DVAK+G53wJrPHXw7sW/WvqoPfR/C83b5eBg36+72",
-	"twn+UYrn0qmRrUeUWqJJg8vgEs6KTSEdOj0Ps4Rmza0/3IQBbJmU3nB1TRw054HQNT370IYa/QwWa0Fb",
-	"XC8F86qjE2m1EcsIwor4SMvsRjnnII2B2C42fqBi6U/c884HSjj+j/pacIxISJ6DiAVNWJKwa0W6fyie",
-	"lKp3X+6CzgUxUVMUuEGPGsc+mQQtKMnyNKDgwUKecZwJDTzaRBVVvSL6STFX6dqSWBM0BSRzg6qZZEzd",
-	"2yXGO8aSjFRfYRFRw60GukvkdJf2eqP6eaJgZLcKj1kuzYzd9MI2vGN4ecVtUWLU6tesjGlt6moW3uAF",
-	"NK6x0JF7wPMpn+thfYHL82fBC50TLIKRK9HjMadk8gTpGoVMx475SPRaaU/5tO21QR5tehmG0MYtotjD",
-	"VvrQ7ShbWucQEItN0BnPyRC9Am4BGX9HX5+vygfDAVTwPDr7OXBWZmf6qny1XVc+u5H8VTYEaTNmCQXm",
-	"UF9MW47KBg/HwXBwdnz4jnAQ4Gj/Tlugn5SwZvWerFct2LXKD0ukjjEXOrjFIovgj3c4oepfo6Q7ULR/",
-	"yolQm38+j7EJUqFuAlv1ME8knSfk6DojXMC8rmhE9kjE0pQKQVnWP2zGfsZZkqQkk4YF9NZbKysvt1HC",
-	"4XXRWMfBsrGGA3JjjfJ0CuYuCHoF8caC2v74hW6vXiWESLsL8CO0a3o3vL3TH/wd1F/67qNG8wmdVo1K",
-	"+7Emr6kMNO+0R3T3oA6MfAuG5hajfiflPNTMwKAeWulPzlOCm8fdedDAu6pHlAH9bnBmOC4wSzjSOOOh",
-	"YFF+gMdbhaaAuJEB+S734yUtGd1IhF8kYcYzdDHW8KisdKmAoBwt0oGxFM9CR3RIQc1YD/P9xcG2DrR5",
-	"bmscskxRWUuEiuNXXnSqq3UHTy20tgyZRt2SEb/3YIyZ9mDM9ZVoEsNZtv9xrgh5OJAhZxkiroJ1u1Vo",
-	"ofqO8wT00TQlYu0iU4s0NahA//kHMv//zzYaoUOa5ZKIbfSff/wHpUbXtTH66ps1NELfsZzXiraeqqI9",
-	"vFBAO2SZnJVrbI6ebqoawaLNLa/xT4RcVnt/vnaRnWq3LxIjtZFYMjWJkaq47dRxOFsYHbwx6FPd0AzN",
-	"1JRdf+SKgPAl50/UuP8Z/WcbneCsMAP8z8boxX8AcJtbaOdQ7f0LtHOoaw//s43ACsFW3hxubpnaQoJE",
-	"f3NLzlAKMNRt1v+zjU4lmRfTWrdt9GSqLU61NXR5LS8KkCgK+sJrcpHt6whxCnJoY/RiuPl8tPXUbGmQ",
-	"pu5CnAN9qx9kE9am6K0+R0APrq3VYqQDJti4tWYDGiIWl1V3Xic008gISi94uZXjttTO/B6ZkywmWbTQ",
-	"wYX3iIQg2Y1hqT9JuOSmWQSjBE1oNiV8zmnWoH3OyDXyKhnRGTBcEp1+t/PEvYd0PGVF2M3wTdFPgZT8",
-	"QBbhAR11uyQLGyRjYa1Wis6NEtMMagV6Uyq308WIkzlbTzHNwibCbWGe/fmVwfOhdccVz6sZsRMyKV6Q",
-	"S0iUW/vyLQJLsVj9vbEGgnBOtYOqs4Z/JBD5aPI8lLeootwsMZP9PBkqQ5ndUCVTKhHjoFKwtczuqvZh",
-	"8+1OlPSXbGbgwBHp9AJmCmr4AlWHSMzw1lfPVSOY0ZjFiyH64YUwWXqcaMxY8oTnl2Ahz7UR047sI5Py",
-	"5+sQlq6RtSpK28lfY2HNpJ70lU/V9azVbezG32POxkS/Ij8XyapMI0izQMcUHp2UFExOjTGBzhSCjskD",
-	"UCUz3KciSnr93dt5D1QoTHzEIotmnBWhEgoEF0bTUqU0lJgIc/r6HKIIz2UOwaRrMdVDBOmETEIPAsJH",
-	"unzkiI9/2hTjA2fR2PmrEYYgB3X6TxNHW0+h/6OinfD3iiuo2Zylt8dM17MPn88WgkYAbRdSzsbmLRu7",
-	"NiXO0aakdkZla0hwxQF4TBIC0aptFAkXZH0web4VT8bPJl/FW1E8Hn/z9Ok3T59vjb+abL6YbEVk6/mL",
-	"+Ouvnj/7ZhxHLzY2Np5ONsjGs61vtvDXZPIiegrwWVmt/42s1gsJX38VgGlzC3v0D42nrxZDOBRmcNkE",
-	"DiQdkzhui/kXiNNvGzlfJMakMSMI24pkzX50hS6qIdNLwx2I44bbz3pSTfxgxdczGs3Ahgxaot4RdCEh",
-	"QYCav3Wj2DrIqsGaon8H9FX3FNaZCsRziAFmQjofTNA4wdnlMJhlIc9seGcI9Qx9mg862Gs1FPO9R17u",
-	"e4zC0cxvhs2xdwu9l6ni4sNWoXb7ULwtF2cwVKtCVQ+XhoUC0J2+YWs2idr5L8ciDUkYhK5g0WcGkVMr",
-	"QYkD4V0rsmgj1mg9tr7kQTOC9oYCbqYUjf8+tKvtwW0bdK3NUN3Fcwy2dJaE9udv/KZgVMQ9g2TDq7nk",
-	"IhUPfHFoLOda7yqo5dbVtAJg6JpQYdezrSgUwmZ2mh8NOJ+bJ2pjLsgT+4Y12R8b++2yty6P86FtkYIl",
-	"wdR4XnGV+Y/M54hlGYmMitiha8jxE0S/B3thomyK0cGeb0FQGSGM2rrlocekVE6sQzs3ikshZS4rSHKs",
-	"DfO/LeW3i3AGfJnQdtTgkIoT+rt+0btEjoSrZ20ydHPWLrGq2RARGTVtVzkpTulwVVY19ADYvJW+CjSU",
-	"+cMmzgIppn2EobisOPXT1FbsnDCf6nTH3QyaP5UzaBc2fNJd9luS10/9dnJuFvqw6ORW1aWlRM5YXD5S",
-	"vgTiPCOguwdbhUgyvjghgvRN69c2Y6/ntmrlUR0UDhRnw6lcgOlqE0Fqrlt7updIFrUtjJXknHB1IrTv",
-	"2C1vsVHwFivk59Ux9YzucHk1L/52t1djTx0GQUsAs8A6GxT9PBNWl+SbyzhrjWXwMLSAYqS2Ov4cmuu5",
-	"2TVXKeZdB2ujeZVhr5pQtHZ2q4JJGAVEc3Jxe6QBR99lmbQCvYFBKybdwZ6p2g5W9fuRporBTed27ZXO",
-	"r6BlwXr3s2O81akyGXb0FtkXg5ynd4HzrQ9mfTK9j2bjBeDZRTn8Dh/PWx3FyrFoWFLTyeo4w/XjWxy7",
-	"N1jIU0KypkvDllcvCkA1oQqkj4W48fwljQPVNSKG/9BGqSRz+SQJt33fQuXhJtCMQS7563eMXVrEsRjw",
-	"kkwY983QdiaScO+3rnBCxoz5NYoPy2BGaSq1oQN1qrNp7MafYFM/3pzrwLnVs8dlE72HJ29V2V5JVXoP",
-	"3EJlrbdjFEKdNBEiP/V7CGJ1jkDbkhpqUDZwLH9ZkiRVZl0lKpXi0iwC5aGpdVQrk6dg2I+irBzjQ39/",
-	"uLC13ng9tUKgQFkF6/izBesYDozwrt8OWt7i/qJ8hAyYP5d5UPNMgup2sO+i2RTst1sOC6gHbezEGZba",
-	"MKzCbvWNZ9CmDa9MqC+4T4hgyVULuG2sTajeYHoCa3TBX7FATFVGjzPwr56gjOkvIN9XHzE4DZeSH3/i",
-	"dPltaw9u8JyTK8pycbjMRps9tm2ThbEDjG+54drIIcmbXVO+M8nzIpZNEhppMxluFuYDQBsqwmogXZr9",
-	"C9a1R3Rq0Q9LG2B4c2tGuSMRDtvjl1rHZyOy0pIwdHTqBKCNUpewHftZqZPCSxgxjs5P3qz1c09tX9Rt",
-	"WMKj095LeFcWedtlNIf33KPTxoA5MZRV+zLmONoEbBtvrK2tPekLmvKgLYCCwzajc215+Vkoe3UOwSOf",
-	"kesWKpeRa0PXNL1z1M1koOxH3CxpaBnIVgmPlrGM9Bmq+eA275RzV1oKsZ2fQJcwymT/7uY0yvOwgpWY",
-	"isu7tC9SgN+uh6ox4TwfuE7N7PqCth3HRcmfQQO7jNRFfsqfMDdPjF1OJY1wEkiPucxLqDxRP/tmvbQY",
-	"PFTqTShUbCcZKvN9M105JJntF5UCZwvjTVKWhfgxZD/cDMvFEBHMK/7QEt2Cw3Rc0FyXOtGEaDadIJzF",
-	"64ybWGP26xrakSghWFHjjBSV01zA88IY7cUVk7Xy7LcHJLuinEF87G/nnMU5KAWHkhL+7YSD0Ww8qJmQ",
-	"lRcZ0ufb6ehVQtL7UpxbL1CwgYIWVFGzTu3h7pl9GOcVLHyv+DJIRBFk2rluK7z8Vg+2OTQSjvkMC/Jf",
-	"3x6TLKZZY3alCqTud43a8q/XGsvI4K3xkiw2tWZ1c3hJFlv/pX9sNdrANhMVOBRizjJBlg8LBM30UxiW",
-	"qb3y3eveQz4Nhdg8BAfbT2/qmvxyjWY7JgdcxSpfE06QieU8ycEQSHcUMmSqKfVLQzYT3zbus8J7Noty",
-	"fXuWfqm4b5HapzH0R+1hELkEwOGJVNwPxDJhy+r+tqHhRXfiABxJelXYLhil/bKiI2uSEQxXWZa0La2M",
-	"h7ASPedhnjFV18gKdVFTK13gxsmwnBytPwwqbobBvJ9gsxcvSQDOnLVfbJUMouI9WfHFVE/GYx31R7SF",
-	"LNcyDhMfqLzSGik22UTMPPKMamnJUIdIYbzIFwp5+IZIu/PMSJKMhFwkOnWoHQzmD6PjKaaZkDbkS7JA",
-	"CcMx0UOIWmCl5+V4Rhujb/Do953Rv7cvLka/rF3A/36+uPjwXxcXo4uLf1xc/OvDPx//7371nvzr8cXF",
-	"2s+6Yqj4f5rzmLTZyms5ZJFxvxuDz70WLgNbE9G8naNE0dRXnoUVGcJLsm/ILjJtUwxqYppoa9FI5jgp",
-	"wvbclUpbmzmPWPts9hK0qW4rHTifuG5JuHTvFUtMRYIrBoU9CKnfon/oTLePOkMPWB9bu061F8HISjgk",
-	"7rpluEz/tut1XRRminBH+F4py/mw+NYPRq98KxsBa9ZwP7pg9Pjt0dn+ttZkOHdeExmwGgJx5/igr7+c",
-	"sar+VbBsRKcZ48SZUTu93K1UiUvesq5N7xAEQfnFsgqO2gnTt5L1ue7RQVG/fCuHqVDp0lua/ujB4vOM",
-	"ymbKY1RVy9wOcYMlikcsSpApk7dBmNr5W+mfJXeyAT+K+RY756NeC4d/azN177TNMI+vIY9dZmMXqBeR",
-	"8YhwC/g05utmDjYU230YsAdAczudfr2LDtOiuiXREcTyAXHPlGPtiWAlQL5txjFTL8L4aDIpmRrtXGMq",
-	"IWSTsX/W8bxA5XGMc7Gkur+0IG9qtTJvtoHSsgirVFS3NykVl5YZKK8aIJQKQ8AIVKvCp9jOElnrF0ri",
-	"aG6NxvVp8HICkI9zJor7RjsiXGT7OJqBY3DEOAdZQ6xDDBYPIX0sjFesY2cWaxdZd1AKvYjSqYpYkoDG",
-	"ttDuN7KJapKNTgfqPt4BByXjdRA8hL7CvqEPr0aD30awZ4U6IdeAl4xJdLC3TFc65kefK6wWZuQG8rJq",
-	"IqihHV7lkaOUp5ZS9pxe1Y7AB6iDQn0Ww/L2NdOt2nOnw05+DjVBsZTiDE8LeZix+RBDRLMoyWMdnplk",
-	"9jsSM5YnMRoTFLPrzDw11T1i4s4HTHNNvVMd8qeTsdKLcbXd5X7b9jcdYItvpd7Uc7pXczf/erQu6/d3",
-	"PZYWe7vrsd7FEgZvBcCctdv8jO1hSHZwlMujifnbs3K8jV6nNElviECpP2qwccXcslxaU928y5OMcEPb",
-	"d6+Ip/+tAskE0o1LYXznEH0IgLX7bh9d+d0hchUOlhZdkYMA6606MIEqqIvJsvtuf7S1sfVstLn19NmT",
-	"NXR4cHayb4RLquz9+/fvRzZVn9d8iKzRTWG9CJllEkm4zuvjrNA9YdPzZyVZkxrh543RNx/+eHZj/xiG",
-	"c09+QgV5eZPe7TfEReJCHrRZGuhUosbWwIWTUFBXT1lor2anb2nwAKHCAu/xjArJOI1wso7zmJocPUPk",
-	"myg0GCj4cztRR77DC8fZPxRx2e9ntssGMdF42kxbfHlRx5vG6lWsFx/YDThpACxvQgy+uoBIYP7TKkbr",
-	"kgP+0Sdgso2Osf3HTT2V7pgTfKmuw9aVjBfowp/XxaBu91xAT1QfhH+CyZs5tU9cMomThuOtirywA6GR",
-	"egawNqzDnwk65unfBp2qtySAahhA1ur+VxYcPG5UXHbGplw6HOTwTxbPMsj9Ggk4sL26A7jSqLjUebkC",
-	"oUixnDWZmXHQdi90Puli8vY28vpsXwuMEYjFqveK5zDqyzw2PrgVPUSlRjljL6RxYROEk4RdK27D1dZk",
-	"kut4zIgCns5NUOY6GKac5fOXi2YJn7YAuCQLePka30cEzRSInWljMf4YplsSAnq8wuOfd0b/xqPfFZfw",
-	"88j9/cv62od/PPmXV9hDowQ8yXnm0pU3pI3W+Zs9qmP3yEt0bg91nAPmGPCZjHWN6Z+hdKdj+ErW6gnK",
-	"s/q4bh+XGj/4AGLRJeE7uUbvZRRf0NAwjTiXM8UkegfLy3FDg74auZz1iahzFNEdW/VG8ZFCXDMeN1gS",
-	"mlLIHcQuiZ6Ky2pTnmbp5nD9BjP8NeXUK8WT6RiqQxRg1+gN5602SMDztpQQFpFc5k2LMy6Gjo7aIBlS",
-	"UE+IJGsICJptULzwbQ5DMHXHCOLF0yvjUEm4SQOi5R9Y63TyjMo1VETGdR8h39w2+o/QQWaFzh06RP9J",
-	"9QcdN1Z9mOkPECEX8McjC//a/nlz9M2Hi4v4H0/+dXER/yzSWZgG7GcRi2k27RM2gJi6+k6CqA9AxLHE",
-	"hU7Qbah9T8wTTLPBcKAzdPbOH6CHOjaN7e+XppMbP43ArlMGVlKwuhojoyjrOk1Fn6emQRURA32GkK+W",
-	"4yCQ5qtapSWfucncqLBRT6CkTr1dHLv6FMs+QyZq3ebT+PnTrfjF86dfP40wJjF+/izGzza+2pp889XX",
-	"E4y/frY1ib7e+GpjY+v5189ejKOvv9l4/lX04sXmN/HmeMMPdhYJPtgejNT/Xu6/PniLdvdPzg5eHezu",
-	"nO2jk/0fz/dPz6D0Ijs8OHj58tfdl/zHg5c7ey/fHJ5fXp9cv9979+OPe/sbOx8Pt37cOvz9+8ujvfe/",
-	"v/397a/vf3qV/Pv1/tbb1yezt3s7mxfZYfr+q7dncfr+p/2nb/e+T9//Hl2/Pdu5Pvz1/dO3ezP6/vfo",
-	"q8O995vvf58+OzxLLg9/Org+fHV5vX/9/rsf2L8PLrLff93Y3fnx/YH69fuvG3s7P0Z7P0539r97ebj7",
-	"dOPtyfdn3z99+9NRQug373+6fHm4fvg7e7v3enF48kP++/7G+kUW/XC5+D/vvicfv/tt4+NBtrX1fvft",
-	"26f/3nv78eP1T8/fJD9On9JfX2dXp/LHo/HznZ3DHfZ6d/e316eHz755uXO4e5HtbEx3DvfPdw9+3Dvl",
-	"H+nzSx7v/hC92Z3Fhy+fXn998Fu6l/x7drL/evzd4e7+6bvsuRDHOwfTf7/554/8e3l9kb04+Sd/Nqf4",
-	"/dW/LyUXl08Xuwf5709nB18n7H36f46fxi++vcgA7Ptv91q2ZBWA8O8WgLBGIpaLRVhvfr9p8huSzISe",
-	"y41Vi0xhYWGzI72eCQsqLoHmaEDYZqtpych77UU6tPR+hgUaE5Ih20E4sGERcLTpqd6hL3sDHagbBdIu",
-	"l4KvoAPF1s8THBFTzabGRI/N8/7J0Fg+Q2zDlPCpTZQIuhgbaTa2tbxjV4NdcDhwYvHHAH4D2/BamiVD",
-	"E6oDV0kE9ikgygqN35BQ3BtT75MRXYSjrqnjy5Ji2+oAABYXOnWPD4tAsMpPC8NlQQbqqOBIqrE2+Ami",
-	"X+38GlRf6pB6wqZe8pTm014XZHQM2nXoPTvEux7/puDnwPBjaeKD+gTgGovS2e8Xr8a2eLnojkRv6vaQ",
-	"H3m9Dv0l9cjF2LUFtzAGDQC+OF5BXAsHTghWK8dQqFV5sGgKwZF7GYDVGfpViIU/W4iF+4qUEObMujEd",
-	"vFuodmtxFfUZq9V9JKzDNBjxBnSqosFj9Xj/cATCAhKj4x92T/97c8NP8A85/yuhFAPcStnovH/Q6+EA",
-	"NM4nXaFEz/yUGOFwooCyJlri2q+CZeixDSzc4mp2F7ZsR7NjE3sTuyyl1tT3mqrX/3yeLHRCtkIDCaJq",
-	"dYY8MklFiI8s8OhW4WBLVqCC90TQBuuRhorL3Q+9yHXxNrgVm+EZ2heo3I3/JgiG1yZsl9Vmel/PUkzu",
-	"cE+0GNY3m/i27/FpIV5r2l1TpY31mrFrI29VZBsoheaG0SuQZCHDgfsI7kVDqwvQCwnz0oI/EPnfDH15",
-	"X05H9uYKb/v5yRu7O+cHxcnVoc1zob2pdM4N9f3HE6RQROffoJlJvw7jFTlTGg35bivRbBJsVuBVDNAI",
-	"g14oYVUnHWihqhWo4fEFFdWJjzQ6kdMtUEN3PfKO5CgcG3kXKnqJYvewxMU0/WMOQnC4LrCduuofTWii",
-	"VR9nb07DB19P5pIsWifxA1ksNfglWXSNXT3sDVCpT7HXxvcnCT0ogw1yrY4Fu+Wme+tSSMU4lY0gL+ru",
-	"2KrN0Pc5G9ez/1U0HuBQyA/NPYMIRBGPOOZEOKvKzoWjx5YRnjEh1atve8647GGG1AIgN9ngziuOObDN",
-	"V/qZ5qk0jIkRmOhp8sgi8BNz+Ty0MXmAmIc996sPW0goAVozDQtNgjmdToHHg7eHGlxr8vQbB/gpiLJA",
-	"JvSjVtIRCvId1d02egxaNjBMVR/EE28EU4pzyVL1PnF+s2Hu8LZPxriwkGyl9Wpt1poSXNSuIOyTFvz2",
-	"Ew+7dL+rx+K9PxZ1Vv2A4d6sbIxYeZpVI5IrOGoL+AZz59spBEz6/5Bd4YxxOUQpjmY0I8U8zfbDKStH",
-	"69J9OV26PnSeTtjaRu1yYty7Sl8oy1yQX1tw7jzByl9qFW3sssoXv8+623/D50qL3ePzWhCb3ePzatib",
-	"3ePzt+oCKyodQlSgWlv9udpcf630sEfFZa29+lhtrb5V2vrJxkseSl5BzbHJK6sG/dmjwlzIfhjmgItT",
-	"xeOo+tnF2/MKKr3u6mSPNft0871ume4aBG3SK/tZNXKuAbhaoTbjaoXqbhydggmyjTLWKA5vK8NJZdoN",
-	"YSnbAzoO/OAn73BCy18Osivz7cA4YJ1hcekG9j8eE57iDGIoeIcPrF4YX+xA6BY6Tkjp80GGywXmmomL",
-	"KsUJByNkO0f4UUwPfp5oi66CfPhfTyXm9a9uqqUOjFKk+v0lltFsj4q5jtZYKTVQI4mFe62p369zQoYU",
-	"iGlKpbdjfmEFckVBDXZF0THmotaR+kizaY0yggP7QgE98NHDsYbMyC2JUAdD4393QoRkHD54BMR5f7dF",
-	"29PT6cXjnOqqTnzRZjfrMX1HGXzR1HGIzFn17yVHOO05/tCtIWmX4JZZMHfLFuyAGcCtf2iY3UZWu9Fd",
-	"BkpHxhgtsi4zQ3VNWzcaF5fM8OCLObyUSk4hOrLLfG7C57RtY3fUomoTO/kWNOuMwxBOG96Gnb1COwTy",
-	"+nYQ3FZpdHsU4w5avUTP1YC9TVE2O4IsNMTkbLrn2ntr8vBqpZQNPTa3aOnVI919uy2ahPtdaqIdc6xc",
-	"ID06LLcI99qO7PWa4V7s7dmjG1O16CfAOjR0U68Z7qXOa/TosNao6LuN72j042hs4vdbuuTbMSVYud5X",
-	"57xK1bx3v40Ao/PS+/5lN8MBy8gSziu1zntFbGkgJv1atxPO2/RRJZFdfTQj5zItG7Gwq5NW9Ohu3Imt",
-	"XV20HPFlmi636FbquUzjBmK+dBd3mkSYXPfD3abLs7t1O4PUv30DN9TVQQ+27+bDUlGogaNtsOGxRRW7",
-	"nQb3709lrOOG62ehA6LylVXOX9Yqx3ucBh+lbhZaaEohNcOcZfC0r4tLKxos27hbEbLkOB2KITduaM2v",
-	"aGKFbk1rhkJtqDGhodxXUVt78B9CknyU6PH52avRC1DAaG+iQgdXDAJuxGaYkJmFqmfdibq15553VNBB",
-	"UC2/ObmuKnXpdBv8RRtyqNCEPBLaNXToeZgZ1RQ4mtn0FVmeEk4jdLC3hva0aTGYGlwMOGPyYtCaRb0j",
-	"XXpqzIMaZzgn3AjLkaq7ht6zHGiMnrOO+JMqijDBKU0o5ohFEifWtCMhGHzKfiec2YjYG8+fPYNdxtpS",
-	"LaKpaaAz84baPNvaeKKInMxpvC6InKp/JI0uF2hs3OqQS/0H5tKKiDnA6oT1lcXASVHrFCj24KqmF86r",
-	"n4vGnDgaWpDC4ZPu522y4jch9pFVM/kZACMndDWJLrwwff2c+0pdezJc//OJ67v02b6JPpgZLueS79Oq",
-	"TnbOP9idrM8YMt+QYwxWQ3/UHdcd6WlwYQfucUkf41cmooevYid+vPr744NWDMoX4bAFGLGck5Zucr+O",
-	"WdBnmG93RWW+XWfTejC+vRiuF9+uD+eKb/+r8u3dj/+ab/kY0qUFr3ooAm6lHHmpiELxMIG8WmTtwWBe",
-	"Rr4afFu4cBu6VjVsDyy5Z6ghk9zjmPCIZLIxV5upplgyU88y97cYbJInXQsrat5lcZKk8wRL0up04L/U",
-	"zsoNrNUwFQaN1NPNGASD4TsL4o+kKYmPctm1SKgHHd1ljbeOSNV/lLYQaVUYD81hDKHW0AWF8jDB4boH",
-	"uF5koS5W/EvQBU9bFiIMnwWnb4MAXXvYTdU/ObzbSfA9QrqEWwri1v8XYrbcEeBdgA6Lvx8e2hU9a/DW",
-	"U9XfNkYv8oGtQercOozXlcJqAmHhiQ02HYTv/e1uy9CQnyyLybIbXEBh+c0uKwkefpONXvhBz5Phgj79",
-	"Saro3x4eutYcIARebqtwLMk04Hhu+lDPIKjhDI4KeysItP/yk98+5SvnzvdNdeU9tjHo/Fivs5zfY42D",
-	"qMjVtePgyy6exDBsRWosTVZUv5pdbEi+F16zk8ktKclq8U2GdXb6Ixs49EuAdVKqDP44RYLI1udnKZuk",
-	"h6G3SLdmm1aSWtdDuFaChn4yAZKXH7EW9jws7anGYLXAaDwSt8oj5rW8xQnpnUUMag8RAfk8TpIFosUj",
-	"xjtxM3xFQH8ADmH66oWgghmekpI7Fs0QRtezJrXXcj6/Dh3unkArrkWT7kYLV7ug/b0kZ2UiuKST8Wsq",
-	"AxkoaxfhlMpgnG/t5G9jeoPz4Gsqy7kXkfZuWyasrQ1ma1Pt06k9soXZTZAJ5K64+yYrunJSwjBjCVTx",
-	"hFzRtkAHulRNOrdJXjvnW0uw6iZfG3XYFKB3OMh6sdeVBKXdszEqLrPzDbjzXT4+yCRn6kRDQOhgnIyG",
-	"ikWUYAiWSv1ylAt1R+qWaOf4AD0+Pjo9Q+t+uqv1P7RA9hca36xDJ0+8TMFHO7mcbfl4beS3BzpViP5x",
-	"SiJOdBzIl1jQCIGvtCo/P3kDiFhH3Gaz+PIaqvzYlMpZPg7yYTlPSiGyBlZEjOd0Tbdbi1g6CF1zHpDG",
-	"WICjfFmzGe4L1qzbqp9DNM4linCGxgTpPDb0dxOD1Iywn0nC55wKYsTm3Vgkm4yPXiu8mrNbcDOKwBRH",
-	"xSp7TahbG/RVoIyBuzB6PM/HCY10kydD9N3Z2fG6+s8plEOG09PT7+AHJPpnUidYLhah4LdrE6cJMTN/",
-	"f6gFbPQqdlDu74qaN36fXVnoXMVW7wwPPPBEKT1KKhjZU6vs7Zfi21+rhj7eBpDSn4Y6TJKhKGGZpo6l",
-	"yKoDTyFisHPdFK6rThTW6vDSNqvHZhfiqYkNm9HvO5KknkVdfyV3IGf0SyxIIPY8ZLwIvNr86xIo8wxz",
-	"aVhUKtCMJKmfKjV4J8G2zHGTIZRh5F2tIuyyl4I1JvOELVLrO+r2Il2M8Hw+KoYIjA8qtxYuE2Ll1QP8",
-	"eUyB7iE0Me8MYz6mkmNOkwXKiAAXcOtuU82Z7sDt8wCDbEqzj3CdTgfbg821rU3tug0h5rXdBR6DYkZP",
-	"ecaEFIAE6q/Bth3BEF91H+hizbwM1s1HLSMYHIOb++DmA1gdcAKL2mV5JgfbT0tRRdQCB9svNhxwd5Nc",
-	"SMIPjsNvPw2vVzSYBNwqXi1QwaZGcWMQyMiESvT2G0E/YLTDSYIhnDYszc+hBcy1Tq/NY8LRmEyYjnrI",
-	"i4iGesTSVvxs5qoqxTnchGsLnKrjaArYFeGcxkSsLdJEHU/HcHenZfbPuN7yYLS8+oFn7HInqp/1ypmd",
-	"tKbmBWFHmgtQ86ZEBsKZjwkiH0mUSx0SqtdTQs2t9TkhaUpYk/7pTx1rHT0Sj8qh1h+lj8qh1hXKPZo9",
-	"unu49ZtQCo5+DhkFdpzkmT2+5Y+B+OdX7zC/SzSy/eyKcpbBi/YKcwpu/5dkMdIWDXNMOaTA+1WLn22+",
-	"hTxTMA7HXM2zRtPYVAG6jKF+fj2cLRDm0zyFp79mv4XEWYx5rBPAI7HIJP6okIcKnX/J2vwJlBqfEDuS",
-	"QHM6B5n5FMK5DhVGUXiLLNA14cUkUJ4p8oIV8zpDo0hbm34Mq+yuGb/cow1WgKpQ51KxWVH0ciFQq041",
-	"kmeZtQ8xE+3xLsvDkuTysd1eBtdcs2MsZ0fzTgu4Upv9j3N1ewGt6JyXV7keeyJDxBV7xI0o/IPEfwyi",
-	"3qqtc3KEMM0zyVZIHNy10JJr54k12Oq6aByPxROkxtd3lwQ7ZZKwa21bCiIDtQSBJRXGMha+uqn3t0gq",
-	"WWcGCHKz6AIbW0Unw9BW2QhUAw4tHahB1BVpN447gjmU0GeooBrEkdJLZYnXV5mLU5NUbymdIlJRgoAc",
-	"Dq9FPHB36WQTyNqYc8Yk2t0J4k/PvCsmWJC2AgjMq1e+FXFJ5/p1+45w97AMBJK6pHPEScokMRIudOU1",
-	"CMcVl4noBYyzN6c6wJm1bO81ddX7JVn07/2SLPp3zi6bkukXyW7uDP0lst20jdXNGXgnoF30qZ6mPWWf",
-	"mZ5JP+mnogrHQTKivlp5pxYkP9I8vc0GLZkX2Nr6ZrjUgyYNMExFEIWXBX93zamUJLuz7JTXZadW9GkC",
-	"ootFFqEWqarIJ+qlFFg8d34mIDRQpDJiqSL5E2mi+RdirgMtstJsDEG/5QRyoXGcEkm4QCKPZgiLbXQx",
-	"WFcUcV2ydWvS+S+o/S3UDpn9t8pn3fY9vEjWYmQTXb+lXA0Qxpm7ilqyOpfNsoTfdcS+rRDsHsRZauie",
-	"8iwfUOrx/h00bZNoAXysHAsnSViC5ckL1iMrM2wVXMGz2OSuPW04FWpYfWI0M8uyRCfOtk0VA68tOo1U",
-	"qYAZiMS5QCnENlRH1J4tzcLDSw9uX7M4yzGPFxZF9TkWSGGTehbATIgwLwGI8TcjyVxTY6mD/5kVWWRX",
-	"8HHY1Y3qHeI7CPsUEMXVHVZuJ5M72j0wGWvVRcMlneBIBqVocxxd9kqwuIywApZ3yPJMvmNJnpLq8sqz",
-	"13W0zqmYeKqaK6bSc8Nq0Gc4qLQ636tKeqgiQFCqRVvtLXUjWE4DVGxHjbA4zpOkMDwotCQHk7dMHmt9",
-	"dU03cmTcscrKkEd+m0dr6Cf1LhREQtlOco0X4pF2V9NwpALNc7DUUHfpAuQblVZvVUmpEfD2OOEExwtE",
-	"PoJ4LqvEHLZES485GFYXA732pGYKPq4f9aPSl/pk+rMgDWNWQPthtubmvrCm57koTbPJ63evFBfRMCJs",
-	"olixo92DEci7KM5k/TAH1NElHOtclIeSsCIb2LOduHRPTNs02FSOhsSmeKHIsguRS7jXMGM6zZExRlMk",
-	"wHYGUpeEqdtBIKOYZzwVdTpXVqP14IXseoM7lyU0uxV9hoahKJnWlcmnvYb17f2s9yZU+Cl2iJj1hHqS",
-	"bajc51HRvU4nw9cOoXXy0VuQYR3WqjKMT8ukNgIuFMfoYQ0wA3GUQip5wjnjh01hZdXoUAOZUHM2RqsV",
-	"L04wTXIefvwwTqc0w4kL7twrrAUnki927Y1bns7bkhOKJocSi8si4ZlqTUuCo17uICUoVGfetbuNQXoe",
-	"fqProa4+wZ7P7SB/lt2/xsJuvNXfaePTFPNLLXGcF4Axhtd3RBFvon3w5ftr2cOEKFSrh/3Q9z+d+W8R",
-	"eJ98/9MPp6GEFjEN39/7H+da/2KroCjBNLXKViOo+f6ns1DYg7yHNVKJmnemEadC5KEwCG6auoI/yTvM",
-	"UXcWRONfry/FedNjWQEZPf7+9Ogt+omM0Q9kgU6JfFLIF+D96UsVjJmOzd5udg0mDVlesFP6N4BoeXus",
-	"X69ldwhSEz/UrjaEwj+8EO0vtEoFL5w3Rj/kY8IzIolYP5qT7HRGJ9Jdt12yFjynjVtADfXzRgAbsfOT",
-	"N2FvOCrmCV6EvXW+q8RQ13WRE8YC9WvmEYaFnYX3fAtZifzkknZSgX54IQpQUIFMJ2HZOuNTnNHfAVI7",
-	"QqFM2oO+KpQ/Cres9KkAY+w7auCxT02T58CBxG8PwDKadw0BVaxBqNb2Ud1fmiTrTv6JHpmKj7T2UpCw",
-	"UtSCqPv6rOR78XfMHorLFyLsjjLG0VsR7v7k5c5uxdqoiPUSPrOcJWS5XToptzB9NEnM3I4YsZlkEC5g",
-	"rsUkxthGdannrQGcQYBi+rtxzzBlIEDT2iXQco84SQgWxLOogfac+P0KY8ZuoVKEDtYDmsA6Lov4CMcp",
-	"zUYX+cbG08i1gp+kR36REg4MLWEIUitHDrTta/tL5b5eCcOBgNH6mpF7REs3/ELjO+WZvKWWp5S+VMPA",
-	"0+QY8V6jdWD3nhVgXda8sDjp3V19uTGbAo9a3yay2NpOrx3TujgAoWMJjk/hqC6FVCCmQtIskiZ/4dCQ",
-	"HYKjGVIsJGSSBj2r1FfJxeCSLL4FLvBisHaRlQ31SGGA9G1hrQc8/JSy7NtcjAgWcrQJvvyEfzvG0SXJ",
-	"4mVs9szKTj3/r9rqVAVkPcRM6Br4pvV57Arsg0z0JatwFPou5UTAVTpBKZbRzKS4xlmsfxf2L9oebeft",
-	"HonX0H46l4v1LE+SyuhCN1M88syEr694h1V67bq6Dqv1FVkoZnqnZJYpnquF/3FJFkPY4xttNBawDQup",
-	"wF2ol6BBqSrxOFXrFWeMbBaZnBEJdvVWO+QMWnyzMoW5ejuuMKcsF85/DKYh1tBOoUJO8ULfmqDfYjqh",
-	"wB+Fn90Q2YndhMMc0iwP0KxDLT2FPOZeKitI0o0SmlInnS9iawB6O6W6tlKkLrV8KW8o4SBlgSh8ACF8",
-	"hWmiOFU/ExbkFcK/5cTg5sLp2STTzywnybXZl42Q1gtBhLXrGzjEUaHJAnilqyf+ldbsZeSjtGfFzaQA",
-	"964GE2gM1b0tqAD7AehLTctEMZoznRLDgsystGzcoNZtrZcUe6RAIGc4QxhNyLW18dR7OsdCqKNnDi3s",
-	"uPUi1ppIZ8IAzJh+wcM67dZWkorRWPOyiYVU6bU7oVxIG5mTDFGeJUQItGC5ng8nEaEOlMaGBbL8ZWUp",
-	"T4O1RIppRrPpgSRpg1imGgJnLNTGZtIgl5knAF7f9IpAKfAbcwaTuM1utF2KzkZvW1pksZoBk2UeQWxF",
-	"MNG0lA0UVFU8d+uwkxIozyDDL+CpBqTqxgI9IROJ8sxmuGcplZ5xqiCcKg7aWPL7E/WiZKDH5pIfkwjn",
-	"giAKxeDyOcszMOJkRSmAwGTsS7AwlZ4U6+HEgE5jYHVNeiHOZvVWK7FxwlgSw+sUZ+hqc23zKxQzLU8h",
-	"0htDY7liKDK1jWoRznKmijdqZf8gQtIU9Pj/0KeN/m6cZiOWJFp+sYZ0skph2UA1LidAKZv61up8oAbc",
-	"Gf8a9VefMEG1O6NyndUfDEEDtDPYOYWWl2ThU09z5WuXA9EUgEmbgDblKHQGooXLAxAQuGUrKWgOFHfz",
-	"lkn4d/+jupwGw8EeI+Itk/A7+Pgt/F0C6yo7X0imB15GqlfhFxUIvUV/6N4G0cY0aoamsPTtH5mvutk3",
-	"YMpyoJtu1jk9nVvNRtg/ZBmVrFPnl+pq3cIL39LMNOp+F/u9fwg5CPTJFeCvBFwDettmvMUpidGVttCA",
-	"J0NdpBfQuRuleE3nfmd7i2Y7Cy38LQnZA1KVeqVCCu8sQctS19p62/IhGRfZhpU1eRwPQZTb0CioYBgO",
-	"+CT6+vnzrcat18X1lvUMIHK53B/NHbc3bFp8V7vg+m+aUaAdoQM6Ak+anRkdQn8Btk6Fq2/ZRlG26bRU",
-	"uaRKaMn9fBC39mlCeh7stXSh5WR9umkRhPwJxevVveqSsNMqcWiNjhKgJy3qKw+WRoGlufsJJRw9zq0A",
-	"tlLm8pZrytOQGPhTaAbuVebOVJ2tpihQd5aTi4jN29xGDdx1Nf2ehDfFcopJ2IGuI6wviM6jq97nNJuw",
-	"ru5svX49quO0m2Calo7Jz4M5yJ45iX9x7gcfqgcHVJl+XBLni6AVreYx5yZkH2sgx3SOtBPdhSBTrTUw",
-	"SoCfLwJzuBh8gBLF1Cf2h8jHF4MPT+7AXFYVBVUC7G1keR88glohjI0nrIa+wVvnYG+3486p1KjcOAd7",
-	"u73vm447QXV15xvB6+QLuw9KkOy8DdoouerJ0OrzkzcWz10gkihSfKhYmzI21cbyXyrlpnH0+ei2gvId",
-	"qfYD0cXvfzoztP9PTg8NVn8yYlfEjKuTOVemzl5Z3o6TBM0JB2FtHJa5axGiER0KaGGEvrAnpq42Jw0w",
-	"4lnGJHbh0m6pkigqg8zJuKKZsBdBj/XIZKQ/oykREqcNCl2IKgAWFTqE/zUWZilxSZQVY0lGqnI43rPO",
-	"VL/sWEZeGOvU9/3Hm5LMy/BSy/sPwuDICWNLCQywM8hGRS9WhhgTobDXBGpEx2yeJwoSDt6gQF5DJwTH",
-	"I5Yli56hx5NOjVSKP1pHpudPh13YcKjVUyZNA1h2aUWQFpSBZ5+ON2X1IOZoaR1JhCWZKt6EoMdA5eCr",
-	"lhk+cQqNwa3973R91YG3rK2vQusCJXVoE738Elgidg1BQnRCMPg+VATwAtIlrGsiZvSzDUqFklok6LFv",
-	"lEgGqDr8qn0pCU9T80gUFmBXRs2iPSOKdfdwk9VE6aTZvWGnarnhB9OriIZX+TzuL59HPxx3exO3bntJ",
-	"+qxTe9jrvo4REVXsSgATyuyS4lOPdg+sKwvVxpttwr+YRZfqmDVEyYRSGLoug1Os2nI5eP3uWpa5NJcY",
-	"XrblF80SQxzjUURv6bmrhiscg6zrUN2lp3Ljg7/ooctAZ33q1K1R86Xb0c6lLm2bxi090Da6gEaatiFu",
-	"bx30eJ4nyZOhKf6JU0n8Otfqg64ElH2ei9kTH1hmJq5xEGxjLAg4ZIXDvMK9aDUhkufAP4H/OMjYhaci",
-	"t8rWwvcKvPwMbdHufTASeplTUAMaWjSnalORyPkER5oIC0VpYPcVw6vvLK2Hn8ulVDAv7fL2M6ljw1Y5",
-	"+HuIr8GKI90q0jPVbrSBkIJRw/OvwP8FmjEhFTEZolc/7r2FeIsHxwjHMVcYBSb5zJnPMi7tI+C3HC/W",
-	"KBsW+8FJPMMSvqUL9zVi6fZXGxsbQ7T5zdba5vMXa5trm+bLz9vbmx/g7/D7ElZGApE3awcAPLChNiBw",
-	"xLKMRPpuYqXTUPNHH5oePzx4sJG7O9SziPb0QPWolyKZR6ph3WnQIE2LZ7ezge8QCYWqVeRCzpoehIUr",
-	"lUSgK7BW3lUMCkuOE5yR5vU6aJpWcONwlqC5avcleRUE3CzuJOt6AK3Fsr4HJb+Dx3POfoU3kzFnP8gi",
-	"lirSpS3RMQRjq3sfwDYAMUaPWDQfPUL/RLarJj8EVQiGja9oIkMQO5j4rkfAJphmwoaPoMLYitiHN1ip",
-	"xYRb67GKvWhhFG0tv+CFhR5dksUjdd08cjawj8AkCUZVFS/JAvz7TTTLIQTBM9Oxs8HG2BY95mSKeQxG",
-	"ZNbc40lhi2ZMtozDtsYmYYj1SE0fTQAkCu4TMG6S6hSZiF44a4iTc7/SyjnJhML8RpHl39ad4svTkrXJ",
-	"MYM3q0cT6mZbq9S1f+nUtf7mB/OPtGb37EKnsNtCtUY5J61f+nCpaWuj9nqElY7OKlHtXzVRbe2QtKJ0",
-	"/cXhc111jO7mhJHjhIH1EjN2bTOK8DCsyEct4Q29KPZNGTrYcxLvygT7yH9BQhTmPo5O/Zw8KYvJGrrQ",
-	"tosXg5K3BEFHp6DUguoxuqIYjRmTkWLP+DwdMSE5sdGTNF4K1ZkJr1XqLmO63miMFSNRngX1JDo+62MD",
-	"TZkO+75qYfUHpq3+dWx7uBkOjrGMZif6dEFcF0tNWqV3S8a9VShg2ER/RTiOBzrGvHZI45DTHxKqNVgx",
-	"h6PW7iDQ4R5r/zcXIixsAx2eqiZskiEcgx+ImdRa7WiyeVsmmypZbUtiXJRZzwBDZA3zX6KyXpZjXSu4",
-	"wGPnshwCUuHQrC1eQUtnoqG7rRKIZa0qkKJm8x0V6NVwtxeDKZEXA/WHukb1X1oNqv/WJ0f/DUln9Z9a",
-	"c6n//ocRwYJ+2I3wZDku1i6wSbxk7jI3bZMPS88A8myJ+mycVvtJn/hTZgJDH6QhpCp2NcylOKg7ObDn",
-	"uq7nBgQ4EDCsqNfcrd9ZMYRnK9GbCfHQs9OmwZtZCCY/5jhOiLz3BCg92+2byPlLNDkXoKLuXT9gm98/",
-	"G0BrdMmuSbTHPrv5EFQ2Of1qXCQMO9fc2cOGTGqZSFhmcLugwSBWucbCsaDLpQz1Rg1DU+c0CTvenxQJ",
-	"DnGR/gQ878PhMZuuzXpb64hlDTDeMmksA3Bm4kDCFaXqW8ERuyLcC8tcRJQVPFqnWUw+rv0q+vFqvgA+",
-	"uG5Xau9MiyOViLGVDFFDq8jorw6o5ooqT+473V1dYaC/NSFUKWOft4mVXFMQExGVwhlXcgX5j8uBExmp",
-	"183V5phIvGkfDv6Yg/LTROvfba8jNb7/GPe1q54G09ecDYyGy7NgiSj04XJ4+jksfwZ9ykpq8zeS2hTI",
-	"Z12PCtTo2S6cFbTjidyQjNY/nWFmqlxeFvi4MmMS8SDyHl4ZtBen5Z35lbDnryrsqZytFlSuhWwrx0Ao",
-	"35sdzo0tzn3O+Mdcty1B872q6spoNtdwFe/qtejPrzNZkT/DrsqlSXbsU0Oa92qN5VJYl7fvjimky53d",
-	"NY/0cqmcrbPyTkK4PMk1p1N9MngrqDO0s4o6vpwnHiLfq77Dev68ydJ5zx4Uy3PSVHO9XngrfEU4SP6E",
-	"EeiwsYlzYqKWwsA0m66hV7Cf2+256bqzzrVlnLu4iP/ZlGRuOJi3SKXOdBBYK2xiE7MiHfGA0+lUUfUQ",
-	"JLURuI6TdkU4ld0p9v39PjWNtA1kNbe87dHbptI6yuYSnchVGqxuq2RKazhjnxQ/YZ7ph8MupxC/ZTAc",
-	"HGQT1vtt0TCXouPGKt6IjXX0VLxF/xC88U/cJa7uOGdBcUUxLHvn+MBf9K7CXzD9IKd0qqZpxcbDwX7G",
-	"WZKkJJPFN50TfmCy+g+G5YeIHft0kalL4Iyk8wRLUtyEO7mcWcFD8OFeCW1gFBSNV9fu8XkjAZvnoTgJ",
-	"w8EeFZeN1rdUXIZb6RgSjREpGiNM1G84P/RD74uuYTVd11jbvDrskBsgoS7DxkAW9Q0MMzGntSw+phvt",
-	"Y9Isp8b2EglFFrG+W1AJcVVrDR3ZkF366xwCbBlKQIUVai/Bg1dvswArLtTbm2ZTCPR9hZOWy2dM5DUh",
-	"mV0/gqZq3g9wn7j0pS2ZS5u2euhvRWDFbcQaqEMj3VKlZTlKyZEDoq+ZkF46GYFJTFEI8ZjO8gWmMIYW",
-	"atWI067fVuZSom4tUhc1vv+YtlmZnSqhLCysiGuGA50o+4RcUTOxFNNsJYJZiWBqdEjh4rJCGK/lfYth",
-	"iq53TUy1ZkWBDtDXmTpAVxM6ek+cR9afkApUIhkaA9aCHoRqo6n8DouAwFx9tTyhjuIGlcOviU+j2whA",
-	"rTkNRCfAoJYAB4tckePlAdam4/BAOSxtYWl6XdhhxXQPJGwzVHeRRUtf9KeGlK/EbX9RcVuFjrbyJRWR",
-	"mzThoh+LJ47r0KE6W8U3zVmatbJuEkzOTLNaAsUDVdPV0J5gRQNjf2488LR1eYgh0hblGVOoY1tTxYLu",
-	"42hmQn+Xu9LO3rYDNWGfKysCIZf0hiXmx/ds3nj24qHTvnYkvqyyX6HxrbM7N7UC+1NaPnBw5YU/e9Y9",
-	"E3PV9KVUQTlLKUFsZW0tZk8BRqH9cNxCyum3v6OcE9+OzrfIOYcDK+7bhUuvKX6o4xnQTPESzohAzaMh",
-	"FL7t+HVLKAbXuRdpIdB3n3CptxDXOmwqOSFOjNSnOxqmCHAc2h5SlGPlQ5eIVtL/+AySHTTCEidsuqQ4",
-	"zi6kEFiVv+/aXr3FfyYbl9LgQQ4wI9dH4ZgPQBPJtc5bgB5Tl5JwnGjHkixPwMjLeqIFXHrIFWW5aBnA",
-	"VrnDKIYBeUVJErfwbBCu2ATjuCbcMS4FmS2ouTvnzp8IVuACh5gXi/5nzfpn2d/SCCmD8G5VfJT44vK6",
-	"gierKcJmnao21OyRWezk1S5SbRVdzGLMY3Br6sz1pQObeC6cLpV94bpVp8+3TXBlY5yGIN6Y59qtLLT4",
-	"5XySTO6svCETzQlLEpZLLerWCSLCGiTHCM7YNTCAUNekTdFGmlz31aWCfYllNDs1kXeafe79SnXBspAc",
-	"SzJd9JcqV3psAYaf5biEqn6xVaWZRaO5/mq4NCDjAf8DfRdoqndGU8LyzrhkVnyq3+S1bWrllsKbq12C",
-	"eQ7repnHU9I9iWr9m+FA5GBfdTbjRMxYEvcwnrXKrrDpnJ7tqd3Z4Mmw+66lJYyaVFcaMAYpDYda3hn/",
-	"TJZRIXQyT8WsyJ+/RBiQ3ZJZgprZ6el3SHKciTnjoYzHnF5hSX4gi2MsxHzGsWjSabpyLQYXs2PXtsQb",
-	"qYrXjMeDhw52UJpSZzAMs3IA0GXvJYQQp4lhN0w61XJ1mfPMiCggegROEnPnxix7JG0Nnf7Di2x1P2Kb",
-	"yMV4Kc0wn04JhEMBU0kzhaiI8EJtrpYh2nB8I6mlDni6FRQFruQ29yq3aUhJ28doo3gHajhaf4mGEGRY",
-	"hK1DUhzNaEYah7qeLSoDqI02bOTF4JXOiHsxMPMxyUGoKPLjkHQuFyafB9V+Xv7Dtsiqs4NOYJooSjDX",
-	"gc+sxa9ZLKDxOFfni+jEIuyKcE5jghpEzqL9IFsPOgc8dATpibbRxeBUX0YXA8S4v9JPjjaKLRvhLB4Z",
-	"kHYyZCHxnVm4IRMOAwqkC3Erp2DhHu9Ekl4RBSLS/Eib0elslKhFIbVahFUjvac6gqHv1AYdwiwShmP9",
-	"6KSZ+6wzFA+GA9sJVIhJ6WeKIfMKzoxf3EQxCbrI5Lbp+bStr3LHTqRedOLNuF56UKyhXvjKrqphQLuw",
-	"evFeeeX1CoclWIRm7UGnXnxu4VXs+T6EtujYcx3/omwcB5s/oWW/TBvBxcVyGfE8MwE1E5pdQpH+wyvB",
-	"CcVavCl0Df2HV0ONTCP9GrAj0EyLXQcuNCd8Bg6J6hCuY4g4ZrFkOFgOUTzQ7Lt1NZaduMnWq7yxS28q",
-	"amu8Y6BTLzm08Goqauv21IK0XrRXALleeFCAvV742tuIAIJ5W1MvfYnDrc7d9gVgr+4YH53fMBx3ILM6",
-	"1z1QWch8rJCV4RiWkzE5mrAciOwYxyNBpDmmoMADCsunHvrelj65JZzqGVQ/v7Ezqha8ZfKVmWC16CWO",
-	"T918q4X7Zv7V74d2PbWCCt65ggB9Oc+oLLjqasxCR5m6WOCGG6oapDZ4YTWzVDYqlUKAst4Bokqdfmdf",
-	"LDEmKct6aWBIgZ09F1UlwTca65bpooz28KIeu/ahI3Ctr/AhLN0kULUheIpr3IGD51lmb+MiZvCzsl0U",
-	"Hv2+Mfpm9OGfQUNbNVB4NnD8ivBUQ3Sh3n/xmgk0fTF4UnGl8wo7eSQYtowl5T3ygT0soaQHxRDTVDXT",
-	"rK+tXKFsneUH8UVWmHp/j8TVe+2LsEeqoMhyJknVxvdrlVTpPewhFqhUdhOrVHg4V7HQwL00m9VjvTJi",
-	"+asasYQOXxeG17zHSnTcyI6bybnWyYYTqINC93rGRNGBjWA8AT8T1i0R0P33WayjMP3iRBjBvzWBv6Nj",
-	"lYbT/RgbGKzekS3pH0rJ9x1wr7HQlgJe2II+qSCWsQyoZVgJ7sNy1h9uAQb31mB/aUr+zbKK4cEbpr1j",
-	"KnNQMPmdZcSLbyqMhbyOi73zdseG3tk52d9Zf3O0u3N2cPTWpqhXH8v8jE7qrHaaccQigjOdZdu2dDYK",
-	"qvIcc0mjPMEcCap2gsoZNWYamBM8BM7ccHxoJyWcRnj9Lbn+5T3jl0O0nyv8Wz/GnFpfhTzD6ZhOc5YL",
-	"9HQUzTDHkVRU065VR0YV+XzOuCQxenwxeH14puPWnJ/tGi6zRp7O2CXJvJhQywRy98M/cucLFEph9QuN",
-	"m5IzWuJebFWXKZZ6XDJNiWMyJdmIfJQcjySeahrEeDrY9ga+aVQq7JTiITtlQilM8i/wecqxjsvQbsnQ",
-	"c2osJkOWKtqgnvd2fr9ovVHIyuL4h919PT9b5z7n4gauTAoW/UtYnW82D6rUNflaTPcLoEY1bRsANCwy",
-	"6J6uNyVNp7Sw5pec08Y52kro/OQAPbakrXWnEZ2U0pqXA2cbXH9yX3vgr6KyBWVIBgztoNjmg4dgZl6D",
-	"+0XbUteVeUKc2cYdgNL7mgZ0Vhq+cmF5ODL0yECQa9DUTyc/vBv5M32E81Y07Z+Ny67zVUClcOREEGE1",
-	"Ndf5/xXQmhv/0ipHKnXkFTWEcZxTTsQvNCQT0LGAVQ1jmqjuJ5pZX7SwIwaNGwF0sLeLDvYMlB9//9PZ",
-	"kzV0rK9lHbZZGzhBPZOdgWQ0LlAuoDNsPVKOaHgnK9gPlDRQRw2GKll8STAPOriGVPXa8uU0mpHYeMdX",
-	"zYyKRNbC1LI0jSn+KkIxu86Mlgd4FROpcmhIGzzYaGpLXVoLqa1tAk/ZTuOXXc6ycgZ2ITGXrzmOyJ7n",
-	"c9/Xikd6XF/ro9bWqz2e5CA4hxAxOBeEH2QTdlt6oFDQ9tFMEBqO8n77GQ7nT3qVJwlIFjtD8gdeLmqq",
-	"pRir9xdhOJCKsc7S2DouB2NY0Z2PQ8YgWqBQ5hl7HCpPElPelasmIedrKv03cDhBYMPLyXYaQrZ36b3H",
-	"M6yYS+XjhIrZMeOyRYw0Y0KOJBtNFUOjE9oY+0PhtAfvDo3TB8kkX+jEjN5jyryjLgaqLzXcNnSm/rI2",
-	"BvWS9TlnkkUsuRiYpA0XgxcbLza2X2zYRubnuozm5vHicNOXym+Mvvnwz239z+P1xzKa/988nv9fEcn5",
-	"kyf/Corqa+a71d3504RerFq1vDtE14xfgorPRic2aRRfgZPyrkwQnpJM6twT7w59j5wUL0xmSHoFDoCE",
-	"ggmXTnJ6tHugIxWvq9frBEfw1MXqxa4mak26zVMpkzDIK8ZtuQ3pL7THkQldbPJ4GhchjH7Ix+Qd5RKp",
-	"/+Q4OdR2Ouj9zuEb7VWkSEGMrtK1BU6TYMJEHS7zMOzxCJ8rQY90XNQraNbX8Ur3A65XxiqoSFoGjIZ5",
-	"ausMJvO6E/lgnchoPZvS7OO6ms5avM1Zd2KEsN8NRFqJck7l4hTyOsHMx8BQ2GRY+tcrK+H5/qezQZEz",
-	"ypQW40PUJn1LNGX4OT8PB+MupY/0jO4ROsRz8N6ohBcv8sOuWUKvmMfBbzkB7yPjJ8/4VDHqxQGd0x+I",
-	"YfBpNmFGFidxBPsOqXUH2wNJcPq/fR/9osczdzCQyRuEzghOjZW3yZ61vb5eal3LDPpzuYsPj0PNnhjZ",
-	"uL4cjKltlGCuo256Qb/ZRAtDQdxF4ilxpuGKDZMzQrk75WLtIgOTkogYjsSsbGeOoxlBW2sbtcVcX1+v",
-	"YSheY3y6btqK9TcHu/tvT/dHW2sbazOZJprBkoCrFSDtHB8MhsWlOLBBD24ggHGG53SwPXi6trG2aVzF",
-	"AB3X1TN5PXLWwNOQLPg1kdXkL7UUV85uTWEmSGmMiTHEcga+Cgbc2tiwOGHSVntEav1XYxqoCW6fpNZm",
-	"FEC4CnP3g1r7s80X9zaeU2fVxoL8XxkriBiJYfCtbx5g8DPG0CHOFsjIBLXCTT/Bfx6UN07nTNO7Xgkv",
-	"3bj14E/fGcQaIjEVYxkmMYwar4k89gb/hChSCc4dgF5reG7YxI3NB9jE88wKrAzq/C3xdjj46h43v3no",
-	"A5u4WOs0kbY36ndsFFrbqy14ZsqvShchGB1z9tGmUDbySBunvQB/U5YtHQRHckqudFh3XysTPmV2Cp/y",
-	"fNUe4CHUrsx2dahWh6p6qK5wQmNjHBY8VO9MBcWnVo6Ik/fVj4BtBSwPxymRhAt4I9ZZ51Cv6tTZqTkW",
-	"eEZwDGy55et8TYPl33EgzYWOuPaJTmIbSqiVwDL00XuIQV/i2KLgw533M+NQWqx1deD/pAf+D3uxqUN0",
-	"s+4k+3MWzPRWSlv4UUcrCl2tvmJbLHG7Pj7eOTRZVJ/U1YxGz5yway1HAN2uESaECc+ZUaO2Up23XuST",
-	"lms/FwXtAVmDozw+DAe+UEKr6joIEQDpJYsX94YqJcsEtdd+Vx9H19fXI8UFjHKeGEfJW/d9U13uzSek",
-	"rWWdYyPhcQqEe6ayncOXiG2f4+cEf433LTyL/CCv5WhAZYxXlf26ogvzdzIvJbutqHAdxEsu+hBEFnH2",
-	"hdrwXUtJtSGjOTvQg+oABJcplsasqlTpkTYHyskjHZLCighdJAx44totbJJ32U5ar/lh3bfR5RLWsSwl",
-	"p1H5Ya29Y0lsnXONjJhynRy4EmqFXBG+kDOTaCw0UWh16kXIeKDZ6nAfQ0sdaTY1Lo2MKxBfEvTo20dD",
-	"9Ohb9V+cxejRf337qLCyvySLTZ1JeXN4SRZb/6V/bFl1QmClMOLtVqowKcUfaZqnKHNh9yziuUUaTCkj",
-	"CKCh8cWAVDvCiEqaEK3UHNFJGcshd4/u1LY3+JtigG2GxqSIqgN5tN3BATG9yMcCvP6lPkWNmEFT8D8o",
-	"4FRztjYwGWxvbmxsgGWX/rkRiEn04RML+CxNaZLfGDHfX5eprT1iN54+wKivGB/TOCbZZ+dkH2K1p0YF",
-	"cJ45MWDtIp27aOc3wwY2dRcyJaonavDmrF+cukEplvqn4cxKQ/TinjY/4dghqFk3XBh+D/JJlhr6uS0B",
-	"dnG9TpnrcIqX/3FEe8zixX+vW83WOpSrCb0msn2waaXCrUc60ZlL20fjgUq3HPFmRRw/NXHceAjiuMuy",
-	"SUK1Mn1Fjqvk+OOoSBg7KD9wak+e9T9A5KCptyIhIUM99X0JOr7XRYt+7op8GhxI8d96jg0CgNs9/B9c",
-	"Arni0R6CDD17gCHfMom0R/+KDgXoULP5RG9S8rqV07g9HZnqwFp/diLSxSyuSMmKlPw9XphYRgEbx2OQ",
-	"bvYnJ1D/kxAUmOC9kpS+z94RDP3PJS2BVJvPpD9YEbW/J1FbvQw/PxnNAxyZdtRagoqedApkbk9Hi+w9",
-	"D05IP6X88KGp5+eQWK6I9opor4j2g4vzoiLVrdCpbq3FT7s5Q2OK3C7bhsaGK0OHlaHDytBhZehwV9rZ",
-	"SGBWVg8rq4fPdi833rM9TCB6XLZN5hBtmew/xdumebwHNpTomEhPq4nmXhpMKNrgfXt7iiWmMW2rfVdL",
-	"iyXmwbta3HouWuDQ2PHOXDG4Or18eUp5z4Yr65CVdcjqOdnn2iq9LVteku0PzR5GJLExIvFvQmSOLyoo",
-	"SsiQpC8F6hQ6dl/CKxOTFS1b6YW/VGIWlHVxgmMtR3KP6KiFoNTMTx6Y+tybYQokdPgtJwc65hRw65/n",
-	"1b4iUCsCtSJQ3VYstxISQNsHplErW5cVUVwRxZUO9Yslw3mQTwRxV4VV3O3NKp4sJy67J1L8RZjL3FGk",
-	"/Fmp8WeXaK9uhNWNsLoRviQx6Dr2FBjBu0YrKgiCEKvZoo31r3P857dSgtzhvpEM4fKEV/fNivtf0foV",
-	"rf8r0/qCiiuirwNc4wiCga9zInKdEiJs9nEC5S4q9hgLEiOWaZu+wswOZ/E6M7Zz7mvI3F71phP8iU9k",
-	"9aF71yN9JmJZnkJzeK8VnVwZe31yElI674MPQDXGOLJJ0aEP/fbW8YQtPTFH3lKImyq9qZY70tJhrK0P",
-	"R5dldkEjVmbYKzPslRn2X98MO4A+Y8YSgjM0SfBUoZBJA4lYlixgommK+aKc6VesoZ/UIgGKDMG7zaZG",
-	"0RADINssONAVEAXTmR99Xad7hb1g1xnhjzSilY7EowJ81bSvkBPvkelYdfUIUQEzagKpVzeEgAYeIWC9",
-	"oonaQMenLdDuu310sGfWoFFQuHKd+/noVGcZQjGdqufxDIuK0PgqTzLC8ZgmVC7W0KGii2OCMDo8ODvZ",
-	"Hwm5SPzMvujx7rv90fv379+PNApFZIggpZT6vrWx9Wy0ufX02VeNZzC6Igdxaekp/mizzz5/NvTTTaku",
-	"IdfUH89u7B/Dm0CWqU9qK6AvqpU5/4rD+8wcXh/b/Qrv1WSor6t90vfZQ5vg+6P2sLePWGpSxZiGARP7",
-	"Wp1bW5Fr49DmkbzSu1juNw0wdUV37/0NFvKU6JDvDaO4KncfzZyZ5rFMhbuMdEKymHASt0CvUuWung1N",
-	"I/FS8f2M0gRBHqi08kVY+SKsBLO1OzckFfHFIUvEpey+oPeaL4NOvVil85WHwIrCrAxwvwgS0xx+spti",
-	"vG7g7m5DLr6QWJPNzP6KVqxoxV9dBNBumd9JL6DivVGMlYH9imqtqNbKnuZPSCfbAkh2k8mTFmHMbQjl",
-	"F2H+vozs9uEI48PKiVeUeEWJV5T4MwjQ1r1pivU/8HxuPhemjBJz2WrLqCpApumiK8QyJGfU6sbX0CmR",
-	"AmHzc5SQK5Ig0/drkpk7ALErwjmNCXpMs5jMSRaTTLqkr0X3j1THUYJVsyutWh+iSUKIRJKk80RdN4wj",
-	"IXEW44Rl1o7hyf+yKW4lZwmaJzhTv9J5Lk2q+Yx8lGjqZqStYGD0qZqKmbKoTgjlgmZT+KpujREYh845",
-	"VRMxbZC76rQNA5WIjcFERfem0/RqIxMHByr0KNo+VLK5BQY32pHSJBQcEJamEEmaEpi/yPkVVeNUQMRZ",
-	"krBciiESNIuImhIVKCNXRMGOcWfJIsvmII8EDGXMIFKCM5pNJ3mCrmc0IcHNEupWUxsiYVEXA55nqtXF",
-	"YO0iC5m0KpDpe2On6OqOLMF9MQLDrnG91Q8VCGMyoZ6tkoNi4y42zNQcz5VMaHWnr+70v9mdvrSNcelm",
-	"T+iERIsoabE5bqq/NM/QwTGc3pZfcHP69HwCkjMs/+y370FtvTDjRDDErtV1M14gbEaFTPxUClWiep/r",
-	"XVI3E84TCVn8YQNKV9f1jEYzmJCZgbxmyGwzusYCUSFyEqOUgfVsRDKZLJDEl0QgMpmQSIZu99PV3b66",
-	"21d3++puX93tX+DdzuZtVzubr272O9/swTuTzVdX5urKXF2ZqytzdWX+ua5M32uhMaaLWnmcG+mo7kDb",
-	"inpt63apHe4Qt7NOLTr9IjSjPhRW5iMrir6i6H8rpWWZvAbIb4KFFMY7qtGmF3y8sZBI1QQOXkiczls4",
-	"4waD3wZHq1sa/jbOa6IjvXwZRsEOJi3WJM/q+/KWoV0ziRUpXdkP/+0ImyNcAaJmn8KdRM29mY18JUS5",
-	"Wl0p70K5KoPbGAfm5X6PNCwoYgC6eZmx68xN5B3hJb62EgkBKp+U6w7+rNKCFc1csZ8r9vOzU2lHiQNU",
-	"WjhH71YarasperqMa1nQQXzlYLYidisG8W/mYLY0DfHcze6NiqyczlaUbEXJVpTsLi5gSxOyk86IOSu3",
-	"sBXpWpGu1YvzL/TiNK9K9d4kGWdJkpJMRiyb0GnrU7OoXAq4Gnph7ruqu7rfJYgq7pl7SkeLnkAge2so",
-	"7MXTB/vlOWdXNCbx0MWQVoDTwWRnJLpEtCv7iIk5K8KDgJkWNRZoERbEhbulVoJpwghXIbKGDjKEkwQx",
-	"OSMc2upJelD2B9LRhGHmY4JIOpeNMX4jwT+b0LG28StKv2JS/yZ0tzi5jfk+avS2TIRdoqfWYPzFGauS",
-	"xYa4/LUGqxD9qxD9qxD9f48Q/Q9z2xvCsgqpvgqp/ie7f9ujq2ctt2lTpPVai08UdL0+zgPHX2+YQGco",
-	"dpNfst68FrEaN9W8Y1j2HkPHDRXvEna8x7DTUK17HbMlvnpT3buGJe+xbt5U897H7oiOfs8wWAVKXwVK",
-	"/3u/ZEvZieufl4ikvtxlvNeLgHfqb5qHXMVaXxGplWZlRRe76GJzoPflCNrrbtbwjtTsC7HU6/XuWFG1",
-	"lRbhbyTFaA0QvxydgUafmNKsrPlW1G5F7VY83BdDX9sCyy9HXk/6SbruSGC/CBvDW0qwPwtt/WyC8xVd",
-	"X9H1FV3/M8os17V6CieNUXeMpgsxjmKSLYJXRf2G2Omn9brFDSEZwuUpfWk3xI4F+ee+KexEVnLVlQRi",
-	"RUk7KWlBK9tJ6vIuzXcXot7OsWclSl0RshUh+5uJUu9Ee8KC1U9BfVbi1RUFXFHA1TP8ryBevRPJPVnG",
-	"qG8lcl3R2xW9XXGcf7ans++QfaVm0vg8PiGSU3JFBMLO10s3CSV1AN8/3WGXv9/fxqXslHGJGI8JNzmp",
-	"ChcvtSAbILfszvdI9fEIPc7ItboUJpQL2Tg56Lw0KZMEC5wORDQYDkiWpwpdMPyCjx+Gt3WH0/uv901t",
-	"kfVn63KVvGc/s+Hfy4f0kwpt1I6uXOlWrnSf7x77/9i7tt62cTT6Vwi/7AyQyxbYl+2iD0XaKQIsNoOk",
-	"6L50Hhjqs82NTBok7dQ7mP8+4EV3SpQdX+LJ9xTEokiKos7h5fB8tgdGuMuTiSUqF5IocVD9Fxe2KHE4",
-	"/RefER5IxwPpeCD9LRxI7zTqbbDMsTVaLKjaNKOW6aI9HOT0VZJmwX5cP/hMYi/2UcocqDgwfTtEQ/pG",
-	"+j4ZfbsvZcTp9xZD9x14d6kOdMjd533kg+21QpOH2f0xQ39HzyHyon12P8Tdk/2suPLivAcOhdev71yO",
-	"hbuvIT5lCHwQKS2PpWqX6TvvFifAexpP1a++9JT5YCOqbho8TY6nyXFLqM1GjclkmEFWk8nr393fP66L",
-	"QLfrGpBEZ5luhFyGxV1XiNKdZiZgJ7o1JJ9FLTR9u5iejaBpjSx3DD6Dk12c7OJkF93XEojcgjScceKM",
-	"83VyfJfQR5D+CN+YLPjGdLi5xyum9cG8eAhwuBFAW5gysmQ0pEFEQvXHKwDB6GxFAc38UL0cpySB6wsY",
-	"RK1jola7tRG+EL5wDJcaw422+EvuOHzqXVFPqnebWaN7H6INos3ZDpacf14SLb7EN8h2gIo9nud8GwIH",
-	"xCrEqjeopxj04UvilUu3J8TCM6AIWAhYeO7z1UHkkJVeEiHv+1U7O2DkWRzZ3EICdzRIPKraDiEYIRgh",
-	"+Ig6q9Ldrqijvv6dLpfhZ+Z/0YYq9yxxDfGDvUyoILVsCGVKau0FOGF2S9hKKRAm37hticyLYbgOs13y",
-	"AEYT6v+7zGENOcn5FNiG5XaC7FQ95CcuMliCyECYAu1r5f5NkwxYTi2PrP3+ys/EzKkhXPt0kBEpiJHL",
-	"4m5lM1OQNapvb3RHKimbkwU4yUt4CmrCLe6MqBfn2MxXRi6o4Yzm+YZwMQfFjX/IYnLv6vE/WZ/jk5wa",
-	"UC5CMS12g1hZUq4lmVNNuNG2yYhcg1I8g3BgtXikUOefNAC5LmI7j321tiEUubq68q/55wvyPOdsbl9c",
-	"uXvzLEm4gTzb6vjoxwvphDrMv1JDn0ATmE6BmVA/asKTxI4ku17jCOFjVcWX8fzBlm3axdYa9YJQ2+Wm",
-	"vCaAcm/W9UT3Gov18p7qhXfyahagcYqE/Iz8fAx+dvT8SJmrBgv3+omKQ4P2xlsDy0tqdDnH9un6km9P",
-	"/3I5xP5yieSP5L8l+cslcj9yP3I/cj9y/ym5P+HC7JSKlSdfU7NYLM3Gd+J3M9476H48QidCJ26FH3cr",
-	"vGXqucXG+L4ABLfHEcQQxBDEdtisDn4OW46A7lMuELh/jZiFmIWYdYjTGTULYe+IMMpCOOPacMFM6Vzg",
-	"7y2dcSvIq0Bps4Q+r+F/+5JHoJ7NJZgJlFinQsXKSii56BNDP3GRDUJf4bDrJdOj3HU/kinPg9FGuy5S",
-	"5BtvtVG6WfrFzspOY8bXIHz60iHiIPYTe6ild15I1XLv1hFVd/P1PbVl8W4LA/CDLpa5v8M/yGf/i0OB",
-	"oM1/Pwk/Vp4W9qPKiy/EmVd4x/A1V1IsQJgPSyWzFQur4gpmXIoPK30JVJvLd5OLieGgPjxS9gQim/xm",
-	"wWEc6LjvEu0h0B7iZOTl+n2XvMLnYFlLqhkV/P9+7XIr//vGnVeE3FkU9Liimxc9GFqgWWlQbpuNMgba",
-	"IlHcnPiuUau3aqJ/yAXUegsjRCFEHR2iKsZ2nuWy9cUXCFb/vQtkzbssnilYSs2NVBwSLun3RcpNyir9",
-	"vp4nGqajhxx6yKGH3MvwsgIfJF8k35PND0q23IxxLY8wZp91eZX0QP7ltQKObGLeLjnpZF60iG+xh41g",
-	"XStr1k3TaTcLkfZv7aWNcLa+CNYutWr32Kk33tnuvudDBc0al19qDj5UkuokQWtwtAZHWVwU9xtzqsYM",
-	"qj2l2sZyahRdfBqGnuTebaQQdKBC7MEd1bMBnwEbqlEI8mVgTLErfJyJCnZ4KIr4gfjxFiatw9ZQozAk",
-	"qED3jCIohUUkQyTD81CvGDsHPaNGQed9YqFlV/A8CwnutquQxwXM4696IkojSiNKn3x57prNgT1dSsYv",
-	"+YLOoN9P4sYmJLxhiXB3c0vcbc5rwQm1+GMOfi9WwYxrozaESTHls5XyO7ZxsnCbvtUdCjIQhtNcu/1x",
-	"JoUA5j0gwBguZppQt3FMs0obYR8oi+YeUUO7x6nS3jF+655/T5QU1KT1NghP8Mp5qqddTjTY79bm3mkF",
-	"cOj/JkiFXEY/sEyCJkIaLxhBHtiCBzp4n+YFQ2fbsYJnBENn/v0483wqHFmcGyd8pTNkhFirIB8gHyAf",
-	"/KX4wOK8Z4Mgcd4IlhRGVyqktDS6SovaaNRGozYatdEvX2qsMAXV0aiOPiHdVpw5Th8dIc5+hfSQ1nfv",
-	"H9LxVdLtspM66UIKOKSTzrppXqZVHips1krwcr3yUGkqkgg1y6hZxk2RHjRuTX/q85vOjGc73fIoGP+U",
-	"gqIRi0qRglC9jCiE6sMzgqFB/fIoJPkyOM7YHUbORsU8PFREJEEkeRvTy5SSeRSaBBnvAfAE9cyIaYhp",
-	"qJV75Sia0DSPAtH75GLM7jB6JsrmbdcOjw2ep1itRMxGzEbMPvpS3hqU5r5qvbNtHcoMaaOz7G8hnwNi",
-	"V1HEwJgPtw/fRi8vem2ngxcXbNd+1tfrdyMjCTIptPR+x9HP4G4JglDyX3h8kOwJDAk3EA3aFmgHH63Y",
-	"kWolhFNreLWCt+2Ofjv+Ui2C4E2ozZbDIp/PSSMJ2nYIQs3gQLunYIEXQ57rkZchlyCuyPeJBsVp/n3i",
-	"ftCEEgM/DDGgFlzQ/F/k+2QtWO3yt//ckKWSPzbErISAfEC3ZIv8aqs5xrXd12NyYYvrerfbXmxTXq6p",
-	"sgW4Tn5TFfFQ3F377ZsD+G7D3E6Jq4SLZemCbbqemSug2eaSMhdStN1i0VCcXGgDNLMtPKU8t535mZs5",
-	"oeQff/8nKWi3kB27UX1W5sg1ybgOfQEyJ00yMs/I87xXSTOV9iuuN18ImDp5P6W5hrLZHqXMgYrI6uk7",
-	"zwEtOHnmhs1t/X9V0kgmvUNzGG+OGR6OooD04Cs9VkoObUZhdOS5boUBJWhOHrzE6rNSUvnUkap9oQae",
-	"6YZ85QuQK9MA36wMQBCJ/DdzYWRqiFzgbwN4C7jtRP0bTt0H6vtA71EY/bqA+a/T98+7ayd7cz2BVzj6",
-	"XrNS+eT95Jou+fX63cS+z1CRSAdWwfDdIrV9AyBM+ECuaszauDDpkkMtIynIx5WZ/6rkmmegmnLkWn7L",
-	"kCCZ2w0ow6e2bHjgMzv2CW8umjWrUmufWpU9b7ic1tdUzzS8v8EcbAMWoal9OOhOBuH3ZE0+CyXzfAHC",
-	"DD0plKlGPaE/9OKCAtivFtZ2zFzPzv6QrFoz8Fb9fh91Z5sqhNgmIRh6xqdTUCDiufuwCNvkXrfLj2bZ",
-	"8ClPPXef9XjIqybzT+fUp9Uv86pNtUc8MQPuHjgynQ45lrOX3/74MwAA//9VKuseqoYDAA==",
+	"H4sIAAAAAAAC/+z9iXIcN9Iwir4Kvv4mQtJMc9Fij8w/HHMokpI5NiWapOzjMXVtdBW6G8NqoA2gSLV9",
+	"FHHf4b7hfZITSCyFqkItzU2SXd8f/1jswppIZCZy/WOU8MWSM8KUHO38MZLJnCww/HMXL48Fv6QpEadL",
+	"kuifUiITQZeKcjbaqTZA5uuESIQZ2mWSTjKCdnPFF1j3QMcZVlMuFujh7u7xI7S0fVHC2ZTOcgGtNkfj",
+	"0VLwJRGKElgHXtK3IqtPfzYniDJFBMMZ2t09RrvHh+jtyXd6BLVaktHOSCpB2Wz0YTzCuZpzQX+HORqH",
+	"e7Obq/kTVGqMCEuXnDLVOHaSUcLUYdo6pmmEDvdbhjgliSCqzzASWkaHSqlcZnj1Gi9IfaRv8gVmG4Lg",
+	"FOvDsW0RwwuCplwgNSf+XKKjE6Y72q1OcZ6p0Y4SORlXJvpxTtSc6AGphMPxp00lsoMEE0w4zwhmegYu",
+	"ZphZ2OtNHAsype/rW3kD/8AZWkIDWL6eKOwPG5Ob6JAlfEHZzPyNsCCIvF9ySVKEpRvgH/A1umu3+DP4",
+	"EDse3QXxKaAOYYomZv4QloTli9HOzyOMl6N3kUlkwpdE1of/jkqlh7YYYJohxZEgv+VEAhZQRRbQtTaq",
+	"/QELgVfwN78gnRcAGnUh/ofxSK+ACo0OP5dhNHa3NnLzgjUEd6dyBzw4CkjxyX9JovQedieSZ7kix1jN",
+	"6/s4IUtBJGEK6BC2bdGUZgQtsZrXKcwyOo6Gh++tm2iYYzMOZ3BV5EoqsthEr7kiSM2xQpitEHlPpdLY",
+	"Bk2vaJahCUH8kogrQZUiQOPIe7xYZnpfW5dYbGV8toWXy82Mz6KQrsNgSX8gQsJSa4T5+NB+QymZUkYk",
+	"rPbS/EZSZKi8Riq4n8JBzCCtRmOGzFSb6JQI3RHJOc+zVBPrSyIUEiThM0Z/96MBSuppMqyIVAVpvsRZ",
+	"TsYIsxQt8AoJosdFOQtGgCZyEx1xQRBlU76D5kot5c7W1oyqzYvncpPyrYQvFjmjarWVcKYEneSKC7mV",
+	"kkuSbUk628AimVNFEpULsoWXdAMWy/Sm5OYi/V9BJM9FQmR4HS8fT4jCj0fj0TSjs7lKVKYnK36uX9bx",
+	"6P2G7r5xiQVQFD1OcSA/+K7Fby/d2Ic89vlgsVQrPdH7jRnfqF3i3eWym/Ro2OPlMrO0J9wj8Hipr+Vv",
+	"OU4zuF8ahpgyIkbj0Zxki9F4dLnovVdYz54f1v7wvR/dtygmsT99Y+ayf/2wGL0zG3Tr1l0IAy6Is+zN",
+	"dLTz8x+jvwkyHe2M/nerkFa2LNptvaQZcZ0+jNvbnpAMK3ppKIduXKJg+sc6vamsb59I3eFUYRU5EPsV",
+	"ZXRKklWSESR1Q+BOmhrFz0fkjBlgS8WXS5L2P4fYsk78cA0NTt0s5a0dsMsfsDAksUQgSfEBpyk1jPe4",
+	"1KQuh5TgcsAuqeBsQZhCl1hQED8uyGoDrj5aYirkGFGmQU5SlOZ6GCRypuiCbCKN5xdkBUTE9CA4maNF",
+	"LpWmrROirghh6DE0ePLFU5TMscCJIkJujmonGqenHgzfubPbm2M2I+k+UZhmEbDgREXpr15tgQCmlWEP",
+	"V1g6tm3kH4cB+tzh+LHQ10cQ86+10cCvfRdmPTXDtjUwEza3OHFL0VL0chmXK/WO9XIidAhdzbkkKCWX",
+	"NCEbmSbWAXA0VxQ0JSgxsI6LtHAA3RTQtIO7llLdaEEZVlygZS6WXJbpfsuB3wDsJZSBFb+rCkrBbgqI",
+	"jh0yvWvHzTdLYt5J5lpaiTJNSQpYs+CX8K98mWJ1nY348XftmLFvJ36e2Ne3bu7yyo+5iDxt9K9ogZdL",
+	"fd0p02e3wAqdj+ZcKv1xx/Mp/df5CD0km7PNMTofPd9+vr3zfPt89KgsT9nftZSHlSJCT/P/OT9P/7Gj",
+	"/+dvMQQLl2nF2BdYRrBtjy8WRqy3ZMAQ9iwrYbweX0YesoxxI2LdhJLuiglVAosVWhCFU6wwCgbeRG8l",
+	"Sb3wla3QZAU3EkQmnqFlhhlxQCxJPFdcXGQcpyB+PEJXc8KQEphJfSb6eGpbRFghQVhKBAIyDSiI0zcs",
+	"W7lXYQ2XcSHKtDFqJ/GY7ZcYbj+poIljf3g3XoNlg3gc7HuMsEQLLkH+JUxlKySJcjDWRHwLqKUld1ol",
+	"ITfRCcHpBmfZagclcFaaZ+l+KRUkUeaQ9Cyr/4N0M2TFcX0h9LgGxiQNV4KkUblk9BI+WeEbzwhT9YP4",
+	"MB6xRsIdjqpbebb6+P////3/lZkpyjibjZHZ4xVVc4RRRpQiAnGBWL6YEGFEfXttEePoak4VkUucxB/X",
+	"lte9IiwgbdVrlzM9B2WJIAvCFEkdzAWpAtyIBhoha6yISteepJ/CsXhoUKbIjIjao9rdlg6uUNPThdxP",
+	"/2AprP6news03Bsr0weDl94Kjb1sg3I/eFc0dNHvgHJr9zZp6GAfF+U+l43j/1Aa/YMnxlYz5kGrVU6M",
+	"9KAoEch0PTciS+7qEoVkV6cqLLvaV2BT4dQn9pn8HV1QJWMKFvMdZdDAKw4rj5sy80uWeeReH781g+gr",
+	"lXBB5CZ6aSQAQaQSFB4DE6xZGmc1BlTm+9ub//wiRl8WZMHFqj75Efxu5wdaxp1KMWdU3WAlT774ctFX",
+	"i1ODehvAE86kEpiyvlDP/BH25JWVs+9atOapuYxL5uYbaJOQpGyWlWmxVaEZuh0K5seCLLEVVuF5Yv5Z",
+	"PGoPhOBiNB69ZReMX2kqoK9mRhSIpO5ta/+lu6wtBZulhwupfQxWVvsWfX+bT27ttQ/FZmqfwt1F1uG2",
+	"G/8E+y8f2ltJRP0xK3K2K+MCQi6JCF93Ru0JP9ckJKcnnBD9eke5ZpH6/U4lohIxrswIejRs1JIwjL5/",
+	"lIH61DMbGXtNPqRT9/ckI4820b4xQ3j1o10VVgXj1SuRerqHMxAytFgsOFePEJ3CkjTTplMae36WVXJv",
+	"LSTCnzfkBV1uONqxASpzIgyD77o/P/AsX1Sk2qp0ahS4GESzFF1CD71LEIHqOqXyqcalvreM/paXX+zh",
+	"uPYwItQlIrwlGaaLY57RZLUGnTEbPyn1rgo/sPaI5PNHT4Z9uMAzYiYqCUhd3PFIS5vX6AfzNXZ+V2Wz",
+	"kUa1S2lOpcUoFF4N27hkD1rrOOr2ol7oe1LFAW8ZHJ0QfZVH4waknvOr4JbOMUszQHWLjOYJOieIX7Hq",
+	"AxREeVBDhLzDzveu/Y1vlm2IZDvfupXb9rp2zRqu0pQIwhISEwDsJ0fkUrLM+Iqk6M3e4YY+2oxiphDV",
+	"GIi4QJo3TXGi0AQnFxp0rXPH7l24no7XhzzNFwssVj2FgbKyRDYLAt8QnKn5ajQe7ZOZwEYfVWf+r3m4",
+	"lvWZfXn5xaSNTYLVNLaJ8Plygyi/LzepbkxDXSkijapnb46zjLBZBNixVgjLC3217DtZcfRbzhVBVEl0",
+	"vHcSUVcxHsVFLRBoOfjLZxuEJTwlKYKWwSEaBQJlSZanemL0W44zOl1pRASFlb0MsAI9sdH6aT+AlSJR",
+	"A3wiZJMHSEreEy8qnH6zu/Hkiy9hS36TJYro56JMPX0yqj/BIzSwdDsALHZJ0ZtRQL8giXFl3xRnsuY6",
+	"UesfONboLS4IlrnQBIlzZXVV5P3SGE74NDgHiSjTFuuMEKUFJfObVducHR+hJRGUpzQxEhFZcqEMxBw8",
+	"qTCQlHTGvM6FCoSLNWq7zFjreJI5OFZcEgGiFMIzTJlUVlXiCJg19dawbYHf78Zw+Rt+BUonhO3IiTdS",
+	"64EBBhYiC7D5A922eCjIQq9BT0pTY0UqQy+Zk+Si1AUkx5QTI6uafZqtFGIRldpAZdYx1aahshT6dHth",
+	"5vKNCpnYS5r6WmC05JJqeySy2IemPMv4leU2RollHqOn+VIfD0mLH8GRZQf9Kn+Fx6AkCWepHKNfF+aH",
+	"BWW5IvqHuflhznNjDwsU4w//tfPz442v3p2fp39/9K/z8/RnuZi/+1v/S3hSOVp3Mu4Gwqmtegslx3sn",
+	"fsQf9IDwhKfs0HR+3HE7+9zK7zWGt1LN7w1p1CdkqGSAIQ9k5EpUL4TuoA/o7Pjo5oTVAjTxpHxCQBpi",
+	"8ooII//cgICWTw22m3qO0PfI/ElV3Y1+i8M6stez46MnL37ZPTs7OD1DUokc3DiQICoXFtC6yS/f92Ya",
+	"+oSwHqTv/Ge/nB6+er179vbkYF0W1cAkzPbDpbQxjlzN98AXMmJqLrn7tEv1viUYT4AuuVdGu3BqG7c5",
+	"sdWAHLrbyYPQOzDmDlhqDezC+gIaO1pp3rh7oFtM25tIS5iXmGZ65KbNrPFMytXcw69LOgjOqemI91cM",
+	"L2jyJgDFrtQIsrBuLxWy1NUFYfinBM0HkNwylAulZa7mgdetfrNFGLF5yzV6xP379M1r7w0HZEm3N2zT",
+	"am6MmBIuAtFUH8GUEuFMjz+fj2aC50t5PtLG3O3z0TvEhf45yaXiC/MzF7Pz0btH67k4tnmQuofpaBzZ",
+	"W+BJWtsB6Eq87ZmL2YY1PLfeCD39aT7tN73Mpz2n3wC4xKdXnW4SpYGxx6Pw6ZUahIs8pCv4rowbQ4E0",
+	"HVh/wjPSE9vLTRF5rwROlESCZ0SiqeCLKEajXAJ3LDD15jiup9wCdLXoXkfid/AXrM3/QXC2+AUnCZEW",
+	"y93nNREaXGFTDY+YugI+whKNtye1jgC7dq3GX+qhU1AaO+aUvreKPvD1LGEES2HTkiyxwIqLR+Z2L7BK",
+	"5vYN4jSHGMlg+pnATEnTGn4Aq6kVm6mSpvFahPfUbz0KGLfE8u3aqV2vU9cQbhcXsx1Yn/U2eWi7ogc7",
+	"Dx5tIgC0I2ZOeeKnAq4llxkYrSrEdiOAhnQD6f3zXFVGmGV8gjMANpiYwbk4y0rDyWtecNjbfV3sdfhY",
+	"vC1KA3WgYWJwu41poXTFsXAbM7b1GrTaLN9u7y3o1s6bx6MlEcZ60iIqmCaNQ8AroXURp9CiYYC6IVut",
+	"ZcXuMUH3AO1g6jNCO5Q+NCFbe7cozrV2QYkgWIHO2V7PCt/V5AL8STRe1hlJH1FD99QMe6OPzAGNrZIg",
+	"aRMB/Kh3LYb0XtGdCyXu8vWjXY0o1PgUCr8iUY4iiT8iyqFrSBodjWYZE67m6M3h/h5QeBNWEw1tu9ar",
+	"7oKyyCPrW8pSo7QwcLGc3+/EsbIT/cJ2sRCGyhoQBZsu4j50zAZlU2fqtZSZFNFB5hFgwtLyCXiEWBdn",
+	"iRTfRHvet8p6pep4LLSHFyTbw5LcedSHxgK5oUEW56fOjbLrCN4AjI6IwrqXtPa6vi9HYwRsfi3aQw2W",
+	"Y+fowmP96m3HZd3C4EXmXsghU5W3h5derGt4mNemvYUH+HAbPspt0Gdq7sJ6OG1OvAup+zgyYrxsxJhK",
+	"6PJ4dPFcNjX+9rmsNOYaUZ800gEg5tUuNG2U6TQbqDZfEibndNro7PhmSdipblDxQKgKf6Woy95CYG1F",
+	"XSJbZM+dXRp20HHX8XKt9tXD+/CujI0l+LyzWNZHCVFuU3qiGAVE9SnS+nC5vadJZe393xOVjrf3jqgN",
+	"3Pv9UO3ZRBUCJUD0rIrvaKIJm3WWshbNwEhTektCNC88lU2E0pKIBZXS+SQ4E6qW38CCao4cPCrNiWMk",
+	"CfC1DE9IJhEXtqGx/kmSkURx4R1Ipdcl6d6R8c067GigbkHa9HfwHoM3OmcgKftx/XzeYV+anABlZm7a",
+	"RL1KI8tSELsm9Rbdrq0+yC1YzclKe+Ssp8NqDj+AmfVnvaGSysq8LDAymjfdxKqivKKJylABFXer4Vnb",
+	"xDBU2ecNRqyFyGxwCHDi4pE53uIDTheUmdGCOAJYVnRF7gA7WaxGhFPXuMlLR2+v8eK0PfTjV6mlhzc0",
+	"aD1Vu55G8cLp1xCo0gOv+yHdHWIY9tCeYoIE63JB945A392j1JLfvvq02j7bj64Pp4q1LI7Kgd+SKsO7",
+	"FA9g1cKyymck4groM0sx3PA2yYbidhGl2e5H8V2QFHsx5Serwr6RNndN5DMHGMM4He0I7qsHTIm1HZZQ",
+	"ojUgNj7COiESPVBghgXnbu2uiASZUanEqo5g66SuAS6J5JxfMReP9fawUEbtEabenDapo2CJ8WkACsb4",
+	"E7AEt+ZigoQwxeXGhHOVbIV/2DkX+P13hM20ienJF1+AC4v7+3GMFuFZDMWB+MN+dYMiJNHAuLBCSSUI",
+	"XnxlrEzmj8fbNUNTsKbHT55X1xQ4Bf18fn71Tv/P5sa7P7bHj5/880PUI6i/X04BcbvXOBaqJGKSe2Ek",
+	"IVDEkQzcvPSRT+BnqR/XLCF1bJqDJ+eeoIoI2vmehUm+KXf5MDYBNPH7ucDv6SJf2KhHxAVaEqExAc9s",
+	"NLqVl7h96js8hYVvjvpK2sd+VJCtF5TpaUOQe9fFd9eXLsYjmYOl8mwuiJzzLB3t9F/Xh6bT/KZ2CJVb",
+	"Dd9RYhvYJDoWdiWAGSF3QYga698dfdLgneNLyBahFfXECKsKixlRRYimzX0DXbmwIsqEoAQiS8Ebz+5/",
+	"mmsTqVPORFRjhUuu8xWuE5EpgvBOWIo0Pr1IehfoQpp+IMu+0E6QtyOjh1ygshvwI83fQl/FOTauiv6x",
+	"U3ZSl35+CjZNSQ1AzO1YxZ1sfKKc11zps9Meoi27ZPZlUtrX3vHbMTKxd2NkMjJceD2bUaRN4ITdBBZA",
+	"8RVJji/286b44JKzpSaS1h215I+ccKYoy3kusxXgEayXg1/SvIKGeKpAuw+BVxq3qJLBLV4Hg9CZx2Ab",
+	"ABWcgp1BP0Gp5vLMdfM3QCKptDAbcz79E3qZNhKSU0vnG9iD+1xKeOWk0okT++DAyHuS5AqSJLRwD9k4",
+	"32553JLs2VcuNJyunYOONVCwIrPOuKoTfZy5OnXNq8zXjxNjunt6z+BWTU7IJU+aQvBjzZAgCRegcBHk",
+	"kl8UeJsUzSNyHrTp98zzpK40JiTSoVLmoImJynjk/ZIKIncbuLfPD+AWHo5u+2rVi/5H6aNRA6UCwi4L",
+	"A4AogKLRo+Qyqm/0hp4wHvaDZTSJG5qXJV7T0NtliwkboolgW53brwLV9uu/fkkExdlrEILic5kWXk6a",
+	"NoF9jObkvfPH7Q5TKk08DpEq3H2ICL3RP25Ta2xaNq5Fm92bja1x9l50Kdp7sLn9aW1uwXmf0hmjbHZi",
+	"ANeK/eWmJVcJB3jjz+2CJEIi449vb3dwiPiLOUQ04pCzbkofk3u9YUz323KzaJynkz/UmzfyiHLTj8En",
+	"6itYl1eURxj4xV+PX8QzxP8o8HIJbrs8ZynCxpnQ+FymaO/0ZIwWPCWZiU+5yCdEMKKIRJQDMPGSboai",
+	"9+bl483WJdSvD0h/JimKebnGDKE2IWfxlOZTEzNK1coL3JUnTY84YghZaEv1198SUsmmqgdGWBnkKqL3",
+	"itwnDsbAaDWcl3yZZzgwhutMaRJujIY9tHfe/3SxyEHrHMmdahApKiFEguqOD46Kf3+7d/q/j7f1cjbR",
+	"UWCJ0fR308sNlGSpiVsO8KFN+DBUoXdEIBENin+W2vedeZ44nDB9TFI7GxGKM6NccfniO3T7OY2QvreH",
+	"+/dwasEiJJ7FrGhv4XevMZKFV4MOYzW9AmhYjax9eleuRH90dhmF2uMR7wEwFcLocLuEKusRwoasIgV6",
+	"4aU2seJsKyWM4mxLR5DnglT0w7DLIJGibIA7otMi6XwsnK9oGr+xdsi6pD4uAIcgDtnDvNdd08SW+mSn",
+	"1XyO7ptRVReJEl1NA/Stzo6BkqChIGgXQEfSMdonjJLUQOglpraaRD+5xY3ZGcwZbCGKAzp5wAkB7SoX",
+	"qzcJBeNh8IJaw4hqe2k4mKQEFEzTzu9eG06N0U/TIFAzU2dWbbGothg64exhRH0Rt9otnl0GT7THFxPK",
+	"XEhZaYA5l6oQwgp4edI9tnIaFwuD5VqBbtdWJI5w6/gtxyuQsm7TANtorex37idE5tn6J6472WoLoWHc",
+	"IsBDhWfmWsP+ubAgcadPM6pWjyIPBo8dzXHYyh8+F9q0XMeq8AjjJhkiBBd7PI3Z6s/Ojh0908y/FMWv",
+	"Ry5tF9I8hbNLBADbRLsTSZgq8kA5UmkNKZghPZNNKg7rsWjCiNLpjEHNyXP1aDMun+keR0RqJlffBKTw",
+	"QQvz2eVg0S+Sq/kqCkBYkt9GN7Mp2vZEszM8uxPiYjbi0U32ctb4jEmLCBveC30Bp4c2QGng+9OBoDN/",
+	"8d3Cvtr8oj71bfhxtLtqxHGzngv3Opm5S8nWP4x793OFKtbo0pDPb41Mgk35ljvTArKMsube7z7EAeyE",
+	"lN5w9V08NJeRvPI9xzDxBP3i6moZ1f0ohfBqih7ATUScEYQ18VE+tU0uBGEumZUrS6RF+hP/vAuBEk/O",
+	"r38tJMYgh4wxd2vS/W3xpNSjh3oXnTjfBsRrcIPXYpqGZFJvGxGWLyJ5drFUZwIzaYBHm6iibhfY3vxa",
+	"le/rbIoaSJaD6pUw8FPob5VbNHE1cKND3qho2yFqnicaRu6o8ITnyq7YLy8eajqBl1falsJd737T6Zg2",
+	"Z75lkaq1gIa2Q0Jafchcki85K22cMvXlsyhDb7alPpwISqaPqkZUP+cD2WunPfXTbtQGfbQdZRxDG7+J",
+	"4gxb6UN3FsvSPscuiuAMPHdegrSAbDLC0LVUfx+NR9AgSLfYL7tiZXV2rMqvbujKz36mcJcNtV+sh2yB",
+	"OTRU05aLvQhbsuTs+OgHm6JuNA4/mCcl7JlmsaaFuFb5wxGpYywkND1dsQT+8YNWIuoWxkPjUNP+mSBS",
+	"Hz7USbFprpckcU2P8kzRZUbeXDEiJKxLG7b3iVYrm/iU/jmtD5jgWbYgTFkRMNhv7Vt5u40ajmCIxjYe",
+	"lo0tPJAbW5SXUwh3UdBriDd+qJ1P+NGf1cuMEOVOAf6InZo5jeDszA/hCZpf+p6jQfMpnVVjH/uJJq+o",
+	"inTvDJvzfNDUW7yGQHONWb9RahnrZmFQr3vwicuUkI3g5jJo5F3VIwUwtCs8wn3W9HgBUy5iIWBh9aVr",
+	"5Y3WA8T0uyIsZrBm6QEZf5HEBc8YY6zh0XHF+6wEgnIpJw/GUrJpk255AWbGevXQzw62daAtc9fiiDOq",
+	"uCdCxfUrb3phmnXXZCusthzZTt2akXD0aAL49hqP9Z0YEiM4O3i/FETGy6bq74j4Bi47lEYLPXaaZ2CP",
+	"pgsiN88ZxACaFlSiX/+O7P/7dQdtoCPjFLuDfv37rz7qaHvji6820Qb6huei9unJU/1pH0Pq0CPO1Lzc",
+	"4vHG08e6RfTT4ydB5x8JuaiO/uXmOSt8e10ko9RL/VWv2JnjtCWhFHyph6HM+PT68cglAeVLrmMhN9Cv",
+	"G7/uoBPMioiUX7c3nhtn4MdP0O6RPvvnaPfItB7/uoPAC8E1fjx+/MS2lgo0+o+fqLl1LDZ9tn7dQaeK",
+	"LItlbbk+ZjHVHqcm9rC8l+e/liLAngddztmBKd+iIYe2N56PH3+58eSpPdIoTd2DPIWGqx+yKW8z9Faf",
+	"I2AHN67KKTIJD11ROXsADYUQy6a7YBDKDDKC0QtebuWk6rU7v0+WhKWEJStTs3CfKEgh3Vjt8k6qMDat",
+	"IprCf0rZjIiloKzB+szIFQoamYNHIHApnRr8UZBLl83AW9lN31SaDEjJt2QVn9A1AGOpTXK5cl4rxeDW",
+	"iGkndQq9GVU7i9WGIEu+tcCUxaPV2qpHhusrg+dd64lrmdcIYidkWrwg19Aot44VegSWCqWFZ+McBOGe",
+	"mjxKPvb0gUTkvS0fXT6iinGzJEz2cyivTGVPQ3+ZUYW4AJOCa2VPF0LPoxjSiZLhlr3rM3PRH0wRj6Z6",
+	"+gJVx0jOsc5kz6dmRROersbo2+fSFv/3qjHryRNfn9Yw2IKcTa7gZZ1UuF6PsHSTbFZR2i1eK2usm9Sj",
+	"vvqpup21eozd+Hss+ISYV+THIlmVZURpFtiY4rOTkoHJmzGmMJhG0Am5B6pkp7sromT2332ct0CF4sRH",
+	"rlgyF7zI6FcguLSWliqloaRURmCMErxUUDSgXvA0RpBOyDT2INCub/B9wxOf8LZpwQfuorlNMMMY9KDe",
+	"/unLGrC1Emi1E/5eRX+MmLP28djlBv7hy/lKQrRfIZr4wnllZ9emevzGldStqOwNaXKn+Kwnox2f7NCn",
+	"IBlNv3ySTifPpl+kT5J0Mvnq6dOvnn75ZPLF9PHz6ZOEPPnyefrPL7589tUkTZ5vb28/nW6T7WdPvnqC",
+	"/0mmz5OnAJ/Ba/0v5LVeaPj6mwBsn2v4o79rvH21An+xMgHrVlcmiwmBUt+tviKVyluukw/Q5lxZN4K4",
+	"rwhrTulQ2KIaCsg38ECcNnC/Is4sqCRY1KeBnqh3eTuoFhyh5q/9LK4NcmawptKcEXvVLdVcpBKJHFJV",
+	"23qLh1M0yTC7GMdOT+TM1V6EOowwJpZBJbZqncRbL4vY9xrFS41+GDcXxivsXraJL95Whdr16+S1MM5o",
+	"HTWNqgEujQsDoL9949ZSz7X7Xy4UFtMwuKh/iz42wL1cMTBSe62ii7ZqjdZrG2oejCDoOBRIMyHy3Yp1",
+	"tb3yXIOttRmqe3iJwZfOkdD+8k3YtZZszspqvvJ3GbJcHlnPuVZeBa38vpp2EAvavJ6j2K6P31PchuhW",
+	"Yx+CuGsvIdf3dztRzSUfqy97JQuwIAEZt+l27AXuJtWMHPblH9uRebUHkl7VQlPONdI4bpcLenmed22b",
+	"lNHkdqXP1fdQYn9OOGMksVZzf4Pr+5ZGG3643xTcDZ/R4X7oVFGZIX7bTc+jQG6r4IrHWz+Lk5Ic/9br",
+	"trEKXxsxeYmpkPrFxkBUlQZRKaOK4oz+btDZvfsVEfqln439mhV33caIqKTpuMpF/Ev0prKrcQDA5qMM",
+	"rcKxSuV210ax624dSsu2ZO88XztDkw2nn8waLuUM+sV9wcyQ/bYUjFNn2D7yxFwWqWeobW1B1JxXyhuG",
+	"Spm3jIA7A7hvJIqL1QmRpfW1uUm0rTgYua1ZeVYPhZc0I+DhMyUiUFNVZRbrqZaW/OSmVCOk7WzdwKx3",
+	"mEcBcFg7L57KKFmej+oIoIM0ZJe4DPNBSz9rtbxcs+vVHanaqjCM6thSKgydaX/EwP60ujLhSxrm7rCw",
+	"fJjyK5ZxnD4Cp25e+pYv4Uu4Otd8NB6Zr2thWwkzipHqH9/asduViZaPAnWzHa1qb4zoFFGFUhpPg7ls",
+	"rIOEJ5JnubLp8Pi0gGJNblxDP1iclp17bNGzmZYcajwTVK3AJ76JrTe3rekES4yfuh7W/XpJhEZ4E5R6",
+	"TfF4IyoeF4a56py13FdlFb53hKqfE10QqfBi6XZTcTGF0TXS+7qsYZaqNdK8rKH2iB3B9YTzxpE6/B3X",
+	"ONKCg7iCzG+ZdKby0BvQn8E6tzy2gWKmtjbhGprb+dU1NynWXQdro/doNGddAVU+bb0Y5vdDsDyo1fWR",
+	"RiPC2m/Q4pLBVSgW3fH6vM49KwYPixOv46ZtqhG/4FzdDEzXup+muz1sp1pRy8VNlnLtK15fTO9L3igW",
+	"Bg6k/qbEL/q1LnXlgjVsqemOdlCDOiEoLvB3WKpTQlgTE3Tfq4wPkFbqDyrE5+Z3fNY4Ud107ERS8N4n",
+	"zClCtFKUJqTvpajgj19AMwZ9R6ckWSUZ+YbzC4c4DgNekCkXob/u7lQREfxtGpwQrcMOWhQ/rIMZpaXU",
+	"po60qa6mcZhwgU3jBGuuA+daypDM9b4F3WDVK6kYvE36WYeuVfZ6PZEjNkgTIfJ6hAaI1WUL43RvqUHZ",
+	"E7z8y5okqbLqKlGpfC6tIvI9trSOZmXyFM2PVHwrJ0Myv99fGapgvp7mc91+yGr0yWU1Go+slaPfCTrZ",
+	"4vbSIcUiPT6WH2XzSqI6E3CEpWz2sqE6jLss4EfhSjr4GgoVcatv4pc2tUBlQX3BfUIkzy5bwO1KgEDz",
+	"Bh892KNriLDU5W20ayCDRBRTxLj5BdQ/+kcM2RWM9jfipXtPB+z2Hj3gpSCXlOfyaJ2Dtmfs+upE2bo7",
+	"Sa954MYbLMubY/i+4VfeLTOjibJJaM3GQgAYj27YzWg8es3dv2Bf+yQjcUzv8lQL1taMcm9kPL9Z+NVl",
+	"iLCKbJuA/s2pN4s0apHiAT9npUGKdAqIC/T25LvNfnH87Zu6jkj45rT3Fn4oG8LcNppLcuzTWWNmsRS+",
+	"VceyfovGV3YHb29ubj7qC5rypC2Agss2p0vjov5RKHt1DdErz8hVC5XTzvGGrhl656mbIAsdz9mPuDnS",
+	"0DKRaxKfjXFG+kzVfHGbT+oYC7wgiohToq7lqxgOgGiQDE2RxTLDoAq3LYxUV0tg5ap+UF9tSBdsAC9O",
+	"quaIEQr2CFyUcWNcBMKw62+G9aWa3M9OCa9h2qR3k81O8JXLbLXhRAXTbaKjXOXg+0PeJ1ku6aU1M7kV",
+	"r8cArl2PxMC3LaqmIz7mbF4/rrE2FRsnjeIT5PFDx6W/i1ockAiyQADrT02tA24FEytYa/fQjLE+Enkt",
+	"UlyU0ehQxCbLvJ9sXF6HUwXqmh036W/Kf1x/hAo09W78oHZ1fUHbTpVlKVTRALtMhl2tl/HoRyzso9iX",
+	"RhmbZFFr50mILbSYKPa1mDz2NVhQ7LNbZOxbmHbBf88XvbPZYbaygaJl7V14qd99GJc/Q7LP4PO7lsRV",
+	"ApbjCZfJDcKZKwRmB9Em8i2wnZMsLSgW2lUoI1gqk1fFNXZX3PrjpxVv9PLqd0aEXVLBoQrb10vB0xzM",
+	"mGNFifh6KjhThKWjmnd4eZMxVz23HLNLKMpZql8SVJKyUDCqVWr3aZLXBB6dNi4VyzDhTRkksihl5rOy",
+	"aLz82kz2eGx1css5luR/vj4mLKWssb5/BVK3u0cYvN8ey8gQ7PGCrB4bD6HH4wuyevI/5o8njeEtzUQF",
+	"LoVccibJ+hn/oJtR3sA2TcIdr48KkA8+a2HTsvSnH+oeaeUWzS7KBbfHCl0RQcqliuxAMR/lmnNaacpm",
+	"4tv2Xqq8lpqND6GraktV+aLVdYrLN2b1qkkyoRNk83J03kHtsFH2mSx8q71ODgIgG51xwddHEEauJJqA",
+	"BQBB4V1TzaT39n60qwmzrDRtDxwUmzdWCZyU6yRcrWcKiU0vuwuM4gQKWdnGzi9wXV2u85yMJtouq77X",
+	"9pnTg/Ce67B6hWpShwrx1EsrySc2PYI9EZuzvD8MKgkSokI7RBuka9K3Mx+nkDqrn6zkfahkkdAvpGOT",
+	"r1C2VdqChshmNizvtNrFVR2268gZNerLsXnE6OJwVM15rpDMp1P6HorTYSTnJMs2pFplBM0yPnGTwfph",
+	"djzDlEnlktVlK6QvFjFTyJi7cpiJcXvjK7zx++7Gf3bOzzd+2TyH//v5/Pzd/5yfb5yf//38/F/v/vHw",
+	"/+rX7tG/Hp6fb/5sGsY+/6253nFblJ8xDBzzjCY9pfa3QQ+Dy82885ohnkXX0JodtywWzyTPVZDtq+0n",
+	"Smi9i26IE/3GLRIO3pQJOaJdNC69ItagTfUor8j9xPUYiLVHr8SQaBJcCYXoQUjDHv2TfvtzhLMwcVOO",
+	"CeqziOaExDH98zUTfYfcrhe7KKIJgEeE8bTrRd8Wo3j3lWs57TiPpdtxzkAPX785O9gxpkWfiIS6yqLl",
+	"5M27x4d9I/1tPNh/JWcbdMa4ID4AzBvKr2XbX5PL+j69kydF1TPrWhxrN8xwJZctpscARfsyV45ToRLT",
+	"W5v+mMnSt4yqZspjbcfrcIe0wTUsIBYlyJTJ2yhO7cKjDO+Sv9mAH8V6i5MLUa/lAXPtALvgts2xSK+w",
+	"MMK8ybqkH3xmr4UW724C7+waLEu8ldC7CGiu52RTH6LD16/u2vcGshCCNmsmsImhdAqu0FnqmOsHb/pm",
+	"Oi35/u1eYaog2aQNUzKZSMEGeYxzuab/TWlDwdJq34LVRr6WNXSlT3UHsNLn0jYj36seQaWPMWBEmlXh",
+	"Uxxniaz1S4L1xkYGu9sQVDMi75dcFvzGhFCeswOczCGlScKFAFVKauwjxUPIXAubz8OLM6vNc9adTsts",
+	"onSrEp5l4EJRjRWJiIl6kY2xgZof7+oWLjgweglDD5qGMYIWDRGn0ZE16sQi+LTfsQ7dW2Mok62sDwur",
+	"JUjTPNsRQQPt+C7fuEbo1FHKnsurOvaEAPVQqK9iXD6+ZrpVe+50hLMtoaWpk40ZnhXqPuuEJceIsiTL",
+	"U1NYgrgC4DKo5u3ijKC2e+oMjhHrn213apIVdgpWZjO+tWfu1+3/oQNs6bX8DcyabtX/NGSPZvjbZI+l",
+	"zV6PPdaHWMMDtQCYdz9dnvF9DGWa3uTqzdT+O3A7vo7ZqrTIYIrI13DWaOeK/3P5a80y9UOeMSIsbd+7",
+	"JOsGVi4hbyIAa++HA3QZDofIZTzNa3JJDiOitx6gMAlbd5S9Hw42nmw/ebbx+MnTZ4820dHh2cmBVS7p",
+	"bz/99NNPG67CfNB9jJwXXOFODDXxMmvBpmksNv7LZyVdk55B65He/fHsg/vH+MPfRvfrqlY+pB8OGjI6",
+	"CqkO21x/4KNz/vGJsDTU9VMW+uvVGS4NGnQqfdDmnErFhbaIbuE8pba64BiFPkMNHkPh2k7ItL6wSpif",
+	"d0gqKsrczmrXTb9m8LSZtoT6oo43jfdlYYXTSaENgO1NicVXn8rxZdw1BceSFzVJir3ijU1er50/PtST",
+	"WEwEwReaHbbuZLJC5+G6zkf1QIQCerL6IPwEFm/X1L5wxRXOGq63/hREgMdm6hn/bUWHTwk69unfBp3K",
+	"RTKgGkeQtXr+lQ1HrxuVF51ZtddOZD3+xDJxR6XfxKZa1GKvGQBYGpUXpqJonTw0B6GbcHEuViYKvVi8",
+	"40bBmO17gTkiWeTNWYkcZn2RpzZVRsUOUWmBTOJlG1YHBei0jloXGtLShm9tyKQwlSQQBTxd2nISdTDM",
+	"BM+XL1bNGj7j4HBBVvDytWHNCLppEHtf42L+CSy3pAQMZIWHP+9u/Adv/K6lhJ83/L9/2dp89/dH/wo+",
+	"9rAogUzyluFLTK1jZ+w8F5TRRb4IqI47I+R7+kud5oA5Fny21q7uHhZZC0jHgrLdjunx+8r0OavP689x",
+	"rfmjDyCeXBCxm6t5M1WMG76goxUaca7mhKnwYgXV+Wg0eCpX8z65AN8kdNc1hWwQUl5xkcah576aBBkX",
+	"xCzF1+MrL7PEOfy40drETdWAS5nwOqbqUAW4PQbTBbuNEvC8rZiVQyRfM9zhjLuD2CRXUhxpqGdEkU0E",
+	"BM11KF74rvoyxJ5gBJVu6KWNcCbCFjAz+g9sbDo5o2oTFTn9/Y8SYaGz2EuTHl+aqudj9OvC/GAy3usf",
+	"5uYHyO0P+BOQhX/t/Px446t35+fp3x/96/w8/Vku5nEacMASrrUXfbL7ENvW8CRIzgREHCtc2AT9gbr3",
+	"xDLDlGn1DdQW7135yEx1bDu7v1/YQT6EBZD2vDGwfIeIb7FhDWVdt6kY89R2qCJiZMwY8tWqM0UKlFab",
+	"lDPt+orqXPia0xobzQJK5tTrZeCtL7EcxGeu9Ojx0/TLp0/S518+/efTBGOS4i+fpfjZ9hdPpl998c8p",
+	"xv989mSa/HP7i+3tJ1/+89nzSfLPr7a//CJ5/vzxV+njyXaYpjWRYrQz2tD/9+Lg1eFrtHdwcnb48nBv",
+	"9+wAnRx8//bg9Ay+nrOjw8MXL/6790J8f/hid//Fd0dvL65Orn7a/+H77/cPtnffHz35/snR7/++eLP/",
+	"0++vf3/9359+fJn959XBk9evTuav93cfn7OjxU9fvD5LFz/9ePD09f6/Fz/9nly9Ptu9OvrvT09f78/p",
+	"T78nXxzt//T4p99nz47OsoujHw+vjl5eXB1c/fTNt/w/h+fs9/9u7+1+/9Oh/uv3/27v736f7H8/2z34",
+	"5sXR3tPt1yf/Pvv309c/vskI/eqnHy9eHG0d/c5f779aHZ18m/9+sL11zpJvL1b/9w//Ju+/+W37/SF7",
+	"8uSnvdevn/5n//X791c/fvld9v3sKf3vK3Z5qr5/M/lyd/dol7/a2/vt1enRs69e7B7tnbPd7dnu0cHb",
+	"vcPv90/Fe/rlhUj3vk2+25unRy+eXv3z8LfFfvaf+cnBq8k3R3sHpz+wL6U83j2c/ee7f3wv/q2uztnz",
+	"k3+IZ0uKf7r8z4US8uLpau8w//3p/PCfGf9p8X8fP02ff33OAOwHr/dbjmRInfxXS51cIxHrZVGud79G",
+	"QmW70l5EdtfSyR7E1jUtapzGlc2e9AYuLKhgAs1J+7Crs1fXiZlinUSnNS7Sm9mB0BxLNCGEITdAPCVz",
+	"kSr9muE1ELADzxBJVCWvkk5ALMgywwmxzVxRb/TQPu8fja1jN8KCoAURM1fiGWwxLkd+6loF164Gu+h0",
+	"EFUWzgHyBnZZMI1IhqbU5JdUCPxTQJUVmz+qWinNac7Jqi7i+WL19eVZcWx1AICIC4P6x4dDINjl3cJw",
+	"XZCBOSo6k+4MAI2jX+3+WlRf65IGyqZe+pTm215XZHRM2nXpAz/Em17/prItIPBjZTObhwRAq5rDu98v",
+	"FZXr8WLVXUPHtu2hPwpGHYdb6lFFuusIruEMGgF8cb2iuBbPZBJtVk5qUmtyb+lNojP3cgCr9Rxynnxy",
+	"OU9uK3VJXDLrxnTdzBx00NDcsVrbB9JlMNBXMRaeKhtCyI8PjjZAWUBSdPzt3un/Pt4O42mQNJWCQ+oZ",
+	"kVbKTuf9y3WMR2BxPunK+H0WFvOKZ/0GlLVJjTe1Gyx66EoitETS3UQs23Uh65mVz1x9defqe0X163+5",
+	"zFYmZr2wQIKqWt+hgExSGZMjCzy6ViL7kheoFD0RtMF7pKHhevyhF7ku3gbXEjMK9ApQuRv/bVaaoE/c",
+	"L6vN9b7qS6+3f30+0eJY3+zi237Gp4V6rel0bZM20WvOr6y+VZNtoBRGGkYvQZOFrAQeIniQnrCuQC80",
+	"zGsr/kDl/2Ec6vtyuuE4V/zY3558507n7WFxc03gYC5NNJWpFqZ///4EaRQxlcMouzDlzGC+otpboyPf",
+	"dTWaTYrNCryKCRph0AslnOmkAy10swI1ArmgvKwS0pgSlNdADTP0RnAlN+IlDPagYRB8uY8VLpYZXnM9",
+	"gEvubpeuxzf5tvVKz747jV98s5gLsmpdxLdktdbk2tG2Y+7qZW+ASn2JvQ6+P0noQRlcLQo2Mx7D1zn0",
+	"YF8aqbigqhHkRdtd17QZ+sHIyI8c/iobL3AsB4+RnkEFoolHmgoivVdl58bRQycIz7lU+tW3s+RC9XBD",
+	"agGQX2z05LXEHDnmS/NMC0wa1sUIXPQMeeQJxIn5SmTGmTxCzOOJCaoPWyiFxYWHBcyhBJ3NQMZTczu5",
+	"seSZNw7IU5BEgkzpe2Oks1l+9HA76CFY2cAxVf8gHwUz2K84V3yh3yfudxmXDq/7ZEwLD8lWWq/35rwp",
+	"IUTtEvKwGcVvP/XwifN/Gx6Lt/5YhEqxPYoqVZ5m1ZIHGo7GA77B3fl6BoHmmk9yzoXSzq3JnDJSrNMe",
+	"P9yycvq8Slkoc+kCm7DzjdoTxIZ3lX6hnPms2+7DWx8JVv6l1tAlE6z8Eo5ZD/tv+LnSY+/4bS1Hz97x",
+	"22pWn73jt681AysaHUHSo1pf83O1u/m1MoJ2R6v11z9We+vfKn2DqOFyhFLwoRbYFHyr5jTap9Iy5KD9",
+	"YSTEqRJxVP3ZJ8AMPlRG3TNlqmv+6fb3ume67xD1Sa+cZ9XJuQbgaoPaiqsNqqfx5hRckF3av0Z1eNs3",
+	"nFWW3ZAntj3D6ijM7fKD9kUv/XLILku/+Xjl5vT4flWHNmTrDMuL6I/HRCwwg6wLwXUFPxkuVruQy4Zq",
+	"n6/w50OGyx8sY0qLJgVNALdltyv4o9gQ/HlifMAKghP+eqqwqP/ql1oawJpRqr+/0J7/+1QuMSRcrXy1",
+	"cCaZO6la16Zx9X8mOLmIL9F97epdI5KmkvRiQVWAPuHHyqEUH2rHUnw6xkKSNPKjzl8bW4H+/9EfA4R3",
+	"0ffmCpWQvamQ+9gGA54QqbiAHwJq5kPRi0tZr9okGn5vKRVvdtBLRjs1Tb36pc3vNxBa3zD4xVD3MbK0",
+	"JuSrnvDbb90Zdbs00GUR0ksJhThjJ/D7H1thvfGp0BjuA183rDNd4kJ+xkgWYUA+bZx9Q6yW8NIrBbWY",
+	"zDTLpU3/03by3Umlql3c4lswszOPRLl9bMQqQvdKTRF0CMesYnC7Dj1W8K6D7bSO155cvYNjrTFyNY94",
+	"U/LfjlQTDamCm7h9+2hNcW6t1L9hxOYeLaMG7KjvsEWX+LhrLbRjjRWm2GPAco/4qO1Xpt4yPkqdsfYY",
+	"sNapfez+Ky33aB/VCQprDGu7xMddY7zaOBHBsGGYesv4KHVJsseAtU7F2G1SZWNcT2OXcNySnNWOQ9HG",
+	"9bE611VqFuiBXEag18ajN4g31BZARtYIZqoN3iuDTwNZ7de7nYVcZ4wqs+gaoxk51+nZiIVdg7SiR3fn",
+	"TmztGqLliq/Tdb1Nt/KRdTo3sLW1h7jRIuKMa50RGmj1dYa40U7irKjfLWwSiLp7t4vO/fs3yMldA/R4",
+	"EPSDQEy8/vCu/CLrKJUAr6QGvzb3qeLL1pAS4a4c2Px0/bzWdPPBU+3P66kWKDyiig6/CmNIoBKZ7FCg",
+	"YaqbECpWXde52zi45jwdxlI/b2zP+ppbRXTTnuGjcV6a0liBxqStP8TUIUXeK/Tw7dnLjedglDQRdoVd",
+	"upjEVf5ucj3S7VyIXbdHSRAx+OFDw/aPAoQrr19/RT5pfTyGOr5rvYMH0oRLj4OoS1eURVMFV2OJ5Qsi",
+	"aIIO9zfRvnG31zcVnY8E58oU2Y8mu9Q/bsgLutxwnn4bQAKI8LkvF9ZlrnGFSyKsAQnptpvoJ54DjTFr",
+	"NlmwFlwQNMULmlEsEE8Uzpy7U0awhjD6nQjukuBvf/nsGZwyNt6bCV3YDjxXDX2ePdl+pImcymm6JYma",
+	"6f8omlys0MSGmiJfnxZCCDQR84Adwzorm4GbovcpURrAVS9vM55aQhLRCi2oM3Sn5znaGb0toob7HXMT",
+	"Yr9xptewTG3izQq2GlOQurJfwGtp6MBKEf584scu/ezehe/sCtdLUxHSqk5BMLzYnULTBMqzkWMMnnR/",
+	"1JM5eNLTkNYB5M414+5f2iw3odsJCUtU3J4cNAgon0UQI2DEeoGLpsvtBivCmHG53X8qy+3w8/3J7cV0",
+	"veR2aD7I7X9aub1bAVLLtzDRzeKsHj6BtFLORlZkZrmf5HbNu4onuLM65ujbwqegMa2qqaxgyz3Tb9l6",
+	"PsdEJISpxoKithla+nZOuL/GZNM869pY0fImm3O191oDccKX2lm5g/Okp9KikX66WSd5CAbhUfxRdEHS",
+	"N7nq2iS0g4FussdrZ2lbZ5acza0rVseeYnMYMLow4NRW5zJkD0309mkK8v+CEFV0eyBdcvREUEUExb0W",
+	"25bjsIoQY0s5Yvdg7LO6BWjrL2Zwyr1oWF0P/KcgYsW2olTso1zA6yBA1xl2s6A7h3c7v7hFSJdwS0O8",
+	"uLnTPqn/WgHeBei4veL+oV1eR5xF6+avG9OPhcA2IPVxWTZsUmM10agsicsWH4Xv7Z1uy9SK25DUNQ+4",
+	"gML6h122hdz/Ibf41N3ZfbIi293fpEaj2/3DubaUKMiFbXV2y8guTeVKPTw48iUXjYLbTWe+mnNJKkd9",
+	"Yw7VBJe+CPCxb1l5HaN39wV4CG9NIFWifWkHKHBPl69iNF9f5gNctvsBEo21FKSkf5GpuSByzrP040iB",
+	"lY3e88XGf8Z73VcmDUC/fnnE5oGqAVX2o7UYWu5VfX7cL1YnhV9bdY4fbYYsnGUlTLFKNlcIxs7tTtQw",
+	"CJegHrJbQFzmJeW5rGJDPC2a1gCe3ROegTZZqDPaJHv66n3+9KS5n/3TOin+ka5r5RLF4BpbXfCU97AJ",
+	"MaXrNnVR6junpF0UFJoIrMgsooSxYyBpW/gAhSI+g2l4vLjzx3r5hX4rRDLceY9jjCZ7qbdZL89LB8Wz",
+	"RUdfdBE+qygrSgGbV5i9FGWABeo3Rt6rI6x/YJgl5EfKUn4VJX4MSaLG/u7bF7wt02YTVyyKkdAVDAXJ",
+	"GDX+ARHWs5n1jkGGgleMzQrgKQs0sr35kpjiyv1IiyNKvQw9cWYXN5J7e9s1eGFLfio4+86cVFgpIlWv",
+	"nC+7RVNbMxkSqAu8IIqICPYeu2/6dKUtpa4PNKhIBGYt6ZiXu0ljVATNISzRH3+gzWKmzfN8e/tpckFW",
+	"8A+CPnwArw1Dqm3BMEQZ4iIFTwnupoE8UXpBvvAQrIxfEiFoShDBIqNEIM7WLmTsN3saN/NZvO5Xbfqk",
+	"1FhzTlB4cNHVEXJznrrGAXm8Rm1z17Uos9BQL6VSoePOLNNF9G29xljcjFxp5YHRSI+vL5Vemzz3LtkN",
+	"rceI6L1SnGUrRAvLRdECzfElgbcjZF9JrO3CVOQjpdwnlCGsM2o2+NOtl2DLo8PNq1WntdJN3WjhWxd3",
+	"bR1K3V20N4Yzr6hNpn8MtI34kjcVHz2qokW1TEY9V0ALMvW8oqqo76mbIZNKZp0aMq5yjPHi1GO5K1vE",
+	"NETlc+E/d4tRxVDe/SA6pmE/J+SStmUVNF/1onNJCr+E1vVWjipYfG3WcVM1nPGI9VKFWzAu7TF3r8b6",
+	"ztmTb8Cdb/LJIVOC6xutJ44npWxoWJTkgcokNPyOch1DjkxPXcEcPTx+c3qGtsLa0lt/GE+PX2j6YQsG",
+	"ebSJ3kr7gn6jMzk9CfHaOoYcWhUV/HFKEkFM0YUXWNIE6V7wXSd300CvI25zDHd5D9XHwIyqeT6JPgJy",
+	"kZXyUY+c7wle0k3TbzPhi1GMzQVA0g7BeuFll8n4WLBn01f/OQaTcIIZmhBkisbS30katEIHTBGxFFQS",
+	"64/TjUWqKarhlcarJb+G2KgJTHFVnBeprSvjKqxIxDjk5kIPl/kko4np8miMvjk7O97S/3MK38eIC3R6",
+	"+g38offDOJDdcBMafnuuSrmUc/vvd7XqCEHDDsr9TdHyQzhmR7dT37A1lUAAHt2o/CKuYGRPd9XgvPSj",
+	"8ZXuGOJtBCnDZejLpDhKMs4MdSyVMRkFnlYWO7fsxy09iMZaU8vJldB83IV4emHjZvT7hmSLIMinv/ds",
+	"0MmRFl2iJlLoDcpLRlQGIbsEyjzXD0AjolKJ5iRboIDKRXkSHMsSN0VY2BeTb1XUOCrGRSlZZny1cIma",
+	"/FksVht4udwopojMb14jzRcXEtPXs+kHQoEZIbaw4A5jMaFKYEGzFWJEQr41lxtCVgrheHCHMsCIzSh7",
+	"D+x0pkvbbD55bPKkQT23ETh04wl4fJklz7lUEpBA/2u042awxFfzA/N5CcLLaMv+aBRUo2PIKaedmd/Z",
+	"cgM0wXs8Z2q087SUwlNvcLTzfNsDdy/LpSLi8Dj+yDbw0v7YLR6dDqi6FUhjkDXY1iUIzhvBOEYvSDIM",
+	"tatga2HBahCutUBrXqFoQqbclBgQRfkAM2PpKH62a9WN0hw44eYKL/R1tB/ca1VurhbZ6F0gcHdUrKvc",
+	"cXPk0dT09QvP+cVuUr/rlTsbkXG9oG9zMy9yCVatBVGR2mETgsh7kuRW6dvrKaHX1vqcMHnJbJX5rpHM",
+	"Lm2H4gGu6ILwXH2GxdHQA/mgXBvtweJBuTaaRtsH8wc3r4/2IVYzs1/EfAH7k5x1BksUrU3qonSNHlp4",
+	"gKBWR2iajj2iqSylKE65qUnDwjPW5g+5qUvtTrhQUI2VL0H5BQot67Y95/zigbR9DN2AjvDRWH1AbwVX",
+	"xhiwcnCshgaGlricbTAYgunC/raVZpBsZsnVAtv8ybUh8VQR4Ufkjl75HHVmEv2wLs+hjdLofGSKn5yP",
+	"UMZn0tuqcmFmSzhTlGnaCgFbXhFrtg+BVDnzGmDXswCI2SBLw5khHlEJCvmNjC4kvGnOSTUU1wBEo7Fd",
+	"bE/JrQE5du1YDZ8P7RQl7CoQr6YhmIef1kTjqEKsGLCdnJdHiYhbSwitylZIEpZqivTq4MxXEwGVBaTx",
+	"ZqWa/EzRDFFlI0bT4NzJ+6Xx3JKuskxK4BFh+lSOUKKo3cIN0mQ20aRVv1HCSaydx9eSsjbb8lPvyfa2",
+	"LTZvqp5+8dVXYQ3U7e2YmUP/U1w2ujOD6YGDMQNNiLoiBEriToj8xHlACTKPb1Azs/GVo5FUn73+r3SP",
+	"HICNAY0WIr3AcD7KeIIz/dv5CIFJKON8CSbZw2OX+Ln7Ta1X034nNAOKFMe8/AGLm5SqOGCXVHAGGthL",
+	"LCjkhNVJwk1ozxJTIceIsv+aC+KK8eqLsSDxglw5a4wRXwC9LElUevAky8EEg9kKYTHLF6CqNuoiqTBL",
+	"sUiRnJMsQ3LFFH6vT4JKU5zfBb9KtLAJYtxMEi3pUqMdn4Enw1hjriHJK+On4BaBcpYSfXgTLOdoIwHm",
+	"QN7H3cGvuLjYpw3hsPqjKbTtSmab7UIVL1OHOmfMcVy70B56xJx14IcTOWo4IosPawkvcZOGHazXWkpR",
+	"tnVQ+fzHYRltHuYQTw0FCbglWFbBMYEvIVDX/SBIxnG6NvusrvTUDtfWgi9bG5z4NbW1MauNQS3O83zd",
+	"OlyCTaXmX40p8fAI1jr+4vAgZoX2SM4QLiy81sYbaTql78dIx7Sjc/OG37Rv5/NR/J5hql5yoVd1GVGK",
+	"+Fp5ul3AqQEs1Iixl1aGhA8FvnlXli4mj15zVQTX+5fTOeDf+agYskeBPYDhODiRpjtUPBt31nmn+G46",
+	"VvvNstfbw/c5eL/UjMoc9xr93khIjbxep0Dftu46bZnHtfoYhD5kU96sRmhYW42Y4rJ+sbNEn2scqBnA",
+	"EmrePyBKeWteXPNgCWOD8xpfdtDWh7rUAYsvR2JF5XRV++hX1FvbUYJaQDdaVT0hLGEnnYcTYGk9wz1D",
+	"xH8OwE20IIOVUc2KnNwY5DF8rOHJrVV2rQSbFBjkHy8zekm87vV6m2vwTLCb6DwWRwRqUOCylDi90cMU",
+	"THunCHR/kUvyUJ8bpE2Bb4xXv4NN6ZETToP3+PXA0UTBK9vphIslxHWw9Ly0RueLldF8ZNq3zBvSq/cX",
+	"fl3/4paToUTUlM0GfWxTg3jLvkmChLgIhd+7IHuVY7FpSPoQkIAVNMjJ7ts1r+1LSrJUlgsQPpBWMio5",
+	"31Qgc5ObPIbvVlmPfg3TFewgLBZfPvt1E31LVubVrSyXkkVOKbdm68vkAREKcaVuBXSMKg1My6XtmUG7",
+	"aUwA9ejplayva1iUy5YpDR/QvSiBmdTSXMS3CG8mIiLlvoCEXMgl5BKcK7S3G2UNSyzlFRdpkweM+Yps",
+	"tSEThRxZl3f99ONF5tJpj4zF3hR3aBJKTi/oEgmy4IpYrx10GXSIe+CrTPYCxtl3p6ZCmksD1mvpevQL",
+	"suo/+gVZ9R9c+4w0BfFrn5RbgX7uMlFFJ3JfO+fqtlQEN6DdnUurq3r6czGzkn4eXZqmH0eZgP7Vaeo9",
+	"pdPNHblTPKiM7RLZ+dAQGz4CS5FE42Wha7wSVCnCbuwPJur+YM6dy1ZUlyuWoBZPMfNujW1e+KR8oCO0",
+	"eRk05fYvzsJ159C44RhyStBvORErVDgPa8XvHGG5g85HW5qfbSm+5fLf/Atafw2to0/lNp8zf3z372bm",
+	"MLKJrl/TVwgQxsGm7Cpk0toR60Vdwu86Yl/XsecWXHT01H1VVQGgtEPCN9C1TX8N8HG+OTjL4l45gQ/E",
+	"VuL8oFqdccDUT1OjBGu4FXpac2OMwhOMYPpQXFet5LVhabxyQ8HNT0i0gOKI+oq6u2XUvGB1AO5rN+e0",
+	"qpOVQ1FzjyWIJ2xmV2JMGlSaIoFzki2Lt1OxI4fsGj4eu/rp7VtckuC9EHEvqmf3u56f0Zu9Q/tq0oxG",
+	"KDrFiYp6Bi1xcoFnpHtH6zhgwPaOeM7UDzzLF6S6vfLqTRvjR1ssfKG7kxThIGdlg4+mh0prtnbdyExV",
+	"VOhZGHed9p6mE2ynASpuoEZYHOdZFtrpnefn4fQ1V8fGB7/m7/lmaShf2bb1IOzzYBO5gCT4tptd4ZV8",
+	"YEzTBo5UomUOoU+al65AiVnp9Vp/KXWC9wfOBMHpCpH34HJUVSg7omXm1KUcypuBUXtSMw0fP47+ozKW",
+	"/smO50Aax6yIR6c9mg+3hTU978V4VO9bQ/39UhywFUT0O4rpm7ChF5RRzFT9MtdvwbKEY52bClASdmQp",
+	"SAdx6V6YidMQZEalEitLYnW0yIQgX2OXiKAj40bZY6M7NQlwg4FlLuOaO0hkgw24WMg6nSu7BveQhdx+",
+	"oyfHMsquRZ+hY6zMpsv7WNGnKuuSua42tUjq2uE2ZxbUk2xD4z6Piu59er9Ekz23Tj56q6Fcds+qBupu",
+	"hdRGwMUK39xvRHN9/miYARGCi6OmurR6dmiBbK03l5PA6XSsY1PcuiDojDKc+erQvWoACKLEas9x3PJy",
+	"Xpcy9tk4WCwv0BxLNCGEOf+pzTXT0ZWgUF151+k2VnW5/4OuLeUuznzpJvlUTv8KF45z1kPQRHMvsLgw",
+	"+uJlAZh6NofroEiw0D748u8r1SMsKtaqR0zUv388C98i8D7594/fnkZCo/OUxvn3gXNic01QkmG6cPpk",
+	"q6j5949nsRzxeY8IqxI17/DqHo+olDkRLcs0DcJF3mCNZrAoGv/36kK+bXosayCjh/8+ffMa/UgmWkmO",
+	"Tol6VOgX4P0ZahVs6NEFWQHbs6cGi0aSzhj2gQwNIFo/xuy/V6q7BqgySO52G0Phb5/L9hdapUGQvgaj",
+	"b/MJEYwoIrfeLAk7ndOp8uy2S9eCl7TxCKilfsEMEPem9WYxKKZULjO8imcL/KZShN20RV4Za5LRNMoI",
+	"4yJ2JHi+xSJfCtMllejb57IABZXIDhLXrXMxw4z+DpDalRplFj3oq0b5N/GelTE1YGzMys4fDU9NtIQG",
+	"HiRhfwCW9QI1ENCf4VfY23vNvwxJNoP8Az2wDR8YDzdJ4o5zDkTd7FPrzAlTXnkRnJi7FBfPZTy/ywQn",
+	"rxvcb09e7O5VIqiKwhjxOyt4RtY7pZNyDztGk8bMn4hVm0H2EEGXRk1iA4j0kGbdBsAMKgTT322+E/sN",
+	"FGjGugSekBuCZARLEkQJQX9BwnGlDc13UClq95oJbRWSaabVconKNnC6oGzDZLvwveBP8qiHsTbEgbEj",
+	"DFFq5cmBiedtf6nc1ithPJIwW9/Q+GKVyHT8TIvh5Exd08qDVWDlMTAILDlWvdcY8dh9ZgVY1w2Z9J97",
+	"DPX5FriJPGrDOM/iaDszkdjexQWIXUtwTIqXwCi0AimVirJEIeNCNLZkx+amIwvNSIydVRlWcj66IKuv",
+	"QQo8H22es3LwISmc1L8uIhBBhp9Rzr7O5QbBUm081uClRHyt/e8JS9eJQxyPymlqYrvTDZDLemPrfMBv",
+	"xp7HLzU+uFI1zuBoIyoEkcBKpybNj/Xvwiw1fxeubcZLY/f1Pkk30cFiqVZbLM+yyuzSdEOMq7mtH1/J",
+	"eFMZtYt1HVXba7JQrPQGPjG7aIGXeuN/XJDVGM74gwksiLuH1FHO1cWIBsnqL4Gk6jL9WBepFVNzomhS",
+	"HEfhdBO6t2jMNcehoyB4Ln1OHFiG3ES7fghQc+oBjH2Lm4r+fxS5g8bILexDvCYcZXmEZh0Z7akkygWO",
+	"aaoEf2OU0UXh7l0UCQD09kZ1EzFTxBN590Hr+aG1LFCyDCCELzHNtKRqMNS+wSTiS/xbTixurrydTXHz",
+	"zPKa3CDgqlKvBZt0PsQmPAOyoLh94l8GWc/sXfErKcC9Z8AEFkPNtyWVijBlxtLLsolol9xm5KLTcKdl",
+	"5wa9b+d7xoUBgZpjhjCakisXb2TOdImlJKkBiTtxl5bPWCIdtI0wltv4Scjubo7WghIMjhOCaGpk2cxB",
+	"qvTanVIhXVCaJGOUs4xIiVY8N+sRJCHUg9L6sGjhELOylqfBW2KBKaNsdqjIokEtU60XMpH6YJmyyGXX",
+	"CYA3nB4Lk8vJXB8TVl4ctNsKvOF9T4cszjKQWoLGhYWqp2xgoKriud+HW5REObtg/Ir5GEwzjAN6RqbK",
+	"BHBCA76gKghgkkRQLUFjH+vpFxpk6UcPLZOfkATnkiATWaC3nsxzduGjUs3XMLlfhqVt9KjYjyAWdAYD",
+	"q3vyCQJvsBNXVIlnKbxOMUOXjzcff4FSDuuWRAVzGCynTBGmjzGXXlSq443e2d+JVHQBdvy/QzNJf4cu",
+	"+opmGbGhs3ugMZJODNTzCgKUsmlsY84HaiB8gJg1f/UpU1LjGRV2Vn8wRB3QzubEouUFWYXU07J8k0ZB",
+	"NiVHNg68XPSIdzJpHICAuLR/ZZWwtqxyBf890IZZORqP9jmRr7mCv6OP3yKHR2Rf5YQSipuJ19HqVeRF",
+	"DcJg0++6j0G2CY2wnMCJv3+yw+phfwBXlkPT9XFd0jsiCy5WriT7EWe6/n+XzW9hmnUrL0JPM9up+10c",
+	"jv4ulrCgT3H5cCeQRKC3b4ZWGqXoElqaN1tdpRexuVujeM3mfmN/i2Y/C6P8LSnZI1qVeqNCC+89Qcta",
+	"19p+S4YbI1Ivl7a2rk371bCzpixqY1DlNnSKGhjGIzFN/vnll08aj958rvcszsSqSg0oP4x7hpS1DNze",
+	"sWnzXf2i+//QjALtCF1vE2qzmbUh9Fdg52rOheWyjapsO2ipccmUEE/Bbu0rrWOaRlqx0DyE0ZP1GaZF",
+	"EfIJqterZ9WlYadV4tCa8TVCT1rMVwEsTRMr3U8pEehh7hSwlW9Wj02ZoTzyUYPB9fYtA7eqc+e6zZOm",
+	"tOo31pPLhC/bUmFZuJtm5j0Jb4r1DJNwAl1XGBp1X91cEkHZlHcN59r1G1Ffpz1tFi1dE607J1MiBEl/",
+	"ca30UVQM0NqUGeZadU2toZUy/yssyD3WQI/pk4NNzRCSzIzVwBoBfj6PrOF89A6+aKE+c3/IfHI+evfo",
+	"BsJl1VBQJcDBQZbPISCoFcLYeMNq6BvlOof7ex08p9KiwnEO9/d685sOnqCHujFHCAb5zPhBCZKd3KCN",
+	"kuuRTAOw9Fs898lVk0TLoXJzxvnMOMt/rpSbpsnHo9sayjek2vdEF7UXh6H9nzg9tFh9Z8SuyINfJ3P+",
+	"G6JVfbuuFbQkApS1aVznblSIVnUooYeZV8KZ2LbGnTQiiDPGTbmHm5gkisagc5qsvOqYJvGsRrAeypku",
+	"hiMVXiw7qgWZnuDYZrayRr2glGTkOnNZfSF0X2e+GWGNiXp2kVEGJ14ZW6r2jr1DNipGKcKfpcZeW+UD",
+	"HfNlnoWVo4wBeROdEJxuaFNKzwrK3ckVFvi9C2T68um4CxuOjHnKfDaeXcYQZBRlc+xzaDs7iL1aNpEg",
+	"VmSmZROCHgKVg1+NzvCRN2iMrh1/Z9rbFHBuW0++iO0LjNSxQwyK8WOlbdnSsFL3+xhRpo2wlKVbhohZ",
+	"+2yDUaFkFolMyJwRyQIVpvUvJRlYah7IwgPs0oxnIyOKffcIkzVE6aQ5vGG36rkRFgioqIYpiwhe31KW",
+	"Gpuv3ZOx4pSuA5QHODg9C+FNnQ2xaCoLPb22ZFE2daKNLzIQGNOIl9LyyYIq6RgoqKHRHlBENHE5L9JN",
+	"dMjQHl6QbA9LsomOuCB6Cr6DgpTcmxfP5SblmskvckbVSkcBKkEnueJCbqXkkmRbks42wmwCOtv8RsLZ",
+	"pd6uVtAu0v/VJyE3NMjkDZw8/Nmkrcde0j7rU7LjR1lYQrW4EsGEsrik5VQdX2JDWSiRXcq/lCcXRDTJ",
+	"SPvwFaau6+C0qHa2lh4uHK5lm2tLifFtO3nRbjEmMb5J6DUjd/V0RWCQnXhVD+mpcHyIFz3iKSnH1Gmu",
+	"UYul24XGaMHT4gHiJtLB1bqToW1IOK6jywlk2aOx/fyjoIqEbXQwOjGNgLIvczl/FALLrsR3joJtgiWB",
+	"gKx46Rrgi84SokQO8pPuY+KeZGAid8bWIvYKovwsbTHhfTATepFTMANaWrSk+lCRzMUUJ4YIS4IIg9NH",
+	"WFqeBZMYf6P+JpgXbnsHTJl6N1UJ/hbya/DiSreq9GyzD+ORg1HD86/A/xXk9dTEZIxefr//GjLDFRk8",
+	"jUs+9+6zXCj3CPgtx6tNysfFeQiSzrGC3xYr/2vCFztfbG9vj9Hjr55sPv7y+ebjzcf2l593dh6/g3/H",
+	"35ewMxKpJlK7ABCBDa0BgRPOGEkMb+Kl21CLRx/bEd/de7KRmwfU84T2jEANqJcmmW90x3rQoEWalshu",
+	"7wPfoRKKNavohVwToywcTBKRocBb2abAPM4wI8379dC0vYDjCJ6hpe73OUUVRMIsbqTrugerxbqxB2Ff",
+	"9HAp+H/hzWTd2Q9ZwheadMHf4DoTiz7QXw0xRg94stx4gP6B3FBNcQj6Izg2vqSZikHscBqGHoGYYLv5",
+	"vOFUWl8R9/AGL7WUCOc9VvEXLZyinecXvLDQgwuyeoC4QA+8D+wDcEmCWXVD7YxCfYgJePn55bjVYOts",
+	"ix4KMsMiBScy5+7xyK/RuWzZgG2DTdIS6w29fO3wrIhwNU4nRCkiXLIxzBry5NyutnJJmNSY36iy/MuG",
+	"U3x+VrI2PWaUswY0IZaAlQZah/Yoet/yw3h409/mm/7uqqmGhx9NQB6c/9ipAPxyutApHrZQbWEd+911",
+	"Cr7KaGTjtfDR38SGS1ydtdcjLOwVu9TDJfgIl8BHL6yFyu7Eu1C64dlRaVF+cYRSVx2juyVh5CVhEL3k",
+	"XHthm7R6Ig4r8t5oeGMvigP7DR3ue413ZYF99L+gIYpLH29OwzrDWjcE1YG0tuJ8VIqWMGmIpW2eokuK",
+	"0YRzlSAukFguNrhUgrjsSQYvoa6STa9VGo5x025Dq3FSVF4FDTQ6oejjEk3ZAfu+amH3h7av+evYjfBh",
+	"PDreO/Gq8R/07V5Tc1frb+NlTE7ahCyVrxtnvMGP907cYZ5+s7vx5Isv0QSzizq2UZaS902B4Sl570Y5",
+	"3jupWoeePgmr4jx5GhTFiZbEaXOO9puYk/cbThXlVl5szK0ExHu9R/BZJlBVhCpEfssxBHWtbONFu9DV",
+	"4qRcy++jweT3EKMKx3sn/mhrp/eDi+0pwk/u95wajiM+YuMhVGbpyGQXwiwOMn16J4bpwCbdSbVejTWT",
+	"eQfVTcKLjtN0ZMpJmjhNQRb8Uv9DkQbn/ngq7l0Erg3HJizUZ86LhwbElwqf9DJxCuFRdlGbNYhC5ZPG",
+	"otVVaeOYiIQwFU1RU3xzSGlW6t7EJeFjWTQ2raIbPPaR/DEgFXH+xhFcj+sKIvqjkoizVstg0bJZdIuM",
+	"ah9956MZUecj/Q9NPs2/jHeA+bdhKObfS42b5p/GoG/+/XdrmQC3CT/Do/Ued26DTVpX87VYti19b1YA",
+	"JfVlfTWum3zUJy2bXcA4BGn0ivpziwvvHurePFKctClFi0EuqZ9l0K552HCwYorAhai3bB6gZ6erT7Cy",
+	"GEy+z3GaEXXrtY579juwRcfW6KLj2tdpHwlZ6V+0szXpatci2lMC6tqckQPxslF64i0Wb82j5X4zibUs",
+	"JK5Ku14ubdA2atcm9zLrvPClRFzBrHFomvLF8XwUJ/6tCZTaNDUJKeJZY5vYZr1vufgYFH+yDjOY2fSo",
+	"wKJ0e6dP5ZdEBNnKi0TLUiRbIIFs/lf2e8KEdqnovv1XxzMdjlQSKVeKwY+dfa+/laxaFn48quWgHo/q",
+	"djTzWxNCFd8ChYGu3VkuK8+Fz1Af5mEOyoKHOpeR16TqR//l4wlR+LF7T4dzjsovduOW4kbd0POHOqrQ",
+	"6SAw7IcG5ZE1/BaHq+Grx/AFQH0BDw1UMDMOysy/kDKzQD4XkVegRs9+pv2amiNY27sGCmMGjgtT5e9l",
+	"Paj/Zj2F7kUNKiqT9pK0gjs/6ED/rDrQyt1qQeVaJsNyapAy3+yI+W2JefU+cZbdttSSCJpqltHsxeQb",
+	"3jSYN1xfZ+3EcIVdjUuL7DinhrrY1RahcBBWlMITniurKoB2oMAqH18t045nvxGXOwHXTmHVIEP1IjZF",
+	"sc6uV12wmjigDFHZzYhQJ3lGYk+GYAd1gXZe8VIpPrv9YT123P0lbwoA2LdfvMxJF0bqDbK+4UsiQCEu",
+	"rUKHT2z6H5vMFybWihv0Es5zp718eHdh8HJR8HIR8PPz9B/Ndb+XLVqpM5Mb2X7XUDM7MolABJ3NiJBR",
+	"SJrYCD0+lDKiatXNpYLzPrWdjGtwBXH8iMExlfZR1tZ3IldpsroLn/1awxn3pPgRC2YeDnuCQlojXROi",
+	"XMCt/W3RsJZi4MYmwYyNbcxSgk1/G+X4J56Jax7nHYu0xUdve/f4MNz0HhHWI4qc0pleplMbj0cHTPAs",
+	"WxCmit9MJb3RePQyI8S9n/xDxM19umKaCZyRxTLDihScULtXOMVD9OFeyfhh7XaNrGvv+G0jAVvmsfQh",
+	"49E+lReNTulUXsR7mdQqTf2aE6/UOVyYEaU3o2vYTRcba1tXh3t+AyQ+vCtf4lJ+l/oBxoWY01pxKzuM",
+	"Cb1q1lNjx0RiCXdcSCM0QkK32kRvXCY78+uSCOToDsjFhjivIYNXuVlEFJf67a3TQDFFxCXOWpjPhKgr",
+	"QpjbP4KuRN4LP/n58cZX787P0783MZWWVD7j8CgiO24j1kAdGumW/lrWo5Tim/RRukx3pkaHrddSKPG4",
+	"KX6nePGgMaYR73RyXZ1Libq1aF30/OFj2qjqRltuPbKsLKyoa8YjhcWMqBNySe3CFpiyQQUzqGBqdEjj",
+	"4rpKmKDnbathiqH3bKrBZkOByVvZWVHDNJMmqVWaJy7MlkoUzmcxYDNqktcHTdU3WEYU5vpXJxOa5IbQ",
+	"OP6auBvbRgRqzdVROgEGrSTEHeVMEbE+wNpsHAEox6UjLC2vCzugnPQ+nU5j772UTh3BB9ouKZtlLg2m",
+	"Y5hAGuxb1yXKLMVlu2d0kyhhO8cP0o1ccs6wmSV3J1J/osGPKOXEpM80teZWRG0afJEGiLE53Gr7znHl",
+	"vOyNR0W62ek7YVNqO7OuGbtf2fGuA3R61nvSlpqJNVtdW1I7tbx40Jf+SfWlxTHrCKqG+2yZCC6TQHOl",
+	"TMpS41JhStULK3mZRNSyYsmL0BIzfLvbic244K+xTZ8xdkeKNLewKS7QW+bSOhfdsSAuSe61boEGDyPp",
+	"HgwcjWQF2t4AQfiG5ppbGpWiBZFeXwZpnOO5Yw07ilRNLcpCmSZoynOWuhiiAtyuzCCkKtfBbiYyyHa6",
+	"8pl4J7YwLFmzoFNV2I7t3iCA3bH3ElgagHaT1MoUY48wHjx9UDtw6KvV7Hb1tjUWw7LMSzocoo626++c",
+	"mwPAM0yZVOVaEKboWTiiY2rVVfTyGWjC2wizswKCJ8cGukZ4KN13vfhYIL5q3L75VtuFRTuFL0iR/LmQ",
+	"NJxoZ674aGwd4EY2BQ5ZU6MYAGEX1rPnxm1s8XaZdrTYtyuBGNHptKlGCMnSEuEysNWsjVnFhPUixoIg",
+	"QVKcXJc8FTJhb26tl6i/FHaOlor3fRN2NA7Rkqdj7JDIArPrOjfZ9sotAuuesgVbHspHXsFhkuW3Mqa4",
+	"eKj3vAS/IC0i62GB91AB8ZyrOgmGqE1ctDC5GIoONgLU5sAw8Z0x3Yuh3IxrIcf1pkRuogOczM1CKkOp",
+	"eTiAXnCoACpKkZRclEp6ljC30Paz57fnLBamw85NoknjZMUSVOBQR+n5PiTYPRQ8Ga6fT2n7oCwqb/zZ",
+	"s+6V2Fdt3+saNemI0BpQ43q9XhbN5tRqm/UMqu2scC2TKr7ei6TFpDoeOcviXosMFqgnnCBmJRG9joZi",
+	"VG7gVy3J0PzgQa6zyNh9ChZcwzLssamUBmRqDUzd+ehlRLlhIpJkWUKBIfWjulSAU4uV5ktsBASGLjlG",
+	"GCVY4YzP4s32zEeTYc3+ATEwpnRNJTqtfSo31rgyUOiqNEahLU16HYjJIafB4/06jc2upHNywLVbqiRV",
+	"vIZ4os+vMAmWf9/zk1S/lOI6Q+vRR3IyLk0eVcExcvUmnotOT8vIlamnhh5SXyp9kpmAd13sSv/hMmSo",
+	"eqoBckl5LlsmcE1uMIt9bYK01aJzKUl+RHjFQ8F8Ch7nqZ+DJKxu5BMaWpWx+c+myxvh/lbWSuz+XvrX",
+	"TPQEWn1RSqrK8k6jFKipFkCd+zS07FED+eTlHtJ9Nf9gKRYpJGDorEoMNzpMNmM0FKUkE5En3TVL8bpq",
+	"DDGI503ZEvzOYptfL3uCskfWUDPzRBs9c2UomSllF3fq8QLznF+BoAxtbYFH8zwWZqwur7gXOk7p1OYI",
+	"bc4OFjaq2/qlEliR2aq/ob8yYgswXpoS57sNoPhR31zFUcpNMhCMJnpoK7+YIaDguM2lYUoYSiRzk2pO",
+	"zQWRc6610TpoK5c6CFjmcklYapDXDjJGGcGXTjHqIF0QDpwJgtNVoeYyBETTyoqDyCbUk8wyXeXwfISK",
+	"YMVsZWuT6XG5DGYxaofKOIXsYlKaFP5awbL1dRSgaysXtLRbDS8H/DQa+7X15Y+Rczq2Q8W+nfjhi0M+",
+	"wpQpwnA09We9DRJEryhRNgOM3u/EhvKWDt7Wv/Mw2DXNwMdNv97sB+0LlxFXCVCPtggmu6Is5VcSOi1J",
+	"UbDMWU/0ebu6YhhNMpxc8Nz8vIle2GXVEcXNXVRjU0SIfKlcZTpsZ0ZJ5nh4mQq6qfaximll4eex42Th",
+	"jsAJ8HfOyFijqXEEZNzCpgwzRC4J08IkjsDEgaTEHUtJi0fjHppJuiD/4azzWXbm2n0Yj+yZxAl29PDc",
+	"Ph2eVFCjtyqnhow/wgzrxYO75bfQvfoMPS6Gaem5NUaCaCy1UbiUGxWSPv0aPNruTV2X2Jlqfk9wVi7p",
+	"1eyj+g2/Qhm3lNViliky527cVBFhys+axSv43ZZmdQMHGbqKMr9Q37fVuwibMXNG1SY6zZdLDnjvfwSd",
+	"3w76Vf5adkL6dfFr2Qnp1/mvjU5ID/+14/2QHv3r/Dzt6YyEVeg22oIuxzyjyaoRR8xn5wTsjnlpfnVG",
+	"2YzE6l/bt6p5Lug7yPPO03eOX3DudWmmxwWrykAfQIzR5JFy9iJPZ6R7EdX2+oqWGc16N92IN5aZ9exe",
+	"Fl+0+4oRPM6c3NEj8Ng5CsdNCGaeUyeCRSmiE9CMpwmntnq+ORorPVqVWxk3QvmgLLPFROhTOd+D6Iw1",
+	"85PslUI69MpOT79BSmAm9W2MKHwFvcSKfEtWx1jK5Vxg2eQP7r+b2yvnx75vSdmjG15xkY7uO39qaUmd",
+	"+XXtzgFAF723EEOcJg2k+d14Bxhx1HoHaPglOMusMJNy9kC5FqaicJAs/3Y8JhKfNrq0wnw2I5BhGcJM",
+	"7RKSImk0deWfx2jbK8KI6pnZZHCZuFWXCShufL2Al0KxbeDock1EZxIEy3hkzQInc8pI41RX81VlAn3Q",
+	"Vm4+H1kSfj6y67H1hqksSm4TXefdlgimJnVUqKkvCnXvohNYJkoyLEwtBRctbTcLaDzJVeFsxS+JEDQl",
+	"qMFdT7ZfZAvLAnjoDbxzdD71U8OMzkeIi3Cnd442WkDbwCzdsCDtFoQinjN245ZMeAwokC4mL51CdgCw",
+	"Dl8SDSLSrF+d09l8I9ObAkkQzOSXVtZU1qvQcUf4ZlaRcZya5zJl/metgyCpNaFeQg1mUPuX/gzFEz3S",
+	"VAsJ5pMtl93zUV7f5a5bSP3TSbDi+tfDYg/1jy/drhomdBurf94nuL3BUQkWsVUH0Kl/fuvgVZz5AWTL",
+	"7Thzk1K3HFgIh6/ttuGBm4bpyKeH3hA5szV6MsouSOr/EXzBGcXGXitNC/OPoIWemSZGbedmoMzYkUe+",
+	"2g/8DBISNVWhJjgNsGQ8Wg9RAtAc+H01fjvxi603+c5tvelTW+ddC536lyMHr6ZPbcOeOpDWP+0XQK5/",
+	"PCzAXv/4KjiICIIFR1P/+gLHe731xxeBveYxITp/x3Hagcz6XvdAZanyiUZWjlPYDuNqA9zUDF5tSKLs",
+	"NQU/LqCwYhag73Xpk9/CqVlB9efv3IqqH15z9dIusPrpBU5P/XqrHw/s+qu/H7n91D5U8M5/iNCXt4yq",
+	"Qqque19ZytQlAjdwqGrdqyjDahapXKJ70HOUHCkgUf3pN+7FkmKy4KyXSwkpsLPnpqok+IPBunWGKKM9",
+	"vKgnvn/sClwZFj6GrW/oTRRZvQs27sEhcsYcNy7KkD0rx5Thjd+3N77aePePaJCynii+Gv0lyHivk7BJ",
+	"OU83be2689Gj8mLCj50yEkxbxpLyGYXAHpdQMoBiTGiqhrjW91ZuUI5sC+uCIWcHvb1H4vBe+yxiuSoo",
+	"sl44V7Xz7UZ0VUaPZ9eJNCqn2Kk0uL80O7GJexkzKh2H+JE/bfxI7PJ1YXgt806JjlvdcTM5N85XcX9r",
+	"/QldgY3bDeCKok01JkSDLCqwMOP32aynMP1ybFrTg0sfcMOkNAZOt+M9GfgMNcP1gUS+HZJEc2BVpH6B",
+	"fziQuwOtlLnttVADJR+TcUqiSYTtPdxVLTVwsbKmyNLa9JrAWTNIUtmnHu46zpm1MtNRzFnPAbcKXIhf",
+	"PAtM3IXv53fc5EKprMEZ54MiT9JaLE1xwN3Xuy7R8u7Jwe7Wd2/2ds8O37we23o2+seyBKbpGdXnp/V8",
+	"PCGYGX9N19MbTHXjJRaKJnmGBZJUnwRVc2q9TbAgeKwnR1ZGRbsLImiCt16Tq19+4uJijA5yfWO2jrGg",
+	"LjNFzvBiQmc5zyV6upHMscCJRk/viGACK6S3vj48H706OjNZit+e7Vm5uEZQz7RHVhAwtE41y7AGjvCZ",
+	"X2J1/H+hERZYLn9WHFWXN7x+DnPDO1IyI2yDvFcCbyg8894To51g4g+NZpDdUlE4b/4o1Yr7BX6eCcxU",
+	"t9tkz6XxlIz5QhMJrZBw6/vFWLpiLp3H3+4dmPW5Nre5Fj9xZVGw6V/inoL28KBJ3UnQKBZ/AdQYjUd1",
+	"gI7eXW+5wZIMnTLqpV9yQRvX6BqhtyeH6KEjba0nDeUMbKEwCA8rIYrF9Ue3dQbhLipHUIZkJNYBPts7",
+	"aAq2Bh1uF21LQ1fWCcW2Gk8Avt7WMmCw0vQVhhXgyDggA1E5x1A/ueRMkpuRPztGvHhv0/nZMbD1pNSN",
+	"mmNjG7vDVyAPzZ1/adV8lQYKPjXUsllSQeQvNKbFAGhAC3NXnKuUdfqJp92gaSOADvf3dF0cA+WH//7x",
+	"7NEmOjZs2fj4Gd9paGdL1BJG0wLlIlbO1ivliUZws6LjwJcG6mjAUCWLLwgW0XRmMecC4y10msxJmmeR",
+	"KfadP7mWnmwrR9P4AiuaoJRfMWuXAlnFlusZW9Kmf1Z04b762r7KeCjdjs8aOMC9Ejgh+4H3Wl/Pp/Ud",
+	"G2PeX5E1xIiBztGvc+ddlx5oFHRjNBOEhqt80H6H4zGpL3VFbv2psy5p5Lmjl1qKNbq9MmtLeIQKkv6S",
+	"SyLiaz92bZBrE92EzCcx9xWjAinLjD0uVaA7Kp/KZZNaVoe1B692uG1Gmdz9vnaDxpDth8WtV68o72iZ",
+	"TzIq58dcqBbF15xLtaH4xkwLNKaqtw1tkN7e8cORjbslTJcJX+RShY8p+446H+mx9HQ7MJj+l/OKqH/Z",
+	"WgqueMKz85GtXHs+er79fHvn+bbrZP/cUsnSPl48boZ2hO2Nr979Y8f85+HWQ5Us/588Xf4/MlHLR4/+",
+	"9bdRn8ig6ul8MoU2qn44PxyhKy4uwCjpSrRNTIncl5CSbk9lCM8IU8ax94ejMCja+iSnJKOXkO6JUHA6",
+	"w6YM996hKde2hYWiU5zAUxdLRGGhLn7MPpWYgklecuG+u7qm0gR92/ptBl1clDZG3+YT8gMVCun/yXF2",
+	"ZDyL0E+7R9+ZwG5NClJ0udhc4UW2OaqfzsgURzmK57eCnysprk3Klkvo1jf23Yyjvzk/JrsJIoygYZ/a",
+	"MDhfegIaRJcTlWyxGWXvtTZ0upnuCH79pEo/YpXMDy6jmaiKb1albPJcMB4UsZZKELxwDtugcfdaYRCk",
+	"tGMiSc3ervSAX2sZvQ4uu6T2yklqXpm+UEsCzuwffHdwdrBfamMjSgoN7djkbNDCiVPRboLlDzQdxKLf",
+	"wcnJm5PKQKAJBVA4Fy5YdBSb3JobLXNvlvi3nFgXeMcGqCxN6XAEAGdhvYm0ay2iykX8V2ZCv+VErAJV",
+	"o0lEmS9IMJRx3K/Ntzm6ZmR+gSrRuHzlCtSUYdKOkN3htTbRCkZFJ8hKILTx4sWbN98e7Z58ixIsBDV1",
+	"I800Ri4FpEgvMUtIFI6bFgVc98qhwyA+Fs0eTSCV7+7vH+zrxL1v9g9fHsI/LXaOxiO3Np3lWE/S0zmj",
+	"DJvd1LhglH894ikEWNQ+mIQr9d9fcH6xwOKi9sG4ZGg3ih8tcwizNbuCLv0l2YZRyjUEim+GvBbcQbrI",
+	"PaO4H5uq+p5RWfHpgSxYmaQzRgSYmoxhw6mALS/RZiXOgDOM0f7rU1cPn6Xo8FiH/wkipY1IKpLAmuld",
+	"oLtubAYvbAB14qa3ddyYCwVPJM9yVS6WZKdRHOkySObuHh8c+fqNIaQaUl+Z/b1uzDsjrbwbwMEJo8Ho",
+	"0aFTJl8brKw9ID0cFXfKLk2p3GQ4g7K0EPUD7dbLllXoAE7N07+JY4AuzaQ3pGmQdz2EW6BAALnGIAyc",
+	"NyBXBSGBh2Ek51xo6ulG7um9PhM8b6hpCZ9iC9TYdUFWJtPwOIieCkQse24CsRw0/uhwvxpTKjhXJqS0",
+	"TmRm3HreXNDlhnusb9jUjUahplUpy113HepbKF2WOzn1C7K65evjwjU0dBuuT7+ETcFxgSPTb7lBFFpa",
+	"B2QWiKuNgCTyxQLH7Pm7cDmxFVdMzNssX4D1SA8PQjkSOZOWpevdmtxrOsC5tLjQOKQ4WujkYTBGIIGb",
+	"5WiHduSy7mHjUUDekyTXF8oolbPVGHzg1VzwfGauBcmy9Y7V3LcWAmXuY7BR71RQuSjaJUH7K6gKOzAy",
+	"KPxhPR8EYSm8UoKTcXHc5oljHNZTbR9rzmIhm1JkmMwYH/UuWy2MzQzW74pXBDbbN+Ag44KJFfexLsDp",
+	"U9WhrVSttJLRsogJqCi1CrT466Ujmf/+8Ww0HoEkCRFY8LXYH1T9MXqnw4YcbG/fxmucG9Mov2JlAW8T",
+	"oSO8BHhW8uLI8osAsJlBSToCKaUMSdBL0ar/AqGX9FtiTQaUTbn1R1DYPGjIAtNstDNSBC/+rzDHezHi",
+	"mX9qoz3OlOAZOiN4YVNS7IycU0ypd9XxdPRzeYh3D2PdHtnHnL0gJtxQR70Y6hzUUudTI9qYdD7prMh7",
+	"YCPtqfDCltw8Z+BWnxCr47Q7213iZE7Qk83t2maurq42MXze5GK2ZfvKre8O9w5enx5sPNnc3pyrRWZU",
+	"tgpevxUg7R4fjsaFmm3kkuZ/gAK4DC/paGf0dHN787HN/wXouKUNb1uJj4icxfxhXhFVSXdSfv5vhkV2",
+	"D1Nr97VhluOR09TChE+2tx1O2Jd2QHS3/mvDo8xjqtMDrZgFEK7yqvxW7/3Z4+e3Np936avNpVcCTMDB",
+	"haQw+ZOv7mHyM87REWYrZL0MjNOhMer9PCof3AgKFJhTr5Qnbjx6SGbVWQRZtwrmsmrnOGq8Iuo4mPwO",
+	"UaRS3DkCvdbyznCI24/v4RDfMmcCJ+lfF2/Hoy+2t+9haqiOok1xxq8TmQd+v2uj0dqxtuidKdupfIVZ",
+	"dCz4e+o1LLBlJ24V4K8SWqf0NborJSi5NGXBQz+v+C1zS7jL+1Uz6cVQu7La4VINl6p6qS5NDnLSeKls",
+	"knKi5dTKFfEeBPUr4HqNyo6pP0cfz5FR9a1zS/Mi8JzgFMRyJ9eFvkujcQDHqiXi3R3exDaU0DuBbZir",
+	"dx+TvsCpQ8H7u+9nNvtdsdfhwn+iF/4Px9j0Jfqw5X2FllyqRp8hZZ2frLUjwlpDV1m5Bnd9eLx7ZDSd",
+	"4lHdcdF6rmqDPGg+wVvUmifjhOfMOma2Up3XgfKshe3nsqA9S6N1sJQnhOEoVFsYXU0HIQIgveDp6tZQ",
+	"peTrrM86HOr9xtXV1YaWAjZykVnt47XH/lDd7oc7pK1lL8ZGwiN8i9ulsp3Tl4htn+vnEKf54QfPojCx",
+	"cTnFexnjdeOwrezC/F1WeMOVzLPGYutSyoOuz8dYmeBfo44tlWqCEfQA4AqxALOuqjZ6YAIMcvLAVnGy",
+	"ammfyBeeuO4Im/RdbpBWNj+OFMawqXatCRoyQ5Ye1iZDEEldgiKrvaXCmtfKyk9yScRK6cQSTQuFXqdB",
+	"gt97Wi3AVo4dddS6ZoMrXGgQXxD04OsHY/Tga/2/Wnn24H++flBEGl+Q1eOv4dwejy/I6sn/mD+eOAel",
+	"yE5hxuvt1GRifE8X+QIxX7bNIZ7fJGXF5j2CoDOPkuiKZhmSRLUiWqm79n8vYTnkCHemBtPf4q+2welr",
+	"XEsaWFwcU5wtn0hNA5gyt6gRM+iCqhKcaiY7C5PRzuPt7W2IFTF/bkcSzb+7YwWfoylN+hur5vvzCrW1",
+	"R+z203uY9SUXE5qmhH10SfY+dntqTQBvmVcD1hjp0lfL/jBuEFNNNSD9RI1yzjrjNB3CxqO7kcxKU/SS",
+	"nh7f4dwxqLlURDC98dApddz5owK7tN6mGn5qKd7fPNGe8HT1v1vOsrUF3/WCXhHVPtmMqNuZ6YQsM5x0",
+	"bE1EGl1zxg8Dcbxr4rh9H8RR27kymqiBHMfI8fsNR2NHO6WvclR78mz9ASoHQ701CYmF/mRkLTq+30WL",
+	"fu5ynolOBFUDYOgGBcD1Hv73roEcZLT7IEPP7mHK11whk9VsoEMROtTsPtGblLwi6k7oyIyoz4GIdAmL",
+	"AykZSMlf44Wp1ZixCsMQtNKbnED7OyEosMBbJSl9n70bMPU/1vQE0n0+kv1gIGp/TaI2vAw/PhnNIxKZ",
+	"Sf2wBhU96VTIXJ+OmqQRH4WQ3qX+8L6p58fQWA5EeyDaA9G+d3VeEEskyCVPigQsza4MQQRa0N3WCZrj",
+	"S4ImBFw4LvkFMTFm8CvjCq2IMhmXSFpnDXr0II73JFjQHdLE6IxdJtLBWvnXuE8hgtMZo2xmRYL65Wq4",
+	"SpVbVh6l46aFQe2mn4VQlwdRY8fBnWhwJxrciQZ3oltkmmUCM/gWDdz6E+XW7Y5GPZhtk9NRY8878kBq",
+	"nu+e3ZE6FtLTN6l5lAZHpTZ4X99raY1lzIi6gzVYzdga6xBdPa69FqPWaxx4d6mfkTirLynv2XHwwRp8",
+	"sAalzU0emdWXZPtDs4erlvm9zAmRvb6ooCgxd62+FKhTtd/NhAdHroGWDd4Xnysxi+q6BMGp0SP5R3TS",
+	"QlBqTl73TH1uzf0LSkf+lpNDk9vMpMz6KK/2gUANBGogUN2+YtdSEkDfe6ZRg0fZQBQHojh4Kny2ZDiP",
+	"yomg7qqIinu9RcWT9dRlt0SKPwuntBuqlD8qNf7oGu2BIwwcYeAIn5MadAsHBoworzGGCkibnRK2ahP9",
+	"6xL/22sZQW7AbxRHuLzggd8M0v9A6wda/2em9QUV10TfuCTjRK9AbpniVM1pEE/gu889P8GSpIgz49NX",
+	"uNlhlm5x6zvnf40FtejRTJF8eUdeH2Z0M9NHIpblJTQn0Rvo5ODsdeckpHTfddGQ9xtigk39sMSOYd7e",
+	"QZG40Y7t5ynEhyq9qX73pKXDWdtcji7P7IJGDG7Ygxv24Ib953fDjqDPhPOMYF1AEM/C2mamfCSS+WKB",
+	"hS8uZ6nPJvrRlJ0ypQ70u80VIDIQAyC76rUwlP7sBgtrHKA37usDqCz1wCBa6Uo8KMAnTRFTg7C67Kpe",
+	"xwM7sB7qAaISuQqsMZAGbWMIaOERA9ZLmukD9HLaCu39cAAVrGAPBgWl/34155KgN6emOjBK6YxIhea2",
+	"qGmBHZd5xojAE5rpWnvoSNPFCUEYHR2enRxsSLXKSFCyGz3c++Fg46effvppw6BQQsZIX0m9mo0n20+e",
+	"bTx+8vTZF413MLkkh2lp6wv8/jvCZmo+2vny2TgsE62HhBrRfzz74P4x/vC3WDneWtG8qUWMC0KWLmE3",
+	"I8AONZlhcNCmriiCYqJj5GqJwqd4rVudxRu4hqZWDtRY2mTlG6f6SkGFT4kok4rgtKCBFOrGZXB537KM",
+	"SFmrLUulxupxUPMUQRl8aYsjMrPU0qJgTUDlqyuz9b5d9WBXFbXpZKB07ZpICagHmfGR4jMCldZgqQ9g",
+	"tAebyMjIEuFKadygNK/HLl9ttAoXPkXYs19A9oTQS5IGlWk30eG0MizUpM04mxFRVOIZBwKCpRipBe+z",
+	"x9voFWfE1d9CSUb1gYKsoJkbFioo8qv78FwhXCtt2wDgSrOPVtXBSF42PmU8UuS92iIahhsG5/qPVIB/",
+	"ePx8pMfP4/uArr4Vw1PLP7X6BNFUHkFNETOm2Z0qSu47FiactUfgS8IXtjKa7RiJdam1uXY4h/HSbp4p",
+	"+HqTEJqmCWZE3dro32GpTglhLbP4Jjefzd6Z5rlsg5vMdGKLx7ZAr9LkpiFGTTOJ0ufbmaUJgiLSaAgK",
+	"GoKCBgtJjefG1JOhXnKNNMzdDHq/mRl0Gqgrgw+hOgOFGTzhPwsS05xtuZtivCLq1sjFZ5JauVnYH2jF",
+	"QCv+7CqA9hCZTnoBDW+NYgyRLgPVGqjW4Nj2CdLJtnzJ3WTypEUZcx1C+VnEoayju70/wni/euKBEg+U",
+	"eKDEH0GBthUsU279gZdL+3PhU6ywUK1OxboBwgwFQyHOkJpT56SyiU6JkgjbPzcyckkyZ2h/RZjlAYhf",
+	"EiFoStBDylKyJCwlTDn6Hgz/QA+cZFh3uzQ+LmM0zQhRSJHFMtPshgskFWYpzjhzDkWP/o9zEFGCZ2iZ",
+	"Yab/Wixzk8yZIEbeKzTzKxp7DwE800uxS5bVBaFcam8M/avmGhvgpb0UVC/E9kGe1RlnIqoQn4B3ghnN",
+	"VKU33l4eDlSaWYyjtuJLBwxhrSOlRWg4IKzsR6Towng4yFxcUj1PBURCe43kSo6RpCwheklUIqZdTJBU",
+	"XHiXMlX2y3ogYSrrj7QgWHu8TPMMXc1pRqKHJTVX0weiYFPnI5Ez3et8tHnOYr7lGmSGb+wWQ91QJLgt",
+	"QWDcNW+w+7EGYUqmNHAa9FBsPMWGldrrOeiEBp4+8PS/GE9f29m/xNkzOiXJKslanP+b2q8tM3RIDKfX",
+	"lRf8mu5eTjCFHT5x7ntY2y+sOJMcaRdn6wxqZgW3R6qk/qJHX5pTQqkJIADXUjiAEuu6mtNkDguyK1BX",
+	"HNljRldYIiplTlK04OA3mRCmtJM1viASkemUJCrG3U8H3j7w9oG3D7x94O2fIW/nyzbWzpcDZ78xZ4/y",
+	"TL4cWObAMgeWObDMgWV+WiwzjFpoTK6kd57mVjtqBjC+okHful9qRzjE9bxTi0E/C8toCIXBfWSg6ANF",
+	"/0sZLcvkNUJ+MyyVtNFRjT69kGwBS4V0S5DgpcKLZYtk3ODw2xBodU3H38Z1Tbm4VeJ8twHGDiYt3iTP",
+	"6ufymqM9u4iBlA7+w385wuYJV4SouadwJ1FzDZ1+JUa5WkMpb0K5KpO7ZCNFuoo7VTEA3bxg2qLhFtKR",
+	"lwEan5Tbjj5VbcFAMwfxcxA/PzqV9pQ4SqV1vf8wW2ebo5xui7BOWBV0cCZUxUPNgORGT63mZAWZqIL8",
+	"NklClqrIoCPN/mLO1HpCQ2X2wiXekP6bfFOlPUBKOD3bZ6FQCIBx4qvWB/Ep95R7uZh7KCA9CMYDyQ1J",
+	"bo2sRoiv9Fk2WgVk08wkFuv/zI9m5xiiewciNBChv1h079o0JIj1vTUqMkT8DpRsoGQDJbtJ/O3ahOyk",
+	"M13ZEJM7kK6BdA3qvj/R29O+KvV7kzDtyLkgTCWcTems9alZNC6lnY+9MA980z0z7hpEFfeswGlqZkyh",
+	"nI9TMQYPagge0YWEaErScahHtCn15yS50PUI2muw2cz7Mj4J+MhS6/6bYEl80n/qzEe2mEIVIpvokIGm",
+	"lEOecd3XLDKAcjiRqakAK58QRBZL1VjpIJHio1l8agc/UPpBSP2L0N3i5jZWPavR2zIRFm5PrSWJijtW",
+	"JYsN1YlqHYZCRUOhoqFQ0V+jUNH9cHtLWAYz31A68BPjv+2lLVgLN20qc1HrcUcVL+rz3HPxi4YFdNbB",
+	"sFW2691r5QJwU8sb1sToMXXa0PAmNR96TDsj6o7nbClu0dT2pjUheuxbNLW89bk7SlPcMgyGKhVDlYq/",
+	"9ktWBMuPvGXXKGOxHjPe70XAO+03zVMOhS4GIjVYVga62EUXm6tsrEfQXhF1x9TsM/HU6/XuGKjaYEX4",
+	"C2kxWqtzrEdnoNMdU5rBm2+gdgO1G2S4z4a+tlX1WI+8nvTTdN2QwH4WPobX1GB/FNr60RTnA10f6PpA",
+	"1z9FneWWMU/hrDHlmbV0IS5QStgqyirqHGK3n9XrGhxCcYTLS/rcOMSuA/nH5hRuIYNeddBADJS0k5IW",
+	"tLKdpK4f0nxzJer1AnsGVepAyAZC9hdTpd6I9sQVq3dBfQb16kABBwo4PMP/DOrVG5Hck3Wc+gaV60Bv",
+	"B3o7SJyf2tM5DMi+1CtpfB6fECUo0fV4sI/1Ml1iFXUg9s8M2BXv95cJKTvlQiEuUiJsQcAixGuyKrKT",
+	"l8P5HugxHqCHjFxppjClQqrGxcHgpUXZCoQQdCCT0XhEWL7Q6ILhL/jx3fi64XDm/M256SNy8WxdoZK3",
+	"HGc2/ovHkOpSlZrlowtClq4GNyNQt0XfBwaoL5UgeKGlnN39/YN9xLgqZZM2kaOIkSuzR32Z4IARlugU",
+	"gLNxqv809xpRJhXBaXFBdQdDGzbRW5YRKb0MY7NBIyqRJMqmRDDrsSW/oYRm19ogElJPU1nglGcZv3I1",
+	"OV+8efPt0e7Jt01AvtKdYxCecJ4RzGIghlrclzijKVJ8RiBxAiz5AYz2YBOdEJkvgDrCLwhPAec0CnBJ",
+	"YSM0JUyZGE2bXrYKH8hB4VAmW0HVT3pJUvQjvO/1Zn1l0mJYGSawdcxhHCC1xbvUgvnZ4230ijPiy68n",
+	"GdVgBPx2BdX172Ynug/PFcLV5TYBuNLs42WE0PCycaHjkSLvlWFyGwb1+g9UQH+QKD+SRPn4PqCrL8Ug",
+	"TGphEnC9LkDqn420CEUZO7JFvNRtujJEvDQDDVkhhqwQQ1aIv0JWiLr4avNW6RUtFlisynVbpYMHkJym",
+	"ReLUFmCRp2aQNQW8tWRoEFLH6OjN/uHLw4N9+LR/8N3BWUV0lSC7emHV0MxPR5wuL2yQogcpOiZFAIMe",
+	"pOhBih6k6DWlaCCrPTLBVATlpuQv0OqOEr6Yse85yUswaWdiFxNyb3o0JFRx8Ll+QpOG4WdE3dLYLQlS",
+	"wu/XnkeT6TNbKN/yjchsWaxVdU6DvGtkQ2kAngi/3jTjSisQRb3NkFllyKwyuEdUuVFJpwM/hzqdrT/g",
+	"vx+2lCURlwEhiSp74KHqWqPLgqLUtT0dZCfqJsGvmHlna2G6Nk2DU8Q0YJbXrII56JwGndOgcxoykXZQ",
+	"5ApJG/KQDnlIP00eX2foPZh+jxxq5neEa7y5IW9a5cLcWAS4Owmg6qTZc+YhOdtAkQZPyE+ACEZfK0Jb",
+	"WdQ8lFM6CdcrogaqdZ9UqwrtgXwN5GuQ4bpkuN7pbjstDvuNGvXOSJby0EMm24HaDNTmsxWWIJdsJ7V4",
+	"RdQtkYpbzG3wSfgZ3bljxkCrBlr1F/SnaM1J20mvoN0tUawhH8JAsAaCNeRA+ORIZFta2U4KedLstXMN",
+	"GvlZpC9YwwXu3kjivXrbDSR4IMEDCb5HPyuf6dWtUW79gZdL+3NifoE4Ar3auA/xqf6MMEPBMAgngktp",
+	"ozzM6xYluRCEqWwFZgkbO0Glfe2iU4hMMX9tZOSSZCijU5Kskkw/kMGrBz2kLCVLwlLClKP2wbwPJEpJ",
+	"kmHNRy6NfeURUnOsEJWmHUkRZ0jxpest9GCCpKXl6466AcHJHC0IuLzYXWBlu0C+BOOcowfPFV9gRROc",
+	"ZStE2ZwIqswm3eMe1vFfHr7xUYaV9tU61PEi1hqU+JkyydEcS0SV1CBD/JIIQVNikzdQWVrzQ0kI2rKT",
+	"9T5aDQiBNjc3zTE/GqOrOU3m+uAchNQVR7YDutLLkTInKVpwcNRJzJEqfEEkItMpSZRdH1Z2J7H0HIA1",
+	"wBB2iyXejM/fmdqmOm0A1DHCGuWmNHCAgpN9IO3mvfGrYXn2TD4ZBfTwRBr488Cf74M/A3ue4ASWkdi+",
+	"5qEC1KBqeCvRcs8aRx/ifL6x+frsny/buD9fDsx/YP5rMn++HHj/wPsH3j/w/oH3f0ze31GRADwVi/y0",
+	"ZZ9Fp5qNW+Kvl4T2Tu3xA+kcSOdgCr9fU3glwfUahvHbIiCDeXwgYgMRG4jYNYzVNp/DmhLQSVcWiMF+",
+	"PdCsgWYNNOsuojOCdPomI0KvdPopZLVOlM9cYPr6LPEFySuI0mpJmvLuf2dm7kH19Cg2mYCndcIuzC9C",
+	"8EWTM/QFZWkr6XPZ5o3LdK9M87toSjObaKO6Fq7zB+oF+RVb1W6RTmNGLwkz7X2GiDtJP3ELqzSZF7pW",
+	"eeupIwp0M+v92On7r6cYIO/xYpmZHmYjB+YX/YN18B/tjOyPfk9wqTJ3QyB5hamecUkFZwvC1NdLwdM8",
+	"sVpxQWaUs69zuUGwVBuPR+ORokR8PcHJBWHp6N2HDyEg2ogO3MshPcSQHuKjMS/A+zrzstdBcy0uZpjR",
+	"32FZ69WCKfXcRAhyvRq6IssfDTHUhCaXRICZDScJkZoSxXOEvymt6q9aUOYuFaghhAcSNZCoeydRBcf+",
+	"Di5p5cY7Chb+Xidk5V6angkCCZ65oKSjWMGJa7nqqlhwEo451C0YcsgNOeSGHHI3o5cF8RmY78B8P9r7",
+	"wHPLVZ+s5RGO2ZS6vGh6R/nLgwnuOYl5debOTOYOIgZipyuW1FNZJ/U2NbhpEqn/Gxxaj8zWY5vaJVh2",
+	"Qzr10pldP+9520Qzom5jFmvyaZtJ1JoMqcGH1OCDW1yU7pfeVKUXVPVJtU7KqV7sYr+d9HTabiOTDBmo",
+	"BtozWFQ/G+LTkoaqFwV5RdStk4/PxAu2XRQd6MdAP/4Kj9b21FC9aIj1Ar1lKjK4wg6UbKBkQzzUJ0w7",
+	"W3NG9SKdJx2KlusSz8/CBXddLeT9Esz713oOVHqg0gOV/ujqua1kTpKLDZ7QDbrAM9KcT2JPN0S0lBLh",
+	"zd4hgm6IOkctOsmIscVq90ipxAolnE3pLBfGYhtnFmD0LXoIApW8cSbBPh7UW5dEaYO6RBgMxzgtfCP0",
+	"htLo6BFvaNhO0fZNQg9h/7fEkqw3aQgDu4NPnE81wOUjCfv11ZyAr8Ag+v8lmAraiF6wlBOJGFfGYWTg",
+	"A2vwgRq97+YLCs/W4wqGIyg8M+cDyfMxA2bxufGEMzwbOEIMKgM/GPjBwA/+VPxA03nDDUxLuWJJp2N0",
+	"4YXU7RpdtB18owff6ME3evCNvrmqsaApg3f04B39EdltwTP7+UdHGGezh3Sbr++tX6T795Kuzt3pJ+1c",
+	"Adv8pNN6m5v5KrdNNiPqdmbyNrK22USk0eCzPPgsD0aRBmpcef4UX2X9xbOe33IvMr7fRYp6KJUiEw3e",
+	"ywMVGrwPPyMy1Oq/3IuSvCLqTsjIZ+PF3C4qDpRkoCR/jedllydzL2pi3XjvgJ4M/swDTRto2uAr94lT",
+	"0Q6f5l5E9KRTGXN9MvqZeDavqzu8b+L5MbSVA80eaPZAsz8JVd7WMsOsxYWNL5a5IkCJkzlmM5eTt8IC",
+	"rniemXp0K22dpQrpSUgaZO3VrgKScqYJO1USvaIKFZ4YY3RF1ZznCl0JCpZvzKyRHv2AM5oCaBERggtZ",
+	"JNx13ZE9CufmtuRCFcZnb4zWm91EEbgiGpqsDecpLxAlmDGutCE5ybhuBg5rOI05yx1nmN3N20Fv4PNh",
+	"eQYO/ulwX9xOTzs8FwYVyMAIaozA0HvNDS6JkNSsr1H3Ku3Etm1U5/qDHecO77abouVKD84kfw1Ud1hb",
+	"w3L3QaP2ldy6fNyzrmzCmeQZabwGb5aEIYx+JJNTnlwQhWwHJInUE2quXKkkLHLGwHfPSB+miEP07phP",
+	"QT3ZPbuaNeUFM85HrSur4WDd9m0+8lsqHTtuq8AROQy+JGwTnY8kERRn5yP4QSKMFHmvkCJiQRnO/g86",
+	"H12yJPj8w+s9tBT8/QqpnDGStXix6inPVsv2fbgaHmYdo7Gerl7JQ2OxbrlxiYWeAJB8r5ji1PUOfvsB",
+	"qHwdMIdTBIuAysZQehkwM9NS6moDJ1BgugqxaGFmyqQiONUQnmKaaWTW4jnC6Nn2V8g9wlwQCuh4Uj8i",
+	"lSil0uICScFRVfEsRVfzRr/KKde3OASfLZ892pniTBIPtgnnGcHMia8Bw3lseECFnFxRlehXBDoWXPGE",
+	"ZzIQAftIbL1YQLc81P1y7nzo9qLRkX0dMkUEwxm8e4hAB/oNZVpHlvYKK3KFV+iMLgjPVYn4pr4cTaQO",
+	"rKaepSKwjv6WCK8jt7UasO2tm4j6bVDvXjT60yLMfx7c/7xRuxObOxF4yYWacnGFRdofiT3yQkEQ4FYS",
+	"cUbQ2d5xGPunRROksJgRhQTByRxUHT4mpBPpj7lQL+3iPmGJxO5wzqVCWMKc2SVJK/JXKQYk4wnOdIcm",
+	"hqS/ja67En0M+mCbBtffWrftYwi+/OKLp18EQQSPewQRDMSgRgyexDdpCcI9EozwujcSjWojEyhjbl0u",
+	"stHOaAsv6dbl49GHd35BEaIhbN0gLeLp0yJMWc66GYjkpQ+jD+OWgThDu7maHwt+SVMiylFtwXhL26Bz",
+	"tD0ilA6Lxoqc0pl+NNlTjg6dFK2laS08lrbPU6FG4aD2HD+MOwBo2iFzxPUB7O+dKzlggmfZgjDVtlPi",
+	"W/XaoYmdhtpS+oaTS8JUaTj9Q+fSyvVbw/6meOM6S7Al8nAiuNTPgemUCMLio0PbtUYPqy5FhyyVu+na",
+	"d1MFGztWEC3aPVJTyKcfK1DU9dhxQihsOKKHsyN6tce7D//vAD65cc8SBwQA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
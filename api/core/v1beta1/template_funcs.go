@@ -0,0 +1,402 @@
+package v1beta1
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net"
+	"net/netip"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"sigs.k8s.io/yaml"
+)
+
+// maxTemplateIndent is the largest indentation accepted by indent and nindent.
+const maxTemplateIndent = 256
+
+// errInvalidTemplateInput marks errors caused by the values passed to a template function rather
+// than by the template itself.  These values usually come from device labels or parameters, so such
+// errors can only be detected when rendering the template for a specific device.
+var errInvalidTemplateInput = errors.New("invalid input")
+
+func invalidTemplateInput(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errInvalidTemplateInput, fmt.Sprintf(format, args...))
+}
+
+// GetGoTemplateFuncMap returns the functions that we provide to users in templates.  It is shared
+// by fleet template rendering and the quadlet renderer.  All functions are deterministic and have
+// no access to the environment, the file system or the network, so rendering the same template
+// with the same device always produces the same result.
+//
+// In case of a missing label, we may get an interface{} rather than string because
+// ExecuteGoTemplateOnDevice() converts the Device struct to a map.  Therefore our functions here
+// need to ensure we get a string, and if not then they treat the value as an empty string.  Note
+// that this will only happen if the "missingkey=zero" option is used in the template.  If
+// "missingkey=error" is used, the template execution will fail and we won't get to this point.
+func GetGoTemplateFuncMap() template.FuncMap {
+	return template.FuncMap{
+		// Strings
+		"upper":           templateUpper,
+		"lower":           templateLower,
+		"replace":         templateReplace,
+		"trim":            templateTrim,
+		"getOrDefault":    templateGetOrDefault,
+		"default":         templateDefault,
+		"coalesce":        templateCoalesce,
+		"indent":          templateIndent,
+		"nindent":         templateNindent,
+		"splitList":       templateSplitList,
+		"join":            templateJoin,
+		"regexMatch":      templateRegexMatch,
+		"regexReplaceAll": templateRegexReplaceAll,
+
+		// Encoding
+		"b64enc":    templateB64Enc,
+		"b64dec":    templateB64Dec,
+		"toJson":    templateToJson,
+		"toYaml":    templateToYaml,
+		"sha256sum": templateSha256Sum,
+
+		// Integer math
+		"add": templateAdd,
+		"sub": templateSub,
+		"mul": templateMul,
+		"div": templateDiv,
+		"mod": templateMod,
+		"max": templateMax,
+		"min": templateMin,
+
+		// Networking
+		"isIPv4":       templateIsIPv4,
+		"isIPv6":       templateIsIPv6,
+		"cidrContains": templateCidrContains,
+		"cidrHost":     templateCidrHost,
+		"cidrNetmask":  templateCidrNetmask,
+	}
+}
+
+// templateString converts a template value to a string, returning an empty string for missing values.
+func templateString(v any) string {
+	switch s := v.(type) {
+	case string:
+		return s
+	case *string:
+		if s != nil {
+			return *s
+		}
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, bool:
+		return fmt.Sprint(s)
+	}
+	return ""
+}
+
+func templateUpper(s any) string {
+	return strings.ToUpper(templateString(s))
+}
+
+func templateLower(s any) string {
+	return strings.ToLower(templateString(s))
+}
+
+func templateReplace(old, new string, input any) string {
+	return strings.ReplaceAll(templateString(input), old, new)
+}
+
+func templateTrim(s any) string {
+	return strings.TrimSpace(templateString(s))
+}
+
+func templateGetOrDefault(m *map[string]string, key string, defaultValue string) string {
+	if m == nil {
+		return defaultValue
+	}
+	if val, ok := (*m)[key]; ok {
+		return val
+	}
+	return defaultValue
+}
+
+// isEmptyTemplateValue returns true for missing values, empty strings, collections and zero numbers.
+func isEmptyTemplateValue(v any) bool {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && (rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface) {
+		if rv.IsNil() {
+			return true
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return true
+	}
+	switch rv.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return rv.Len() == 0
+	default:
+		return rv.IsZero()
+	}
+}
+
+func templateDefault(defaultValue any, v any) any {
+	if isEmptyTemplateValue(v) {
+		return defaultValue
+	}
+	return v
+}
+
+func templateCoalesce(values ...any) any {
+	for _, v := range values {
+		if !isEmptyTemplateValue(v) {
+			return v
+		}
+	}
+	return ""
+}
+
+func templateIndent(spaces int, s any) (string, error) {
+	if spaces < 0 || spaces > maxTemplateIndent {
+		return "", fmt.Errorf("indentation must be between 0 and %d", maxTemplateIndent)
+	}
+	pad := strings.Repeat(" ", spaces)
+	return pad + strings.ReplaceAll(templateString(s), "\n", "\n"+pad), nil
+}
+
+func templateNindent(spaces int, s any) (string, error) {
+	indented, err := templateIndent(spaces, s)
+	if err != nil {
+		return "", err
+	}
+	return "\n" + indented, nil
+}
+
+func templateSplitList(sep string, s any) []string {
+	return strings.Split(templateString(s), sep)
+}
+
+func templateJoin(sep string, list any) (string, error) {
+	rv := reflect.ValueOf(list)
+	for rv.IsValid() && rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return "", nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return "", nil
+	}
+	if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+		return "", fmt.Errorf("cannot join %T, expected a list", list)
+	}
+	items := make([]string, rv.Len())
+	for i := range items {
+		items[i] = templateString(rv.Index(i).Interface())
+	}
+	return strings.Join(items, sep), nil
+}
+
+func templateRegexMatch(pattern string, s any) (bool, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.MatchString(templateString(s)), nil
+}
+
+func templateRegexReplaceAll(pattern string, s any, replacement string) (string, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return "", fmt.Errorf("invalid regular expression: %w", err)
+	}
+	return re.ReplaceAllString(templateString(s), replacement), nil
+}
+
+func templateB64Enc(s any) string {
+	return base64.StdEncoding.EncodeToString([]byte(templateString(s)))
+}
+
+func templateB64Dec(s any) (string, error) {
+	decoded, err := base64.StdEncoding.DecodeString(templateString(s))
+	if err != nil {
+		return "", invalidTemplateInput("decoding base64: %v", err)
+	}
+	return string(decoded), nil
+}
+
+func templateToJson(v any) (string, error) {
+	out, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(out), nil
+}
+
+func templateToYaml(v any) (string, error) {
+	out, err := yaml.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(string(out), "\n"), nil
+}
+
+func templateSha256Sum(s any) string {
+	sum := sha256.Sum256([]byte(templateString(s)))
+	return hex.EncodeToString(sum[:])
+}
+
+// templateInt converts a template value to an integer.  Missing values and empty strings are zero.
+func templateInt(v any) (int64, error) {
+	rv := reflect.ValueOf(v)
+	for rv.IsValid() && rv.Kind() == reflect.Pointer {
+		if rv.IsNil() {
+			return 0, nil
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return 0, nil
+	}
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil //nolint:gosec // G115: template integers are small
+	case reflect.String:
+		s := strings.TrimSpace(rv.String())
+		if s == "" {
+			return 0, nil
+		}
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return 0, invalidTemplateInput("%q is not an integer", s)
+		}
+		return i, nil
+	default:
+		return 0, fmt.Errorf("cannot convert %T to an integer", v)
+	}
+}
+
+func templateIntPair(a, b any) (int64, int64, error) {
+	x, err := templateInt(a)
+	if err != nil {
+		return 0, 0, err
+	}
+	y, err := templateInt(b)
+	if err != nil {
+		return 0, 0, err
+	}
+	return x, y, nil
+}
+
+func templateAdd(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	return x + y, err
+}
+
+func templateSub(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	return x - y, err
+}
+
+func templateMul(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	return x * y, err
+}
+
+func templateDiv(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if y == 0 {
+		return 0, invalidTemplateInput("division by zero")
+	}
+	return x / y, nil
+}
+
+func templateMod(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	if err != nil {
+		return 0, err
+	}
+	if y == 0 {
+		return 0, invalidTemplateInput("division by zero")
+	}
+	return x % y, nil
+}
+
+func templateMax(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	return max(x, y), err
+}
+
+func templateMin(a, b any) (int64, error) {
+	x, y, err := templateIntPair(a, b)
+	return min(x, y), err
+}
+
+func templateIsIPv4(s any) bool {
+	addr, err := netip.ParseAddr(templateString(s))
+	return err == nil && addr.Is4()
+}
+
+func templateIsIPv6(s any) bool {
+	addr, err := netip.ParseAddr(templateString(s))
+	return err == nil && addr.Is6() && !addr.Is4In6()
+}
+
+func templatePrefix(cidr any) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(templateString(cidr))
+	if err != nil {
+		return netip.Prefix{}, invalidTemplateInput("invalid CIDR %q", templateString(cidr))
+	}
+	return prefix.Masked(), nil
+}
+
+func templateCidrContains(cidr any, ip any) (bool, error) {
+	prefix, err := templatePrefix(cidr)
+	if err != nil {
+		return false, err
+	}
+	addr, err := netip.ParseAddr(templateString(ip))
+	if err != nil {
+		return false, invalidTemplateInput("invalid IP address %q", templateString(ip))
+	}
+	return prefix.Contains(addr), nil
+}
+
+// templateCidrHost returns the address of the given host number within the network.
+func templateCidrHost(cidr any, hostNum any) (string, error) {
+	prefix, err := templatePrefix(cidr)
+	if err != nil {
+		return "", err
+	}
+	num, err := templateInt(hostNum)
+	if err != nil {
+		return "", err
+	}
+	hostBits := prefix.Addr().BitLen() - prefix.Bits()
+	if num < 0 || big.NewInt(num).BitLen() > hostBits {
+		return "", invalidTemplateInput("host number %d does not fit in %s", num, prefix)
+	}
+	addr := new(big.Int).SetBytes(prefix.Addr().AsSlice())
+	addr.Add(addr, big.NewInt(num))
+	b := addr.FillBytes(make([]byte, prefix.Addr().BitLen()/8))
+	host, _ := netip.AddrFromSlice(b)
+	return host.String(), nil
+}
+
+func templateCidrNetmask(cidr any) (string, error) {
+	prefix, err := templatePrefix(cidr)
+	if err != nil {
+		return "", err
+	}
+	mask := net.CIDRMask(prefix.Bits(), prefix.Addr().BitLen())
+	return net.IP(mask).String(), nil
+}
@@ -0,0 +1,81 @@
+package v1beta1
+
+import (
+	"testing"
+	"text/template"
+
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGoTemplateFuncMap(t *testing.T) {
+	device := &Device{
+		Metadata: ObjectMeta{
+			Name:   lo.ToPtr("device-1"),
+			Labels: &map[string]string{"site": "paris", "index": "7", "hosts": "a,b,c"},
+		},
+	}
+	parameters := map[string]string{"subnet": "10.1.0.0/16", "encoded": "aGVsbG8=", "empty": ""}
+
+	tests := []struct {
+		name          string
+		template      string
+		expected      string
+		expectedError bool
+	}{
+		{"upper", "{{ upper .metadata.name }}", "DEVICE-1", false},
+		{"lower", `{{ lower "ABC" }}`, "abc", false},
+		{"replace", `{{ replace "-" "_" .metadata.name }}`, "device_1", false},
+		{"trim", `{{ trim "  abc " }}`, "abc", false},
+		{"getOrDefault", `{{ getOrDefault .metadata.labels "zone" "eu" }}`, "eu", false},
+		{"default on empty", `{{ default "fallback" .parameters.empty }}`, "fallback", false},
+		{"default on value", `{{ default "fallback" .metadata.labels.site }}`, "paris", false},
+		{"coalesce", `{{ coalesce .parameters.empty .metadata.labels.site "x" }}`, "paris", false},
+		{"indent", `{{ indent 2 "a\nb" }}`, "  a\n  b", false},
+		{"nindent", `{{ nindent 2 "a" }}`, "\n  a", false},
+		{"indent out of range", `{{ indent 1000 "a" }}`, "", true},
+		{"splitList and join", `{{ splitList "," .metadata.labels.hosts | join ";" }}`, "a;b;c", false},
+		{"join non-list", `{{ join "," 5 }}`, "", true},
+		{"regexMatch", `{{ regexMatch "^dev" .metadata.name }}`, "true", false},
+		{"regexReplaceAll", `{{ regexReplaceAll "[0-9]+" .metadata.name "N" }}`, "device-N", false},
+		{"invalid regex", `{{ regexMatch "(" .metadata.name }}`, "", true},
+		{"b64enc", `{{ b64enc "hello" }}`, "aGVsbG8=", false},
+		{"b64dec", `{{ b64dec .parameters.encoded }}`, "hello", false},
+		{"b64dec invalid", `{{ b64dec "!!" }}`, "", true},
+		{"toJson", `{{ toJson .metadata.labels }}`, `{"hosts":"a,b,c","index":"7","site":"paris"}`, false},
+		{"toYaml", `{{ toYaml .metadata.labels }}`, "hosts: a,b,c\nindex: \"7\"\nsite: paris", false},
+		{"sha256sum", `{{ sha256sum "hello" }}`, "2cf24dba5fb0a30e26e83b2ac5b9e29e1b161e5c1fa7425e73043362938b9824", false},
+		{"add label", `{{ add .metadata.labels.index 3 }}`, "10", false},
+		{"sub", `{{ sub 10 3 }}`, "7", false},
+		{"mul", `{{ mul .metadata.labels.index 6 }}`, "42", false},
+		{"div", `{{ div 10 3 }}`, "3", false},
+		{"div by zero", `{{ div 10 0 }}`, "", true},
+		{"mod", `{{ mod 10 3 }}`, "1", false},
+		{"max", `{{ max 10 3 }}`, "10", false},
+		{"min", `{{ min 10 3 }}`, "3", false},
+		{"math on non-integer", `{{ add .metadata.labels.site 1 }}`, "", true},
+		{"isIPv4", `{{ isIPv4 "10.0.0.1" }} {{ isIPv4 "::1" }}`, "true false", false},
+		{"isIPv6", `{{ isIPv6 "fd00::1" }} {{ isIPv6 "10.0.0.1" }}`, "true false", false},
+		{"cidrContains", `{{ if cidrContains .parameters.subnet "10.1.2.3" }}in{{ else }}out{{ end }}`, "in", false},
+		{"cidrContains outside", `{{ cidrContains .parameters.subnet "10.2.0.1" }}`, "false", false},
+		{"cidrHost", `{{ cidrHost .parameters.subnet (add .metadata.labels.index 256) }}`, "10.1.1.7", false},
+		{"cidrHost ipv6", `{{ cidrHost "fd00::/64" 16 }}`, "fd00::10", false},
+		{"cidrHost out of range", `{{ cidrHost "10.0.0.0/30" 4 }}`, "", true},
+		{"cidrHost invalid cidr", `{{ cidrHost "10.0.0.0" 4 }}`, "", true},
+		{"cidrNetmask", `{{ cidrNetmask .parameters.subnet }}`, "255.255.0.0", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("t").Option("missingkey=error").Funcs(GetGoTemplateFuncMap()).Parse(tt.template)
+			require.NoError(t, err)
+			output, err := ExecuteGoTemplateOnDeviceWithParameters(tmpl, device, parameters)
+			if tt.expectedError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, output)
+		})
+	}
+}
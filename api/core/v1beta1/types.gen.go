@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/oapi-codegen/runtime"
+	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
 // Defines values for AapProviderSpecProviderType.
@@ -44,6 +45,13 @@ const (
 	ApplicationLifecycleChangedDetailType ApplicationLifecycleChangedDetailsDetailType = "ApplicationLifecycleChanged"
 )
 
+// Defines values for ApplicationOperation.
+const (
+	ApplicationOperationAdded   ApplicationOperation = "added"
+	ApplicationOperationRemoved ApplicationOperation = "removed"
+	ApplicationOperationUpdated ApplicationOperation = "updated"
+)
+
 // Defines values for ApplicationStatusType.
 const (
 	ApplicationStatusCompleted ApplicationStatusType = "Completed"
@@ -138,6 +146,17 @@ const (
 	DeviceDecommissionTargetTypeUnenroll     DeviceDecommissionTargetType = "Unenroll"
 )
 
+// Defines values for DeviceFileTransferDetailsDetailType.
+const (
+	DeviceFileTransfer DeviceFileTransferDetailsDetailType = "DeviceFileTransfer"
+)
+
+// Defines values for DeviceFileTransferDetailsDirection.
+const (
+	DeviceFileTransferDownload DeviceFileTransferDetailsDirection = "download"
+	DeviceFileTransferUpload   DeviceFileTransferDetailsDirection = "upload"
+)
+
 // Defines values for DeviceIntegrityCheckStatusType.
 const (
 	DeviceIntegrityCheckStatusFailed      DeviceIntegrityCheckStatusType = "Failed"
@@ -255,6 +274,10 @@ const (
 	EventReasonDeviceDiskCritical              EventReason = "DeviceDiskCritical"
 	EventReasonDeviceDiskNormal                EventReason = "DeviceDiskNormal"
 	EventReasonDeviceDiskWarning               EventReason = "DeviceDiskWarning"
+	EventReasonDeviceFileTransferFailed        EventReason = "DeviceFileTransferFailed"
+	EventReasonDeviceFileTransferred           EventReason = "DeviceFileTransferred"
+	EventReasonDeviceIntegrityFailed           EventReason = "DeviceIntegrityFailed"
+	EventReasonDeviceIntegrityVerified         EventReason = "DeviceIntegrityVerified"
 	EventReasonDeviceIsRebooting               EventReason = "DeviceIsRebooting"
 	EventReasonDeviceMemoryCritical            EventReason = "DeviceMemoryCritical"
 	EventReasonDeviceMemoryNormal              EventReason = "DeviceMemoryNormal"
@@ -277,6 +300,9 @@ const (
 	EventReasonFleetRolloutCreated             EventReason = "FleetRolloutCreated"
 	EventReasonFleetRolloutDeviceSelected      EventReason = "FleetRolloutDeviceSelected"
 	EventReasonFleetRolloutFailed              EventReason = "FleetRolloutFailed"
+	EventReasonFleetRolloutRollbackCompleted   EventReason = "FleetRolloutRollbackCompleted"
+	EventReasonFleetRolloutRollbackFailed      EventReason = "FleetRolloutRollbackFailed"
+	EventReasonFleetRolloutRollbackStarted     EventReason = "FleetRolloutRollbackStarted"
 	EventReasonFleetRolloutStarted             EventReason = "FleetRolloutStarted"
 	EventReasonFleetValid                      EventReason = "FleetValid"
 	EventReasonInternalTaskFailed              EventReason = "InternalTaskFailed"
@@ -338,6 +364,21 @@ const (
 	FleetRolloutFailed FleetRolloutFailedDetailsDetailType = "FleetRolloutFailed"
 )
 
+// Defines values for FleetRolloutRollbackCompletedDetailsDetailType.
+const (
+	FleetRolloutRollbackCompleted FleetRolloutRollbackCompletedDetailsDetailType = "FleetRolloutRollbackCompleted"
+)
+
+// Defines values for FleetRolloutRollbackFailedDetailsDetailType.
+const (
+	FleetRolloutRollbackFailed FleetRolloutRollbackFailedDetailsDetailType = "FleetRolloutRollbackFailed"
+)
+
+// Defines values for FleetRolloutRollbackStartedDetailsDetailType.
+const (
+	FleetRolloutRollbackStarted FleetRolloutRollbackStartedDetailsDetailType = "FleetRolloutRollbackStarted"
+)
+
 // Defines values for FleetRolloutStartedDetailsDetailType.
 const (
 	FleetRolloutStarted FleetRolloutStartedDetailsDetailType = "FleetRolloutStarted"
@@ -359,6 +400,20 @@ const (
 	GitRepoSpecTypeGit GitRepoSpecType = "git"
 )
 
+// Defines values for HookActionFailurePolicy.
+const (
+	HookActionFailurePolicyAbort  HookActionFailurePolicy = "abort"
+	HookActionFailurePolicyIgnore HookActionFailurePolicy = "ignore"
+)
+
+// Defines values for HookActionSystemdOperation.
+const (
+	HookActionSystemdOperationReload  HookActionSystemdOperation = "reload"
+	HookActionSystemdOperationRestart HookActionSystemdOperation = "restart"
+	HookActionSystemdOperationStart   HookActionSystemdOperation = "start"
+	HookActionSystemdOperationStop    HookActionSystemdOperation = "stop"
+)
+
 // Defines values for HttpRepoSpecType.
 const (
 	HttpRepoSpecTypeHttp HttpRepoSpecType = "http"
@@ -486,10 +541,18 @@ const (
 	ResourceSyncCompleted ResourceSyncCompletedDetailsDetailType = "ResourceSyncCompleted"
 )
 
+// Defines values for ResourceSyncPlannedChangeAction.
+const (
+	ResourceSyncPlannedActionCreate ResourceSyncPlannedChangeAction = "create"
+	ResourceSyncPlannedActionDelete ResourceSyncPlannedChangeAction = "delete"
+	ResourceSyncPlannedActionUpdate ResourceSyncPlannedChangeAction = "update"
+)
+
 // Defines values for ResourceSyncType.
 const (
-	ResourceSyncTypeCatalog ResourceSyncType = "catalog"
-	ResourceSyncTypeFleet   ResourceSyncType = "fleet"
+	ResourceSyncTypeCatalog      ResourceSyncType = "catalog"
+	ResourceSyncTypeFleet        ResourceSyncType = "fleet"
+	ResourceSyncTypeOrganization ResourceSyncType = "organization"
 )
 
 // Defines values for ResourceUpdatedDetailsDetailType.
@@ -499,11 +562,12 @@ const (
 
 // Defines values for ResourceUpdatedDetailsUpdatedFields.
 const (
-	Labels       ResourceUpdatedDetailsUpdatedFields = "labels"
-	Owner        ResourceUpdatedDetailsUpdatedFields = "owner"
-	Spec         ResourceUpdatedDetailsUpdatedFields = "spec"
-	SpecSelector ResourceUpdatedDetailsUpdatedFields = "spec.selector"
-	SpecTemplate ResourceUpdatedDetailsUpdatedFields = "spec.template"
+	Labels         ResourceUpdatedDetailsUpdatedFields = "labels"
+	Owner          ResourceUpdatedDetailsUpdatedFields = "owner"
+	Spec           ResourceUpdatedDetailsUpdatedFields = "spec"
+	SpecParameters ResourceUpdatedDetailsUpdatedFields = "spec.parameters"
+	SpecSelector   ResourceUpdatedDetailsUpdatedFields = "spec.selector"
+	SpecTemplate   ResourceUpdatedDetailsUpdatedFields = "spec.template"
 )
 
 // Defines values for Rfc7662IntrospectionSpecType.
@@ -511,6 +575,12 @@ const (
 	Rfc7662 Rfc7662IntrospectionSpecType = "rfc7662"
 )
 
+// Defines values for RolloutFailureAction.
+const (
+	RolloutFailureActionPause    RolloutFailureAction = "pause"
+	RolloutFailureActionRollback RolloutFailureAction = "rollback"
+)
+
 // Defines values for RolloutStrategy.
 const (
 	RolloutStrategyBatchSequence RolloutStrategy = "BatchSequence"
@@ -571,6 +641,15 @@ const (
 	Bearer TokenResponseTokenType = "Bearer"
 )
 
+// Defines values for WatchEventType.
+const (
+	WatchEventTypeAdded    WatchEventType = "ADDED"
+	WatchEventTypeBookmark WatchEventType = "BOOKMARK"
+	WatchEventTypeDeleted  WatchEventType = "DELETED"
+	WatchEventTypeError    WatchEventType = "ERROR"
+	WatchEventTypeModified WatchEventType = "MODIFIED"
+)
+
 // Defines values for ListEventsParamsOrder.
 const (
 	Asc  ListEventsParamsOrder = "asc"
@@ -675,6 +754,9 @@ type ApplicationLifecycleChangedDetailsAction string
 // ApplicationLifecycleChangedDetailsDetailType The type of detail for discriminator purposes.
 type ApplicationLifecycleChangedDetailsDetailType string
 
+// ApplicationOperation defines model for ApplicationOperation.
+type ApplicationOperation string
+
 // ApplicationPort Port mapping in format "hostPort:containerPort" (e.g., "8080:80").
 type ApplicationPort = string
 
@@ -756,6 +838,39 @@ type ApplicationVolumeStatus struct {
 // ApplicationsSummaryStatusType Status of all applications on the device.
 type ApplicationsSummaryStatusType string
 
+// AttestationChallenge AttestationChallenge asks a device to quote its PCRs.
+type AttestationChallenge struct {
+	// Nonce The base64-encoded nonce the device must include as qualifying data of the quote.
+	Nonce []byte `json:"nonce"`
+
+	// Pcrs The indexes of the SHA-256 PCRs to quote.
+	Pcrs []int32 `json:"pcrs"`
+}
+
+// AttestationPolicy AttestationPolicy describes the measured boot state expected of the devices in a fleet. Devices with a TPM periodically report quotes of their PCRs signed by their attestation key, which are verified against the reference values.
+type AttestationPolicy struct {
+	// MaxAge How long a verification of the boot measurements of a device remains valid. The measured boot check of a device that does not attest again within this duration fails. Defaults to 30m. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	MaxAge *string `json:"maxAge,omitempty"`
+
+	// Pcrs Reference values of the PCRs to verify.
+	Pcrs []PCRReferenceValue `json:"pcrs"`
+}
+
+// AttestationQuote AttestationQuote is a quote of a device's PCRs signed by the attestation key of its TPM.
+type AttestationQuote struct {
+	// Nonce The base64-encoded nonce of the challenge being answered.
+	Nonce []byte `json:"nonce"`
+
+	// Pcrs The values of the quoted PCRs.
+	Pcrs []PCRValue `json:"pcrs"`
+
+	// Quote The base64-encoded TPM2B_ATTEST structure returned by TPM2_Quote.
+	Quote []byte `json:"quote"`
+
+	// Signature The base64-encoded TPMT_SIGNATURE of the quote.
+	Signature []byte `json:"signature"`
+}
+
 // AuthConfig defines model for AuthConfig.
 type AuthConfig struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -794,6 +909,9 @@ type AuthDynamicRoleAssignment struct {
 	// ClaimPath The JSON path to the role/group claim (e.g., ["groups"], ["roles"], ["realm_access", "roles"]).
 	ClaimPath []string `json:"claimPath"`
 
+	// ScopedRoles Scoped role definitions. A claim value (optionally prefixed with an organization and the separator) that matches the name of a scoped role grants that role only within its scope.
+	ScopedRoles *[]AuthScopedRole `json:"scopedRoles,omitempty"`
+
 	// Separator Separator for org:role format (default ':'). Roles containing the separator are split into organization-scoped roles. Roles without separator are global and apply to all organizations.
 	Separator *string `json:"separator,omitempty"`
 
@@ -862,6 +980,21 @@ type AuthRoleAssignment struct {
 	union json.RawMessage
 }
 
+// AuthScopedRole AuthScopedRole binds a name reported by the auth provider to a role whose permissions on devices and fleets are limited to a set of labels or fleets. The selector restricts the role to devices and fleets whose labels match it. Exactly one of selector or fleets must be set.
+type AuthScopedRole struct {
+	// Fleets Restricts the role to the listed fleets and the devices they own.
+	Fleets *[]string `json:"fleets,omitempty"`
+
+	// Name The role name or claim value (e.g., a group name) that grants this scoped role.
+	Name string `json:"name"`
+
+	// Role The role granted within the scope (e.g., flightctl-operator). The flightctl-admin role cannot be scoped.
+	Role string `json:"role"`
+
+	// Selector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	Selector *LabelSelector `json:"selector,omitempty"`
+}
+
 // AuthStaticOrganizationAssignment AuthStaticOrganizationAssignment assigns all users from this auth provider to a single static organization.
 type AuthStaticOrganizationAssignment struct {
 	// OrganizationName The name of the organization where all users will be assigned.
@@ -879,6 +1012,9 @@ type AuthStaticRoleAssignment struct {
 	// Roles The list of role names to assign to all users.
 	Roles []string `json:"roles"`
 
+	// ScopedRoles Scoped role definitions. A role name in roles that matches the name of a scoped role grants that role only within its scope.
+	ScopedRoles *[]AuthScopedRole `json:"scopedRoles,omitempty"`
+
 	// Type The type of role assignment.
 	Type AuthStaticRoleAssignmentType `json:"type"`
 }
@@ -900,6 +1036,9 @@ type BaseImageEntry struct {
 
 // Batch Batch is an element in batch sequence.
 type Batch struct {
+	// HealthCriteria Health criteria that a device in the batch must meet, in addition to having reached the target rendered version, in order to be counted as successfully updated.
+	HealthCriteria *BatchHealthCriteria `json:"healthCriteria,omitempty"`
+
 	// Limit The maximum number or percentage of devices to update in the batch.
 	Limit *Batch_Limit `json:"limit,omitempty"`
 
@@ -918,6 +1057,18 @@ type Batch_Limit struct {
 	union json.RawMessage
 }
 
+// BatchHealthCriteria Health criteria that a device in the batch must meet, in addition to having reached the target rendered version, in order to be counted as successfully updated.
+type BatchHealthCriteria struct {
+	// ApplicationsHealthy If true, the summary status of the device's applications must be Healthy (or NoApplications). A device that has not reported an applications summary is considered healthy.
+	ApplicationsHealthy *bool `json:"applicationsHealthy,omitempty"`
+
+	// ResourcesNotCritical If true, none of the device's CPU, memory, or disk resources may be in Critical status.
+	ResourcesNotCritical *bool `json:"resourcesNotCritical,omitempty"`
+
+	// SoakDuration The duration for which a device must continuously meet the other health criteria after completing its update in order to be counted as successfully updated. The batch is not considered complete while an updated device is still within this duration. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	SoakDuration *string `json:"soakDuration,omitempty"`
+}
+
 // BatchSequence BatchSequence defines the list of batches to be executed in sequence.
 type BatchSequence struct {
 	// Sequence A list of batch definitions.
@@ -927,6 +1078,39 @@ type BatchSequence struct {
 	Strategy RolloutStrategy `json:"strategy"`
 }
 
+// CertificateRevocation CertificateRevocation records a revoked device certificate.
+type CertificateRevocation struct {
+	// DeviceName The name of the device the certificate was issued to.
+	DeviceName string `json:"deviceName"`
+
+	// ExpiresAt The time the revoked certificate expires. Expired certificates are dropped from the revocation list.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// Reason A human-readable reason for the revocation.
+	Reason *string `json:"reason,omitempty"`
+
+	// RevokedAt The time the certificate was revoked.
+	RevokedAt time.Time `json:"revokedAt"`
+
+	// SerialNumber The serial number of the revoked certificate, hex-encoded.
+	SerialNumber string `json:"serialNumber"`
+}
+
+// CertificateRevocationList CertificateRevocationList is a list of CertificateRevocation.
+type CertificateRevocationList struct {
+	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
+	ApiVersion ApiVersion `json:"apiVersion"`
+
+	// Items List of CertificateRevocation.
+	Items []CertificateRevocation `json:"items"`
+
+	// Kind Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds.
+	Kind string `json:"kind"`
+
+	// Metadata ListMeta describes metadata that synthetic resources must have, including lists and various status objects. A resource may have only one of {ObjectMeta, ListMeta}.
+	Metadata ListMeta `json:"metadata"`
+}
+
 // CertificateSigningRequest CertificateSigningRequest represents a request for a signed certificate from the CA.
 type CertificateSigningRequest struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -1269,6 +1453,12 @@ type DeviceCapabilities struct {
 	OsMode *OsModeType `json:"osMode,omitempty"`
 }
 
+// DeviceCertificateRevocationRequest A request to revoke the certificates issued to a device.
+type DeviceCertificateRevocationRequest struct {
+	// Reason A human-readable reason for the revocation.
+	Reason *string `json:"reason,omitempty"`
+}
+
 // DeviceConfigStatus Current status of the device config.
 type DeviceConfigStatus struct {
 	// RenderedVersion Rendered version of the device config.
@@ -1293,11 +1483,38 @@ type DeviceDecommission struct {
 // DeviceDecommissionTargetType Specifies the desired decommissioning method of the device.
 type DeviceDecommissionTargetType string
 
+// DeviceFileTransferDetails Structured details for file transfers to and from a device with "flightctl cp".
+type DeviceFileTransferDetails struct {
+	// Bytes The number of file bytes transferred.
+	Bytes int64 `json:"bytes"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType DeviceFileTransferDetailsDetailType `json:"detailType"`
+
+	// Direction Whether the file was copied from the device (download) or to the device (upload).
+	Direction DeviceFileTransferDetailsDirection `json:"direction"`
+
+	// Error The reason the transfer failed, if it did.
+	Error *string `json:"error,omitempty"`
+
+	// Path The absolute path of the file on the device.
+	Path string `json:"path"`
+}
+
+// DeviceFileTransferDetailsDetailType The type of detail for discriminator purposes.
+type DeviceFileTransferDetailsDetailType string
+
+// DeviceFileTransferDetailsDirection Whether the file was copied from the device (download) or to the device (upload).
+type DeviceFileTransferDetailsDirection string
+
 // DeviceIntegrityCheckStatus DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
 type DeviceIntegrityCheckStatus struct {
 	// Info Human-readable information about the integrity check status.
 	Info *string `json:"info,omitempty"`
 
+	// LastVerified Timestamp of the last time the check was verified successfully.
+	LastVerified *time.Time `json:"lastVerified,omitempty"`
+
 	// Status Status of the integrity check performed on the device.
 	Status DeviceIntegrityCheckStatusType `json:"status"`
 }
@@ -1316,6 +1533,9 @@ type DeviceIntegrityStatus struct {
 	// LastVerified Timestamp of the last integrity verification.
 	LastVerified *time.Time `json:"lastVerified,omitempty"`
 
+	// MeasuredBoot DeviceIntegrityCheckStatus represents the status of the integrity check performed on the device.
+	MeasuredBoot *DeviceIntegrityCheckStatus `json:"measuredBoot,omitempty"`
+
 	// Status Status of the integrity of the device.
 	Status DeviceIntegrityStatusSummaryType `json:"status"`
 
@@ -1428,6 +1648,18 @@ type DeviceOwnershipChangedDetails struct {
 // DeviceOwnershipChangedDetailsDetailType The type of detail for discriminator purposes.
 type DeviceOwnershipChangedDetailsDetailType string
 
+// DeviceParameterSet DeviceParameterSet is a set of template parameter values applied to the devices it matches. A set with neither a selector nor a list of devices applies to all devices of the fleet.
+type DeviceParameterSet struct {
+	// Devices The names of the devices the set applies to. Mutually exclusive with selector.
+	Devices *[]string `json:"devices,omitempty"`
+
+	// Selector A label selector is a label query over a set of resources. The result of matchLabels and matchExpressions are ANDed. Empty/null label selectors match nothing.
+	Selector *LabelSelector `json:"selector,omitempty"`
+
+	// Values The parameter values, keyed by parameter name. Parameter names must be valid template identifiers.
+	Values map[string]string `json:"values"`
+}
+
 // DeviceResourceStatus Current status of the resources of the device.
 type DeviceResourceStatus struct {
 	// Cpu The types of resource statuses.
@@ -1470,6 +1702,9 @@ type DeviceSpec struct {
 	// Applications List of application providers.
 	Applications *[]ApplicationProviderSpec `json:"applications,omitempty"`
 
+	// Certificates List of workload certificates the agent requests for applications on the device and renews before they expire.
+	Certificates *[]WorkloadCertificateSpec `json:"certificates,omitempty"`
+
 	// Config List of config providers.
 	Config *[]ConfigProviderSpec `json:"config,omitempty"`
 
@@ -1970,6 +2205,9 @@ type FleetRolloutBatchCompletedDetails struct {
 
 	// Total The total number of devices in the batch.
 	Total int64 `json:"total"`
+
+	// Unhealthy The number of devices in the batch that completed their update but did not meet the batch's health criteria.
+	Unhealthy *int64 `json:"unhealthy,omitempty"`
 }
 
 // FleetRolloutBatchCompletedDetailsDetailType The type of detail for discriminator purposes.
@@ -2029,6 +2267,69 @@ type FleetRolloutFailedDetails struct {
 // FleetRolloutFailedDetailsDetailType The type of detail for discriminator purposes.
 type FleetRolloutFailedDetailsDetailType string
 
+// FleetRolloutRollbackCompletedDetails defines model for FleetRolloutRollbackCompletedDetails.
+type FleetRolloutRollbackCompletedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRollbackCompletedDetailsDetailType `json:"detailType"`
+
+	// RollbackTemplateVersion The name of the TemplateVersion that the devices were rolled back to.
+	RollbackTemplateVersion string `json:"rollbackTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout failed.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRollbackCompletedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRollbackCompletedDetailsDetailType string
+
+// FleetRolloutRollbackFailedDetails defines model for FleetRolloutRollbackFailedDetails.
+type FleetRolloutRollbackFailedDetails struct {
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRollbackFailedDetailsDetailType `json:"detailType"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout failed and could not be rolled back.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRollbackFailedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRollbackFailedDetailsDetailType string
+
+// FleetRolloutRollbackStartedDetails defines model for FleetRolloutRollbackStartedDetails.
+type FleetRolloutRollbackStartedDetails struct {
+	// Batch The batch that failed to reach its success threshold.
+	Batch string `json:"batch"`
+
+	// DetailType The type of detail for discriminator purposes.
+	DetailType FleetRolloutRollbackStartedDetailsDetailType `json:"detailType"`
+
+	// RollbackTemplateVersion The name of the TemplateVersion that the devices are rolled back to.
+	RollbackTemplateVersion string `json:"rollbackTemplateVersion"`
+
+	// TemplateVersion The name of the TemplateVersion whose rollout failed.
+	TemplateVersion string `json:"templateVersion"`
+}
+
+// FleetRolloutRollbackStartedDetailsDetailType The type of detail for discriminator purposes.
+type FleetRolloutRollbackStartedDetailsDetailType string
+
+// FleetRolloutRollbackStatus FleetRolloutRollbackStatus describes the rollback of a failed fleet rollout.
+type FleetRolloutRollbackStatus struct {
+	// Batch The batch that failed to reach its success threshold.
+	Batch string `json:"batch"`
+
+	// Completed Whether all the devices updated by the failed rollout were returned to the previous TemplateVersion.
+	Completed bool `json:"completed"`
+
+	// FromTemplateVersion The name of the TemplateVersion whose rollout failed.
+	FromTemplateVersion string `json:"fromTemplateVersion"`
+
+	// StartTime The time the rollback started.
+	StartTime time.Time `json:"startTime"`
+
+	// ToTemplateVersion The name of the TemplateVersion that the devices are rolled back to.
+	ToTemplateVersion string `json:"toTemplateVersion"`
+}
+
 // FleetRolloutStartedDetails defines model for FleetRolloutStartedDetails.
 type FleetRolloutStartedDetails struct {
 	// DetailType The type of detail for discriminator purposes.
@@ -2051,10 +2352,22 @@ type FleetRolloutStartedDetailsRolloutStrategy string
 type FleetRolloutStatus struct {
 	// CurrentBatch The batch number currently being rolled out.
 	CurrentBatch *int `json:"currentBatch,omitempty"`
+
+	// NextMaintenanceWindow When set, the rollout is waiting for a maintenance window to start its next batch, and this is the time the next window opens.
+	NextMaintenanceWindow *time.Time `json:"nextMaintenanceWindow,omitempty"`
+
+	// Rollback FleetRolloutRollbackStatus describes the rollback of a failed fleet rollout.
+	Rollback *FleetRolloutRollbackStatus `json:"rollback,omitempty"`
 }
 
 // FleetSpec FleetSpec is a description of a fleet's target state.
 type FleetSpec struct {
+	// Attestation AttestationPolicy describes the measured boot state expected of the devices in a fleet. Devices with a TPM periodically report quotes of their PCRs signed by their attestation key, which are verified against the reference values.
+	Attestation *AttestationPolicy `json:"attestation,omitempty"`
+
+	// Parameters Parameter sets providing per-device values to the template, accessible as {{ .parameters.<key> }}. Sets are applied in order, so values of later matching sets override earlier ones.
+	Parameters *[]DeviceParameterSet `json:"parameters,omitempty"`
+
 	// RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 	RolloutPolicy *RolloutPolicy `json:"rolloutPolicy,omitempty"`
 
@@ -2166,11 +2479,35 @@ type HookAction struct {
 	// If Conditions that must be met for the action to be executed.
 	If *[]HookCondition `json:"if,omitempty"`
 
+	// OnFailure What the agent does when the action fails. "abort" stops processing the hook's actions and fails the update, so that a failure in the beforeUpdating hook aborts the update before changes are made and a failure in the afterUpdating or beforeRebooting hook rolls the update back. "ignore" logs the failure and continues with the next action. If unset, the failure fails the hook and the update is retried only if the action timed out.
+	OnFailure *HookActionFailurePolicy `json:"onFailure,omitempty"`
+
 	// Timeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours.
 	Timeout *string `json:"timeout,omitempty"`
 	union   json.RawMessage
 }
 
+// HookActionFailurePolicy What the agent does when the action fails. "abort" stops processing the hook's actions and fails the update, so that a failure in the beforeUpdating hook aborts the update before changes are made and a failure in the afterUpdating or beforeRebooting hook rolls the update back. "ignore" logs the failure and continues with the next action. If unset, the failure fails the hook and the update is retried only if the action timed out.
+type HookActionFailurePolicy string
+
+// HookActionHttpProbe defines model for HookActionHttpProbe.
+type HookActionHttpProbe struct {
+	// HttpProbe Repeatedly sends a GET request to a URL on the device until it responds with the expected status code, or until the action times out.
+	HttpProbe HookActionHttpProbeSpec `json:"httpProbe"`
+}
+
+// HookActionHttpProbeSpec Repeatedly sends a GET request to a URL on the device until it responds with the expected status code, or until the action times out.
+type HookActionHttpProbeSpec struct {
+	// ExpectedStatus The HTTP status code that indicates success. Defaults to 200.
+	ExpectedStatus *int `json:"expectedStatus,omitempty"`
+
+	// Interval The time to wait between probes. The duration should be specified as a positive integer followed by a time unit. Supported time units are 's' for seconds, 'm' for minutes, and 'h' for hours. Defaults to 1s.
+	Interval *string `json:"interval,omitempty"`
+
+	// Url The http or https URL to probe. The host must be "localhost" or a loopback IP address.
+	Url string `json:"url"`
+}
+
 // HookActionRun defines model for HookActionRun.
 type HookActionRun struct {
 	// EnvVars Environment variable key-value pairs, injected during runtime.
@@ -2183,14 +2520,56 @@ type HookActionRun struct {
 	WorkDir *string `json:"workDir,omitempty"`
 }
 
+// HookActionSystemd defines model for HookActionSystemd.
+type HookActionSystemd struct {
+	// Systemd Controls a systemd unit on the device.
+	Systemd HookActionSystemdSpec `json:"systemd"`
+}
+
+// HookActionSystemdOperation The operation to perform on the systemd unit.
+type HookActionSystemdOperation string
+
+// HookActionSystemdSpec Controls a systemd unit on the device.
+type HookActionSystemdSpec struct {
+	// Operation The operation to perform on the systemd unit.
+	Operation HookActionSystemdOperation `json:"operation"`
+
+	// Unit The name of the systemd unit, including its suffix, e.g. "nginx.service".
+	Unit string `json:"unit"`
+
+	// WaitForActive If true, wait until the unit is active after the operation completed, or until the action times out. Not supported for the "stop" operation.
+	WaitForActive *bool `json:"waitForActive,omitempty"`
+}
+
 // HookCondition defines model for HookCondition.
 type HookCondition struct {
 	union json.RawMessage
 }
 
+// HookConditionApplicationOp defines model for HookConditionApplicationOp.
+type HookConditionApplicationOp struct {
+	// Application The name of the application that must have changed as condition for the action to be performed.
+	Application string `json:"application"`
+
+	// Op The operation(s) on the application that satisfy the application condition.
+	Op []ApplicationOperation `json:"op"`
+}
+
 // HookConditionExpression An expression that must evaluate to true as condition for the action to be performed.
 type HookConditionExpression = string
 
+// HookConditionLabels defines model for HookConditionLabels.
+type HookConditionLabels struct {
+	// Labels Labels that the device must have with the given values as condition for the action to be performed.
+	Labels map[string]string `json:"labels"`
+}
+
+// HookConditionOsImage defines model for HookConditionOsImage.
+type HookConditionOsImage struct {
+	// OsImageChanged Whether the OS image must have changed (true) or must not have changed (false) during the update as condition for the action to be performed.
+	OsImageChanged bool `json:"osImageChanged"`
+}
+
 // HookConditionPathOp defines model for HookConditionPathOp.
 type HookConditionPathOp struct {
 	// Op The operation(s) on files at or below the path that satisfy the path condition.
@@ -2200,6 +2579,12 @@ type HookConditionPathOp struct {
 	Path string `json:"path"`
 }
 
+// HookConditionSystemInfo defines model for HookConditionSystemInfo.
+type HookConditionSystemInfo struct {
+	// SystemInfo Fields of the device's system information that must have the given values as condition for the action to be performed, for example `architecture: arm64`. Keys are the names of the fields of DeviceSystemInfo, including the names of additional and custom information fields.
+	SystemInfo map[string]string `json:"systemInfo"`
+}
+
 // HttpConfig Configuration for HTTP transport.
 type HttpConfig struct {
 	// CaCrt Base64 encoded root CA.
@@ -2732,6 +3117,24 @@ type OrganizationSpec struct {
 // OsModeType OS management mode. "image" indicates the OS is managed via bootc or rpm-ostree image updates. "package" indicates no image-based OS management is available.
 type OsModeType string
 
+// PCRReferenceValue PCRReferenceValue lists the accepted values of a PCR of the SHA-256 bank.
+type PCRReferenceValue struct {
+	// Index The index of the PCR.
+	Index int32 `json:"index"`
+
+	// Values The accepted hex-encoded SHA-256 values of the PCR. The PCR matches if it equals any of them.
+	Values []string `json:"values"`
+}
+
+// PCRValue PCRValue is the value of a PCR of the SHA-256 bank.
+type PCRValue struct {
+	// Index The index of the PCR.
+	Index int32 `json:"index"`
+
+	// Value The hex-encoded SHA-256 value of the PCR.
+	Value string `json:"value"`
+}
+
 // PatchRequest defines model for PatchRequest.
 type PatchRequest = []struct {
 	// Op The operation to perform.
@@ -2929,6 +3332,18 @@ type ResourceSyncCompletedDetails struct {
 // ResourceSyncCompletedDetailsDetailType The type of detail for discriminator purposes.
 type ResourceSyncCompletedDetailsDetailType string
 
+// ResourceSyncFieldDiff A difference in a single field between the current and the desired state of a resource.
+type ResourceSyncFieldDiff struct {
+	// Current The current value of the field. Absent if the field does not exist yet.
+	Current interface{} `json:"current,omitempty"`
+
+	// Desired The desired value of the field. Absent if the field would be removed.
+	Desired interface{} `json:"desired,omitempty"`
+
+	// Path A JSON pointer to the field.
+	Path string `json:"path"`
+}
+
 // ResourceSyncList defines model for ResourceSyncList.
 type ResourceSyncList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -2944,6 +3359,45 @@ type ResourceSyncList struct {
 	Metadata ListMeta `json:"metadata"`
 }
 
+// ResourceSyncPlan The changes a ResourceSync would apply for a given revision of its Git repository.
+type ResourceSyncPlan struct {
+	// Changes The resources that would be created, updated or deleted. Unchanged resources are omitted.
+	Changes []ResourceSyncPlannedChange `json:"changes"`
+
+	// Commit The commit hash the revision resolved to.
+	Commit *string `json:"commit,omitempty"`
+
+	// Errors Validation errors found in the repository contents. A plan with errors would not be applied.
+	Errors []string `json:"errors"`
+
+	// TargetRevision The Git revision that was planned.
+	TargetRevision string `json:"targetRevision"`
+}
+
+// ResourceSyncPlanRequest Parameters for planning a ResourceSync.
+type ResourceSyncPlanRequest struct {
+	// TargetRevision The Git revision to plan against. Defaults to the targetRevision of the ResourceSync.
+	TargetRevision *string `json:"targetRevision,omitempty"`
+}
+
+// ResourceSyncPlannedChange A single resource change in a ResourceSync plan.
+type ResourceSyncPlannedChange struct {
+	// Action The action the ResourceSync would take on the resource.
+	Action ResourceSyncPlannedChangeAction `json:"action"`
+
+	// Diff The fields that would change. Sensitive values are redacted.
+	Diff []ResourceSyncFieldDiff `json:"diff"`
+
+	// Kind The kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name The name of the resource.
+	Name string `json:"name"`
+}
+
+// ResourceSyncPlannedChangeAction The action the ResourceSync would take on the resource.
+type ResourceSyncPlannedChangeAction string
+
 // ResourceSyncSpec ResourceSyncSpec describes the file(s) to sync from a repository.
 type ResourceSyncSpec struct {
 	// Path The path of a file or directory in the repository. If a directory, the directory should contain only resource definitions with no subdirectories. Each file should contain the definition of one or more resources.
@@ -2955,7 +3409,7 @@ type ResourceSyncSpec struct {
 	// TargetRevision The desired revision in the repository.
 	TargetRevision string `json:"targetRevision"`
 
-	// Type The type of resources this ResourceSync manages. Defaults to fleet if not specified.
+	// Type The type of resources this ResourceSync manages. Defaults to fleet if not specified. A fleet ResourceSync manages Fleets, a catalog ResourceSync manages Catalogs and CatalogItems, and an organization ResourceSync manages Fleets, Catalogs, CatalogItems, Repositories, AuthProviders and the labels of existing Devices.
 	Type *ResourceSyncType `json:"type,omitempty"`
 }
 
@@ -2971,7 +3425,7 @@ type ResourceSyncStatus struct {
 	ObservedGeneration *int64 `json:"observedGeneration,omitempty"`
 }
 
-// ResourceSyncType The type of resources this ResourceSync manages. Defaults to fleet if not specified.
+// ResourceSyncType The type of resources this ResourceSync manages. Defaults to fleet if not specified. A fleet ResourceSync manages Fleets, a catalog ResourceSync manages Catalogs and CatalogItems, and an organization ResourceSync manages Fleets, Catalogs, CatalogItems, Repositories, AuthProviders and the labels of existing Devices.
 type ResourceSyncType string
 
 // ResourceUpdatedDetails defines model for ResourceUpdatedDetails.
@@ -3012,6 +3466,33 @@ type RolloutDeviceSelection struct {
 	union json.RawMessage
 }
 
+// RolloutFailureAction What to do when a batch of a rollout fails to reach its success threshold. "pause" suspends the rollout, leaving the devices that were already updated on the new TemplateVersion. "rollback" additionally returns those devices to the TemplateVersion that was deployed before the rollout started. Defaults to "pause".
+type RolloutFailureAction string
+
+// RolloutMaintenance RolloutMaintenance restricts when the batches of a rollout may be started. A batch is only started while one of the maintenance windows is open and the current date is not a blackout date. Batches that were already started are not interrupted when a window closes.
+type RolloutMaintenance struct {
+	// BlackoutDates Dates, in the maintenance time zone, on which no batch may be started even if a maintenance window is open.
+	BlackoutDates *[]openapi_types.Date `json:"blackoutDates,omitempty"`
+
+	// TimeZone Time zone identifiers follow the IANA format AREA/LOCATION, where AREA represents a continent or ocean, and LOCATION specifies a particular site within that area, for example America/New_York, Europe/Paris. Only unambiguous 3-character time zones are supported ("GMT", "UTC").
+	TimeZone *TimeZone `json:"timeZone,omitempty"`
+
+	// Windows The maintenance windows in which batches may be started.
+	Windows []RolloutMaintenanceWindow `json:"windows"`
+}
+
+// RolloutMaintenanceWindow RolloutMaintenanceWindow defines a recurring period of time in which batches of a rollout may be started.
+type RolloutMaintenanceWindow struct {
+	// At Cron expression format for scheduling times.
+	// The format is `* * * * *`: - Minutes: `*` matches 0-59. - Hours: `*` matches 0-23. - Day of Month: `*` matches 1-31. - Month: `*` matches 1-12. - Day of Week: `*` matches 0-6.
+	// Supported operators: - `*`: Matches any value (e.g., `*` in hours matches every hour). - `-`: Range (e.g., `0-8` for 12 AM to 8 AM). - `,`: List (e.g., `1,12` for 1st and 12th minute). - `/`: Step (e.g., `*/12` for every 12th minute). - Single value (e.g., `8` matches the 8th minute).
+	// Example: `* 0-8,16-23 * * *`.
+	At CronExpression `json:"at"`
+
+	// Duration How long the window remains open after each time it opens. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
+	Duration string `json:"duration"`
+}
+
 // RolloutPolicy RolloutPolicy is the rollout policy of the fleet.
 type RolloutPolicy struct {
 	// DefaultUpdateTimeout The maximum duration allowed for the action to complete. The duration should be specified as a positive integer followed by a time unit. Supported time units are: `s` for seconds, `m` for minutes, `h` for hours.
@@ -3023,6 +3504,12 @@ type RolloutPolicy struct {
 	// DisruptionBudget DisruptionBudget defines the level of allowed disruption when rollout is in progress.
 	DisruptionBudget *DisruptionBudget `json:"disruptionBudget,omitempty"`
 
+	// Maintenance RolloutMaintenance restricts when the batches of a rollout may be started. A batch is only started while one of the maintenance windows is open and the current date is not a blackout date. Batches that were already started are not interrupted when a window closes.
+	Maintenance *RolloutMaintenance `json:"maintenance,omitempty"`
+
+	// OnFailure What to do when a batch of a rollout fails to reach its success threshold. "pause" suspends the rollout, leaving the devices that were already updated on the new TemplateVersion. "rollback" additionally returns those devices to the TemplateVersion that was deployed before the rollout started. Defaults to "pause".
+	OnFailure *RolloutFailureAction `json:"onFailure,omitempty"`
+
 	// SuccessThreshold Percentage is the string format representing percentage string.
 	SuccessThreshold *Percentage `json:"successThreshold,omitempty"`
 }
@@ -3137,6 +3624,9 @@ type TemplateVersionStatus struct {
 	// Applications List of application providers.
 	Applications *[]ApplicationProviderSpec `json:"applications,omitempty"`
 
+	// Certificates List of workload certificates the agent requests for applications on the device and renews before they expire.
+	Certificates *[]WorkloadCertificateSpec `json:"certificates,omitempty"`
+
 	// Conditions Current state of the device.
 	Conditions []Condition `json:"conditions"`
 
@@ -3152,6 +3642,9 @@ type TemplateVersionStatus struct {
 	// Os DeviceOsSpec describes the target OS for the device.
 	Os *DeviceOsSpec `json:"os,omitempty"`
 
+	// Parameters The fleet's parameter sets at the time the template version was created.
+	Parameters *[]DeviceParameterSet `json:"parameters,omitempty"`
+
 	// Resources Array of resource monitor configurations.
 	Resources *[]ResourceMonitor `json:"resources,omitempty"`
 
@@ -3292,6 +3785,57 @@ type VolumeMount struct {
 	Path string `json:"path"`
 }
 
+// WatchEvent WatchEvent is a single notification streamed by a list endpoint when called with watch=true.
+type WatchEvent struct {
+	// Object The resource the notification refers to. For DELETED notifications only apiVersion, kind and metadata.name are set. For ERROR notifications this is a Status object.
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// ResourceVersion Opaque position of this notification in the watch stream. Pass it as the resourceVersion query parameter to resume the watch after this notification.
+	ResourceVersion string `json:"resourceVersion"`
+
+	// Type The type of change a WatchEvent reports. BOOKMARK carries no object and only advances the resourceVersion. ERROR carries a Status object and ends the stream.
+	Type WatchEventType `json:"type"`
+}
+
+// WatchEventType The type of change a WatchEvent reports. BOOKMARK carries no object and only advances the resourceVersion. ERROR carries a Status object and ends the stream.
+type WatchEventType string
+
+// WorkloadCertificateSpec WorkloadCertificateSpec describes a certificate for applications on the device, issued by the service's workload signer. In fleet templates, the common name, DNS names and IP addresses may reference device labels and fleet parameters.
+type WorkloadCertificateSpec struct {
+	// CertPath The absolute path on the device to write the PEM-encoded certificate to.
+	CertPath string `json:"certPath"`
+
+	// CommonName The subject common name of the certificate.
+	CommonName string `json:"commonName"`
+
+	// DnsNames DNS names to include as subject alternative names.
+	DnsNames *[]string `json:"dnsNames,omitempty"`
+
+	// ExpirationSeconds The requested validity of the certificate in seconds. The signer may issue a certificate with a shorter validity.
+	ExpirationSeconds *int32 `json:"expirationSeconds,omitempty"`
+
+	// Group The group of the certificate and key files, specified either as a name or numeric ID. Defaults to "root".
+	Group string `json:"group,omitempty"`
+
+	// IpAddresses IP addresses to include as subject alternative names.
+	IpAddresses *[]string `json:"ipAddresses,omitempty"`
+
+	// KeyPath The absolute path on the device to write the PEM-encoded private key to.
+	KeyPath string `json:"keyPath"`
+
+	// Name The name of the certificate, unique within the device spec.
+	Name string `json:"name"`
+
+	// ReloadCommand A command and its arguments the agent runs after writing a new certificate, for example to make the application reload it. The command is executed directly, not through a shell.
+	ReloadCommand *[]string `json:"reloadCommand,omitempty"`
+
+	// SignerName The signer the agent requests the certificate from. Set by the service when serving the rendered device spec to the agent.
+	SignerName *string `json:"signerName,omitempty"`
+
+	// User The owner of the certificate and key files, specified either as a name or numeric ID. Defaults to "root".
+	User Username `json:"user,omitempty"`
+}
+
 // AuthValidateParams defines parameters for AuthValidate.
 type AuthValidateParams struct {
 	// Authorization The authentication token to validate.
@@ -3347,6 +3891,12 @@ type ListDevicesParams struct {
 
 	// CveId Filter devices by CVE ID. Only returns devices whose OS image digest has the specified vulnerability. Must be a MITRE-style identifier (CVE-YYYY-sequence, e.g. CVE-2024-12345).
 	CveId *string `form:"cveId,omitempty" json:"cveId,omitempty"`
+
+	// Watch If true, keep the connection open and stream ADDED, MODIFIED and DELETED notifications for matching devices as Server-Sent Events instead of returning a list. Unless resourceVersion is set, the stream starts with an ADDED notification for every matching device followed by a BOOKMARK.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion Only valid together with 'watch'. Resumes a watch after the position identified by the resourceVersion of a previously received WatchEvent. If the position is no longer available, the server responds with 410 Gone and the client must restart the watch without a resourceVersion.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // GetRenderedDeviceParams defines parameters for GetRenderedDevice.
@@ -3389,6 +3939,12 @@ type ListEventsParams struct {
 
 	// Continue An optional parameter to query more results from the server. The value of the paramter must match the value of the 'continue' field in the previous list response.
 	Continue *string `form:"continue,omitempty" json:"continue,omitempty"`
+
+	// Watch If true, keep the connection open and stream an ADDED notification for every new matching event as Server-Sent Events instead of returning a list. Unless resourceVersion is set, the stream starts with an ADDED notification for every existing matching event followed by a BOOKMARK.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion Only valid together with 'watch'. Resumes a watch after the position identified by the resourceVersion of a previously received WatchEvent. If the position is no longer available, the server responds with 410 Gone and the client must restart the watch without a resourceVersion.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListEventsParamsOrder defines parameters for ListEvents.
@@ -3410,6 +3966,12 @@ type ListFleetsParams struct {
 
 	// AddDevicesSummary Include a summary of the devices in the fleet.
 	AddDevicesSummary *bool `form:"addDevicesSummary,omitempty" json:"addDevicesSummary,omitempty"`
+
+	// Watch If true, keep the connection open and stream ADDED, MODIFIED and DELETED notifications for matching fleets as Server-Sent Events instead of returning a list. Unless resourceVersion is set, the stream starts with an ADDED notification for every matching fleet followed by a BOOKMARK.
+	Watch *bool `form:"watch,omitempty" json:"watch,omitempty"`
+
+	// ResourceVersion Only valid together with 'watch'. Resumes a watch after the position identified by the resourceVersion of a previously received WatchEvent. If the position is no longer available, the server responds with 410 Gone and the client must restart the watch without a resourceVersion.
+	ResourceVersion *string `form:"resourceVersion,omitempty" json:"resourceVersion,omitempty"`
 }
 
 // ListTemplateVersionsParams defines parameters for ListTemplateVersions.
@@ -3499,6 +4061,15 @@ type GetDeviceApplicationConsoleParams struct {
 // GetDeviceApplicationConsoleParamsConsoleType defines parameters for GetDeviceApplicationConsole.
 type GetDeviceApplicationConsoleParamsConsoleType string
 
+// GetDevicePortForwardParams defines parameters for GetDevicePortForward.
+type GetDevicePortForwardParams struct {
+	// Host The target host as resolved on the Device. Defaults to localhost.
+	Host *string `form:"host,omitempty" json:"host,omitempty"`
+
+	// Port The target TCP port.
+	Port int `form:"port" json:"port"`
+}
+
 // AuthTokenJSONRequestBody defines body for AuthToken for application/json ContentType.
 type AuthTokenJSONRequestBody = TokenRequest
 
@@ -3541,6 +4112,9 @@ type ReplaceDeviceJSONRequestBody = Device
 // DecommissionDeviceJSONRequestBody defines body for DecommissionDevice for application/json ContentType.
 type DecommissionDeviceJSONRequestBody = DeviceDecommission
 
+// RevokeDeviceCertificatesJSONRequestBody defines body for RevokeDeviceCertificates for application/json ContentType.
+type RevokeDeviceCertificatesJSONRequestBody = DeviceCertificateRevocationRequest
+
 // PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody defines body for PatchDeviceStatus for application/json-patch+json ContentType.
 type PatchDeviceStatusApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 
@@ -3604,6 +4178,9 @@ type PatchResourceSyncApplicationJSONPatchPlusJSONRequestBody = PatchRequest
 // ReplaceResourceSyncJSONRequestBody defines body for ReplaceResourceSync for application/json ContentType.
 type ReplaceResourceSyncJSONRequestBody = ResourceSync
 
+// PlanResourceSyncJSONRequestBody defines body for PlanResourceSync for application/json ContentType.
+type PlanResourceSyncJSONRequestBody = ResourceSyncPlanRequest
+
 // Getter for additional properties for DeviceSystemInfo. Returns the specified
 // element and whether it was found
 func (a DeviceSystemInfo) Get(fieldName string) (value string, found bool) {
@@ -5280,6 +5857,90 @@ func (t *EventDetails) MergeFleetRolloutDeviceSelectedDetails(v FleetRolloutDevi
 	return err
 }
 
+// AsFleetRolloutRollbackStartedDetails returns the union data inside the EventDetails as a FleetRolloutRollbackStartedDetails
+func (t EventDetails) AsFleetRolloutRollbackStartedDetails() (FleetRolloutRollbackStartedDetails, error) {
+	var body FleetRolloutRollbackStartedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRollbackStartedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRollbackStartedDetails
+func (t *EventDetails) FromFleetRolloutRollbackStartedDetails(v FleetRolloutRollbackStartedDetails) error {
+	v.DetailType = "FleetRolloutRollbackStarted"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRollbackStartedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRollbackStartedDetails
+func (t *EventDetails) MergeFleetRolloutRollbackStartedDetails(v FleetRolloutRollbackStartedDetails) error {
+	v.DetailType = "FleetRolloutRollbackStarted"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetRolloutRollbackCompletedDetails returns the union data inside the EventDetails as a FleetRolloutRollbackCompletedDetails
+func (t EventDetails) AsFleetRolloutRollbackCompletedDetails() (FleetRolloutRollbackCompletedDetails, error) {
+	var body FleetRolloutRollbackCompletedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRollbackCompletedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRollbackCompletedDetails
+func (t *EventDetails) FromFleetRolloutRollbackCompletedDetails(v FleetRolloutRollbackCompletedDetails) error {
+	v.DetailType = "FleetRolloutRollbackCompleted"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRollbackCompletedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRollbackCompletedDetails
+func (t *EventDetails) MergeFleetRolloutRollbackCompletedDetails(v FleetRolloutRollbackCompletedDetails) error {
+	v.DetailType = "FleetRolloutRollbackCompleted"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsFleetRolloutRollbackFailedDetails returns the union data inside the EventDetails as a FleetRolloutRollbackFailedDetails
+func (t EventDetails) AsFleetRolloutRollbackFailedDetails() (FleetRolloutRollbackFailedDetails, error) {
+	var body FleetRolloutRollbackFailedDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromFleetRolloutRollbackFailedDetails overwrites any union data inside the EventDetails as the provided FleetRolloutRollbackFailedDetails
+func (t *EventDetails) FromFleetRolloutRollbackFailedDetails(v FleetRolloutRollbackFailedDetails) error {
+	v.DetailType = "FleetRolloutRollbackFailed"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeFleetRolloutRollbackFailedDetails performs a merge with any union data inside the EventDetails, using the provided FleetRolloutRollbackFailedDetails
+func (t *EventDetails) MergeFleetRolloutRollbackFailedDetails(v FleetRolloutRollbackFailedDetails) error {
+	v.DetailType = "FleetRolloutRollbackFailed"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 // AsDeviceVulnerabilityCveDetails returns the union data inside the EventDetails as a DeviceVulnerabilityCveDetails
 func (t EventDetails) AsDeviceVulnerabilityCveDetails() (DeviceVulnerabilityCveDetails, error) {
 	var body DeviceVulnerabilityCveDetails
@@ -5392,6 +6053,34 @@ func (t *EventDetails) MergeApplicationLifecycleChangedDetails(v ApplicationLife
 	return err
 }
 
+// AsDeviceFileTransferDetails returns the union data inside the EventDetails as a DeviceFileTransferDetails
+func (t EventDetails) AsDeviceFileTransferDetails() (DeviceFileTransferDetails, error) {
+	var body DeviceFileTransferDetails
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromDeviceFileTransferDetails overwrites any union data inside the EventDetails as the provided DeviceFileTransferDetails
+func (t *EventDetails) FromDeviceFileTransferDetails(v DeviceFileTransferDetails) error {
+	v.DetailType = "DeviceFileTransfer"
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeDeviceFileTransferDetails performs a merge with any union data inside the EventDetails, using the provided DeviceFileTransferDetails
+func (t *EventDetails) MergeDeviceFileTransferDetails(v DeviceFileTransferDetails) error {
+	v.DetailType = "DeviceFileTransfer"
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t EventDetails) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"detailType"`
@@ -5412,6 +6101,8 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsDependencyChangeDetectedDetails()
 	case "DependencySyncProbeFailed":
 		return t.AsDependencySyncProbeFailedDetails()
+	case "DeviceFileTransfer":
+		return t.AsDeviceFileTransferDetails()
 	case "DeviceMultipleOwnersDetected":
 		return t.AsDeviceMultipleOwnersDetectedDetails()
 	case "DeviceMultipleOwnersResolved":
@@ -5430,6 +6121,12 @@ func (t EventDetails) ValueByDiscriminator() (interface{}, error) {
 		return t.AsFleetRolloutDeviceSelectedDetails()
 	case "FleetRolloutFailed":
 		return t.AsFleetRolloutFailedDetails()
+	case "FleetRolloutRollbackCompleted":
+		return t.AsFleetRolloutRollbackCompletedDetails()
+	case "FleetRolloutRollbackFailed":
+		return t.AsFleetRolloutRollbackFailedDetails()
+	case "FleetRolloutRollbackStarted":
+		return t.AsFleetRolloutRollbackStartedDetails()
 	case "FleetRolloutStarted":
 		return t.AsFleetRolloutStartedDetails()
 	case "InternalTaskFailed":
@@ -5483,6 +6180,58 @@ func (t *HookAction) MergeHookActionRun(v HookActionRun) error {
 	return err
 }
 
+// AsHookActionSystemd returns the union data inside the HookAction as a HookActionSystemd
+func (t HookAction) AsHookActionSystemd() (HookActionSystemd, error) {
+	var body HookActionSystemd
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionSystemd overwrites any union data inside the HookAction as the provided HookActionSystemd
+func (t *HookAction) FromHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionSystemd performs a merge with any union data inside the HookAction, using the provided HookActionSystemd
+func (t *HookAction) MergeHookActionSystemd(v HookActionSystemd) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookActionHttpProbe returns the union data inside the HookAction as a HookActionHttpProbe
+func (t HookAction) AsHookActionHttpProbe() (HookActionHttpProbe, error) {
+	var body HookActionHttpProbe
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookActionHttpProbe overwrites any union data inside the HookAction as the provided HookActionHttpProbe
+func (t *HookAction) FromHookActionHttpProbe(v HookActionHttpProbe) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookActionHttpProbe performs a merge with any union data inside the HookAction, using the provided HookActionHttpProbe
+func (t *HookAction) MergeHookActionHttpProbe(v HookActionHttpProbe) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t HookAction) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	if err != nil {
@@ -5503,6 +6252,13 @@ func (t HookAction) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if t.OnFailure != nil {
+		object["onFailure"], err = json.Marshal(t.OnFailure)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'onFailure': %w", err)
+		}
+	}
+
 	if t.Timeout != nil {
 		object["timeout"], err = json.Marshal(t.Timeout)
 		if err != nil {
@@ -5531,6 +6287,13 @@ func (t *HookAction) UnmarshalJSON(b []byte) error {
 		}
 	}
 
+	if raw, found := object["onFailure"]; found {
+		err = json.Unmarshal(raw, &t.OnFailure)
+		if err != nil {
+			return fmt.Errorf("error reading 'onFailure': %w", err)
+		}
+	}
+
 	if raw, found := object["timeout"]; found {
 		err = json.Unmarshal(raw, &t.Timeout)
 		if err != nil {
@@ -5593,6 +6356,110 @@ func (t *HookCondition) MergeHookConditionExpression(v HookConditionExpression)
 	return err
 }
 
+// AsHookConditionOsImage returns the union data inside the HookCondition as a HookConditionOsImage
+func (t HookCondition) AsHookConditionOsImage() (HookConditionOsImage, error) {
+	var body HookConditionOsImage
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookConditionOsImage overwrites any union data inside the HookCondition as the provided HookConditionOsImage
+func (t *HookCondition) FromHookConditionOsImage(v HookConditionOsImage) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookConditionOsImage performs a merge with any union data inside the HookCondition, using the provided HookConditionOsImage
+func (t *HookCondition) MergeHookConditionOsImage(v HookConditionOsImage) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookConditionApplicationOp returns the union data inside the HookCondition as a HookConditionApplicationOp
+func (t HookCondition) AsHookConditionApplicationOp() (HookConditionApplicationOp, error) {
+	var body HookConditionApplicationOp
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookConditionApplicationOp overwrites any union data inside the HookCondition as the provided HookConditionApplicationOp
+func (t *HookCondition) FromHookConditionApplicationOp(v HookConditionApplicationOp) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookConditionApplicationOp performs a merge with any union data inside the HookCondition, using the provided HookConditionApplicationOp
+func (t *HookCondition) MergeHookConditionApplicationOp(v HookConditionApplicationOp) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookConditionLabels returns the union data inside the HookCondition as a HookConditionLabels
+func (t HookCondition) AsHookConditionLabels() (HookConditionLabels, error) {
+	var body HookConditionLabels
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookConditionLabels overwrites any union data inside the HookCondition as the provided HookConditionLabels
+func (t *HookCondition) FromHookConditionLabels(v HookConditionLabels) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookConditionLabels performs a merge with any union data inside the HookCondition, using the provided HookConditionLabels
+func (t *HookCondition) MergeHookConditionLabels(v HookConditionLabels) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsHookConditionSystemInfo returns the union data inside the HookCondition as a HookConditionSystemInfo
+func (t HookCondition) AsHookConditionSystemInfo() (HookConditionSystemInfo, error) {
+	var body HookConditionSystemInfo
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromHookConditionSystemInfo overwrites any union data inside the HookCondition as the provided HookConditionSystemInfo
+func (t *HookCondition) FromHookConditionSystemInfo(v HookConditionSystemInfo) error {
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeHookConditionSystemInfo performs a merge with any union data inside the HookCondition, using the provided HookConditionSystemInfo
+func (t *HookCondition) MergeHookConditionSystemInfo(v HookConditionSystemInfo) error {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JSONMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t HookCondition) MarshalJSON() ([]byte, error) {
 	b, err := t.union.MarshalJSON()
 	return b, err
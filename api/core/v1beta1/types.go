@@ -36,6 +36,35 @@ type DeviceConsoleSessionMetadata struct {
 	Command           *DeviceCommand `json:"command,omitempty"`
 	TTY               bool           `json:"tty,omitempty"`
 	Protocols         []string       `json:"protocols,omitempty"`
+	// PortForward is set instead of Command when the session tunnels a TCP connection
+	// to a target reachable from the device rather than running a shell.
+	PortForward *DevicePortForward `json:"portForward,omitempty"`
+	// FileCopy is set instead of Command when the session copies a file to or from the device.
+	FileCopy *DeviceFileCopy `json:"fileCopy,omitempty"`
+}
+
+// DevicePortForward is the TCP target of a port-forward session, as seen from the device.
+type DevicePortForward struct {
+	Host string `json:"host"`
+	Port int    `json:"port"`
+}
+
+// DeviceFileCopy is the file transferred by a file-copy session.
+type DeviceFileCopy struct {
+	Direction DeviceFileTransferDetailsDirection `json:"direction"`
+	// Path is the absolute path of the file on the device.
+	Path string `json:"path"`
+	// Size and Mode describe the file being uploaded. They are not set for downloads.
+	Size int64  `json:"size,omitempty"`
+	Mode uint32 `json:"mode,omitempty"`
+}
+
+// DeviceFileCopyStatus is the last message the agent sends on the status channel of a
+// file-copy session. Error is set if the transfer failed.
+type DeviceFileCopyStatus struct {
+	Size  int64  `json:"size"`
+	Mode  uint32 `json:"mode,omitempty"`
+	Error string `json:"error,omitempty"`
 }
 
 type RolloutBatchCompletionReport struct {
@@ -45,6 +74,7 @@ type RolloutBatchCompletionReport struct {
 	Successful        int64  `json:"successful"`
 	Failed            int64  `json:"failed"`
 	TimedOut          int64  `json:"timedOut"`
+	Unhealthy         int64  `json:"unhealthy,omitempty"`
 }
 
 // A username on the system
@@ -4,8 +4,13 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"slices"
+	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
+
+	"github.com/samber/lo"
 )
 
 type DeviceCompletionCount struct {
@@ -14,19 +19,29 @@ type DeviceCompletionCount struct {
 	SameTemplateVersion bool
 	UpdatingReason      UpdateState
 	UpdateTimedOut      bool
+	ApplicationsStatus  ApplicationsSummaryStatusType
+	ResourcesCritical   bool
+	Soaked              bool
+	HealthySoaked       bool
 }
 
 type HookActionType string
 
 const (
-	HookActionTypeRun HookActionType = "run"
+	HookActionTypeRun       HookActionType = "run"
+	HookActionTypeSystemd   HookActionType = "systemd"
+	HookActionTypeHttpProbe HookActionType = "httpProbe"
 )
 
 type HookConditionType string
 
 const (
-	HookConditionTypePathOp     HookConditionType = "path"
-	HookConditionTypeExpression HookConditionType = "expression"
+	HookConditionTypePathOp        HookConditionType = "path"
+	HookConditionTypeExpression    HookConditionType = "expression"
+	HookConditionTypeOsImage       HookConditionType = "osImageChanged"
+	HookConditionTypeApplicationOp HookConditionType = "application"
+	HookConditionTypeLabels        HookConditionType = "labels"
+	HookConditionTypeSystemInfo    HookConditionType = "systemInfo"
 )
 
 type ConfigProviderType string
@@ -68,6 +83,8 @@ func (t HookAction) Type() (HookActionType, error) {
 
 	types := []HookActionType{
 		HookActionTypeRun,
+		HookActionTypeSystemd,
+		HookActionTypeHttpProbe,
 	}
 	for _, t := range types {
 		if _, exists := data[t]; exists {
@@ -91,6 +108,10 @@ func (t HookCondition) Type() (HookConditionType, error) {
 
 	types := []HookConditionType{
 		HookConditionTypePathOp,
+		HookConditionTypeOsImage,
+		HookConditionTypeApplicationOp,
+		HookConditionTypeLabels,
+		HookConditionTypeSystemInfo,
 	}
 	for _, t := range types {
 		if _, exists := data[string(t)]; exists {
@@ -303,71 +324,30 @@ func (c ApplicationVolume) GetReclaimPolicy() ApplicationVolumeReclaimPolicy {
 	return *c.ReclaimPolicy
 }
 
-// Some functions that we provide to users.  In case of a missing label,
-// we may get an interface{} rather than string because
-// ExecuteGoTemplateOnDevice() converts the Device struct to a map.
-// Therefore our functions here need to ensure we get a string, and if
-// not then they return an empty string.  Note that this will only
-// happen if the "missingkey=zero" option is used in the template.  If
-// "missingkey=error" is used, the template execution will fail and we
-// won't get to this point.
-func GetGoTemplateFuncMap() template.FuncMap {
-	stringOrDefault := func(s any) string {
-		str, ok := s.(string)
-		if ok {
-			return str
-		}
-		strPtr, ok := s.(*string)
-		if ok && strPtr != nil {
-			return *strPtr
-		}
-		return ""
-	}
-
-	toUpper := func(s any) string {
-		return strings.ToUpper(stringOrDefault(s))
-	}
-
-	toLower := func(s any) string {
-		return strings.ToLower(stringOrDefault(s))
-	}
-
-	replace := func(old, new string, input any) string {
-		return strings.Replace(stringOrDefault(input), old, new, -1)
-	}
-
-	getOrDefault := func(m *map[string]string, key string, defaultValue string) string {
-		if m == nil {
-			return defaultValue
-		}
-		if val, ok := (*m)[key]; ok {
-			return val
-		}
-		return defaultValue
-	}
-
-	return template.FuncMap{
-		"upper":        toUpper,
-		"lower":        toLower,
-		"replace":      replace,
-		"getOrDefault": getOrDefault,
-	}
-}
-
 // This function wraps template.Execute.  Instead of passing the device directly,
 // it converts it into a map first.  This has two purposes:
 // 1. The user-provided template uses the yaml/json API format (e.g., lower case)
 // 2. The map contains only the device fields we allow access to
 func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error) {
+	return ExecuteGoTemplateOnDeviceWithParameters(t, dev, nil)
+}
+
+// ExecuteGoTemplateOnDeviceWithParameters is like ExecuteGoTemplateOnDevice, but also exposes
+// the device's template parameters as .parameters
+func ExecuteGoTemplateOnDeviceWithParameters(t *template.Template, dev *Device, parameters map[string]string) (string, error) {
 	var name string
 	if dev.Metadata.Name != nil {
 		name = *dev.Metadata.Name
 	}
+	if parameters == nil {
+		parameters = map[string]string{}
+	}
 	devMap := map[string]interface{}{
 		"metadata": map[string]interface{}{
 			"name":   name,
 			"labels": dev.Metadata.Labels,
 		},
+		"parameters": &parameters,
 	}
 
 	buf := new(bytes.Buffer)
@@ -378,6 +358,164 @@ func ExecuteGoTemplateOnDevice(t *template.Template, dev *Device) (string, error
 	return buf.String(), nil
 }
 
+// GetDeviceTemplateParameters returns the template parameters of a device by merging the values of
+// the parameter sets that apply to it, in order.  A set applies to a device if it lists the device's
+// name, if its selector matches the device's labels, or if it has neither devices nor a selector.
+func GetDeviceTemplateParameters(dev *Device, parameterSets *[]DeviceParameterSet) map[string]string {
+	parameters := map[string]string{}
+	if parameterSets == nil {
+		return parameters
+	}
+	var name string
+	if dev.Metadata.Name != nil {
+		name = *dev.Metadata.Name
+	}
+	var labels map[string]string
+	if dev.Metadata.Labels != nil {
+		labels = *dev.Metadata.Labels
+	}
+	for _, set := range *parameterSets {
+		switch {
+		case set.Devices != nil:
+			if !slices.Contains(*set.Devices, name) {
+				continue
+			}
+		case set.Selector != nil:
+			if !set.Selector.Matches(labels) {
+				continue
+			}
+		}
+		for k, v := range set.Values {
+			parameters[k] = v
+		}
+	}
+	return parameters
+}
+
+// MissingTemplateParameters returns the sorted names of the parameters referenced as
+// .parameters.<name> (or $.parameters.<name>) by the template that are not defined in parameters.
+// References with a default, such as {{ getOrDefault .parameters "name" "default" }}, are not required.
+func MissingTemplateParameters(t *template.Template, parameters map[string]string) []string {
+	if t == nil || t.Tree == nil || t.Root == nil {
+		return nil
+	}
+	referenced := map[string]struct{}{}
+	collectTemplateParameters(t.Root.Nodes, true, referenced)
+	var missing []string
+	for key := range referenced {
+		if _, ok := parameters[key]; !ok {
+			missing = append(missing, key)
+		}
+	}
+	sort.Strings(missing)
+	return missing
+}
+
+func collectTemplateParameters(nodes []parse.Node, rootContext bool, referenced map[string]struct{}) {
+	for _, node := range nodes {
+		var pipe *parse.PipeNode
+		var branch *parse.BranchNode
+		switch n := node.(type) {
+		case *parse.ActionNode:
+			pipe = n.Pipe
+		case *parse.IfNode:
+			branch = &n.BranchNode
+		case *parse.WithNode:
+			branch = &n.BranchNode
+		case *parse.RangeNode:
+			branch = &n.BranchNode
+		}
+		if branch != nil {
+			pipe = branch.Pipe
+			if branch.List != nil {
+				// Inside a 'with' or 'range' body the dot no longer refers to the device
+				collectTemplateParameters(branch.List.Nodes, rootContext && branch.NodeType == parse.NodeIf, referenced)
+			}
+			if branch.ElseList != nil {
+				collectTemplateParameters(branch.ElseList.Nodes, rootContext, referenced)
+			}
+		}
+		collectPipeParameters(pipe, rootContext, referenced)
+	}
+}
+
+func collectPipeParameters(pipe *parse.PipeNode, rootContext bool, referenced map[string]struct{}) {
+	if pipe == nil {
+		return
+	}
+	for _, cmd := range pipe.Cmds {
+		for _, arg := range cmd.Args {
+			var ident []string
+			switch a := arg.(type) {
+			case *parse.FieldNode:
+				if rootContext {
+					ident = a.Ident
+				}
+			case *parse.VariableNode:
+				if len(a.Ident) > 1 && a.Ident[0] == "$" {
+					ident = a.Ident[1:]
+				}
+			case *parse.PipeNode:
+				collectPipeParameters(a, rootContext, referenced)
+			}
+			if len(ident) >= 2 && ident[0] == "parameters" {
+				referenced[ident[1]] = struct{}{}
+			}
+		}
+	}
+}
+
+// Matches returns true if the selector matches the given labels.  Like in the API, an empty
+// selector matches nothing.
+func (l LabelSelector) Matches(labels map[string]string) bool {
+	if len(lo.FromPtr(l.MatchLabels)) == 0 && len(lo.FromPtr(l.MatchExpressions)) == 0 {
+		return false
+	}
+	for k, v := range lo.FromPtr(l.MatchLabels) {
+		if value, ok := labels[k]; !ok || value != v {
+			return false
+		}
+	}
+	for _, e := range lo.FromPtr(l.MatchExpressions) {
+		value, exists := labels[e.Key]
+		switch e.Operator {
+		case Exists:
+			if !exists {
+				return false
+			}
+		case DoesNotExist:
+			if exists {
+				return false
+			}
+		case In:
+			if !exists || !slices.Contains(lo.FromPtr(e.Values), value) {
+				return false
+			}
+		case NotIn:
+			if exists && slices.Contains(lo.FromPtr(e.Values), value) {
+				return false
+			}
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// String converts the selector into the label selector syntax used by the list APIs, for example
+// "site=paris,tier in (edge, core)".  The requirements are sorted so the result is stable.
+func (l LabelSelector) String() string {
+	requirements := make([]string, 0, len(lo.FromPtr(l.MatchLabels))+len(lo.FromPtr(l.MatchExpressions)))
+	for k, v := range lo.FromPtr(l.MatchLabels) {
+		requirements = append(requirements, k+"="+v)
+	}
+	for _, e := range lo.FromPtr(l.MatchExpressions) {
+		requirements = append(requirements, e.String())
+	}
+	slices.Sort(requirements)
+	return strings.Join(requirements, ",")
+}
+
 // String converts a MatchExpression into its string representation.
 // Example formats:
 // - Exists: "key"
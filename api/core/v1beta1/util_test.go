@@ -2,6 +2,7 @@ package v1beta1
 
 import (
 	"testing"
+	"text/template"
 
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
@@ -1196,3 +1197,114 @@ func TestAuthProviderPreserveSensitiveData(t *testing.T) {
 		})
 	}
 }
+
+func TestGetDeviceTemplateParameters(t *testing.T) {
+	device := &Device{
+		Metadata: ObjectMeta{
+			Name:   lo.ToPtr("device-1"),
+			Labels: &map[string]string{"site": "paris", "tier": "edge"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		sets     *[]DeviceParameterSet
+		expected map[string]string
+	}{
+		{
+			name:     "no parameter sets",
+			expected: map[string]string{},
+		},
+		{
+			name: "sets are merged in order",
+			sets: &[]DeviceParameterSet{
+				{Values: map[string]string{"version": "v1", "endpoint": "default"}},
+				{Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "paris"}}, Values: map[string]string{"endpoint": "paris"}},
+				{Devices: &[]string{"device-1"}, Values: map[string]string{"version": "v2"}},
+			},
+			expected: map[string]string{"version": "v2", "endpoint": "paris"},
+		},
+		{
+			name: "non-matching sets are ignored",
+			sets: &[]DeviceParameterSet{
+				{Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "berlin"}}, Values: map[string]string{"endpoint": "berlin"}},
+				{Devices: &[]string{"device-2"}, Values: map[string]string{"version": "v2"}},
+				{
+					Selector: &LabelSelector{MatchExpressions: &MatchExpressions{{Key: "tier", Operator: In, Values: &[]string{"edge", "core"}}}},
+					Values:   map[string]string{"tier_class": "small"},
+				},
+			},
+			expected: map[string]string{"tier_class": "small"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, GetDeviceTemplateParameters(device, tt.sets))
+		})
+	}
+}
+
+func TestMissingTemplateParameters(t *testing.T) {
+	parameters := map[string]string{"version": "v1"}
+
+	tests := []struct {
+		name     string
+		template string
+		expected []string
+	}{
+		{"no parameters", "{{ .metadata.name }}", nil},
+		{"defined parameter", "{{ .parameters.version }}", nil},
+		{"missing parameters", "{{ .parameters.zone }}-{{ .parameters.version }}-{{ .parameters.region }}", []string{"region", "zone"}},
+		{"missing parameter in function", "{{ upper .parameters.zone }}", []string{"zone"}},
+		{"missing parameter in pipeline", "{{ .parameters.zone | lower }}", []string{"zone"}},
+		{"missing parameter in if", "{{ if .parameters.zone }}a{{ else }}{{ .parameters.region }}{{ end }}", []string{"region", "zone"}},
+		{"missing root parameter in with", "{{ with .metadata.labels }}{{ $.parameters.zone }}{{ end }}", []string{"zone"}},
+		{"parameter with default", `{{ getOrDefault .parameters "zone" "eu" }}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := template.New("t").Funcs(GetGoTemplateFuncMap()).Parse(tt.template)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, MissingTemplateParameters(tmpl, parameters))
+		})
+	}
+}
+
+func TestLabelSelectorMatches(t *testing.T) {
+	labels := map[string]string{"site": "paris", "tier": "edge"}
+
+	tests := []struct {
+		name     string
+		selector LabelSelector
+		expected bool
+	}{
+		{"empty selector", LabelSelector{}, false},
+		{"matching labels", LabelSelector{MatchLabels: &map[string]string{"site": "paris"}}, true},
+		{"non-matching labels", LabelSelector{MatchLabels: &map[string]string{"site": "berlin"}}, false},
+		{"exists", LabelSelector{MatchExpressions: &MatchExpressions{{Key: "tier", Operator: Exists}}}, true},
+		{"does not exist", LabelSelector{MatchExpressions: &MatchExpressions{{Key: "tier", Operator: DoesNotExist}}}, false},
+		{"in", LabelSelector{MatchExpressions: &MatchExpressions{{Key: "site", Operator: In, Values: &[]string{"paris", "berlin"}}}}, true},
+		{"not in", LabelSelector{MatchExpressions: &MatchExpressions{{Key: "site", Operator: NotIn, Values: &[]string{"paris"}}}}, false},
+		{"not in absent label", LabelSelector{MatchExpressions: &MatchExpressions{{Key: "zone", Operator: NotIn, Values: &[]string{"a"}}}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.selector.Matches(labels))
+		})
+	}
+}
+
+func TestLabelSelectorString(t *testing.T) {
+	selector := LabelSelector{
+		MatchLabels: &map[string]string{"site": "paris", "env": "prod"},
+		MatchExpressions: &[]MatchExpression{
+			{Key: "tier", Operator: In, Values: &[]string{"edge", "core"}},
+			{Key: "retired", Operator: DoesNotExist},
+		},
+	}
+	require.Equal(t, "!retired,env=prod,site=paris,tier in (edge, core)", selector.String())
+	require.Equal(t, "", LabelSelector{}.String())
+}
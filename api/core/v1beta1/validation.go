@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net"
 	"net/url"
 	"reflect"
 	"regexp"
@@ -116,6 +117,86 @@ func (r DeviceSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateSystemdName(&matchPattern, fmt.Sprintf("spec.systemd.matchPatterns[%d]", i))...)
 		}
 	}
+	if r.Certificates != nil {
+		allErrs = append(allErrs, validateWorkloadCertificates(*r.Certificates, fleetTemplate)...)
+	}
+	return allErrs
+}
+
+func validateWorkloadCertificates(certs []WorkloadCertificateSpec, fleetTemplate bool) []error {
+	allErrs := []error{}
+	seenNames := make(map[string]struct{}, len(certs))
+	seenPaths := make(map[string]struct{}, 2*len(certs))
+	for i, c := range certs {
+		path := fmt.Sprintf("spec.certificates[%d]", i)
+		allErrs = append(allErrs, c.Validate(path, fleetTemplate)...)
+
+		if _, exists := seenNames[c.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: duplicate certificate name %q", path, c.Name))
+		}
+		seenNames[c.Name] = struct{}{}
+		for _, p := range []string{c.CertPath, c.KeyPath} {
+			if _, exists := seenPaths[p]; exists {
+				allErrs = append(allErrs, fmt.Errorf("%s: path %q is used by more than one certificate", path, p))
+			}
+			seenPaths[p] = struct{}{}
+		}
+	}
+	return allErrs
+}
+
+func (c WorkloadCertificateSpec) Validate(path string, fleetTemplate bool) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateGenericName(&c.Name, path+".name")...)
+
+	containsParams, paramErrs := validateParametersInString(&c.CommonName, path+".commonName", fleetTemplate)
+	allErrs = append(allErrs, paramErrs...)
+	if !containsParams {
+		allErrs = append(allErrs, validation.ValidateString(&c.CommonName, path+".commonName", 1, 64, nil, "")...)
+	}
+	for j := range lo.FromPtr(c.DnsNames) {
+		dnsName := (*c.DnsNames)[j]
+		containsParams, paramErrs = validateParametersInString(&dnsName, fmt.Sprintf("%s.dnsNames[%d]", path, j), fleetTemplate)
+		allErrs = append(allErrs, paramErrs...)
+		if !containsParams {
+			allErrs = append(allErrs, validation.ValidateHostnameOrFQDN(&dnsName, fmt.Sprintf("%s.dnsNames[%d]", path, j))...)
+		}
+	}
+	for j := range lo.FromPtr(c.IpAddresses) {
+		ip := (*c.IpAddresses)[j]
+		containsParams, paramErrs = validateParametersInString(&ip, fmt.Sprintf("%s.ipAddresses[%d]", path, j), fleetTemplate)
+		allErrs = append(allErrs, paramErrs...)
+		if !containsParams && net.ParseIP(ip) == nil {
+			allErrs = append(allErrs, validation.FormatInvalidError(ip, fmt.Sprintf("%s.ipAddresses[%d]", path, j), "must be a valid IP address")...)
+		}
+	}
+	if c.ExpirationSeconds != nil && *c.ExpirationSeconds < 600 {
+		allErrs = append(allErrs, fmt.Errorf("%s.expirationSeconds: must be at least 600", path))
+	}
+
+	for _, f := range []struct{ name, value string }{{"certPath", c.CertPath}, {"keyPath", c.KeyPath}} {
+		allErrs = append(allErrs, validation.ValidateFilePath(&f.value, path+"."+f.name)...)
+		if err := validation.DenyForbiddenDevicePath(f.value); err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.%s: %w", path, f.name, err))
+		}
+	}
+	if c.CertPath == c.KeyPath {
+		allErrs = append(allErrs, fmt.Errorf("%s: certPath and keyPath must differ", path))
+	}
+
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.User.String(), path+".user")...)
+	allErrs = append(allErrs, validation.ValidateLinuxUserGroup(c.Group, path+".group")...)
+	if c.ReloadCommand != nil {
+		if len(*c.ReloadCommand) == 0 || (*c.ReloadCommand)[0] == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.reloadCommand: must start with the command to run", path))
+		}
+		for j := range *c.ReloadCommand {
+			allErrs = append(allErrs, validation.ValidateString(&(*c.ReloadCommand)[j], fmt.Sprintf("%s.reloadCommand[%d]", path, j), 0, 2048, nil, "")...)
+		}
+	}
+	if c.SignerName != nil {
+		allErrs = append(allErrs, fmt.Errorf("%s.signerName: is set by the service and must not be specified", path))
+	}
 	return allErrs
 }
 
@@ -216,6 +297,20 @@ func (a HookAction) Validate(path string) []error {
 		// TODO: pull the extra validation done by the agent up here
 		allErrs = append(allErrs, validation.ValidateStringMap(runAction.EnvVars, path+".envVars", 1, 256, nil, nil, "")...)
 		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(runAction.WorkDir, path+".workDir")...)
+	case HookActionTypeSystemd:
+		systemdAction, err := a.AsHookActionSystemd()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, systemdAction.Systemd.Validate(path+".systemd")...)
+	case HookActionTypeHttpProbe:
+		httpProbeAction, err := a.AsHookActionHttpProbe()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, httpProbeAction.HttpProbe.Validate(path+".httpProbe")...)
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("%s: unknown hook action type: %s", path, t))
@@ -227,9 +322,70 @@ func (a HookAction) Validate(path string) []error {
 		}
 	}
 
+	if a.OnFailure != nil {
+		switch *a.OnFailure {
+		case HookActionFailurePolicyAbort, HookActionFailurePolicyIgnore:
+		default:
+			allErrs = append(allErrs, fmt.Errorf("%s.onFailure: unsupported value %q", path, *a.OnFailure))
+		}
+	}
+
+	return allErrs
+}
+
+func (s HookActionSystemdSpec) Validate(path string) []error {
+	allErrs := []error{}
+	allErrs = append(allErrs, validation.ValidateSystemdName(&s.Unit, path+".unit")...)
+	if strings.ContainsAny(s.Unit, "*?[]") {
+		allErrs = append(allErrs, fmt.Errorf("%s.unit: must not contain glob patterns: %s", path, s.Unit))
+	}
+	switch s.Operation {
+	case HookActionSystemdOperationStart, HookActionSystemdOperationRestart, HookActionSystemdOperationReload:
+	case HookActionSystemdOperationStop:
+		if lo.FromPtr(s.WaitForActive) {
+			allErrs = append(allErrs, fmt.Errorf("%s.waitForActive: not supported for operation %q", path, s.Operation))
+		}
+	default:
+		allErrs = append(allErrs, fmt.Errorf("%s.operation: unsupported operation %q", path, s.Operation))
+	}
+	return allErrs
+}
+
+func (h HookActionHttpProbeSpec) Validate(path string) []error {
+	allErrs := []error{}
+	u, err := url.Parse(h.Url)
+	if err != nil {
+		allErrs = append(allErrs, fmt.Errorf("%s.url: invalid URL: %w", path, err))
+	} else {
+		if u.Scheme != "http" && u.Scheme != "https" {
+			allErrs = append(allErrs, fmt.Errorf("%s.url: scheme must be http or https: %s", path, h.Url))
+		}
+		if !isLoopbackHost(u.Hostname()) {
+			allErrs = append(allErrs, fmt.Errorf("%s.url: host must be localhost or a loopback address: %s", path, h.Url))
+		}
+	}
+	if h.ExpectedStatus != nil && (*h.ExpectedStatus < 100 || *h.ExpectedStatus > 599) {
+		allErrs = append(allErrs, fmt.Errorf("%s.expectedStatus: must be a valid HTTP status code: %d", path, *h.ExpectedStatus))
+	}
+	if h.Interval != nil {
+		interval, err := time.ParseDuration(*h.Interval)
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s.interval: %w", path, err))
+		} else if interval <= 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.interval: must be positive", path))
+		}
+	}
 	return allErrs
 }
 
+func isLoopbackHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
 func (c HookCondition) Validate(path string) []error {
 	allErrs := []error{}
 
@@ -252,6 +408,43 @@ func (c HookCondition) Validate(path string) []error {
 			allErrs = append(allErrs, err)
 		}
 		allErrs = append(allErrs, validation.ValidateFileOrDirectoryPath(&pathOpCondition.Path, path+".path")...)
+	case HookConditionTypeOsImage:
+		if _, err := c.AsHookConditionOsImage(); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	case HookConditionTypeApplicationOp:
+		applicationOpCondition, err := c.AsHookConditionApplicationOp()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateString(&applicationOpCondition.Application, path+".application", 1, 253, nil, "")...)
+		if len(applicationOpCondition.Op) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.op: at least one operation must be specified", path))
+		}
+		for i, op := range applicationOpCondition.Op {
+			switch op {
+			case ApplicationOperationAdded, ApplicationOperationRemoved, ApplicationOperationUpdated:
+			default:
+				allErrs = append(allErrs, fmt.Errorf("%s.op[%d]: unsupported operation %q", path, i, op))
+			}
+		}
+	case HookConditionTypeLabels:
+		labelsCondition, err := c.AsHookConditionLabels()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		allErrs = append(allErrs, validation.ValidateLabelsWithPath(&labelsCondition.Labels, path+".labels")...)
+	case HookConditionTypeSystemInfo:
+		systemInfoCondition, err := c.AsHookConditionSystemInfo()
+		if err != nil {
+			allErrs = append(allErrs, err)
+			return allErrs
+		}
+		if len(systemInfoCondition.SystemInfo) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.systemInfo: at least one field must be specified", path))
+		}
 	default:
 		// if we hit this case, it means that the type should be added to the switch statement above
 		allErrs = append(allErrs, fmt.Errorf("%s: unknown hook condition type: %s", path, t))
@@ -427,13 +620,19 @@ func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 			allErrs = append(allErrs, validation.ValidateBase64Field(c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), maxInlineLength)...)
 			// Can ignore errors because we just validated it in the previous line
 			b, _ := base64.StdEncoding.DecodeString(c.Inline[i].Content)
-			_, paramErrs = validateParametersInString(lo.ToPtr(string(b)), "spec.config[].inline[%d].content", fleetTemplate)
+			containsParams, paramErrs = validateParametersInString(lo.ToPtr(string(b)), "spec.config[].inline[%d].content", fleetTemplate)
 			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validateHookActionsFile(c.Inline[i].Path, b, fmt.Sprintf("spec.config[].inline[%d].content", i))...)
+			}
 		} else if c.Inline[i].ContentEncoding == nil || (c.Inline[i].ContentEncoding != nil && *(c.Inline[i].ContentEncoding) == EncodingPlain) {
 			// Contents should be limited to 1MB (1024*1024=1048576 bytes)
 			allErrs = append(allErrs, validation.ValidateString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), 0, maxInlineLength, nil, "")...)
-			_, paramErrs = validateParametersInString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), fleetTemplate)
+			containsParams, paramErrs = validateParametersInString(&c.Inline[i].Content, fmt.Sprintf("spec.config[].inline[%d].content", i), fleetTemplate)
 			allErrs = append(allErrs, paramErrs...)
+			if !containsParams {
+				allErrs = append(allErrs, validateHookActionsFile(c.Inline[i].Path, []byte(c.Inline[i].Content), fmt.Sprintf("spec.config[].inline[%d].content", i))...)
+			}
 		} else {
 			allErrs = append(allErrs, fmt.Errorf("unknown contentEncoding: %s", *(c.Inline[i].ContentEncoding)))
 		}
@@ -441,6 +640,58 @@ func (c InlineConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	return allErrs
 }
 
+// hookActionsFileRegexp matches the drop-in files from which the agent loads the actions of lifecycle hooks
+var hookActionsFileRegexp = regexp.MustCompile(`^/etc/flightctl/hooks\.d/([^/]+)/[^/]+\.yaml$`)
+
+// updateHookConditionTypes are the hook conditions that are evaluated against the update the agent is applying, so
+// they are only supported in the hooks triggered by an update
+var updateHookConditionTypes = []HookConditionType{
+	HookConditionTypeOsImage,
+	HookConditionTypeApplicationOp,
+	HookConditionTypeLabels,
+}
+
+// validateHookActionsFile validates the actions in a file that the agent loads as lifecycle hook actions, so that
+// invalid actions are rejected before they reach the device
+func validateHookActionsFile(filePath string, content []byte, path string) []error {
+	match := hookActionsFileRegexp.FindStringSubmatch(filePath)
+	if match == nil {
+		return nil
+	}
+	actions := []HookAction{}
+	if err := yaml.UnmarshalStrict(content, &actions); err != nil {
+		return []error{fmt.Errorf("%s: invalid hook actions: %w", path, err)}
+	}
+	isRebootHook := match[1] == strings.ToLower(string(DeviceLifecycleHookBeforeRebooting)) ||
+		match[1] == strings.ToLower(string(DeviceLifecycleHookAfterRebooting))
+	allErrs := []error{}
+	for i, action := range actions {
+		actionPath := fmt.Sprintf("%s[%d]", path, i)
+		allErrs = append(allErrs, action.Validate(actionPath)...)
+		if isRebootHook {
+			allErrs = append(allErrs, validateRebootHookConditions(action, actionPath)...)
+		}
+	}
+	return allErrs
+}
+
+// validateRebootHookConditions rejects the conditions of a reboot hook action that can only be evaluated against an update
+func validateRebootHookConditions(action HookAction, path string) []error {
+	allErrs := []error{}
+	for i, condition := range lo.FromPtr(action.If) {
+		t, err := condition.Type()
+		if err != nil {
+			// already reported by the validation of the action
+			continue
+		}
+		if slices.Contains(updateHookConditionTypes, t) {
+			allErrs = append(allErrs, fmt.Errorf("%s.if[%d]: %s conditions are only supported in the %s and %s hooks", path, i, t,
+				DeviceLifecycleHookBeforeUpdating, DeviceLifecycleHookAfterUpdating))
+		}
+	}
+	return allErrs
+}
+
 func (h HttpConfigProviderSpec) Validate(fleetTemplate bool) []error {
 	allErrs := []error{}
 	allErrs = append(allErrs, validation.ValidateConfigName(&h.Name, "spec.config[].name")...)
@@ -541,9 +792,24 @@ func (b *Batch) Validate() []error {
 			errs = append(errs, fmt.Errorf("batch success threshold: %w", err))
 		}
 	}
+	errs = append(errs, b.HealthCriteria.Validate()...)
 	return errs
 }
 
+func (h *BatchHealthCriteria) Validate() []error {
+	if h == nil || h.SoakDuration == nil {
+		return nil
+	}
+	soakDuration, err := time.ParseDuration(*h.SoakDuration)
+	if err != nil {
+		return []error{fmt.Errorf("batch health criteria soak duration: %w", err)}
+	}
+	if soakDuration <= 0 {
+		return []error{errors.New("batch health criteria soak duration must be positive")}
+	}
+	return nil
+}
+
 func (b BatchSequence) Validate() []error {
 	var errs []error
 	for _, batch := range lo.FromPtr(b.Sequence) {
@@ -604,6 +870,56 @@ func (r *RolloutPolicy) Validate() []error {
 			errs = append(errs, fmt.Errorf("rollout policy success threshold: %w", err))
 		}
 	}
+	if r.OnFailure != nil {
+		switch *r.OnFailure {
+		case RolloutFailureActionPause:
+		case RolloutFailureActionRollback:
+			if r.DeviceSelection == nil {
+				errs = append(errs, errors.New("rollout policy onFailure \"rollback\" requires deviceSelection to be defined"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("rollout policy onFailure: unsupported value %q", *r.OnFailure))
+		}
+	}
+	if r.Maintenance != nil && r.DeviceSelection == nil {
+		errs = append(errs, errors.New("rollout policy maintenance requires deviceSelection to be defined"))
+	}
+	errs = append(errs, r.Maintenance.Validate()...)
+	return errs
+}
+
+func (m *RolloutMaintenance) Validate() []error {
+	var errs []error
+	if m == nil {
+		return nil
+	}
+	if m.TimeZone != nil {
+		errs = append(errs, validateTimeZone(*m.TimeZone)...)
+	}
+	if len(m.Windows) == 0 {
+		errs = append(errs, errors.New("rollout maintenance must define at least one window"))
+	}
+	for i := range m.Windows {
+		for _, err := range m.Windows[i].Validate() {
+			errs = append(errs, fmt.Errorf("rollout maintenance window %d: %w", i, err))
+		}
+	}
+	return errs
+}
+
+func (w RolloutMaintenanceWindow) Validate() []error {
+	var errs []error
+	// allow only the standard 5 input cron syntax e.g. "* * * * *"
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	if _, err := parser.Parse(w.At); err != nil {
+		errs = append(errs, fmt.Errorf("invalid cron schedule: %s", err))
+	}
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("invalid duration: %w", err))
+	} else if duration <= 0 {
+		errs = append(errs, errors.New("duration must be positive"))
+	}
 	return errs
 }
 
@@ -617,7 +933,77 @@ func (r Fleet) Validate() []error {
 
 	// Validate the Device spec settings
 	allErrs = append(allErrs, r.Spec.Template.Spec.Validate(true)...)
+	allErrs = append(allErrs, validateParameterSets(r.Spec.Parameters)...)
+	allErrs = append(allErrs, r.Spec.Attestation.Validate()...)
+
+	return allErrs
+}
+
+// sha256HexPattern matches a hex-encoded SHA-256 digest
+var sha256HexPattern = regexp.MustCompile(`^[0-9a-fA-F]{64}$`)
+
+func (p *AttestationPolicy) Validate() []error {
+	if p == nil {
+		return nil
+	}
+	allErrs := []error{}
+	if len(p.Pcrs) == 0 {
+		allErrs = append(allErrs, fmt.Errorf("spec.attestation.pcrs: must define at least one PCR"))
+	}
+	if p.MaxAge != nil {
+		maxAge, err := time.ParseDuration(*p.MaxAge)
+		if err != nil {
+			allErrs = append(allErrs, fmt.Errorf("spec.attestation.maxAge: %w", err))
+		} else if maxAge <= 0 {
+			allErrs = append(allErrs, errors.New("spec.attestation.maxAge: must be positive"))
+		}
+	}
+	seen := map[int32]struct{}{}
+	for i, pcr := range p.Pcrs {
+		path := fmt.Sprintf("spec.attestation.pcrs[%d]", i)
+		if pcr.Index < 0 || pcr.Index > 23 {
+			allErrs = append(allErrs, fmt.Errorf("%s.index: must be between 0 and 23", path))
+		}
+		if _, ok := seen[pcr.Index]; ok {
+			allErrs = append(allErrs, fmt.Errorf("%s.index: PCR %d is defined more than once", path, pcr.Index))
+		}
+		seen[pcr.Index] = struct{}{}
+		if len(pcr.Values) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.values: must define at least one value", path))
+		}
+		for j := range pcr.Values {
+			allErrs = append(allErrs, validation.ValidateString(&pcr.Values[j], fmt.Sprintf("%s.values[%d]", path, j), 0, 64, sha256HexPattern, "[0-9a-fA-F]{64}")...)
+		}
+	}
+	return allErrs
+}
+
+// templateParameterNamePattern restricts parameter names to identifiers, so they can be accessed as .parameters.<name>
+var templateParameterNamePattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+const maxTemplateParameterValueLength = 4096
 
+func validateParameterSets(sets *[]DeviceParameterSet) []error {
+	allErrs := []error{}
+	for i, set := range lo.FromPtr(sets) {
+		path := fmt.Sprintf("spec.parameters[%d]", i)
+		if set.Selector != nil && set.Devices != nil {
+			allErrs = append(allErrs, fmt.Errorf("%s: only one of selector or devices can be specified", path))
+		}
+		for _, err := range set.Selector.Validate() {
+			allErrs = append(allErrs, fmt.Errorf("%s.selector: %w", path, err))
+		}
+		for j := range lo.FromPtr(set.Devices) {
+			allErrs = append(allErrs, validation.ValidateResourceNameReference(&(*set.Devices)[j], fmt.Sprintf("%s.devices[%d]", path, j))...)
+		}
+		if len(set.Values) == 0 {
+			allErrs = append(allErrs, fmt.Errorf("%s.values: must define at least one parameter", path))
+		}
+		for key, value := range set.Values {
+			allErrs = append(allErrs, validation.ValidateString(&key, path+".values", 1, 63, templateParameterNamePattern, "[a-zA-Z_][a-zA-Z0-9_]*", "vpnEndpoint")...)
+			allErrs = append(allErrs, validation.ValidateString(&value, fmt.Sprintf("%s.values.%s", path, key), 0, maxTemplateParameterValueLength, nil, "")...)
+		}
+	}
 	return allErrs
 }
 
@@ -1647,20 +2033,20 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 
 	t, err := template.New("t").Option("missingkey=zero").Funcs(GetGoTemplateFuncMap()).Parse(*s)
 	if err != nil {
-		return false, validation.FormatInvalidError(*s, path, fmt.Sprintf("invalid parameter syntax: %v", err))
+		return false, validation.FormatInvalidError(*s, path, fmt.Sprintf("invalid parameter syntax: %s", templateErrorMessage(*s, err)))
 	}
 
 	// Ensure template has only supported types (recursively, to catch
 	// disallowed constructs nested inside conditionals).
 	if err := validateTemplateNodes(t.Root.Nodes, 0); err != nil {
-		return false, validation.FormatInvalidError(*s, path, err.Error())
+		return false, validation.FormatInvalidError(*s, path, templateErrorMessage(*s, err))
 	}
 
 	// Validate field access paths statically across all branches, so that
 	// invalid references (e.g. .metadata.annotations) are caught even in
 	// conditional branches that a dummy render would not execute.
 	if err := validateTemplateFieldAccess(t.Root.Nodes, true); err != nil {
-		return false, validation.FormatInvalidError(*s, path, err.Error())
+		return false, validation.FormatInvalidError(*s, path, templateErrorMessage(*s, err))
 	}
 
 	// Execute against a dummy device to catch remaining runtime errors
@@ -1674,11 +2060,56 @@ func validateParametersInString(s *string, path string, fleetTemplate bool) (boo
 
 	output, err := ExecuteGoTemplateOnDevice(t, dev)
 	if err != nil {
-		return false, validation.FormatInvalidError(*s, path, fmt.Sprintf("cannot apply parameters, possibly because they access invalid fields: %v", err))
+		// Functions rejecting the dummy device's empty labels and parameters, such as
+		// cidrHost with an empty network, can only be checked when rendering real devices.
+		if errors.Is(err, errInvalidTemplateInput) {
+			return true, allErrs
+		}
+		return false, validation.FormatInvalidError(*s, path, fmt.Sprintf("cannot apply parameters, possibly because they access invalid fields: %s", templateErrorMessage(*s, err)))
 	}
 	return output != *s, allErrs
 }
 
+// templateNodeError is an error found at a node of a parsed template.
+type templateNodeError struct {
+	pos parse.Pos
+	err error
+}
+
+func (e *templateNodeError) Error() string {
+	return e.err.Error()
+}
+
+func (e *templateNodeError) Unwrap() error {
+	return e.err
+}
+
+// atTemplateNode attaches the node's position to err, unless it already points at a nested node.
+func atTemplateNode(node parse.Node, err error) error {
+	var nodeErr *templateNodeError
+	if errors.As(err, &nodeErr) {
+		return err
+	}
+	return &templateNodeError{pos: node.Position(), err: err}
+}
+
+var templateErrorLocation = regexp.MustCompile(`^template: [^:]*:(\d+)(?::\d+)?: `)
+
+// templateErrorMessage formats a template parse, validation or execution error so that it
+// starts with the number of the template line it refers to.
+func templateErrorMessage(s string, err error) string {
+	var nodeErr *templateNodeError
+	if errors.As(err, &nodeErr) {
+		line := 1 + strings.Count(s[:min(int(nodeErr.pos), len(s))], "\n")
+		return fmt.Sprintf("line %d: %v", line, err)
+	}
+	msg := err.Error()
+	if m := templateErrorLocation.FindStringSubmatch(msg); m != nil {
+		return fmt.Sprintf("line %s: %s", m[1], strings.TrimPrefix(msg, m[0]))
+	}
+	return msg
+}
+
 const maxTemplateNestingDepth = 10
 
 func validateTemplateNodes(nodes []parse.Node, depth int) error {
@@ -1698,7 +2129,7 @@ func validateTemplateNodes(nodes []parse.Node, depth int) error {
 				return err
 			}
 		default:
-			return fmt.Errorf("template contains unsupported elements: %s", node.String())
+			return atTemplateNode(node, fmt.Errorf("template contains unsupported elements: %s", node.String()))
 		}
 	}
 	return nil
@@ -1730,11 +2161,11 @@ func validateTemplateFieldAccess(nodes []parse.Node, rootContext bool) error {
 		switch n := node.(type) {
 		case *parse.ActionNode:
 			if err := validatePipeFieldPaths(n.Pipe, rootContext); err != nil {
-				return err
+				return atTemplateNode(n, err)
 			}
 		case *parse.IfNode:
 			if err := validatePipeFieldPaths(n.Pipe, rootContext); err != nil {
-				return err
+				return atTemplateNode(n, err)
 			}
 			if n.List != nil {
 				if err := validateTemplateFieldAccess(n.List.Nodes, rootContext); err != nil {
@@ -1748,7 +2179,7 @@ func validateTemplateFieldAccess(nodes []parse.Node, rootContext bool) error {
 			}
 		case *parse.WithNode:
 			if err := validatePipeFieldPaths(n.Pipe, rootContext); err != nil {
-				return err
+				return atTemplateNode(n, err)
 			}
 			if n.List != nil {
 				if err := validateTemplateFieldAccess(n.List.Nodes, false); err != nil {
@@ -1776,7 +2207,7 @@ func validatePipeFieldPaths(pipe *parse.PipeNode, rootContext bool) error {
 					if err := validateFieldPath(field.Ident); err != nil {
 						return err
 					}
-				} else if len(field.Ident) > 0 && field.Ident[0] == "metadata" {
+				} else if len(field.Ident) > 0 && (field.Ident[0] == "metadata" || field.Ident[0] == "parameters") {
 					return fmt.Errorf("template references root field inside 'with' body: .%s (use $.%s to access root fields)",
 						strings.Join(field.Ident, "."), strings.Join(field.Ident, "."))
 				}
@@ -1812,6 +2243,12 @@ func validateFieldPath(ident []string) error {
 	if len(ident) == 0 {
 		return nil
 	}
+	if ident[0] == "parameters" {
+		if len(ident) > 2 {
+			return fmt.Errorf("template references unsupported field: .%s", strings.Join(ident, "."))
+		}
+		return nil
+	}
 	if ident[0] != "metadata" || len(ident) < 2 {
 		return fmt.Errorf("template references unsupported field: .%s", strings.Join(ident, "."))
 	}
@@ -2229,12 +2666,14 @@ func (a AuthStaticRoleAssignment) Validate(ctx context.Context) []error {
 		allErrs = append(allErrs, ErrRolesRequired)
 	}
 
+	scopedRoleNames := lo.Map(lo.FromPtr(a.ScopedRoles), func(r AuthScopedRole, _ int) string { return r.Name })
+
 	// Validate that all roles are non-empty strings
 	for i, role := range a.Roles {
 		if role == "" {
 			allErrs = append(allErrs, fmt.Errorf("role at index %d cannot be empty", i))
 		}
-		if !slices.Contains(KnownExternalRoles, role) {
+		if !slices.Contains(KnownExternalRoles, role) && !slices.Contains(scopedRoleNames, role) {
 			allErrs = append(allErrs, fmt.Errorf("role at index %d is not a valid role: %s", i, role))
 		}
 	}
@@ -2261,6 +2700,8 @@ func (a AuthStaticRoleAssignment) Validate(ctx context.Context) []error {
 		}
 	}
 
+	allErrs = append(allErrs, validateScopedRoles(a.ScopedRoles, "spec.roleAssignment.scopedRoles")...)
+
 	return allErrs
 }
 
@@ -2271,6 +2712,44 @@ func (a AuthDynamicRoleAssignment) Validate(ctx context.Context) []error {
 		allErrs = append(allErrs, ErrClaimPathRequiredDynamicRole)
 	}
 
+	allErrs = append(allErrs, validateScopedRoles(a.ScopedRoles, "spec.roleAssignment.scopedRoles")...)
+
+	return allErrs
+}
+
+func validateScopedRoles(scopedRoles *[]AuthScopedRole, path string) []error {
+	allErrs := []error{}
+	names := map[string]struct{}{}
+	for i, scopedRole := range lo.FromPtr(scopedRoles) {
+		rolePath := fmt.Sprintf("%s[%d]", path, i)
+		if scopedRole.Name == "" {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: cannot be empty", rolePath))
+		} else if slices.Contains(KnownExternalRoles, scopedRole.Name) {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: %q is a built-in role name", rolePath, scopedRole.Name))
+		} else if _, exists := names[scopedRole.Name]; exists {
+			allErrs = append(allErrs, fmt.Errorf("%s.name: duplicate scoped role name %q", rolePath, scopedRole.Name))
+		}
+		names[scopedRole.Name] = struct{}{}
+
+		if !slices.Contains(KnownExternalRoles, scopedRole.Role) {
+			allErrs = append(allErrs, fmt.Errorf("%s.role: not a valid role: %s", rolePath, scopedRole.Role))
+		} else if scopedRole.Role == ExternalRoleAdmin {
+			allErrs = append(allErrs, fmt.Errorf("%s.role: the %s role cannot be scoped", rolePath, ExternalRoleAdmin))
+		}
+
+		switch {
+		case scopedRole.Selector != nil && scopedRole.Fleets != nil:
+			allErrs = append(allErrs, fmt.Errorf("%s: only one of selector or fleets can be specified", rolePath))
+		case scopedRole.Selector == nil && len(lo.FromPtr(scopedRole.Fleets)) == 0:
+			allErrs = append(allErrs, fmt.Errorf("%s: one of selector or fleets must be specified", rolePath))
+		}
+		for _, err := range scopedRole.Selector.Validate() {
+			allErrs = append(allErrs, fmt.Errorf("%s.selector: %w", rolePath, err))
+		}
+		for j := range lo.FromPtr(scopedRole.Fleets) {
+			allErrs = append(allErrs, validation.ValidateResourceNameReference(&(*scopedRole.Fleets)[j], fmt.Sprintf("%s.fleets[%d]", rolePath, j))...)
+		}
+	}
 	return allErrs
 }
 
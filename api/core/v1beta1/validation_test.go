@@ -3,6 +3,7 @@ package v1beta1
 import (
 	"context"
 	"encoding/base64"
+	"errors"
 	"strings"
 	"testing"
 
@@ -559,6 +560,42 @@ func TestValidateParametersInString(t *testing.T) {
 			containsParams: true,
 			expectError:    0,
 		},
+		{
+			name:           "encoding functions",
+			paramString:    "{{ .metadata.name | b64enc }} {{ sha256sum .metadata.name }} {{ toJson .metadata.labels }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "yaml with nindent",
+			paramString:    "labels:{{ toYaml .metadata.labels | nindent 2 }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "math on label",
+			paramString:    "{{ add .metadata.labels.index 100 }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "cidr helpers on parameters are checked per device",
+			paramString:    "{{ if cidrContains .parameters.subnet \"10.0.0.1\" }}{{ cidrHost .parameters.subnet 10 }}{{ end }}",
+			containsParams: true,
+			expectError:    0,
+		},
+		{
+			name:           "invalid regular expression",
+			paramString:    "{{ regexMatch \"[a-\" .metadata.name }}",
+			containsParams: true,
+			expectError:    1,
+		},
+		{
+			name:           "invalid indentation",
+			paramString:    "{{ indent -1 .metadata.name }}",
+			containsParams: true,
+			expectError:    1,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -571,6 +608,42 @@ func TestValidateParametersInString(t *testing.T) {
 	}
 }
 
+func TestValidateParametersInStringErrorLine(t *testing.T) {
+	tests := []struct {
+		name          string
+		paramString   string
+		expectedError string
+	}{
+		{
+			name:          "parse error",
+			paramString:   "line one\nline two\n{{ badfunction .metadata.name }}",
+			expectedError: `line 3: function "badfunction" not defined`,
+		},
+		{
+			name:          "unsupported field",
+			paramString:   "line one\n{{ if .metadata.name }}\n{{ .metadata.annotations.key }}\n{{ end }}",
+			expectedError: "line 3: template references unsupported field: .metadata.annotations.key",
+		},
+		{
+			name:          "unsupported element",
+			paramString:   "line one\n{{ range .metadata.labels }}{{ . }}{{ end }}",
+			expectedError: "line 2: template contains unsupported elements",
+		},
+		{
+			name:          "execution error",
+			paramString:   "line one\nline two\n{{ regexMatch \"[a-\" .metadata.name }}",
+			expectedError: "line 3: executing",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, errs := validateParametersInString(&(tt.paramString), "path", true)
+			require.Len(t, errs, 1)
+			require.Contains(t, errs[0].Error(), tt.expectedError)
+		})
+	}
+}
+
 func TestValidateInlineApplicationProviderSpec(t *testing.T) {
 	plain := EncodingPlain
 	base64Enc := EncodingBase64
@@ -2405,6 +2478,20 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 			wantErrs:   1,
 			errSubstrs: []string{"is not a valid role"},
 		},
+		{
+			name: "scoped role name",
+			ctx:  baseCtx,
+			assignment: AuthStaticRoleAssignment{
+				Type:  AuthStaticRoleAssignmentTypeStatic,
+				Roles: []string{ExternalRoleViewer, "paris-technician"},
+				ScopedRoles: &[]AuthScopedRole{{
+					Name:     "paris-technician",
+					Role:     ExternalRoleOperator,
+					Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "paris"}},
+				}},
+			},
+			wantErrs: 0,
+		},
 	}
 
 	for _, tt := range tests {
@@ -2424,6 +2511,83 @@ func TestAuthStaticRoleAssignment_Validate(t *testing.T) {
 	}
 }
 
+func TestValidateScopedRoles(t *testing.T) {
+	siteSelector := &LabelSelector{MatchLabels: &map[string]string{"site": "paris"}}
+
+	tests := []struct {
+		name        string
+		scopedRoles []AuthScopedRole
+		errSubstrs  []string
+	}{
+		{
+			name:        "label selector scope",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: ExternalRoleOperator, Selector: siteSelector}},
+		},
+		{
+			name:        "fleet scope",
+			scopedRoles: []AuthScopedRole{{Name: "lyon", Role: ExternalRoleViewer, Fleets: &[]string{"lyon-a", "lyon-b"}}},
+		},
+		{
+			name:        "missing scope",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: ExternalRoleOperator}},
+			errSubstrs:  []string{"one of selector or fleets must be specified"},
+		},
+		{
+			name:        "both selector and fleets",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: ExternalRoleOperator, Selector: siteSelector, Fleets: &[]string{"paris"}}},
+			errSubstrs:  []string{"only one of selector or fleets can be specified"},
+		},
+		{
+			name:        "empty selector",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: ExternalRoleOperator, Selector: &LabelSelector{}}},
+			errSubstrs:  []string{"at least one of [matchLabels,matchExpressions]"},
+		},
+		{
+			name:        "invalid fleet name",
+			scopedRoles: []AuthScopedRole{{Name: "lyon", Role: ExternalRoleViewer, Fleets: &[]string{"Lyon_A"}}},
+			errSubstrs:  []string{"fleets[0]"},
+		},
+		{
+			name:        "admin role cannot be scoped",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: ExternalRoleAdmin, Selector: siteSelector}},
+			errSubstrs:  []string{"cannot be scoped"},
+		},
+		{
+			name:        "unknown role",
+			scopedRoles: []AuthScopedRole{{Name: "paris", Role: "technician", Selector: siteSelector}},
+			errSubstrs:  []string{"not a valid role"},
+		},
+		{
+			name:        "built-in role name",
+			scopedRoles: []AuthScopedRole{{Name: ExternalRoleOperator, Role: ExternalRoleOperator, Selector: siteSelector}},
+			errSubstrs:  []string{"is a built-in role name"},
+		},
+		{
+			name: "duplicate name",
+			scopedRoles: []AuthScopedRole{
+				{Name: "paris", Role: ExternalRoleOperator, Selector: siteSelector},
+				{Name: "paris", Role: ExternalRoleViewer, Fleets: &[]string{"paris"}},
+			},
+			errSubstrs: []string{"duplicate scoped role name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assignment := AuthDynamicRoleAssignment{
+				Type:        AuthDynamicRoleAssignmentTypeDynamic,
+				ClaimPath:   []string{"groups"},
+				ScopedRoles: &tt.scopedRoles,
+			}
+			errs := assignment.Validate(context.Background())
+			require.Len(t, errs, len(tt.errSubstrs), "unexpected errors: %v", errs)
+			for i, substr := range tt.errSubstrs {
+				require.Contains(t, errs[i].Error(), substr)
+			}
+		})
+	}
+}
+
 func TestInlineConfigProviderSpec_Validate_ForbiddenPaths(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -2456,6 +2620,149 @@ func TestInlineConfigProviderSpec_Validate_ForbiddenPaths(t *testing.T) {
 	}
 }
 
+func TestHookActionSystemdSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    HookActionSystemdSpec
+		wantErr bool
+	}{
+		{"restart", HookActionSystemdSpec{Unit: "nginx.service", Operation: HookActionSystemdOperationRestart}, false},
+		{"start and wait", HookActionSystemdSpec{Unit: "nginx.service", Operation: HookActionSystemdOperationStart, WaitForActive: lo.ToPtr(true)}, false},
+		{"stop and wait", HookActionSystemdSpec{Unit: "nginx.service", Operation: HookActionSystemdOperationStop, WaitForActive: lo.ToPtr(true)}, true},
+		{"glob unit", HookActionSystemdSpec{Unit: "nginx*.service", Operation: HookActionSystemdOperationRestart}, true},
+		{"invalid unit", HookActionSystemdSpec{Unit: "nginx service", Operation: HookActionSystemdOperationRestart}, true},
+		{"unsupported operation", HookActionSystemdSpec{Unit: "nginx.service", Operation: "enable"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.spec.Validate("action.systemd")
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestHookActionHttpProbeSpecValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    HookActionHttpProbeSpec
+		wantErr bool
+	}{
+		{"localhost", HookActionHttpProbeSpec{Url: "http://localhost:8080/healthz"}, false},
+		{"loopback address", HookActionHttpProbeSpec{Url: "https://127.0.0.1/healthz", ExpectedStatus: lo.ToPtr(204), Interval: lo.ToPtr("5s")}, false},
+		{"loopback ipv6 address", HookActionHttpProbeSpec{Url: "http://[::1]:8080/healthz"}, false},
+		{"remote host", HookActionHttpProbeSpec{Url: "http://example.com/healthz"}, true},
+		{"unsupported scheme", HookActionHttpProbeSpec{Url: "ftp://localhost/healthz"}, true},
+		{"invalid status", HookActionHttpProbeSpec{Url: "http://localhost/healthz", ExpectedStatus: lo.ToPtr(600)}, true},
+		{"zero interval", HookActionHttpProbeSpec{Url: "http://localhost/healthz", Interval: lo.ToPtr("0s")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.spec.Validate("action.httpProbe")
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestInlineConfigProviderSpec_Validate_HookActions(t *testing.T) {
+	tests := []struct {
+		name    string
+		path    string
+		content string
+		wantErr bool
+	}{
+		{"valid actions", "/etc/flightctl/hooks.d/afterupdating/10-nginx.yaml", `
+- if:
+  - path: /etc/nginx/nginx.conf
+    op: [updated]
+  systemd:
+    unit: nginx.service
+    operation: reload
+- httpProbe:
+    url: http://localhost/healthz
+    interval: 2s
+  onFailure: ignore
+`, false},
+		{"invalid systemd action", "/etc/flightctl/hooks.d/afterupdating/10-nginx.yaml", `
+- systemd:
+    unit: nginx.service
+    operation: enable
+`, true},
+		{"remote http probe", "/etc/flightctl/hooks.d/beforeupdating/10-probe.yaml", `
+- httpProbe:
+    url: http://example.com/healthz
+`, true},
+		{"unsupported failure policy", "/etc/flightctl/hooks.d/beforeupdating/10-battery.yaml", `
+- run: /usr/local/bin/check-battery 30
+  onFailure: retry
+`, true},
+		{"structured conditions", "/etc/flightctl/hooks.d/afterupdating/10-firmware.yaml", `
+- if:
+  - osImageChanged: true
+  - systemInfo:
+      architecture: arm64
+  - labels:
+      site: factory-1
+  - application: telemetry
+    op: [added, updated]
+  run: /usr/local/bin/flash-firmware
+`, false},
+		{"unsupported application operation", "/etc/flightctl/hooks.d/afterupdating/10-firmware.yaml", `
+- if:
+  - application: telemetry
+    op: [created]
+  run: /usr/local/bin/flash-firmware
+`, true},
+		{"invalid label condition", "/etc/flightctl/hooks.d/afterupdating/10-firmware.yaml", `
+- if:
+  - labels:
+      "invalid key!": factory-1
+  run: /usr/local/bin/flash-firmware
+`, true},
+		{"update conditions in a reboot hook", "/etc/flightctl/hooks.d/beforerebooting/10-firmware.yaml", `
+- if:
+  - osImageChanged: false
+  run: /usr/local/bin/flash-firmware
+- if:
+  - labels:
+      site: factory-1
+  run: /usr/local/bin/flash-firmware
+`, true},
+		{"system info condition in a reboot hook", "/etc/flightctl/hooks.d/afterrebooting/10-firmware.yaml", `
+- if:
+  - systemInfo:
+      architecture: arm64
+  run: /usr/local/bin/flash-firmware
+`, false},
+		{"not a list of actions", "/etc/flightctl/hooks.d/afterupdating/10-nginx.yaml", `run: true`, true},
+		{"not a hook file", "/etc/myapp/hooks.yaml", `run: true`, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := InlineConfigProviderSpec{
+				Name:   "test-config",
+				Inline: []FileSpec{{Path: tt.path, Content: tt.content, Mode: lo.ToPtr(0644)}},
+			}
+			errs := spec.Validate(false)
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
 func TestHttpConfigProviderSpec_Validate_ForbiddenPaths(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -3144,7 +3451,322 @@ func TestDisruptionBudgetValidateGroupBy(t *testing.T) {
 	}
 }
 
+func TestRolloutPolicyValidateOnFailure(t *testing.T) {
+	require := require.New(t)
+	minAvail := 1
+
+	deviceSelection := &RolloutDeviceSelection{}
+	require.NoError(deviceSelection.FromBatchSequence(BatchSequence{Strategy: RolloutStrategyBatchSequence}))
+
+	tests := []struct {
+		name            string
+		onFailure       *RolloutFailureAction
+		deviceSelection *RolloutDeviceSelection
+		wantErr         bool
+	}{
+		{"unset", nil, deviceSelection, false},
+		{"pause", lo.ToPtr(RolloutFailureActionPause), deviceSelection, false},
+		{"rollback", lo.ToPtr(RolloutFailureActionRollback), deviceSelection, false},
+		{"pause without device selection", lo.ToPtr(RolloutFailureActionPause), nil, false},
+		{"rollback without device selection", lo.ToPtr(RolloutFailureActionRollback), nil, true},
+		{"unsupported value", lo.ToPtr(RolloutFailureAction("retry")), deviceSelection, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			policy := &RolloutPolicy{
+				DeviceSelection:  tt.deviceSelection,
+				DisruptionBudget: &DisruptionBudget{MinAvailable: &minAvail},
+				OnFailure:        tt.onFailure,
+			}
+			errs := policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestBatchValidateHealthCriteria(t *testing.T) {
+	tests := []struct {
+		name           string
+		healthCriteria *BatchHealthCriteria
+		wantErr        bool
+	}{
+		{"unset", nil, false},
+		{"without soak duration", &BatchHealthCriteria{ApplicationsHealthy: lo.ToPtr(true), ResourcesNotCritical: lo.ToPtr(true)}, false},
+		{"valid soak duration", &BatchHealthCriteria{ApplicationsHealthy: lo.ToPtr(true), SoakDuration: lo.ToPtr("10m")}, false},
+		{"invalid soak duration", &BatchHealthCriteria{SoakDuration: lo.ToPtr("ten minutes")}, true},
+		{"zero soak duration", &BatchHealthCriteria{SoakDuration: lo.ToPtr("0s")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			batch := &Batch{HealthCriteria: tt.healthCriteria}
+			errs := batch.Validate()
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestRolloutPolicyValidateMaintenance(t *testing.T) {
+	deviceSelection := &RolloutDeviceSelection{}
+	require.NoError(t, deviceSelection.FromBatchSequence(BatchSequence{Strategy: RolloutStrategyBatchSequence}))
+
+	tests := []struct {
+		name            string
+		maintenance     *RolloutMaintenance
+		deviceSelection *RolloutDeviceSelection
+		wantErr         bool
+	}{
+		{"unset", nil, deviceSelection, false},
+		{"valid", &RolloutMaintenance{
+			TimeZone: lo.ToPtr("Europe/Paris"),
+			Windows:  []RolloutMaintenanceWindow{{At: "0 2 * * 1-5", Duration: "4h"}},
+		}, deviceSelection, false},
+		{"without device selection", &RolloutMaintenance{
+			Windows: []RolloutMaintenanceWindow{{At: "0 2 * * *", Duration: "4h"}},
+		}, nil, true},
+		{"no windows", &RolloutMaintenance{}, deviceSelection, true},
+		{"invalid time zone", &RolloutMaintenance{
+			TimeZone: lo.ToPtr("Not/AZone"),
+			Windows:  []RolloutMaintenanceWindow{{At: "0 2 * * *", Duration: "4h"}},
+		}, deviceSelection, true},
+		{"invalid cron expression", &RolloutMaintenance{
+			Windows: []RolloutMaintenanceWindow{{At: "0 2 * *", Duration: "4h"}},
+		}, deviceSelection, true},
+		{"invalid duration", &RolloutMaintenance{
+			Windows: []RolloutMaintenanceWindow{{At: "0 2 * * *", Duration: "4 hours"}},
+		}, deviceSelection, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			minAvail := 1
+			policy := &RolloutPolicy{
+				DeviceSelection:  tt.deviceSelection,
+				DisruptionBudget: &DisruptionBudget{MinAvailable: &minAvail},
+				Maintenance:      tt.maintenance,
+			}
+			errs := policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(t, errs)
+			} else {
+				require.Empty(t, errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
 func TestApplicationStatusTypeConstants(t *testing.T) {
 	require.Equal(t, ApplicationStatusType("Stopped"), ApplicationStatusStopped)
 	require.Equal(t, ApplicationStatusType("Stopping"), ApplicationStatusStopping)
 }
+
+func TestValidateParameterSets(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		name    string
+		set     DeviceParameterSet
+		wantErr bool
+	}{
+		{"all devices", DeviceParameterSet{Values: map[string]string{"version": "v1"}}, false},
+		{"selector", DeviceParameterSet{Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "paris"}}, Values: map[string]string{"site_id": "7"}}, false},
+		{"devices", DeviceParameterSet{Devices: &[]string{"device-1"}, Values: map[string]string{"vpnEndpoint": "10.0.0.1"}}, false},
+		{"selector and devices", DeviceParameterSet{Selector: &LabelSelector{MatchLabels: &map[string]string{"site": "paris"}}, Devices: &[]string{"device-1"}, Values: map[string]string{"version": "v1"}}, true},
+		{"empty selector", DeviceParameterSet{Selector: &LabelSelector{}, Values: map[string]string{"version": "v1"}}, true},
+		{"invalid device name", DeviceParameterSet{Devices: &[]string{"Device_1"}, Values: map[string]string{"version": "v1"}}, true},
+		{"no values", DeviceParameterSet{Values: map[string]string{}}, true},
+		{"invalid key", DeviceParameterSet{Values: map[string]string{"vpn-endpoint": "10.0.0.1"}}, true},
+		{"value too long", DeviceParameterSet{Values: map[string]string{"version": strings.Repeat("a", maxTemplateParameterValueLength+1)}}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := validateParameterSets(&[]DeviceParameterSet{tt.set})
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
+
+func TestDeviceSpecValidate_Certificates(t *testing.T) {
+	validCert := func() WorkloadCertificateSpec {
+		return WorkloadCertificateSpec{
+			Name:        "mqtt",
+			CommonName:  "mqtt-client",
+			DnsNames:    &[]string{"broker.example.com"},
+			IpAddresses: &[]string{"10.0.0.1"},
+			CertPath:    "/etc/mqtt/tls.crt",
+			KeyPath:     "/etc/mqtt/tls.key",
+			User:        "mqtt",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		certs         func() []WorkloadCertificateSpec
+		fleetTemplate bool
+		wantErr       string
+	}{
+		{
+			name:  "valid certificate",
+			certs: func() []WorkloadCertificateSpec { return []WorkloadCertificateSpec{validCert()} },
+		},
+		{
+			name: "templated common name in fleet template",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.CommonName = "mqtt-{{ .metadata.name }}"
+				c.DnsNames = &[]string{"{{ .metadata.labels.site }}.example.com"}
+				return []WorkloadCertificateSpec{c}
+			},
+			fleetTemplate: true,
+		},
+		{
+			name: "invalid IP address",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.IpAddresses = &[]string{"not-an-ip"}
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "must be a valid IP address",
+		},
+		{
+			name: "relative path",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.KeyPath = "tls.key"
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "must be an absolute path",
+		},
+		{
+			name: "forbidden path",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.CertPath = "/etc/flightctl/certs/mqtt.crt"
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "is not allowed",
+		},
+		{
+			name: "same cert and key path",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.KeyPath = c.CertPath
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "certPath and keyPath must differ",
+		},
+		{
+			name: "duplicate name",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.CertPath, c.KeyPath = "/etc/other/tls.crt", "/etc/other/tls.key"
+				return []WorkloadCertificateSpec{validCert(), c}
+			},
+			wantErr: "duplicate certificate name",
+		},
+		{
+			name: "duplicate path",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.Name = "other"
+				return []WorkloadCertificateSpec{validCert(), c}
+			},
+			wantErr: "is used by more than one certificate",
+		},
+		{
+			name: "expiration too short",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.ExpirationSeconds = lo.ToPtr(int32(60))
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "must be at least 600",
+		},
+		{
+			name: "reload command with arguments",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.ReloadCommand = &[]string{"systemctl", "reload", "mosquitto"}
+				return []WorkloadCertificateSpec{c}
+			},
+		},
+		{
+			name: "empty reload command",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.ReloadCommand = &[]string{}
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "must start with the command to run",
+		},
+		{
+			name: "signer name set by user",
+			certs: func() []WorkloadCertificateSpec {
+				c := validCert()
+				c.SignerName = lo.ToPtr("flightctl.io/server-svc")
+				return []WorkloadCertificateSpec{c}
+			},
+			wantErr: "is set by the service",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spec := DeviceSpec{Certificates: lo.ToPtr(tt.certs())}
+			errs := spec.Validate(tt.fleetTemplate)
+			if tt.wantErr == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.ErrorContains(t, errors.Join(errs...), tt.wantErr)
+		})
+	}
+}
+
+func TestAttestationPolicyValidate(t *testing.T) {
+	require := require.New(t)
+	digest := strings.Repeat("ab", 32)
+
+	tests := []struct {
+		name    string
+		policy  *AttestationPolicy
+		wantErr bool
+	}{
+		{"nil policy", nil, false},
+		{"valid", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 0, Values: []string{digest}}, {Index: 7, Values: []string{digest, strings.ToUpper(digest)}}}}, false},
+		{"no pcrs", &AttestationPolicy{}, true},
+		{"index out of range", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 24, Values: []string{digest}}}}, true},
+		{"duplicate index", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 4, Values: []string{digest}}, {Index: 4, Values: []string{digest}}}}, true},
+		{"no values", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 4}}}, true},
+		{"not a SHA-256 digest", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 4, Values: []string{"abcd"}}}}, true},
+		{"not hex", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 4, Values: []string{strings.Repeat("zz", 32)}}}}, true},
+		{"valid max age", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 0, Values: []string{digest}}}, MaxAge: lo.ToPtr("1h")}, false},
+		{"invalid max age", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 0, Values: []string{digest}}}, MaxAge: lo.ToPtr("1d")}, true},
+		{"zero max age", &AttestationPolicy{Pcrs: []PCRReferenceValue{{Index: 0, Values: []string{digest}}}, MaxAge: lo.ToPtr("0s")}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := tt.policy.Validate()
+			if tt.wantErr {
+				require.NotEmpty(errs)
+			} else {
+				require.Empty(errs, "unexpected validation error: %v", errs)
+			}
+		})
+	}
+}
@@ -19,138 +19,154 @@ import (
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x97XLcNrbgq6A4UxX7bndLcpK5d7Q1VavIdkY3sa1ryTM/Ym8KTZ7uxhUJ0AAouSel",
-	"qn2IfcJ9ki0cgCBIgt1sRfJH3H9sNQni4+B8n4OD35JUFKXgwLVKjn9LVLqCguKfJyX7B0jFBDe/MlCp",
-	"ZKXGn8nJ+Zl7RzJYMA6K6BWQa/sMMmL7IWJB9IopIqGUoIBrajowjyknYv7fkOoZuQBpPiRqJao8I6ng",
-	"1yA1kZCKJWf/8r0pogUOk1MNShPGNUhOc3JN8womhPKMFHRNJJh+ScWDHrCJmpEXQgJhfCGOyUrrUh0f",
-	"HCyZnl39h5oxcZCKoqg40+uDVHAt2bzSQqqDDK4hP1BsOaUyXTENqa4kHNCSTXGy3CxKzYrsTxKUqGQK",
-	"apZMEuBVkRz/klwf0bxc0aNkkixytlzpVOdmNP/83STR6xKS40RpyfgymSQfpubr6TWVnBagTDfNfvyj",
-	"6bB5+Lzu+kz8I+j4w3Qppu3ebyfJidRsQVN9LkUhzOwvNNUVbjvNMmae0PxcihKkZmb4Bc0VTJIyePRb",
-	"shCyoDqCHa53YhvMyNvEwJMyDvJtYp7iNp4VdAk/VCzPCHVf/E8iOFisAQIfSiH1c+xDuR3Ej5sp+g8R",
-	"4L1lltU8Z2oFWX+Ol7ICcrMCbhHUzvQb5TskEhYggadAVlSROQAnqkpTUGpR5fma3Eimtfnc4uQp1TQX",
-	"yzMNhduQGXnuFso404zmxE1nQmieuxHNgED8PAnVomApzfO1/ZwWwLPCUOek+SLLDEYzSs5PLk//fnD+",
-	"5pIoTaUmVDVd/Q23DIlCS8oVQszMtmmhAxgAk+EaSEl1urIrhiyE7lyIHCg34JVAs/Um0FJNcqBK4642",
-	"0GuAXPMHuzTCFKHXlOV0nsPQkErk15A9Q9zoj/2SFh5/EL9sQ1ITJtErqskNy3MyB/JISHJD1WNSKcgc",
-	"XvrZzMjJ3LAsj68eh5v5G+ia1/XWcKHJGsxwNFtHUBJX8L5i0qDkLzUB1ZAMEbbhCZZNmsX/wHjG+PIS",
-	"n/egvgJivjCrn9uGfubMQILMDamFjAmozM2ohp+OZELBFJ65r4NHP2NHt5ME37kX/aniWz/JVPAFW1bS",
-	"ioYpgWIOmSKp4TMLllJtCKhZhllAmw3psfDor/3dth3Ct7G9ePaBKc34MqCZSyqXgDhJ8/zVIjn+5bfk",
-	"zxIWyXHyp4NGzB44aXaA+Ok5sP36B6oguZ3sxIbTZgoG/TcTRUjjWpCqzKiGKPN03W7vsqTSkInr2ZNa",
-	"tFOD6LH+XpWOoTsVYpobmUts84Yy3VsCXMv1jLyg8ioTN9wwDlWVhtIhGxi3zGlq4dXHE8X4Mvfqix3K",
-	"sKz6q4nVcgy24oIlK6hck6pcSpoBgWwZX626YuVrypeRBV9AcQ2SSPPWANKNrSyDSin3vWdMQqrztZU0",
-	"bmaPYLY0cvVtdXj4LfztaHY4OyT4Iz2afTs7fJs8HpxRBAgnjUTdbSJmEKahCAgxGM09oFLSdfO7O/hT",
-	"Zn4VjFNtWSkCWSM9IAmHdBuhuwgVT5LrIdXVAb7ea/uFH5XDTYtE2ggXZegd1vBu0hnw3PJ0vyADVlqW",
-	"wDNFaINzwqjE4FYXzmFGXqNCCxlhRQEZoxryNWF9es4EWBGE3cwsm2p0qO1yw4lhLZzuFQgPLVoqbZFd",
-	"JZPkfSpunhgMUKL+Nc2Yupp6UTlSrHSn+Y8XT39K+rP/r9NX/3wSeX528Wqo9VOmrk6b2dxOkkbt3Kru",
-	"tiEV6KvenjFbOHeP3lfGKkEFNdAVvNCCD7Qoc9wBGphWAzbBJLliPGuNmkySAjTNqKamE46MOUmBa6Gm",
-	"cyF0OlVaAi3+OsUpIb2XkJrG80YWu+1AoX+La9SG9Byx4HQtz0+K9bRSIA9aQ6SV0qIwm25aXtJlcpxc",
-	"I/9BPaYUimkh1/ZzCUumtETit1KhO0bYd2ug9ghuYd0h3ld0PWUiuTWE2JaKtGW+bpLDgaF76wa16B9j",
-	"lIaVGVKJKZeOZzaWA3LJZgNn5BXP16QUZWWgn1kl+Ybple1IkfcVyLURqLQAbZBHEaOkt7jsVpXC6cYR",
-	"/mtRqrumnxjPUPWumSGaWg2O12Lv9bOLy1CRNhIAmV5ADo01byxxxhdQ69RSFNaq41kpGLe8Jc2ZUR1U",
-	"NS+YVjUNGUN/Rk4pN7xsDk5JyWbkjJNTWkB+ShU8uC1vgKemBmRx4zKkxU17kgoJv14fzUHTo19FCZyW",
-	"7NdXCLgXoGlIpVu3FtHowrQ2X3mbfeR3tn1XxQ0IxWFIsDY3t5gG3HQ8qOj3mhDTG1swUAPqfy2Hva2S",
-	"hcqBGaGgZekGszr8wLpbJoizcQaaGrOlbtnwkbXjULjw20kiOIxQ6lvDGkV+U+PWwG3ZdCq4FUzj7Yko",
-	"ovl+vGURN57GoZDvDXWJkcZSTyPyveB2t8Sqd5/FgfEaqIopdfZ5zLP02ljWBslsB6EScw41cmBT++d5",
-	"pVb2rx+Bg8FKvrz44dWLZJKcCiO/NRgCeU5Zjn+cUp5Cbr+wf7eM901Kz+D6mokNNglmPNyNX8pgk94a",
-	"B1uGix9s5KEy3E0Ari2NDBzjWDCgyDolNtj86LYjRtx9i8wwrovW7J629aghRhg0C5ihQdpAEWuxQT3k",
-	"9wjUqJhSzwMTXVS6rIKOCvrhZ+BLvUqOn3z//SQpGK9/H0VEXaOIRa0HuhwxztGT/+iOU1KtQZpu/vcv",
-	"b9/evDP/zKbvfjucHD3599s/D9jxXvvbtujXvm2jtZhXBk9enZ5ZX6gxzNqAdqbORpB0GF8wq0mwLQHg",
-	"NgvQn5nSm5DGvLcaWm7+aiN5K+ZwTzpwrWu2J/TzlsHHq6g/1PbJXkP9XDVUs9lWP91NX7RIsBnfX8KN",
-	"6+K1heeOlrj7isxFtg5dN96pU809FtS72aeOgOGeDTK4V9cgJcusD9Iw7Fnw2aym7xk5WxBRMK0hmwRO",
-	"0W8UckemMMLwIByRD/tnQ6dWhGZ7guDbrYLAfrgLtNxQnxxQHSRGqG1G0tewuPAuiyHO7BsFwpwSh3pW",
-	"nCBfoXxoByIyve55u4iL9IlefQWEKhJs8+Zt3R5Babqq1ahmpiOjKJPO4jZDfzvoe3A3AGlDvmtM3ll/",
-	"Crt9SP1pwzifn/7kfJy18O3N/SMqUBfOgXInb675mNiX8xqNLFKljTZOeTeK2kamwME6TvsJHA4dF+y4",
-	"70N7o+VdHekMsu1vJ0mlQJ6GTpjxnbzpfdrdWDet9honHlpbdtU7uAa3DluE7njU2SqJEUnrIHPZRoEb",
-	"tq8nB4pWnC7CFjV9dIyzfgC1NhkjSzh1U2za9Fn6HVTqxmsU0a1xpq9r53R8nYsqzx2iN27s/npt/HFC",
-	"3ld0bVRZIZcH+MLwj2NNl/HoY06VvgDg8bHNW6JZAQ3TJjdGjAFw8mgFVOo5UI1d16lHidG7p+ajqAJM",
-	"OVuA0k/ZEmIWlhk1w3exNdZfjwr7hXj7JkZRQyjcaxxINEOa2xykbUzGBJL0CgbYu31NrqBRm/tjRLfO",
-	"NOODsrJ+u1uvHV7hh5gEyxjkEE0G0K5M30dtmhgerwOedRRPC5+HSHlm/QSIFZiEmLEF0kad4aZ+b3zP",
-	"LSYa4CvWU8iWMLUznBp1sh/f8/RQh2Q70bZGpdwUMPSwDpS7ewyt7Y37Lzz8ZNH0DvEn9+EDBKBsz59d",
-	"tKQzrfsOl3QDz7MheOwSMHGdjoqYnFrm2I2ZPEiIJLqkTowk2qY1yQ1dteMkA111wh/RVu34R7yjbgBk",
-	"Q6swAhJDqM0hELed9xMDiQzfCYKE2TfnK0M+cQ3EvEKVvM66c0lHVtyqzkTfV1AhRNNwL0u/Y6nbF6MS",
-	"WuDvvKhgyv9VjxZ/PYRPQZMoLrW68PONN3Ao1AHrhgBB0yASIejziY8ZIoiNvk9j2QcJtgYJnLqwyQUY",
-	"NhnjA7Ta87aMkkDv3WprN/7h35szEu3zXQwYlyMdtZ3M/2bxY7y32xhmM5Uglted7h0dcs3X4z1yzeqG",
-	"jidtTNjpZsju5kxroeqg98tNZRvCb/J3hU3GO7yeDcDmd/immi535eUbvVO7+Iacsf6xnUN2WMzDDv1D",
-	"vYNDO/iL/PGPuxCL/5hoSdMr1ehU7hwRoVpDUepYDEoLQltp5skXZOZ/LgZxc35yd5u4e/byXs1i3/ln",
-	"Zxn3Z3bfxnFDFhH7uD/8LiZy0/UGK/mflBlsfi5kfRBWoZGMJLnBTka6bP/yduAkOalPgj7f2c4ZWnN8",
-	"ohs/aa1iY8uexTzUsG00D7VqA2d70wByGxv3wDqMKCNs7gY/7sfsjk+iY3n7RhusxFabiKEYpZmPaSsO",
-	"TGC8itFI0k9jMX4ptlUjdrySu4vekRm4eFOrPgj9SD0Ojpb3Mad1lH/D6c8aJyMn/P0Zay0I42leZf5c",
-	"8PCBPYIuJ49aN9SY54yTCOcjFdcsHzyyHjo08HidLUUA14DpEWjw2zPkTgMchbkx4yMath1OCXq5LR3I",
-	"oGsDAqZIzvgVZOicuAyPtR9gSKgBeClFVqWQkfna9mJDsoZg8hu6VvU2ZNv3Ycd0kJGJQm3tazdEfgrK",
-	"jIYmE4QWU58R9dF5B/uwS25mg/058V1Phw8bmK7PEaAasDFfzRXI67sCpMYZ23vsmPU5yGlQW4NmjINS",
-	"RFVFQQ2hvuJgcSV2iONRv/TCY4zLCv9VCTIgw5DfzMgb63gjtCnvocgcUlFAU2+CCGkacLhpleaIFNsY",
-	"Td1DVVYiRL7RHm/scOcTqQVTaPc3x/H9fuVsAek6zeGOknSrvY7KE2QnMeuZFaA0Lcp6WoVQWNPHOkqb",
-	"UiC1/kUesRnMJr4eiTX5A0HgjH4XLjC2/9YSLI99DQ/Whc0K9/oapC3tQu1KxnsPfKWOUYuvK8AEC/dT",
-	"mZELMOImbWqxBPNcMIlwo+kKFPFKtd3yGXkpdO1XNmxaVXNliIDroHLM2EVtd1I0FS46Z+q9//VmBdIW",
-	"nVmJm0Al6GoLVvSg03LBwEoVPAQTOme3eWtjtQAGBe1QuY5J8hJuRvTQblXz49/p+R3odJvSMLSW23cD",
-	"W/ZDNB53KooCUQzyTBG1otJiEc1zEuuFXFPJqMOo+6tBkkpA9iWDaiRbEmrvozbJnXKiR9Wq+ITFKCJZ",
-	"1yGsJr2Naib3biT5X+4AmIaPDZTz6NDeZFx9jxEGczPX3hBDDaND306S8JRu34g1qDtUyiiDa5ba18pY",
-	"FpaZGzX7nqoYxSs3jS5iFGU/X1T9Iss7Pl79ooypMqfreKfejF1VBeVTo+WiZuk+Irx7KKfjft+9WpJR",
-	"6mKlkvrd71Ar6R6q9HRo7ssr0GPxShHaB+RQNZ5WNAWreuCEl+waOOngOKE5lnxzrgR0T792ePdT1Ff1",
-	"2tvz6xIUKsPKGSZtYwkkOTk/CzejVUGmnW7a8ZzF9mnIYHSRSHSfSdCV5M59ZnYqpXnuqqFkgn+j6xZC",
-	"r0A659k9OhhTkcVKbFXLpTUH/355eV5PwbRtInY2XjMhh2YHudBEgW7py4zrb58EibFcwxLkPgvl3rNQ",
-	"lKKxOmknPWbavPanpnzY28KxhA2J7HIg3HNCCpquGIfBoW5W684AZqOd7+stxnEqCW8TNx886mir1iEK",
-	"MEWgKLXpw9hITBEuEOIG00xnTelLckJc9CnNqXSnCrlFY7dYRON5pZuiX6I+e8l0vPTbZkKuT/B74KFL",
-	"RiyOydvkwpqtbxOjpAcrfXC0USWkU8qzqQPpVp035ht3C3dswmNAg3Qx1WhEELQHSfO0CfwYBlDZA0ML",
-	"kefixpD+T9UcJAcjWk7Oz0i4XPJGgRUbqH5hgD7LDCNxqS9WETRcvc85c6r0pS/zesmGDmq08yiauTYl",
-	"YiGz7AX93xY1sDYdsu4dsiuGCPrvhpyJpzHXjhjdNsVKJCQDTVmuCJ2LSrsZ++lFUVs452VdzkQMZJHM",
-	"6jDJbOlbWinVhoZNLNFkTo2MrUo7bCgT/vJdVCYMM5dHc8lg8ZjIdlTZj/mNGrXScfkFm5F3IN/Ak0kE",
-	"l+6FaC5GMSAPkUldC/pSVjAhz9F0IG/4FRc3raiqeY9x9FyZ/12LkVZjZ3aur87TuuvOYz/S0NJ9CC8a",
-	"+zRvgiy3GjctQqo11yvQLA2qyxWV0mRFr2HiQi+GWnKMRVGeoXdGVMqLQ6dlkZNGzzB6hOmACJ6va/j+",
-	"1iS/TEg9sdtozphmvIrQ9Au6NqqFIRnnaMXzX+Y3JTkrmCbCikleFXOjAS7QdFFOKYPMVm93TKAupIey",
-	"HTUgdNcWRtYghAJJacjay1dR0vcV+ELwc7CFnbUgTCl8YRXQ4JRXR4sykLdxEJTcGI/UwkxTMrgGZ4J8",
-	"QD0RgyRN+KAG96kFk9kbrAOpmNJGL8O+zLScIlYKpZj50oHMrbTtQzDrTleULyEzshdBoFeUE0oWcEMK",
-	"xisDLtzTkiplFDsDknrH6yr91slaQ9s6mitllVGmSL21DpR1fWyG0d6U5jWkHKTtXtauaVUKbkiz4jko",
-	"RdaisvORkALzoNTiCrhPRAMpzXIsLxnQ0wp7uNzYTKei4gMpeg1GBc5vi1xungj4mxVLVxjRMeBvRy7r",
-	"ja6X4jQ3qJ9aZKljUBnJ6Rxysx0WqgpySI1igwUtunju11FPSpHK8g1bm31Ve8BroOew0KTiSDw8q4tl",
-	"kKxCc0KBZDRn/3I6WjhR3EcbHyCPgCGmzyGllTI6oVU4NUlXFb8yPYnmLYLAeeBRCmGjx816JDjQWQzs",
-	"rskuxBgmd19JbeoINGERx6+PZkffk0zUhlkwhsVyI3m52cZKeW9OH2/Myv4NlGYF6hf/ZqmN/csdQ0lF",
-	"bvYPJ3GKJpS/isKMKwE55VDfWtScz0h0/AEfaKpHKQy3Y2VokJ4YidzW78xC21KE5jkpDQtAMzgqSSxh",
-	"OIJQ+IVjZcjEXdvGz9ax2I1ZSX3gMu71i1SG7nhgfGOrdq09Q2QtP1wAJTMfp51gpG3AdVprus62Noqd",
-	"XcoOob4McrjLWI4K8PNdxltu0GJPiGVxqWcxLc9CYCwEGq6jjCxMeZiRc1+Gtob3WjkHF82mRkEYqfQi",
-	"O9y4/UEZk798O9mGDS8oxk2d/+QK1rV6gxeA+LLkgXQXckm5IWjTzigQSyHNz0cqFaV9apn049D31EMq",
-	"Pq6Wnr22JlI8qW+X3HCQsU1sX0chbrDcOt6TY58bBY+8RQP2wIxtb02J55bbSzECYR8ZkNeqkQMqDsva",
-	"uVxW//hGBffqOOWhdV3PuLhxlIudU52ugopfPiVhh2CBGKC+xiejhWF5BlyhkUCzLPHXAOBfhbhG8jOT",
-	"iTk+S2o2tr93/3nx6iU5FwglYhrFQ34GW+NTtUqUM++FrG8ZmPUsMlEmbhoxY6udkGFsQ0gryfT6wliB",
-	"rioNUAnypLIriZqM7Y/ipmPQzdDetkeyv57XvOM//3mZTOzdUnifC75toLbSuhzsWMjlWRYH5Js3Z089",
-	"VVoWEJj0jqoaXXhGyAtaOndG64NGbM4MtWG6DMfy4oAViVwlBiGXv7KgSgIt2U+AyTB+kncGse0Biy0w",
-	"vhC1vUVTpBSjd+TJcaKBFv8rLCjRTM4AxN4BhRaIFDm5BFokk6SSuQOyOj44aH19Gz1XQupAgpPA6K1o",
-	"9z17yy/Rf+5aFJRjcYygRlKgbpjv565ira+n4QKmYZl8NXvLk0mSsxS4dbC5xZ2UNF0BeYJF5tvrubm5",
-	"mVF8PRNyeeC+VQc/n50+e3nxbPpkdjhb6SJHkmE6N9114NRe9Mn5WRAVP26u7zL7bHcrOU6+nR3Ojhx5",
-	"Iqkd0JIdXB/ZMji4WHwczZfBzOehKpqekxmMwqZNS4UjuurwCiO0ff3AWiPWbDUMKdWNjYAGiTMCazXP",
-	"in8mrV2jhrAf31643mtyphHt7nZyr7Oy6SlDs8K3d5uVoZiCfmBFVbTsNVXluvFMtKxIbyEOwYgVTLdm",
-	"0QskuRGT46PDw0PMQrE/D2PmQVSQu9hvc0uAFu7iAGec2QX4IJEV7ENT9l6dnWBn7E50y3nDQYJVJuMX",
-	"IzBOgKarEOlZbVNtBmlwPUJrihksaJVrryZ07wq7fYeKEfaNlPjk8LDmqmCdCbQsc3ei9OC/nd+2GWDc",
-	"iV08c4Fsu2OV/WT4xXf3OKZ32/bG+oFmpFarcNCjjzDoG04rvUI9O7OjfvsRRn0u5JxlGWDY97snf/0I",
-	"Q14KQV5Qvq5BjPm733+U1V446fqGez+jVbfpUvk8+bk/f12K2PmfU5teN1yBtC1wbPNWzoDzgP0g7G1/",
-	"90xBduGN3mv4ym2Pdo8ebOQYtDLUQvgVDm6PkrWvDWrDLO22aEtpr8b82TO7ucjWfzqotU608XBnA+g3",
-	"Bc46g/Wa9HboXuuPbZn0nWqQbe5zoAzZ7SR5ir6UTVuRdVvceSt+BL1poGXr9f2M8rNYbhnItLjjWLd7",
-	"efTQ8ujwY8ijU8EXObM+gL0EbEvAD9NasNXcw1lit5OYgXbwm6GNWyszsSxTP+0Rn4+Wnk83s59f7lDQ",
-	"2yvG6AnyerHjlG25uUmHf0h9eHgDP5EePPvKGM93H2HIl0KT56Li2Z7z9DlP1M/zI0Y+x3GOHzfoE5+S",
-	"bdyH6f8HsPP3vG3P2/Za1S5a1YG1irEqedwxge8JJbLimEzSuynV9mc4iCv3OiH1xXcTIiRx1Tbd+WcX",
-	"FE5d5ZeIa2Ozmf7LHW9bsQN+CWraZ8nO9tzsgbnZR7VKydSSqEuzc8SBGZJIpXv+Op6/1hx0M5vNrdNo",
-	"UAHNxVLVBTNjeiJ5bt6lml27wK2aEHstgLLf5uzatUp9+YG6oY2Sudz17w8PSc54LIz542Yn1uep4Foo",
-	"WCC4MJmoVL4mj3J2BeSqmkOqc/t+ungcheQVQIlfc5tjSEQJfBs0ae56xXymXCgYjn/i0ZL71pg1fNAH",
-	"cA1cuxsi2lTRiWaLZX3xJ1Uuj3N6AVyTZ9c2m9LC8RFmHdsJ/81A2GJlCK/H8ewiM5syp4yPnwY2J+bL",
-	"9rgIE+JOsHZ3IDb8Xuvfa/17qRTKHSNwNouk5irODdq/C0t2b6fMQOKhAX9SwFVlIILDhKSiXNu8c4Wp",
-	"rvbAnM+JwKv03DlIPD6rFWnFvzjcTN3Upm6EOoHZpqakQmYoxNy5hM3B0eYi010Fmis68ND+3ocM3PZv",
-	"cf0sI7l7m+aPxavJNEI8/hQ0sotPYvYEs0GmZAs/8H61h72w2UHYBKKkK3PAeYu3Z1nGLiIZSLNsXND7",
-	"PMt9nuXHzrN8cOdfcJ3Q3gO4T1r8ZJzf8u7xWYsdDr5RMx/KirtvKvok6m449C6Zi4PZhL0md05lC3Jf",
-	"hkbLek3uPpq44bmg2ebxIo1+d6re0GDL9vt7GmdjTmDTZJ8UuE8K3CcFxiVM37ioTYcBk2L3vMCt8unp",
-	"Fs43LgISGWafGrh3pO8d6Z81/9maG7iVe/y4SbX4w7KOLQrvnn/s+cdef9mgv9wxA89d8GlT8FyPrRy8",
-	"5h7s35mFd3/s7AvMw/vsGNuer/3BEvEcjewz8e6B1Q7l4nU4bu1wGgxK1W6rru7XXHNXhxMkLJlyRe07",
-	"tuRWr9YXpBKKVEM8y8yHdOaMUwyljMjNIlNSX7tF5rmYk3rY20ny7eGT/obUMeXXkDEJqSv3aUFve3jz",
-	"+udkkqyAZs639rNIfXm2YTDc4oj/HildBEUpJJXrZswHGn4vQvaq8f3x64+BS2d17TmbRkqeSSnklygu",
-	"vCDYIjB2zt7uMu0w6dj1PSJ/27fcNYF7KOLwSSXOw6RwexiNyuHuQfQrTOJ2MPhkWdwbxt97j/Yicm/S",
-	"tGVULJHb39g3Jq9u4NL2gdS686brfXbdPrvuD5hd5zF8n2C3T7D7tAKgbC71G5tj1+fm4QXONNCzwiq/",
-	"7mYQJRb6hkqobzrcmKHX3Dj4gEl6zSCfIk+vM/r+aMpXkHxFphFa6lwEygdu/9xzrT7X6muugXY6rLju",
-	"nr3V53wbE7hC9rW7DyQ+2D6Na29If83hyj0PjPPArbljY3hX7bz9GhnXdm1sz8D2nsCvxhCkOo3caoQ3",
-	"M20yBO1dka+fn5K//PXwibsDyXzk0sQ8v1HuGn3T/ECVkB7YHg6s09FeCaTIIyGxhkO6YnkmgT/GKElz",
-	"PMU68fDSQZqmUOKN5S56tHB9FHRt7zGdA6FZBhl5RMsSuL287LG9ItAv395j5+7eZJw8pyy3l2q6+/99",
-	"Cpu9hKbNQXGtnz8PHWtKTxEP/sduiLj9Tq9RhvZXwNr3nH2vmn4FsqSKqKav7WV2m9RTKzGMbJi5Jx3Z",
-	"EHBx8v/+z/+1/sVq7q6PtfcoG2Zu+D5RVQnS3caM94dXUtbXLVup4u92c0LFXQ3trlWekZM8J/ZeaKwo",
-	"ZCM1foTeHchKCwn+OkohCSXfHR4S1gRb7lXyOID+cWTPw7pxP7502buO92LtC00QTwWv2WVVZnh6w73c",
-	"+6Tv5JPGW1jxHnNkyvamyoPEMEjXZ+/27sZwEnzwTkjHmIOiSf2oeqSnWN2jsCuXJTKqr4Fcj7C7BlK3",
-	"727/fwAAAP//Q7yB0VrgAAA=",
+	"H4sIAAAAAAAC/+x963IbN7Pgq6DmfFWxz5KU7FzO+bT1Va0i24lP7FhHkpOtjb1fgTNNEkczwATASGZS",
+	"qtqH2CfcJ9nCHTODIYey5EvMP4nFAbobDaBvaDT+zHJW1YwClSI7+jMT+QoqrP95XJNfgAvCqPqrAJFz",
+	"Ukv9Z3Z8+tx+QwUsCAWB5ArQlfkNCmTgILZAckUE4lBzEEAlVgDUz5giNv8vyOUMnQNXHZFYsaYsUM7o",
+	"FXCJOORsSckfHppAkmk0JZYgJCJUAqe4RFe4bGCCMC1QhdeIg4KLGhpB0E3EDL1kHBChC3aEVlLW4ujg",
+	"YEnk7PLfxYywg5xVVUOJXB/kjEpO5o1kXBwUcAXlgSDLKeb5ikjIZcPhANdkqomlalBiVhX/wkGwhucg",
+	"ZtkkA9pU2dFv2dUjXNYr/CibZIuSLFcyl6XC5n9/O8nkuobsKBOSE7rMJtm7qeo9vcKc4gqEAhPm45cA",
+	"MPz4zIF+zn6JAL+bLtm0Df1mkh1zSRY4l6ecVUxRfy6xbPS046Ig6hdcnnJWA5dEoV/gUsAkq6Of/swW",
+	"jFdYJlaHhY5Mgxl6kyl+YkKBv8nUr3oan1d4Cd83pCwQtj3+O2IUzKoBBO9qxuUzDUPYGdSdA4m+o2Z4",
+	"b5h1My+JWEHRp/GCN4CuV0DNAjWUfiU8QMRhARxoDmiFBZoDUCSaPAchFk1ZrtE1J1ICdWvyBEtcsuVz",
+	"CZWdkBl6ZgdKKJEEl8iSM0G4LC1GhRCQpxNhySqS47Jcm+64AlpUandOQo+iUCuaYHR6fHHy48Hp6wsk",
+	"JOYSYRFA/UNPmd4UkmMqNMcUtaGFjHgAhMdjQDWW+cqMGIqYu3PGSsBUsZcDLtabWIslKgELqWc1cC8w",
+	"2ckHMzREBMJXmJR4XsIQSsHKKyie6rXRx/0zrvz60evLNERuYyK5whJdk7JEc0APGEfXWDxEjYDCrktP",
+	"zQwdzwVQ6derX8OBfsVd9dlNDWUSrUGhw8U6sST1CH5vCFdL8je3gRwn4wUbZIIRk2rw3xNaELq80L/3",
+	"uL4CpHqo0c9NQ085UZxAc7XVYsEEmJcKq5KnI4VQRMJT2zv66YUGdDPJ9Df7oU+q/uqJzBldkGXDjWqY",
+	"IqjmUAiUg2IyybEERGgYxizriiE5lh/9sb/dNkP6a2ounr4jQhK6jPbMBeZL0GsSl+WrRXb025/Z3zgs",
+	"sqPsXw6Cmj2w2uxAr08vgU3v77GA7GaykxjOAwlq+W/eFPEelww1dYElJIWnBbsdZI05UOkg+62WBKoW",
+	"egreq9oKdGtCTEulc5FpHnam/YqASr6eoZeYXxbsmirBIZpa7XQoBvDWJc5B9DGrdSIIXZbefDGoGAXk",
+	"ek2MlaNWqx4wJxXma9TUS44LQFAs06MVl6Q+w3SZGPA5VFfAEVdfFSMtbmEEVI6ph14QDrks10bTWMoe",
+	"wGyp9Oqb5vDwa/jHo9nh7BDpP/JHs69nh2+yh4MUJZhwHDTqboQoJERCFW3ECJv9AXOO1+HvLvInRP1V",
+	"EYqlEaWayVLvB72F432b2HeJXTzJroZMV8t4N9emh8dK4bq1RdoLLinQO6Lh7aSD8NTIdD8gxVZc10AL",
+	"gXBYcwxhisCOLqZhhs60QQsFIlUFBcESyjUi/f1cMDAqSIOZGTGlNsUJq2oOQiEaVh55aKToKwkUzrgx",
+	"lhgUVgKjV7Rchw3nmcfxtdedT2CBm1Jqm50y2ppD9Xc2yf4QslBa5o+Ruic5lp8NrOS3/2UQJL/9zz+M",
+	"oopszO161QxODclwJFKukrVM/qq4zCbZ7zm7fqx2iGDur2lBxOXUmxJK9+PrbJJdrRSp7ArvxIxA+C8v",
+	"n/yU9cfznyevfn2c+P35+auh1k+IuDyJ6Os2Ojv+NfHrLz8+Sfz66pdjzeZg6m91MdrcDx2DD6m2zdz+",
+	"9HsDwlhoOLLPvKEA73BVl3pWceTODvhhk+yS0KKFNZtkFUhcYIkVEKqVYZYDlUxM54zJfCokB1z9fapJ",
+	"0jK2hlw1ngf7x06oNrRu9BilEndWQGlyjZ7NqvW0EcAPWijyRkhWZRPT8gIvs6PsSst8bTvWTBDJ+Np0",
+	"57AkQnItcI0m7uKIYbcQtTHYgXVR/N7g9ZSw7ObmpmuJ4FbIYJPtEwUXbixSs6VSykmpD7X9Uga91VPB",
+	"W9OaKUygFVY1qxvF/cI4JtdErgwggX5vgK+VEYMrkMAREUjypq3ZtppxBlhK55kl1R3TT4QWChN2Cki7",
+	"t2GNO1Pj7On5Rey8KK2rFU1oKkIERUU/CF2A82M4qzQUoEXNCDXyKi8JUIlEM6+IFG4PKUE9QyeYUiaV",
+	"a2QMw2KGnlN0gisoT7CAe4+fKOaJqWJZ2qGP9+KmOckZh39ePZqDxI/+yWqguCb/fKUZ9xIkjnfp1qnV",
+	"y+hctVa9fJxkZD/TvutWRBvFrpBobJa2lNcRAA86V70mSEEjCwJiwOVy6tv7h0VskCkMFa5ri8z4TQPj",
+	"brl91q8caKpcRdcyyJG1lVB64DeTjFEY4Ui10N5MNjduIW7rphNGjWIa78MlF5qH4725tMM6bgl5aNo+",
+	"Gemg9qxQD0VPd0ut+pBlmhlngEXKkDa/p6J5ZyqagXIHIDaMTsEtDt3U/PO0ESvzrx+AglqVdHn+/auX",
+	"2SRTVlsJEtQGeYZJqf9xgmkOpelh/t0KmGwymwbHFwgbbBJRPAzGD2WwSW+Mgy3jwQ828lwZBhOxa0sj",
+	"xcf0Khgwjq1hHDqkp/3MxrfOyZK+z1xp+9PCGvjsULSHoQ0o8ocWeOLWNmgbTCRYAzRthQLVTgIuvAel",
+	"ZKscCmBpbyvXQDUukdps1r4R+vAkdOhavdrh0iG0VtRsggTzrqfrK7R7b0LHaicTLiRShqC2j1gjEQV5",
+	"zfglwjp0u5uzvyBlaiTPSGmIjLjj+TLe4NLzoWAlbS7gFMpjvmwqd7DVMb90A4RdixQ9E+8Fz9eaK7mP",
+	"lZsGiAhEqJC4LKFAjHubaSc21Ti/TM95FJQ5O32JXENFpEXbC4yOR8vr6sxZ9WQEdh41VhQ4E6IdnEVz",
+	"WBgLUdOnbA1H9i2m9yyicZ0ahFgLCVWxgzlmOyTiNrG4eNL2z4bEQdQskgWKGZGD1zKvBkVA5J6lAhA0",
+	"CreyRtZNBKjC714AXcpVdvT4228nWUWo+/tRwoQODl4KkcTLEXgePf73Lp4aSwlcgfnfv715c/1W/Wc2",
+	"ffvn4eTR43+7+dtATNZ7ldsGHdZB8IbUJ6V/Xp08N+daYtVltA3LbGRJx6CKqJpE0xIxbrNhrmXShkWj",
+	"vseaAyk5mZSH6Ok7rOOt9kzUaRbGte91BgtUNUJ7awJkf03Z9mnmEloSCgGmYbOiJX0YYNo9pTlzbsfO",
+	"ZrHrfGGNezuI3cT9j7aTcgZZMbBd1DC+EqgGXhET0FRt20HJw++++SYaKqESlsCNRJarNFw8F6xsJCDV",
+	"JOZZTxBvXmMaw/Zl9GNg0KbVZJuFGIjwx5V2arEnEsc7yW0gNRH95XNX29NB3yKXRLNYkHdpVOab3iQ6",
+	"cO72yeuzF46CQO129kcj2zwJL4iQm7ivvpsoTqn+1TaEW7kgdxQnc/qzTdCLLch3VLv7KNanHMVSk21i",
+	"WLvFlMwi2Lzef4ZrC+LM8HNHT8n2QnNWrOMjNX/Y1sz9KnCz2d8dkfH0fNBYeXUFnJPCnA0rdTqLus2c",
+	"rp6h5wvEKiIlFJPosPoroS0dInTmx71YN3T43DziTGrP9oy6r7cLT91xF25ZVB+dUZ1FrLm2eZGeweLc",
+	"H2sMSWbfqGVq2aWnB23kCqZDM5Cwzx3k7fowAVNnWwhAWKBomjdP6/bMlgDKhVoCpSOzWyadwW3hfssn",
+	"2zQDccN4Fmjbn1wPe5P9aZhjAa95meaJ+jjaJJhky3p5soL8sg/s1xXIFXBF2BVwslhrcD+c/oAEWVKs",
+	"xL4IyS/WvW0Zl+4AqZ/AtqyXP8GARRXRrpBdwlrvug4dsUM9Uuoo4M+f9PmiMhEFM0iwsRrjVRwaescg",
+	"2v3ffb2bY0WNG+WmcPMyM1G894hBGwDpaKT5ZsORhC59CNX+/YQIlYG4e5wySbXHNqJdTMy45oHWNv+2",
+	"SsieeFQMbAvI7rnQrUMWMdj7DFlswPPphSxs4NbZyD3aP2DM4tyehd4qKK46I/Nx7paRWVR5CIBh2k1C",
+	"7Qj2cJw5zkmJzg7zXnB/5MFau18/L2McmDhY2Eq5GBmSNO1vJlkjgJ/EJ7Pjgbzude0uEUtWe4wTz/ct",
+	"68Ofeg8uAt0iztHRTlrDufbSzFdz7SN0SjjGkWc1EIKJWrid1omspoJXZl0nhnBiSQxt+jbcLXzocJSc",
+	"cKY1pf5EZyCE1ajwvmoY5bb0x2sSQSdIJeUo35Xx5YH+oCTRkcTLdBpoiYU8B6Bp3OorkqSCIP5VmjwS",
+	"ABQ9WAHmcg5YatDuDkhWYAlT1SmFr8KULEDIJ2QJYiAiWehvqTG63qPyLydZP9y/aeGaJl09aH9tKDEG",
+	"HVClZRHjqDAKd4uhalsNawm/zDwK22W3YxxD1y5ogO6KZTOPX6ek1hC3e40jvivxty0zpc1kfVsivxwy",
+	"p81nbUY7QH0cye2hmg1b0u7rblA78tijmETDGJTC4brLrirap8uF5Enqsldd+qRk/tIdpoU5SAmHyQVZ",
+	"aPnjrnOJ902stINJZlZW6ykUS5gaCqfKR+8nVnqZ4/JrO2mOwU/flKnpeU2iyOfd5TTuI6afed6fWaa3",
+	"SPyzHe8h889AdtcrBwRtu1HHMveZ/PpASKj1o/Mb1Dd1k6ZkuNgQms1VwEQ0VR+1EoznPx5PH3/7HXKt",
+	"2hc5LdKJ05xqF6M3mVjhx99+d2Turqzgnf4HvMkGLDmfxL9tKtI3H2xeCh3ltrboTtIjyB8wdKXojzQc",
+	"Nfz5Wpr4jZdkhMrvvkmeRjbUDRqKk1uxv5WZEXFw9EzY+x4g3aUTMw6BAmVpNRqRfj6KU4ZUx6EEzWNp",
+	"2crY7k1MtyjsnE7CUt+yGz+5pNEOWXedNdrNv58N8WOXmJ0FOipx9MSYKt3U0XvJFE0OqZMqmmzTInID",
+	"qHa66ACoThZoslU7bJgG1M0D3dAqDiimFtTmTFA7nRtSQW8/ByEFtEOguXV0usIiRZ7yB9Qnkw5hL3za",
+	"+1zG+BUdQn9voNEczeO5rP2M5XZelLQxzN95UBHJ/+mwpT8PraeoSXIttUB4etMN7BLqsHVDDkRokEiC",
+	"6MuJD5kFkcK+v82zz4PYmgdhjfdNxydxkzHnJ8b823axJvJCtyfG+iPw9706k4T5NsWMi5Fn0Z2iE2Hw",
+	"Yw6otwnMQMrzCEqH3FseZoTe408zwui6qZd34Kf46jrbu0cXmHc7gmgt98EzA0vKtk2z6ZQgbjL+mODp",
+	"AH9x5AKPHKP3mt/vQCBQtKs62XgksEtA3kbvPnREvl0EwAfle2VzdgjS++Int9mvvjOSHOeXIph1tooO",
+	"wlJCVctUpo9kCLeKLGSfUdzvU4mQhephuwfJupXH7jRO5oF/cs55n7K79s/Dtki46H30u3jpAfQGR/1X",
+	"TNRqfsa4k7hC++l6S25w1U06e+sv74pOsmNXB+3Zzq7W0JjThG7s0hrFxpY9p32oYdtvH2rVZs72phHn",
+	"NjbusXV4oYxw+32nO/L800R0nH/faIOj2mqT8FWTe+ZDuqsDBIw3MTyAj+S0fi7uXVA73kbexe4oFF+8",
+	"t+es0AfiYVRYsb9yWoUsN9Q+c2syUd/SVxjUlz/zsil8BsJwuSqko15+aV1jIoXqlZB8qKGSlIMFG+OY",
+	"ii4uZQpxwhXwtYs5QBFZgKNWbsp3SebKDCde/7wt6Vot18ACIlBJ6KXOqTXs8denD8wVX8/wmrOiyd3p",
+	"GBE2D0ZtmPIar4WbhmL7POyYzTcyHbttfe22kJ+AUNi0xwWxw9UXRP3lvIN72d1uaoJ9lcRdayMO+6cW",
+	"5ghWDbior+b2KPRWDHFrxkBPXaY+BT6NKsviglAQAommqrDaqK8omLWSSmV+0C88+lAnajDfqwYebcNY",
+	"3szQaxP7QzgUt1UnazmrIFRbRYyrBupGSlyYNlFqdvTuHqoxnNjkG/1x/82FZZxiisMGoRiln6+SLCBf",
+	"5yXcUpNu9de18QTFccp7JhUIiavakVUxoSY+N7HaUAjX2V/oAZnBbBIqDCgEsSKwTr+ZHO37by1A/NBX",
+	"sCVd3qz0XF8BN4WNsRnJ+OiBr1M7avCu/nE0cE+KCndLxGgeKhGHj7YkBQecr0Agb1SbKZ+hn5l0oW0l",
+	"pkUzF2oTUBkYK8YOanuQItR37VSU9CHg6xVwU3J5xa4jk6BrLRjVo9ChBQGjVaQOuETx4W0B41QlzEFF",
+	"O1SsdpL9DNcjILRbOXn8nsHnAaDbjIahsdy8HZiy75NHgiesqvQSg7IQSKwwN6tI1dVIQUFXmBNsV9Td",
+	"VeDNOWjxxaNavFvuQ9xFZd5b3TwbVan1I5ZiTdxti3k16U1UIO7tyO1/sQNjghwbKGbb2XuTcdVtRzjM",
+	"gdYeiqGGSdQ3kyyul9Z3YtXSHSrkXcAVyc1ngbCM6gvdUQ3vdN3y0SW8k+Lns6rebWTHh6veXRBRl3id",
+	"Burd2FVTYTrlgAttWdpOiHavPnfC77vXCicSqlSh8D74HSqF30GN6s6e+/zKU5t1JRDuM3KoFnXrNEXX",
+	"D9MEL8kVUNRZ4wiXXAeQTShBh6fP7Lr7KRmrOvP+/LoGoY1hYR2TtrMEHB2fPo8no1XLt51/3omcpeZp",
+	"yGE0v5vwGQfZcGrDZ2qm1Psdti5twehX0rVg+laxmYk7DDDmyTo4581yadzBHy8uTh0Jqm04sTPnNRN0",
+	"qGaQMunqCMXZk18/Tqal7hNh7jQRRgiceiXguCdMw2d/6dWfmhs+1rDhZgsfOO45RhXOV4TCIKrr1bqD",
+	"wNS/0zS80ec4DYc3maVHF5TQ7c0SIAJBVUsFA7j+kzLNcV4ZYOHhF3SM7OlTXmLuqwboZWwHq5fxvJGh",
+	"5D1zFS6ITA5cbN7IlpeBeTokwxZH6E12btzWNxliPB7pvS8bUUM+xbSYWpZutXlTsXE7cCsm/AoIiy5l",
+	"Go04BO1xUv0aDn6UAGj0yNCClSW7Vlv/p2YOnIIEoaQ0ioeLXgswakObX64gGvbZN8YQVFK9LzlVsOTC",
+	"P3Kk4g9j8igCreGBJCiMeNHxb7M0FCVUi+4dsiuGNvSPajsjv8dsO0RoofOL6BIVIDEpBcJz1khLsScv",
+	"ubSZDV66wrJsIItk5o5JZkvf0mipNjdMYonU9TQK1NSMtgY+fFVhWLg8mHMCi4eIt0+VPc6vxKiRjssv",
+	"2Lx4B/IN/DZJrKU72TTnowSQ58jEVf1Tr2lN0DPtOqDX9JKy69apqvquz9FLof5vW4z0GjvUWVidXx3o",
+	"zs8e09DQW/X+NkTNjCo3bc0u989xqdXaeTwwHntdYkJtTZHvvhn7coZFdWo7u7+/t0CGhuNPJJNHuepL",
+	"lDfotprZX2JN5QokyaNnC3TpxhW+gok9SVKjL/XRGqaFDjaxRnjtbo1GdOxBaLNIAUCMhiKRf4Zcngly",
+	"hN2ki0MS2iQm5iVe25KSLm6s77eqv9WxeUUkYkbr06aaA1dYdXjd2phQmKcYrUxzLzSoDlpOcR19rhgH",
+	"M72R4ldSypsLrMa/N+BfdZyDL4ZDhGjACeX4FmvHKMTSYCyMIVIS04qD5ASujBKg8E7qsbFFoCSw+8Sw",
+	"Sc2NfmBEECGBSgNLkWXtypoJQVRPyzI70nZIRI07X2G6NOWCNQvkClOE0QKuUUVoo9il57TG+iqTZomb",
+	"cavbbczYcdvEzRthbGt9E8pMrWWle+yO6MPrHJeOU+azuwNmI+2iZlRJmoaWIARas8bQwyEH4lkp2SVQ",
+	"n1cHnKvhGNE4YHZWptSJcgFPWDNUlTSsqCiWbxaXpVMz/npF8pU+oFLsbx/Euol2Q7GGKLhfzWJxR2oF",
+	"KvEcSjUdhqsCSsgl40JXQeuucz8OR5Q6ONdiUK9Tw0gFxjG9hIVEDdWbhxauwhoqGu0dCeAEl7YCSZtQ",
+	"e6OtBKlqPBC90ueQ40YAItLYzxLlq4ZeKkgsfNUssAcKWqnqRg/DeDhY1pkV2B2TGQgR7zMS57kx7ZHr",
+	"NX71aPboW1Qw52dGOMwqJ1TqUyi1zUMFqu66USP7VxCSVNpc+lckoyuEaouWav40ESfaI/Tvyiq8HLSk",
+	"HIItmZN8jNs/QNXgHXujcJxJECR06iDafUOkq0XUwUQNXIugIq1JzMawG0LoHlaUaSFu24awYScAQSmT",
+	"20rkJ8pFdAJKvrGxItdeIJJWWDHikqLHGlv64HAgEuwMd9NT26lmKDucXBZQwm1w2V2gu++Cb7nBKD9G",
+	"RsTlXsS0AiWR7xOguJ1RxBkcM3Tq3zear6MKJjOdqTlVBsJIG16Lw43T3ykBt2U1vMT6GNh8VrU4nHmj",
+	"X/P1bwxG2p3xJVaPNut2OZawZFz9+UDkrDa/GiH9MA6l9RbVuCvepn2q4mZvXOyaAk9NYvttWXZNhXv0",
+	"2vyu71m/0f74gcJtnkBOp8pPMtdr+O1t6kwjy1SNlrRT04z98ZWIHsk28Npvb487Bk9KsVMs81VUJtZn",
+	"WOxw9sEGdl8IMUmGauCKXbHdj4si82966n9V7Er9QypiUnHcdE3vY/Qf569+RqdMc0lX9U6fYKrVmiZV",
+	"f3LRCsbdk6GznoPJ6mwyXPi7V3FHQN5wItfnyqm1NdIAc+DHjVwNesDtTmlPOAIzNLdtTOavZ052/Mev",
+	"FyqspFFkR/Zr4JoKhA0CZnz5vBgofvk6VKi0IiCKUNhdFWzhGUIvcW2jM60OQW3O1G5TE0qofrcOdH08",
+	"Ixgyxpf/JFEVGFwTVZhTbXRH5K1ZbCDoYjKELpjzt+yFJagwKbOjTAKu/kdcMCcQpxhiHnTXHghnJboA",
+	"rI5vG15aJqtoY6v3TfKaDHLnIlYDa7+6DXv2hl7o4wDbosJUF/+JKvZF5obqP7dPIfl6Qfb8t/USzeyN",
+	"cq9LkgM18UI7uOMa5ytAj2eHvfFcX1/PsP48U5XMbF9x8OL5ydOfz59OH88OZytZlXrLEFkqcB0+tQd9",
+	"fPo8OuQ/Cm/xq3k2s5UdZV/PDmeP7PbUW01FYw+uHplSanqw+udk+o9O5B4qve4l2fPCNg0thcZonx0U",
+	"+sC5bx8Yb8S4rUog5TL4CGwRnEBn5hn1T7jxa8TQ6tdfzy10t51xwrq7mdwpVSbbZogq/fV2VKkdU+F3",
+	"pGqqlr8mdEleT1DsRXoPcYhHpCKyRUXvXMxizI4eHR4e6qQa8+dhyj1IKnJ7lO3XgeKppsM5Z2YA/szL",
+	"KPYhkn1UZyfeKb9TRxm948DBGJPpFzcJRYDzVbzoifOpNrM0enezRWJhaid7M6FbN1kdWzvYeic+Pjzs",
+	"PHESvWt18F82DB0QjLsDrfanEdsdr+wnJS++uUOcPgrdw/U9LpAzqzTSRx8A6WuKG7nSdnZhsH79AbA+",
+	"Y3xOigL0KfY3j//+AVBeMIZeYrp2LNbpyN9+kNGeW+36mvo4ozG38VL4tP+5v9Fes9R1phOTLThctr6t",
+	"cEzzVgqEjYB9z4r1PewgM/Bg9yq5ctPbu4/uDXOKW4W2QuilRm5uxrXfo27zLO+2aGtpb8b8zQs79dzF",
+	"vxw4q1P7eHpmI+6HAo4dZL0mvRm60/qKW4i+VY3FzTAHyizeTLInOpayaSqKbotbT8UPIDchWoK8cywv",
+	"2HILItXilrhu9vrovvXR4YfQR6oYbklyudeAfQ34buoUW3YUfdMEJxy0gz/V3rgxOrMEmcri1L+P1p5P",
+	"Nouf327xCow3jHUkyNvFVlK29eYmG/4+7eHhCfxIdvDsCxM833wAlOrq1DPW0GIvefqSJxnn+UGffI6T",
+	"HD+A/CTFxl24/n8BP38v2/aybW9V7WJVHRivWFE5EJjQ3xFGvKE6mSR6PsXUbDbwEKHIFtCdmCi9/hfj",
+	"yNYvtde57aGwQQtFX8SebHbTf7vlE30G4edgpn2S4mwvze5Zmn1QrxRNzRa1aXZ2c+gMSb1L9/J1vHx1",
+	"EnSzmC1N0GjQAC3ZUrgSpCk7ET1T33JJruzBrZgg8+yJMH1LcmVb5b6agmtoTslsKv63h4eoJBTEFuu2",
+	"H8T6NA1cwwXDBHtMxhpRrtGDklwCumzmkMvSfJ8uHiY5eQlQ697U5BgiVgPdxk1cWqg6n6lkAobPP/VN",
+	"mbu2mCW8kwdwBVTaF3Dau6Jzms2WiDWybiTCwuZxTs+BSvT0ymRTGj4+0FnHhuB/KA6jRZdfD9PZRYoa",
+	"k7g/mgzdHKmebbyaJ8heyO3OQAr93urfW/17rRTrHaVwNquk8H77BuvfHkt2nzQvgOtLA/6mgC0ygRiF",
+	"CcpZvSY671zoVFdz/8/nROiHXe21Tn0bWArUOv+icD21pE0tBpfAbFJTcsYLrcTsvYTNh6Ph9ftdFZqt",
+	"oXDf8d77PLjtP/3/SZ7k7n2av5asRtPE5vGXurW4+ChuT0SNFkqmjgXtF6/YK5sdlE2kSro6B2y0eHuW",
+	"Zeppl4E0yxCC3udZ7vMsP3Se5b0H/6IHmvYRwH3S4keT/EZ2j89a7EjwjZb5UFbcXe+ij2Luxqh3yVwc",
+	"zCbsNbl1KluU+zKEreg1uT02+/TsZnyJRu+dqjeEbAnyHvBszAkMTfZJgfukwH1SYFrD9J0L5zoMuBS7",
+	"5wVu1U9Ptki+cScgCTT71MB9IH0fSP+k5c/W3MCt0uMHkF+g6Nhi8O7lx15+7O2XDfbLLTPw7HulJgXP",
+	"Qmzl4IWXxd8zC+/uxNlnmIf3yQm2vVz7iyXi2T2yz8S7A1E7lIvXkbgu4DR4KOXCVl3bL7za544TOCyJ",
+	"sDX6O77k1qjWZ2QSslxCOsvMH+nMCcX6KGVEbhaaIveKGJqXbI4c2ptJ9vXh4/6EuDPlMygIh9yW+zSs",
+	"NxBen73IJtkKcGFjay9Y7suzDbPhRmP8tz7GC6hqxjFfB5z3hH6vQvam8d3J6w+xlp672nMmjRQ95Zzx",
+	"z1FdeEWwRWHsnL3dFdpx0rGFPSJ/27fcNYF76MTho2qc+0nh9jwalcPd4+gXmMRtefDRsrg34N9Hj/Yq",
+	"cu/StHVUKpHbP0A4Jq9u4A36gdS60wB6n123z677C2bX+RW+T7DbJ9h9XAVQhzcKx+bY9aV5/B41juys",
+	"uMqvfRlEsIW8xhzcw40bM/Q8pvtM0gtIPkaeXgf7/mrKF5B8haaJvdR515QOPGa6l1p9qdW3XCPrdNhw",
+	"3T17qy/5NiZwxeJr9xhIGtk+jWvvSH/Jx5V7GZiWgVtzx8bILhe8/RIF13ZrbC/A9pHAL8YRxDJPvGqk",
+	"X2ba5AiatyLPnp2g7/5++Ni+gaQ62TQxL28EkpgvQVdsOBA15AcGwoEJOpongQR6wLiu4ZCvSFlwoA/1",
+	"KUm4nmKCeAhzQDjPodYPsNvTo4WFUeG1ecd0DggXBRToAa5roObxsofmiUA/fPOOnX17k1Ck3uQ2j2qe",
+	"Gg/Xp7CZR2jaElSP9dOXoWNd6aleB/9tt4W4/U2vUY72FyDa95J9b5p+AbqkSZimZ+Yxu03mqdEYSjfM",
+	"7C8d3RBJcfT//s//NfHFZm6fjzXvKCthruQ+Ek0N3L7GrBrmDefuuWWjVfzbblap2Keh7bPKM3Rclsi8",
+	"C61osic1HkPvDWQhGQf/HCXjCKNvDg8RCYctd6p5LEP/OrrnfsO4H1677EPHe7X2mSaI54w6cdnUhb69",
+	"YT/uY9K3iknrV1j5lRPK5qXKg+zmrYfZe707OE6MDr4JaQVzVDTpZjICUqruUQzKZomMgjWQ6xGDC5y6",
+	"eXvz/wcAX/uAByf0AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -28,11 +28,21 @@ const (
 	ExistingCatalogItem ExistingCatalogItemTargetType = "ExistingCatalogItem"
 )
 
+// Defines values for ExportCompressionType.
+const (
+	ExportCompressionTypeNone ExportCompressionType = "none"
+	ExportCompressionTypeXz   ExportCompressionType = "xz"
+	ExportCompressionTypeZstd ExportCompressionType = "zstd"
+)
+
 // Defines values for ExportFormatType.
 const (
 	ExportFormatTypeISO                ExportFormatType = "iso"
+	ExportFormatTypeOVA                ExportFormatType = "ova"
 	ExportFormatTypeQCOW2              ExportFormatType = "qcow2"
 	ExportFormatTypeQCOW2DiskContainer ExportFormatType = "qcow2-disk-container"
+	ExportFormatTypeRAW                ExportFormatType = "raw"
+	ExportFormatTypeVHD                ExportFormatType = "vhd"
 	ExportFormatTypeVMDK               ExportFormatType = "vmdk"
 )
 
@@ -50,7 +60,8 @@ const (
 
 // Defines values for ImageBuildConditionType.
 const (
-	ImageBuildConditionTypeReady ImageBuildConditionType = "Ready"
+	ImageBuildConditionTypeReady  ImageBuildConditionType = "Ready"
+	ImageBuildConditionTypeSigned ImageBuildConditionType = "Signed"
 )
 
 // Defines values for ImageBuildRefSourceType.
@@ -58,6 +69,13 @@ const (
 	ImageBuildRefSourceTypeImageBuild ImageBuildRefSourceType = "imageBuild"
 )
 
+// Defines values for ImageBuildSignedConditionReason.
+const (
+	ImageBuildSignedConditionReasonSigned          ImageBuildSignedConditionReason = "Signed"
+	ImageBuildSignedConditionReasonSigningDisabled ImageBuildSignedConditionReason = "SigningDisabled"
+	ImageBuildSignedConditionReasonSigningFailed   ImageBuildSignedConditionReason = "SigningFailed"
+)
+
 // Defines values for ImageExportConditionReason.
 const (
 	ImageExportConditionReasonCanceled   ImageExportConditionReason = "Canceled"
@@ -187,6 +205,9 @@ type ExistingCatalogItemTarget struct {
 // ExistingCatalogItemTargetType Discriminator for the target type.
 type ExistingCatalogItemTargetType string
 
+// ExportCompressionType The compression applied to the exported image. Only supported for the raw format. Defaults to none.
+type ExportCompressionType string
+
 // ExportFormatType The type of format to export the image to.
 type ExportFormatType string
 
@@ -243,6 +264,27 @@ type ImageBuildConditionReason string
 // ImageBuildConditionType Type of ImageBuild condition.
 type ImageBuildConditionType string
 
+// ImageBuildCustomizations ImageBuildCustomizations specifies additional content to add to the built image.
+type ImageBuildCustomizations struct {
+	// ApplicationImages References of application container images to embed in the image, so that applications can start on first boot without network access.
+	ApplicationImages *[]string `json:"applicationImages,omitempty"`
+
+	// Files Files to add to the image.
+	Files *[]ImageBuildFile `json:"files,omitempty"`
+
+	// KernelArguments Kernel arguments to add to the image, applied by bootc when the image is installed or updated.
+	KernelArguments *[]string `json:"kernelArguments,omitempty"`
+
+	// Packages Additional RPM packages to install in the image.
+	Packages *[]string `json:"packages,omitempty"`
+
+	// RpmRepositories Additional RPM repositories to configure in the image before installing packages.
+	RpmRepositories *[]ImageBuildRpmRepository `json:"rpmRepositories,omitempty"`
+
+	// Systemd ImageBuildSystemd specifies the systemd units to enable or disable in the image.
+	Systemd *ImageBuildSystemd `json:"systemd,omitempty"`
+}
+
 // ImageBuildDestination ImageBuildDestination specifies the destination for the built image.
 type ImageBuildDestination struct {
 	// ImageName The name of the output image.
@@ -255,6 +297,33 @@ type ImageBuildDestination struct {
 	Repository string `json:"repository"`
 }
 
+// ImageBuildFile ImageBuildFile specifies a file to add to the image. Exactly one of content or httpRef must be set.
+type ImageBuildFile struct {
+	// Content The inline content of the file.
+	Content *string `json:"content,omitempty"`
+
+	// ContentEncoding Specifies the encoding type used for data representation.
+	ContentEncoding *externalRef0.EncodingType `json:"contentEncoding,omitempty"`
+
+	// HttpRef ImageBuildFileHttpRef references the content of a file in a Repository of type http.
+	HttpRef *ImageBuildFileHttpRef `json:"httpRef,omitempty"`
+
+	// Mode The file's permission mode. Defaults to 0644.
+	Mode *int `json:"mode,omitempty"`
+
+	// Path The absolute path of the file in the image.
+	Path string `json:"path"`
+}
+
+// ImageBuildFileHttpRef ImageBuildFileHttpRef references the content of a file in a Repository of type http.
+type ImageBuildFileHttpRef struct {
+	// Repository The name of the Repository resource of type http.
+	Repository string `json:"repository"`
+
+	// Suffix The suffix to append to the URL of the repository.
+	Suffix *string `json:"suffix,omitempty"`
+}
+
 // ImageBuildList ImageBuildList is a list of ImageBuild resources.
 type ImageBuildList struct {
 	// ApiVersion APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources.
@@ -294,6 +363,24 @@ type ImageBuildRefSource struct {
 // ImageBuildRefSourceType The type of source.
 type ImageBuildRefSourceType string
 
+// ImageBuildRpmRepository ImageBuildRpmRepository specifies an RPM repository to configure in the image.
+type ImageBuildRpmRepository struct {
+	// BaseUrl The base URL of the repository.
+	BaseUrl string `json:"baseUrl"`
+
+	// GpgCheck Whether to verify the GPG signatures of the packages. Defaults to true.
+	GpgCheck *bool `json:"gpgCheck,omitempty"`
+
+	// GpgKey The URL of the GPG key used to verify the packages.
+	GpgKey *string `json:"gpgKey,omitempty"`
+
+	// Name The ID of the repository, also used as the name of the repository file.
+	Name string `json:"name"`
+}
+
+// ImageBuildSignedConditionReason Reason for the ImageBuild Signed condition.
+type ImageBuildSignedConditionReason string
+
 // ImageBuildSource ImageBuildSource specifies the source image for the build.
 type ImageBuildSource struct {
 	// ImageName The name of the source image.
@@ -311,6 +398,9 @@ type ImageBuildSpec struct {
 	// Binding ImageBuildBinding specifies binding configuration for the build.
 	Binding ImageBuildBinding `json:"binding"`
 
+	// Customizations ImageBuildCustomizations specifies additional content to add to the built image.
+	Customizations *ImageBuildCustomizations `json:"customizations,omitempty"`
+
 	// Destination ImageBuildDestination specifies the destination for the built image.
 	Destination ImageBuildDestination `json:"destination"`
 
@@ -339,6 +429,15 @@ type ImageBuildStatus struct {
 	ManifestDigest *string `json:"manifestDigest,omitempty"`
 }
 
+// ImageBuildSystemd ImageBuildSystemd specifies the systemd units to enable or disable in the image.
+type ImageBuildSystemd struct {
+	// Disable The names of the units to disable.
+	Disable *[]string `json:"disable,omitempty"`
+
+	// Enable The names of the units to enable.
+	Enable *[]string `json:"enable,omitempty"`
+}
+
 // ImageBuildUserConfiguration ImageBuildUserConfiguration specifies user configuration for the build.
 type ImageBuildUserConfiguration struct {
 	// Publickey The public key for the user configuration.
@@ -366,6 +465,27 @@ type ImageExport struct {
 	Status *ImageExportStatus `json:"status,omitempty"`
 }
 
+// ImageExportArtifact ImageExportArtifact describes the exported file served by the download endpoint.
+type ImageExportArtifact struct {
+	// Checksum The SHA-256 checksum of the exported file, in the form "sha256:<hex>".
+	Checksum string `json:"checksum"`
+
+	// Compression The compression applied to the exported image. Only supported for the raw format. Defaults to none.
+	Compression *ExportCompressionType `json:"compression,omitempty"`
+
+	// Filename The name of the exported file.
+	Filename string `json:"filename"`
+
+	// Size The size of the exported file in bytes.
+	Size int64 `json:"size"`
+
+	// UncompressedChecksum The SHA-256 checksum of the image before compression, in the form "sha256:<hex>". Only set if the file is compressed.
+	UncompressedChecksum *string `json:"uncompressedChecksum,omitempty"`
+
+	// UncompressedSize The size of the image in bytes before compression. Only set if the file is compressed.
+	UncompressedSize *int64 `json:"uncompressedSize,omitempty"`
+}
+
 // ImageExportCondition defines model for ImageExportCondition.
 type ImageExportCondition struct {
 	// LastTransitionTime The last time the condition transitioned from one status to another.
@@ -421,6 +541,9 @@ type ImageExportSourceType string
 
 // ImageExportSpec ImageExportSpec describes the specification for an image export.
 type ImageExportSpec struct {
+	// Compression The compression applied to the exported image. Only supported for the raw format. Defaults to none.
+	Compression *ExportCompressionType `json:"compression,omitempty"`
+
 	// Format The type of format to export the image to.
 	Format ExportFormatType `json:"format"`
 
@@ -430,6 +553,9 @@ type ImageExportSpec struct {
 
 // ImageExportStatus ImageExportStatus represents the current status of an ImageExport.
 type ImageExportStatus struct {
+	// Artifact ImageExportArtifact describes the exported file served by the download endpoint.
+	Artifact *ImageExportArtifact `json:"artifact,omitempty"`
+
 	// Conditions Current conditions of the ImageExport.
 	Conditions *[]ImageExportCondition `json:"conditions,omitempty"`
 
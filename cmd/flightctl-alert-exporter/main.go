@@ -69,7 +69,7 @@ func main() {
 	}()
 
 	processID := fmt.Sprintf("alert-exporter-%s-%s", util.GetHostname(), uuid.New().String())
-	queuesProvider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	queuesProvider, err := queues.NewProvider(ctx, log, processID, cfg, queues.DefaultRetryConfig())
 	if err != nil {
 		log.Fatalf("initializing queue provider: %v", err)
 	}
@@ -78,7 +78,7 @@ func main() {
 		queuesProvider.Wait()
 	}()
 
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg)
 	if err != nil {
 		log.Fatalf("initializing kv store: %v", err)
 	}
@@ -25,16 +25,25 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/rendered"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
 	"github.com/flightctl/flightctl/internal/store"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
 	resourcesyncstore "github.com/flightctl/flightctl/internal/store/resourcesync"
+	"github.com/flightctl/flightctl/internal/tasks"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/google/uuid"
 	"github.com/prometheus/client_golang/prometheus"
+	"github.com/sirupsen/logrus"
 )
 
 //nolint:gocyclo
@@ -51,11 +60,16 @@ func main() {
 	defer log.Println("API service stopped")
 	log.Printf("Using config: %s", cfg)
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
 	caClient := crypto.NewCAClient(cfg.CA, ca)
+	defer func() {
+		if err := caClient.Close(); err != nil {
+			log.Errorf("closing CA: %v", err)
+		}
+	}()
 
 	serverCerts, err := config.LoadServerCertificates(cfg, log)
 	if err != nil {
@@ -91,14 +105,20 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed creating TLS config: %v", err)
 	}
+	// Keep accepting device certificates issued by a CA that is being rotated out.
+	clientCAs, err := caClient.GetClientCAsX509()
+	if err != nil {
+		log.Fatalf("loading client CA certificates: %v", err)
+	}
+	agentTlsConfig.ClientCAs = crypto.NewCertPool(clientCAs)
 
 	processID := fmt.Sprintf("api-%s-%s", util.GetHostname(), uuid.New().String())
-	provider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	provider, err := queues.NewProvider(ctx, log, processID, cfg, queues.DefaultRetryConfig())
 	if err != nil {
-		log.Fatalf("failed connecting to Redis queue: %v", err)
+		log.Fatalf("failed connecting to queue: %v", err)
 	}
 
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg)
 	if err != nil {
 		log.Fatalf("creating kvstore: %v", err)
 	}
@@ -108,6 +128,12 @@ func main() {
 	if err = rendered.Bus.Instance().Start(ctx); err != nil {
 		log.Fatalf("starting rendered version manager: %v", err)
 	}
+	if err = watch.Bus.Initialize(ctx, provider, log); err != nil {
+		log.Fatalf("creating watch hub: %v", err)
+	}
+	if err = watch.Bus.Instance().Start(ctx); err != nil {
+		log.Fatalf("starting watch hub: %v", err)
+	}
 
 	// create the agent service listener as tcp (combined HTTP+gRPC)
 	network := "tcp"
@@ -145,7 +171,7 @@ func main() {
 			log.Fatalf("creating listener: %s", err)
 		}
 		// we pass the grpc server for now, to let the console sessions to establish a connection in grpc
-		server := apiserver.New(log, cfg, db, caClient, listener, provider, agentServer.GetGRPCServer())
+		server := apiserver.New(log, cfg, db, caClient, listener, provider, agentServer.GetGRPCServer(), newResourceSyncPlanner(cfg, log))
 		if err := server.Run(ctx); err != nil {
 			log.Fatalf("Error running server: %s", err)
 		}
@@ -210,3 +236,16 @@ func main() {
 
 	<-ctx.Done()
 }
+
+// newResourceSyncPlanner returns a factory for the planner that previews ResourceSyncs
+// using the same resource handling as the periodic ResourceSync task.
+func newResourceSyncPlanner(cfg *config.Config, log logrus.FieldLogger) apiserver.ResourceSyncPlannerFactory {
+	var ignoreResourceUpdates []string
+	if cfg.GitOps != nil {
+		ignoreResourceUpdates = cfg.GitOps.IgnoreResourceUpdates
+	}
+	return func(repositorySvc repositoryservice.Service, fleetSvc fleetservice.Service, catalogSvc catalogservice.Service,
+		authProviderSvc authproviderservice.Service, deviceSvc deviceservice.Service) resourcesyncservice.Planner {
+		return tasks.NewResourceSync(repositorySvc, fleetSvc, nil, catalogSvc, authProviderSvc, deviceSvc, log, cfg, ignoreResourceUpdates)
+	}
+}
@@ -68,23 +68,28 @@ func main() {
 	ctx = context.WithValue(ctx, consts.EventActorCtxKey, "service:flightctl-imagebuilder-worker")
 
 	processID := fmt.Sprintf("imagebuilder-worker-%s-%s", util.GetHostname(), uuid.New().String())
-	provider, err := queues.NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, queues.DefaultRetryConfig())
+	provider, err := queues.NewProvider(ctx, log, processID, cfg, queues.DefaultRetryConfig())
 	if err != nil {
-		log.Fatalf("failed connecting to Redis queue: %v", err)
+		log.Fatalf("failed connecting to queue: %v", err)
 	}
 
-	kvStore, err := kvstore.NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, log, cfg)
 	if err != nil {
 		log.Fatalf("creating kvstore: %v", err)
 	}
 
 	// Initialize CA client for generating enrollment credentials
 	log.Println("Initializing CA client")
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading CA certificates: %v", err)
 	}
 	caClient := crypto.NewCAClient(cfg.CA, ca)
+	defer func() {
+		if err := caClient.Close(); err != nil {
+			log.Errorf("closing CA: %v", err)
+		}
+	}()
 
 	server := imagebuilderworker.New(cfg, log, imageBuilderStore, db, kvStore, provider, caClient)
 	if err := server.Run(ctx); err != nil {
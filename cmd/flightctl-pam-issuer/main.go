@@ -42,11 +42,16 @@ func main() {
 		log.Fatalf("PAM OIDC issuer not configured")
 	}
 
-	ca, err := crypto.LoadInternalCA(cfg.CA)
+	ca, err := crypto.LoadCA(cfg.CA)
 	if err != nil {
 		log.Fatalf("loading client-signer certificates: %v", err)
 	}
 	caClient := crypto.NewCAClient(cfg.CA, ca)
+	defer func() {
+		if err := caClient.Close(); err != nil {
+			log.Errorf("closing CA: %v", err)
+		}
+	}()
 
 	// Use separate configuration for PAM issuer service
 	pamIssuerAddress := cfg.Auth.PAMOIDCIssuer.Address
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/spf13/cobra"
+)
+
+func NewCACommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ca [command]",
+		Short: "Manage the certificate authorities of the service",
+		Run: func(cmd *cobra.Command, args []string) {
+			_ = cmd.Help()
+			os.Exit(0)
+		},
+	}
+
+	cmd.AddCommand(NewEnableCRLSigningCommand())
+
+	return cmd
+}
+
+func NewEnableCRLSigningCommand() *cobra.Command {
+	var certFile, keyFile, issuerCertFile, issuerKeyFile, bundleFile string
+
+	cmd := &cobra.Command{
+		Use:   "enable-crl-signing",
+		Short: "Reissue a CA certificate so that it permits signing certificate revocation lists",
+		Long: `Reissue a CA certificate that lacks the cRLSign key usage, keeping its key, subject, key IDs and validity.
+Certificates issued by the CA remain valid. A self-signed CA certificate is reissued with its own key,
+any other CA certificate requires the certificate and key of its issuer.`,
+		Example: `  flightctl-standalone ca enable-crl-signing \
+    --cert /etc/flightctl/pki/flightctl-api/client-signer.crt \
+    --key /etc/flightctl/pki/flightctl-api/client-signer.key \
+    --issuer-cert /etc/flightctl/pki/ca.crt \
+    --issuer-key /etc/flightctl/pki/ca.key \
+    --bundle /etc/flightctl/pki/ca-bundle.crt`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			reissued, err := crypto.ReissueCAForCRLSigning(certFile, keyFile, issuerCertFile, issuerKeyFile, bundleFile)
+			if err != nil {
+				return err
+			}
+			if !reissued {
+				fmt.Fprintf(cmd.OutOrStdout(), "CA certificate %s already permits signing certificate revocation lists\n", certFile)
+				return nil
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "Reissued CA certificate %s with the cRLSign key usage\n", certFile)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&certFile, "cert", "", "CA certificate file to reissue (required)")
+	cmd.Flags().StringVar(&keyFile, "key", "", "CA key file (required)")
+	cmd.Flags().StringVar(&issuerCertFile, "issuer-cert", "", "Certificate file of the CA that issued the CA certificate, if it is not self-signed")
+	cmd.Flags().StringVar(&issuerKeyFile, "issuer-key", "", "Key file of the CA that issued the CA certificate, if it is not self-signed")
+	cmd.Flags().StringVar(&bundleFile, "bundle", "", "CA bundle file in which to replace the CA certificate")
+	_ = cmd.MarkFlagRequired("cert")
+	_ = cmd.MarkFlagRequired("key")
+
+	return cmd
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEnableCRLSigningCommand(t *testing.T) {
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client-signer.crt")
+	keyFile := filepath.Join(dir, "client-signer.key")
+
+	// A CA certificate as created before certificate revocation, without the cRLSign key usage
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: "client-signer"},
+		SerialNumber:          big.NewInt(1),
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	certPEM, err := oscrypto.EncodeCertificates(cert)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(certFile, certPEM, 0600))
+	keyPEM, err := fccrypto.PEMEncodeKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(keyFile, keyPEM, 0600))
+
+	run := func() string {
+		var out bytes.Buffer
+		cmd := NewStandaloneCommand()
+		cmd.SetOut(&out)
+		cmd.SetArgs([]string{"ca", "enable-crl-signing", "--cert", certFile, "--key", keyFile})
+		require.NoError(t, cmd.Execute())
+		return out.String()
+	}
+
+	require.Contains(t, run(), "Reissued CA certificate")
+	certs, err := crypto.LoadCACertsFromFile(certFile)
+	require.NoError(t, err)
+	require.NotZero(t, certs[0].KeyUsage&x509.KeyUsageCRLSign)
+	require.Contains(t, run(), "already permits")
+}
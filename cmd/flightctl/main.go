@@ -34,10 +34,16 @@ func NewFlightCtlCommand() *cobra.Command {
 	cmd.AddCommand(cli.NewCmdConfig())
 	cmd.AddCommand(cli.NewCmdDecommission())
 	cmd.AddCommand(cli.NewCmdDeny())
+	cmd.AddCommand(cli.NewCmdPlan())
+	cmd.AddCommand(cli.NewCmdExport())
+	cmd.AddCommand(cli.NewCmdImport())
 	cmd.AddCommand(cli.NewCmdLogin())
 	cmd.AddCommand(cli.NewCmdResume())
+	cmd.AddCommand(cli.NewCmdRevoke())
 	cmd.AddCommand(cli.NewCmdVersion())
 	cmd.AddCommand(cli.NewConsoleCmd())
+	cmd.AddCommand(cli.NewCmdPortForward())
+	cmd.AddCommand(cli.NewCmdCopy())
 	cmd.AddCommand(cli.NewCmdCompletion())
 	cmd.AddCommand(cli.NewCmdEnrollmentConfig())
 	cmd.AddCommand(cli.NewCmdCertificate())
@@ -13,6 +13,7 @@ import (
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/attestation"
 	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
@@ -271,9 +272,6 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.log,
 	)
 
-	// create hook manager
-	hookManager := hook.NewManager(rootReadWriter, exec, a.log)
-
 	// create systemd manager
 	systemdManagerFactory := systemd.NewManagerFactory(a.log)
 	rootSystemdManager, err := systemdManagerFactory("")
@@ -301,6 +299,12 @@ func (a *Agent) Run(ctx context.Context) error {
 	// create os manager
 	osManager := os.NewManager(a.log, osClient, osMode, rootReadWriter, rootPodmanClient, pullConfigResolver)
 
+	// create image verifier, nil if no trusted keys are configured
+	imageVerifier, err := dependency.NewImageVerifier(a.log, rootReadWriter, rwFactory, a.config.ImageVerification.TrustedKeys)
+	if err != nil {
+		return fmt.Errorf("failed to initialize image verifier: %w", err)
+	}
+
 	// create prefetch manager
 	prefetchManager := dependency.NewPrefetchManager(
 		a.log,
@@ -311,6 +315,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config.PullTimeout,
 		resourceManager,
 		pollBackoff,
+		imageVerifier,
 	)
 
 	// create status manager
@@ -319,6 +324,9 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.log,
 	)
 
+	// create hook manager
+	hookManager := hook.NewManager(rootReadWriter, exec, rootSystemdClient, statusManager, a.log)
+
 	// create lifecycle manager
 	lifecycleManager := lifecycle.NewManager(
 		deviceName,
@@ -335,6 +343,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		statusManager,
 		rootSystemdClient,
 		identityProvider,
+		tpmClient,
 		backoff,
 		a.log,
 	)
@@ -384,6 +393,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		a.config, deviceName,
 		bootstrap.ManagementClient(),
 		rootReadWriter,
+		a.executer,
 		identity.NewExportableFactory(tpmClient, a.log),
 		identityProvider,
 		statusManager,
@@ -412,6 +422,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		console.ConsoleUser,
 		exec,
 		specManager.Watch(),
+		a.config.FileCopy,
 		a.log,
 	)
 
@@ -426,6 +437,24 @@ func (a *Agent) Run(ctx context.Context) error {
 		systemInfoManager.BootTime(),
 	)
 
+	// register the steps of a factory reset, which remove everything the device was given
+	// through its spec before the agent wipes its identity
+	lifecycleManager.RegisterFactoryResetStep("removing applications", func(ctx context.Context, current *v1beta1.DeviceSpec) error {
+		if err := applicationsController.Sync(ctx, current, &v1beta1.DeviceSpec{}); err != nil {
+			return err
+		}
+		return applicationsManager.AfterUpdate(ctx)
+	})
+	lifecycleManager.RegisterFactoryResetStep("removing managed files", func(ctx context.Context, current *v1beta1.DeviceSpec) error {
+		return configController.Sync(ctx, current, &v1beta1.DeviceSpec{})
+	})
+	lifecycleManager.RegisterFactoryResetStep("removing volumes and images", func(ctx context.Context, current *v1beta1.DeviceSpec) error {
+		return lifecycle.PruneContainerStorage(ctx, podmanClientFactory, current, a.log)
+	})
+	lifecycleManager.RegisterFactoryResetStep("resetting agent configuration", func(ctx context.Context, _ *v1beta1.DeviceSpec) error {
+		return lifecycle.ResetAgentConfig(rootReadWriter, a.config.ConfigDir, a.log)
+	})
+
 	// create image pruning manager
 	pruningManager := imagepruning.New(
 		podmanClientFactory,
@@ -462,6 +491,7 @@ func (a *Agent) Run(ctx context.Context) error {
 		prefetchManager,
 		pullConfigResolver,
 		pruningManager,
+		certManager,
 		backoff,
 		a.log,
 	)
@@ -488,6 +518,10 @@ func (a *Agent) Run(ctx context.Context) error {
 	startAsync(func(ctx context.Context) { applicationsManager.RunConsole(ctx, appConsoleWatcher) })
 	startAsync(specManager.Publisher().Run)
 	startAsync(certManager.Run)
+	if tpmClient != nil {
+		attestationManager := attestation.NewManager(deviceName, bootstrap.ManagementClient(), tpmClient, time.Duration(a.config.TPM.AttestationInterval), a.log)
+		startAsync(attestationManager.Run)
+	}
 
 	// main agent loop: all critical work happens here serially
 	err = agent.Run(ctx)
@@ -61,6 +61,8 @@ type Management interface {
 	SetRPCMetricsCallback(cb RPCMetricsCallback)
 	CreateCertificateSigningRequest(ctx context.Context, csr v1beta1.CertificateSigningRequest, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
 	GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error)
+	GetDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, int, error)
+	CreateDeviceAttestation(ctx context.Context, name string, quote v1beta1.AttestationQuote, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, int, error)
 }
 
 // Enrollment is client the interface for managing device enrollment.
@@ -183,3 +183,56 @@ func (m *management) GetCertificateSigningRequest(ctx context.Context, name stri
 
 	return nil, resp.StatusCode(), nil
 }
+
+// GetDeviceAttestationChallenge requests a fresh nonce and the PCRs the device must quote to attest
+// its boot measurements. A 204 status without a challenge means the device is not subject to
+// attestation.
+func (m *management) GetDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, int, error) {
+	start := time.Now()
+	resp, err := m.client.GetDeviceAttestationChallengeWithResponse(ctx, name, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("get_device_attestation_challenge_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON200 != nil {
+		return resp.JSON200, resp.StatusCode(), nil
+	}
+
+	return nil, resp.StatusCode(), nil
+}
+
+// CreateDeviceAttestation submits a PCR quote answering an attestation challenge and returns the
+// integrity status of the device resulting from its evaluation.
+func (m *management) CreateDeviceAttestation(ctx context.Context, name string, quote v1beta1.AttestationQuote, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, int, error) {
+	start := time.Now()
+	resp, err := m.client.CreateDeviceAttestationWithResponse(ctx, name, quote, rcb...)
+
+	if m.rpcMetricsCallbackFunc != nil {
+		m.rpcMetricsCallbackFunc("create_device_attestation_duration", time.Since(start).Seconds(), err)
+	}
+
+	if err != nil {
+		return nil, http.StatusInternalServerError, err
+	}
+	if resp.HTTPResponse != nil {
+		defer func() { _ = resp.HTTPResponse.Body.Close() }()
+	}
+
+	if resp.JSON400 != nil {
+		return nil, resp.StatusCode(), fmt.Errorf("create device attestation failed: %s", resp.JSON400.Message)
+	}
+
+	if resp.JSON200 != nil {
+		return resp.JSON200, resp.StatusCode(), nil
+	}
+
+	return nil, resp.StatusCode(), nil
+}
@@ -175,3 +175,28 @@ func (d *ManagementDelegate) GetCertificateSigningRequest(
 	}
 	return m.GetCertificateSigningRequest(ctx, name, rcb...)
 }
+
+func (d *ManagementDelegate) GetDeviceAttestationChallenge(
+	ctx context.Context,
+	name string,
+	rcb ...agentclient.RequestEditorFn,
+) (*api.AttestationChallenge, int, error) {
+	m, err := d.mgmt()
+	if err != nil {
+		return nil, 0, err
+	}
+	return m.GetDeviceAttestationChallenge(ctx, name, rcb...)
+}
+
+func (d *ManagementDelegate) CreateDeviceAttestation(
+	ctx context.Context,
+	name string,
+	quote api.AttestationQuote,
+	rcb ...agentclient.RequestEditorFn,
+) (*api.DeviceIntegrityStatus, int, error) {
+	m, err := d.mgmt()
+	if err != nil {
+		return nil, 0, err
+	}
+	return m.CreateDeviceAttestation(ctx, name, quote, rcb...)
+}
@@ -63,6 +63,27 @@ func (mr *MockManagementMockRecorder) CreateCertificateSigningRequest(ctx, csr a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCertificateSigningRequest", reflect.TypeOf((*MockManagement)(nil).CreateCertificateSigningRequest), varargs...)
 }
 
+// CreateDeviceAttestation mocks base method.
+func (m *MockManagement) CreateDeviceAttestation(ctx context.Context, name string, quote v1beta1.AttestationQuote, rcb ...client.RequestEditorFn) (*v1beta1.DeviceIntegrityStatus, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, quote}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateDeviceAttestation", varargs...)
+	ret0, _ := ret[0].(*v1beta1.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// CreateDeviceAttestation indicates an expected call of CreateDeviceAttestation.
+func (mr *MockManagementMockRecorder) CreateDeviceAttestation(ctx, name, quote any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, quote}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestation", reflect.TypeOf((*MockManagement)(nil).CreateDeviceAttestation), varargs...)
+}
+
 // GetCertificateSigningRequest mocks base method.
 func (m *MockManagement) GetCertificateSigningRequest(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.CertificateSigningRequest, int, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +105,27 @@ func (mr *MockManagementMockRecorder) GetCertificateSigningRequest(ctx, name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateSigningRequest", reflect.TypeOf((*MockManagement)(nil).GetCertificateSigningRequest), varargs...)
 }
 
+// GetDeviceAttestationChallenge mocks base method.
+func (m *MockManagement) GetDeviceAttestationChallenge(ctx context.Context, name string, rcb ...client.RequestEditorFn) (*v1beta1.AttestationChallenge, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range rcb {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDeviceAttestationChallenge", varargs...)
+	ret0, _ := ret[0].(*v1beta1.AttestationChallenge)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDeviceAttestationChallenge indicates an expected call of GetDeviceAttestationChallenge.
+func (mr *MockManagementMockRecorder) GetDeviceAttestationChallenge(ctx, name any, rcb ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, rcb...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceAttestationChallenge", reflect.TypeOf((*MockManagement)(nil).GetDeviceAttestationChallenge), varargs...)
+}
+
 // GetRenderedDevice mocks base method.
 func (m *MockManagement) GetRenderedDevice(ctx context.Context, name string, params *v1beta1.GetRenderedDeviceParams, rcb ...client.RequestEditorFn) (*v1beta1.Device, int, error) {
 	m.ctrl.T.Helper()
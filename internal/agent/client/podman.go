@@ -458,6 +458,32 @@ func (p *Podman) RemoveArtifact(ctx context.Context, artifact string) error {
 	return nil
 }
 
+// PruneImages removes all images that are not used by a container.
+func (p *Podman) PruneImages(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"image", "prune", "--all", "--force"}
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("prune images: %w", deviceerrors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
+// PruneVolumes removes all volumes that are not used by a container.
+func (p *Podman) PruneVolumes(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	args := []string{"volume", "prune", "--force"}
+	_, stderr, exitCode := p.exec.ExecuteWithContext(ctx, podmanCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("prune volumes: %w", deviceerrors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
 // extractArtifactAnnotations parses the podman artifact inspect JSON output and extracts annotations
 func extractArtifactAnnotations(inspect *ArtifactInspect) map[string]string {
 	// Merge annotations from all layers in the manifest
@@ -274,6 +274,24 @@ func TestPodman_RemoveImage(t *testing.T) {
 	}
 }
 
+func TestPodman_Prune(t *testing.T) {
+	require := require.New(t)
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	mockExec := executer.NewMockExecuter(ctrl)
+	readWriter := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+	podman := NewPodman(log.NewPrefixLogger("test"), mockExec, readWriter, poll.Config{})
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"image", "prune", "--all", "--force"}).
+		Return("", "", 0)
+	require.NoError(podman.PruneImages(context.Background()))
+
+	mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", []string{"volume", "prune", "--force"}).
+		Return("", "Error: permission denied", 125)
+	require.ErrorContains(podman.PruneVolumes(context.Background()), "prune volumes")
+}
+
 func TestPodman_RemoveArtifact(t *testing.T) {
 	require := require.New(t)
 	ctrl := gomock.NewController(t)
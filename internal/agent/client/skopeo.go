@@ -90,23 +90,11 @@ func (s *Skopeo) InspectManifest(ctx context.Context, image string, opts ...Clie
 	defer cancel()
 
 	args := []string{"inspect", "--raw", fmt.Sprintf("docker://%s", image)}
-
-	pullSecretPath := options.pullSecretPath
-	if pullSecretPath != "" {
-		exists, err := s.readWriter.PathExists(pullSecretPath)
-		if err != nil {
-			return nil, fmt.Errorf("check pull secret path: %w", err)
-		}
-		if !exists {
-			return nil, fmt.Errorf("pull secret path %s does not exist", pullSecretPath)
-		}
-		args = append(args, "--authfile", pullSecretPath)
-	} else {
-		// Skopeo does not behave well when looking up default credentials as a non-root user without a proper systemd session
-		// running, so disable default credentials when none were explicitly provided. This
-		// means any credentials required have to be specified in the options.
-		args = append(args, "--no-creds")
+	credArgs, err := s.credentialArgs(options, "--no-creds")
+	if err != nil {
+		return nil, err
 	}
+	args = append(args, credArgs...)
 
 	stdout, stderr, exitCode := s.exec.ExecuteWithContext(ctx, skopeoCmd, args...)
 	if exitCode != 0 {
@@ -120,3 +108,55 @@ func (s *Skopeo) InspectManifest(ctx context.Context, image string, opts ...Clie
 
 	return &manifest, nil
 }
+
+// CopyToDir copies an image from a registry into the given directory in skopeo's "dir" layout:
+// the manifest is written to manifest.json and each blob to a file named after the encoded part
+// of its digest.
+func (s *Skopeo) CopyToDir(ctx context.Context, image string, dir string, opts ...ClientOption) error {
+	options := &clientOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+
+	timeout := s.timeout
+	if options.timeout > 0 {
+		timeout = options.timeout
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	args := []string{"copy"}
+	credArgs, err := s.credentialArgs(options, "--src-no-creds")
+	if err != nil {
+		return err
+	}
+	args = append(args, credArgs...)
+	args = append(args, fmt.Sprintf("docker://%s", image), fmt.Sprintf("dir:%s", dir))
+
+	_, stderr, exitCode := s.exec.ExecuteWithContext(ctx, skopeoCmd, args...)
+	if exitCode != 0 {
+		return fmt.Errorf("copy image: %w", errors.FromStderr(stderr, exitCode))
+	}
+	return nil
+}
+
+// credentialArgs returns the arguments selecting the registry credentials: the pull secret
+// if one was specified in the options, otherwise the given flag disabling default credentials.
+func (s *Skopeo) credentialArgs(options *clientOptions, noCredsFlag string) ([]string, error) {
+	pullSecretPath := options.pullSecretPath
+	if pullSecretPath == "" {
+		// Skopeo does not behave well when looking up default credentials as a non-root user without a proper systemd session
+		// running, so disable default credentials when none were explicitly provided. This
+		// means any credentials required have to be specified in the options.
+		return []string{noCredsFlag}, nil
+	}
+	exists, err := s.readWriter.PathExists(pullSecretPath)
+	if err != nil {
+		return nil, fmt.Errorf("check pull secret path: %w", err)
+	}
+	if !exists {
+		return nil, fmt.Errorf("pull secret path %s does not exist", pullSecretPath)
+	}
+	return []string{"--authfile", pullSecretPath}, nil
+}
@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"slices"
 	"sort"
 	"strings"
 	"time"
@@ -74,8 +75,12 @@ const (
 	DefaultTPMDevicePath = "/dev/tpm0"
 	// DefaultTPMKeyFile is the default filename for TPM key persistence
 	DefaultTPMKeyFile = "tpm-blob.yaml"
+	// DefaultTPMAttestationInterval is the default interval between two attestations of the boot measurements
+	DefaultTPMAttestationInterval = util.Duration(10 * time.Minute)
 	// TestRootDirEnvKey is the environment variable key used to set the file system root when testing.
 	TestRootDirEnvKey = "FLIGHTCTL_TEST_ROOT_DIR"
+	// DefaultFileCopyMaxSize is the default maximum size of a file copied to or from the device
+	DefaultFileCopyMaxSize = 512 * 1024 * 1024
 	// DefaultMetricsEnabled controls whether Prometheus metrics are enabled by default.
 	DefaultMetricsEnabled = false
 	// DefaultProfilingEnabled controls whether runtime profiling (pprof) is enabled by default.
@@ -161,6 +166,12 @@ type Config struct {
 	// ImagePruning holds all image/artifact pruning-related configuration
 	ImagePruning ImagePruning `json:"image-pruning,omitempty"`
 
+	// ImageVerification holds the image signature verification configuration
+	ImageVerification ImageVerification `json:"image-verification,omitempty"`
+
+	// FileCopy holds the limits for copying files to and from the device
+	FileCopy FileCopy `json:"file-copy,omitempty"`
+
 	// Warnings collects non-fatal issues encountered during config loading
 	// (e.g., skipped drop-ins) so they can be surfaced in device status.
 	Warnings []string `json:"-"`
@@ -177,6 +188,9 @@ type TPM struct {
 	AuthEnabled bool `json:"auth-enabled,omitempty"`
 	// StorageFilePath specifies the file path for TPM key storage.
 	StorageFilePath string `json:"storage-file-path,omitempty"`
+	// AttestationInterval is the interval between two attestations of the boot measurements,
+	// performed when the device's fleet has an attestation policy.
+	AttestationInterval util.Duration `json:"attestation-interval,omitempty"`
 }
 
 type ImagePruning struct {
@@ -185,6 +199,37 @@ type ImagePruning struct {
 	Enabled *bool `json:"enabled,omitempty"`
 }
 
+type ImageVerification struct {
+	// TrustedKeys are the paths to PEM encoded public keys trusted to sign images.
+	// If set, images pulled by podman (including OS images) must carry a valid
+	// cosign signature from one of these keys.
+	TrustedKeys []string `json:"trusted-keys,omitempty"`
+}
+
+type FileCopy struct {
+	// MaxSize is the maximum size in bytes of a file copied to or from the device.
+	MaxSize int64 `json:"max-size,omitempty"`
+	// AllowedPaths are the directories files may be copied from and to, including subdirectories.
+	AllowedPaths []string `json:"allowed-paths,omitempty"`
+	// DeniedPaths are directories below AllowedPaths that files may not be copied from or to.
+	// They take precedence over AllowedPaths.
+	DeniedPaths []string `json:"denied-paths,omitempty"`
+}
+
+// DefaultFileCopyAllowedPaths are the directories files may be copied from and to by default.
+var DefaultFileCopyAllowedPaths = []string{
+	"/var/log",
+	"/var/tmp",
+	"/tmp",
+	"/var/lib/systemd/coredump",
+}
+
+// DefaultFileCopyDeniedPaths keep the agent's own configuration and data out of reach by default.
+var DefaultFileCopyDeniedPaths = []string{
+	DefaultDataDir,
+	DefaultConfigDir,
+}
+
 // DefaultSystemInfo defines the list of system information keys that are included
 // in the default system info status report generated by the agent.
 var DefaultSystemInfo = append([]string{
@@ -218,15 +263,21 @@ func NewDefault() *Config {
 		MetricsEnabled:       DefaultMetricsEnabled,
 		ProfilingEnabled:     DefaultProfilingEnabled,
 		TPM: TPM{
-			Enabled:         false,
-			AuthEnabled:     false,
-			DevicePath:      DefaultTPMDevicePath,
-			StorageFilePath: filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			Enabled:             false,
+			AuthEnabled:         false,
+			DevicePath:          DefaultTPMDevicePath,
+			StorageFilePath:     filepath.Join(DefaultDataDir, DefaultTPMKeyFile),
+			AttestationInterval: DefaultTPMAttestationInterval,
 		},
 		AuditLog: *audit.NewDefaultAuditConfig(),
 		ImagePruning: ImagePruning{
 			Enabled: lo.ToPtr(false),
 		},
+		FileCopy: FileCopy{
+			MaxSize:      DefaultFileCopyMaxSize,
+			AllowedPaths: DefaultFileCopyAllowedPaths,
+			DeniedPaths:  DefaultFileCopyDeniedPaths,
+		},
 	}
 
 	if value := os.Getenv(TestRootDirEnvKey); value != "" {
@@ -380,6 +431,10 @@ func (cfg *Config) Validate() error {
 		return fmt.Errorf("cannot enable TPM password authentication when TPM device identity is disabled")
 	}
 
+	if err := cfg.FileCopy.Validate(); err != nil {
+		return fmt.Errorf("file-copy: %w", err)
+	}
+
 	// Validate audit log configuration
 	if err := cfg.AuditLog.Validate(cfg.readWriter); err != nil {
 		return fmt.Errorf("audit log configuration validation failed: %w", err)
@@ -415,6 +470,19 @@ func (cfg *Config) Validate() error {
 	return nil
 }
 
+// Validate checks that the size limit is positive and that all paths are absolute.
+func (f *FileCopy) Validate() error {
+	if f.MaxSize <= 0 {
+		return fmt.Errorf("max-size must be positive, got %d", f.MaxSize)
+	}
+	for _, p := range append(slices.Clone(f.AllowedPaths), f.DeniedPaths...) {
+		if !filepath.IsAbs(p) {
+			return fmt.Errorf("path %q must be absolute", p)
+		}
+	}
+	return nil
+}
+
 // ParseConfigFile reads the config file and unmarshals it into the Config struct
 func (cfg *Config) ParseConfigFile(cfgFile string) error {
 	contents, err := cfg.readWriter.ReadFile(cfgFile)
@@ -539,6 +607,7 @@ func mergeConfigs(base, override *Config) {
 	overrideIfNotEmpty(&base.TPM.AuthEnabled, override.TPM.AuthEnabled)
 	overrideIfNotEmpty(&base.TPM.DevicePath, override.TPM.DevicePath)
 	overrideIfNotEmpty(&base.TPM.StorageFilePath, override.TPM.StorageFilePath)
+	overrideIfNotEmpty(&base.TPM.AttestationInterval, override.TPM.AttestationInterval)
 
 	// audit log
 	overrideIfNotEmpty(&base.AuditLog.Enabled, override.AuditLog.Enabled)
@@ -554,6 +623,14 @@ func mergeConfigs(base, override *Config) {
 	// but a dropin with image-pruning.enabled: false will override to false.
 	overrideIfNotEmpty(&base.ImagePruning.Enabled, override.ImagePruning.Enabled)
 
+	// image verification
+	overrideSliceIfNotNil(&base.ImageVerification.TrustedKeys, override.ImageVerification.TrustedKeys)
+
+	// file copy
+	overrideIfNotEmpty(&base.FileCopy.MaxSize, override.FileCopy.MaxSize)
+	overrideSliceIfNotNil(&base.FileCopy.AllowedPaths, override.FileCopy.AllowedPaths)
+	overrideSliceIfNotNil(&base.FileCopy.DeniedPaths, override.FileCopy.DeniedPaths)
+
 	maps.Copy(base.DefaultLabels, override.DefaultLabels)
 	maps.Copy(base.LabelFromSystemInfo, override.LabelFromSystemInfo)
 }
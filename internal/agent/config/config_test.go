@@ -319,6 +319,45 @@ image-pruning:
 	require.True(*cfg.ImagePruning.Enabled, "pruning dropin should override config setting")
 }
 
+func TestLoadWithOverridesFileCopyFromConfD(t *testing.T) {
+	require := require.New(t)
+	tmpDir := t.TempDir()
+	configDir := filepath.Join(tmpDir, "etc", "flightctl")
+	dataDir := filepath.Join(tmpDir, "var", "lib", "flightctl")
+	require.NoError(os.MkdirAll(configDir, 0o755))
+	require.NoError(os.MkdirAll(dataDir, 0o755))
+
+	cfg := NewDefault()
+	cfg.ConfigDir = configDir
+	cfg.DataDir = dataDir
+	cfg.readWriter = fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+
+	configFile := filepath.Join(configDir, "config.yaml")
+	content := `enrollment-service:
+  service:
+    server: https://enrollment.endpoint
+    certificate-authority-data: abcd
+  authentication:
+    client-certificate-data: efgh
+    client-key-data: ijkl
+`
+	require.NoError(os.WriteFile(configFile, []byte(content), 0o600))
+
+	dropinDir := filepath.Join(configDir, "conf.d")
+	require.NoError(os.MkdirAll(dropinDir, 0o755))
+	dropin := "file-copy:\n  max-size: 1024\n  allowed-paths:\n  - /srv/data\n"
+	require.NoError(os.WriteFile(filepath.Join(dropinDir, "file-copy.yaml"), []byte(dropin), 0o600))
+
+	require.NoError(cfg.LoadWithOverrides(configFile))
+	require.Equal(int64(1024), cfg.FileCopy.MaxSize)
+	require.Equal([]string{"/srv/data"}, cfg.FileCopy.AllowedPaths)
+	// the denied paths were not overridden and keep their defaults
+	require.Equal(DefaultFileCopyDeniedPaths, cfg.FileCopy.DeniedPaths)
+
+	cfg.FileCopy.AllowedPaths = []string{"relative/path"}
+	require.ErrorContains(cfg.Validate(), "must be absolute")
+}
+
 func TestLoadWithOverrides_DropinErrorHandling(t *testing.T) {
 	tests := []struct {
 		name             string
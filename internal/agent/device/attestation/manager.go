@@ -0,0 +1,113 @@
+package attestation
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+// Manager periodically attests the boot measurements of the device. It answers the attestation
+// challenges of the management server with PCR quotes signed by the TPM's attestation key; the
+// server evaluates them against the attestation policy of the device's fleet.
+type Manager struct {
+	deviceName string
+	management client.Management
+	tpmClient  tpm.Client
+	interval   time.Duration
+	log        *log.PrefixLogger
+}
+
+// NewManager creates an attestation manager that attests every interval.
+func NewManager(deviceName string, management client.Management, tpmClient tpm.Client, interval time.Duration, log *log.PrefixLogger) *Manager {
+	return &Manager{
+		deviceName: deviceName,
+		management: management,
+		tpmClient:  tpmClient,
+		interval:   interval,
+		log:        log,
+	}
+}
+
+// Run attests the boot measurements once and then every interval until the context is done.
+func (m *Manager) Run(ctx context.Context) {
+	if m.interval <= 0 {
+		m.log.Info("TPM attestation is disabled")
+		return
+	}
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.Attest(ctx); err != nil {
+			m.log.Errorf("Failed to attest boot measurements: %v", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Attest answers an attestation challenge of the management server, if the device is subject to
+// one, with a quote of the requested PCRs.
+func (m *Manager) Attest(ctx context.Context) error {
+	challenge, statusCode, err := m.management.GetDeviceAttestationChallenge(ctx, m.deviceName)
+	if err != nil {
+		return fmt.Errorf("getting attestation challenge: %w", err)
+	}
+	if statusCode == http.StatusNoContent {
+		m.log.Debug("Device is not subject to attestation")
+		return nil
+	}
+	if statusCode == http.StatusConflict {
+		return errors.New("getting attestation challenge: the service does not know the attestation key of the device, re-enroll the device to attest it")
+	}
+	if challenge == nil {
+		return fmt.Errorf("getting attestation challenge: unexpected status code %d", statusCode)
+	}
+
+	pcrs := lo.Map(challenge.Pcrs, func(pcr int32, _ int) int { return int(pcr) })
+	quote, err := m.tpmClient.Quote(pcrs, challenge.Nonce)
+	if err != nil {
+		return fmt.Errorf("quoting PCRs: %w", err)
+	}
+
+	values := make([]v1beta1.PCRValue, 0, len(quote.PCRValues))
+	for pcr, value := range quote.PCRValues {
+		values = append(values, v1beta1.PCRValue{Index: int32(pcr), Value: hex.EncodeToString(value)}) //nolint:gosec // G115: PCR indexes are at most 23
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i].Index < values[j].Index })
+
+	integrity, statusCode, err := m.management.CreateDeviceAttestation(ctx, m.deviceName, v1beta1.AttestationQuote{
+		Nonce:     challenge.Nonce,
+		Quote:     quote.Quote,
+		Signature: quote.Signature,
+		Pcrs:      values,
+	})
+	if err != nil {
+		return fmt.Errorf("submitting attestation: %w", err)
+	}
+	if integrity == nil {
+		return fmt.Errorf("submitting attestation: unexpected status code %d", statusCode)
+	}
+
+	if integrity.MeasuredBoot != nil && integrity.MeasuredBoot.Status == v1beta1.DeviceIntegrityCheckStatusFailed {
+		m.log.Warnf("Boot measurements do not match the fleet's attestation policy: %s", lo.FromPtr(integrity.MeasuredBoot.Info))
+		return nil
+	}
+	m.log.Debug("Boot measurements verified")
+	return nil
+}
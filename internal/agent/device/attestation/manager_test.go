@@ -0,0 +1,101 @@
+package attestation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestManager_Attest(t *testing.T) {
+	nonce := []byte("nonce")
+
+	testCases := []struct {
+		name      string
+		setupMock func(management *client.MockManagement, tpmClient *tpm.MockClient)
+		wantErr   bool
+	}{
+		{
+			name: "device not subject to attestation",
+			setupMock: func(management *client.MockManagement, tpmClient *tpm.MockClient) {
+				management.EXPECT().GetDeviceAttestationChallenge(gomock.Any(), "device").Return(nil, http.StatusNoContent, nil)
+			},
+		},
+		{
+			name: "quotes the challenged PCRs",
+			setupMock: func(management *client.MockManagement, tpmClient *tpm.MockClient) {
+				management.EXPECT().GetDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1beta1.AttestationChallenge{Nonce: nonce, Pcrs: []int32{7, 0}}, http.StatusOK, nil)
+				tpmClient.EXPECT().Quote([]int{7, 0}, nonce).Return(&tpm.PCRQuote{
+					Quote:     []byte("quote"),
+					Signature: []byte("signature"),
+					PCRValues: map[int][]byte{7: {0x07}, 0: {0x00}},
+				}, nil)
+				management.EXPECT().CreateDeviceAttestation(gomock.Any(), "device", v1beta1.AttestationQuote{
+					Nonce:     nonce,
+					Quote:     []byte("quote"),
+					Signature: []byte("signature"),
+					Pcrs:      []v1beta1.PCRValue{{Index: 0, Value: "00"}, {Index: 7, Value: "07"}},
+				}).Return(&v1beta1.DeviceIntegrityStatus{
+					Status:       v1beta1.DeviceIntegrityStatusFailed,
+					MeasuredBoot: &v1beta1.DeviceIntegrityCheckStatus{Status: v1beta1.DeviceIntegrityCheckStatusFailed, Info: lo.ToPtr("PCR 7 has unexpected value 07")},
+				}, http.StatusOK, nil)
+			},
+		},
+		{
+			name: "attestation key unknown to the service",
+			setupMock: func(management *client.MockManagement, tpmClient *tpm.MockClient) {
+				management.EXPECT().GetDeviceAttestationChallenge(gomock.Any(), "device").Return(nil, http.StatusConflict, nil)
+			},
+			wantErr: true,
+		},
+		{
+			name: "quote failure",
+			setupMock: func(management *client.MockManagement, tpmClient *tpm.MockClient) {
+				management.EXPECT().GetDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1beta1.AttestationChallenge{Nonce: nonce, Pcrs: []int32{0}}, http.StatusOK, nil)
+				tpmClient.EXPECT().Quote([]int{0}, nonce).Return(nil, errors.New("tpm failure"))
+			},
+			wantErr: true,
+		},
+		{
+			name: "rejected attestation",
+			setupMock: func(management *client.MockManagement, tpmClient *tpm.MockClient) {
+				management.EXPECT().GetDeviceAttestationChallenge(gomock.Any(), "device").
+					Return(&v1beta1.AttestationChallenge{Nonce: nonce, Pcrs: []int32{0}}, http.StatusOK, nil)
+				tpmClient.EXPECT().Quote([]int{0}, nonce).Return(&tpm.PCRQuote{PCRValues: map[int][]byte{0: {0x00}}}, nil)
+				management.EXPECT().CreateDeviceAttestation(gomock.Any(), "device", gomock.Any()).
+					Return(nil, http.StatusBadRequest, errors.New("create device attestation failed"))
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			management := client.NewMockManagement(ctrl)
+			tpmClient := tpm.NewMockClient(ctrl)
+			tc.setupMock(management, tpmClient)
+
+			m := NewManager("device", management, tpmClient, time.Minute, log.NewPrefixLogger("test"))
+			err := m.Attest(context.Background())
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
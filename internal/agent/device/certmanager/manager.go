@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/certmanager/provider"
@@ -16,6 +17,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/device/systeminfo"
 	"github.com/flightctl/flightctl/internal/agent/identity"
 	pkgcertmanager "github.com/flightctl/flightctl/pkg/certmanager"
+	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
 )
 
@@ -25,13 +27,16 @@ const (
 	certsBundleName       = "certs-config-yaml"
 	certsBundleConfigFile = "certs.yaml"
 
+	workloadBundleName = "device-spec-certificates"
+
 	defaultSyncInterval         = time.Hour
 	renewBeforeExpiryPercentage = 75
 )
 
 type AgentCertManager struct {
-	cm  *pkgcertmanager.CertManager
-	log *log.PrefixLogger
+	cm           *pkgcertmanager.CertManager
+	specProvider *provider.SpecConfigProvider
+	log          *log.PrefixLogger
 }
 
 // NewAgentCertManager wires the pkg certmanager with agent-specific providers/factories.
@@ -42,6 +47,7 @@ func NewAgentCertManager(
 	deviceName string,
 	managementClient client.Management,
 	readWriter fileio.ReadWriter,
+	exec executer.Executer,
 	idFactory identity.ExportableFactory,
 	identityProvider identity.Provider,
 	statusManager status.Manager,
@@ -126,20 +132,48 @@ func NewAgentCertManager(
 		return nil, fmt.Errorf("new %q bundle: %w", certsBundleName, err)
 	}
 
+	// Workload certificates declared in the device spec, stored for and reloaded by applications.
+	specProvider := provider.NewSpecConfigProvider(renewBeforeExpiryPercentage)
+	workloadBundle, err := pkgcertmanager.NewBundle(
+		workloadBundleName,
+		pkgcertmanager.WithConfigProvider(specProvider),
+		pkgcertmanager.WithProvisionerFactory(
+			provider.NewCSRProvisionerFactory(deviceName, managementClient, idFactory),
+		),
+		pkgcertmanager.WithStorageFactory(
+			provider.NewReloadingFileSystemStorageFactory(readWriter, exec),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("new %q bundle: %w", workloadBundleName, err)
+	}
+
 	cm, err := pkgcertmanager.NewManager(ctx, log,
 		pkgcertmanager.WithBundleProvider(managementBundle),
 		pkgcertmanager.WithBundleProvider(certsBundle),
+		pkgcertmanager.WithBundleProvider(workloadBundle),
 	)
 	if err != nil {
 		return nil, fmt.Errorf("new cert manager: %w", err)
 	}
 
 	return &AgentCertManager{
-		cm:  cm,
-		log: log,
+		cm:           cm,
+		specProvider: specProvider,
+		log:          log,
 	}, nil
 }
 
+// SyncWorkloadCertificates reconciles the workload certificates with those declared in the
+// desired device spec. New and changed certificates are requested asynchronously and
+// renewed by the periodic sync.
+func (a *AgentCertManager) SyncWorkloadCertificates(ctx context.Context, desired *v1beta1.DeviceSpec) error {
+	if err := a.specProvider.Update(desired); err != nil {
+		return err
+	}
+	return a.cm.SyncBundle(ctx, workloadBundleName)
+}
+
 // Sync delegates to the pkg cert manager.
 // The agent decides when to call Sync (e.g., on a timer, on config change, at startup).
 func (a *AgentCertManager) Sync(ctx context.Context, _ *config.Config) error {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"text/template"
 
@@ -13,6 +14,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/identity"
 	agentapi "github.com/flightctl/flightctl/internal/api/client/agent"
 	"github.com/flightctl/flightctl/pkg/certmanager"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/uuid"
 )
 
@@ -34,6 +36,10 @@ type CSRProvisionerConfig struct {
 	Signer string `json:"signer"`
 	// CommonName is the common name for the certificate
 	CommonName string `json:"common-name,omitempty"`
+	// DNSNames are the DNS subject alternative names requested for the certificate
+	DNSNames []string `json:"dns-names,omitempty"`
+	// IPAddresses are the IP subject alternative names requested for the certificate
+	IPAddresses []string `json:"ip-addresses,omitempty"`
 	// Usages specifies a set of key usages requested in the issued certificate (e.g., "clientAuth", "serverAuth")
 	Usages []string `json:"usages,omitempty"`
 	// ExpirationSeconds requests a specific certificate validity duration (in seconds); signer may ignore
@@ -89,8 +95,24 @@ func (p *CSRProvisioner) Provision(ctx context.Context, _ certmanager.ProvisionR
 	// Generate unique CSR object name for Kubernetes resource
 	p.csrName = fmt.Sprintf("%s-%s", p.cfg.CommonName, uuid.NewString()[:8])
 
+	var csrOpts []fccrypto.CSROption
+	if len(p.cfg.DNSNames) > 0 {
+		csrOpts = append(csrOpts, fccrypto.WithDNSNames(p.cfg.DNSNames...))
+	}
+	if len(p.cfg.IPAddresses) > 0 {
+		ips := make([]net.IP, 0, len(p.cfg.IPAddresses))
+		for _, s := range p.cfg.IPAddresses {
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP address %q", s)
+			}
+			ips = append(ips, ip)
+		}
+		csrOpts = append(csrOpts, fccrypto.WithIPAddresses(ips...))
+	}
+
 	// Generate private key and CSR using the configured CommonName (without suffix)
-	id, err := p.identityProvider.NewExportable(p.cfg.CommonName)
+	id, err := p.identityProvider.NewExportable(p.cfg.CommonName, csrOpts...)
 	if err != nil {
 		return nil, fmt.Errorf("new identity: %w", err)
 	}
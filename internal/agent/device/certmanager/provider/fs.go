@@ -5,15 +5,22 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
 	"github.com/flightctl/flightctl/pkg/certmanager"
 	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/executer"
 )
 
 const (
 	StorageTypeFilesystem certmanager.StorageType = "filesystem"
+
+	reloadCommandTimeout = 2 * time.Minute
 )
 
 // FileSystemStorageConfig defines configuration for filesystem-based certificate storage.
@@ -24,6 +31,13 @@ type FileSystemStorageConfig struct {
 	CertPath string `json:"cert-path"`
 	// KeyPath is the path where the private key will be stored
 	KeyPath string `json:"key-path"`
+	// User is the owner of the certificate and key files, as a name or numeric ID (defaults to the agent's user)
+	User string `json:"user,omitempty"`
+	// Group is the group of the certificate and key files, as a name or numeric ID (defaults to the agent's group)
+	Group string `json:"group,omitempty"`
+	// ReloadCommand is the command and its arguments run after a new certificate has been stored,
+	// e.g. to make an application reload it. It is executed directly, not through a shell.
+	ReloadCommand []string `json:"reload-command,omitempty"`
 }
 
 // FileSystemStorage handles certificate storage on the local filesystem.
@@ -38,6 +52,12 @@ type FileSystemStorage struct {
 	deviceReadWriter fileio.ReadWriter
 	// Logger for storage operations
 	log certmanager.Logger
+	// Ownership applied to the stored files, if set
+	fileOpts []fileio.FileOption
+	// Command run after storing a new certificate, if set
+	reloadCommand []string
+	// Executer used to run the reload command
+	exec executer.Executer
 }
 
 // NewFileSystemStorage creates a new filesystem storage provider with the specified configuration.
@@ -51,6 +71,26 @@ func NewFileSystemStorage(certPath, keyPath string, rw fileio.ReadWriter, log ce
 	}
 }
 
+// newFileSystemStorageFromConfig creates a filesystem storage provider that also applies the
+// ownership and reload command of the configuration.
+func newFileSystemStorageFromConfig(cfg FileSystemStorageConfig, rw fileio.ReadWriter, exec executer.Executer, log certmanager.Logger) (*FileSystemStorage, error) {
+	storage := NewFileSystemStorage(cfg.CertPath, cfg.KeyPath, rw, log)
+	if cfg.User != "" || cfg.Group != "" {
+		user := v1beta1.Username(cfg.User)
+		if user == "" {
+			user = v1beta1.RootUsername
+		}
+		uid, gid, err := fileio.LookupFileOwnership(user, cfg.Group)
+		if err != nil {
+			return nil, err
+		}
+		storage.fileOpts = []fileio.FileOption{fileio.WithUid(uid), fileio.WithGid(gid)}
+	}
+	storage.reloadCommand = cfg.ReloadCommand
+	storage.exec = exec
+	return storage, nil
+}
+
 // LoadCertificate loads a certificate from the filesystem.
 // It reads the certificate file and parses it as a PEM-encoded X.509 certificate.
 func (fs *FileSystemStorage) LoadCertificate(_ context.Context) (*x509.Certificate, error) {
@@ -75,20 +115,26 @@ func (fs *FileSystemStorage) Store(ctx context.Context, req certmanager.StoreReq
 
 	certPEM := req.Result.Cert
 
-	if err := fs.deviceReadWriter.MkdirAll(filepath.Dir(fs.CertPath), 0o700); err != nil {
+	// Directories holding files owned by another user must be traversable by that user.
+	dirPerm := os.FileMode(0o700)
+	if len(fs.fileOpts) > 0 {
+		dirPerm = fileio.DefaultDirectoryPermissions
+	}
+
+	if err := fs.deviceReadWriter.MkdirAll(filepath.Dir(fs.CertPath), dirPerm); err != nil {
 		return fmt.Errorf("mkdir for cert path: %w", err)
 	}
 	// write certificate (0644)
-	if err := fs.deviceReadWriter.WriteFile(fs.CertPath, certPEM, fileio.DefaultFilePermissions); err != nil {
+	if err := fs.deviceReadWriter.WriteFile(fs.CertPath, certPEM, fileio.DefaultFilePermissions, fs.fileOpts...); err != nil {
 		fs.log.Errorf("Failed to write cert to %s: %v", fs.CertPath, err)
 		return fmt.Errorf("write cert: %w", err)
 	}
 
 	if req.Result.Key != nil {
-		if err := fs.deviceReadWriter.MkdirAll(filepath.Dir(fs.KeyPath), 0o700); err != nil {
+		if err := fs.deviceReadWriter.MkdirAll(filepath.Dir(fs.KeyPath), dirPerm); err != nil {
 			return fmt.Errorf("mkdir for key path: %w", err)
 		}
-		if err := fs.deviceReadWriter.WriteFile(fs.KeyPath, req.Result.Key, 0o600); err != nil {
+		if err := fs.deviceReadWriter.WriteFile(fs.KeyPath, req.Result.Key, 0o600, fs.fileOpts...); err != nil {
 			fs.log.Errorf("Failed to write key to %s: %v", fs.KeyPath, err)
 			return fmt.Errorf("write key: %w", err)
 		}
@@ -100,9 +146,29 @@ func (fs *FileSystemStorage) Store(ctx context.Context, req certmanager.StoreReq
 	// Best-effort cleanup. Never fail Store if cleanup fails.
 	fs.deleteOldBestEffort(req)
 
+	// Best-effort reload. The certificate is stored, so a failing reload does not fail Store.
+	fs.reload(ctx)
+
 	return nil
 }
 
+func (fs *FileSystemStorage) reload(ctx context.Context) {
+	if len(fs.reloadCommand) == 0 || fs.exec == nil {
+		return
+	}
+	command := strings.Join(fs.reloadCommand, " ")
+
+	ctx, cancel := context.WithTimeout(ctx, reloadCommandTimeout)
+	defer cancel()
+
+	_, stderr, exitCode := fs.exec.ExecuteWithContext(ctx, fs.reloadCommand[0], fs.reloadCommand[1:]...)
+	if exitCode != 0 {
+		fs.log.Errorf("filesystem storage: reload command %q for %s returned with exit code %d: %s", command, fs.CertPath, exitCode, stderr)
+		return
+	}
+	fs.log.Debugf("filesystem storage: ran reload command %q for %s", command, fs.CertPath)
+}
+
 func (fs *FileSystemStorage) deleteOldBestEffort(req certmanager.StoreRequest) {
 	if req.LastApplied.IsEmpty() {
 		return
@@ -134,6 +200,8 @@ func (fs *FileSystemStorage) deleteOldBestEffort(req certmanager.StoreRequest) {
 type FileSystemStorageFactory struct {
 	// File I/O interface for reading and writing files
 	rw fileio.ReadWriter
+	// Executer used to run reload commands; reload commands are rejected if nil
+	exec executer.Executer
 }
 
 // NewFileSystemStorageFactory creates a new filesystem storage factory with the specified file I/O interface.
@@ -143,6 +211,15 @@ func NewFileSystemStorageFactory(rw fileio.ReadWriter) *FileSystemStorageFactory
 	}
 }
 
+// NewReloadingFileSystemStorageFactory creates a filesystem storage factory that also supports
+// running a reload command after a new certificate has been stored.
+func NewReloadingFileSystemStorageFactory(rw fileio.ReadWriter, exec executer.Executer) *FileSystemStorageFactory {
+	return &FileSystemStorageFactory{
+		rw:   rw,
+		exec: exec,
+	}
+}
+
 // Type returns the storage type string used as map key in the certificate manager.
 func (f *FileSystemStorageFactory) Type() string {
 	return string(StorageTypeFilesystem)
@@ -158,7 +235,7 @@ func (f *FileSystemStorageFactory) New(log certmanager.Logger, cc certmanager.Ce
 		return nil, fmt.Errorf("failed to decode filesystem Storage config for certificate %q: %w", cc.Name, err)
 	}
 
-	return NewFileSystemStorage(fsConfig.CertPath, fsConfig.KeyPath, f.rw, log), nil
+	return newFileSystemStorageFromConfig(fsConfig, f.rw, f.exec, log)
 }
 
 // Validate checks whether the provided configuration is valid for filesystem storage.
@@ -181,6 +258,9 @@ func (f *FileSystemStorageFactory) Validate(log certmanager.Logger, cc certmanag
 	if fsConfig.KeyPath == "" {
 		return fmt.Errorf("key-path is required for filesystem storage, certificate %s", cc.Name)
 	}
+	if len(fsConfig.ReloadCommand) > 0 && f.exec == nil {
+		return fmt.Errorf("reload-command is not supported for filesystem storage of certificate %s", cc.Name)
+	}
 
 	return nil
 }
@@ -0,0 +1,173 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestFileSystemStorageStore(t *testing.T) {
+	testCases := []struct {
+		name        string
+		cfg         FileSystemStorageConfig
+		setupMock   func(exec *executer.MockExecuter)
+		needsRoot   bool
+		wantUID     int
+		wantGID     int
+		wantDirPerm os.FileMode
+	}{
+		{
+			name:        "default ownership",
+			cfg:         FileSystemStorageConfig{CertPath: "/etc/app/certs/tls.crt", KeyPath: "/etc/app/keys/tls.key"},
+			wantUID:     os.Getuid(),
+			wantGID:     os.Getgid(),
+			wantDirPerm: 0o700,
+		},
+		{
+			name:        "owned by the application",
+			cfg:         FileSystemStorageConfig{CertPath: "/etc/app/certs/tls.crt", KeyPath: "/etc/app/keys/tls.key", User: "1234", Group: "5678"},
+			needsRoot:   true,
+			wantUID:     1234,
+			wantGID:     5678,
+			wantDirPerm: fileio.DefaultDirectoryPermissions,
+		},
+		{
+			name: "reload command with quoted argument",
+			cfg: FileSystemStorageConfig{
+				CertPath:      "/etc/app/certs/tls.crt",
+				KeyPath:       "/etc/app/keys/tls.key",
+				ReloadCommand: []string{"/usr/bin/app-ctl", "reload", "--message", "certificate renewed"},
+			},
+			setupMock: func(exec *executer.MockExecuter) {
+				exec.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/app-ctl", "reload", "--message", "certificate renewed").Return("", "", 0)
+			},
+			wantUID:     os.Getuid(),
+			wantGID:     os.Getgid(),
+			wantDirPerm: 0o700,
+		},
+		{
+			name: "failing reload command does not fail the store",
+			cfg: FileSystemStorageConfig{
+				CertPath:      "/etc/app/certs/tls.crt",
+				KeyPath:       "/etc/app/keys/tls.key",
+				ReloadCommand: []string{"systemctl", "reload", "app"},
+			},
+			setupMock: func(exec *executer.MockExecuter) {
+				exec.EXPECT().ExecuteWithContext(gomock.Any(), "systemctl", "reload", "app").Return("", "failed", 1)
+			},
+			wantUID:     os.Getuid(),
+			wantGID:     os.Getgid(),
+			wantDirPerm: 0o700,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.needsRoot && os.Geteuid() != 0 {
+				t.Skip("changing file ownership requires root")
+			}
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			exec := executer.NewMockExecuter(ctrl)
+			if tc.setupMock != nil {
+				tc.setupMock(exec)
+			}
+
+			tmpDir := t.TempDir()
+			rw := fileio.NewReadWriter(
+				fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+				fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+			)
+			storageCfg, err := json.Marshal(tc.cfg)
+			require.NoError(t, err)
+
+			storage, err := NewReloadingFileSystemStorageFactory(rw, exec).New(log.NewPrefixLogger("test"), certmanager.CertificateConfig{
+				Name:    "app",
+				Storage: certmanager.StorageConfig{Type: StorageTypeFilesystem, Config: storageCfg},
+			})
+			require.NoError(t, err)
+
+			err = storage.Store(context.Background(), certmanager.StoreRequest{
+				Result: &certmanager.ProvisionResult{Ready: true, Cert: []byte("cert"), Key: []byte("key")},
+			})
+			require.NoError(t, err)
+
+			for _, p := range []string{tc.cfg.CertPath, tc.cfg.KeyPath} {
+				info, err := os.Stat(filepath.Join(tmpDir, p))
+				require.NoError(t, err)
+				stat := info.Sys().(*syscall.Stat_t)
+				require.Equal(t, tc.wantUID, int(stat.Uid), p)
+				require.Equal(t, tc.wantGID, int(stat.Gid), p)
+
+				dirInfo, err := os.Stat(filepath.Dir(filepath.Join(tmpDir, p)))
+				require.NoError(t, err)
+				require.Equal(t, tc.wantDirPerm, dirInfo.Mode().Perm(), p)
+			}
+		})
+	}
+}
+
+func TestFileSystemStorageFactoryValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cfg       FileSystemStorageConfig
+		reloading bool
+		wantErr   bool
+	}{
+		{
+			name: "valid",
+			cfg:  FileSystemStorageConfig{CertPath: "/etc/app/tls.crt", KeyPath: "/etc/app/tls.key"},
+		},
+		{
+			name:    "missing key path",
+			cfg:     FileSystemStorageConfig{CertPath: "/etc/app/tls.crt"},
+			wantErr: true,
+		},
+		{
+			name:      "reload command",
+			cfg:       FileSystemStorageConfig{CertPath: "/etc/app/tls.crt", KeyPath: "/etc/app/tls.key", ReloadCommand: []string{"systemctl", "reload", "app"}},
+			reloading: true,
+		},
+		{
+			name:    "reload command without executer",
+			cfg:     FileSystemStorageConfig{CertPath: "/etc/app/tls.crt", KeyPath: "/etc/app/tls.key", ReloadCommand: []string{"systemctl", "reload", "app"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			rw := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+			factory := NewFileSystemStorageFactory(rw)
+			if tc.reloading {
+				factory = NewReloadingFileSystemStorageFactory(rw, executer.NewMockExecuter(ctrl))
+			}
+			storageCfg, err := json.Marshal(tc.cfg)
+			require.NoError(t, err)
+
+			err = factory.Validate(log.NewPrefixLogger("test"), certmanager.CertificateConfig{
+				Name:    "app",
+				Storage: certmanager.StorageConfig{Type: StorageTypeFilesystem, Config: storageCfg},
+			})
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
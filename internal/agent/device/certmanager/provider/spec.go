@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/pkg/certmanager"
+	"github.com/samber/lo"
+)
+
+const (
+	specConfigProviderName = "device-spec"
+
+	// defaultWorkloadSignerName is the signer requested when the service does not set the
+	// signer name of a workload certificate in the rendered device spec.
+	defaultWorkloadSignerName = "flightctl.io/device-workload"
+)
+
+// SpecConfigProvider supplies the workload certificates declared in the device spec.
+// The agent updates it with each desired spec it reconciles; certificates are requested
+// through CSRs with the workload signer and stored as files owned by the application.
+type SpecConfigProvider struct {
+	mu sync.RWMutex
+	// Certificate configurations derived from the last applied spec
+	configs []certmanager.CertificateConfig
+	// Percentage of the certificate lifetime remaining at which certificates are renewed
+	renewBeforePercentage int32
+}
+
+// NewSpecConfigProvider creates a configuration provider for workload certificates that
+// renews certificates when the given percentage of their lifetime remains.
+func NewSpecConfigProvider(renewBeforePercentage int32) *SpecConfigProvider {
+	return &SpecConfigProvider{renewBeforePercentage: renewBeforePercentage}
+}
+
+// Name returns the unique identifier for this provider
+func (p *SpecConfigProvider) Name() string { return specConfigProviderName }
+
+// GetCertificateConfigs returns the certificate configurations of the last applied spec.
+func (p *SpecConfigProvider) GetCertificateConfigs() ([]certmanager.CertificateConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.configs, nil
+}
+
+// Update replaces the certificate configurations with the workload certificates of the spec.
+func (p *SpecConfigProvider) Update(spec *v1beta1.DeviceSpec) error {
+	var certs []v1beta1.WorkloadCertificateSpec
+	if spec != nil {
+		certs = lo.FromPtr(spec.Certificates)
+	}
+
+	configs := make([]certmanager.CertificateConfig, 0, len(certs))
+	for _, cert := range certs {
+		cfg, err := p.certificateConfig(cert)
+		if err != nil {
+			return fmt.Errorf("certificate %q: %w", cert.Name, err)
+		}
+		configs = append(configs, cfg)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.configs = configs
+	return nil
+}
+
+func (p *SpecConfigProvider) certificateConfig(cert v1beta1.WorkloadCertificateSpec) (certmanager.CertificateConfig, error) {
+	provisionerCfg, err := json.Marshal(CSRProvisionerConfig{
+		Signer:            lo.CoalesceOrEmpty(lo.FromPtr(cert.SignerName), defaultWorkloadSignerName),
+		CommonName:        cert.CommonName,
+		DNSNames:          lo.FromPtr(cert.DnsNames),
+		IPAddresses:       lo.FromPtr(cert.IpAddresses),
+		Usages:            []string{"serverAuth"},
+		ExpirationSeconds: cert.ExpirationSeconds,
+	})
+	if err != nil {
+		return certmanager.CertificateConfig{}, fmt.Errorf("encoding provisioner config: %w", err)
+	}
+
+	storageCfg, err := json.Marshal(FileSystemStorageConfig{
+		CertPath:      cert.CertPath,
+		KeyPath:       cert.KeyPath,
+		User:          cert.User.String(),
+		Group:         cert.Group,
+		ReloadCommand: lo.FromPtr(cert.ReloadCommand),
+	})
+	if err != nil {
+		return certmanager.CertificateConfig{}, fmt.Errorf("encoding storage config: %w", err)
+	}
+
+	return certmanager.CertificateConfig{
+		Name: cert.Name,
+		Provisioner: certmanager.ProvisionerConfig{
+			Type:   ProvisionerTypeCSR,
+			Config: provisionerCfg,
+		},
+		Storage: certmanager.StorageConfig{
+			Type:   StorageTypeFilesystem,
+			Config: storageCfg,
+		},
+		RenewBeforePercentage: lo.ToPtr(p.renewBeforePercentage),
+	}, nil
+}
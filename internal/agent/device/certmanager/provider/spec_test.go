@@ -0,0 +1,99 @@
+package provider
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpecConfigProviderUpdate(t *testing.T) {
+	cert := v1beta1.WorkloadCertificateSpec{
+		Name:              "mqtt",
+		CommonName:        "mqtt-client",
+		DnsNames:          &[]string{"mqtt.example.com"},
+		IpAddresses:       &[]string{"10.0.0.1"},
+		ExpirationSeconds: lo.ToPtr(int32(3600)),
+		CertPath:          "/etc/mqtt/tls.crt",
+		KeyPath:           "/etc/mqtt/tls.key",
+		User:              "mqtt",
+		Group:             "mqtt",
+		ReloadCommand:     &[]string{"systemctl", "reload", "mosquitto"},
+	}
+
+	testCases := []struct {
+		name        string
+		spec        *v1beta1.DeviceSpec
+		wantSigner  string
+		wantConfigs int
+	}{
+		{
+			name: "nil spec",
+		},
+		{
+			name: "no certificates",
+			spec: &v1beta1.DeviceSpec{},
+		},
+		{
+			name:        "default signer",
+			spec:        &v1beta1.DeviceSpec{Certificates: &[]v1beta1.WorkloadCertificateSpec{cert}},
+			wantSigner:  defaultWorkloadSignerName,
+			wantConfigs: 1,
+		},
+		{
+			name: "signer set by the service",
+			spec: &v1beta1.DeviceSpec{Certificates: &[]v1beta1.WorkloadCertificateSpec{func() v1beta1.WorkloadCertificateSpec {
+				c := cert
+				c.SignerName = lo.ToPtr("example.com/workload")
+				return c
+			}()}},
+			wantSigner:  "example.com/workload",
+			wantConfigs: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := NewSpecConfigProvider(75)
+			// Configurations of a previous spec must be replaced.
+			require.NoError(t, p.Update(&v1beta1.DeviceSpec{Certificates: &[]v1beta1.WorkloadCertificateSpec{cert, {Name: "old"}}}))
+
+			require.NoError(t, p.Update(tc.spec))
+			configs, err := p.GetCertificateConfigs()
+			require.NoError(t, err)
+			require.Len(t, configs, tc.wantConfigs)
+			if tc.wantConfigs == 0 {
+				return
+			}
+
+			cfg := configs[0]
+			require.Equal(t, "mqtt", cfg.Name)
+			require.Equal(t, ProvisionerTypeCSR, cfg.Provisioner.Type)
+			require.Equal(t, StorageTypeFilesystem, cfg.Storage.Type)
+			require.Equal(t, int32(75), lo.FromPtr(cfg.RenewBeforePercentage))
+
+			var provisionerCfg CSRProvisionerConfig
+			require.NoError(t, json.Unmarshal(cfg.Provisioner.Config, &provisionerCfg))
+			require.Equal(t, CSRProvisionerConfig{
+				Signer:            tc.wantSigner,
+				CommonName:        "mqtt-client",
+				DNSNames:          []string{"mqtt.example.com"},
+				IPAddresses:       []string{"10.0.0.1"},
+				Usages:            []string{"serverAuth"},
+				ExpirationSeconds: lo.ToPtr(int32(3600)),
+			}, provisionerCfg)
+
+			var storageCfg FileSystemStorageConfig
+			require.NoError(t, json.Unmarshal(cfg.Storage.Config, &storageCfg))
+			require.Equal(t, FileSystemStorageConfig{
+				CertPath:      "/etc/mqtt/tls.crt",
+				KeyPath:       "/etc/mqtt/tls.key",
+				User:          "mqtt",
+				Group:         "mqtt",
+				ReloadCommand: []string{"systemctl", "reload", "mosquitto"},
+			}, storageCfg)
+		})
+	}
+}
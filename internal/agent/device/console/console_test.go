@@ -14,6 +14,7 @@ import (
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -80,6 +81,7 @@ func setupVars(t *testing.T) *vars {
 			lo.Must(user.Current()).Username,
 			executor,
 			mockWatcher,
+			config.FileCopy{},
 			logger),
 		recvChan: make(chan lo.Tuple2[*grpc_v1.StreamResponse, error]),
 	}
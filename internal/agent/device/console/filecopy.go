@@ -0,0 +1,240 @@
+package console
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	fileCopyChunkSize       = 32 * 1024
+	fileCopyTempFilePattern = ".flightctl-cp-*"
+	defaultFileCopyMode     = 0o644
+)
+
+// resolveFileCopyPath returns the path a file copy request refers to after resolving symlinks and
+// checks it against the allowed and denied paths. For uploads only the parent directory has to
+// exist.
+func resolveFileCopyPath(cfg *config.FileCopy, fileCopy *v1beta1.DeviceFileCopy) (string, error) {
+	if !filepath.IsAbs(fileCopy.Path) {
+		return "", fmt.Errorf("path %s is not absolute", fileCopy.Path)
+	}
+	var (
+		resolved string
+		err      error
+	)
+	requested := filepath.Clean(fileCopy.Path)
+	if fileCopy.Direction == v1beta1.DeviceFileTransferUpload {
+		var dir string
+		dir, err = filepath.EvalSymlinks(filepath.Dir(requested))
+		resolved = filepath.Join(dir, filepath.Base(requested))
+	} else {
+		resolved, err = filepath.EvalSymlinks(requested)
+	}
+	if err != nil {
+		return "", fmt.Errorf("resolving path %s: %w", fileCopy.Path, err)
+	}
+	for _, denied := range cfg.DeniedPaths {
+		if isPathWithin(resolved, resolveConfiguredPath(denied)) {
+			return "", fmt.Errorf("copying %s is not allowed", fileCopy.Path)
+		}
+	}
+	for _, allowed := range cfg.AllowedPaths {
+		if isPathWithin(resolved, resolveConfiguredPath(allowed)) {
+			return resolved, nil
+		}
+	}
+	return "", fmt.Errorf("copying %s is not allowed", fileCopy.Path)
+}
+
+// resolveConfiguredPath resolves symlinks in a configured path so that it can be compared with a
+// resolved request path. Paths that do not exist are used as configured.
+func resolveConfiguredPath(p string) string {
+	if resolved, err := filepath.EvalSymlinks(p); err == nil {
+		return resolved
+	}
+	return filepath.Clean(p)
+}
+
+// isPathWithin returns true if p is dir or below it.
+func isPathWithin(p, dir string) bool {
+	return p == dir || strings.HasPrefix(p, strings.TrimSuffix(dir, "/")+"/")
+}
+
+// openFileCopySource opens the file to download and checks that it is a regular file within the
+// size limit.
+func openFileCopySource(cfg *config.FileCopy, path string) (*os.File, os.FileInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("opening %s: %w", path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return nil, nil, fmt.Errorf("stat %s: %w", path, err)
+	}
+	if !info.Mode().IsRegular() {
+		f.Close()
+		return nil, nil, fmt.Errorf("%s is not a regular file", path)
+	}
+	if info.Size() > cfg.MaxSize {
+		f.Close()
+		return nil, nil, fmt.Errorf("%s is larger than the maximum of %d bytes", path, cfg.MaxSize)
+	}
+	return f, info, nil
+}
+
+func sendFileCopyStatus(streamClient grpc_v1.RouterService_StreamClient, status v1beta1.DeviceFileCopyStatus) error {
+	b, err := json.Marshal(&status)
+	if err != nil {
+		return err
+	}
+	return streamClient.Send(&grpc_v1.StreamRequest{Payload: append([]byte{v1beta1.DeviceFileCopyStatusChannel}, b...)})
+}
+
+// sendFile streams the file on the data channel followed by its size and mode on the status
+// channel.
+func sendFile(streamClient grpc_v1.RouterService_StreamClient, f *os.File, info os.FileInfo) error {
+	buf := make([]byte, fileCopyChunkSize)
+	var sent int64
+	for {
+		n, err := f.Read(buf)
+		if n > 0 {
+			payload := make([]byte, n+1)
+			payload[0] = v1beta1.DeviceFileCopyDataChannel
+			copy(payload[1:], buf[:n])
+			if sendErr := streamClient.Send(&grpc_v1.StreamRequest{Payload: payload}); sendErr != nil {
+				return sendErr
+			}
+			sent += int64(n)
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return sendFileCopyStatus(streamClient, v1beta1.DeviceFileCopyStatus{Size: sent, Error: err.Error()})
+		}
+	}
+	return sendFileCopyStatus(streamClient, v1beta1.DeviceFileCopyStatus{Size: sent, Mode: uint32(info.Mode().Perm())})
+}
+
+// receiveFile reads size bytes from the data channel into a temporary file next to path and
+// renames it into place once complete, so that an aborted upload never leaves a partial file.
+func receiveFile(streamClient grpc_v1.RouterService_StreamClient, path string, size int64, mode os.FileMode) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), fileCopyTempFilePattern)
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %w", err)
+	}
+	defer func() {
+		tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	var received int64
+	for received < size {
+		msg, err := streamClient.Recv()
+		if err != nil {
+			return fmt.Errorf("receiving file: %w", err)
+		}
+		payload := msg.GetPayload()
+		if len(payload) > 1 && payload[0] == v1beta1.DeviceFileCopyDataChannel {
+			data := payload[1:]
+			if received+int64(len(data)) > size {
+				return fmt.Errorf("received more than the announced %d bytes", size)
+			}
+			if _, err := tmp.Write(data); err != nil {
+				return fmt.Errorf("writing file: %w", err)
+			}
+			received += int64(len(data))
+		}
+		if msg.GetClosed() && received < size {
+			return fmt.Errorf("upload ended after %d of %d bytes", received, size)
+		}
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("syncing file: %w", err)
+	}
+	if err := tmp.Chmod(mode); err != nil {
+		return fmt.Errorf("setting file mode: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("closing file: %w", err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("renaming file: %w", err)
+	}
+	return nil
+}
+
+// fileCopyTransfer is a file copy request that passed the checks on the device: either the opened
+// file to download or the resolved destination of an upload.
+type fileCopyTransfer struct {
+	fileCopy *v1beta1.DeviceFileCopy
+	src      *os.File
+	srcInfo  os.FileInfo
+	dst      string
+}
+
+// prepareFileCopy checks the request against cfg and opens the file to download.
+func prepareFileCopy(cfg *config.FileCopy, fileCopy *v1beta1.DeviceFileCopy) (*fileCopyTransfer, error) {
+	path, err := resolveFileCopyPath(cfg, fileCopy)
+	if err != nil {
+		return nil, err
+	}
+	t := &fileCopyTransfer{fileCopy: fileCopy}
+	switch fileCopy.Direction {
+	case v1beta1.DeviceFileTransferDownload:
+		if t.src, t.srcInfo, err = openFileCopySource(cfg, path); err != nil {
+			return nil, err
+		}
+	case v1beta1.DeviceFileTransferUpload:
+		if fileCopy.Size < 0 || fileCopy.Size > cfg.MaxSize {
+			return nil, fmt.Errorf("file size %d exceeds the maximum of %d bytes", fileCopy.Size, cfg.MaxSize)
+		}
+		t.dst = path
+	default:
+		return nil, fmt.Errorf("unsupported file copy direction %q", fileCopy.Direction)
+	}
+	return t, nil
+}
+
+func (t *fileCopyTransfer) close() {
+	if t.src != nil {
+		_ = t.src.Close()
+	}
+}
+
+// run performs the transfer over the stream. The result is reported on the status channel before
+// the stream is closed.
+func (t *fileCopyTransfer) run(streamClient grpc_v1.RouterService_StreamClient, log *log.PrefixLogger) {
+	defer func() {
+		_ = streamClient.Send(&grpc_v1.StreamRequest{Closed: true})
+	}()
+	if t.src != nil {
+		if err := sendFile(streamClient, t.src, t.srcInfo); err != nil {
+			log.Warnf("sending file %s: %v", t.fileCopy.Path, err)
+		}
+		return
+	}
+	mode := os.FileMode(defaultFileCopyMode)
+	if t.fileCopy.Mode != 0 {
+		mode = os.FileMode(t.fileCopy.Mode).Perm()
+	}
+	status := v1beta1.DeviceFileCopyStatus{Size: t.fileCopy.Size}
+	if err := receiveFile(streamClient, t.dst, t.fileCopy.Size, mode); err != nil {
+		log.Warnf("receiving file %s: %v", t.fileCopy.Path, err)
+		status.Error = err.Error()
+	}
+	if err := sendFileCopyStatus(streamClient, status); err != nil {
+		log.Debugf("sending file copy status: %v", err)
+	}
+}
@@ -0,0 +1,158 @@
+package console
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestResolveFileCopyPath(t *testing.T) {
+	root := t.TempDir()
+	allowed := filepath.Join(root, "allowed")
+	denied := filepath.Join(allowed, "denied")
+	outside := filepath.Join(root, "outside")
+	for _, dir := range []string{allowed, denied, outside} {
+		require.NoError(t, os.MkdirAll(dir, 0o755))
+	}
+	require.NoError(t, os.WriteFile(filepath.Join(allowed, "app.log"), []byte("log"), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0o600))
+	require.NoError(t, os.Symlink(filepath.Join(outside, "secret"), filepath.Join(allowed, "link")))
+	require.NoError(t, os.Symlink(outside, filepath.Join(allowed, "linkdir")))
+
+	cfg := &config.FileCopy{MaxSize: 1024, AllowedPaths: []string{allowed}, DeniedPaths: []string{denied}}
+	tests := []struct {
+		name        string
+		direction   v1beta1.DeviceFileTransferDetailsDirection
+		path        string
+		errContains string
+	}{
+		{name: "download allowed", direction: v1beta1.DeviceFileTransferDownload, path: filepath.Join(allowed, "app.log")},
+		{name: "upload allowed", direction: v1beta1.DeviceFileTransferUpload, path: filepath.Join(allowed, "new.txt")},
+		{name: "relative", direction: v1beta1.DeviceFileTransferDownload, path: "app.log", errContains: "not absolute"},
+		{name: "outside", direction: v1beta1.DeviceFileTransferDownload, path: filepath.Join(outside, "secret"), errContains: "not allowed"},
+		{name: "dot dot", direction: v1beta1.DeviceFileTransferDownload, path: allowed + "/../outside/secret", errContains: "not allowed"},
+		{name: "denied", direction: v1beta1.DeviceFileTransferUpload, path: filepath.Join(denied, "x"), errContains: "not allowed"},
+		{name: "symlink out", direction: v1beta1.DeviceFileTransferDownload, path: filepath.Join(allowed, "link"), errContains: "not allowed"},
+		{name: "symlink dir out", direction: v1beta1.DeviceFileTransferUpload, path: filepath.Join(allowed, "linkdir", "x"), errContains: "not allowed"},
+		{name: "missing", direction: v1beta1.DeviceFileTransferDownload, path: filepath.Join(allowed, "missing"), errContains: "resolving path"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := resolveFileCopyPath(cfg, &v1beta1.DeviceFileCopy{Direction: tt.direction, Path: tt.path})
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// newFileCopyStream returns a mock stream that delivers recv and records the sent requests.
+func newFileCopyStream(t *testing.T, recv ...*grpc_v1.StreamResponse) (*MockRouterService_StreamClient, func() []*grpc_v1.StreamRequest) {
+	ctrl := gomock.NewController(t)
+	streamClient := NewMockRouterService_StreamClient(ctrl)
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		if len(recv) == 0 {
+			return nil, io.EOF
+		}
+		msg := recv[0]
+		recv = recv[1:]
+		return msg, nil
+	}).AnyTimes()
+	var mu sync.Mutex
+	var sent []*grpc_v1.StreamRequest
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *grpc_v1.StreamRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, req)
+		return nil
+	}).AnyTimes()
+	return streamClient, func() []*grpc_v1.StreamRequest {
+		mu.Lock()
+		defer mu.Unlock()
+		return sent
+	}
+}
+
+func parseFileCopyStatus(t *testing.T, req *grpc_v1.StreamRequest) v1beta1.DeviceFileCopyStatus {
+	require.Equal(t, v1beta1.DeviceFileCopyStatusChannel, req.Payload[0])
+	var status v1beta1.DeviceFileCopyStatus
+	require.NoError(t, json.Unmarshal(req.Payload[1:], &status))
+	return status
+}
+
+func TestFileCopyDownload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app.log")
+	require.NoError(t, os.WriteFile(path, []byte("hello"), 0o640))
+	cfg := &config.FileCopy{MaxSize: 1024, AllowedPaths: []string{dir}}
+
+	transfer, err := prepareFileCopy(cfg, &v1beta1.DeviceFileCopy{Direction: v1beta1.DeviceFileTransferDownload, Path: path})
+	require.NoError(t, err)
+	defer transfer.close()
+	streamClient, sent := newFileCopyStream(t)
+	transfer.run(streamClient, log.NewPrefixLogger("test"))
+
+	reqs := sent()
+	require.Len(t, reqs, 3)
+	require.Equal(t, append([]byte{v1beta1.DeviceFileCopyDataChannel}, "hello"...), reqs[0].Payload)
+	require.Equal(t, v1beta1.DeviceFileCopyStatus{Size: 5, Mode: 0o640}, parseFileCopyStatus(t, reqs[1]))
+	require.True(t, reqs[2].Closed)
+
+	cfg.MaxSize = 4
+	_, err = prepareFileCopy(cfg, &v1beta1.DeviceFileCopy{Direction: v1beta1.DeviceFileTransferDownload, Path: path})
+	require.ErrorContains(t, err, "larger than the maximum")
+}
+
+func TestFileCopyUpload(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "new.txt")
+	cfg := &config.FileCopy{MaxSize: 1024, AllowedPaths: []string{dir}}
+	fileCopy := &v1beta1.DeviceFileCopy{Direction: v1beta1.DeviceFileTransferUpload, Path: path, Size: 5, Mode: 0o600}
+
+	transfer, err := prepareFileCopy(cfg, fileCopy)
+	require.NoError(t, err)
+	streamClient, sent := newFileCopyStream(t,
+		&grpc_v1.StreamResponse{Payload: append([]byte{v1beta1.DeviceFileCopyDataChannel}, "hel"...)},
+		&grpc_v1.StreamResponse{Payload: append([]byte{v1beta1.DeviceFileCopyDataChannel}, "lo"...)},
+	)
+	transfer.run(streamClient, log.NewPrefixLogger("test"))
+
+	reqs := sent()
+	require.Len(t, reqs, 2)
+	require.Equal(t, v1beta1.DeviceFileCopyStatus{Size: 5}, parseFileCopyStatus(t, reqs[0]))
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "hello", string(content))
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	// an interrupted upload leaves nothing behind
+	partial := filepath.Join(dir, "partial.txt")
+	transfer, err = prepareFileCopy(cfg, &v1beta1.DeviceFileCopy{Direction: v1beta1.DeviceFileTransferUpload, Path: partial, Size: 5})
+	require.NoError(t, err)
+	streamClient, sent = newFileCopyStream(t,
+		&grpc_v1.StreamResponse{Payload: append([]byte{v1beta1.DeviceFileCopyDataChannel}, "he"...)},
+	)
+	transfer.run(streamClient, log.NewPrefixLogger("test"))
+	require.NotEmpty(t, parseFileCopyStatus(t, sent()[0]).Error)
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+
+	fileCopy.Size = 2048
+	_, err = prepareFileCopy(cfg, fileCopy)
+	require.ErrorContains(t, err, "exceeds the maximum")
+}
@@ -4,11 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"sync"
 	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/spec"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/pkg/executer"
@@ -29,6 +31,7 @@ type Manager struct {
 	deviceName string
 	watcher    spec.Watcher
 	user       string
+	fileCopy   config.FileCopy
 
 	activeSessions   []*session
 	inactiveSessions []*session
@@ -48,6 +51,7 @@ func NewManager(
 	user string,
 	executor executer.Executer,
 	watcher spec.Watcher,
+	fileCopy config.FileCopy,
 	log *log.PrefixLogger,
 ) *Manager {
 	return &Manager{
@@ -56,6 +60,7 @@ func NewManager(
 		user:       user,
 		executor:   executor,
 		watcher:    watcher,
+		fileCopy:   fileCopy,
 		log:        log,
 	}
 }
@@ -143,6 +148,14 @@ func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
 	// add key-value pairs of metadata to context
 	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionIDKey, s.id)
 	ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcClientNameKey, c.deviceName)
+	if sessionMetadata.PortForward != nil {
+		c.startPortForward(ctx, s, sessionMetadata)
+		return
+	}
+	if sessionMetadata.FileCopy != nil {
+		c.startFileCopy(ctx, s, sessionMetadata)
+		return
+	}
 	selectedProtocol, err := c.selectProtocol(sessionMetadata.Protocols)
 	if err != nil {
 		c.log.Errorf("failed to select protocol: %v", err)
@@ -159,6 +172,72 @@ func (c *Manager) start(ctx context.Context, dc v1beta1.DeviceConsole) {
 	s.run(ctx, sessionMetadata)
 }
 
+// startPortForward connects to the requested target and forwards the session stream to it. A
+// connection failure is reported to the server instead of a selected protocol.
+func (c *Manager) startPortForward(ctx context.Context, s *session, sessionMetadata *v1beta1.DeviceConsoleSessionMetadata) {
+	var conn net.Conn
+	if !lo.Contains(sessionMetadata.Protocols, v1beta1.DevicePortForwardProtocol) {
+		c.log.Errorf("port-forward session %s does not request protocol %s", s.id, v1beta1.DevicePortForwardProtocol)
+	} else {
+		target := sessionMetadata.PortForward
+		var err error
+		conn, err = dialPortForwardTarget(ctx, target)
+		if err != nil {
+			c.log.Warnf("port-forward session %s: %v", s.id, err)
+			ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionErrorKey, sanitizeGrpcMetadataValue(err.Error()))
+		} else {
+			defer conn.Close()
+			ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSelectedProtocolKey, v1beta1.DevicePortForwardProtocol)
+		}
+	}
+	streamClient, err := c.grpcClient.Stream(ctx)
+	if err != nil {
+		c.log.Errorf("error creating port-forward stream client: %v", err)
+		return
+	}
+	s.streamClient = streamClient
+	if conn == nil {
+		_ = streamClient.CloseSend()
+		return
+	}
+	c.log.Debugf("port-forward session %s started", s.id)
+	defer c.log.Debugf("port-forward session %s finished", s.id)
+	forwardPort(ctx, streamClient, conn, c.log)
+}
+
+// startFileCopy checks the requested path and opens the file to download before the protocol is
+// selected, so that a rejected request is reported to the server as a session error.
+func (c *Manager) startFileCopy(ctx context.Context, s *session, sessionMetadata *v1beta1.DeviceConsoleSessionMetadata) {
+	var (
+		transfer *fileCopyTransfer
+		err      error
+	)
+	if !lo.Contains(sessionMetadata.Protocols, v1beta1.DeviceFileCopyProtocol) {
+		err = fmt.Errorf("file copy session does not request protocol %s", v1beta1.DeviceFileCopyProtocol)
+	} else {
+		transfer, err = prepareFileCopy(&c.fileCopy, sessionMetadata.FileCopy)
+	}
+	if err != nil {
+		c.log.Warnf("file copy session %s: %v", s.id, err)
+		ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSessionErrorKey, sanitizeGrpcMetadataValue(err.Error()))
+	} else {
+		defer transfer.close()
+		ctx = metadata.AppendToOutgoingContext(ctx, consts.GrpcSelectedProtocolKey, v1beta1.DeviceFileCopyProtocol)
+	}
+	streamClient, streamErr := c.grpcClient.Stream(ctx)
+	if streamErr != nil {
+		c.log.Errorf("error creating file copy stream client: %v", streamErr)
+		return
+	}
+	s.streamClient = streamClient
+	if transfer == nil {
+		_ = streamClient.CloseSend()
+		return
+	}
+	c.log.Infof("file copy session %s: %s %s", s.id, sessionMetadata.FileCopy.Direction, sessionMetadata.FileCopy.Path)
+	transfer.run(streamClient, c.log)
+}
+
 func (c *Manager) sync(ctx context.Context, desired *v1beta1.DeviceSpec) {
 	c.log.Debug("Syncing console status")
 	defer c.log.Debug("Finished syncing console status")
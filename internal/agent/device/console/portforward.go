@@ -0,0 +1,93 @@
+package console
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/pkg/log"
+)
+
+const (
+	portForwardDialTimeout = 10 * time.Second
+	portForwardBufferSize  = 32 * 1024
+)
+
+// dialPortForwardTarget connects to the target of a port-forward session on the device.
+func dialPortForwardTarget(ctx context.Context, target *v1beta1.DevicePortForward) (net.Conn, error) {
+	dialer := net.Dialer{Timeout: portForwardDialTimeout}
+	return dialer.DialContext(ctx, "tcp", net.JoinHostPort(target.Host, strconv.Itoa(target.Port)))
+}
+
+// sanitizeGrpcMetadataValue replaces characters that are not allowed in gRPC metadata values.
+func sanitizeGrpcMetadataValue(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7E {
+			return '?'
+		}
+		return r
+	}, s)
+}
+
+// forwardPort copies bytes between the stream and conn until either side closes.
+func forwardPort(ctx context.Context, streamClient grpc_v1.RouterService_StreamClient, conn net.Conn, log *log.PrefixLogger) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		_ = conn.Close()
+	}()
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		for {
+			msg, err := streamClient.Recv()
+			if err != nil {
+				if !errors.Is(err, io.EOF) {
+					log.Debugf("port-forward stream receive: %v", err)
+				}
+				return
+			}
+			if len(msg.GetPayload()) > 0 {
+				if _, err := conn.Write(msg.GetPayload()); err != nil {
+					log.Debugf("port-forward write to target: %v", err)
+					return
+				}
+			}
+			if msg.GetClosed() {
+				return
+			}
+		}
+	}()
+	go func() {
+		defer wg.Done()
+		defer cancel()
+		buf := make([]byte, portForwardBufferSize)
+		for {
+			n, err := conn.Read(buf)
+			if n > 0 {
+				payload := make([]byte, n)
+				copy(payload, buf[:n])
+				if sendErr := streamClient.Send(&grpc_v1.StreamRequest{Payload: payload}); sendErr != nil {
+					log.Debugf("port-forward stream send: %v", sendErr)
+					return
+				}
+			}
+			if err != nil {
+				_ = streamClient.Send(&grpc_v1.StreamRequest{Closed: true})
+				return
+			}
+		}
+	}()
+	wg.Wait()
+}
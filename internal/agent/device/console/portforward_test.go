@@ -0,0 +1,82 @@
+package console
+
+import (
+	"context"
+	"io"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestForwardPort(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	// echo server that closes after one message
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		buf := make([]byte, 64)
+		n, _ := conn.Read(buf)
+		_, _ = conn.Write(buf[:n])
+	}()
+
+	ctrl := gomock.NewController(t)
+	streamClient := NewMockRouterService_StreamClient(ctrl)
+
+	recvCh := make(chan *grpc_v1.StreamResponse, 1)
+	recvCh <- &grpc_v1.StreamResponse{Payload: []byte("ping")}
+	streamClient.EXPECT().Recv().DoAndReturn(func() (*grpc_v1.StreamResponse, error) {
+		msg, ok := <-recvCh
+		if !ok {
+			return nil, io.EOF
+		}
+		return msg, nil
+	}).AnyTimes()
+
+	var mu sync.Mutex
+	var sent []*grpc_v1.StreamRequest
+	streamClient.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *grpc_v1.StreamRequest) error {
+		mu.Lock()
+		defer mu.Unlock()
+		sent = append(sent, req)
+		if req.Closed {
+			close(recvCh)
+		}
+		return nil
+	}).AnyTimes()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	port := listener.Addr().(*net.TCPAddr).Port
+	conn, err := dialPortForwardTarget(ctx, &v1beta1.DevicePortForward{Host: "127.0.0.1", Port: port})
+	require.NoError(t, err)
+	forwardPort(ctx, streamClient, conn, log.NewPrefixLogger("test"))
+
+	mu.Lock()
+	defer mu.Unlock()
+	require.Len(t, sent, 2)
+	require.Equal(t, "ping", string(sent[0].Payload))
+	require.True(t, sent[1].Closed)
+}
+
+func TestDialPortForwardTargetRefused(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := listener.Addr().(*net.TCPAddr).Port
+	listener.Close()
+
+	_, err = dialPortForwardTarget(context.Background(), &v1beta1.DevicePortForward{Host: "127.0.0.1", Port: port})
+	require.Error(t, err)
+}
@@ -204,6 +204,9 @@ type prefetchManager struct {
 	// encounters an error
 	pullTimeout time.Duration
 	pollConfig  *poll.Config
+	// imageVerifier verifies the signatures of pulled images, nil if
+	// signature verification is disabled
+	imageVerifier *ImageVerifier
 
 	mu         sync.Mutex
 	tasks      map[imageRef]*prefetchTask
@@ -214,9 +217,12 @@ type prefetchManager struct {
 type prefetchTask struct {
 	clientOptsFn ClientOptsFn
 	ociType      OCIType
-	err          error
-	done         bool
-	cancelFn     context.CancelFunc
+	// verifyOnly is set for images that already exist locally but whose
+	// signature still has to be verified
+	verifyOnly bool
+	err        error
+	done       bool
+	cancelFn   context.CancelFunc
 }
 
 // NewPrefetchManager creates a new prefetch manager instance
@@ -230,6 +236,7 @@ func NewPrefetchManager(
 	pullTimeout util.Duration,
 	resourceManager resource.Manager,
 	pollConfig poll.Config,
+	imageVerifier *ImageVerifier,
 ) *prefetchManager {
 	return &prefetchManager{
 		log:             log,
@@ -240,6 +247,7 @@ func NewPrefetchManager(
 		pullTimeout:     time.Duration(pullTimeout),
 		pollConfig:      &pollConfig,
 		resourceManager: resourceManager,
+		imageVerifier:   imageVerifier,
 		tasks:           make(map[imageRef]*prefetchTask),
 		queue:           make(chan imageRef, maxQueueSize),
 	}
@@ -473,9 +481,16 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 		opts = append(opts, task.clientOptsFn()...)
 	}
 
+	if task.verifyOnly {
+		return m.verifyImage(ctx, target, podman, skopeo, opts...)
+	}
+
 	switch ociType {
 	case OCITypePodmanImage:
 		_, err = podman.Pull(ctx, target.image, opts...)
+		if err == nil {
+			err = m.verifyImage(ctx, target, podman, skopeo, opts...)
+		}
 	case OCITypeCRIImage:
 		_, err = m.cliClients.CRI().Pull(ctx, target.image, opts...)
 	case OCITypePodmanArtifact:
@@ -499,6 +514,9 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 		switch detectedType {
 		case OCITypePodmanImage:
 			_, err = podman.Pull(ctx, target.image, opts...)
+			if err == nil {
+				err = m.verifyImage(ctx, target, podman, skopeo, opts...)
+			}
 		case OCITypePodmanArtifact:
 			_, err = podman.PullArtifact(ctx, target.image, opts...)
 		default:
@@ -510,6 +528,27 @@ func (m *prefetchManager) pull(ctx context.Context, target imageRef, task *prefe
 	return err
 }
 
+// verifyImage verifies the signature of a pulled image if signature verification is enabled.
+// Images failing verification are removed again, so that they cannot be used.
+func (m *prefetchManager) verifyImage(
+	ctx context.Context,
+	target imageRef,
+	podman *client.Podman,
+	skopeo *client.Skopeo,
+	opts ...client.ClientOption,
+) error {
+	if m.imageVerifier == nil {
+		return nil
+	}
+	if err := m.imageVerifier.Verify(ctx, target.owner, podman, skopeo, target.image, opts...); err != nil {
+		if removeErr := podman.RemoveImage(ctx, target.image); removeErr != nil {
+			m.log.Warnf("Failed to remove unverified image %s: %v", target.image, removeErr)
+		}
+		return fmt.Errorf("verifying image signature: %w", err)
+	}
+	return nil
+}
+
 func (m *prefetchManager) setResult(target imageRef, err error) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -582,10 +621,11 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 		return false, fmt.Errorf("creating podman client: %w", err)
 	}
 
-	var targetExists bool
+	var targetExists, podmanImageExists bool
 	switch ociType {
 	case OCITypePodmanImage:
-		targetExists = podman.ImageExists(ctx, target.image)
+		podmanImageExists = podman.ImageExists(ctx, target.image)
+		targetExists = podmanImageExists
 	case OCITypeCRIImage:
 		// CRI needs config options for existence check
 		var opts []client.ClientOption
@@ -596,7 +636,8 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 	case OCITypePodmanArtifact:
 		targetExists = podman.ArtifactExists(ctx, target.image)
 	case OCITypeAuto:
-		targetExists = podman.ImageExists(ctx, target.image) || podman.ArtifactExists(ctx, target.image)
+		podmanImageExists = podman.ImageExists(ctx, target.image)
+		targetExists = podmanImageExists || podman.ArtifactExists(ctx, target.image)
 	case OCITypeHelmChart:
 		resolved, err := m.cliClients.Helm().IsResolved(target.image)
 		if err != nil {
@@ -607,6 +648,18 @@ func (m *prefetchManager) prepareTask(ctx context.Context, target imageRef, ociT
 		return false, fmt.Errorf("invalid oci type %s", ociType)
 	}
 
+	if podmanImageExists && m.imageVerifier != nil {
+		// an image that is already present may have been pulled before signature
+		// verification was enabled, so it is verified without being pulled again
+		m.log.Debugf("Scheduled prefetch target already exists, verifying its signature: %s", target)
+		m.tasks[target] = &prefetchTask{
+			ociType:      ociType,
+			clientOptsFn: clientOptsFn,
+			verifyOnly:   true,
+		}
+		return true, nil
+	}
+
 	if targetExists {
 		m.log.Debugf("Scheduled prefetch target already exists: %s", target)
 		m.tasks[target] = &prefetchTask{
@@ -264,7 +264,7 @@ func TestEnsureScheduled(t *testing.T) {
 
 			timeout := util.Duration(5 * time.Second)
 			cliClients := client.NewCLIClients()
-			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{})
+			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{}, nil)
 
 			// register a collector that returns the test targets
 			manager.RegisterOCICollector(newTestOCICollector(func(ctx context.Context, current, desired *v1beta1.DeviceSpec, _ ...OCICollectOpt) (*OCICollection, error) {
@@ -352,7 +352,7 @@ func TestIsReady(t *testing.T) {
 				return skopeo, nil
 			}
 
-			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{})
+			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{}, nil)
 
 			for _, image := range tt.scheduledImages {
 				state := tt.imageStates[image]
@@ -412,7 +412,7 @@ func TestStatus(t *testing.T) {
 		return skopeo, nil
 	}
 
-	manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{})
+	manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{}, nil)
 
 	targets := OCIPullTargetsByUser{
 		"": []OCIPullTarget{
@@ -794,7 +794,7 @@ func TestBeforeUpdate(t *testing.T) {
 
 			timeout := util.Duration(5 * time.Second)
 			cliClients := client.NewCLIClients()
-			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{})
+			manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{}, nil)
 
 			// Register collectors
 			for _, collector := range tt.collectors {
@@ -933,7 +933,7 @@ func TestPullSecretCleanup(t *testing.T) {
 
 	timeout := util.Duration(5 * time.Second)
 	cliClients := client.NewCLIClients()
-	manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{})
+	manager := NewPrefetchManager(log, podmanFactory, skopeoFactory, cliClients, rw, timeout, mockResourceManager, poll.Config{}, nil)
 
 	// simulate a collector that would be called by applications manager
 	// note: cleanup is now handled centrally by PullConfigResolver at the device level
@@ -1234,7 +1234,7 @@ func TestSetResultAfterCleanup(t *testing.T) {
 		return skopeoClient, nil
 	}
 
-	pm := NewPrefetchManager(logger, podmanFactory, skopeoFactory, cliClients, readWriter, pullTimeout, mockResourceManager, poll.Config{})
+	pm := NewPrefetchManager(logger, podmanFactory, skopeoFactory, cliClients, readWriter, pullTimeout, mockResourceManager, poll.Config{}, nil)
 
 	testImage := imageRef{image: "quay.io/test/image:latest"}
 	pm.tasks[testImage] = &prefetchTask{
@@ -0,0 +1,133 @@
+package dependency
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/containers/image/v5/docker/reference"
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/imagesign"
+	"github.com/flightctl/flightctl/pkg/log"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+)
+
+// ImageVerifier verifies that pulled images carry a valid cosign signature from a trusted key.
+type ImageVerifier struct {
+	log       *log.PrefixLogger
+	rwFactory fileio.ReadWriterFactory
+	keys      []crypto.PublicKey
+}
+
+// NewImageVerifier returns a verifier trusting the PEM encoded public keys in the given files.
+// It returns nil if no key files are given, in which case image signatures are not verified.
+func NewImageVerifier(
+	log *log.PrefixLogger,
+	readWriter fileio.ReadWriter,
+	rwFactory fileio.ReadWriterFactory,
+	keyFiles []string,
+) (*ImageVerifier, error) {
+	if len(keyFiles) == 0 {
+		return nil, nil
+	}
+	keys := make([]crypto.PublicKey, 0, len(keyFiles))
+	for _, keyFile := range keyFiles {
+		contents, err := readWriter.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("reading trusted key %s: %w", keyFile, err)
+		}
+		key, err := imagesign.ParsePublicKeyPEM(contents)
+		if err != nil {
+			return nil, fmt.Errorf("trusted key %s: %w", keyFile, err)
+		}
+		keys = append(keys, key)
+	}
+	return &ImageVerifier{
+		log:       log,
+		rwFactory: rwFactory,
+		keys:      keys,
+	}, nil
+}
+
+// Verify checks that the locally stored image has a signature from a trusted key in its registry.
+// The signature must cover the digest of the local image and the repository it was pulled from.
+func (v *ImageVerifier) Verify(
+	ctx context.Context,
+	owner v1beta1.Username,
+	podman *client.Podman,
+	skopeo *client.Skopeo,
+	image string,
+	opts ...client.ClientOption,
+) error {
+	manifestDigest, err := podman.ImageDigest(ctx, image)
+	if err != nil {
+		return err
+	}
+	named, err := reference.ParseNormalizedNamed(image)
+	if err != nil {
+		return fmt.Errorf("parsing image reference: %w", err)
+	}
+	repository := reference.TrimNamed(named).Name()
+	tag, err := imagesign.SignatureTag(manifestDigest)
+	if err != nil {
+		return err
+	}
+
+	readWriter, err := v.rwFactory(owner)
+	if err != nil {
+		return err
+	}
+	dir, err := readWriter.MkdirTemp("image-signature")
+	if err != nil {
+		return fmt.Errorf("creating signature directory: %w", err)
+	}
+	defer func() {
+		if err := readWriter.RemoveAll(dir); err != nil {
+			v.log.Warnf("Failed to remove signature directory %s: %v", dir, err)
+		}
+	}()
+
+	signatureRef := fmt.Sprintf("%s:%s", repository, tag)
+	if err := skopeo.CopyToDir(ctx, signatureRef, dir, opts...); err != nil {
+		if errors.Is(err, errors.ErrImageNotFound) {
+			return fmt.Errorf("%w: no signature found for %s@%s", errors.ErrImageNotTrusted, repository, manifestDigest)
+		}
+		return fmt.Errorf("fetching signature %s: %w", signatureRef, err)
+	}
+
+	contents, err := readWriter.ReadFile(filepath.Join(dir, "manifest.json"))
+	if err != nil {
+		return fmt.Errorf("reading signature manifest: %w", err)
+	}
+	var manifest ocispec.Manifest
+	if err := json.Unmarshal(contents, &manifest); err != nil {
+		return fmt.Errorf("%w: parsing signature manifest: %w", errors.ErrImageNotTrusted, err)
+	}
+
+	verifyErr := imagesign.ErrNoValidSignature
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[imagesign.SignatureAnnotation]
+		if !ok || layer.MediaType != imagesign.PayloadMediaType {
+			continue
+		}
+		payload, err := readWriter.ReadFile(filepath.Join(dir, layer.Digest.Encoded()))
+		if err != nil {
+			return fmt.Errorf("reading signature payload: %w", err)
+		}
+		if err := imagesign.Verify(v.keys, payload, signature); err != nil {
+			continue
+		}
+		if err := imagesign.VerifyPayload(payload, repository, manifestDigest); err != nil {
+			verifyErr = err
+			continue
+		}
+		v.log.Infof("Verified signature of image %s@%s", repository, manifestDigest)
+		return nil
+	}
+	return fmt.Errorf("%w: %s@%s: %w", errors.ErrImageNotTrusted, repository, manifestDigest, verifyErr)
+}
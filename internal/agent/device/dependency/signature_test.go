@@ -0,0 +1,219 @@
+package dependency
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/executer"
+	"github.com/flightctl/flightctl/pkg/imagesign"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/poll"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestImageVerifier(t *testing.T) {
+	const (
+		image          = "quay.io/example/os:v1"
+		repository     = "quay.io/example/os"
+		manifestDigest = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+		otherDigest    = "sha256:0a5b0c0e2d2a4c6f7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+		signatureRef   = "docker://quay.io/example/os:sha256-4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945.sig"
+	)
+
+	trustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	// signatureFiles returns the files written by skopeo copy for a signature of the given digest
+	signatureFiles := func(key *ecdsa.PrivateKey, signedDigest string) map[string][]byte {
+		payload, err := imagesign.NewPayload(repository, signedDigest)
+		require.NoError(t, err)
+		signature, err := imagesign.Sign(key, payload)
+		require.NoError(t, err)
+		payloadDigest := digest.FromBytes(payload)
+		manifest, err := json.Marshal(ocispec.Manifest{
+			Layers: []ocispec.Descriptor{{
+				MediaType:   imagesign.PayloadMediaType,
+				Digest:      payloadDigest,
+				Size:        int64(len(payload)),
+				Annotations: map[string]string{imagesign.SignatureAnnotation: signature},
+			}},
+		})
+		require.NoError(t, err)
+		return map[string][]byte{
+			"manifest.json":         manifest,
+			payloadDigest.Encoded(): payload,
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		files         map[string][]byte
+		copyStderr    string
+		expectedError error
+	}{
+		{
+			name:  "signed by trusted key",
+			files: signatureFiles(trustedKey, manifestDigest),
+		},
+		{
+			name:          "signed by untrusted key",
+			files:         signatureFiles(untrustedKey, manifestDigest),
+			expectedError: errors.ErrImageNotTrusted,
+		},
+		{
+			name:          "signature of other digest",
+			files:         signatureFiles(trustedKey, otherDigest),
+			expectedError: errors.ErrImageNotTrusted,
+		},
+		{
+			name:          "no signature",
+			copyStderr:    "reading manifest sha256-4f53.sig in quay.io/example/os: manifest unknown",
+			expectedError: errors.ErrImageNotTrusted,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			logger := log.NewPrefixLogger("test")
+
+			rootDir := t.TempDir()
+			rwFactory := fileio.NewReadWriterFactory(rootDir)
+			readWriter, err := rwFactory("")
+			require.NoError(err)
+
+			der, err := x509.MarshalPKIXPublicKey(trustedKey.Public())
+			require.NoError(err)
+			require.NoError(readWriter.WriteFile("/trusted.pub", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+			verifier, err := NewImageVerifier(logger, readWriter, rwFactory, []string{"/trusted.pub"})
+			require.NoError(err)
+
+			mockExec := executer.NewMockExecuter(ctrl)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "image", "inspect", "--format", "{{.Digest}}", image).
+				Return(manifestDigest+"\n", "", 0)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "skopeo", gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ string, args ...string) (string, string, int) {
+					require.Equal(signatureRef, args[len(args)-2])
+					if tc.copyStderr != "" {
+						return "", tc.copyStderr, 1
+					}
+					dir := filepath.Join(rootDir, strings.TrimPrefix(args[len(args)-1], "dir:"))
+					for name, content := range tc.files {
+						require.NoError(os.WriteFile(filepath.Join(dir, name), content, 0600))
+					}
+					return "", "", 0
+				})
+
+			podman := client.NewPodman(logger, mockExec, readWriter, poll.Config{})
+			skopeo := client.NewSkopeo(logger, mockExec, readWriter)
+			err = verifier.Verify(t.Context(), "", podman, skopeo, image)
+			if tc.expectedError != nil {
+				require.ErrorIs(err, tc.expectedError)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
+func TestNewImageVerifierWithoutKeys(t *testing.T) {
+	rw := fileio.NewReadWriter(fileio.NewReader(), fileio.NewWriter())
+	verifier, err := NewImageVerifier(log.NewPrefixLogger("test"), rw, fileio.NewReadWriterFactory(""), nil)
+	require.NoError(t, err)
+	require.Nil(t, verifier)
+}
+
+func TestPrefetchVerifiesExistingImage(t *testing.T) {
+	const image = "quay.io/example/os:v1"
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name           string
+		withVerifier   bool
+		expectedQueued bool
+	}{
+		{
+			name:           "existing image is verified when verification is enabled",
+			withVerifier:   true,
+			expectedQueued: true,
+		},
+		{
+			name:           "existing image is ready when verification is disabled",
+			withVerifier:   false,
+			expectedQueued: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			logger := log.NewPrefixLogger("test")
+
+			rwFactory := fileio.NewReadWriterFactory(t.TempDir())
+			readWriter, err := rwFactory("")
+			require.NoError(err)
+
+			var verifier *ImageVerifier
+			if tc.withVerifier {
+				der, err := x509.MarshalPKIXPublicKey(key.Public())
+				require.NoError(err)
+				require.NoError(readWriter.WriteFile("/trusted.pub", pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}), 0600))
+				verifier, err = NewImageVerifier(logger, readWriter, rwFactory, []string{"/trusted.pub"})
+				require.NoError(err)
+			}
+
+			mockExec := executer.NewMockExecuter(ctrl)
+			podman := client.NewPodman(logger, mockExec, readWriter, poll.Config{})
+			skopeo := client.NewSkopeo(logger, mockExec, readWriter)
+			podmanFactory := func(v1beta1.Username) (*client.Podman, error) { return podman, nil }
+			skopeoFactory := func(v1beta1.Username) (*client.Skopeo, error) { return skopeo, nil }
+			manager := NewPrefetchManager(logger, podmanFactory, skopeoFactory, client.NewCLIClients(), readWriter, util.Duration(time.Minute), nil, poll.Config{}, verifier)
+
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "image", "exists", image).Return("", "", 0)
+
+			target := imageRef{image: image}
+			queued, err := manager.prepareTask(t.Context(), target, OCITypePodmanImage, nil)
+			require.NoError(err)
+			require.Equal(tc.expectedQueued, queued)
+			if !tc.expectedQueued {
+				require.True(manager.tasks[target].done)
+				return
+			}
+
+			// the image is not pulled again, only its signature is checked
+			manifestDigest := "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "image", "inspect", "--format", "{{.Digest}}", image).
+				Return(manifestDigest+"\n", "", 0)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "skopeo", gomock.Any()).
+				Return("", "reading manifest sha256-4f53.sig in quay.io/example/os: manifest unknown", 1)
+			mockExec.EXPECT().ExecuteWithContext(gomock.Any(), "podman", "image", "rm", image).Return("", "", 0)
+
+			err = manager.pull(t.Context(), target, manager.tasks[target])
+			require.ErrorIs(err, errors.ErrImageNotTrusted)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/agent/client"
 	agent_config "github.com/flightctl/flightctl/internal/agent/config"
 	"github.com/flightctl/flightctl/internal/agent/device/applications"
+	"github.com/flightctl/flightctl/internal/agent/device/certmanager"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/console"
 	"github.com/flightctl/flightctl/internal/agent/device/dependency"
@@ -51,6 +52,7 @@ type Agent struct {
 	prefetchManager        dependency.PrefetchManager
 	pullConfigResolver     dependency.PullConfigResolver
 	pruningManager         imagepruning.Manager
+	certManager            *certmanager.AgentCertManager
 
 	statusUpdateInterval util.Duration
 
@@ -81,6 +83,7 @@ func NewAgent(
 	prefetchManager dependency.PrefetchManager,
 	pullConfigResolver dependency.PullConfigResolver,
 	pruningManager imagepruning.Manager,
+	certManager *certmanager.AgentCertManager,
 	backoff wait.Backoff,
 	log *log.PrefixLogger,
 ) *Agent {
@@ -106,6 +109,7 @@ func NewAgent(
 		prefetchManager:        prefetchManager,
 		pullConfigResolver:     pullConfigResolver,
 		pruningManager:         pruningManager,
+		certManager:            certManager,
 		backoff:                backoff,
 		log:                    log,
 	}
@@ -134,7 +138,7 @@ func (a *Agent) sync(ctx context.Context, current, desired *v1beta1.Device) erro
 		return fmt.Errorf("%w: %w", errors.ErrPhaseApplyingUpdate, err)
 	}
 
-	if err := a.afterUpdate(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.afterUpdate(ctx, current, desired); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrPhaseActivatingConfig, err)
 	}
 
@@ -470,7 +474,7 @@ func (a *Agent) beforeUpdate(ctx context.Context, current, desired *v1beta1.Devi
 		return fmt.Errorf("%w: %w", errors.ErrComponentApplications, err)
 	}
 
-	if err := a.hookManager.OnBeforeUpdating(ctx, current.Spec, desired.Spec); err != nil {
+	if err := a.hookManager.OnBeforeUpdating(ctx, current, desired); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentHooks, err)
 	}
 
@@ -525,6 +529,12 @@ func (a *Agent) syncDevice(ctx context.Context, current, desired *v1beta1.Device
 		return fmt.Errorf("%w: %w", errors.ErrComponentSystemd, err)
 	}
 
+	if a.certManager != nil {
+		if err := a.certManager.SyncWorkloadCertificates(ctx, desired.Spec); err != nil {
+			return fmt.Errorf("%w: %w", errors.ErrComponentCertificates, err)
+		}
+	}
+
 	if err := a.lifecycleManager.Sync(ctx, current.Spec, desired.Spec); err != nil {
 		return fmt.Errorf("%w: %w", errors.ErrComponentLifecycle, err)
 	}
@@ -548,12 +558,12 @@ func (a *Agent) systemdControllerSync(_ context.Context, desired *v1beta1.Device
 	return nil
 }
 
-func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.DeviceSpec) error {
+func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Device) error {
 	a.log.Debug("Executing after update actions")
 	defer a.log.Debug("Finished executing after update actions")
 
 	// execute after update for lifecycle
-	if err := a.lifecycleManager.AfterUpdate(ctx, current, desired); err != nil {
+	if err := a.lifecycleManager.AfterUpdate(ctx, current.Spec, desired.Spec); err != nil {
 		a.log.Errorf("Error executing lifecycle: %v", err)
 		return err
 	}
@@ -568,7 +578,7 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	// after the os is updated.This happens because the os update requires a
 	// reboot so the lower blocks are not executed until after reboot.
 	if !isOSReconciled && a.specManager.IsOSUpdate() {
-		if err = a.afterUpdateOS(ctx, desired); err != nil {
+		if err = a.afterUpdateOS(ctx, desired.Spec); err != nil {
 			a.log.Errorf("Error executing OS: %v", err)
 			return err
 		}
@@ -580,7 +590,7 @@ func (a *Agent) afterUpdate(ctx context.Context, current, desired *v1beta1.Devic
 	rebooted := false
 	if err := a.hookManager.OnAfterUpdating(ctx, current, desired, rebooted); err != nil {
 		a.log.Errorf("Error executing AfterUpdating hook: %v", err)
-		return err
+		return fmt.Errorf("%w: %w", errors.ErrComponentHooks, err)
 	}
 
 	// execute after update for applications
@@ -607,7 +617,7 @@ func (a *Agent) afterUpdateOS(ctx context.Context, desired *v1beta1.DeviceSpec)
 
 	if err := a.hookManager.OnBeforeRebooting(ctx); err != nil {
 		a.log.Errorf("Error executing BeforeRebooting hook: %v", err)
-		return err
+		return fmt.Errorf("%w: %w", errors.ErrComponentHooks, err)
 	}
 
 	if err := a.specManager.CreateRollback(ctx); err != nil {
@@ -34,6 +34,7 @@ var (
 	ErrComponentConfig         = errors.New("config")
 	ErrComponentSystemd        = errors.New("systemd")
 	ErrComponentLifecycle      = errors.New("lifecycle")
+	ErrComponentCertificates   = errors.New("certificates")
 	ErrComponentOS             = errors.New("os")
 	ErrComponentOSReconciled   = errors.New("os reconciliation")
 
@@ -85,6 +86,8 @@ var (
 	ErrTokenNotSupported              = errors.New("invalid token: not supported")
 	ErrActionTypeNotFound             = errors.New("failed to find action type")
 	ErrRunActionInvalid               = errors.New("invalid run action")
+	ErrSystemdActionInvalid           = errors.New("invalid systemd action")
+	ErrHttpProbeActionInvalid         = errors.New("invalid httpProbe action")
 	ErrUnsupportedFilesystemOperation = errors.New("unsupported filesystem operation")
 
 	// networking
@@ -105,6 +108,7 @@ var (
 	// images
 	ErrImageNotFound     = errors.New("image not found")
 	ErrImageUnauthorized = errors.New("image unauthorized")
+	ErrImageNotTrusted   = errors.New("image signature not trusted")
 
 	// policy
 	ErrDownloadPolicyNotReady = errors.New("download policy not ready")
@@ -179,6 +183,9 @@ var (
 	ErrUnknownHookConditionType             = errors.New("unknown hook condition type")
 	ErrFailedToExecute                      = errors.New("failed to execute")
 	ErrLookingForHook                       = errors.New("looking for hook")
+	ErrSystemdUnitNotActive                 = errors.New("systemd unit not active")
+	ErrHttpProbeFailed                      = errors.New("http probe failed")
+	ErrHookActionAborted                    = errors.New("hook action aborted")
 
 	// OS errors
 	ErrUnableToParseImageReference = errors.New("unable to parse image reference into a valid bootc target")
@@ -241,6 +248,7 @@ var (
 		// authentication
 		ErrAuthenticationFailed: codes.Unauthenticated,
 		ErrImageUnauthorized:    codes.PermissionDenied,
+		ErrImageNotTrusted:      codes.PermissionDenied,
 
 		// not found / filesystem
 		ErrNotFound:            codes.NotFound,
@@ -259,17 +267,19 @@ var (
 		context.DeadlineExceeded: codes.DeadlineExceeded,
 
 		// invalid argument / configuration
-		ErrImageShortName:     codes.InvalidArgument,
-		ErrNoComposeFile:      codes.InvalidArgument,
-		ErrNoComposeServices:  codes.InvalidArgument,
-		ErrNoQuadletFile:      codes.InvalidArgument,
-		ErrNoQuadletWorkload:  codes.InvalidArgument,
-		ErrInvalidTokenFormat: codes.InvalidArgument,
-		ErrTokenNotSupported:  codes.InvalidArgument,
-		ErrRunActionInvalid:   codes.InvalidArgument,
-		ErrPathIsDir:          codes.InvalidArgument,
-		ErrInvalidPath:        codes.InvalidArgument,
-		ErrInvalidSpec:        codes.InvalidArgument,
+		ErrImageShortName:         codes.InvalidArgument,
+		ErrNoComposeFile:          codes.InvalidArgument,
+		ErrNoComposeServices:      codes.InvalidArgument,
+		ErrNoQuadletFile:          codes.InvalidArgument,
+		ErrNoQuadletWorkload:      codes.InvalidArgument,
+		ErrInvalidTokenFormat:     codes.InvalidArgument,
+		ErrTokenNotSupported:      codes.InvalidArgument,
+		ErrRunActionInvalid:       codes.InvalidArgument,
+		ErrSystemdActionInvalid:   codes.InvalidArgument,
+		ErrHttpProbeActionInvalid: codes.InvalidArgument,
+		ErrPathIsDir:              codes.InvalidArgument,
+		ErrInvalidPath:            codes.InvalidArgument,
+		ErrInvalidSpec:            codes.InvalidArgument,
 
 		// internal errors
 		ErrParseAppType:             codes.Internal,
@@ -363,6 +373,9 @@ var (
 		ErrUnknownHookConditionType:             codes.Internal,
 		ErrFailedToExecute:                      codes.Unavailable,
 		ErrLookingForHook:                       codes.InvalidArgument,
+		ErrSystemdUnitNotActive:                 codes.DeadlineExceeded,
+		ErrHttpProbeFailed:                      codes.DeadlineExceeded,
+		ErrHookActionAborted:                    codes.Aborted,
 
 		// OS errors
 		ErrUnableToParseImageReference: codes.InvalidArgument,
@@ -470,6 +483,13 @@ func IsRetryable(err error) bool {
 	}
 	var dnsErr *net.DNSError
 	switch {
+	case errors.Is(err, ErrHookActionAborted):
+		// a hook action that aborts the update must not be retried, even if it
+		// failed because it timed out
+		return false
+	case errors.Is(err, ErrImageNotTrusted):
+		// a missing or invalid image signature does not resolve by retrying
+		return false
 	case errors.As(err, &dnsErr):
 		// see https://pkg.go.dev/net#DNSError
 		return dnsErr.Temporary()
@@ -564,6 +564,12 @@ func getFileOwnership(file v1beta1.FileSpec) (int, int, error) {
 	return uid, gid, nil
 }
 
+// LookupFileOwnership resolves a user and group, each given as a name or numeric ID, to the
+// uid and gid to set on a file. An empty group resolves to root.
+func LookupFileOwnership(user v1beta1.Username, group string) (int, int, error) {
+	return getFileOwnership(v1beta1.FileSpec{User: user, Group: group})
+}
+
 func userToUID(user string) (int, error) {
 	userID, err := strconv.Atoi(user)
 	if err != nil {
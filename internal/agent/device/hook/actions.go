@@ -3,6 +3,8 @@ package hook
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
 	"os"
 	"os/exec"
 	"reflect"
@@ -10,17 +12,25 @@ import (
 	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 )
 
 type CommandLineVarKey string
 
 const (
 	DefaultHookActionTimeout = 10 * time.Second
+	// DefaultHttpProbeInterval is the time between the requests of an httpProbe action if no interval is specified
+	DefaultHttpProbeInterval = time.Second
+	// DefaultHttpProbeExpectedStatus is the status code expected by an httpProbe action if none is specified
+	DefaultHttpProbeExpectedStatus = http.StatusOK
+	// systemdActiveCheckInterval is the time between checks whether a unit is active
+	systemdActiveCheckInterval = 500 * time.Millisecond
 
 	// PathKey defines the name of the variable that contains the path operated on
 	PathKey CommandLineVarKey = "Path"
@@ -45,30 +55,44 @@ const (
 type actionContext struct {
 	hook            api.DeviceLifecycleHookType
 	systemRebooted  bool
+	update          bool // whether the hook is triggered by an update, which the update conditions are evaluated against
 	createdFiles    map[string]api.FileSpec
 	updatedFiles    map[string]api.FileSpec
 	removedFiles    map[string]api.FileSpec
+	osImageChanged  bool
+	applicationOps  map[string]api.ApplicationOperation
+	labels          map[string]string
+	systemInfo      api.DeviceSystemInfo
 	commandLineVars map[CommandLineVarKey]string
 }
 
-func newActionContext(hook api.DeviceLifecycleHookType, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) *actionContext {
+func newActionContext(hook api.DeviceLifecycleHookType, current *api.Device, desired *api.Device, systemRebooted bool, systemInfo api.DeviceSystemInfo) *actionContext {
 	actionContext := &actionContext{
 		hook:            hook,
 		systemRebooted:  systemRebooted,
 		createdFiles:    make(map[string]api.FileSpec),
 		updatedFiles:    make(map[string]api.FileSpec),
 		removedFiles:    make(map[string]api.FileSpec),
+		applicationOps:  make(map[string]api.ApplicationOperation),
+		labels:          make(map[string]string),
+		systemInfo:      systemInfo,
 		commandLineVars: make(map[CommandLineVarKey]string),
 	}
 	resetCommandLineVars(actionContext)
 	if current != nil || desired != nil {
-		defaultIfNil := func(spec *api.DeviceSpec) *api.DeviceSpec {
-			if spec == nil {
+		actionContext.update = true
+		defaultIfNil := func(device *api.Device) *api.DeviceSpec {
+			if device == nil || device.Spec == nil {
 				return &api.DeviceSpec{}
 			}
-			return spec
+			return device.Spec
 		}
 		computeFileDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+		computeApplicationDiff(actionContext, defaultIfNil(current), defaultIfNil(desired))
+		actionContext.osImageChanged = osImage(defaultIfNil(current)) != osImage(defaultIfNil(desired))
+	}
+	if desired != nil && desired.Metadata.Labels != nil {
+		actionContext.labels = *desired.Metadata.Labels
 	}
 	return actionContext
 }
@@ -107,7 +131,43 @@ func computeFileDiff(actionCtx *actionContext, current *api.DeviceSpec, desired
 	}
 }
 
-func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixLogger, action api.HookAction, actionCtx *actionContext, actionTimeout time.Duration) error {
+func osImage(spec *api.DeviceSpec) string {
+	if spec.Os == nil {
+		return ""
+	}
+	return spec.Os.Image
+}
+
+func computeApplicationDiff(actionCtx *actionContext, current *api.DeviceSpec, desired *api.DeviceSpec) {
+	currentApps := applicationsByName(current)
+	desiredApps := applicationsByName(desired)
+	for name, app := range desiredApps {
+		if currentApp, ok := currentApps[name]; !ok {
+			actionCtx.applicationOps[name] = api.ApplicationOperationAdded
+		} else if !reflect.DeepEqual(app, currentApp) {
+			actionCtx.applicationOps[name] = api.ApplicationOperationUpdated
+		}
+	}
+	for name := range currentApps {
+		if _, ok := desiredApps[name]; !ok {
+			actionCtx.applicationOps[name] = api.ApplicationOperationRemoved
+		}
+	}
+}
+
+func applicationsByName(spec *api.DeviceSpec) map[string]api.ApplicationProviderSpec {
+	apps := make(map[string]api.ApplicationProviderSpec)
+	for _, app := range lo.FromPtr(spec.Applications) {
+		name, err := app.GetName()
+		if err != nil || name == nil {
+			continue
+		}
+		apps[*name] = app
+	}
+	return apps
+}
+
+func executeAction(ctx context.Context, exec executer.Executer, systemd *client.Systemd, log *log.PrefixLogger, action api.HookAction, actionCtx *actionContext, actionTimeout time.Duration) error {
 	actionType, err := action.Type()
 	if err != nil {
 		return err
@@ -123,11 +183,125 @@ func executeAction(ctx context.Context, exec executer.Executer, log *log.PrefixL
 			return err
 		}
 		return executeRunAction(ctx, exec, log, runAction, actionCtx)
+	case api.HookActionTypeSystemd:
+		systemdAction, err := action.AsHookActionSystemd()
+		if err != nil {
+			return err
+		}
+		return executeSystemdAction(ctx, systemd, log, systemdAction.Systemd, actionCtx)
+	case api.HookActionTypeHttpProbe:
+		httpProbeAction, err := action.AsHookActionHttpProbe()
+		if err != nil {
+			return err
+		}
+		return executeHttpProbeAction(ctx, log, httpProbeAction.HttpProbe, actionCtx)
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
 }
 
+func executeSystemdAction(ctx context.Context, systemd *client.Systemd, log *log.PrefixLogger,
+	action api.HookActionSystemdSpec, actionCtx *actionContext) error {
+
+	var err error
+	switch action.Operation {
+	case api.HookActionSystemdOperationStart:
+		err = systemd.Start(ctx, action.Unit)
+	case api.HookActionSystemdOperationStop:
+		err = systemd.Stop(ctx, action.Unit)
+	case api.HookActionSystemdOperationRestart:
+		err = systemd.Restart(ctx, action.Unit)
+	case api.HookActionSystemdOperationReload:
+		err = systemd.Reload(ctx, action.Unit)
+	default:
+		return fmt.Errorf("%w: unsupported systemd operation %q", errors.ErrSystemdActionInvalid, action.Operation)
+	}
+	if err != nil {
+		log.Errorf("Running systemd %s of unit %q failed: %v", action.Operation, action.Unit, err)
+		return err
+	}
+
+	if lo.FromPtr(action.WaitForActive) {
+		if err := waitForUnitActive(ctx, systemd, action.Unit); err != nil {
+			log.Errorf("Waiting for unit %q to become active failed: %v", action.Unit, err)
+			return err
+		}
+	}
+	log.Infof("Hook %s executed systemd %s of unit %q without error", actionCtx.hook, action.Operation, action.Unit)
+	return nil
+}
+
+func waitForUnitActive(ctx context.Context, systemd *client.Systemd, unit string) error {
+	ticker := time.NewTicker(systemdActiveCheckInterval)
+	defer ticker.Stop()
+	for {
+		active, err := systemd.IsActive(ctx, unit)
+		if err != nil && ctx.Err() == nil {
+			return err
+		}
+		if active {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%w: unit %q: %w", errors.ErrSystemdUnitNotActive, unit, ctx.Err())
+		case <-ticker.C:
+		}
+	}
+}
+
+func executeHttpProbeAction(ctx context.Context, log *log.PrefixLogger, action api.HookActionHttpProbeSpec, actionCtx *actionContext) error {
+	interval := DefaultHttpProbeInterval
+	if action.Interval != nil {
+		var err error
+		if interval, err = time.ParseDuration(*action.Interval); err != nil {
+			return fmt.Errorf("%w: interval: %w", errors.ErrHttpProbeActionInvalid, err)
+		}
+		if interval <= 0 {
+			return fmt.Errorf("%w: interval must be positive: %s", errors.ErrHttpProbeActionInvalid, *action.Interval)
+		}
+	}
+	expectedStatus := lo.FromPtrOr(action.ExpectedStatus, DefaultHttpProbeExpectedStatus)
+
+	httpClient := &http.Client{}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var lastResult string
+	for {
+		statusCode, err := probeURL(ctx, httpClient, action.Url)
+		if err == nil && statusCode == expectedStatus {
+			log.Infof("Hook %s probed %q with status %d", actionCtx.hook, action.Url, statusCode)
+			return nil
+		}
+		if err != nil {
+			lastResult = err.Error()
+		} else {
+			lastResult = fmt.Sprintf("status %d", statusCode)
+		}
+		log.Debugf("Hook %s probing %q: expected status %d, got %s", actionCtx.hook, action.Url, expectedStatus, lastResult)
+		select {
+		case <-ctx.Done():
+			log.Errorf("Probing %q did not return status %d: %s", action.Url, expectedStatus, lastResult)
+			return fmt.Errorf("%w: %q: expected status %d, last result: %s", errors.ErrHttpProbeFailed, action.Url, expectedStatus, lastResult)
+		case <-ticker.C:
+		}
+	}
+}
+
+func probeURL(ctx context.Context, httpClient *http.Client, url string) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return 0, err
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	return resp.StatusCode, nil
+}
+
 func executeRunAction(ctx context.Context, exec executer.Executer, log *log.PrefixLogger,
 	action api.HookActionRun, actionCtx *actionContext) error {
 
@@ -331,6 +505,13 @@ func checkActionDependency(action api.HookAction) error {
 			return err
 		}
 		return checkRunActionDependency(runAction)
+	case api.HookActionTypeSystemd:
+		if _, err := exec.LookPath("systemctl"); err != nil {
+			return fmt.Errorf("%w: systemctl", err)
+		}
+		return nil
+	case api.HookActionTypeHttpProbe:
+		return nil
 	default:
 		return fmt.Errorf("%w: %q", errors.ErrUnknownHookActionType, actionType)
 	}
@@ -1,13 +1,20 @@
 package hook
 
 import (
+	"context"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"os/exec"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
 )
 
@@ -266,6 +273,68 @@ func TestReplaceTokensInrun(t *testing.T) {
 	}
 }
 
+func TestExecuteHttpProbeAction(t *testing.T) {
+	tests := []struct {
+		name           string
+		statuses       []int
+		expectedStatus *int
+		interval       string
+		wantErr        error
+	}{
+		{
+			name:     "succeeds on first probe",
+			statuses: []int{http.StatusOK},
+		},
+		{
+			name:     "succeeds after retries",
+			statuses: []int{http.StatusServiceUnavailable, http.StatusServiceUnavailable, http.StatusOK},
+		},
+		{
+			name:           "custom expected status",
+			statuses:       []int{http.StatusOK, http.StatusNoContent},
+			expectedStatus: lo.ToPtr(http.StatusNoContent),
+		},
+		{
+			name:     "times out",
+			statuses: []int{http.StatusServiceUnavailable},
+			wantErr:  errors.ErrHttpProbeFailed,
+		},
+		{
+			name:     "invalid interval",
+			statuses: []int{http.StatusOK},
+			interval: "0s",
+			wantErr:  errors.ErrHttpProbeActionInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require := require.New(t)
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				i := int(requests.Add(1)) - 1
+				w.WriteHeader(tt.statuses[min(i, len(tt.statuses)-1)])
+			}))
+			defer server.Close()
+
+			ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+			defer cancel()
+			action := v1beta1.HookActionHttpProbeSpec{
+				Url:            server.URL,
+				ExpectedStatus: tt.expectedStatus,
+				Interval:       lo.ToPtr(lo.CoalesceOrEmpty(tt.interval, "1s")),
+			}
+			actionCtx := newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, nil, nil, false, v1beta1.DeviceSystemInfo{})
+			err := executeHttpProbeAction(ctx, log.NewPrefixLogger("test"), action, actionCtx)
+			if tt.wantErr != nil {
+				require.ErrorIs(err, tt.wantErr)
+				return
+			}
+			require.NoError(err)
+		})
+	}
+}
+
 var testTokens = map[CommandLineVarKey]string{
 	PathKey:  "replaced",
 	FilesKey: "a b c",
@@ -34,6 +34,30 @@ func checkCondition(cond *v1beta1.HookCondition, actionContext *actionContext) (
 			return false, err
 		}
 		return checkPathOpCondition(pathOp, actionContext), nil
+	case v1beta1.HookConditionTypeOsImage:
+		osImage, err := (*cond).AsHookConditionOsImage()
+		if err != nil {
+			return false, err
+		}
+		return actionContext.update && osImage.OsImageChanged == actionContext.osImageChanged, nil
+	case v1beta1.HookConditionTypeApplicationOp:
+		applicationOp, err := (*cond).AsHookConditionApplicationOp()
+		if err != nil {
+			return false, err
+		}
+		return checkApplicationOpCondition(applicationOp, actionContext), nil
+	case v1beta1.HookConditionTypeLabels:
+		labels, err := (*cond).AsHookConditionLabels()
+		if err != nil {
+			return false, err
+		}
+		return checkLabelsCondition(labels, actionContext), nil
+	case v1beta1.HookConditionTypeSystemInfo:
+		systemInfo, err := (*cond).AsHookConditionSystemInfo()
+		if err != nil {
+			return false, err
+		}
+		return checkSystemInfoCondition(systemInfo, actionContext), nil
 	default:
 		return false, fmt.Errorf("%w: %q", errors.ErrUnknownHookConditionType, conditionType)
 	}
@@ -76,6 +100,60 @@ func checkEquals[T comparable](a, b T) bool {
 	return a == b
 }
 
+// checkApplicationOpCondition checks whether a specified operation (added, updated, removed) has been performed
+// on the specified application during the update.
+func checkApplicationOpCondition(cond v1beta1.HookConditionApplicationOp, actionCtx *actionContext) bool {
+	op, ok := actionCtx.applicationOps[cond.Application]
+	return ok && slices.Contains(cond.Op, op)
+}
+
+// checkLabelsCondition checks whether the device has all specified labels with the specified values.
+// The labels are those of the desired device, so the condition is never met outside of an update.
+func checkLabelsCondition(cond v1beta1.HookConditionLabels, actionCtx *actionContext) bool {
+	if !actionCtx.update {
+		return false
+	}
+	for key, value := range cond.Labels {
+		if actual, ok := actionCtx.labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+// checkSystemInfoCondition checks whether the device's system info has all specified fields with the specified values.
+// Keys are matched against the fields of the system info first, then against its additional and custom fields.
+func checkSystemInfoCondition(cond v1beta1.HookConditionSystemInfo, actionCtx *actionContext) bool {
+	for key, value := range cond.SystemInfo {
+		if actual, ok := systemInfoField(actionCtx.systemInfo, key); !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func systemInfoField(systemInfo v1beta1.DeviceSystemInfo, key string) (string, bool) {
+	switch key {
+	case "architecture":
+		return systemInfo.Architecture, true
+	case "operatingSystem":
+		return systemInfo.OperatingSystem, true
+	case "agentVersion":
+		return systemInfo.AgentVersion, true
+	case "bootID":
+		return systemInfo.BootID, true
+	}
+	if value, ok := systemInfo.AdditionalProperties[key]; ok {
+		return value, true
+	}
+	if systemInfo.CustomInfo != nil {
+		if value, ok := (*systemInfo.CustomInfo)[key]; ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
 func checkPathOpCondition(cond v1beta1.HookConditionPathOp, actionCtx *actionContext) bool {
 	resetCommandLineVars(actionCtx)
 
@@ -0,0 +1,82 @@
+package hook
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckCondition(t *testing.T) {
+	newDevice := func(spec string, labels map[string]string) *v1beta1.Device {
+		deviceSpec := &v1beta1.DeviceSpec{}
+		require.NoError(t, json.Unmarshal([]byte(spec), deviceSpec))
+		return &v1beta1.Device{
+			Metadata: v1beta1.ObjectMeta{Labels: &labels},
+			Spec:     deviceSpec,
+		}
+	}
+	current := newDevice(`{
+		"os": {"image": "quay.io/example/os:v1"},
+		"applications": [
+			{"name": "app1", "appType": "compose", "image": "quay.io/example/app1:v1"},
+			{"name": "app2", "appType": "compose", "image": "quay.io/example/app2:v1"}
+		]
+	}`, map[string]string{"site": "factory-1"})
+	desired := newDevice(`{
+		"os": {"image": "quay.io/example/os:v2"},
+		"applications": [
+			{"name": "app1", "appType": "compose", "image": "quay.io/example/app1:v2"},
+			{"name": "app3", "appType": "compose", "image": "quay.io/example/app3:v1"}
+		]
+	}`, map[string]string{"site": "factory-2", "region": "emea"})
+	systemInfo := v1beta1.DeviceSystemInfo{
+		Architecture:         "arm64",
+		OperatingSystem:      "linux",
+		AdditionalProperties: map[string]string{"hostname": "gateway-1"},
+		CustomInfo:           &v1beta1.CustomDeviceInfo{"serialNumber": "SN-1234"},
+	}
+
+	testCases := []struct {
+		name          string
+		condition     string
+		current       *v1beta1.Device
+		desired       *v1beta1.Device
+		expectedMatch bool
+	}{
+		{"os image changed", `{"osImageChanged": true}`, current, desired, true},
+		{"os image not changed", `{"osImageChanged": false}`, current, desired, false},
+		{"os image unchanged", `{"osImageChanged": true}`, current, current, false},
+		{"os image changed without update", `{"osImageChanged": true}`, nil, nil, false},
+		{"os image not changed without update", `{"osImageChanged": false}`, nil, nil, false},
+		{"application updated", `{"application": "app1", "op": ["updated"]}`, current, desired, true},
+		{"application removed", `{"application": "app2", "op": ["removed"]}`, current, desired, true},
+		{"application added", `{"application": "app3", "op": ["added", "updated"]}`, current, desired, true},
+		{"application operation not matching", `{"application": "app1", "op": ["added"]}`, current, desired, false},
+		{"application unknown", `{"application": "app4", "op": ["added"]}`, current, desired, false},
+		{"labels of desired device", `{"labels": {"site": "factory-2", "region": "emea"}}`, current, desired, true},
+		{"labels not matching", `{"labels": {"site": "factory-1"}}`, current, desired, false},
+		{"labels without update", `{"labels": {"site": "factory-2"}}`, nil, nil, false},
+		{"system info", `{"systemInfo": {"architecture": "arm64", "operatingSystem": "linux"}}`, nil, nil, true},
+		{"system info additional property", `{"systemInfo": {"hostname": "gateway-1"}}`, nil, nil, true},
+		{"system info custom info", `{"systemInfo": {"serialNumber": "SN-1234"}}`, nil, nil, true},
+		{"system info not matching", `{"systemInfo": {"architecture": "amd64"}}`, nil, nil, false},
+		{"system info unknown field", `{"systemInfo": {"doesNotExist": ""}}`, nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			condition := v1beta1.HookCondition{}
+			require.NoError(json.Unmarshal([]byte(tc.condition), &condition))
+			require.Empty(condition.Validate("condition"))
+
+			actionCtx := newActionContext(v1beta1.DeviceLifecycleHookAfterUpdating, tc.current, tc.desired, false, systemInfo)
+			match, err := checkCondition(lo.ToPtr(condition), actionCtx)
+			require.NoError(err)
+			require.Equal(tc.expectedMatch, match)
+		})
+	}
+}
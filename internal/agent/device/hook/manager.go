@@ -9,10 +9,13 @@ import (
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
 	"sigs.k8s.io/yaml"
 )
 
@@ -31,23 +34,27 @@ var _ Manager = (*manager)(nil)
 type Manager interface {
 	Sync(current, desired *api.DeviceSpec) error
 
-	OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error
-	OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error
+	OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error
+	OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error
 	OnBeforeRebooting(ctx context.Context) error
 	OnAfterRebooting(ctx context.Context) error
 }
 
 type manager struct {
-	log    *log.PrefixLogger
-	reader fileio.Reader
-	exec   executer.Executer
+	log          *log.PrefixLogger
+	reader       fileio.Reader
+	exec         executer.Executer
+	systemd      *client.Systemd
+	statusGetter status.Getter
 }
 
-func NewManager(reader fileio.Reader, exec executer.Executer, log *log.PrefixLogger) Manager {
+func NewManager(reader fileio.Reader, exec executer.Executer, systemd *client.Systemd, statusGetter status.Getter, log *log.PrefixLogger) Manager {
 	return &manager{
-		log:    log,
-		reader: reader,
-		exec:   exec,
+		log:          log,
+		reader:       reader,
+		exec:         exec,
+		systemd:      systemd,
+		statusGetter: statusGetter,
 	}
 }
 
@@ -55,27 +62,32 @@ func (m *manager) Sync(currentPtr, desiredPtr *api.DeviceSpec) error {
 	return nil
 }
 
-func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec) error {
-	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeUpdating, current, desired, false)
+func (m *manager) OnBeforeUpdating(ctx context.Context, current *api.Device, desired *api.Device) error {
+	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeUpdating, current, desired, false, m.systemInfo(ctx))
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
-func (m *manager) OnAfterUpdating(ctx context.Context, current *api.DeviceSpec, desired *api.DeviceSpec, systemRebooted bool) error {
+func (m *manager) OnAfterUpdating(ctx context.Context, current *api.Device, desired *api.Device, systemRebooted bool) error {
 
-	actionCtx := newActionContext(api.DeviceLifecycleHookAfterUpdating, current, desired, systemRebooted)
+	actionCtx := newActionContext(api.DeviceLifecycleHookAfterUpdating, current, desired, systemRebooted, m.systemInfo(ctx))
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
 func (m *manager) OnBeforeRebooting(ctx context.Context) error {
-	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeRebooting, nil, nil, false)
+	actionCtx := newActionContext(api.DeviceLifecycleHookBeforeRebooting, nil, nil, false, m.systemInfo(ctx))
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
 func (m *manager) OnAfterRebooting(ctx context.Context) error {
-	actionCtx := newActionContext(api.DeviceLifecycleHookAfterRebooting, nil, nil, true)
+	actionCtx := newActionContext(api.DeviceLifecycleHookAfterRebooting, nil, nil, true, m.systemInfo(ctx))
 	return m.loadAndExecuteActions(ctx, actionCtx)
 }
 
+// systemInfo returns the system info last collected by the agent, which hook conditions are evaluated against
+func (m *manager) systemInfo(ctx context.Context) api.DeviceSystemInfo {
+	return m.statusGetter.Get(ctx).SystemInfo
+}
+
 func (m *manager) loadAndExecuteActions(ctx context.Context, actionCtx *actionContext) error {
 	m.log.Debugf("Starting hook manager On%s()", actionCtx.hook)
 	defer m.log.Debugf("Finished hook manager On%s()", actionCtx.hook)
@@ -87,8 +99,14 @@ func (m *manager) loadAndExecuteActions(ctx context.Context, actionCtx *actionCo
 	return m.executeActions(ctx, actions, actionCtx)
 }
 
-func (m *manager) loadAndMergeActions(hookType api.DeviceLifecycleHookType) ([]api.HookAction, error) {
-	actionsMap := map[string][]api.HookAction{}
+// fileAction is a hook action together with the file it was loaded from
+type fileAction struct {
+	api.HookAction
+	file string
+}
+
+func (m *manager) loadAndMergeActions(hookType api.DeviceLifecycleHookType) ([]fileAction, error) {
+	actionsMap := map[string][]fileAction{}
 	// Read actions from the read-only hooks directory (/usr/lib/flightctl/hooks.d/${hookType}/*.yaml)
 	err := m.loadActions(actionsMap, filepath.Join(ReadOnlyConfigDir, HooksDropInDirName, strings.ToLower(string(hookType)), "*.yaml"))
 	if err != nil {
@@ -105,14 +123,14 @@ func (m *manager) loadAndMergeActions(hookType api.DeviceLifecycleHookType) ([]a
 		keyList = append(keyList, k)
 	}
 	sort.Strings(keyList)
-	actions := []api.HookAction{}
+	actions := []fileAction{}
 	for _, k := range keyList {
 		actions = append(actions, actionsMap[k]...)
 	}
 	return actions, nil
 }
 
-func (m *manager) loadActions(actionsMap map[string][]api.HookAction, actionFilesGlob string) error {
+func (m *manager) loadActions(actionsMap map[string][]fileAction, actionFilesGlob string) error {
 	actionFiles, err := filepath.Glob(m.reader.PathFor(actionFilesGlob))
 	if err != nil {
 		return fmt.Errorf("%w: actions matching %q: %w", errors.ErrLookingForHook, actionFilesGlob, err)
@@ -133,14 +151,16 @@ func (m *manager) loadActions(actionsMap map[string][]api.HookAction, actionFile
 		if len(allErrs) > 0 {
 			return errors.Join(allErrs...)
 		}
-		actionsMap[filepath.Base(f)] = actions
+		actionsMap[filepath.Base(f)] = lo.Map(actions, func(action api.HookAction, _ int) fileAction {
+			return fileAction{HookAction: action, file: filepath.Join(filepath.Dir(actionFilesGlob), filepath.Base(f))}
+		})
 	}
 	return nil
 }
 
-func (m *manager) executeActions(ctx context.Context, actions []api.HookAction, actionCtx *actionContext) error {
+func (m *manager) executeActions(ctx context.Context, actions []fileAction, actionCtx *actionContext) error {
 	for i, action := range actions {
-		if err := checkActionDependency(action); err != nil {
+		if err := checkActionDependency(action.HookAction); err != nil {
 			m.log.Debugf("Skipping %s hook action #%d: dependencies not met: %v", actionCtx.hook, i+1, err)
 			continue
 		}
@@ -166,8 +186,18 @@ func (m *manager) executeActions(ctx context.Context, actions []api.HookAction,
 		if err != nil {
 			return err
 		}
-		if err := executeAction(ctx, m.exec, m.log, action, actionCtx, actionTimeout); err != nil {
-			return fmt.Errorf("%w: %s hook action #%d: %w", errors.ErrFailedToExecute, actionCtx.hook, i+1, err)
+		if err := executeAction(ctx, m.exec, m.systemd, m.log, action.HookAction, actionCtx, actionTimeout); err != nil {
+			switch lo.FromPtr(action.OnFailure) {
+			case api.HookActionFailurePolicyIgnore:
+				m.log.Warnf("Ignoring failure of %s hook action #%d: %v", actionCtx.hook, i+1, err)
+				continue
+			case api.HookActionFailurePolicyAbort:
+				// the element identifies the failing action in the device's update status
+				element := errors.WithElement(fmt.Sprintf("%s hook action #%d (%s)", actionCtx.hook, i+1, action.file))
+				return fmt.Errorf("%w %w: %w: %w", errors.ErrHookActionAborted, element, errors.ErrFailedToExecute, err)
+			default:
+				return fmt.Errorf("%w: %s hook action #%d: %w", errors.ErrFailedToExecute, actionCtx.hook, i+1, err)
+			}
 		}
 	}
 	return nil
@@ -3,14 +3,18 @@ package hook
 import (
 	"context"
 	"os"
+	"os/exec"
 	"path/filepath"
 	"runtime"
 	"strings"
 	"testing"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
 	"github.com/flightctl/flightctl/internal/agent/device/config"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
 	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/executer"
 	"github.com/flightctl/flightctl/pkg/log"
@@ -29,48 +33,48 @@ func TestHookManager(t *testing.T) {
 	testCases := []struct {
 		name             string
 		hooks            map[string]string
-		current          *v1beta1.DeviceSpec
-		desired          *v1beta1.DeviceSpec
+		current          *v1beta1.Device
+		desired          *v1beta1.Device
 		rebooted         bool
 		expectedCommands []command
 	}{
 		{
 			name:             "creating a file outside the default hooks' paths should trigger no action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/user/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{},
 		},
 		{
 			name:             "creating a file inside a default hook's path should trigger its default action",
 			hooks:            map[string]string{},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/systemd/system/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"daemon-reload"}}},
 		},
 		{
 			name:             "creating a file whose path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToFile},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:             "creating a file whose parent directory's path is being watched should trigger the action once",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"systemctl", []string{"restart", "someservice"}}},
 		},
 		{
 			name:    "creating multiple files whose parent directory's path is being watched should trigger the action once",
 			hooks:   map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookPathToDir},
-			current: createDeviceSpec(require, map[string]string{}),
-			desired: createDeviceSpec(require, map[string]string{
+			current: createDevice(require, map[string]string{}),
+			desired: createDevice(require, map[string]string{
 				"/etc/someservice/some.config":      "data:,content",
 				"/etc/someservice/someother.config": "data:,content",
 			}),
@@ -80,16 +84,16 @@ func TestHookManager(t *testing.T) {
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         true,
 			expectedCommands: []command{{"echo", []string{"System was rebooted."}}},
 		},
 		{
 			name:             "actions with rebooted condition should run if the system rebooted during the update",
 			hooks:            map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookRebootedCondition},
-			current:          createDeviceSpec(require, map[string]string{}),
-			desired:          createDeviceSpec(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
+			current:          createDevice(require, map[string]string{}),
+			desired:          createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"}),
 			rebooted:         false,
 			expectedCommands: []command{{"echo", []string{"System was not rebooted."}}},
 		},
@@ -105,7 +109,7 @@ func TestHookManager(t *testing.T) {
 			mockExecuter := executer.NewMockExecuter(ctrl)
 			logger := log.NewPrefixLogger("test")
 			logger.SetLevel(logrus.DebugLevel)
-			hookManager := NewManager(readWriter, mockExecuter, logger)
+			hookManager := NewManager(readWriter, mockExecuter, client.NewSystemd(mockExecuter, v1beta1.RootUsername), status.NewManager("test", logger), logger)
 			expectExecCalls(mockExecuter, tc.expectedCommands)
 
 			ctx, cancel := context.WithCancel(context.TODO())
@@ -115,6 +119,86 @@ func TestHookManager(t *testing.T) {
 	}
 }
 
+func TestHookManagerSystemdAction(t *testing.T) {
+	require := require.New(t)
+	if _, err := exec.LookPath("systemctl"); err != nil {
+		t.Skip("systemctl is not available")
+	}
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	readWriter := createTempHooksDir(t, map[string]string{"/etc/flightctl/hooks.d/afterupdating/01-test.yaml": testHookSystemd})
+	mockExecuter := executer.NewMockExecuter(ctrl)
+	hookManager := NewManager(readWriter, mockExecuter, client.NewSystemd(mockExecuter, v1beta1.RootUsername), status.NewManager("test", log.NewPrefixLogger("test")), log.NewPrefixLogger("test"))
+	gomock.InOrder(
+		mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "restart", "someservice.service").Return("", "", 0),
+		mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "is-active", "someservice.service").Return("activating", "", 3),
+		mockExecuter.EXPECT().ExecuteWithContext(gomock.Any(), "/usr/bin/systemctl", "is-active", "someservice.service").Return("active", "", 0),
+	)
+
+	current := createDevice(require, map[string]string{})
+	desired := createDevice(require, map[string]string{"/etc/someservice/some.config": "data:,content"})
+	require.NoError(hookManager.OnAfterUpdating(context.Background(), current, desired, false))
+}
+
+func TestHookManagerOnFailure(t *testing.T) {
+	testCases := []struct {
+		name          string
+		hook          string
+		expectedErr   bool
+		expectedAbort bool
+	}{
+		{
+			name:        "failing action without policy fails the hook",
+			hook:        testHookFailing,
+			expectedErr: true,
+		},
+		{
+			name:          "failing action with abort policy aborts",
+			hook:          testHookFailingAbort,
+			expectedErr:   true,
+			expectedAbort: true,
+		},
+		{
+			name:        "failing action with ignore policy continues with the next action",
+			hook:        testHookFailingIgnore,
+			expectedErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			readWriter := createTempHooksDir(t, map[string]string{"/etc/flightctl/hooks.d/beforeupdating/01-test.yaml": tc.hook})
+			mockExecuter := executer.NewMockExecuter(ctrl)
+			hookManager := NewManager(readWriter, mockExecuter, client.NewSystemd(mockExecuter, v1beta1.RootUsername), status.NewManager("test", log.NewPrefixLogger("test")), log.NewPrefixLogger("test"))
+			failing := mockExecuter.EXPECT().ExecuteWithContextFromDir(gomock.Any(), "", "test", []string{"-f", "/run/battery-ok"}, gomock.Any()).Return("", "", 1)
+			if !tc.expectedErr {
+				mockExecuter.EXPECT().ExecuteWithContextFromDir(gomock.Any(), "", "true", []string{}, gomock.Any()).Return("", "", 0).After(failing)
+			}
+
+			current := createDevice(require, map[string]string{})
+			desired := createDevice(require, map[string]string{})
+			err := hookManager.OnBeforeUpdating(context.Background(), current, desired)
+			if !tc.expectedErr {
+				require.NoError(err)
+				return
+			}
+			require.ErrorIs(err, errors.ErrFailedToExecute)
+			if !tc.expectedAbort {
+				require.NotErrorIs(err, errors.ErrHookActionAborted)
+				return
+			}
+			require.ErrorIs(err, errors.ErrHookActionAborted)
+			require.False(errors.IsRetryable(err))
+			require.Equal("BeforeUpdating hook action #1 (/etc/flightctl/hooks.d/beforeupdating/01-test.yaml)", errors.GetElement(err))
+		})
+	}
+}
+
 const testHookPathToFile = `
 - if:
   - path: /etc/someservice/some.config
@@ -138,6 +222,16 @@ const testHookRebootedCondition = `
   run: echo "System was not rebooted."
 `
 
+const testHookSystemd = `
+- if:
+  - path: /etc/someservice/some.config
+    op: [created]
+  systemd:
+    unit: someservice.service
+    operation: restart
+    waitForActive: true
+`
+
 func createTempHooksDir(t *testing.T, hooks map[string]string) fileio.ReadWriter {
 	tempDir := t.TempDir()
 	readerWriter := fileio.NewReadWriter(
@@ -162,7 +256,7 @@ func createTempHooksDir(t *testing.T, hooks map[string]string) fileio.ReadWriter
 	return readerWriter
 }
 
-func createDeviceSpec(require *require.Assertions, fileMap map[string]string) *v1beta1.DeviceSpec {
+func createDevice(require *require.Assertions, fileMap map[string]string) *v1beta1.Device {
 	files := []v1beta1.FileSpec{}
 	for path, data := range fileMap {
 		files = append(files, v1beta1.FileSpec{
@@ -174,8 +268,10 @@ func createDeviceSpec(require *require.Assertions, fileMap map[string]string) *v
 	config, err := config.FilesToProviderSpec(files)
 	require.NoError(err)
 
-	return &v1beta1.DeviceSpec{
-		Config: config,
+	return &v1beta1.Device{
+		Spec: &v1beta1.DeviceSpec{
+			Config: config,
+		},
 	}
 }
 
@@ -196,3 +292,20 @@ func expectExecCalls(mockExecuter *executer.MockExecuter, expectedCommands []com
 			}).Times(0)
 	}
 }
+
+const testHookFailing = `
+- run: test -f /run/battery-ok
+- run: "true"
+`
+
+const testHookFailingAbort = `
+- run: test -f /run/battery-ok
+  onFailure: abort
+- run: "true"
+`
+
+const testHookFailingIgnore = `
+- run: test -f /run/battery-ok
+  onFailure: ignore
+- run: "true"
+`
@@ -55,7 +55,7 @@ func (mr *MockManagerMockRecorder) OnAfterRebooting(ctx any) *gomock.Call {
 }
 
 // OnAfterUpdating mocks base method.
-func (m *MockManager) OnAfterUpdating(ctx context.Context, current, desired *v1beta1.DeviceSpec, systemRebooted bool) error {
+func (m *MockManager) OnAfterUpdating(ctx context.Context, current, desired *v1beta1.Device, systemRebooted bool) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OnAfterUpdating", ctx, current, desired, systemRebooted)
 	ret0, _ := ret[0].(error)
@@ -83,7 +83,7 @@ func (mr *MockManagerMockRecorder) OnBeforeRebooting(ctx any) *gomock.Call {
 }
 
 // OnBeforeUpdating mocks base method.
-func (m *MockManager) OnBeforeUpdating(ctx context.Context, current, desired *v1beta1.DeviceSpec) error {
+func (m *MockManager) OnBeforeUpdating(ctx context.Context, current, desired *v1beta1.Device) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "OnBeforeUpdating", ctx, current, desired)
 	ret0, _ := ret[0].(error)
@@ -0,0 +1,126 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/client"
+	"github.com/flightctl/flightctl/internal/agent/device/applications/provider"
+	"github.com/flightctl/flightctl/internal/agent/device/errors"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/samber/lo"
+)
+
+const (
+	// PristineConfigDir is where image based operating systems keep the agent configuration
+	// shipped with the image, from which /etc/flightctl was initialized.
+	PristineConfigDir = "/usr/etc/flightctl"
+	// configDropInDirName is the agent's configuration drop-in directory below the config dir.
+	configDropInDirName = "conf.d"
+)
+
+// FactoryResetFunc performs one step of a factory reset. current is the spec the device was
+// running when it was decommissioned.
+type FactoryResetFunc func(ctx context.Context, current *v1beta1.DeviceSpec) error
+
+type factoryResetStep struct {
+	name string
+	fn   FactoryResetFunc
+}
+
+// RegisterFactoryResetStep registers a step that runs when the device is decommissioned with the
+// FactoryReset target. Steps run in the order they were registered, before the agent reports the
+// completion of decommissioning and wipes its identity.
+func (m *LifecycleManager) RegisterFactoryResetStep(name string, fn FactoryResetFunc) {
+	m.factoryResetSteps = append(m.factoryResetSteps, factoryResetStep{name: name, fn: fn})
+}
+
+// factoryReset runs all registered steps and reports each one through the decommissioning
+// condition. A failing step does not stop the remaining ones so that as much as possible is
+// removed from the device.
+func (m *LifecycleManager) factoryReset(ctx context.Context, current *v1beta1.DeviceSpec) []error {
+	var errs []error
+	for i, step := range m.factoryResetSteps {
+		message := fmt.Sprintf("Factory reset step %d of %d: %s", i+1, len(m.factoryResetSteps), step.name)
+		m.log.Warn(message)
+		if err := m.updateWithInProgressCondition(ctx, message); err != nil {
+			m.log.Warnf("Unable to update Condition to factory reset progress: %v", err)
+		}
+		if err := step.fn(ctx, current); err != nil {
+			m.log.Errorf("Factory reset step %q failed: %v", step.name, err)
+			errs = append(errs, fmt.Errorf("%s: %w", step.name, err))
+		}
+	}
+	return errs
+}
+
+func (m *LifecycleManager) updateWithInProgressCondition(ctx context.Context, message string) error {
+	updateErr := m.statusManager.UpdateCondition(ctx, v1beta1.Condition{
+		Type:    v1beta1.ConditionTypeDeviceDecommissioning,
+		Status:  v1beta1.ConditionStatusTrue,
+		Reason:  string(v1beta1.DecommissionStateInProgress),
+		Message: message,
+	})
+	if updateErr != nil {
+		return fmt.Errorf("failed to update decommission in progress status: %w", updateErr)
+	}
+	return nil
+}
+
+// PruneContainerStorage removes all images and volumes that are no longer used by a container,
+// for root and for every user that ran one of the applications in current.
+func PruneContainerStorage(ctx context.Context, podmanFactory client.PodmanFactory, current *v1beta1.DeviceSpec, log *log.PrefixLogger) error {
+	users := []v1beta1.Username{v1beta1.CurrentProcessUsername}
+	if current != nil && current.Applications != nil {
+		for i := range *current.Applications {
+			user, err := provider.ResolveUser(&(*current.Applications)[i])
+			if err != nil {
+				log.Warnf("Unable to resolve the user of an application: %v", err)
+				continue
+			}
+			if !lo.Contains(users, user) {
+				users = append(users, user)
+			}
+		}
+	}
+
+	var errs []error
+	for _, user := range users {
+		podman, err := podmanFactory(user)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("podman client for user %q: %w", user, err))
+			continue
+		}
+		if err := podman.PruneVolumes(ctx); err != nil {
+			errs = append(errs, err)
+		}
+		if err := podman.PruneImages(ctx); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ResetAgentConfig removes the agent's configuration drop-ins and restores the configuration
+// shipped with the OS image, if the image provides one. Without it config.yaml is kept, as the
+// agent could not enroll again otherwise.
+func ResetAgentConfig(rw fileio.ReadWriter, configDir string, log *log.PrefixLogger) error {
+	if err := rw.RemoveAll(filepath.Join(configDir, configDropInDirName)); err != nil {
+		return fmt.Errorf("removing configuration drop-ins: %w", err)
+	}
+	exists, err := rw.PathExists(PristineConfigDir)
+	if err != nil {
+		return fmt.Errorf("checking %s: %w", PristineConfigDir, err)
+	}
+	if !exists {
+		log.Warnf("The OS image does not provide %s, keeping the current agent configuration file", PristineConfigDir)
+		return nil
+	}
+	if err := rw.CopyDir(PristineConfigDir, configDir); err != nil {
+		return fmt.Errorf("restoring agent configuration from %s: %w", PristineConfigDir, err)
+	}
+	return nil
+}
@@ -0,0 +1,92 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/internal/agent/device/status"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLifecycleManager_factoryReset(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockStatusManager := status.NewMockManager(ctrl)
+
+	var messages []string
+	mockStatusManager.EXPECT().UpdateCondition(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, condition v1beta1.Condition) error {
+			require.Equal(t, v1beta1.ConditionTypeDeviceDecommissioning, condition.Type)
+			require.Equal(t, string(v1beta1.DecommissionStateInProgress), condition.Reason)
+			messages = append(messages, condition.Message)
+			return nil
+		}).Times(3)
+
+	m := &LifecycleManager{statusManager: mockStatusManager, log: log.NewPrefixLogger("test")}
+	current := &v1beta1.DeviceSpec{}
+	var ran []string
+	step := func(name string, err error) FactoryResetFunc {
+		return func(_ context.Context, spec *v1beta1.DeviceSpec) error {
+			require.Same(t, current, spec)
+			ran = append(ran, name)
+			return err
+		}
+	}
+	m.RegisterFactoryResetStep("first", step("first", nil))
+	m.RegisterFactoryResetStep("second", step("second", errors.New("boom")))
+	m.RegisterFactoryResetStep("third", step("third", nil))
+
+	errs := m.factoryReset(context.Background(), current)
+	require.Equal(t, []string{"first", "second", "third"}, ran)
+	require.Equal(t, []string{
+		"Factory reset step 1 of 3: first",
+		"Factory reset step 2 of 3: second",
+		"Factory reset step 3 of 3: third",
+	}, messages)
+	require.Len(t, errs, 1)
+	require.ErrorContains(t, errs[0], "second: boom")
+}
+
+func TestResetAgentConfig(t *testing.T) {
+	writeFile := func(t *testing.T, path, content string) {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	t.Run("restores the configuration shipped with the image", func(t *testing.T) {
+		root := t.TempDir()
+		rw := fileio.NewReadWriter(fileio.NewReader(fileio.WithReaderRootDir(root)), fileio.NewWriter(fileio.WithWriterRootDir(root)))
+		configDir := "/etc/flightctl"
+		writeFile(t, filepath.Join(root, configDir, "config.yaml"), "changed")
+		writeFile(t, filepath.Join(root, configDir, "conf.d", "override.yaml"), "override")
+		writeFile(t, filepath.Join(root, PristineConfigDir, "config.yaml"), "pristine")
+
+		require.NoError(t, ResetAgentConfig(rw, configDir, log.NewPrefixLogger("test")))
+
+		content, err := os.ReadFile(filepath.Join(root, configDir, "config.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, "pristine", string(content))
+		require.NoDirExists(t, filepath.Join(root, configDir, "conf.d"))
+	})
+
+	t.Run("keeps config.yaml without a pristine configuration", func(t *testing.T) {
+		root := t.TempDir()
+		rw := fileio.NewReadWriter(fileio.NewReader(fileio.WithReaderRootDir(root)), fileio.NewWriter(fileio.WithWriterRootDir(root)))
+		configDir := "/etc/flightctl"
+		writeFile(t, filepath.Join(root, configDir, "config.yaml"), "current")
+		writeFile(t, filepath.Join(root, configDir, "conf.d", "override.yaml"), "override")
+
+		require.NoError(t, ResetAgentConfig(rw, configDir, log.NewPrefixLogger("test")))
+
+		content, err := os.ReadFile(filepath.Join(root, configDir, "config.yaml"))
+		require.NoError(t, err)
+		require.Equal(t, "current", string(content))
+		require.NoDirExists(t, filepath.Join(root, configDir, "conf.d"))
+	})
+}
@@ -55,6 +55,8 @@ type LifecycleManager struct {
 	statusManager       status.Manager
 	systemdClient       *client.Systemd
 	identityProvider    identity.Provider
+	tpmClient           tpm.Client
+	factoryResetSteps   []factoryResetStep
 
 	backoff wait.Backoff
 	log     *log.PrefixLogger
@@ -76,6 +78,7 @@ func NewManager(
 	statusManager status.Manager,
 	systemdClient *client.Systemd,
 	identityProvider identity.Provider,
+	tpmClient tpm.Client,
 	backoff wait.Backoff,
 	log *log.PrefixLogger,
 ) *LifecycleManager {
@@ -96,6 +99,7 @@ func NewManager(
 		statusManager:        statusManager,
 		systemdClient:        systemdClient,
 		identityProvider:     identityProvider,
+		tpmClient:            tpmClient,
 	}
 }
 
@@ -138,8 +142,11 @@ func (m *LifecycleManager) AfterUpdate(ctx context.Context, current, desired *v1
 			m.log.Warn("Unable to update Condition to decommissioning started")
 		}
 
-		// TODO: add support for additional decommissioning target types.
-		// these are the steps that will take places between Started and Completed status
+		factoryReset := desired.Decommissioning.Target == v1beta1.DeviceDecommissionTargetTypeFactoryReset
+		if factoryReset {
+			m.log.Warn("Performing factory reset")
+			errs = append(errs, m.factoryReset(ctx, current)...)
+		}
 
 		if len(errs) == 0 {
 			m.log.Warn("No errors during decommissioning prior to wiping key and cert; updating Condition to decommissioning completed")
@@ -157,7 +164,7 @@ func (m *LifecycleManager) AfterUpdate(ctx context.Context, current, desired *v1
 
 		// after this point the device will no longer be able to communicate with the management service
 		m.log.Warn("Preparing to wipe agent certificate and keys and reboot")
-		if err := m.wipeAndReboot(ctx); err != nil {
+		if err := m.wipeAndReboot(ctx, factoryReset); err != nil {
 			errs = append(errs, err)
 		}
 	}
@@ -209,8 +216,9 @@ func (m *LifecycleManager) updateWithErrorCondition(ctx context.Context, errs []
 	return nil
 }
 
-// point of no return - wipes management cert and keys
-func (m *LifecycleManager) wipeAndReboot(ctx context.Context) error {
+// point of no return - wipes management cert and keys. A factory reset also clears all keys held
+// by the TPM.
+func (m *LifecycleManager) wipeAndReboot(ctx context.Context, factoryReset bool) error {
 	var errs []error
 
 	// Use identity provider to wipe credentials securely
@@ -219,6 +227,14 @@ func (m *LifecycleManager) wipeAndReboot(ctx context.Context) error {
 		errs = append(errs, fmt.Errorf("failed to wipe credentials via identity provider: %w", err))
 	}
 
+	if factoryReset && m.tpmClient != nil {
+		m.log.Warn("Clearing TPM keys during factory reset")
+		if err := m.tpmClient.Clear(); err != nil {
+			m.log.Errorf("Failed to clear TPM: %v", err)
+			errs = append(errs, fmt.Errorf("failed to clear TPM: %w", err))
+		}
+	}
+
 	// Clear sensitive data ahead of time in case reboot fails
 	m.deviceName = ""
 	m.enrollmentUIEndpoint = ""
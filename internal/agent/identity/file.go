@@ -50,7 +50,7 @@ type softwareExportableProvider struct {
 func newSoftwareExportableProvider() *softwareExportableProvider {
 	return &softwareExportableProvider{}
 }
-func (f *softwareExportableProvider) NewExportable(name string) (*Exportable, error) {
+func (f *softwareExportableProvider) NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error) {
 	_, priv, err := fccrypto.NewKeyPair()
 	if err != nil {
 		return nil, fmt.Errorf("creating key pair: %q: %w", name, err)
@@ -60,7 +60,7 @@ func (f *softwareExportableProvider) NewExportable(name string) (*Exportable, er
 		return nil, fmt.Errorf("expected crypto.Signer, got %T", priv)
 	}
 
-	csr, err := fccrypto.MakeCSR(signer, name)
+	csr, err := fccrypto.MakeCSR(signer, name, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("creating CSR: %w", err)
 	}
@@ -63,8 +63,9 @@ func (e *Exportable) KeyPEM() ([]byte, error) {
 
 // ExportableProvider defines the interface for providing Exportable identities
 type ExportableProvider interface {
-	// NewExportable creates an Exportable for the specified name
-	NewExportable(name string) (*Exportable, error)
+	// NewExportable creates an Exportable for the specified name. The options customize
+	// the CSR, e.g. with subject alternative names, and may not be supported by all providers.
+	NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error)
 }
 
 // Provider defines the interface for identity providers that handle device authentication.
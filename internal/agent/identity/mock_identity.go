@@ -17,6 +17,7 @@ import (
 	grpc_v1 "github.com/flightctl/flightctl/api/grpc/v1"
 	client "github.com/flightctl/flightctl/internal/agent/client"
 	client0 "github.com/flightctl/flightctl/internal/client"
+	crypto "github.com/flightctl/flightctl/pkg/crypto"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -44,18 +45,23 @@ func (m *MockExportableProvider) EXPECT() *MockExportableProviderMockRecorder {
 }
 
 // NewExportable mocks base method.
-func (m *MockExportableProvider) NewExportable(name string) (*Exportable, error) {
+func (m *MockExportableProvider) NewExportable(name string, opts ...crypto.CSROption) (*Exportable, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "NewExportable", name)
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "NewExportable", varargs...)
 	ret0, _ := ret[0].(*Exportable)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // NewExportable indicates an expected call of NewExportable.
-func (mr *MockExportableProviderMockRecorder) NewExportable(name any) *gomock.Call {
+func (mr *MockExportableProviderMockRecorder) NewExportable(name any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExportable", reflect.TypeOf((*MockExportableProvider)(nil).NewExportable), name)
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewExportable", reflect.TypeOf((*MockExportableProvider)(nil).NewExportable), varargs...)
 }
 
 // MockProvider is a mock of Provider interface.
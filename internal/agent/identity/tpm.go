@@ -20,6 +20,7 @@ import (
 	agent_client "github.com/flightctl/flightctl/internal/api/client/agent"
 	base_client "github.com/flightctl/flightctl/internal/client"
 	"github.com/flightctl/flightctl/internal/tpm"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/flightctl/flightctl/pkg/log"
 	"github.com/samber/lo"
 	"google.golang.org/grpc"
@@ -72,7 +73,10 @@ func newTPMExportableProvider(client tpm.Client) *tpmExportableProvider {
 	}
 }
 
-func (t *tpmExportableProvider) NewExportable(name string) (*Exportable, error) {
+func (t *tpmExportableProvider) NewExportable(name string, opts ...fccrypto.CSROption) (*Exportable, error) {
+	if len(opts) > 0 {
+		return nil, fmt.Errorf("creating application identity: %q: CSR options are not supported for TPM-backed keys", name)
+	}
 	csr, keyPem, err := t.client.CreateApplicationKey(name)
 	if err != nil {
 		return nil, fmt.Errorf("creating application identity: %q: %w", name, err)
@@ -0,0 +1,9 @@
+package client
+
+import agentv1beta1 "github.com/flightctl/flightctl/api/agent/v1beta1"
+
+// The generated client takes its types from the core API. Operations that only exist in the
+// agent API have request body types that the core API lacks, so they are aliased here.
+
+// CreateDeviceAttestationJSONRequestBody defines body for CreateDeviceAttestation for application/json ContentType.
+type CreateDeviceAttestationJSONRequestBody = agentv1beta1.CreateDeviceAttestationJSONRequestBody
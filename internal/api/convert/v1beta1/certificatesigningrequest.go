@@ -10,6 +10,7 @@ type CertificateSigningRequestConverter interface {
 	ToDomain(apiv1beta1.CertificateSigningRequest) domain.CertificateSigningRequest
 	FromDomain(*domain.CertificateSigningRequest) *apiv1beta1.CertificateSigningRequest
 	ListFromDomain(*domain.CertificateSigningRequestList) *apiv1beta1.CertificateSigningRequestList
+	RevocationListFromDomain(*domain.CertificateRevocationList) *apiv1beta1.CertificateRevocationList
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListCertificateSigningRequestsParams) domain.ListCertificateSigningRequestsParams
@@ -34,6 +35,10 @@ func (c *certificateSigningRequestConverter) ListFromDomain(l *domain.Certificat
 	return l
 }
 
+func (c *certificateSigningRequestConverter) RevocationListFromDomain(l *domain.CertificateRevocationList) *apiv1beta1.CertificateRevocationList {
+	return l
+}
+
 func (c *certificateSigningRequestConverter) ListParamsToDomain(p apiv1beta1.ListCertificateSigningRequestsParams) domain.ListCertificateSigningRequestsParams {
 	return p
 }
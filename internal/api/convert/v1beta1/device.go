@@ -14,9 +14,13 @@ type DeviceConverter interface {
 
 	// Operation types
 	DecommissionToDomain(apiv1beta1.DeviceDecommission) domain.DeviceDecommission
+	CertificateRevocationRequestToDomain(apiv1beta1.DeviceCertificateRevocationRequest) domain.DeviceCertificateRevocationRequest
 	ResumeRequestToDomain(apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest
 	ResumeResponseFromDomain(domain.DeviceResumeResponse) apiv1beta1.DeviceResumeResponse
 	LastSeenFromDomain(*domain.DeviceLastSeen) *apiv1beta1.DeviceLastSeen
+	AttestationChallengeFromDomain(*domain.AttestationChallenge) *apiv1beta1.AttestationChallenge
+	AttestationQuoteToDomain(apiv1beta1.AttestationQuote) domain.AttestationQuote
+	IntegrityStatusFromDomain(*domain.DeviceIntegrityStatus) *apiv1beta1.DeviceIntegrityStatus
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListDevicesParams) domain.ListDevicesParams
@@ -46,6 +50,10 @@ func (c *deviceConverter) DecommissionToDomain(d apiv1beta1.DeviceDecommission)
 	return d
 }
 
+func (c *deviceConverter) CertificateRevocationRequestToDomain(r apiv1beta1.DeviceCertificateRevocationRequest) domain.DeviceCertificateRevocationRequest {
+	return r
+}
+
 func (c *deviceConverter) ResumeRequestToDomain(r apiv1beta1.DeviceResumeRequest) domain.DeviceResumeRequest {
 	return r
 }
@@ -58,6 +66,18 @@ func (c *deviceConverter) LastSeenFromDomain(l *domain.DeviceLastSeen) *apiv1bet
 	return l
 }
 
+func (c *deviceConverter) AttestationChallengeFromDomain(ch *domain.AttestationChallenge) *apiv1beta1.AttestationChallenge {
+	return ch
+}
+
+func (c *deviceConverter) AttestationQuoteToDomain(q apiv1beta1.AttestationQuote) domain.AttestationQuote {
+	return q
+}
+
+func (c *deviceConverter) IntegrityStatusFromDomain(i *domain.DeviceIntegrityStatus) *apiv1beta1.DeviceIntegrityStatus {
+	return i
+}
+
 func (c *deviceConverter) ListParamsToDomain(p apiv1beta1.ListDevicesParams) domain.ListDevicesParams {
 	return p
 }
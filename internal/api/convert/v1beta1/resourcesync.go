@@ -10,6 +10,7 @@ type ResourceSyncConverter interface {
 	ToDomain(apiv1beta1.ResourceSync) domain.ResourceSync
 	FromDomain(*domain.ResourceSync) *apiv1beta1.ResourceSync
 	ListFromDomain(*domain.ResourceSyncList) *apiv1beta1.ResourceSyncList
+	PlanFromDomain(*domain.ResourceSyncPlan) *apiv1beta1.ResourceSyncPlan
 
 	// Params conversions
 	ListParamsToDomain(apiv1beta1.ListResourceSyncsParams) domain.ListResourceSyncsParams
@@ -34,6 +35,10 @@ func (c *resourceSyncConverter) ListFromDomain(l *domain.ResourceSyncList) *apiv
 	return l
 }
 
+func (c *resourceSyncConverter) PlanFromDomain(p *domain.ResourceSyncPlan) *apiv1beta1.ResourceSyncPlan {
+	return p
+}
+
 func (c *resourceSyncConverter) ListParamsToDomain(p apiv1beta1.ListResourceSyncsParams) domain.ListResourceSyncsParams {
 	return p
 }
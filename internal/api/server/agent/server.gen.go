@@ -24,6 +24,12 @@ type ServerInterface interface {
 	// (GET /certificatesigningrequests/{name})
 	GetCertificateSigningRequest(w http.ResponseWriter, r *http.Request, name string)
 
+	// (POST /devices/{name}/attestation)
+	CreateDeviceAttestation(w http.ResponseWriter, r *http.Request, name string)
+
+	// (GET /devices/{name}/attestation/challenge)
+	GetDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request, name string)
+
 	// (GET /devices/{name}/rendered)
 	GetRenderedDevice(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceParams)
 
@@ -54,6 +60,16 @@ func (_ Unimplemented) GetCertificateSigningRequest(w http.ResponseWriter, r *ht
 	w.WriteHeader(http.StatusNotImplemented)
 }
 
+// (POST /devices/{name}/attestation)
+func (_ Unimplemented) CreateDeviceAttestation(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
+// (GET /devices/{name}/attestation/challenge)
+func (_ Unimplemented) GetDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	w.WriteHeader(http.StatusNotImplemented)
+}
+
 // (GET /devices/{name}/rendered)
 func (_ Unimplemented) GetRenderedDevice(w http.ResponseWriter, r *http.Request, name string, params GetRenderedDeviceParams) {
 	w.WriteHeader(http.StatusNotImplemented)
@@ -127,6 +143,56 @@ func (siw *ServerInterfaceWrapper) GetCertificateSigningRequest(w http.ResponseW
 	handler.ServeHTTP(w, r)
 }
 
+// CreateDeviceAttestation operation middleware
+func (siw *ServerInterfaceWrapper) CreateDeviceAttestation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateDeviceAttestation(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetDeviceAttestationChallenge operation middleware
+func (siw *ServerInterfaceWrapper) GetDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", chi.URLParam(r, "name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetDeviceAttestationChallenge(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetRenderedDevice operation middleware
 func (siw *ServerInterfaceWrapper) GetRenderedDevice(w http.ResponseWriter, r *http.Request) {
 
@@ -371,6 +437,12 @@ func HandlerWithOptions(si ServerInterface, options ChiServerOptions) http.Handl
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/certificatesigningrequests/{name}", wrapper.GetCertificateSigningRequest)
 	})
+	r.Group(func(r chi.Router) {
+		r.Post(options.BaseURL+"/devices/{name}/attestation", wrapper.CreateDeviceAttestation)
+	})
+	r.Group(func(r chi.Router) {
+		r.Get(options.BaseURL+"/devices/{name}/attestation/challenge", wrapper.GetDeviceAttestationChallenge)
+	})
 	r.Group(func(r chi.Router) {
 		r.Get(options.BaseURL+"/devices/{name}/rendered", wrapper.GetRenderedDevice)
 	})
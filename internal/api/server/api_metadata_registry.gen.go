@@ -12,6 +12,7 @@ const (
 	API_RESOURCE_CATALOGITEMS = "catalogitems"
 	API_RESOURCE_CATALOGS = "catalogs"
 	API_RESOURCE_CATALOGS_ITEMS = "catalogs/items"
+	API_RESOURCE_CERTIFICATEREVOCATIONS = "certificaterevocations"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS = "certificatesigningrequests"
 	API_RESOURCE_CERTIFICATESIGNINGREQUESTS_APPROVAL = "certificatesigningrequests/approval"
 	API_RESOURCE_DEVICES = "devices"
@@ -20,8 +21,10 @@ const (
 	API_RESOURCE_DEVICES_CONSOLE = "devices/console"
 	API_RESOURCE_DEVICES_DECOMMISSION = "devices/decommission"
 	API_RESOURCE_DEVICES_LASTSEEN = "devices/lastseen"
+	API_RESOURCE_DEVICES_PORTFORWARD = "devices/portforward"
 	API_RESOURCE_DEVICES_RENDERED = "devices/rendered"
 	API_RESOURCE_DEVICES_RESUME = "devices/resume"
+	API_RESOURCE_DEVICES_REVOKECERTIFICATES = "devices/revokecertificates"
 	API_RESOURCE_DEVICES_STATUS = "devices/status"
 	API_RESOURCE_ENROLLMENTCONFIG = "enrollmentconfig"
 	API_RESOURCE_ENROLLMENTREQUESTS = "enrollmentrequests"
@@ -38,6 +41,7 @@ const (
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_IMAGE = "repositories/check-oci-image"
 	API_RESOURCE_REPOSITORIES_CHECK_OCI_TAG = "repositories/check-oci-tag"
 	API_RESOURCE_RESOURCESYNCS = "resourcesyncs"
+	API_RESOURCE_RESOURCESYNCS_PLAN = "resourcesyncs/plan"
 	API_RESOURCE_VERSION = "version"
 	API_RESOURCE_VULNERABILITIES = "vulnerabilities"
 )
@@ -279,6 +283,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1alpha1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/certificaterevocations": {
+		OperationID: "listCertificateRevocations",
+		Resource:    "certificaterevocations",
+		Action:      "list",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/certificatesigningrequests": {
 		OperationID: "listCertificateSigningRequests",
 		Resource:    "certificatesigningrequests",
@@ -439,6 +451,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/devices/{name}/revokecertificates": {
+		OperationID: "revokeDeviceCertificates",
+		Resource:    "devices/revokecertificates",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/devices/{name}/status": {
 		OperationID: "getDeviceStatus",
 		Resource:    "devices/status",
@@ -799,6 +819,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"POST:/resourcesyncs/{name}/plan": {
+		OperationID: "planResourceSync",
+		Resource:    "resourcesyncs/plan",
+		Action:      "create",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 	"GET:/version": {
 		OperationID: "getVersion",
 		Resource:    "version",
@@ -879,6 +907,14 @@ var APIMetadataMap = map[string]*apimetadata.EndpointMetadata{
 			{Version: "v1beta1", DeprecatedAt: nil},
 		},
 	},
+	"GET:/ws/v1/devices/{name}/portforward": {
+		OperationID: "getDevicePortForward",
+		Resource:    "devices/portforward",
+		Action:      "get",
+		Versions: []apimetadata.EndpointMetadataVersion{
+			{Version: "v1beta1", DeprecatedAt: nil},
+		},
+	},
 }
 
 // MetadataResolver provides lookup for endpoint metadata.
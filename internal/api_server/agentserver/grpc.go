@@ -163,6 +163,13 @@ func (s *AgentGrpcServer) Stream(stream pb.RouterService_StreamServer) error {
 
 			selectedProtocols := md.Get(consts.GrpcSelectedProtocolKey)
 			if len(selectedProtocols) != 1 {
+				if agentErrs := md.Get(consts.GrpcSessionErrorKey); len(agentErrs) == 1 && agentErrs[0] != "" {
+					s.log.Infof("client %s reported session-level failure before protocol selection for session %s", clientName, sessionId)
+					select {
+					case otherSideSession.ErrCh <- agentErrs[0]:
+					default:
+					}
+				}
 				close(otherSideSession.ProtocolCh)
 				return status.Error(codes.InvalidArgument, "missing "+consts.GrpcSelectedProtocolKey)
 			}
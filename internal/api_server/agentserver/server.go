@@ -22,11 +22,14 @@ import (
 	"github.com/flightctl/flightctl/internal/healthchecker"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service"
+	certificaterevocationservice "github.com/flightctl/flightctl/internal/service/certificaterevocation"
 	certificatesigningrequestservice "github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	deviceattestationservice "github.com/flightctl/flightctl/internal/service/deviceattestation"
 	enrollmentrequestservice "github.com/flightctl/flightctl/internal/service/enrollmentrequest"
 	"github.com/flightctl/flightctl/internal/service/events"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	enrollmentrequeststore "github.com/flightctl/flightctl/internal/store/enrollmentrequest"
@@ -57,6 +60,8 @@ type AgentServer struct {
 	deviceSvc                deviceservice.Service
 	enrollmentRequestSvc     enrollmentrequestservice.Service
 	csrSvc                   certificatesigningrequestservice.Service
+	revocationSvc            certificaterevocationservice.Service
+	attestationSvc           deviceattestationservice.Service
 	catalogStore             catalogstore.Store
 	organizationStore        organizationstore.Store
 	kvStore                  kvstore.KVStore
@@ -100,6 +105,7 @@ func (s *AgentServer) init(ctx context.Context) error {
 
 	deviceStore := devicestore.NewDeviceStore(s.db, s.log.WithField("pkg", "device-store"))
 	fleetStore := fleetstore.NewFleetStore(s.db, s.log.WithField("pkg", "fleet-store"))
+	revocationStore := certificaterevocationstore.NewCertificateRevocationStore(s.db, s.log.WithField("pkg", "certificate-revocation-store"))
 	enrollmentRequestStore := enrollmentrequeststore.NewEnrollmentRequestStore(s.db, s.log.WithField("pkg", "enrollmentrequest-store"))
 	csrStore := certificatesigningrequeststore.NewCertificateSigningRequestStore(s.db, s.log.WithField("pkg", "csr-store"))
 	eventStore := eventstore.NewEventStore(s.db, s.log.WithField("pkg", "event-store"))
@@ -112,7 +118,7 @@ func (s *AgentServer) init(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	s.kvStore, err = kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	s.kvStore, err = kvstore.New(ctx, s.log, s.cfg)
 	if err != nil {
 		return err
 	}
@@ -121,11 +127,15 @@ func (s *AgentServer) init(ctx context.Context) error {
 	eventsSvc := events.NewServiceHandler(eventStore, workerClient, s.log)
 
 	s.deviceSvc = deviceservice.WrapWithTracing(
-		deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, eventsSvc, s.kvStore, s.cfg.Service.AgentEndpointAddress, s.log))
+		deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, revocationStore, eventsSvc, s.kvStore, s.cfg.Service.AgentEndpointAddress, s.log))
 	s.enrollmentRequestSvc = enrollmentrequestservice.WrapWithTracing(
 		enrollmentrequestservice.NewServiceHandler(enrollmentRequestStore, deviceStore, csrStore, s.ca, s.kvStore, eventsSvc, s.log, s.cfg.Service.TPMCAPaths, s.cfg.Service.AgentEndpointAddress, s.cfg.Service.BaseUIUrl))
 	s.csrSvc = certificatesigningrequestservice.WrapWithTracing(
 		certificatesigningrequestservice.NewServiceHandler(csrStore, enrollmentRequestStore, s.ca, eventsSvc, s.log, s.cfg.Service.AgentEndpointAddress, s.cfg.Service.BaseUIUrl))
+	s.revocationSvc = certificaterevocationservice.WrapWithTracing(
+		certificaterevocationservice.NewServiceHandler(revocationStore, deviceStore, s.ca, s.log))
+	s.attestationSvc = deviceattestationservice.WrapWithTracing(
+		deviceattestationservice.NewServiceHandler(deviceStore, fleetStore, s.kvStore, eventsSvc, s.log))
 
 	s.agentGrpcServer = NewAgentGrpcServer(s.log, s.cfg, s.enrollmentRequestSvc)
 	return nil
@@ -247,7 +257,7 @@ func isEnrollmentRequest(r *http.Request) bool {
 
 func (s *AgentServer) prepareHTTPHandler(ctx context.Context) (http.Handler, error) {
 	// Create agent authentication middleware for device operations
-	s.agentAuthMiddleware = fcmiddleware.NewAgentAuthMiddleware(s.ca, s.log)
+	s.agentAuthMiddleware = fcmiddleware.NewAgentAuthMiddleware(s.ca, s.revocationSvc, s.log)
 	go s.agentAuthMiddleware.Start()
 
 	// Create enrollment authentication middleware for enrollment/bootstrap operations
@@ -320,7 +330,7 @@ func (s *AgentServer) prepareHTTPHandler(ctx context.Context) (http.Handler, err
 	// Create versioning infrastructure
 	negotiator := versioning.NewNegotiator(versioning.V1Beta1, apimetaserver.MetadataResolver)
 
-	handlerV1Beta1 := agenttransportv1beta1.NewAgentTransportHandler(s.deviceSvc, s.enrollmentRequestSvc, s.csrSvc, convertv1beta1.NewConverter(), s.ca, s.log)
+	handlerV1Beta1 := agenttransportv1beta1.NewAgentTransportHandler(s.deviceSvc, s.enrollmentRequestSvc, s.csrSvc, s.attestationSvc, convertv1beta1.NewConverter(), s.ca, s.log)
 
 	// Create version-specific router with OpenAPI validation
 	agentV1Beta1Swagger, err := agentv1beta1.GetSwagger()
@@ -0,0 +1,28 @@
+package apiserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/flightctl/flightctl/internal/service/certificaterevocation"
+)
+
+// CRLPath is where the revocation list of certificates issued by the service CA is published.
+// It is served without authentication so that other services, like the telemetry gateway, can
+// check the client certificates they are presented.
+const CRLPath = "/crl"
+
+// CRLHandler returns an HTTP handler that serves the DER-encoded certificate revocation list.
+func CRLHandler(revocationSvc certificaterevocation.Service) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		crl, status := revocationSvc.GetCertificateRevocationList(r.Context())
+		if status.Code != http.StatusOK {
+			http.Error(w, status.Message, int(status.Code))
+			return
+		}
+		w.Header().Set("Content-Type", "application/pkix-crl")
+		w.Header().Set("Content-Length", strconv.Itoa(len(crl)))
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(crl)
+	})
+}
@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"fmt"
 	"net/http"
 	"time"
@@ -11,6 +12,7 @@ import (
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/crypto/signer"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/org"
 	"github.com/flightctl/flightctl/internal/transport"
@@ -18,36 +20,55 @@ import (
 	"github.com/sirupsen/logrus"
 )
 
+// revocationCacheTTL bounds how long a revoked certificate may still be accepted.
+const revocationCacheTTL = 30 * time.Second
+
+// RevocationChecker reports whether a client certificate has been revoked.
+type RevocationChecker interface {
+	IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (bool, domain.Status)
+}
+
 // AgentAuthMiddleware handles certificate-based authentication for device agents.
 // It authenticates agents presenting a device management client certificate issued by either:
 // - the initial device management signer, or
 // - the device management renewal signer (for rotated certs).
+// Certificates that have been revoked are rejected.
 type AgentAuthMiddleware struct {
-	ca    *crypto.CAClient
-	log   logrus.FieldLogger
-	cache *ttlcache.Cache[string, *AgentIdentity]
+	ca                *crypto.CAClient
+	revocationChecker RevocationChecker
+	log               logrus.FieldLogger
+	cache             *ttlcache.Cache[string, *AgentIdentity]
+	revocationCache   *ttlcache.Cache[string, bool]
 }
 
-// NewAgentAuthMiddleware creates a new device agent authentication middleware
-func NewAgentAuthMiddleware(ca *crypto.CAClient, log logrus.FieldLogger) *AgentAuthMiddleware {
+// NewAgentAuthMiddleware creates a new device agent authentication middleware.
+// revocationChecker may be nil, in which case certificates are not checked for revocation.
+func NewAgentAuthMiddleware(ca *crypto.CAClient, revocationChecker RevocationChecker, log logrus.FieldLogger) *AgentAuthMiddleware {
 	cache := ttlcache.New(
 		ttlcache.WithTTL[string, *AgentIdentity](10 * time.Minute),
 	)
+	revocationCache := ttlcache.New(
+		ttlcache.WithTTL[string, bool](revocationCacheTTL),
+	)
 
 	return &AgentAuthMiddleware{
-		ca:    ca,
-		log:   log,
-		cache: cache,
+		ca:                ca,
+		revocationChecker: revocationChecker,
+		log:               log,
+		cache:             cache,
+		revocationCache:   revocationCache,
 	}
 }
 
 // Start starts the cache background cleanup
 func (m *AgentAuthMiddleware) Start() {
+	go m.revocationCache.Start()
 	m.cache.Start()
 }
 
 // Stop stops the cache background cleanup
 func (m *AgentAuthMiddleware) Stop() {
+	m.revocationCache.Stop()
 	m.cache.Stop()
 }
 
@@ -65,6 +86,24 @@ func (m *AgentAuthMiddleware) AuthenticateAgent(next http.Handler) http.Handler
 
 		// Create cache key from certificate fingerprint
 		cacheKey := m.createCacheKey(r.TLS)
+
+		// Check revocation on every request, as the identity cache would otherwise keep
+		// accepting a revoked certificate
+		if cacheKey != "" {
+			revoked, err := m.isRevoked(ctx, cacheKey, r.TLS.PeerCertificates[0])
+			if err != nil {
+				m.log.Errorf("Failed to check agent certificate revocation: %v", err)
+				transport.WriteJSONError(w, r, "failed to check certificate revocation", http.StatusServiceUnavailable)
+				return
+			}
+			if revoked {
+				m.log.Warnf("Rejecting revoked agent certificate: serial=%x", r.TLS.PeerCertificates[0].SerialNumber)
+				m.cache.Delete(cacheKey)
+				transport.WriteJSONError(w, r, "client certificate has been revoked", http.StatusUnauthorized)
+				return
+			}
+		}
+
 		if cacheKey != "" {
 			// Check cache first
 			if item := m.cache.Get(cacheKey); item != nil {
@@ -163,6 +202,23 @@ func (m *AgentAuthMiddleware) AuthenticateAgent(next http.Handler) http.Handler
 	})
 }
 
+// isRevoked reports whether the certificate has been revoked, caching the answer for
+// revocationCacheTTL.
+func (m *AgentAuthMiddleware) isRevoked(ctx context.Context, cacheKey string, cert *x509.Certificate) (bool, error) {
+	if m.revocationChecker == nil {
+		return false, nil
+	}
+	if item := m.revocationCache.Get(cacheKey); item != nil {
+		return item.Value(), nil
+	}
+	revoked, status := m.revocationChecker.IsCertificateRevoked(ctx, cert)
+	if status.Code != http.StatusOK {
+		return false, fmt.Errorf("%d: %s", status.Code, status.Message)
+	}
+	m.revocationCache.Set(cacheKey, revoked, ttlcache.DefaultTTL)
+	return revoked, nil
+}
+
 // createCacheKey creates a cache key from the TLS connection
 // Uses the certificate fingerprint as the key for caching agent identities
 func (m *AgentAuthMiddleware) createCacheKey(tlsState *tls.ConnectionState) string {
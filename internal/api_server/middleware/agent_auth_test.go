@@ -0,0 +1,78 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/jellydator/ttlcache/v3"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeRevocationChecker struct {
+	revoked bool
+	status  domain.Status
+	calls   int
+}
+
+func (f *fakeRevocationChecker) IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (bool, domain.Status) {
+	f.calls++
+	return f.revoked, f.status
+}
+
+func TestAgentAuthMiddleware_Revocation(t *testing.T) {
+	cert := &x509.Certificate{Raw: []byte("agent-cert"), SerialNumber: big.NewInt(42)}
+	newRequest := func() *http.Request {
+		r := httptest.NewRequest(http.MethodGet, "/api/v1/devices/dev1/rendered", nil)
+		r.TLS = &tls.ConnectionState{PeerCertificates: []*x509.Certificate{cert}}
+		return r
+	}
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+
+	t.Run("rejects a revoked certificate even when its identity is cached", func(t *testing.T) {
+		checker := &fakeRevocationChecker{revoked: true, status: domain.StatusOK()}
+		m := NewAgentAuthMiddleware(nil, checker, logrus.New())
+		cacheKey := m.createCacheKey(newRequest().TLS)
+		m.cache.Set(cacheKey, &AgentIdentity{deviceFingerprint: "dev1", expirationDate: time.Now().Add(time.Hour)}, ttlcache.DefaultTTL)
+
+		rec := httptest.NewRecorder()
+		m.AuthenticateAgent(next).ServeHTTP(rec, newRequest())
+
+		assert.Equal(t, http.StatusUnauthorized, rec.Code)
+		assert.Contains(t, rec.Body.String(), "revoked")
+		assert.Nil(t, m.cache.Get(cacheKey), "identity of a revoked certificate should be evicted")
+	})
+
+	t.Run("accepts a cached identity whose certificate is not revoked", func(t *testing.T) {
+		checker := &fakeRevocationChecker{revoked: false, status: domain.StatusOK()}
+		m := NewAgentAuthMiddleware(nil, checker, logrus.New())
+		cacheKey := m.createCacheKey(newRequest().TLS)
+		m.cache.Set(cacheKey, &AgentIdentity{deviceFingerprint: "dev1", expirationDate: time.Now().Add(time.Hour)}, ttlcache.DefaultTTL)
+
+		for i := 0; i < 2; i++ {
+			rec := httptest.NewRecorder()
+			m.AuthenticateAgent(next).ServeHTTP(rec, newRequest())
+			assert.Equal(t, http.StatusOK, rec.Code)
+		}
+		assert.Equal(t, 1, checker.calls, "revocation status should be cached")
+	})
+
+	t.Run("fails closed when revocation cannot be checked", func(t *testing.T) {
+		checker := &fakeRevocationChecker{status: domain.StatusInternalServerError("database unavailable")}
+		m := NewAgentAuthMiddleware(nil, checker, logrus.New())
+
+		rec := httptest.NewRecorder()
+		m.AuthenticateAgent(next).ServeHTTP(rec, newRequest())
+
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	})
+}
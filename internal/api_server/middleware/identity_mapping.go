@@ -77,6 +77,7 @@ func (m *IdentityMappingMiddleware) MapIdentityToDB(next http.Handler) http.Hand
 		// Build org roles map: map organization ID to roles
 		// Match ReportedOrganizations (from identity) with database organizations
 		orgRoles := make(map[string][]string)
+		orgScopedRoles := make(map[string][]identitylib.ScopedRole)
 		reportedOrgs := identity.GetOrganizations()
 
 		// Create a lookup map from external ID to database organization
@@ -104,6 +105,9 @@ func (m *IdentityMappingMiddleware) MapIdentityToDB(next http.Handler) http.Hand
 			if dbOrg != nil {
 				// Store roles keyed by organization ID, transforming role names
 				orgRoles[dbOrg.ID.String()] = transformRoleNames(reportedOrg.Roles)
+				if scopedRoles := transformScopedRoleNames(reportedOrg.ScopedRoles); len(scopedRoles) > 0 {
+					orgScopedRoles[dbOrg.ID.String()] = scopedRoles
+				}
 			}
 		}
 		if identity.IsSuperAdmin() {
@@ -127,6 +131,7 @@ func (m *IdentityMappingMiddleware) MapIdentityToDB(next http.Handler) http.Hand
 			identity.IsSuperAdmin(),
 			identity.GetIssuer(),
 		)
+		mappedIdentity.OrgScopedRoles = orgScopedRoles
 
 		// Set mapped identity in context for downstream use
 		ctx = context.WithValue(ctx, consts.MappedIdentityCtxKey, mappedIdentity)
@@ -178,3 +183,16 @@ func transformRoleNames(roles []string) []string {
 	}
 	return transformed
 }
+
+// transformScopedRoleNames transforms the roles granted by scoped roles to internal role constants
+// Scoped roles granting unknown roles are dropped
+func transformScopedRoleNames(scopedRoles []identitylib.ScopedRole) []identitylib.ScopedRole {
+	transformed := make([]identitylib.ScopedRole, 0, len(scopedRoles))
+	for _, scopedRole := range scopedRoles {
+		if mappedRole, exists := roleNameMap[scopedRole.Role]; exists {
+			scopedRole.Role = mappedRole
+			transformed = append(transformed, scopedRole)
+		}
+	}
+	return transformed
+}
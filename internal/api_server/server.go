@@ -23,11 +23,13 @@ import (
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/console"
 	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/gitwebhook"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/rendered"
 	"github.com/flightctl/flightctl/internal/service"
 	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	certificaterevocationservice "github.com/flightctl/flightctl/internal/service/certificaterevocation"
 	certificatesigningrequestservice "github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	enrollmentrequestservice "github.com/flightctl/flightctl/internal/service/enrollmentrequest"
@@ -42,6 +44,7 @@ import (
 	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	enrollmentrequeststore "github.com/flightctl/flightctl/internal/store/enrollmentrequest"
@@ -87,8 +90,21 @@ type Server struct {
 	consoleEndpointReg console.InternalSessionRegistration
 	authN              *authn.MultiAuth
 	authZ              auth.AuthZMiddleware
+
+	newResourceSyncPlanner ResourceSyncPlannerFactory
 }
 
+// ResourceSyncPlannerFactory builds the planner that previews ResourceSyncs from the services
+// of the API server. The planner lives with the ResourceSync task, which the API server cannot
+// import directly.
+type ResourceSyncPlannerFactory func(
+	repositorySvc repositoryservice.Service,
+	fleetSvc fleetservice.Service,
+	catalogSvc catalogservice.Service,
+	authProviderSvc authproviderservice.Service,
+	deviceSvc deviceservice.Service,
+) resourcesyncservice.Planner
+
 // New returns a new instance of a flightctl server.
 func New(
 	log logrus.FieldLogger,
@@ -98,15 +114,17 @@ func New(
 	listener net.Listener,
 	queuesProvider queues.Provider,
 	consoleEndpointReg console.InternalSessionRegistration,
+	newResourceSyncPlanner ResourceSyncPlannerFactory,
 ) *Server {
 	return &Server{
-		log:                log,
-		cfg:                cfg,
-		db:                 db,
-		ca:                 ca,
-		listener:           listener,
-		queuesProvider:     queuesProvider,
-		consoleEndpointReg: consoleEndpointReg,
+		log:                    log,
+		cfg:                    cfg,
+		db:                     db,
+		ca:                     ca,
+		listener:               listener,
+		queuesProvider:         queuesProvider,
+		consoleEndpointReg:     consoleEndpointReg,
+		newResourceSyncPlanner: newResourceSyncPlanner,
 	}
 }
 
@@ -171,7 +189,7 @@ func (s *Server) Run(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	kvStore, err := kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, s.log, s.cfg)
 	if err != nil {
 		return err
 	}
@@ -187,6 +205,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	deviceStore := devicestore.NewDeviceStore(s.db, s.log.WithField("pkg", "device-store"))
 	fleetStore := fleetstore.NewFleetStore(s.db, s.log.WithField("pkg", "fleet-store"))
+	revocationStore := certificaterevocationstore.NewCertificateRevocationStore(s.db, s.log.WithField("pkg", "certificate-revocation-store"))
 	enrollmentRequestStore := enrollmentrequeststore.NewEnrollmentRequestStore(s.db, s.log.WithField("pkg", "enrollmentrequest-store"))
 	csrStore := certificatesigningrequeststore.NewCertificateSigningRequestStore(s.db, s.log.WithField("pkg", "csr-store"))
 	templateVersionStore := templateversionstore.NewTemplateVersionStore(s.db, s.log.WithField("pkg", "templateversion-store"))
@@ -201,7 +220,7 @@ func (s *Server) Run(ctx context.Context) error {
 	eventsSvc := events.NewServiceHandler(eventStore, workerClient, s.log)
 
 	deviceSvc := deviceservice.WrapWithTracing(
-		deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, eventsSvc, kvStore, s.cfg.Service.BaseAgentEndpointUrl, s.log))
+		deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, revocationStore, eventsSvc, kvStore, s.cfg.Service.BaseAgentEndpointUrl, s.log))
 	fleetSvc := fleetservice.WrapWithTracing(
 		fleetservice.NewServiceHandler(fleetStore, eventsSvc, s.log))
 	enrollmentRequestSvc := enrollmentrequestservice.WrapWithTracing(
@@ -209,11 +228,9 @@ func (s *Server) Run(ctx context.Context) error {
 	csrSvc := certificatesigningrequestservice.WrapWithTracing(
 		certificatesigningrequestservice.NewServiceHandler(csrStore, enrollmentRequestStore, s.ca, eventsSvc, s.log, s.cfg.Service.BaseAgentEndpointUrl, s.cfg.Service.BaseUIUrl))
 	templateVersionSvc := templateversionservice.WrapWithTracing(
-		templateversionservice.NewServiceHandler(templateVersionStore, kvStore, eventsSvc, s.log))
+		templateversionservice.NewServiceHandler(templateVersionStore, fleetStore, kvStore, eventsSvc, s.log))
 	repositorySvc := repositoryservice.WrapWithTracing(
 		repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, s.log))
-	resourceSyncSvc := resourcesyncservice.WrapWithTracing(
-		resourcesyncservice.NewServiceHandler(resourceSyncStore, catalogStore, fleetStore, eventsSvc, s.log))
 	catalogSvc := catalogservice.WrapWithTracing(
 		catalogservice.NewServiceHandler(catalogStore, eventsSvc, s.log))
 	eventSvc := eventservice.WrapWithTracing(
@@ -222,8 +239,16 @@ func (s *Server) Run(ctx context.Context) error {
 		organizationservice.NewServiceHandler(organizationStore))
 	authProviderSvc := authproviderservice.WrapWithTracing(
 		authproviderservice.NewServiceHandler(authProviderStore, eventsSvc, s.log))
+	var resourceSyncPlanner resourcesyncservice.Planner
+	if s.newResourceSyncPlanner != nil {
+		resourceSyncPlanner = s.newResourceSyncPlanner(repositorySvc, fleetSvc, catalogSvc, authProviderSvc, deviceSvc)
+	}
+	resourceSyncSvc := resourcesyncservice.WrapWithTracing(
+		resourcesyncservice.NewServiceHandler(resourceSyncStore, catalogStore, fleetStore, deviceStore, repositoryStore, authProviderStore, eventsSvc, resourceSyncPlanner, s.log))
 	vulnerabilityFindingSvc := vulnerabilityfindingservice.WrapWithTracing(
 		vulnerabilityfindingservice.NewServiceHandler(vulnerabilityFindingStore, deviceStore, fleetStore, eventsSvc, vulnerabilityEnabled, s.log))
+	revocationSvc := certificaterevocationservice.WrapWithTracing(
+		certificaterevocationservice.NewServiceHandler(revocationStore, deviceStore, s.ca, s.log))
 
 	// Initialize auth with the authprovider service for OIDC provider access
 	authN, err := auth.InitMultiAuth(s.cfg, s.log, authProviderSvc)
@@ -294,7 +319,7 @@ func (s *Server) Run(ctx context.Context) error {
 	negotiator := versioning.NewNegotiator(versioning.V1Beta1, server.MetadataResolver)
 
 	handlerV1Beta1 := transportv1beta1.NewTransportHandler(
-		authProviderSvc, csrSvc, deviceSvc, enrollmentRequestSvc, eventSvc,
+		authProviderSvc, revocationSvc, csrSvc, deviceSvc, enrollmentRequestSvc, eventSvc,
 		fleetSvc, organizationSvc, repositorySvc, resourceSyncSvc, templateVersionSvc,
 		convertv1beta1.NewConverter(),
 		s.authN, authTokenProxy, authUserInfoProxy, s.authZ,
@@ -407,6 +432,33 @@ func (s *Server) Run(ctx context.Context) error {
 	// Backward-compatible /corev1beta1/version endpoint (redirects to same handler as /corev1beta1/v1/version)
 	router.Get("/api/version", handlerV1Beta1.GetVersion)
 
+	// git webhook endpoint: bypasses OpenAPI + auth, requests are authenticated by the webhook secret
+	if webhookSecret := s.cfg.GetGitOpsWebhookSecret(); webhookSecret != "" {
+		gitPushPublisher, err := gitwebhook.NewPublisher(ctx, s.queuesProvider)
+		if err != nil {
+			return fmt.Errorf("failed creating git push publisher: %w", err)
+		}
+		webhookHandler := gitwebhook.NewHandler(webhookSecret, organizationSvc, repositorySvc, gitPushPublisher, s.log)
+		router.Group(func(r chi.Router) {
+			ConfigureRateLimiterFromConfig(
+				r,
+				s.cfg.Service.RateLimit,
+				RateLimitScopeAuth,
+			)
+			r.Method(http.MethodPost, gitwebhook.Path, webhookHandler)
+		})
+	}
+
+	// CRL endpoint: bypasses OpenAPI + auth, the revocation list is signed by the CA
+	router.Group(func(r chi.Router) {
+		ConfigureRateLimiterFromConfig(
+			r,
+			s.cfg.Service.RateLimit,
+			RateLimitScopeGeneral,
+		)
+		r.Method(http.MethodGet, CRLPath, CRLHandler(revocationSvc))
+	})
+
 	// health endpoints: bypass OpenAPI + auth, but keep global safety middlewares
 	router.Group(func(r chi.Router) {
 		if s.cfg != nil && s.cfg.Service != nil && s.cfg.Service.HealthChecks != nil && s.cfg.Service.HealthChecks.Enabled {
@@ -429,7 +481,7 @@ func (s *Server) Run(ctx context.Context) error {
 		)
 
 		consoleSessionManager := console.NewConsoleSessionManager(deviceSvc, s.log, s.consoleEndpointReg, rendered.Bus.Instance())
-		ws := transportv1beta1.NewWebsocketHandler(s.ca, s.log, consoleSessionManager)
+		ws := transportv1beta1.NewWebsocketHandler(s.ca, s.log, consoleSessionManager, s.authZ, s.cfg.Service.PortForward, eventSvc)
 		ws.RegisterRoutes(r)
 	})
 
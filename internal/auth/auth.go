@@ -14,6 +14,7 @@ import (
 	authprovider "github.com/flightctl/flightctl/internal/auth/provider"
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/sirupsen/logrus"
 )
@@ -50,6 +51,12 @@ type AuthZMiddleware interface {
 	GetUserPermissions(ctx context.Context) (*api.PermissionList, error)
 }
 
+// AccessScopeProvider is implemented by authorization middleware that can limit a permitted
+// operation to a subset of devices and fleets
+type AccessScopeProvider interface {
+	GetAccessScope(ctx context.Context, resource string, op string) *identity.AccessScope
+}
+
 func getTlsConfig(cfg *config.Config) *tls.Config {
 	tlsConfig := &tls.Config{
 		InsecureSkipVerify: cfg.Auth.InsecureSkipTlsVerify, //nolint:gosec
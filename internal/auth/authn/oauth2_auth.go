@@ -318,6 +318,7 @@ func (o *OAuth2Auth) GetIdentity(ctx context.Context, token string) (common.Iden
 
 	// Build ReportedOrganization with roles embedded
 	reportedOrganizations, isSuperAdmin := common.BuildReportedOrganizations(organizations, orgRoles, false)
+	o.roleExtractor.ApplyScopedRoles(reportedOrganizations)
 
 	// Get issuer (should be set by validation, but fallback to AuthorizationUrl if not)
 	issuer := lo.FromPtr(o.spec.Issuer)
@@ -361,6 +361,7 @@ func (o *OIDCAuth) parseAndCreateIdentity(ctx context.Context, token string) (*J
 
 	// Build ReportedOrganization with roles embedded
 	reportedOrganizations, isSuperAdmin := common.BuildReportedOrganizations(organizations, orgRoles, false)
+	o.roleExtractor.ApplyScopedRoles(reportedOrganizations)
 	identity.SetOrganizations(reportedOrganizations)
 	identity.SetSuperAdmin(isSuperAdmin)
 
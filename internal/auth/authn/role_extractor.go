@@ -5,6 +5,9 @@ import (
 	"strings"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -189,6 +192,63 @@ func (r *RoleExtractor) parseRolesWithSeparator(roles []interface{}, separator s
 	return orgRoles
 }
 
+// getScopedRoles returns the scoped role definitions of the role assignment keyed by name
+func (r *RoleExtractor) getScopedRoles() map[string]api.AuthScopedRole {
+	discriminator, err := r.roleAssignment.Discriminator()
+	if err != nil {
+		return nil
+	}
+
+	var scopedRoles *[]api.AuthScopedRole
+	switch discriminator {
+	case string(api.AuthStaticRoleAssignmentTypeStatic):
+		if staticRoleAssignment, err := r.roleAssignment.AsAuthStaticRoleAssignment(); err == nil {
+			scopedRoles = staticRoleAssignment.ScopedRoles
+		}
+	case string(api.AuthDynamicRoleAssignmentTypeDynamic):
+		if dynamicRoleAssignment, err := r.roleAssignment.AsAuthDynamicRoleAssignment(); err == nil {
+			scopedRoles = dynamicRoleAssignment.ScopedRoles
+		}
+	}
+	return lo.SliceToMap(lo.FromPtr(scopedRoles), func(s api.AuthScopedRole) (string, api.AuthScopedRole) {
+		return s.Name, s
+	})
+}
+
+// ApplyScopedRoles replaces the role names of each organization that refer to a scoped role
+// definition with the scoped role they grant
+func (r *RoleExtractor) ApplyScopedRoles(organizations []common.ReportedOrganization) {
+	definitions := r.getScopedRoles()
+	if len(definitions) == 0 {
+		return
+	}
+
+	for i := range organizations {
+		roles := make([]string, 0, len(organizations[i].Roles))
+		for _, role := range organizations[i].Roles {
+			definition, ok := definitions[role]
+			if !ok {
+				roles = append(roles, role)
+				continue
+			}
+			// flightctl-admin is a global role and cannot be scoped
+			if definition.Role == api.ExternalRoleAdmin {
+				r.log.Warnf("RoleExtractor: ignoring scoped role %s granting %s", definition.Name, definition.Role)
+				continue
+			}
+			scopedRole := identity.ScopedRole{
+				Role:   definition.Role,
+				Fleets: lo.FromPtr(definition.Fleets),
+			}
+			if definition.Selector != nil {
+				scopedRole.LabelSelector = definition.Selector.String()
+			}
+			organizations[i].ScopedRoles = append(organizations[i].ScopedRoles, scopedRole)
+		}
+		organizations[i].Roles = roles
+	}
+}
+
 // ValidateRoleAssignment validates a role assignment configuration
 func ValidateRoleAssignment(roleAssignment api.AuthRoleAssignment) error {
 	discriminator, err := roleAssignment.Discriminator()
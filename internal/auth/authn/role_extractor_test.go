@@ -4,6 +4,8 @@ import (
 	"testing"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -203,3 +205,46 @@ func TestRoleExtractor_MixedRoles_NotCreatedBySuperAdmin(t *testing.T) {
 	org2Roles := result["org2"]
 	assert.Contains(t, org2Roles, api.ExternalRoleViewer, "org2 viewer should be present")
 }
+
+func TestRoleExtractor_ApplyScopedRoles(t *testing.T) {
+	log := logrus.New()
+	log.SetLevel(logrus.ErrorLevel)
+
+	roleAssignment := api.AuthRoleAssignment{}
+	err := roleAssignment.FromAuthDynamicRoleAssignment(api.AuthDynamicRoleAssignment{
+		Type:      api.AuthDynamicRoleAssignmentTypeDynamic,
+		ClaimPath: []string{"groups"},
+		ScopedRoles: &[]api.AuthScopedRole{
+			{
+				Name: "paris-technicians",
+				Role: api.ExternalRoleOperator,
+				Selector: &api.LabelSelector{
+					MatchLabels: &map[string]string{"site": "paris"},
+				},
+			},
+			{
+				Name:   "lyon-viewers",
+				Role:   api.ExternalRoleViewer,
+				Fleets: &[]string{"lyon-a", "lyon-b"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	extractor := NewRoleExtractor(roleAssignment, false, log)
+
+	claims := map[string]interface{}{
+		"groups": []interface{}{api.ExternalRoleViewer, "paris-technicians", "org1:lyon-viewers"},
+	}
+	reportedOrgs, isSuperAdmin := common.BuildReportedOrganizations([]string{"org1", "org2"}, extractor.ExtractOrgRolesFromMap(claims), false)
+	require.False(t, isSuperAdmin)
+	extractor.ApplyScopedRoles(reportedOrgs)
+
+	require.Len(t, reportedOrgs, 2)
+	parisOperator := identity.ScopedRole{Role: api.ExternalRoleOperator, LabelSelector: "site=paris"}
+	lyonViewer := identity.ScopedRole{Role: api.ExternalRoleViewer, Fleets: []string{"lyon-a", "lyon-b"}}
+
+	assert.Equal(t, []string{api.ExternalRoleViewer}, reportedOrgs[0].Roles)
+	assert.ElementsMatch(t, []identity.ScopedRole{parisOperator, lyonViewer}, reportedOrgs[0].ScopedRoles)
+	assert.Equal(t, []string{api.ExternalRoleViewer}, reportedOrgs[1].Roles)
+	assert.Equal(t, []identity.ScopedRole{parisOperator}, reportedOrgs[1].ScopedRoles)
+}
@@ -179,6 +179,21 @@ func (m *MultiAuthZ) CheckPermission(ctx context.Context, resource string, op st
 	}
 }
 
+// GetAccessScope returns the scope the operation is limited to. Only the static authZ supports
+// scoped roles, so identities from K8s and OpenShift issuers are never limited.
+func (m *MultiAuthZ) GetAccessScope(ctx context.Context, resource string, op string) *identity.AccessScope {
+	ident, ok := ctx.Value(consts.IdentityCtxKey).(common.Identity)
+	if !ok || ident.GetIssuer() == nil {
+		return nil
+	}
+	switch ident.GetIssuer().Type {
+	case identity.AuthTypeOpenShift, identity.AuthTypeK8s:
+		return nil
+	default:
+		return m.getStaticAuthZ().GetAccessScope(ctx, resource, op)
+	}
+}
+
 // checkPermissionOpenShift handles permission checks for OpenShift identities
 func (m *MultiAuthZ) checkPermissionOpenShift(ctx context.Context, ident common.Identity, resource string, op string) (bool, error) {
 	issuer := ident.GetIssuer()
@@ -5,9 +5,11 @@ import (
 	"fmt"
 	"slices"
 	"sort"
+	"strings"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/sirupsen/logrus"
 )
@@ -47,14 +49,19 @@ var resourcePermissions = map[string]map[string][]string{
 		"imagepromotions":                {"get", "list", "create", "update", "patch", "delete"},
 		"repositories/check-oci-tag":     {"create"},
 		"repositories/check-oci-image":   {"create"},
+		"resourcesyncs/plan":             {"create"},
 		"devices/applications/lifecycle": {"update"},      // stop/start/restart a device's application
+		"devices/revokecertificates":     {"create"},      // revoke a compromised device's certificates
 		"fleets/applications/lifecycle":  {"update"},      // stop/start an application across a fleet
+		"devices/portforward/anyport":    {},              // Explicitly denied - ports outside service.portForward.allowedPorts require admin
 		"*":                              {"get", "list"}, // Default read access for other resources
 	},
 	v1beta1.RoleViewer: {
 		"*":                            {"get", "list"}, // Default read access to all resources
 		"devices/console":              {},              // Explicitly denied - console access requires operator or admin role
 		"devices/applications/console": {},              // Explicitly denied - console access requires operator or admin role
+		"devices/portforward":          {},              // Explicitly denied - port forwarding requires operator or admin role
+		"devices/portforward/anyport":  {},              // Explicitly denied - port forwarding requires operator or admin role
 		"imageexports/download":        {},              // Explicitly denied - empty list overrides wildcard
 	},
 	v1beta1.RoleInstaller: {
@@ -74,7 +81,8 @@ var resourcePermissions = map[string]map[string][]string{
 // this list with OpenAPI-derived resources so wildcard ("*") role permissions
 // expand to include them in the generated K8s ClusterRoles.
 var syntheticResources = []string{
-	"alerts", // gated by cmd/flightctl-alertmanager-proxy
+	"alerts",                      // gated by cmd/flightctl-alertmanager-proxy
+	"devices/portforward/anyport", // checked by the port-forward websocket handler
 }
 
 // GetSyntheticResources returns a copy of the synthetic resource list.
@@ -171,7 +179,8 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 
 	// 3. Get user's roles for the selected organization only
 	roles := mappedIdentity.GetRolesForOrg(orgID)
-	if len(roles) == 0 {
+	scopedRoles := mappedIdentity.GetScopedRolesForOrg(orgID)
+	if len(roles) == 0 && len(scopedRoles) == 0 {
 		s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 			mappedIdentity.GetUsername(), orgID)
 		return false, nil
@@ -179,36 +188,21 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 
 	// Check if any of the user's roles in this org grant the required permission
 	for _, role := range roles {
-		if permissions, exists := resourcePermissions[role]; exists {
-			// First check for specific resource - if it exists, it takes precedence over wildcard
-			// This allows explicit denials via empty permission lists
-			if resourcePerms, exists := permissions[resource]; exists {
-				// If the resource has an empty permission list, it's an explicit denial - skip to next role
-				if len(resourcePerms) == 0 {
-					s.log.Debugf("StaticAuthZ: permission explicitly denied for user=%s, role=%s, org=%s, resource=%s, op=%s",
-						mappedIdentity.GetUsername(), role, orgID, resource, op)
-					continue
-				}
-				for _, allowedOp := range resourcePerms {
-					if allowedOp == "*" || allowedOp == op {
-						s.log.Debugf("StaticAuthZ: permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
-							mappedIdentity.GetUsername(), role, orgID, resource, op)
-						return true, nil
-					}
-				}
-				// Specific resource entry exists but doesn't grant the operation - continue to check other roles
-				continue
-			}
+		if rolePermits(role, resource, op) {
+			s.log.Debugf("StaticAuthZ: permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
+				mappedIdentity.GetUsername(), role, orgID, resource, op)
+			return true, nil
+		}
+	}
 
-			// No specific resource entry - check for wildcard resource (*) - gives access to all resources
-			if resourcePerms, exists := permissions["*"]; exists {
-				for _, allowedOp := range resourcePerms {
-					if allowedOp == "*" || allowedOp == op {
-						s.log.Debugf("StaticAuthZ: permission granted via wildcard resource for user=%s, role=%s, org=%s, resource=%s, op=%s",
-							mappedIdentity.GetUsername(), role, orgID, resource, op)
-						return true, nil
-					}
-				}
+	// Scoped roles grant their permissions on devices and fleets only; the handlers limit the
+	// request to the resources in scope (see GetAccessScope)
+	if isScopableResource(resource) {
+		for _, scopedRole := range scopedRoles {
+			if rolePermits(scopedRole.Role, resource, op) {
+				s.log.Debugf("StaticAuthZ: scoped permission granted for user=%s, role=%s, org=%s, resource=%s, op=%s",
+					mappedIdentity.GetUsername(), scopedRole.Role, orgID, resource, op)
+				return true, nil
 			}
 		}
 	}
@@ -218,6 +212,85 @@ func (s StaticAuthZ) CheckPermission(ctx context.Context, resource string, op st
 	return false, nil
 }
 
+// rolePermits returns true if the role grants the operation on the resource.
+// A specific resource entry takes precedence over the wildcard, and an empty list is an explicit denial.
+func rolePermits(role string, resource string, op string) bool {
+	permissions, exists := resourcePermissions[role]
+	if !exists {
+		return false
+	}
+	resourcePerms, exists := permissions[resource]
+	if !exists {
+		resourcePerms = permissions["*"]
+	}
+	for _, allowedOp := range resourcePerms {
+		if allowedOp == "*" || allowedOp == op {
+			return true
+		}
+	}
+	return false
+}
+
+// scopableResources are the resources, including their subresources, on which scoped roles apply
+var scopableResources = []string{"devices", "fleets"}
+
+func isScopableResource(resource string) bool {
+	base, _, _ := strings.Cut(resource, "/")
+	return slices.Contains(scopableResources, base)
+}
+
+// scopedPermissions returns the permissions a role grants when it is scoped, i.e. its permissions
+// on devices and fleets and their subresources
+func scopedPermissions(role string) map[string][]string {
+	permissions := resourcePermissions[role]
+	result := make(map[string][]string)
+	for _, resource := range scopableResources {
+		if ops, exists := permissions[resource]; exists {
+			result[resource] = ops
+		} else if ops, exists := permissions["*"]; exists {
+			result[resource] = ops
+		}
+	}
+	for resource, ops := range permissions {
+		if resource != "*" && isScopableResource(resource) {
+			result[resource] = ops
+		}
+	}
+	return result
+}
+
+// GetAccessScope returns the devices and fleets the operation on the resource is limited to, or
+// nil if it is not limited. Requests are limited when the permission is granted only by scoped roles.
+func (s StaticAuthZ) GetAccessScope(ctx context.Context, resource string, op string) *identity.AccessScope {
+	mappedIdentity, ok := contextutil.GetMappedIdentityFromContext(ctx)
+	if !ok || mappedIdentity.IsSuperAdmin() || !isScopableResource(resource) {
+		return nil
+	}
+	orgUUID, ok := util.GetOrgIdFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	orgID := orgUUID.String()
+
+	scopedRoles := mappedIdentity.GetScopedRolesForOrg(orgID)
+	if len(scopedRoles) == 0 {
+		return nil
+	}
+	for _, role := range mappedIdentity.GetRolesForOrg(orgID) {
+		if rolePermits(role, resource, op) {
+			return nil
+		}
+	}
+
+	scope := &identity.AccessScope{}
+	for _, scopedRole := range scopedRoles {
+		if rolePermits(scopedRole.Role, resource, op) {
+			scope.Add(scopedRole)
+		}
+	}
+	return scope
+}
+
 func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.PermissionList, error) {
 	// Get mapped identity from context (set by identity mapping middleware)
 	mappedIdentity, ok := contextutil.GetMappedIdentityFromContext(ctx)
@@ -230,6 +303,7 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 
 	// Super admins have all permissions
 	var userRoles []string
+	var scopedRoles []identity.ScopedRole
 	if mappedIdentity.IsSuperAdmin() {
 		s.log.Debugf("StaticAuthZ: user=%s is super admin, granting all permissions", mappedIdentity.GetUsername())
 		userRoles = []string{v1beta1.RoleAdmin}
@@ -244,7 +318,8 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 
 		// Get user's roles for the selected organization only
 		userRoles = mappedIdentity.GetRolesForOrg(orgID)
-		if len(userRoles) == 0 {
+		scopedRoles = mappedIdentity.GetScopedRolesForOrg(orgID)
+		if len(userRoles) == 0 && len(scopedRoles) == 0 {
 			s.log.Debugf("StaticAuthZ: user=%s has no roles in organization=%s",
 				mappedIdentity.GetUsername(), orgID)
 			return &v1beta1.PermissionList{Permissions: []v1beta1.Permission{}}, nil
@@ -253,31 +328,39 @@ func (s StaticAuthZ) GetUserPermissions(ctx context.Context) (*v1beta1.Permissio
 			mappedIdentity.GetUsername(), orgID, userRoles)
 	}
 
-	// Merge permissions from all roles
-	mergedPermissions := make(map[string][]string)
+	rolePermissions := make([]map[string][]string, 0, len(userRoles)+len(scopedRoles))
 	for _, role := range userRoles {
 		if permissions, exists := resourcePermissions[role]; exists {
-			for resource, ops := range permissions {
-				if existingOps, exists := mergedPermissions[resource]; exists {
-					// Merge operations, avoiding duplicates
-					opsMap := make(map[string]bool)
-					for _, op := range existingOps {
-						opsMap[op] = true
-					}
-					for _, op := range ops {
-						opsMap[op] = true
-					}
-					mergedOps := make([]string, 0, len(opsMap))
-					for op := range opsMap {
-						mergedOps = append(mergedOps, op)
-					}
-					mergedPermissions[resource] = mergedOps
-				} else {
-					// Copy operations slice to avoid sharing
-					opsCopy := make([]string, len(ops))
-					copy(opsCopy, ops)
-					mergedPermissions[resource] = opsCopy
+			rolePermissions = append(rolePermissions, permissions)
+		}
+	}
+	for _, scopedRole := range scopedRoles {
+		rolePermissions = append(rolePermissions, scopedPermissions(scopedRole.Role))
+	}
+
+	// Merge permissions from all roles
+	mergedPermissions := make(map[string][]string)
+	for _, permissions := range rolePermissions {
+		for resource, ops := range permissions {
+			if existingOps, exists := mergedPermissions[resource]; exists {
+				// Merge operations, avoiding duplicates
+				opsMap := make(map[string]bool)
+				for _, op := range existingOps {
+					opsMap[op] = true
+				}
+				for _, op := range ops {
+					opsMap[op] = true
+				}
+				mergedOps := make([]string, 0, len(opsMap))
+				for op := range opsMap {
+					mergedOps = append(mergedOps, op)
 				}
+				mergedPermissions[resource] = mergedOps
+			} else {
+				// Copy operations slice to avoid sharing
+				opsCopy := make([]string, len(ops))
+				copy(opsCopy, ops)
+				mergedPermissions[resource] = opsCopy
 			}
 		}
 	}
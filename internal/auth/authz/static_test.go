@@ -402,6 +402,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "devices/applications/lifecycle",
 					Operations: []string{"update"},
 				},
+				{
+					Resource:   "devices/revokecertificates",
+					Operations: []string{"create"},
+				},
+				{
+					Resource:   "devices/portforward/anyport",
+					Operations: []string{}, // Explicitly denied
+				},
 				{
 					Resource:   "fleets",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
@@ -454,6 +462,10 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "resourcesyncs",
 					Operations: []string{"create", "delete", "get", "list", "patch", "update"},
 				},
+				{
+					Resource:   "resourcesyncs/plan",
+					Operations: []string{"create"},
+				},
 			},
 		},
 		{
@@ -472,6 +484,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "devices/console",
 					Operations: []string{}, // Explicitly denied
 				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied
+				},
+				{
+					Resource:   "devices/portforward/anyport",
+					Operations: []string{}, // Explicitly denied
+				},
 				{
 					Resource:   "imageexports/download",
 					Operations: []string{}, // Explicitly denied
@@ -532,6 +552,14 @@ func TestStaticAuthZ_GetUserPermissions(t *testing.T) {
 					Resource:   "devices/console",
 					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
 				},
+				{
+					Resource:   "devices/portforward",
+					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
+				},
+				{
+					Resource:   "devices/portforward/anyport",
+					Operations: []string{}, // Explicitly denied by viewer, installer does not grant it
+				},
 				{
 					Resource:   "enrollmentrequests",
 					Operations: []string{"get", "list"},
@@ -706,3 +734,140 @@ func TestStaticAuthZ_GetUserPermissions_SuperAdmin(t *testing.T) {
 	assert.Equal(t, "*", permissionList.Permissions[0].Resource)
 	assert.Equal(t, []string{"*"}, permissionList.Permissions[0].Operations)
 }
+
+func TestStaticAuthZ_ScopedRoles(t *testing.T) {
+	log := logrus.New()
+	authZ := NewStaticAuthZ(log)
+
+	parisOperator := identity.ScopedRole{Role: v1beta1.RoleOperator, LabelSelector: "site=paris"}
+	lyonViewer := identity.ScopedRole{Role: v1beta1.RoleViewer, Fleets: []string{"lyon"}}
+
+	tests := []struct {
+		name          string
+		roles         []string
+		scopedRoles   []identity.ScopedRole
+		resource      string
+		op            string
+		expected      bool
+		expectedScope *identity.AccessScope
+	}{
+		{
+			name:          "scoped operator can update devices within scope",
+			scopedRoles:   []identity.ScopedRole{parisOperator},
+			resource:      "devices",
+			op:            "update",
+			expected:      true,
+			expectedScope: &identity.AccessScope{LabelSelectors: []string{"site=paris"}},
+		},
+		{
+			name:          "scoped operator can access device subresources within scope",
+			scopedRoles:   []identity.ScopedRole{parisOperator},
+			resource:      "devices/console",
+			op:            "get",
+			expected:      true,
+			expectedScope: &identity.AccessScope{LabelSelectors: []string{"site=paris"}},
+		},
+		{
+			name:        "scoped operator has no access to other resources",
+			scopedRoles: []identity.ScopedRole{parisOperator},
+			resource:    "repositories",
+			op:          "list",
+			expected:    false,
+		},
+		{
+			name:        "scoped viewer cannot update devices",
+			scopedRoles: []identity.ScopedRole{lyonViewer},
+			resource:    "devices",
+			op:          "update",
+			expected:    false,
+		},
+		{
+			name:        "scoped viewer cannot open a console",
+			scopedRoles: []identity.ScopedRole{lyonViewer},
+			resource:    "devices/console",
+			op:          "get",
+			expected:    false,
+		},
+		{
+			name:          "scopes granting the operation are combined",
+			scopedRoles:   []identity.ScopedRole{parisOperator, lyonViewer},
+			resource:      "devices",
+			op:            "list",
+			expected:      true,
+			expectedScope: &identity.AccessScope{LabelSelectors: []string{"site=paris"}, Fleets: []string{"lyon"}},
+		},
+		{
+			name:          "only scopes granting the operation are used",
+			scopedRoles:   []identity.ScopedRole{parisOperator, lyonViewer},
+			resource:      "devices",
+			op:            "delete",
+			expected:      true,
+			expectedScope: &identity.AccessScope{LabelSelectors: []string{"site=paris"}},
+		},
+		{
+			name:        "unscoped role granting the operation removes the scope",
+			roles:       []string{v1beta1.RoleViewer},
+			scopedRoles: []identity.ScopedRole{parisOperator},
+			resource:    "devices",
+			op:          "list",
+			expected:    true,
+		},
+		{
+			name:          "unscoped role not granting the operation keeps the scope",
+			roles:         []string{v1beta1.RoleViewer},
+			scopedRoles:   []identity.ScopedRole{parisOperator},
+			resource:      "devices",
+			op:            "update",
+			expected:      true,
+			expectedScope: &identity.AccessScope{LabelSelectors: []string{"site=paris"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			orgID := uuid.New()
+			testOrg := &model.Organization{ID: orgID, ExternalID: "test-org", DisplayName: "Test Organization"}
+			mappedIdentity := identity.NewMappedIdentity("testuser", "testuser", []*model.Organization{testOrg},
+				map[string][]string{orgID.String(): tt.roles}, false, nil)
+			mappedIdentity.OrgScopedRoles = map[string][]identity.ScopedRole{orgID.String(): tt.scopedRoles}
+
+			ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+			ctx = util.WithOrganizationID(ctx, orgID)
+
+			allowed, err := authZ.CheckPermission(ctx, tt.resource, tt.op)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, allowed)
+			if allowed {
+				assert.Equal(t, tt.expectedScope, authZ.GetAccessScope(ctx, tt.resource, tt.op))
+			}
+		})
+	}
+}
+
+func TestStaticAuthZ_GetUserPermissions_ScopedRoles(t *testing.T) {
+	authZ := NewStaticAuthZ(logrus.New())
+
+	orgID := uuid.New()
+	testOrg := &model.Organization{ID: orgID, ExternalID: "test-org", DisplayName: "Test Organization"}
+	mappedIdentity := identity.NewMappedIdentity("testuser", "testuser", []*model.Organization{testOrg},
+		map[string][]string{orgID.String(): {}}, false, nil)
+	mappedIdentity.OrgScopedRoles = map[string][]identity.ScopedRole{
+		orgID.String(): {{Role: v1beta1.RoleOperator, LabelSelector: "site=paris"}},
+	}
+
+	ctx := context.WithValue(context.Background(), consts.MappedIdentityCtxKey, mappedIdentity)
+	ctx = util.WithOrganizationID(ctx, orgID)
+
+	permissions, err := authZ.GetUserPermissions(ctx)
+	require.NoError(t, err)
+
+	resources := make(map[string][]string)
+	for _, p := range permissions.Permissions {
+		resources[p.Resource] = p.Operations
+	}
+	assert.Equal(t, []string{"create", "delete", "get", "list", "patch", "update"}, resources["devices"])
+	assert.Equal(t, []string{"update"}, resources["devices/applications/lifecycle"])
+	assert.Contains(t, resources, "fleets")
+	assert.NotContains(t, resources, "repositories")
+	assert.NotContains(t, resources, "*")
+}
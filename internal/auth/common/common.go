@@ -43,6 +43,7 @@ type ReportedOrganization struct {
 	IsInternalID bool
 	ID           string
 	Roles        []string
+	ScopedRoles  []identity.ScopedRole
 }
 
 type AuthNMiddleware interface {
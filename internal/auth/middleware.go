@@ -11,6 +11,7 @@ import (
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/auth/common"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/sirupsen/logrus"
 )
@@ -153,6 +154,14 @@ func CreateAuthZMiddleware(authZ AuthZMiddleware, log logrus.FieldLogger) func(h
 			log.Debugf("AuthZMiddleware: authorization granted for path=%s, method=%s, resource=%s, action=%s",
 				r.URL.Path, r.Method, resource, action)
 
+			// Limit the request to the devices and fleets in scope if the permission came from scoped roles
+			if scopeProvider, ok := authZ.(AccessScopeProvider); ok {
+				if scope := scopeProvider.GetAccessScope(ctx, resource, string(action)); scope != nil {
+					log.Debugf("AuthZMiddleware: limiting request to scope %+v", *scope)
+					r = r.WithContext(contextutil.WithAccessScope(r.Context(), scope))
+				}
+			}
+
 			// If authorized, proceed to the next handler
 			next.ServeHTTP(w, r)
 		}
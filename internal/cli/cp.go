@@ -0,0 +1,351 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const copyBufferSize = 32 * 1024
+
+type CopyOptions struct {
+	GlobalOptions
+}
+
+// copyLocation is one side of a copy: a local path, or a path on a device if DeviceName is set.
+type copyLocation struct {
+	DeviceName string
+	Path       string
+}
+
+func (l copyLocation) isRemote() bool {
+	return l.DeviceName != ""
+}
+
+func DefaultCopyOptions() *CopyOptions {
+	return &CopyOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdCopy() *cobra.Command {
+	o := DefaultCopyOptions()
+	cmd := &cobra.Command{
+		Use:   "cp SOURCE DESTINATION",
+		Short: "Copy a file to or from a device.",
+		Long: `Copy a single file to or from a device, tunneled through the server. One of SOURCE and
+DESTINATION must be a device path in the form device/NAME:/ABSOLUTE/PATH. The device only allows
+paths within its configured file-copy directories and files up to its configured maximum size.`,
+		Example: `  # Download a log file from a device
+  flightctl cp device/my-device:/var/log/app.log ./app.log
+
+  # Upload a file to a device
+  flightctl cp ./debug.conf device/my-device:/var/tmp/debug.conf`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *CopyOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+}
+
+func (o *CopyOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *CopyOptions) Validate(args []string) error {
+	_, _, err := parseCopyArgs(args[0], args[1])
+	return err
+}
+
+// parseCopyLocation parses "device/NAME:/PATH" as a device path and anything else as a local path.
+func parseCopyLocation(arg string) (copyLocation, error) {
+	kindName, remotePath, found := strings.Cut(arg, ":")
+	if !found || !strings.Contains(kindName, "/") {
+		return copyLocation{Path: arg}, nil
+	}
+	kind, name, err := parseAndValidateKindName(kindName)
+	if err != nil {
+		// not a resource reference, so a local path that contains a colon
+		return copyLocation{Path: arg}, nil //nolint:nilerr
+	}
+	if kind != DeviceKind {
+		return copyLocation{}, fmt.Errorf("only devices support copying files")
+	}
+	if len(name) == 0 {
+		return copyLocation{}, fmt.Errorf("device name is required, use 'device/NAME:/PATH'")
+	}
+	if !path.IsAbs(remotePath) {
+		return copyLocation{}, fmt.Errorf("device path %q must be absolute", remotePath)
+	}
+	return copyLocation{DeviceName: name, Path: path.Clean(remotePath)}, nil
+}
+
+func parseCopyArgs(srcArg, dstArg string) (copyLocation, copyLocation, error) {
+	src, err := parseCopyLocation(srcArg)
+	if err != nil {
+		return src, copyLocation{}, err
+	}
+	dst, err := parseCopyLocation(dstArg)
+	if err != nil {
+		return src, dst, err
+	}
+	if src.isRemote() == dst.isRemote() {
+		return src, dst, fmt.Errorf("exactly one of SOURCE and DESTINATION must be a device path, use 'device/NAME:/PATH'")
+	}
+	if src.Path == "" || dst.Path == "" {
+		return src, dst, fmt.Errorf("paths must not be empty")
+	}
+	return src, dst, nil
+}
+
+func (o *CopyOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+	src, dst, err := parseCopyArgs(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	tlsCfg, err := buildTLSConfigForConsole(&config.Service, config.AuthInfo)
+	if err != nil {
+		return err
+	}
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	c := &fileCopier{
+		options:   o,
+		server:    config.Service.Server,
+		tlsConfig: tlsCfg,
+		token:     refresher.GetAccessToken(),
+	}
+	if src.isRemote() {
+		return c.download(ctx, src, dst.Path)
+	}
+	return c.upload(ctx, src.Path, dst)
+}
+
+// fileCopier copies files over the console websocket of a device.
+type fileCopier struct {
+	options   *CopyOptions
+	server    string
+	tlsConfig *tls.Config
+	token     string
+}
+
+func (c *fileCopier) connect(ctx context.Context, deviceName string, fileCopy *api.DeviceFileCopy) (*websocket.Conn, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/console", c.server, url.PathEscape(deviceName)))
+	if err != nil {
+		return nil, fmt.Errorf("parsing console URL: %w", err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	metadata, err := json.Marshal(&api.DeviceConsoleSessionMetadata{FileCopy: fileCopy})
+	if err != nil {
+		return nil, fmt.Errorf("building session metadata: %w", err)
+	}
+	q := url.Values{}
+	q.Set(api.DeviceQueryConsoleSessionMetadata, string(metadata))
+	q.Set(api.OrganizationIDQueryKey, c.options.GetEffectiveOrganization())
+	u.RawQuery = q.Encode()
+
+	dialer := websocket.Dialer{
+		TLSClientConfig: c.tlsConfig,
+		Subprotocols:    []string{api.DeviceFileCopyProtocol},
+	}
+	headers := http.Header{}
+	if c.token != "" {
+		headers.Set("Authorization", "Bearer "+c.token)
+	}
+	ws, resp, err := dialer.DialContext(ctx, u.String(), headers)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, copyBufferSize))
+			return nil, fmt.Errorf("%s: %s", http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+		}
+		return nil, err
+	}
+	return ws, nil
+}
+
+// readCopyMessage returns the next message and its channel. It returns io.EOF once the server
+// closes the connection.
+func readCopyMessage(ws *websocket.Conn) (byte, []byte, error) {
+	for {
+		msgType, msg, err := ws.ReadMessage()
+		if err != nil {
+			if websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return 0, nil, io.EOF
+			}
+			return 0, nil, err
+		}
+		if msgType == websocket.BinaryMessage && len(msg) > 0 {
+			return msg[0], msg[1:], nil
+		}
+	}
+}
+
+func parseCopyStatus(data []byte) (*api.DeviceFileCopyStatus, error) {
+	var status api.DeviceFileCopyStatus
+	if err := json.Unmarshal(data, &status); err != nil {
+		return nil, fmt.Errorf("parsing copy status: %w", err)
+	}
+	if status.Error != "" {
+		return nil, errors.New(status.Error)
+	}
+	return &status, nil
+}
+
+// download copies src from the device to dst. The file is written to a temporary file first and
+// only renamed to dst once the device confirmed the transfer.
+func (c *fileCopier) download(ctx context.Context, src copyLocation, dst string) error {
+	if info, err := os.Stat(dst); err == nil && info.IsDir() {
+		dst = filepath.Join(dst, path.Base(src.Path))
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(dst), ".flightctl-cp-*")
+	if err != nil {
+		return fmt.Errorf("creating temporary file: %w", err)
+	}
+	defer func() {
+		tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	ws, err := c.connect(ctx, src.DeviceName, &api.DeviceFileCopy{Direction: api.DeviceFileTransferDownload, Path: src.Path})
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	var received int64
+	for {
+		channel, data, err := readCopyMessage(ws)
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("connection closed after %d bytes before the transfer completed", received)
+		}
+		if err != nil {
+			return err
+		}
+		switch channel {
+		case api.DeviceFileCopyDataChannel:
+			if _, err := tmp.Write(data); err != nil {
+				return fmt.Errorf("writing %s: %w", dst, err)
+			}
+			received += int64(len(data))
+		case api.DeviceFileCopyStatusChannel:
+			status, err := parseCopyStatus(data)
+			if err != nil {
+				return err
+			}
+			if status.Size != received {
+				return fmt.Errorf("received %d of %d bytes", received, status.Size)
+			}
+			mode := os.FileMode(status.Mode).Perm()
+			if mode == 0 {
+				mode = 0o644
+			}
+			if err := tmp.Chmod(mode); err != nil {
+				return fmt.Errorf("setting mode of %s: %w", dst, err)
+			}
+			if err := tmp.Close(); err != nil {
+				return fmt.Errorf("writing %s: %w", dst, err)
+			}
+			if err := os.Rename(tmp.Name(), dst); err != nil {
+				return fmt.Errorf("writing %s: %w", dst, err)
+			}
+			return nil
+		}
+	}
+}
+
+// upload copies the local file src to the device and waits for the device to confirm it.
+func (c *fileCopier) upload(ctx context.Context, src string, dst copyLocation) error {
+	f, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	info, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("%s is not a regular file", src)
+	}
+
+	ws, err := c.connect(ctx, dst.DeviceName, &api.DeviceFileCopy{
+		Direction: api.DeviceFileTransferUpload,
+		Path:      dst.Path,
+		Size:      info.Size(),
+		Mode:      uint32(info.Mode().Perm()),
+	})
+	if err != nil {
+		return err
+	}
+	defer ws.Close()
+
+	buf := make([]byte, copyBufferSize+1)
+	buf[0] = api.DeviceFileCopyDataChannel
+	for {
+		n, err := f.Read(buf[1:])
+		if n > 0 {
+			if werr := ws.WriteMessage(websocket.BinaryMessage, buf[:n+1]); werr != nil {
+				return fmt.Errorf("sending %s: %w", src, werr)
+			}
+		}
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", src, err)
+		}
+	}
+
+	for {
+		channel, data, err := readCopyMessage(ws)
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("connection closed before the device confirmed the transfer")
+		}
+		if err != nil {
+			return err
+		}
+		if channel == api.DeviceFileCopyStatusChannel {
+			_, err := parseCopyStatus(data)
+			return err
+		}
+	}
+}
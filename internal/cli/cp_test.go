@@ -0,0 +1,152 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCopyArgs(t *testing.T) {
+	tests := []struct {
+		name        string
+		src, dst    string
+		wantSrc     copyLocation
+		wantDst     copyLocation
+		errContains string
+	}{
+		{
+			name:    "download",
+			src:     "device/mydevice:/var/log/app.log",
+			dst:     "./app.log",
+			wantSrc: copyLocation{DeviceName: "mydevice", Path: "/var/log/app.log"},
+			wantDst: copyLocation{Path: "./app.log"},
+		},
+		{
+			name:    "upload",
+			src:     "local:file",
+			dst:     "device/mydevice:/var/tmp/../tmp/x",
+			wantSrc: copyLocation{Path: "local:file"},
+			wantDst: copyLocation{DeviceName: "mydevice", Path: "/var/tmp/x"},
+		},
+		{name: "both local", src: "a", dst: "b", errContains: "exactly one"},
+		{name: "both remote", src: "device/a:/a", dst: "device/b:/b", errContains: "exactly one"},
+		{name: "relative remote", src: "device/a:var/log", dst: "b", errContains: "must be absolute"},
+		{name: "not a device", src: "fleet/a:/a", dst: "b", errContains: "only devices"},
+		{name: "no name", src: "device/:/a", dst: "b", errContains: "device name is required"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			src, dst, err := parseCopyArgs(tt.src, tt.dst)
+			if tt.errContains != "" {
+				require.ErrorContains(t, err, tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantSrc, src)
+			assert.Equal(t, tt.wantDst, dst)
+		})
+	}
+}
+
+// newCopyTestServer serves one file copy session with handle and returns the session metadata it
+// received.
+func newCopyTestServer(t *testing.T, handle func(conn *websocket.Conn)) (*httptest.Server, chan api.DeviceConsoleSessionMetadata) {
+	metadata := make(chan api.DeviceConsoleSessionMetadata, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var m api.DeviceConsoleSessionMetadata
+		if err := json.Unmarshal([]byte(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata)), &m); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		metadata <- m
+		upgrader := websocket.Upgrader{Subprotocols: []string{api.DeviceFileCopyProtocol}}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		handle(conn)
+		_ = conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""), time.Now().Add(time.Second))
+	}))
+	t.Cleanup(server.Close)
+	return server, metadata
+}
+
+func copyStatusMessage(t *testing.T, status api.DeviceFileCopyStatus) []byte {
+	b, err := json.Marshal(&status)
+	require.NoError(t, err)
+	return append([]byte{api.DeviceFileCopyStatusChannel}, b...)
+}
+
+func TestFileCopierDownload(t *testing.T) {
+	server, metadata := newCopyTestServer(t, func(conn *websocket.Conn) {
+		_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{api.DeviceFileCopyDataChannel}, "hello"...))
+		_ = conn.WriteMessage(websocket.BinaryMessage, copyStatusMessage(t, api.DeviceFileCopyStatus{Size: 5, Mode: 0o600}))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	c := &fileCopier{options: DefaultCopyOptions(), server: server.URL}
+	require.NoError(t, c.download(ctx, copyLocation{DeviceName: "mydevice", Path: "/var/log/app.log"}, dir))
+
+	m := <-metadata
+	require.NotNil(t, m.FileCopy)
+	assert.Equal(t, api.DeviceFileTransferDownload, m.FileCopy.Direction)
+	assert.Equal(t, "/var/log/app.log", m.FileCopy.Path)
+	content, err := os.ReadFile(filepath.Join(dir, "app.log"))
+	require.NoError(t, err)
+	assert.Equal(t, "hello", string(content))
+}
+
+func TestFileCopierDownloadIncomplete(t *testing.T) {
+	server, _ := newCopyTestServer(t, func(conn *websocket.Conn) {
+		_ = conn.WriteMessage(websocket.BinaryMessage, append([]byte{api.DeviceFileCopyDataChannel}, "hel"...))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	dir := t.TempDir()
+	c := &fileCopier{options: DefaultCopyOptions(), server: server.URL}
+	err := c.download(ctx, copyLocation{DeviceName: "mydevice", Path: "/var/log/app.log"}, filepath.Join(dir, "app.log"))
+	require.ErrorContains(t, err, "before the transfer completed")
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestFileCopierUpload(t *testing.T) {
+	received := make(chan []byte, 1)
+	server, metadata := newCopyTestServer(t, func(conn *websocket.Conn) {
+		_, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		received <- msg
+		_ = conn.WriteMessage(websocket.BinaryMessage, copyStatusMessage(t, api.DeviceFileCopyStatus{Error: "disk full"}))
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	src := filepath.Join(t.TempDir(), "debug.conf")
+	require.NoError(t, os.WriteFile(src, []byte("hello"), 0o640))
+	c := &fileCopier{options: DefaultCopyOptions(), server: server.URL}
+	err := c.upload(ctx, src, copyLocation{DeviceName: "mydevice", Path: "/var/tmp/debug.conf"})
+	require.ErrorContains(t, err, "disk full")
+
+	m := <-metadata
+	require.NotNil(t, m.FileCopy)
+	assert.Equal(t, api.DeviceFileCopy{Direction: api.DeviceFileTransferUpload, Path: "/var/tmp/debug.conf", Size: 5, Mode: 0o640}, *m.FileCopy)
+	assert.Equal(t, append([]byte{api.DeviceFileCopyDataChannel}, "hello"...), <-received)
+}
@@ -13,8 +13,10 @@ import (
 )
 
 var (
-	// todo - add api.FactoryReset once we support it
-	allowedTargets = []string{string(api.DeviceDecommissionTargetTypeUnenroll)}
+	allowedTargets = []string{
+		string(api.DeviceDecommissionTargetTypeUnenroll),
+		string(api.DeviceDecommissionTargetTypeFactoryReset),
+	}
 )
 
 type DecommissionOptions struct {
@@ -59,7 +61,7 @@ func NewCmdDecommission() *cobra.Command {
 
 func (o *DecommissionOptions) Bind(fs *pflag.FlagSet) {
 	o.GlobalOptions.Bind(fs)
-	fs.StringVarP(&o.DecommissionTarget, "target", "t", o.DecommissionTarget, "Specify the type of decommissioning operation: currently supports only 'unenroll'")
+	fs.StringVarP(&o.DecommissionTarget, "target", "t", o.DecommissionTarget, "Specify the type of decommissioning operation: 'unenroll' or 'factoryReset', which also removes all applications, managed files, container images and volumes and resets the agent configuration")
 }
 
 func (o *DecommissionOptions) Complete(cmd *cobra.Command, args []string) error {
@@ -112,9 +114,11 @@ func (o *DecommissionOptions) Run(ctx context.Context, args []string) error {
 	}
 
 	var body api.DeviceDecommission
-	switch o.DecommissionTarget {
+	switch {
+	case strings.EqualFold(o.DecommissionTarget, string(api.DeviceDecommissionTargetTypeFactoryReset)):
+		body = api.DeviceDecommission{Target: api.DeviceDecommissionTargetTypeFactoryReset}
 	default:
-		body = api.DeviceDecommission{Target: "Unenroll"}
+		body = api.DeviceDecommission{Target: api.DeviceDecommissionTargetTypeUnenroll}
 	}
 	response, err := c.DecommissionDeviceWithResponse(ctx, name, body)
 	if err != nil {
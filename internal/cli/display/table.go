@@ -288,9 +288,9 @@ func (f *TableFormatter) printEnrollmentRequestsTable(w *tabwriter.Writer, ers .
 
 func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool, fleets ...api.Fleet) error {
 	if showSummary {
-		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID", "DEVICES")
+		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID", "ROLLOUT", "DEVICES")
 	} else {
-		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID")
+		f.printHeaderRowLn(w, "NAME", "OWNER", "SELECTOR", "VALID", "ROLLOUT")
 	}
 	for i := range fleets {
 		fleet := fleets[i]
@@ -299,12 +299,14 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 			selector = strings.Join(util.LabelMapToArray(fleet.Spec.Selector.MatchLabels), ",")
 		}
 		valid := "Unknown"
+		rollout := NoneString
 		numDevices := "Unknown"
 		if fleet.Status != nil {
 			condition := api.FindStatusCondition(fleet.Status.Conditions, api.ConditionTypeFleetValid)
 			if condition != nil {
 				valid = string(condition.Status)
 			}
+			rollout = fleetRolloutState(fleet.Status)
 			if showSummary && fleet.Status.DevicesSummary != nil {
 				numDevices = fmt.Sprintf("%d", fleet.Status.DevicesSummary.Total)
 			}
@@ -315,6 +317,7 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 			util.DefaultIfNil(fleet.Metadata.Owner, NoneString),
 			selector,
 			valid,
+			rollout,
 		)
 
 		if showSummary {
@@ -325,6 +328,18 @@ func (f *TableFormatter) printFleetsTable(w *tabwriter.Writer, showSummary bool,
 	return nil
 }
 
+// fleetRolloutState summarizes the state of the fleet's rollout, including when a waiting rollout may proceed
+func fleetRolloutState(status *api.FleetStatus) string {
+	condition := api.FindStatusCondition(status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+	if condition == nil {
+		return NoneString
+	}
+	if status.Rollout != nil && status.Rollout.NextMaintenanceWindow != nil {
+		return fmt.Sprintf("%s (until %s)", condition.Reason, status.Rollout.NextMaintenanceWindow.Format(time.RFC3339))
+	}
+	return condition.Reason
+}
+
 func (f *TableFormatter) printOrganizationsTable(w *tabwriter.Writer, orgs ...api.Organization) error {
 	f.printHeaderRowLn(w, "NAME", "DISPLAY NAME", "EXTERNAL ID")
 	for _, org := range orgs {
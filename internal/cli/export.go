@@ -0,0 +1,195 @@
+package cli
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/orgexport"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/version"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type ExportOptions struct {
+	GlobalOptions
+
+	SignKey string
+}
+
+func DefaultExportOptions() *ExportOptions {
+	return &ExportOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdExport() *cobra.Command {
+	o := DefaultExportOptions()
+	cmd := &cobra.Command{
+		Use:   "export FILE",
+		Short: "Export the resources of an organization to an archive.",
+		Long: `Export the repositories, catalogs, fleets, devices and approved enrollment requests of an
+organization to a versioned archive that "flightctl import" can load into another organization
+or installation. Status, server-managed metadata and secrets are not exported.
+
+Template versions are not exported; fleets render them again after the import. ResourceSyncs
+are not exported either: the resources they manage are exported like any other resource and
+the ResourceSyncs must be recreated in the target organization.
+
+The export reads the organization through the API with the permissions of the current user
+and does not need access to the database of the installation.`,
+		Example: `  # Export the current organization to a signed archive
+  flightctl export staging.tar.gz --sign-key export-signing.key`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ExportOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.SignKey, "sign-key", o.SignKey, "Path to a PEM encoded private key used to sign the archive.")
+}
+
+func (o *ExportOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ExportOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(args[0]) == 0 {
+		return fmt.Errorf("specify the file to export to")
+	}
+	return nil
+}
+
+func (o *ExportOptions) Run(ctx context.Context, args []string) error {
+	var signer crypto.Signer
+	if len(o.SignKey) > 0 {
+		key, err := fccrypto.LoadKey(o.SignKey)
+		if err != nil {
+			return fmt.Errorf("loading signing key: %w", err)
+		}
+		if signer, err = orgexport.NewSigner(key); err != nil {
+			return err
+		}
+	}
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	archive := &orgexport.Archive{
+		Manifest: orgexport.Manifest{
+			SourceOrganization: o.GetEffectiveOrganization(),
+			FlightctlVersion:   version.Get().String(),
+			ExportedAt:         time.Now().UTC(),
+		},
+		Resources: map[string][]orgexport.Resource{},
+	}
+	for _, kind := range orgexport.Kinds {
+		resources, err := exportResources(ctx, c, kind)
+		if err != nil {
+			return err
+		}
+		archive.Resources[kind] = resources
+	}
+
+	f, err := os.OpenFile(args[0], os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return fmt.Errorf("creating %s: %w", args[0], err)
+	}
+	defer f.Close()
+	if err := orgexport.Write(f, archive, signer); err != nil {
+		return fmt.Errorf("writing %s: %w", args[0], err)
+	}
+	if err := f.Close(); err != nil {
+		return fmt.Errorf("writing %s: %w", args[0], err)
+	}
+
+	for _, file := range archive.Manifest.Files {
+		fmt.Printf("Exported %d %s resource(s)\n", file.Count, file.Kind)
+	}
+	return nil
+}
+
+// exportResources lists and sanitizes all resources of a kind.
+func exportResources(ctx context.Context, c *client.Client, kind string) ([]orgexport.Resource, error) {
+	items, err := listAllResources(ctx, c, kind)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []orgexport.Resource{}
+	for _, item := range items {
+		// Pending and denied enrollment requests are not worth moving; devices re-enroll.
+		if kind == api.EnrollmentRequestKind && item.Approval() == nil {
+			continue
+		}
+		resources = append(resources, orgexport.Sanitize(kind, item))
+	}
+	return resources, nil
+}
+
+func listAllResources(ctx context.Context, c *client.Client, kind string) ([]orgexport.Resource, error) {
+	o := DefaultGetOptions()
+	o.Limit = maxRequestLimit
+
+	var items []orgexport.Resource
+	for {
+		response, err := o.getResourceList(ctx, c, ResourceKind(strings.ToLower(kind)))
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		json200, err := ExtractJSON200(response)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		data, err := json.Marshal(json200)
+		if err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		var page struct {
+			Items    []orgexport.Resource `json:"items"`
+			Metadata struct {
+				Continue *string `json:"continue"`
+			} `json:"metadata"`
+		}
+		if err := json.Unmarshal(data, &page); err != nil {
+			return nil, fmt.Errorf("listing %s: %w", kind, err)
+		}
+		items = append(items, page.Items...)
+		if page.Metadata.Continue == nil || len(*page.Metadata.Continue) == 0 {
+			return items, nil
+		}
+		o.Continue = *page.Metadata.Continue
+	}
+}
@@ -44,6 +44,7 @@ const (
 	FlagSortBy      = "sort-by"      // for vulnerabilities
 	FlagOrder       = "order"        // for vulnerabilities
 	FlagCveId       = "cve-id"       // for filtering devices by CVE
+	FlagWatch       = "watch"        // for listing devices, fleets and events
 )
 
 type FlagContextualRule struct {
@@ -70,6 +71,7 @@ type GetOptions struct {
 	SortBy        string
 	Order         string
 	CveId         string
+	Watch         bool
 }
 
 func DefaultGetOptions() *GetOptions {
@@ -142,6 +144,7 @@ func (o *GetOptions) Bind(fs *pflag.FlagSet) {
 	fs.StringVar(&o.SortBy, FlagSortBy, o.SortBy, "Field to sort results by (for vulnerabilities).")
 	fs.StringVar(&o.Order, FlagOrder, o.Order, "Sort order: 'asc' or 'desc' (for vulnerabilities).")
 	fs.StringVar(&o.CveId, FlagCveId, o.CveId, "Filter devices by CVE ID (e.g., CVE-2023-44487).")
+	fs.BoolVarP(&o.Watch, FlagWatch, "w", false, "After listing the requested resources, watch for changes.")
 	o.hideHelpContextualFlags(fs)
 }
 
@@ -155,6 +158,7 @@ var flagContextualRules = []FlagContextualRule{
 	{FlagSortBy, []ResourceKind{VulnerabilityKind}, []string{"any"}},
 	{FlagOrder, []ResourceKind{VulnerabilityKind}, []string{"any"}},
 	{FlagCveId, []ResourceKind{DeviceKind}, []string{"list"}},
+	{FlagWatch, []ResourceKind{DeviceKind, FleetKind, EventKind}, []string{"any"}},
 }
 
 func (o *GetOptions) hideHelpContextualFlags(fs *pflag.FlagSet) {
@@ -270,6 +274,7 @@ func (o *GetOptions) Validate(args []string) error {
 		func() error { return o.validateWithExports(kind) },
 		func() error { return o.validateVulnerabilityFlags(kind) },
 		func() error { return o.validateCveId(kind, names) },
+		func() error { return o.validateWatch(kind) },
 	}
 
 	for _, v := range validators {
@@ -440,6 +445,10 @@ func (o *GetOptions) Run(ctx context.Context, args []string) error {
 		return o.runVulnerability(ctx, names)
 	}
 
+	if o.Watch {
+		return o.runWatch(ctx, kind, names)
+	}
+
 	formatter := display.NewFormatter(display.OutputFormat(o.Output))
 
 	// Create resource fetchers based on kind
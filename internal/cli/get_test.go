@@ -566,6 +566,47 @@ func TestGetOptionsValidation(t *testing.T) {
 			expectError:   true,
 			errorContains: "'--cve-id' can only be specified when listing devices",
 		},
+
+		// Watch validation tests
+		{
+			name:        "watch_with_device_list_ok",
+			args:        []string{"devices"},
+			options:     &GetOptions{Watch: true, LabelSelector: "app=test"},
+			expectError: false,
+		},
+		{
+			name:        "watch_with_specific_fleets_ok",
+			args:        []string{"fleet", "test1", "test2"},
+			options:     &GetOptions{Watch: true},
+			expectError: false,
+		},
+		{
+			name:        "watch_with_events_ok",
+			args:        []string{"events"},
+			options:     &GetOptions{Watch: true},
+			expectError: false,
+		},
+		{
+			name:          "watch_with_unsupported_kind_fails",
+			args:          []string{"repositories"},
+			options:       &GetOptions{Watch: true},
+			expectError:   true,
+			errorContains: "'--watch' can only be specified when getting devices, fleets or events",
+		},
+		{
+			name:          "watch_with_limit_fails",
+			args:          []string{"devices"},
+			options:       &GetOptions{Watch: true, Limit: 10},
+			expectError:   true,
+			errorContains: "flags '--limit' and '--continue' are not supported when '--watch' is specified",
+		},
+		{
+			name:          "watch_with_summary_fails",
+			args:          []string{"fleets"},
+			options:       &GetOptions{Watch: true, Summary: true},
+			expectError:   true,
+			errorContains: "'--watch' cannot be combined with",
+		},
 	}
 
 	for _, tc := range tests {
@@ -599,6 +640,10 @@ func TestGetOptionsValidation(t *testing.T) {
 				if tc.options.CveId != "" {
 					opts.CveId = tc.options.CveId
 				}
+				if tc.options.Limit != 0 {
+					opts.Limit = tc.options.Limit
+				}
+				opts.Watch = tc.options.Watch
 			}
 
 			err := opts.Validate(tc.args)
@@ -623,3 +668,125 @@ func TestGetOptionsValidation(t *testing.T) {
 func contains(s, substr string) bool {
 	return strings.Contains(s, substr)
 }
+
+// makeWatchStream builds an *http.Response carrying the given watch events as
+// Server-Sent Events.
+func makeWatchStream(t *testing.T, events ...api.WatchEvent) *http.Response {
+	t.Helper()
+
+	var body bytes.Buffer
+	for _, event := range events {
+		data, err := json.Marshal(event)
+		if err != nil {
+			t.Fatalf("failed to marshal watch event: %v", err)
+		}
+		fmt.Fprintf(&body, "data: %s\n\n", data)
+	}
+
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"text/event-stream"}},
+		Body:       io.NopCloser(&body),
+	}
+}
+
+func TestWatchOnce(t *testing.T) {
+	deviceObject := func(name string) *map[string]interface{} {
+		return &map[string]interface{}{
+			"apiVersion": "v1beta1",
+			"kind":       api.DeviceKind,
+			"metadata":   map[string]interface{}{"name": name},
+		}
+	}
+
+	t.Run("prints objects and tracks resourceVersion", func(t *testing.T) {
+		apiClient, _ := newTestClient(t, makeWatchStream(t,
+			api.WatchEvent{Type: api.WatchEventTypeAdded, ResourceVersion: "a.1", Object: deviceObject("dev-1")},
+			api.WatchEvent{Type: api.WatchEventTypeBookmark, ResourceVersion: "a.1"},
+			api.WatchEvent{Type: api.WatchEventTypeModified, ResourceVersion: "a.2", Object: deviceObject("dev-1")},
+			api.WatchEvent{Type: api.WatchEventTypeDeleted, ResourceVersion: "a.3", Object: deviceObject("dev-1")},
+		))
+		c := client.NewTestClient(apiClient)
+
+		opts := DefaultGetOptions()
+		opts.Output = string(display.NameFormat)
+		printer := &watchPrinter{
+			options:   opts,
+			kind:      DeviceKind,
+			formatter: display.NewFormatter(display.NameFormat),
+			objects:   make(map[string]json.RawMessage),
+		}
+
+		resourceVersion := ""
+		var connected bool
+		var err error
+		out := captureStdout(t, func() {
+			connected, err = opts.watchOnce(context.Background(), c, DeviceKind, "", &resourceVersion, printer)
+		})
+		if err != nil {
+			t.Fatalf("watchOnce returned unexpected error: %v", err)
+		}
+		if !connected {
+			t.Errorf("expected watch to be connected")
+		}
+		if resourceVersion != "a.3" {
+			t.Errorf("expected resourceVersion a.3, got %q", resourceVersion)
+		}
+		if out != "dev-1\ndev-1\ndev-1\n" {
+			t.Errorf("unexpected output %q", out)
+		}
+	})
+
+	t.Run("prints the event type in table output", func(t *testing.T) {
+		apiClient, _ := newTestClient(t, makeWatchStream(t,
+			api.WatchEvent{Type: api.WatchEventTypeAdded, ResourceVersion: "a.1", Object: deviceObject("dev-1")},
+			api.WatchEvent{Type: api.WatchEventTypeBookmark, ResourceVersion: "a.1"},
+			api.WatchEvent{Type: api.WatchEventTypeModified, ResourceVersion: "a.2", Object: deviceObject("dev-1")},
+			api.WatchEvent{Type: api.WatchEventTypeDeleted, ResourceVersion: "a.3", Object: deviceObject("dev-1")},
+		))
+		c := client.NewTestClient(apiClient)
+
+		opts := DefaultGetOptions()
+		printer := &watchPrinter{
+			options:   opts,
+			kind:      DeviceKind,
+			formatter: display.NewFormatter(display.OutputFormat(opts.Output)),
+			objects:   make(map[string]json.RawMessage),
+		}
+
+		resourceVersion := ""
+		var err error
+		out := captureStdout(t, func() {
+			_, err = opts.watchOnce(context.Background(), c, DeviceKind, "", &resourceVersion, printer)
+		})
+		if err != nil {
+			t.Fatalf("watchOnce returned unexpected error: %v", err)
+		}
+		lines := strings.Split(strings.TrimSuffix(out, "\n"), "\n")
+		expected := []string{"EVENT   \tNAME", "ADDED   \tdev-1", "MODIFIED\tdev-1", "DELETED \tdev-1"}
+		if len(lines) != len(expected) {
+			t.Fatalf("expected %d lines, got %q", len(expected), out)
+		}
+		for i, prefix := range expected {
+			if !strings.HasPrefix(lines[i], prefix) {
+				t.Errorf("expected line %d to start with %q, got %q", i, prefix, lines[i])
+			}
+		}
+	})
+
+	t.Run("expired resourceVersion", func(t *testing.T) {
+		apiClient, _ := newTestClient(t, &http.Response{
+			StatusCode: http.StatusGone,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"message":"expired"}`)),
+		})
+		c := client.NewTestClient(apiClient)
+
+		opts := DefaultGetOptions()
+		resourceVersion := "a.1"
+		_, err := opts.watchOnce(context.Background(), c, DeviceKind, "", &resourceVersion, &watchPrinter{options: opts})
+		if err != errWatchExpired {
+			t.Errorf("expected errWatchExpired, got %v", err)
+		}
+	})
+}
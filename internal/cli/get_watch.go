@@ -0,0 +1,312 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	apiclient "github.com/flightctl/flightctl/internal/api/client"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+const (
+	// watchMaxRetries is the number of consecutive times a watch is re-established
+	// after a transient error before giving up.
+	watchMaxRetries = 5
+	// watchRetryDelay is the delay before re-establishing an interrupted watch.
+	watchRetryDelay = 2 * time.Second
+	// watchMaxEventSize is the largest watch event the CLI accepts.
+	watchMaxEventSize = 16 * 1024 * 1024
+	// watchEventColumnWidth is the width of the event type column of table
+	// output. It is a multiple of the table tab width, so that the columns that
+	// follow stay aligned.
+	watchEventColumnWidth = 8
+)
+
+var (
+	watchableResourceKinds = []ResourceKind{DeviceKind, FleetKind, EventKind}
+
+	errWatchExpired = errors.New("watch expired")
+)
+
+// validateWatch checks the usage of the --watch flag.
+func (o *GetOptions) validateWatch(kind ResourceKind) error {
+	if !o.Watch {
+		return nil
+	}
+	if !slices.Contains(watchableResourceKinds, kind) {
+		return fmt.Errorf("'--watch' can only be specified when getting devices, fleets or events")
+	}
+	if o.Limit > 0 || len(o.Continue) > 0 {
+		return fmt.Errorf("flags '--limit' and '--continue' are not supported when '--watch' is specified")
+	}
+	if o.Summary || o.SummaryOnly || o.Rendered || o.LastSeen {
+		return fmt.Errorf("'--watch' cannot be combined with '--summary', '--summary-only', '--rendered', or '--last-seen'")
+	}
+	return nil
+}
+
+// runWatch prints the matching resources and then every change to them until
+// the context is cancelled. Interrupted watches are resumed from the last
+// resourceVersion seen, or restarted from scratch if the server no longer
+// remembers it.
+func (o *GetOptions) runWatch(ctx context.Context, kind ResourceKind, names []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	fieldSelector := o.FieldSelector
+	if len(names) > 0 {
+		fieldSelector = fmt.Sprintf("metadata.name in (%s)", strings.Join(names, ","))
+	}
+
+	printer := &watchPrinter{
+		options:   o,
+		kind:      kind,
+		formatter: display.NewFormatter(display.OutputFormat(o.Output)),
+		objects:   make(map[string]json.RawMessage),
+	}
+
+	resourceVersion := ""
+	retries := 0
+	for {
+		connected, err := o.watchOnce(ctx, c, kind, fieldSelector, &resourceVersion, printer)
+		if ctx.Err() != nil {
+			return nil
+		}
+		switch {
+		case errors.Is(err, errWatchExpired):
+			resourceVersion = ""
+		case err != nil && !isTransientStreamError(err) && !errors.Is(err, io.ErrUnexpectedEOF):
+			return fmt.Errorf("watching %s: %w", kind.ToPlural(), err)
+		}
+		if connected {
+			retries = 0
+		}
+		retries++
+		if retries > watchMaxRetries {
+			return fmt.Errorf("watching %s: %w", kind.ToPlural(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(watchRetryDelay):
+		}
+	}
+}
+
+// watchOnce runs a single watch request, advancing resourceVersion as events
+// are received. It reports whether the watch stream was established.
+func (o *GetOptions) watchOnce(ctx context.Context, c *client.Client, kind ResourceKind, fieldSelector string, resourceVersion *string, printer *watchPrinter) (bool, error) {
+	resp, err := o.openWatch(ctx, c, kind, fieldSelector, *resourceVersion)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusGone:
+		return false, errWatchExpired
+	default:
+		body, _ := io.ReadAll(resp.Body)
+		var status api.Status
+		if err := json.Unmarshal(body, &status); err == nil && status.Message != "" {
+			return false, fmt.Errorf("%d %s", resp.StatusCode, status.Message)
+		}
+		return false, fmt.Errorf("%d %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), watchMaxEventSize)
+	for scanner.Scan() {
+		data, found := strings.CutPrefix(scanner.Text(), "data: ")
+		if !found {
+			continue
+		}
+		var event api.WatchEvent
+		if err := json.Unmarshal([]byte(data), &event); err != nil {
+			return true, fmt.Errorf("decoding watch event: %w", err)
+		}
+		switch event.Type {
+		case api.WatchEventTypeBookmark:
+		case api.WatchEventTypeError:
+			var status api.Status
+			if err := remarshal(event.Object, &status); err != nil || status.Message == "" {
+				return true, fmt.Errorf("watch failed")
+			}
+			return true, errors.New(status.Message)
+		default:
+			if err := printer.print(event); err != nil {
+				return true, err
+			}
+		}
+		*resourceVersion = event.ResourceVersion
+	}
+	return true, scanner.Err()
+}
+
+func (o *GetOptions) openWatch(ctx context.Context, c *client.Client, kind ResourceKind, fieldSelector string, resourceVersion string) (*http.Response, error) {
+	switch kind {
+	case DeviceKind:
+		params := api.ListDevicesParams{
+			LabelSelector:   util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector:   util.ToPtrWithNilDefault(fieldSelector),
+			Watch:           lo.ToPtr(true),
+			ResourceVersion: util.ToPtrWithNilDefault(resourceVersion),
+		}
+		return c.ListDevices(ctx, &params)
+	case FleetKind:
+		params := api.ListFleetsParams{
+			LabelSelector:   util.ToPtrWithNilDefault(o.LabelSelector),
+			FieldSelector:   util.ToPtrWithNilDefault(fieldSelector),
+			Watch:           lo.ToPtr(true),
+			ResourceVersion: util.ToPtrWithNilDefault(resourceVersion),
+		}
+		return c.ListFleets(ctx, &params)
+	case EventKind:
+		params := api.ListEventsParams{
+			FieldSelector:   util.ToPtrWithNilDefault(fieldSelector),
+			Watch:           lo.ToPtr(true),
+			ResourceVersion: util.ToPtrWithNilDefault(resourceVersion),
+		}
+		return c.ListEvents(ctx, &params)
+	default:
+		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
+	}
+}
+
+// watchPrinter prints the objects carried by watch events in the requested
+// output format.
+type watchPrinter struct {
+	options   *GetOptions
+	kind      ResourceKind
+	formatter display.OutputFormatter
+	// objects holds the last known state of each resource, so that a deleted
+	// resource can still be shown in full in table output.
+	objects map[string]json.RawMessage
+	// headerPrinted is set once the table header has been printed.
+	headerPrinted bool
+}
+
+func (p *watchPrinter) print(event api.WatchEvent) error {
+	formatOptions := display.FormatOptions{
+		Kind:   p.kind.String(),
+		Wide:   p.options.Output == string(display.WideFormat),
+		Writer: os.Stdout,
+	}
+
+	switch p.options.Output {
+	case string(display.JSONFormat):
+		return p.formatter.Format(event, formatOptions)
+	case string(display.YAMLFormat):
+		fmt.Fprintln(os.Stdout, "---")
+		return p.formatter.Format(event, formatOptions)
+	}
+
+	raw, err := json.Marshal(event.Object)
+	if err != nil {
+		return fmt.Errorf("marshalling watch object: %w", err)
+	}
+	var meta struct {
+		Metadata api.ObjectMeta `json:"metadata"`
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return fmt.Errorf("decoding watch object: %w", err)
+	}
+	name := lo.FromPtr(meta.Metadata.Name)
+
+	if p.options.Output == string(display.NameFormat) {
+		formatOptions.Name = name
+		return p.formatter.Format(meta, formatOptions)
+	}
+
+	if event.Type == api.WatchEventTypeDeleted {
+		if last, ok := p.objects[name]; ok {
+			raw = last
+		}
+		delete(p.objects, name)
+	} else {
+		p.objects[name] = raw
+	}
+
+	response, err := watchListResponse(p.kind, raw)
+	if err != nil {
+		return err
+	}
+	var table bytes.Buffer
+	formatOptions.Writer = &table
+	if err := p.formatter.Format(response, formatOptions); err != nil {
+		return err
+	}
+	p.printWithEventType(event.Type, table.String())
+	return nil
+}
+
+// printWithEventType prints table rows prefixed with an EVENT column holding
+// the type of the watch event.
+func (p *watchPrinter) printWithEventType(eventType api.WatchEventType, table string) {
+	for _, line := range strings.SplitAfter(table, "\n") {
+		if len(line) == 0 {
+			continue
+		}
+		column := string(eventType)
+		if !p.headerPrinted {
+			column = "EVENT"
+			p.headerPrinted = true
+		}
+		fmt.Fprintf(os.Stdout, "%-*s\t%s", watchEventColumnWidth, column, line)
+	}
+}
+
+// watchListResponse wraps a single watched object in the list response the
+// table formatter expects for its kind.
+func watchListResponse(kind ResourceKind, raw json.RawMessage) (interface{}, error) {
+	switch kind {
+	case DeviceKind:
+		var device api.Device
+		if err := json.Unmarshal(raw, &device); err != nil {
+			return nil, fmt.Errorf("decoding device: %w", err)
+		}
+		return &apiclient.ListDevicesResponse{JSON200: &api.DeviceList{Items: []api.Device{device}}}, nil
+	case FleetKind:
+		var fleet api.Fleet
+		if err := json.Unmarshal(raw, &fleet); err != nil {
+			return nil, fmt.Errorf("decoding fleet: %w", err)
+		}
+		return &apiclient.ListFleetsResponse{JSON200: &api.FleetList{Items: []api.Fleet{fleet}}}, nil
+	case EventKind:
+		var event api.Event
+		if err := json.Unmarshal(raw, &event); err != nil {
+			return nil, fmt.Errorf("decoding event: %w", err)
+		}
+		return &apiclient.ListEventsResponse{JSON200: &api.EventList{Items: []api.Event{event}}}, nil
+	default:
+		return nil, fmt.Errorf("unsupported resource kind: %s", kind)
+	}
+}
+
+func remarshal(in any, out any) error {
+	b, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, out)
+}
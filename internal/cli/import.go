@@ -0,0 +1,246 @@
+package cli
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/flightctl/flightctl/internal/orgexport"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+type ImportOptions struct {
+	GlobalOptions
+
+	VerifyKey string
+	DryRun    bool
+	Overwrite bool
+}
+
+func DefaultImportOptions() *ImportOptions {
+	return &ImportOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdImport() *cobra.Command {
+	o := DefaultImportOptions()
+	cmd := &cobra.Command{
+		Use:   "import FILE",
+		Short: "Import an organization archive created by \"flightctl export\".",
+		Long: `Import the resources of an archive created by "flightctl export" into the current organization.
+Resources keep their names. Resources that already exist with different labels, annotations or
+spec are reported as conflicts and left untouched unless --overwrite is given. Repository
+credentials are not part of the archive and must be set again on new repositories.
+
+Archives contain no template versions, which fleets render again, and no ResourceSyncs, which
+must be recreated if the organization should keep syncing from git.
+
+The import writes through the API with the permissions of the current user and does not need
+access to the database of the installation.`,
+		Example: `  # Show what importing a signed archive would change
+  flightctl import staging.tar.gz --verify-key export-signing.pub --dry-run
+
+  # Import it, replacing resources that differ
+  flightctl import staging.tar.gz --verify-key export-signing.pub --overwrite`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *ImportOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.VerifyKey, "verify-key", o.VerifyKey, "Path to a PEM encoded public key or certificate. If set, the archive must be signed by the matching private key.")
+	fs.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only report what would change, without changing anything.")
+	fs.BoolVar(&o.Overwrite, "overwrite", o.Overwrite, "Replace existing resources whose content differs from the archive.")
+}
+
+func (o *ImportOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ImportOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	if len(args[0]) == 0 {
+		return fmt.Errorf("specify the archive to import")
+	}
+	return nil
+}
+
+func (o *ImportOptions) Run(ctx context.Context, args []string) error {
+	var verifyKey crypto.PublicKey
+	if len(o.VerifyKey) > 0 {
+		data, err := os.ReadFile(o.VerifyKey)
+		if err != nil {
+			return fmt.Errorf("reading verification key: %w", err)
+		}
+		if verifyKey, err = orgexport.ParsePublicKeyPEM(data); err != nil {
+			return fmt.Errorf("parsing verification key: %w", err)
+		}
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		return fmt.Errorf("opening %s: %w", args[0], err)
+	}
+	defer f.Close()
+	archive, err := orgexport.Read(f, verifyKey)
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", args[0], err)
+	}
+
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	report := orgexport.Import(ctx, archive, &importTarget{client: c}, orgexport.ImportOptions{
+		DryRun:    o.DryRun,
+		Overwrite: o.Overwrite,
+	})
+	printImportReport(os.Stdout, report)
+
+	if failed := report.Count(orgexport.ActionFailed); failed > 0 {
+		return fmt.Errorf("%d resource(s) failed to import", failed)
+	}
+	if conflicts := report.Count(orgexport.ActionConflict); conflicts > 0 {
+		return fmt.Errorf("%d resource(s) conflict with existing resources; use --overwrite to replace them", conflicts)
+	}
+	return nil
+}
+
+// printImportReport writes a human-readable summary of an import.
+func printImportReport(w io.Writer, report *orgexport.Report) {
+	fmt.Fprint(w, "Import")
+	if report.SourceOrganization != "" {
+		fmt.Fprintf(w, " of organization %s", report.SourceOrganization)
+	}
+	if report.DryRun {
+		fmt.Fprint(w, " (dry run)")
+	}
+	fmt.Fprintln(w, ":")
+
+	for _, result := range report.Results {
+		var symbol string
+		switch result.Action {
+		case orgexport.ActionCreate:
+			symbol = "+"
+		case orgexport.ActionUpdate:
+			symbol = "~"
+		case orgexport.ActionConflict:
+			symbol = "!"
+		case orgexport.ActionFailed:
+			symbol = "x"
+		default:
+			continue
+		}
+		fmt.Fprintf(w, "  %s %s/%s", symbol, result.Kind, result.Name)
+		if result.Message != "" {
+			fmt.Fprintf(w, ": %s", result.Message)
+		}
+		fmt.Fprintln(w)
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d unchanged, %d conflicting, %d failed.\n",
+		report.Count(orgexport.ActionCreate), report.Count(orgexport.ActionUpdate), report.Count(orgexport.ActionUnchanged),
+		report.Count(orgexport.ActionConflict), report.Count(orgexport.ActionFailed))
+}
+
+// importTarget imports into the organization of the client.
+type importTarget struct {
+	client *client.Client
+}
+
+func (t *importTarget) Get(ctx context.Context, kind string, r orgexport.Resource) (orgexport.Resource, error) {
+	var response interface{}
+	var err error
+	switch kind {
+	case apiv1alpha1.CatalogKind:
+		response, err = t.client.V1Alpha1().GetCatalogWithResponse(ctx, r.Name())
+	case apiv1alpha1.CatalogItemKind:
+		response, err = t.client.V1Alpha1().GetCatalogItemWithResponse(ctx, r.Catalog(), r.Name())
+	default:
+		response, err = GetSingleResource(ctx, t.client, ResourceKind(strings.ToLower(kind)), r.Name())
+	}
+	if err != nil {
+		return nil, err
+	}
+	httpResponse, err := responseField[*http.Response](response, "HTTPResponse")
+	if err != nil {
+		return nil, err
+	}
+	if httpResponse.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	json200, err := ExtractJSON200(response)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(json200)
+	if err != nil {
+		return nil, err
+	}
+	var existing orgexport.Resource
+	if err := json.Unmarshal(data, &existing); err != nil {
+		return nil, err
+	}
+	return existing, nil
+}
+
+func (t *importTarget) Apply(ctx context.Context, kind string, r orgexport.Resource) error {
+	buf, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	result := applyResourceByKind(ctx, t.client, nil, ResourceKind(strings.ToLower(kind)), r.Name(), buf)
+	if result.err != nil {
+		return result.err
+	}
+	if result.status != nil {
+		return &APIError{Status: result.status}
+	}
+	return nil
+}
+
+func (t *importTarget) ApproveEnrollmentRequest(ctx context.Context, name string, approval map[string]interface{}) error {
+	labels := map[string]string{}
+	if approvalLabels, ok := approval["labels"].(map[string]interface{}); ok {
+		for key, value := range approvalLabels {
+			labels[key] = fmt.Sprint(value)
+		}
+	}
+	response, err := t.client.ApproveEnrollmentRequest(ctx, name, api.EnrollmentRequestApproval{
+		Approved: true,
+		Labels:   &labels,
+	})
+	return processApprovalReponse(response, err, EnrollmentRequestKind, name)
+}
@@ -0,0 +1,32 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/orgexport"
+)
+
+func TestPrintImportReport(t *testing.T) {
+	report := &orgexport.Report{
+		SourceOrganization: "00000000-0000-0000-0000-000000000000",
+		DryRun:             true,
+		Results: []orgexport.Result{
+			{Kind: "Repository", Name: "private", Action: orgexport.ActionCreate, Message: "credentials were not exported and must be set again"},
+			{Kind: "Fleet", Name: "web", Action: orgexport.ActionConflict, Message: "exists with different spec"},
+			{Kind: "Fleet", Name: "db", Action: orgexport.ActionUnchanged},
+		},
+	}
+
+	var out bytes.Buffer
+	printImportReport(&out, report)
+
+	expected := `Import of organization 00000000-0000-0000-0000-000000000000 (dry run):
+  + Repository/private: credentials were not exported and must be set again
+  ! Fleet/web: exists with different spec
+1 to create, 0 to update, 1 unchanged, 1 conflicting, 0 failed.
+`
+	if out.String() != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out.String(), expected)
+	}
+}
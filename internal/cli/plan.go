@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"slices"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/cli/display"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"sigs.k8s.io/yaml"
+)
+
+var legalPlanOutputTypes = []string{string(display.JSONFormat), string(display.YAMLFormat)}
+
+type PlanOptions struct {
+	GlobalOptions
+
+	Revision string
+	Output   string
+}
+
+func DefaultPlanOptions() *PlanOptions {
+	return &PlanOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdPlan() *cobra.Command {
+	o := DefaultPlanOptions()
+	cmd := &cobra.Command{
+		Use:   "plan resourcesync/NAME",
+		Short: "Show the changes a ResourceSync would apply without applying them.",
+		Long: `Show the changes a ResourceSync would apply if it synced the given revision of its repository.
+Nothing is written. The command fails if the repository contents have validation errors,
+which makes it suitable as a check on pull requests against a GitOps repository.`,
+		Example: `  # Preview what resourcesync/site-config would change at its target revision
+  flightctl plan resourcesync/site-config
+
+  # Preview a branch before merging it
+  flightctl plan resourcesync/site-config --revision feature-branch -o yaml`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{ResourceSyncKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *PlanOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.Revision, "revision", o.Revision, "Git revision to plan against. Defaults to the targetRevision of the ResourceSync.")
+	fs.StringVarP(&o.Output, FlagOutput, "o", o.Output, fmt.Sprintf("Output format. One of: (%s). Defaults to a human-readable summary.", strings.Join(legalPlanOutputTypes, ", ")))
+}
+
+func (o *PlanOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *PlanOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+	if kind != ResourceSyncKind {
+		return fmt.Errorf("kind must be ResourceSync")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific resourcesync to plan")
+	}
+	if len(o.Output) > 0 && !slices.Contains(legalPlanOutputTypes, o.Output) {
+		return fmt.Errorf("output format must be one of (%s)", strings.Join(legalPlanOutputTypes, ", "))
+	}
+	return nil
+}
+
+func (o *PlanOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	body := api.ResourceSyncPlanRequest{}
+	if len(o.Revision) > 0 {
+		body.TargetRevision = lo.ToPtr(o.Revision)
+	}
+	response, err := c.PlanResourceSyncWithResponse(ctx, name, body)
+	if err != nil {
+		return fmt.Errorf("planning resourcesync %s: %w", name, err)
+	}
+	if response.HTTPResponse != nil && response.HTTPResponse.StatusCode != http.StatusOK {
+		return &CLIError{
+			Context: fmt.Sprintf("planning resourcesync %s: failed", name),
+			Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+		}
+	}
+	plan := response.JSON200
+	if plan == nil {
+		return fmt.Errorf("planning resourcesync %s: empty response", name)
+	}
+
+	switch o.Output {
+	case string(display.JSONFormat):
+		marshalled, err := json.MarshalIndent(plan, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshalling plan: %w", err)
+		}
+		fmt.Println(string(marshalled))
+	case string(display.YAMLFormat):
+		marshalled, err := yaml.Marshal(plan)
+		if err != nil {
+			return fmt.Errorf("marshalling plan: %w", err)
+		}
+		fmt.Print(string(marshalled))
+	default:
+		printResourceSyncPlan(os.Stdout, name, plan)
+	}
+
+	if len(plan.Errors) > 0 {
+		return fmt.Errorf("resourcesync %s: plan has %d validation error(s)", name, len(plan.Errors))
+	}
+	return nil
+}
+
+// printResourceSyncPlan writes a human-readable summary of the plan.
+func printResourceSyncPlan(w io.Writer, name string, plan *api.ResourceSyncPlan) {
+	fmt.Fprintf(w, "Plan for resourcesync/%s at revision %s", name, plan.TargetRevision)
+	if commit := lo.FromPtr(plan.Commit); commit != "" {
+		fmt.Fprintf(w, " (commit %s)", commit)
+	}
+	fmt.Fprintln(w, ":")
+
+	counts := map[api.ResourceSyncPlannedChangeAction]int{}
+	if len(plan.Changes) == 0 {
+		fmt.Fprintln(w, "  No changes.")
+	}
+	for _, change := range plan.Changes {
+		counts[change.Action]++
+		symbol := "~"
+		switch change.Action {
+		case api.ResourceSyncPlannedActionCreate:
+			symbol = "+"
+		case api.ResourceSyncPlannedActionDelete:
+			symbol = "-"
+		}
+		fmt.Fprintf(w, "  %s %s/%s\n", symbol, change.Kind, change.Name)
+		for _, diff := range change.Diff {
+			fmt.Fprintf(w, "      %s: %s -> %s\n", diff.Path, formatPlanValue(diff.Current), formatPlanValue(diff.Desired))
+		}
+	}
+	fmt.Fprintf(w, "%d to create, %d to update, %d to delete.\n",
+		counts[api.ResourceSyncPlannedActionCreate], counts[api.ResourceSyncPlannedActionUpdate], counts[api.ResourceSyncPlannedActionDelete])
+
+	if len(plan.Errors) > 0 {
+		fmt.Fprintln(w, "Errors:")
+		for _, planErr := range plan.Errors {
+			fmt.Fprintf(w, "  %s\n", planErr)
+		}
+	}
+}
+
+func formatPlanValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	marshalled, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(marshalled)
+}
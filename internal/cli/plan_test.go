@@ -0,0 +1,38 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+)
+
+func TestPrintResourceSyncPlan(t *testing.T) {
+	plan := &api.ResourceSyncPlan{
+		TargetRevision: "main",
+		Commit:         lo.ToPtr("abc123"),
+		Changes: []api.ResourceSyncPlannedChange{
+			{Kind: "Fleet", Name: "web", Action: api.ResourceSyncPlannedActionCreate, Diff: []api.ResourceSyncFieldDiff{
+				{Path: "/metadata/labels/env", Desired: "prod"},
+			}},
+			{Kind: "Fleet", Name: "old", Action: api.ResourceSyncPlannedActionDelete, Diff: []api.ResourceSyncFieldDiff{}},
+		},
+		Errors: []string{"found multiple fleet definitions with name 'dup'"},
+	}
+
+	var out bytes.Buffer
+	printResourceSyncPlan(&out, "site-config", plan)
+
+	expected := `Plan for resourcesync/site-config at revision main (commit abc123):
+  + Fleet/web
+      /metadata/labels/env: <none> -> "prod"
+  - Fleet/old
+1 to create, 0 to update, 1 to delete.
+Errors:
+  found multiple fleet definitions with name 'dup'
+`
+	if out.String() != expected {
+		t.Fatalf("unexpected output:\n%s\nexpected:\n%s", out.String(), expected)
+	}
+}
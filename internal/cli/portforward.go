@@ -0,0 +1,334 @@
+package cli
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/client"
+	"github.com/gorilla/websocket"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const (
+	defaultPortForwardAddress = "127.0.0.1"
+	defaultPortForwardHost    = "localhost"
+	portForwardBufferSize     = 32 * 1024
+)
+
+type PortForwardOptions struct {
+	GlobalOptions
+
+	Address string
+}
+
+// portForwardSpec is a local port forwarded to a host and port as seen from the device.
+type portForwardSpec struct {
+	LocalPort  int
+	RemoteHost string
+	RemotePort int
+}
+
+func DefaultPortForwardOptions() *PortForwardOptions {
+	return &PortForwardOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+		Address:       defaultPortForwardAddress,
+	}
+}
+
+func NewCmdPortForward() *cobra.Command {
+	o := DefaultPortForwardOptions()
+	cmd := &cobra.Command{
+		Use:   "port-forward device/NAME [LOCAL_PORT:][HOST:]PORT [...]",
+		Short: "Forward local ports to TCP ports reachable from a device.",
+		Long: `Forward one or more local ports to TCP ports reachable from a device, tunneled through the
+server. HOST defaults to localhost, that is the device itself. If LOCAL_PORT is omitted it equals
+PORT; if it is empty, as in ":PORT", a free local port is chosen.`,
+		Example: `  # Reach the web server of a device on local port 8080
+  flightctl port-forward device/my-device 8080:80
+
+  # Reach a PLC on the device's local network
+  flightctl port-forward device/my-device 1502:192.168.1.10:502`,
+		Args: cobra.MinimumNArgs(2),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			return o.Run(cmd.Context(), args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *PortForwardOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.Address, "address", o.Address, "Local address to listen on.")
+}
+
+func (o *PortForwardOptions) Complete(cmd *cobra.Command, args []string) error {
+	return o.GlobalOptions.Complete(cmd, args)
+}
+
+func (o *PortForwardOptions) Validate(args []string) error {
+	kind, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("only devices support port forwarding")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("device name is required, use 'device/NAME'")
+	}
+	for _, arg := range args[1:] {
+		if _, err := parsePortForwardSpec(arg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parsePortForwardSpec parses "PORT", "LOCAL_PORT:PORT" or "LOCAL_PORT:HOST:PORT". An empty
+// LOCAL_PORT selects a free local port.
+func parsePortForwardSpec(spec string) (portForwardSpec, error) {
+	ret := portForwardSpec{RemoteHost: defaultPortForwardHost}
+	parts := strings.Split(spec, ":")
+	var local, remote string
+	switch len(parts) {
+	case 1:
+		local, remote = parts[0], parts[0]
+	case 2:
+		local, remote = parts[0], parts[1]
+	case 3:
+		local, ret.RemoteHost, remote = parts[0], parts[1], parts[2]
+		if ret.RemoteHost == "" {
+			return ret, fmt.Errorf("invalid port specification %q: host must not be empty", spec)
+		}
+	default:
+		return ret, fmt.Errorf("invalid port specification %q: expected [LOCAL_PORT:][HOST:]PORT", spec)
+	}
+	var err error
+	if ret.RemotePort, err = parsePortNumber(remote, false); err != nil {
+		return ret, fmt.Errorf("invalid port specification %q: %w", spec, err)
+	}
+	if ret.LocalPort, err = parsePortNumber(local, true); err != nil {
+		return ret, fmt.Errorf("invalid port specification %q: %w", spec, err)
+	}
+	return ret, nil
+}
+
+func parsePortNumber(s string, allowEmpty bool) (int, error) {
+	if s == "" && allowEmpty {
+		return 0, nil
+	}
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%q is not a port number between 1 and 65535", s)
+	}
+	return port, nil
+}
+
+func (o *PortForwardOptions) Run(ctx context.Context, args []string) error {
+	config, err := client.ParseConfigFile(o.ConfigFilePath)
+	if err != nil {
+		return fmt.Errorf("parsing config file: %w", err)
+	}
+	_, name, err := parseAndValidateKindName(args[0])
+	if err != nil {
+		return err
+	}
+	tlsCfg, err := buildTLSConfigForConsole(&config.Service, config.AuthInfo)
+	if err != nil {
+		return err
+	}
+
+	refresher := client.NewAccessTokenRefresher(config, o.ConfigFilePath, 8080)
+	refresher.Start(ctx)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var listeners []net.Listener
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	forwarders := make([]*portForwarder, 0, len(args)-1)
+	for _, arg := range args[1:] {
+		spec, _ := parsePortForwardSpec(arg)
+		listener, err := net.Listen("tcp", net.JoinHostPort(o.Address, strconv.Itoa(spec.LocalPort)))
+		if err != nil {
+			return fmt.Errorf("listening for %s: %w", arg, err)
+		}
+		listeners = append(listeners, listener)
+		forwarders = append(forwarders, &portForwarder{
+			options:    o,
+			server:     config.Service.Server,
+			deviceName: name,
+			spec:       spec,
+			tlsConfig:  tlsCfg,
+			token:      refresher.GetAccessToken,
+			listener:   listener,
+		})
+		fmt.Printf("Forwarding from %s -> %s:%d\n", listener.Addr(), spec.RemoteHost, spec.RemotePort)
+	}
+
+	var wg sync.WaitGroup
+	for _, f := range forwarders {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			f.serve(ctx)
+		}()
+	}
+	<-ctx.Done()
+	for _, l := range listeners {
+		l.Close()
+	}
+	wg.Wait()
+	return nil
+}
+
+// portForwarder accepts connections on a local listener and tunnels each one to the device.
+type portForwarder struct {
+	options    *PortForwardOptions
+	server     string
+	deviceName string
+	spec       portForwardSpec
+	tlsConfig  *tls.Config
+	token      func() string
+	listener   net.Listener
+}
+
+func (f *portForwarder) serve(ctx context.Context) {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		conn, err := f.listener.Accept()
+		if err != nil {
+			if ctx.Err() == nil && !errors.Is(err, net.ErrClosed) {
+				fmt.Fprintf(os.Stderr, "Error accepting connection on %s: %v\n", f.listener.Addr(), err)
+			}
+			return
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer conn.Close()
+			if err := f.forward(ctx, conn); err != nil {
+				fmt.Fprintf(os.Stderr, "Error forwarding to device %s port %d: %v\n", f.deviceName, f.spec.RemotePort, err)
+			}
+		}()
+	}
+}
+
+func (f *portForwarder) buildURL() (string, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/ws/v1/devices/%s/portforward", f.server, url.PathEscape(f.deviceName)))
+	if err != nil {
+		return "", fmt.Errorf("parsing port-forward URL: %w", err)
+	}
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	}
+	q := url.Values{}
+	q.Set(api.DeviceQueryPortForwardHost, f.spec.RemoteHost)
+	q.Set(api.DeviceQueryPortForwardPort, strconv.Itoa(f.spec.RemotePort))
+	q.Set(api.OrganizationIDQueryKey, f.options.GetEffectiveOrganization())
+	u.RawQuery = q.Encode()
+	return u.String(), nil
+}
+
+// forward tunnels one local connection until either side closes it.
+func (f *portForwarder) forward(ctx context.Context, conn net.Conn) error {
+	connURL, err := f.buildURL()
+	if err != nil {
+		return err
+	}
+	dialer := websocket.Dialer{
+		TLSClientConfig: f.tlsConfig,
+		Subprotocols:    []string{api.DevicePortForwardProtocol},
+	}
+	headers := http.Header{}
+	if token := f.token(); token != "" {
+		headers.Set("Authorization", "Bearer "+token)
+	}
+	ws, resp, err := dialer.DialContext(ctx, connURL, headers)
+	if err != nil {
+		if resp != nil {
+			defer resp.Body.Close()
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, portForwardBufferSize))
+			return fmt.Errorf("%s: %s", http.StatusText(resp.StatusCode), strings.TrimSpace(string(body)))
+		}
+		return err
+	}
+	defer ws.Close()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		<-ctx.Done()
+		ws.Close()
+		conn.Close()
+	}()
+
+	// local connection -> device
+	go func() {
+		defer cancel()
+		buf := make([]byte, portForwardBufferSize)
+		for {
+			n, err := conn.Read(buf)
+			if n > 0 {
+				if werr := ws.WriteMessage(websocket.BinaryMessage, buf[:n]); werr != nil {
+					return
+				}
+			}
+			if err != nil {
+				_ = ws.WriteMessage(websocket.CloseMessage, websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""))
+				return
+			}
+		}
+	}()
+
+	// device -> local connection
+	for {
+		msgType, msg, err := ws.ReadMessage()
+		if err != nil {
+			if ctx.Err() != nil || websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				return nil
+			}
+			return err
+		}
+		if msgType != websocket.BinaryMessage || len(msg) == 0 {
+			continue
+		}
+		if _, err := conn.Write(msg); err != nil {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package cli
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePortForwardSpec(t *testing.T) {
+	tests := []struct {
+		spec        string
+		want        portForwardSpec
+		errContains string
+	}{
+		{spec: "8080", want: portForwardSpec{LocalPort: 8080, RemoteHost: "localhost", RemotePort: 8080}},
+		{spec: "9000:80", want: portForwardSpec{LocalPort: 9000, RemoteHost: "localhost", RemotePort: 80}},
+		{spec: ":80", want: portForwardSpec{LocalPort: 0, RemoteHost: "localhost", RemotePort: 80}},
+		{spec: "1502:192.168.1.10:502", want: portForwardSpec{LocalPort: 1502, RemoteHost: "192.168.1.10", RemotePort: 502}},
+		{spec: "1502::502", errContains: "host must not be empty"},
+		{spec: "80:", errContains: "not a port number"},
+		{spec: "70000", errContains: "not a port number"},
+		{spec: "http", errContains: "not a port number"},
+		{spec: "1:2:3:4", errContains: "expected [LOCAL_PORT:][HOST:]PORT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.spec, func(t *testing.T) {
+			got, err := parsePortForwardSpec(tt.spec)
+			if tt.errContains != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPortForwardOptions_Validate(t *testing.T) {
+	o := DefaultPortForwardOptions()
+	assert.NoError(t, o.Validate([]string{"device/mydevice", "8080:80"}))
+	assert.ErrorContains(t, o.Validate([]string{"fleet/myfleet", "8080:80"}), "only devices")
+	assert.ErrorContains(t, o.Validate([]string{"device/", "8080:80"}), "device name is required")
+	assert.ErrorContains(t, o.Validate([]string{"device/mydevice", "80:x"}), "invalid port specification")
+}
+
+func TestPortForwarder(t *testing.T) {
+	queries := make(chan url.Values, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries <- r.URL.Query()
+		upgrader := websocket.Upgrader{Subprotocols: []string{api.DevicePortForwardProtocol}}
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		// echo until the client closes
+		for {
+			msgType, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if err := conn.WriteMessage(msgType, msg); err != nil {
+				return
+			}
+		}
+	}))
+	defer server.Close()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	f := &portForwarder{
+		options:    DefaultPortForwardOptions(),
+		server:     server.URL,
+		deviceName: "mydevice",
+		spec:       portForwardSpec{RemoteHost: "10.0.0.5", RemotePort: 502},
+		token:      func() string { return "" },
+		listener:   listener,
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		f.serve(ctx)
+	}()
+
+	conn, err := net.Dial("tcp", listener.Addr().String())
+	require.NoError(t, err)
+	_, err = conn.Write([]byte("ping"))
+	require.NoError(t, err)
+	buf := make([]byte, 4)
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+	assert.Equal(t, "ping", string(buf))
+	conn.Close()
+
+	query := <-queries
+	assert.Equal(t, "10.0.0.5", query.Get(api.DeviceQueryPortForwardHost))
+	assert.Equal(t, "502", query.Get(api.DeviceQueryPortForwardPort))
+
+	listener.Close()
+	cancel()
+	<-done
+}
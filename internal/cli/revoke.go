@@ -0,0 +1,144 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+const maxRevocationReasonLength = 256
+
+type RevokeOptions struct {
+	GlobalOptions
+
+	Reason string
+}
+
+func DefaultRevokeOptions() *RevokeOptions {
+	return &RevokeOptions{
+		GlobalOptions: DefaultGlobalOptions(),
+	}
+}
+
+func NewCmdRevoke() *cobra.Command {
+	o := DefaultRevokeOptions()
+	cmd := &cobra.Command{
+		Use:   "revoke device/NAME",
+		Short: "Revoke the certificates issued to a device.",
+		Long: `Revoke all certificates issued to a device, e.g. because the device was stolen or compromised.
+The service rejects revoked certificates and lists them in its certificate revocation list.
+The device has to be re-enrolled to connect again.`,
+		Example: `  # Revoke the certificates of a stolen device
+  flightctl revoke device/my-device --reason "device stolen"`,
+		Args: cobra.ExactArgs(1),
+		ValidArgsFunction: KindNameAutocomplete{
+			Options:            o,
+			AllowMultipleNames: false,
+			AllowedKinds:       []ResourceKind{DeviceKind},
+		}.ValidArgsFunction,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.Complete(cmd, args); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			ctx, cancel := o.WithTimeout(cmd.Context())
+			defer cancel()
+			return o.Run(ctx, args)
+		},
+		SilenceUsage: true,
+	}
+	o.Bind(cmd.Flags())
+	return cmd
+}
+
+func (o *RevokeOptions) Bind(fs *pflag.FlagSet) {
+	o.GlobalOptions.Bind(fs)
+
+	fs.StringVar(&o.Reason, "reason", o.Reason, "Reason for revoking the certificates, recorded with the revocation.")
+}
+
+func (o *RevokeOptions) Complete(cmd *cobra.Command, args []string) error {
+	if err := o.GlobalOptions.Complete(cmd, args); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *RevokeOptions) Validate(args []string) error {
+	if err := o.GlobalOptions.Validate(args); err != nil {
+		return err
+	}
+	return o.validateArgs(args)
+}
+
+func (o *RevokeOptions) validateArgs(args []string) error {
+	kind, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+	if kind != DeviceKind {
+		return fmt.Errorf("kind must be Device")
+	}
+	if len(name) == 0 {
+		return fmt.Errorf("specify a specific device to revoke")
+	}
+	if len(o.Reason) > maxRevocationReasonLength {
+		return fmt.Errorf("reason must be at most %d characters", maxRevocationReasonLength)
+	}
+	return nil
+}
+
+func (o *RevokeOptions) Run(ctx context.Context, args []string) error {
+	c, err := o.BuildClient()
+	if err != nil {
+		return fmt.Errorf("creating client: %w", err)
+	}
+	c.Start(ctx)
+	defer c.Stop()
+
+	_, name, err := parseAndValidateKindNameFromArgsSingle(args)
+	if err != nil {
+		return err
+	}
+
+	body := api.DeviceCertificateRevocationRequest{Reason: lo.EmptyableToPtr(o.Reason)}
+	response, err := c.RevokeDeviceCertificatesWithResponse(ctx, name, body)
+	if err != nil {
+		return fmt.Errorf("revoking certificates of device %s: %w", name, err)
+	}
+	if response.HTTPResponse != nil && response.HTTPResponse.StatusCode != http.StatusOK {
+		return &CLIError{
+			Context: fmt.Sprintf("revoking certificates of device %s: failed", name),
+			Err:     &APIError{Status: ParseStatusFromBody(response.Body)},
+		}
+	}
+	if response.JSON200 == nil {
+		return fmt.Errorf("revoking certificates of device %s: empty response", name)
+	}
+
+	return printRevocations(os.Stdout, name, response.JSON200.Items)
+}
+
+func printRevocations(out io.Writer, name string, revocations []api.CertificateRevocation) error {
+	if len(revocations) == 0 {
+		_, err := fmt.Fprintf(out, "No unexpired certificates found for device %s\n", name)
+		return err
+	}
+	w := tabwriter.NewWriter(out, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "SERIAL\tREVOKED AT\tEXPIRES AT\tREASON")
+	for _, r := range revocations {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", r.SerialNumber, r.RevokedAt.Format(time.RFC3339), r.ExpiresAt.Format(time.RFC3339), lo.FromPtr(r.Reason))
+	}
+	return w.Flush()
+}
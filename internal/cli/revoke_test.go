@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRevokeOptions_validateArgs(t *testing.T) {
+	tests := []struct {
+		name          string
+		args          []string
+		reason        string
+		errorContains string
+	}{
+		{
+			name: "valid device",
+			args: []string{"device/my-device"},
+		},
+		{
+			name:   "valid device with reason",
+			args:   []string{"device/my-device"},
+			reason: "device stolen",
+		},
+		{
+			name:          "wrong kind",
+			args:          []string{"fleet/my-fleet"},
+			errorContains: "kind must be Device",
+		},
+		{
+			name:          "missing name",
+			args:          []string{"devices"},
+			errorContains: "exactly one resource name must be specified",
+		},
+		{
+			name:          "reason too long",
+			args:          []string{"device/my-device"},
+			reason:        strings.Repeat("x", maxRevocationReasonLength+1),
+			errorContains: "reason must be at most",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := &RevokeOptions{Reason: tt.reason}
+			err := o.validateArgs(tt.args)
+			if tt.errorContains == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tt.errorContains)
+		})
+	}
+}
+
+func TestPrintRevocations(t *testing.T) {
+	t.Run("no certificates", func(t *testing.T) {
+		var out bytes.Buffer
+		require.NoError(t, printRevocations(&out, "my-device", nil))
+		require.Equal(t, "No unexpired certificates found for device my-device\n", out.String())
+	})
+
+	t.Run("lists revocations", func(t *testing.T) {
+		revokedAt := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+		var out bytes.Buffer
+		require.NoError(t, printRevocations(&out, "my-device", []api.CertificateRevocation{{
+			SerialNumber: "1a2b",
+			DeviceName:   "my-device",
+			Reason:       lo.ToPtr("device stolen"),
+			RevokedAt:    revokedAt,
+			ExpiresAt:    revokedAt.Add(24 * time.Hour),
+		}}))
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		require.Len(t, lines, 2)
+		require.Contains(t, lines[0], "SERIAL")
+		require.Contains(t, lines[1], "1a2b")
+		require.Contains(t, lines[1], "2026-01-02T03:04:05Z")
+		require.Contains(t, lines[1], "device stolen")
+	})
+}
@@ -1,6 +1,10 @@
 package ca
 
 import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
 	"github.com/flightctl/flightctl/internal/domain"
 )
 
@@ -21,6 +25,41 @@ type InternalCfg struct {
 	CertStore        string `json:"certStore,omitempty"`
 }
 
+// PKCS11Cfg configures a CA whose signing key is held by a PKCS#11 token, such as an HSM.
+// The CA certificate is still read from InternalCfg.CertFile.
+type PKCS11Cfg struct {
+	// ModulePath is the path to the PKCS#11 library of the token.
+	ModulePath string `json:"modulePath,omitempty"`
+	// TokenLabel, TokenSerial or SlotNumber select the token holding the key.
+	TokenLabel  string `json:"tokenLabel,omitempty"`
+	TokenSerial string `json:"tokenSerial,omitempty"`
+	SlotNumber  *int   `json:"slotNumber,omitempty"`
+	// PinFile is the path to a file containing the user PIN of the token.
+	PinFile string `json:"pinFile,omitempty"`
+	// KeyLabel and/or KeyID (hex-encoded CKA_ID) select the signing key pair on the token.
+	KeyLabel string `json:"keyLabel,omitempty"`
+	KeyID    string `json:"keyId,omitempty"`
+}
+
+func (c *PKCS11Cfg) Validate() error {
+	if c.ModulePath == "" {
+		return errors.New("modulePath must be set")
+	}
+	if c.TokenLabel == "" && c.TokenSerial == "" && c.SlotNumber == nil {
+		return errors.New("one of tokenLabel, tokenSerial or slotNumber must be set")
+	}
+	if c.PinFile == "" {
+		return errors.New("pinFile must be set")
+	}
+	if c.KeyLabel == "" && c.KeyID == "" {
+		return errors.New("keyLabel or keyId must be set")
+	}
+	if _, err := hex.DecodeString(c.KeyID); err != nil {
+		return fmt.Errorf("keyId must be hex-encoded: %w", err)
+	}
+	return nil
+}
+
 type Config struct {
 	CAType                            CAIdType     `json:"type,omitempty"`
 	AdminCommonName                   string       `json:"adminCommonName,omitempty"`
@@ -32,11 +71,16 @@ type Config struct {
 	DeviceManagementRenewalSignerName string       `json:"deviceManagementRenewalSignerName,omitempty"`
 	DeviceSvcClientSignerName         string       `json:"deviceSvcClientSignerName,omitempty"`
 	ServerSvcSignerName               string       `json:"serverSvcSignerName,omitempty"`
+	DeviceWorkloadSignerName          string       `json:"deviceWorkloadSignerName,omitempty"`
 	ClientBootstrapValidityDays       int          `json:"clientBootstrapValidityDays,omitempty"`
 	DeviceCommonNamePrefix            string       `json:"deviceCommonNamePrefix,omitempty"`
 	InternalConfig                    *InternalCfg `json:"internalConfig,omitempty"`
+	PKCS11Config                      *PKCS11Cfg   `json:"pkcs11Config,omitempty"`
 	ServerCertValidityDays            int          `json:"serverCertValidityDays,omitempty"`
 	ExtraAllowedPrefixes              []string     `json:"extraAllowedPrefixes,omitempty"`
+	// DeviceWorkloadReservedNames are host names and IP addresses that workload certificates
+	// must not be issued for. The host names of the service endpoints are always reserved.
+	DeviceWorkloadReservedNames []string `json:"deviceWorkloadReservedNames,omitempty"`
 }
 
 func NewDefault(tempDir string) *Config {
@@ -51,6 +95,7 @@ func NewDefault(tempDir string) *Config {
 		DeviceManagementRenewalSignerName: "flightctl.io/device-management-renewal",
 		DeviceSvcClientSignerName:         "flightctl.io/device-svc-client",
 		ServerSvcSignerName:               "flightctl.io/server-svc",
+		DeviceWorkloadSignerName:          "flightctl.io/device-workload",
 		ClientBootstrapValidityDays:       365,
 		ServerCertValidityDays:            365,
 		DeviceCommonNamePrefix:            "device:",
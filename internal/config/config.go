@@ -3,6 +3,7 @@ package config
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"os"
 	"path/filepath"
 	"slices"
@@ -29,6 +30,7 @@ type Config struct {
 	ImageBuilderService    *ImageBuilderServiceConfig `json:"imageBuilderService,omitempty"`
 	ImageBuilderWorker     *imageBuilderWorkerConfig  `json:"imageBuilderWorker,omitempty"`
 	KV                     *kvConfig                  `json:"kv,omitempty"`
+	Queues                 *queuesConfig              `json:"queues,omitempty"`
 	Alertmanager           *alertmanagerConfig        `json:"alertmanager,omitempty"`
 	Auth                   *authConfig                `json:"auth,omitempty"`
 	Metrics                *metricsConfig             `json:"metrics,omitempty"`
@@ -115,6 +117,35 @@ type dbConfig struct {
 	SSLRootCert string `json:"sslrootcert,omitempty"`
 }
 
+// DSN returns the connection string for the database using the given credentials
+func (c *dbConfig) DSN(user string, password api.SecureString) string {
+	dsn := fmt.Sprintf("host=%s user=%s password=%s port=%d",
+		c.Hostname,
+		user,
+		password.Value(),
+		c.Port,
+	)
+	if c.Name != "" {
+		dsn = fmt.Sprintf("%s dbname=%s", dsn, c.Name)
+	}
+
+	// Add SSL parameters if they are configured
+	if c.SSLMode != "" {
+		dsn = fmt.Sprintf("%s sslmode=%s", dsn, c.SSLMode)
+	}
+	if c.SSLCert != "" {
+		dsn = fmt.Sprintf("%s sslcert=%s", dsn, c.SSLCert)
+	}
+	if c.SSLKey != "" {
+		dsn = fmt.Sprintf("%s sslkey=%s", dsn, c.SSLKey)
+	}
+	if c.SSLRootCert != "" {
+		dsn = fmt.Sprintf("%s sslrootcert=%s", dsn, c.SSLRootCert)
+	}
+
+	return dsn
+}
+
 type svcConfig struct {
 	Address                string           `json:"address,omitempty"`
 	AgentEndpointAddress   string           `json:"agentEndpointAddress,omitempty"`
@@ -143,6 +174,48 @@ type svcConfig struct {
 	RateLimit              *RateLimitConfig `json:"rateLimit,omitempty"`
 	TPMCAPaths             []string         `json:"tpmCAPaths,omitempty"`
 	HealthChecks           *HealthChecks    `json:"healthChecks,omitempty"`
+	PortForward            *PortForward     `json:"portForward,omitempty"`
+}
+
+// PortForward restricts the device ports that can be reached with "flightctl port-forward".
+type PortForward struct {
+	// AllowedPorts lists the target ports, or port ranges such as "8000-8099", that users with the
+	// devices/portforward permission may forward to. Other ports additionally require the
+	// devices/portforward/anyport permission.
+	AllowedPorts []string `json:"allowedPorts,omitempty"`
+}
+
+// AllowsPort reports whether port is in AllowedPorts. Entries are validated when the config is loaded.
+func (p *PortForward) AllowsPort(port int) bool {
+	if p == nil {
+		return false
+	}
+	for _, entry := range p.AllowedPorts {
+		low, high, err := parsePortRange(entry)
+		if err == nil && port >= low && port <= high {
+			return true
+		}
+	}
+	return false
+}
+
+func parsePortRange(entry string) (int, int, error) {
+	lowStr, highStr, isRange := strings.Cut(strings.TrimSpace(entry), "-")
+	if !isRange {
+		highStr = lowStr
+	}
+	low, err := strconv.Atoi(strings.TrimSpace(lowStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", entry)
+	}
+	high, err := strconv.Atoi(strings.TrimSpace(highStr))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid port %q", entry)
+	}
+	if low < 1 || high > 65535 || low > high {
+		return 0, 0, fmt.Errorf("invalid port range %q", entry)
+	}
+	return low, high, nil
 }
 
 // HealthChecks holds health check endpoint configuration.
@@ -247,6 +320,14 @@ type imageBuilderWorkerConfig struct {
 	DNFRetries               *int                 `json:"dnfRetries,omitempty"`
 	DNFSkipUnavailable       *bool                `json:"dnfSkipUnavailable,omitempty"`
 	SBOM                     *SBOMConfig          `json:"sbom,omitempty"`
+	Signing                  *ImageSigningConfig  `json:"signing,omitempty"`
+}
+
+// ImageSigningConfig holds configuration for signing pushed images.
+type ImageSigningConfig struct {
+	// KeyFile is the path to an unencrypted PEM encoded ECDSA, RSA or Ed25519 private key.
+	// Images are not signed if empty.
+	KeyFile string `json:"keyFile,omitempty"`
 }
 
 // SBOMConfig holds configuration for SBOM generation during image builds.
@@ -428,6 +509,14 @@ func (c *imageBuilderWorkerConfig) SBOMUploadToTrustify() bool {
 	return c.SBOM.UploadToTrustify
 }
 
+// SigningKeyFile returns the path to the image signing key, or an empty string if signing is disabled.
+func (c *imageBuilderWorkerConfig) SigningKeyFile() string {
+	if c == nil || c.Signing == nil {
+		return ""
+	}
+	return c.Signing.KeyFile
+}
+
 // NewDefaultImageBuilderServiceConfig returns a default ImageBuilder service configuration
 func NewDefaultImageBuilderServiceConfig() *ImageBuilderServiceConfig {
 	return &ImageBuilderServiceConfig{
@@ -456,6 +545,26 @@ type kvConfig struct {
 	Password api.SecureString `json:"password,omitempty"`
 }
 
+const (
+	// QueuesProviderRedis keeps task queues, pub/sub and the key-value store in Redis
+	QueuesProviderRedis = "redis"
+	// QueuesProviderPostgres keeps task queues, pub/sub and the key-value store in the database
+	QueuesProviderPostgres = "postgres"
+)
+
+type queuesConfig struct {
+	// Provider selects the backend for task queues, pub/sub and the key-value store, "redis" (default) or "postgres"
+	Provider string `json:"provider,omitempty"`
+}
+
+// QueuesProvider returns the configured queues provider, or QueuesProviderRedis when unset.
+func (c *Config) QueuesProvider() string {
+	if c.Queues != nil && c.Queues.Provider != "" {
+		return c.Queues.Provider
+	}
+	return QueuesProviderRedis
+}
+
 type alertmanagerConfig struct {
 	Hostname           string `json:"hostname,omitempty"`
 	Port               uint   `json:"port,omitempty"`
@@ -663,6 +772,23 @@ type gitOpsConfig struct {
 	// IgnoreResourceUpdates lists JSON pointer paths that should be ignored
 	// when comparing desired vs. live resources during GitOps sync.
 	IgnoreResourceUpdates []string `json:"ignoreResourceUpdates,omitempty"`
+	// Webhook configures the endpoint git forges call on push to trigger syncs immediately.
+	Webhook *gitOpsWebhookConfig `json:"webhook,omitempty"`
+}
+
+type gitOpsWebhookConfig struct {
+	// Secret authenticates webhook requests. It is the HMAC key of GitHub and Gitea
+	// signatures and the GitLab secret token. The endpoint is disabled while it is empty.
+	Secret api.SecureString `json:"secret,omitempty"`
+}
+
+// GetGitOpsWebhookSecret returns the secret of the git webhook endpoint, or an empty string if
+// the endpoint is disabled.
+func (c *Config) GetGitOpsWebhookSecret() string {
+	if c.GitOps == nil || c.GitOps.Webhook == nil {
+		return ""
+	}
+	return c.GitOps.Webhook.Secret.Value()
 }
 
 type periodicTaskScheduleConfig struct {
@@ -939,6 +1065,9 @@ func NewDefault(opts ...ConfigOption) *Config {
 			Port:     6379,
 			Password: "adminpass",
 		},
+		Queues: &queuesConfig{
+			Provider: QueuesProviderRedis,
+		},
 		Alertmanager: &alertmanagerConfig{
 			Hostname:           "localhost",
 			Port:               9093,
@@ -1063,6 +1192,7 @@ func Load(cfgFile string) (*Config, error) {
 	if err := applyAuthDefaults(c); err != nil {
 		return nil, fmt.Errorf("applying auth defaults: %w", err)
 	}
+	applyCADefaults(c)
 
 	return c, nil
 }
@@ -1097,6 +1227,15 @@ func applyEnvVarOverrides(c *Config) {
 	if dbMigrationPass := os.Getenv("DB_MIGRATION_PASSWORD"); dbMigrationPass != "" {
 		c.Database.MigrationPassword = api.SecureString(dbMigrationPass)
 	}
+	if webhookSecret := os.Getenv("GITOPS_WEBHOOK_SECRET"); webhookSecret != "" {
+		if c.GitOps == nil {
+			c.GitOps = &gitOpsConfig{}
+		}
+		if c.GitOps.Webhook == nil {
+			c.GitOps.Webhook = &gitOpsWebhookConfig{}
+		}
+		c.GitOps.Webhook.Secret = api.SecureString(webhookSecret)
+	}
 	applyVulnerabilityReportingEnvVarOverrides(c)
 	// CRYPTO_FORCE_FIPS environment variable sets the global crypto policy FIPS mode.
 	// This overrides auto-detection and applies to all cryptographic protocols.
@@ -1193,6 +1332,30 @@ func applyVulnerabilityReportingTrustifyEnvVarOverrides(v *VulnerabilityConfig,
 	}
 }
 
+// applyCADefaults reserves the host names of the service endpoints so that devices cannot
+// obtain workload certificates that agents or clients would accept for the service itself.
+func applyCADefaults(c *Config) {
+	if c.CA == nil || c.Service == nil {
+		return
+	}
+	names := slices.Clone(c.Service.AltNames)
+	for _, endpoint := range []string{c.Service.BaseUrl, c.Service.BaseAgentEndpointUrl, c.Service.BaseUIUrl} {
+		if endpoint == "" {
+			continue
+		}
+		u, err := url.Parse(endpoint)
+		if err != nil || u.Hostname() == "" {
+			continue
+		}
+		names = append(names, u.Hostname())
+	}
+	for _, name := range names {
+		if !slices.Contains(c.CA.DeviceWorkloadReservedNames, name) {
+			c.CA.DeviceWorkloadReservedNames = append(c.CA.DeviceWorkloadReservedNames, name)
+		}
+	}
+}
+
 func applyAuthDefaults(c *Config) error {
 	if c.Auth == nil {
 		return nil
@@ -1392,6 +1555,14 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.Service != nil && cfg.Service.PortForward != nil {
+		for _, entry := range cfg.Service.PortForward.AllowedPorts {
+			if _, _, err := parsePortRange(entry); err != nil {
+				return fmt.Errorf("service.portForward.allowedPorts: %w", err)
+			}
+		}
+	}
+
 	if cfg.ImageBuilderService != nil && cfg.ImageBuilderService.HealthChecks != nil && cfg.ImageBuilderService.HealthChecks.Enabled {
 		hc := cfg.ImageBuilderService.HealthChecks
 		if strings.TrimSpace(hc.ReadinessPath) == "" {
@@ -1414,6 +1585,20 @@ func Validate(cfg *Config) error {
 		}
 	}
 
+	if cfg.CA != nil && cfg.CA.PKCS11Config != nil {
+		if err := cfg.CA.PKCS11Config.Validate(); err != nil {
+			return fmt.Errorf("ca.pkcs11Config: %w", err)
+		}
+	}
+
+	if cfg.Queues != nil {
+		switch cfg.Queues.Provider {
+		case "", QueuesProviderRedis, QueuesProviderPostgres:
+		default:
+			return fmt.Errorf("invalid queues.provider %q: must be %q or %q", cfg.Queues.Provider, QueuesProviderRedis, QueuesProviderPostgres)
+		}
+	}
+
 	if cfg.ImageBuilderWorker != nil {
 		if time.Duration(cfg.ImageBuilderWorker.TimeoutCheckTaskInterval) <= 0 {
 			return fmt.Errorf("imageBuilderWorker.timeoutCheckTaskInterval must be greater than 0")
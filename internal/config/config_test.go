@@ -1,9 +1,11 @@
 package config
 
 import (
+	"slices"
 	"strings"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/samber/lo"
 )
@@ -200,3 +202,76 @@ func TestConfig_String_HandlesEmptyClientSecrets(t *testing.T) {
 		t.Error("Should handle empty client secrets gracefully")
 	}
 }
+
+func TestPortForward_AllowsPort(t *testing.T) {
+	var unset *PortForward
+	if unset.AllowsPort(22) {
+		t.Error("nil port-forward config should not allow any port")
+	}
+
+	p := &PortForward{AllowedPorts: []string{"80", "8000-8099"}}
+	for port, want := range map[int]bool{80: true, 81: false, 8000: true, 8050: true, 8099: true, 8100: false} {
+		if got := p.AllowsPort(port); got != want {
+			t.Errorf("AllowsPort(%d) = %v, want %v", port, got, want)
+		}
+	}
+}
+
+func TestValidate_PortForwardAllowedPorts(t *testing.T) {
+	for entry, wantErr := range map[string]bool{"443": false, "1-65535": false, "0": true, "80-70": true, "http": true, "8000-": true} {
+		cfg := NewDefault()
+		cfg.Service.PortForward = &PortForward{AllowedPorts: []string{entry}}
+		err := Validate(cfg)
+		if wantErr && err == nil {
+			t.Errorf("expected %q to be rejected", entry)
+		}
+		if !wantErr && err != nil {
+			t.Errorf("expected %q to be accepted: %v", entry, err)
+		}
+	}
+}
+
+func TestValidate_CAPKCS11Config(t *testing.T) {
+	valid := func() *ca.PKCS11Cfg {
+		return &ca.PKCS11Cfg{ModulePath: "/usr/lib64/pkcs11/libsofthsm2.so", TokenLabel: "flightctl", PinFile: "/etc/flightctl/pki/hsm-pin", KeyLabel: "client-signer"}
+	}
+	for name, tc := range map[string]struct {
+		mutate  func(*ca.PKCS11Cfg)
+		wantErr bool
+	}{
+		"valid":          {mutate: func(*ca.PKCS11Cfg) {}},
+		"key ID only":    {mutate: func(c *ca.PKCS11Cfg) { c.KeyLabel, c.KeyID = "", "0a1b" }},
+		"no module":      {mutate: func(c *ca.PKCS11Cfg) { c.ModulePath = "" }, wantErr: true},
+		"no token":       {mutate: func(c *ca.PKCS11Cfg) { c.TokenLabel = "" }, wantErr: true},
+		"no PIN file":    {mutate: func(c *ca.PKCS11Cfg) { c.PinFile = "" }, wantErr: true},
+		"no key":         {mutate: func(c *ca.PKCS11Cfg) { c.KeyLabel = "" }, wantErr: true},
+		"key ID not hex": {mutate: func(c *ca.PKCS11Cfg) { c.KeyID = "xyz" }, wantErr: true},
+	} {
+		cfg := NewDefault()
+		cfg.CA.PKCS11Config = valid()
+		tc.mutate(cfg.CA.PKCS11Config)
+		err := Validate(cfg)
+		if tc.wantErr && err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+		if !tc.wantErr && err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+		}
+	}
+}
+
+func TestApplyCADefaults_ReservesServiceHostNames(t *testing.T) {
+	cfg := NewDefault()
+	cfg.Service.BaseUrl = "https://api.example.com:3443"
+	cfg.Service.BaseAgentEndpointUrl = "https://agent-api.example.com:7443"
+	cfg.Service.BaseUIUrl = "https://ui.example.com"
+	cfg.Service.AltNames = []string{"api.example.com", "10.0.0.1"}
+	cfg.CA.DeviceWorkloadReservedNames = []string{"registry.example.com"}
+
+	applyCADefaults(cfg)
+
+	want := []string{"registry.example.com", "api.example.com", "10.0.0.1", "agent-api.example.com", "ui.example.com"}
+	if !slices.Equal(cfg.CA.DeviceWorkloadReservedNames, want) {
+		t.Errorf("reserved names = %v, want %v", cfg.CA.DeviceWorkloadReservedNames, want)
+	}
+}
@@ -25,6 +25,9 @@ type ConsoleSession struct {
 	SendCh     chan []byte
 	RecvCh     chan []byte
 	ProtocolCh chan string
+	// ErrCh carries a session-level failure reported by the agent before it selected a
+	// protocol (e.g. the port-forward target refused the connection).
+	ErrCh chan string
 }
 
 type InternalSessionRegistration interface {
@@ -172,6 +175,7 @@ func (m *ConsoleSessionManager) StartSession(ctx context.Context, orgId uuid.UUI
 		SendCh:     make(chan []byte, ChannelSize),
 		RecvCh:     make(chan []byte, ChannelSize),
 		ProtocolCh: make(chan string),
+		ErrCh:      make(chan string, 1),
 	}
 
 	// Now that we know the device exists and is accessible, modify annotations
@@ -81,4 +81,5 @@ const (
 	TokenCtxKey                ctxKey = "token"
 	IdentityCtxKey             ctxKey = "identity"
 	MappedIdentityCtxKey       ctxKey = "mapped-identity"
+	AccessScopeCtxKey          ctxKey = "access-scope"
 )
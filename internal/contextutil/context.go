@@ -12,3 +12,15 @@ func GetMappedIdentityFromContext(ctx context.Context) (*identity.MappedIdentity
 	mappedIdentity, ok := ctx.Value(consts.MappedIdentityCtxKey).(*identity.MappedIdentity)
 	return mappedIdentity, ok
 }
+
+// WithAccessScope returns a context carrying the access scope the request is limited to
+func WithAccessScope(ctx context.Context, scope *identity.AccessScope) context.Context {
+	return context.WithValue(ctx, consts.AccessScopeCtxKey, scope)
+}
+
+// GetAccessScopeFromContext retrieves the access scope the request is limited to.
+// It returns false if the request is not limited to a scope.
+func GetAccessScopeFromContext(ctx context.Context) (*identity.AccessScope, bool) {
+	scope, ok := ctx.Value(consts.AccessScopeCtxKey).(*identity.AccessScope)
+	return scope, ok && scope != nil
+}
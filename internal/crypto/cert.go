@@ -6,11 +6,16 @@ import (
 	"crypto/rand"
 	"crypto/x509"
 	"crypto/x509/pkix"
+	"encoding/hex"
 	"encoding/pem"
+	"errors"
 	"fmt"
 	"math"
+	"math/big"
 	"os"
 	"path/filepath"
+	"slices"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/crypto/signer"
@@ -24,8 +29,15 @@ type CertOption = func(*x509.Certificate) error
 type CABackend interface {
 	IssueRequestedCertificateAsX509(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, usage []x509.ExtKeyUsage, opts ...CertOption) (*x509.Certificate, error)
 	GetCABundleX509() []*x509.Certificate
+	IssueRevocationList(ctx context.Context, entries []x509.RevocationListEntry, number *big.Int, nextUpdate time.Time) ([]byte, error)
+	// Close releases the resources held by the backend, such as the sessions on a PKCS#11 token.
+	Close() error
 }
 
+// ErrCRLSigningNotPermitted is returned when the CA certificate does not allow signing
+// certificate revocation lists.
+var ErrCRLSigningNotPermitted = errors.New("CA certificate does not permit signing certificate revocation lists")
+
 type CAClient struct {
 	caBackend CABackend
 	Cfg       *ca.Config
@@ -49,8 +61,16 @@ func NewCAClient(cfg *ca.Config, caBackend CABackend) *CAClient {
 // If the CA is successfully loaded or generated it returns a valid CA instance, a flag signifying
 // was it loaded or generated and a nil error.
 // In case of errors a non-nil error is returned.
+// A CA whose key is held by a PKCS#11 token is never generated, only loaded.
 func EnsureCA(cfg *ca.Config) (*CAClient, bool, error) {
-	caBackend, fresh, err := ensureInternalCA(cfg)
+	var caBackend CABackend
+	var fresh bool
+	var err error
+	if cfg.PKCS11Config != nil {
+		caBackend, err = LoadCA(cfg)
+	} else {
+		caBackend, fresh, err = ensureInternalCA(cfg)
+	}
 	if err != nil {
 		return nil, fresh, err
 	}
@@ -291,13 +311,68 @@ func (caClient *CAClient) IssueRequestedServerCertificate(ctx context.Context, c
 	return caClient.caBackend.IssueRequestedCertificateAsX509(ctx, csr, expirySeconds, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}, opts...)
 }
 
+// IssueRevocationList returns a DER-encoded certificate revocation list of the given entries,
+// valid until nextUpdate.
+func (caClient *CAClient) IssueRevocationList(ctx context.Context, entries []x509.RevocationListEntry, number *big.Int, nextUpdate time.Time) ([]byte, error) {
+	return caClient.caBackend.IssueRevocationList(ctx, entries, number, nextUpdate)
+}
+
+// IssuerKeyID returns the hex-encoded subject key ID of the CA certificate that signs issued
+// certificates, which is the authority key ID of those certificates.
+func (caClient *CAClient) IssuerKeyID() string {
+	bundle := caClient.caBackend.GetCABundleX509()
+	if len(bundle) == 0 {
+		return ""
+	}
+	return hex.EncodeToString(bundle[0].SubjectKeyId)
+}
+
 func (caClient *CAClient) GetCABundleX509() []*x509.Certificate {
 	return caClient.caBackend.GetCABundleX509()
 }
 
+// caBundleFile returns the configured CA bundle file, or an empty string if there is none
+func (caClient *CAClient) caBundleFile() string {
+	if caClient.Cfg == nil || caClient.Cfg.InternalConfig == nil {
+		return ""
+	}
+	return caClient.Cfg.InternalConfig.CABundleFile
+}
+
+// Close releases the resources held by the CA backend.
+func (caClient *CAClient) Close() error {
+	return caClient.caBackend.Close()
+}
+
+// GetClientCAsX509 returns the CA certificates that client certificates are verified against:
+// the CA bundle of the backend plus any further certificates in the CA bundle file. During a
+// rotation of the CA, the bundle file holds both the old and the new CA certificate, so
+// certificates issued by the old CA keep being accepted until they are renewed.
+func (caClient *CAClient) GetClientCAsX509() ([]*x509.Certificate, error) {
+	certs := caClient.GetCABundleX509()
+	if caClient.caBundleFile() == "" {
+		return certs, nil
+	}
+	caBundlePath := CertStorePath(caClient.Cfg.InternalConfig.CABundleFile, caClient.Cfg.InternalConfig.CertStore)
+	if _, err := os.Stat(caBundlePath); errors.Is(err, os.ErrNotExist) {
+		return certs, nil
+	}
+	bundleCerts, err := LoadCACertsFromFile(caBundlePath)
+	if err != nil {
+		return nil, err
+	}
+	result := append([]*x509.Certificate{}, certs...)
+	for _, bundleCert := range bundleCerts {
+		if !slices.ContainsFunc(result, bundleCert.Equal) {
+			result = append(result, bundleCert)
+		}
+	}
+	return result, nil
+}
+
 func (caClient *CAClient) GetCABundle() ([]byte, error) {
 	// If CABundleFile is configured, read it directly
-	if caClient.Cfg.InternalConfig.CABundleFile != "" {
+	if caClient.caBundleFile() != "" {
 		caBundlePath := CertStorePath(caClient.Cfg.InternalConfig.CABundleFile, caClient.Cfg.InternalConfig.CertStore)
 		caBundleBytes, err := os.ReadFile(caBundlePath)
 		if err != nil {
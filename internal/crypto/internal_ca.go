@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"bytes"
 	"context"
 	"crypto"
 	"crypto/rand"
@@ -30,11 +31,15 @@ func ensureInternalCA(cfg *ca.Config) (CABackend, bool, error) {
 	if len(cfg.InternalConfig.SerialFile) > 0 {
 		caSerialFile = CertStorePath(cfg.InternalConfig.SerialFile, cfg.InternalConfig.CertStore)
 	}
-	ca, err := GetCA(caCertFile, caKeyFile, caSerialFile)
-	if err == nil {
-		return ca, false, err
+	// Never overwrite an existing CA, e.g. an imported intermediate that fails validation.
+	if exists, _ := CanReadCertAndKey(caCertFile, caKeyFile); exists {
+		ca, err := GetCA(caCertFile, caKeyFile, caSerialFile)
+		if err != nil {
+			return nil, false, err
+		}
+		return ca, false, nil
 	}
-	ca, err = MakeSelfSignedCA(caCertFile, caKeyFile, caSerialFile, cfg.InternalConfig.SignerCertName, cfg.InternalConfig.CertValidityDays)
+	ca, err := MakeSelfSignedCA(caCertFile, caKeyFile, caSerialFile, cfg.InternalConfig.SignerCertName, cfg.InternalConfig.CertValidityDays)
 	if err != nil {
 		return nil, false, err
 	}
@@ -54,6 +59,19 @@ func ensureInternalCA(cfg *ca.Config) (CABackend, bool, error) {
 	return ca, true, err
 }
 
+// LoadCA loads the configured CA backend: a PKCS#11 token if one is configured, otherwise the
+// internal CA whose key is kept on disk.
+func LoadCA(cfg *ca.Config) (CABackend, error) {
+	if cfg.PKCS11Config != nil {
+		caBackend, err := loadPKCS11CA(cfg)
+		if err != nil {
+			return nil, err
+		}
+		return caBackend, nil
+	}
+	return LoadInternalCA(cfg)
+}
+
 func LoadInternalCA(cfg *ca.Config) (CABackend, error) {
 	caCertFile := CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore)
 	caKeyFile := CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore)
@@ -69,10 +87,44 @@ func GetCA(certFile, keyFile, serialFile string) (*internalCA, error) {
 	if err != nil {
 		return nil, err
 	}
+	key, ok := ca.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("CA key of type %T is not supported", ca.Config.Key)
+	}
+	if err := validateCACertificates(ca.Config.Certs, key.Public()); err != nil {
+		return nil, err
+	}
 	config := TLSCertificateConfig(*ca.Config)
 	return &internalCA{Config: &config, SerialGenerator: ca.SerialGenerator}, nil
 }
 
+// validateCACertificates checks that the first certificate is a CA certificate for the given
+// key, and that any certificates following it form its chain towards the root, as is the case
+// for an intermediate CA signed by an external PKI.
+func validateCACertificates(certs []*x509.Certificate, publicKey crypto.PublicKey) error {
+	if len(certs) == 0 {
+		return errors.New("no CA certificate found")
+	}
+	signer := certs[0]
+	if !signer.IsCA {
+		return fmt.Errorf("certificate %q is not a CA certificate", signer.Subject.CommonName)
+	}
+	if signer.KeyUsage != 0 && signer.KeyUsage&x509.KeyUsageCertSign == 0 {
+		return fmt.Errorf("CA certificate %q does not permit signing certificates", signer.Subject.CommonName)
+	}
+	key, ok := publicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !key.Equal(signer.PublicKey) {
+		return fmt.Errorf("CA key does not match certificate %q", signer.Subject.CommonName)
+	}
+	for i := 1; i < len(certs); i++ {
+		if err := certs[i-1].CheckSignatureFrom(certs[i]); err != nil {
+			return fmt.Errorf("certificate %q is not issued by the certificate %q following it: %w",
+				certs[i-1].Subject.CommonName, certs[i].Subject.CommonName, err)
+		}
+	}
+	return nil
+}
+
 func MakeSelfSignedCA(certFile, keyFile, serialFile, subjectName string, expiryDays int) (*internalCA, error) {
 
 	var serialGenerator oscrypto.SerialGenerator
@@ -135,7 +187,7 @@ func makeSelfSignedCAConfig(subject pkix.Name, caLifetime time.Duration, serial
 
 		SerialNumber: big.NewInt(serial),
 
-		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		KeyUsage:              x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
 		BasicConstraintsValid: true,
 		IsCA:                  true,
 
@@ -214,6 +266,126 @@ func (caBackend *internalCA) IssueRequestedCertificateAsX509(ctx context.Context
 	return caBackend.signCertificate(template, csr.PublicKey)
 }
 
+// IssueRevocationList signs a certificate revocation list listing the given entries with the
+// CA that issues client certificates. CAs created before CRL support lack the CRL signing key
+// usage and cannot sign revocation lists.
+func (caBackend *internalCA) IssueRevocationList(ctx context.Context, entries []x509.RevocationListEntry, number *big.Int, nextUpdate time.Time) ([]byte, error) {
+	issuer := caBackend.Config.Certs[0]
+	if issuer.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		return nil, ErrCRLSigningNotPermitted
+	}
+	key, ok := caBackend.Config.Key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("CA key of type %T cannot sign revocation lists", caBackend.Config.Key)
+	}
+	template := &x509.RevocationList{
+		RevokedCertificateEntries: entries,
+		Number:                    number,
+		ThisUpdate:                time.Now().Add(-time.Second),
+		NextUpdate:                nextUpdate,
+	}
+	return x509.CreateRevocationList(rand.Reader, template, issuer, key)
+}
+
+// ReissueCAForCRLSigning reissues a CA certificate that lacks the CRL signing key usage, as the CA certificates
+// created before certificate revocation do, so that the CA can sign the published revocation list. The reissued
+// certificate keeps the key, subject, key IDs and validity of the original one, so the certificates issued by the CA
+// remain valid and still verify against the original certificate. A self-signed CA certificate is reissued with its
+// own key, any other one by the CA in issuerCertFile and issuerKeyFile. If bundleFile is set, the original
+// certificate is also replaced in that CA bundle. It returns false if the CA certificate already permits signing CRLs.
+func ReissueCAForCRLSigning(certFile, keyFile, issuerCertFile, issuerKeyFile, bundleFile string) (bool, error) {
+	ca, err := GetCA(certFile, keyFile, "")
+	if err != nil {
+		return false, err
+	}
+	original := ca.Config.Certs[0]
+	if original.KeyUsage&x509.KeyUsageCRLSign != 0 {
+		return false, nil
+	}
+
+	issuer, issuerKey := original, ca.Config.Key
+	if !bytes.Equal(original.RawSubject, original.RawIssuer) || original.CheckSignatureFrom(original) != nil {
+		if issuerCertFile == "" || issuerKeyFile == "" {
+			return false, fmt.Errorf("CA certificate %q is not self-signed, the certificate and key of its issuer are required", original.Subject.CommonName)
+		}
+		issuerCA, err := GetCA(issuerCertFile, issuerKeyFile, "")
+		if err != nil {
+			return false, fmt.Errorf("loading issuer CA: %w", err)
+		}
+		issuer, issuerKey = issuerCA.Config.Certs[0], issuerCA.Config.Key
+		if err := original.CheckSignatureFrom(issuer); err != nil {
+			return false, fmt.Errorf("CA certificate %q is not issued by %q: %w", original.Subject.CommonName, issuer.Subject.CommonName, err)
+		}
+	}
+
+	template := &x509.Certificate{
+		// Keep the encoding of the subject, which the issuer names of issued certificates are matched against
+		RawSubject:            original.RawSubject,
+		NotBefore:             original.NotBefore,
+		NotAfter:              original.NotAfter,
+		KeyUsage:              original.KeyUsage | x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		ExtKeyUsage:           original.ExtKeyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		MaxPathLen:            original.MaxPathLen,
+		MaxPathLenZero:        original.MaxPathLenZero,
+		SubjectKeyId:          original.SubjectKeyId,
+		AuthorityKeyId:        original.AuthorityKeyId,
+	}
+	serial, err := ca.SerialGenerator.Next(template)
+	if err != nil {
+		return false, err
+	}
+	template.SerialNumber = big.NewInt(serial)
+	if issuer == original {
+		issuer = template
+	}
+	reissued, err := signCertificate(template, original.PublicKey, issuer, issuerKey)
+	if err != nil {
+		return false, fmt.Errorf("reissuing CA certificate %q: %w", original.Subject.CommonName, err)
+	}
+
+	if err := replaceCertificateInFile(certFile, original, reissued); err != nil {
+		return false, err
+	}
+	if bundleFile != "" {
+		if err := replaceCertificateInFile(bundleFile, original, reissued); err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// replaceCertificateInFile replaces a certificate in a PEM file of certificates, keeping the mode of the file
+func replaceCertificateInFile(path string, original, replacement *x509.Certificate) error {
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+	certs, err := LoadCACertsFromFile(path)
+	if err != nil {
+		return err
+	}
+	for i := range certs {
+		if certs[i].Equal(original) {
+			certs[i] = replacement
+		}
+	}
+	certPEM, err := oscrypto.EncodeCertificates(certs...)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, certPEM, info.Mode().Perm()); err != nil {
+		return fmt.Errorf("writing certificates to %s: %w", path, err)
+	}
+	return nil
+}
+
+// Close is a no-op, the internal CA holds no resources besides its key in memory.
+func (caBackend *internalCA) Close() error {
+	return nil
+}
+
 func (caBackend *internalCA) GetCABundleX509() []*x509.Certificate {
 	return caBackend.Config.Certs
 }
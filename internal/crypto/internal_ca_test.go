@@ -0,0 +1,265 @@
+package crypto
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestCACert(t *testing.T, cn string, key crypto.Signer, issuer *x509.Certificate, issuerKey crypto.Signer, keyUsage x509.KeyUsage) *x509.Certificate {
+	t.Helper()
+	template := &x509.Certificate{
+		Subject:               pkix.Name{CommonName: cn},
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              keyUsage,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	if issuer == nil {
+		issuer, issuerKey = template, key
+	}
+	cert, err := signCertificate(template, key.Public(), issuer, issuerKey)
+	require.NoError(t, err)
+	return cert
+}
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
+
+func writeTestCA(t *testing.T, cfg *ca.Config, key crypto.PrivateKey, certs ...*x509.Certificate) {
+	t.Helper()
+	certPEM, err := oscrypto.EncodeCertificates(certs...)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore), certPEM, 0600))
+	keyPEM, err := fccrypto.PEMEncodeKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore), keyPEM, 0600))
+}
+
+func issueTestCertificate(t *testing.T, backend CABackend) *x509.Certificate {
+	t.Helper()
+	key := newTestKey(t)
+	csrPEM, err := fccrypto.MakeCSR(key, "device:test")
+	require.NoError(t, err)
+	csr, err := fccrypto.ParseCSR(csrPEM)
+	require.NoError(t, err)
+	cert, err := backend.IssueRequestedCertificateAsX509(context.Background(), csr, 3600, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth})
+	require.NoError(t, err)
+	return cert
+}
+
+func TestLoadCAExternalIntermediate(t *testing.T) {
+	const caUsage = x509.KeyUsageCertSign | x509.KeyUsageCRLSign
+	rootKey := newTestKey(t)
+	root := newTestCACert(t, "corporate-root", rootKey, nil, nil, caUsage)
+	intermediateKey := newTestKey(t)
+	intermediate := newTestCACert(t, "flightctl-client-signer", intermediateKey, root, rootKey, caUsage)
+
+	t.Run("issues certificates that chain to the external root", func(t *testing.T) {
+		cfg := ca.NewDefault(t.TempDir())
+		writeTestCA(t, cfg, intermediateKey, intermediate, root)
+
+		backend, err := LoadCA(cfg)
+		require.NoError(t, err)
+		require.Equal(t, []*x509.Certificate{intermediate, root}, backend.GetCABundleX509())
+
+		cert := issueTestCertificate(t, backend)
+		_, err = cert.Verify(x509.VerifyOptions{
+			Roots:         NewCertPool([]*x509.Certificate{root}),
+			Intermediates: NewCertPool([]*x509.Certificate{intermediate}),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a chain out of order", func(t *testing.T) {
+		cfg := ca.NewDefault(t.TempDir())
+		writeTestCA(t, cfg, intermediateKey, intermediate, newTestCACert(t, "other-root", newTestKey(t), nil, nil, caUsage))
+
+		_, err := LoadCA(cfg)
+		require.ErrorContains(t, err, "is not issued by")
+	})
+
+	t.Run("rejects a certificate that cannot sign certificates", func(t *testing.T) {
+		cfg := ca.NewDefault(t.TempDir())
+		key := newTestKey(t)
+		writeTestCA(t, cfg, key, newTestCACert(t, "no-cert-sign", key, root, rootKey, x509.KeyUsageDigitalSignature))
+
+		_, err := LoadCA(cfg)
+		require.ErrorContains(t, err, "does not permit signing certificates")
+	})
+
+	t.Run("EnsureCA does not replace an invalid CA", func(t *testing.T) {
+		cfg := ca.NewDefault(t.TempDir())
+		writeTestCA(t, cfg, intermediateKey, intermediate, newTestCACert(t, "other-root", newTestKey(t), nil, nil, caUsage))
+
+		_, _, err := EnsureCA(cfg)
+		require.Error(t, err)
+		certs, err := LoadCACertsFromFile(CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore))
+		require.NoError(t, err)
+		require.True(t, certs[0].Equal(intermediate))
+	})
+}
+
+func TestGetClientCAsX509(t *testing.T) {
+	cfg := ca.NewDefault(t.TempDir())
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(t, err)
+	require.True(t, fresh)
+
+	clientCAs, err := caClient.GetClientCAsX509()
+	require.NoError(t, err)
+	require.Equal(t, caClient.GetCABundleX509(), clientCAs)
+
+	// Rotating the CA: the bundle holds the new and the retiring CA certificate.
+	previous := newTestCACert(t, "previous-client-signer", newTestKey(t), nil, nil, x509.KeyUsageCertSign)
+	bundle, err := oscrypto.EncodeCertificates(append(caClient.GetCABundleX509(), previous)...)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore), bundle, 0600))
+
+	clientCAs, err = caClient.GetClientCAsX509()
+	require.NoError(t, err)
+	require.Equal(t, append(caClient.GetCABundleX509(), previous), clientCAs)
+}
+
+func TestCAClientWithoutInternalConfig(t *testing.T) {
+	cfg := ca.NewDefault(t.TempDir())
+	caClient, _, err := EnsureCA(cfg)
+	require.NoError(t, err)
+
+	// A CA client built for a backend without internal CA configuration uses the loaded certificates
+	client := NewCAClient(&ca.Config{}, caClient.caBackend)
+	defer func() { require.NoError(t, client.Close()) }()
+
+	clientCAs, err := client.GetClientCAsX509()
+	require.NoError(t, err)
+	require.Equal(t, caClient.GetCABundleX509(), clientCAs)
+
+	bundle, err := client.GetCABundle()
+	require.NoError(t, err)
+	expected, err := oscrypto.EncodeCertificates(caClient.GetCABundleX509()...)
+	require.NoError(t, err)
+	require.Equal(t, expected, bundle)
+}
+
+func TestReissueCAForCRLSigning(t *testing.T) {
+	const legacyUsage = x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign
+
+	requireSignsRevocationList := func(t *testing.T, cfg *ca.Config, issued *x509.Certificate) *x509.Certificate {
+		t.Helper()
+		backend, err := LoadCA(cfg)
+		require.NoError(t, err)
+		reissued := backend.GetCABundleX509()[0]
+		require.NotZero(t, reissued.KeyUsage&x509.KeyUsageCRLSign)
+
+		der, err := backend.IssueRevocationList(context.Background(), []x509.RevocationListEntry{
+			{SerialNumber: issued.SerialNumber, RevocationTime: time.Now()},
+		}, big.NewInt(1), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		crl, err := x509.ParseRevocationList(der)
+		require.NoError(t, err)
+		require.NoError(t, crl.CheckSignatureFrom(reissued))
+		return reissued
+	}
+
+	t.Run("reissues a self-signed CA", func(t *testing.T) {
+		cfg := ca.NewDefault(t.TempDir())
+		key := newTestKey(t)
+		original := newTestCACert(t, "client-signer", key, nil, nil, legacyUsage)
+		writeTestCA(t, cfg, key, original)
+		certFile := CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore)
+		keyFile := CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore)
+		bundleFile := CertStorePath(cfg.InternalConfig.CABundleFile, cfg.InternalConfig.CertStore)
+		other := newTestCACert(t, "other", newTestKey(t), nil, nil, legacyUsage)
+		bundle, err := oscrypto.EncodeCertificates(original, other)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(bundleFile, bundle, 0600))
+
+		backend, err := LoadCA(cfg)
+		require.NoError(t, err)
+		issued := issueTestCertificate(t, backend)
+		_, err = backend.IssueRevocationList(context.Background(), nil, big.NewInt(1), time.Now().Add(time.Hour))
+		require.ErrorIs(t, err, ErrCRLSigningNotPermitted)
+
+		reissuedCA, err := ReissueCAForCRLSigning(certFile, keyFile, "", "", bundleFile)
+		require.NoError(t, err)
+		require.True(t, reissuedCA)
+		reissued := requireSignsRevocationList(t, cfg, issued)
+		require.Equal(t, original.RawSubject, reissued.RawSubject)
+		require.Equal(t, original.SubjectKeyId, reissued.SubjectKeyId)
+		require.Equal(t, original.NotAfter, reissued.NotAfter)
+
+		// Issued certificates verify against both the reissued and the original CA certificate
+		for _, root := range []*x509.Certificate{reissued, original} {
+			_, err = issued.Verify(x509.VerifyOptions{
+				Roots:     NewCertPool([]*x509.Certificate{root}),
+				KeyUsages: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+			})
+			require.NoError(t, err)
+		}
+
+		bundleCerts, err := LoadCACertsFromFile(bundleFile)
+		require.NoError(t, err)
+		require.Equal(t, []*x509.Certificate{reissued, other}, bundleCerts)
+
+		reissuedCA, err = ReissueCAForCRLSigning(certFile, keyFile, "", "", bundleFile)
+		require.NoError(t, err)
+		require.False(t, reissuedCA)
+	})
+
+	t.Run("reissues a CA with its issuer", func(t *testing.T) {
+		rootDir := t.TempDir()
+		rootKey := newTestKey(t)
+		root := newTestCACert(t, "root", rootKey, nil, nil, legacyUsage)
+		rootCfg := ca.NewDefault(rootDir)
+		writeTestCA(t, rootCfg, rootKey, root)
+
+		cfg := ca.NewDefault(t.TempDir())
+		key := newTestKey(t)
+		original := newTestCACert(t, "client-signer", key, root, rootKey, legacyUsage)
+		writeTestCA(t, cfg, key, original)
+		certFile := CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore)
+		keyFile := CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore)
+
+		backend, err := LoadCA(cfg)
+		require.NoError(t, err)
+		issued := issueTestCertificate(t, backend)
+
+		_, err = ReissueCAForCRLSigning(certFile, keyFile, "", "", "")
+		require.ErrorContains(t, err, "is not self-signed")
+
+		reissuedCA, err := ReissueCAForCRLSigning(certFile, keyFile,
+			CertStorePath(rootCfg.InternalConfig.CertFile, rootCfg.InternalConfig.CertStore),
+			CertStorePath(rootCfg.InternalConfig.KeyFile, rootCfg.InternalConfig.CertStore), "")
+		require.NoError(t, err)
+		require.True(t, reissuedCA)
+		reissued := requireSignsRevocationList(t, cfg, issued)
+		require.NoError(t, reissued.CheckSignatureFrom(root))
+
+		_, err = issued.Verify(x509.VerifyOptions{
+			Roots:         NewCertPool([]*x509.Certificate{root}),
+			Intermediates: NewCertPool([]*x509.Certificate{reissued}),
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		})
+		require.NoError(t, err)
+	})
+}
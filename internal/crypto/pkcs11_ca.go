@@ -0,0 +1,90 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ThalesGroup/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+)
+
+// pkcs11CA is a CA backend whose signing key is held by a PKCS#11 token, such as an HSM.
+// Certificates are built and serial numbers generated like by the internal CA, but all
+// signing operations are performed by the token and the key never leaves it.
+type pkcs11CA struct {
+	*internalCA
+	ctx *crypto11.Context
+}
+
+func loadPKCS11CA(cfg *ca.Config) (*pkcs11CA, error) {
+	p11Cfg := cfg.PKCS11Config
+	if err := p11Cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid PKCS#11 configuration: %w", err)
+	}
+	pin, err := os.ReadFile(p11Cfg.PinFile)
+	if err != nil {
+		return nil, fmt.Errorf("reading PKCS#11 PIN: %w", err)
+	}
+	keyID, err := hex.DecodeString(p11Cfg.KeyID)
+	if err != nil {
+		return nil, fmt.Errorf("decoding PKCS#11 key ID: %w", err)
+	}
+	var keyLabel []byte
+	if p11Cfg.KeyLabel != "" {
+		keyLabel = []byte(p11Cfg.KeyLabel)
+	}
+
+	certs, err := LoadCACertsFromFile(CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore))
+	if err != nil {
+		return nil, err
+	}
+	serialGenerator, err := caSerialGenerator(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, err := crypto11.Configure(&crypto11.Config{
+		Path:        p11Cfg.ModulePath,
+		TokenLabel:  p11Cfg.TokenLabel,
+		TokenSerial: p11Cfg.TokenSerial,
+		SlotNumber:  p11Cfg.SlotNumber,
+		Pin:         strings.TrimSpace(string(pin)),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("opening PKCS#11 token: %w", err)
+	}
+	key, err := ctx.FindKeyPair(keyID, keyLabel)
+	if err != nil {
+		_ = ctx.Close()
+		return nil, fmt.Errorf("finding CA key on PKCS#11 token: %w", err)
+	}
+	if err := validateCACertificates(certs, key.Public()); err != nil {
+		_ = ctx.Close()
+		return nil, err
+	}
+
+	return &pkcs11CA{
+		internalCA: &internalCA{
+			Config:          &TLSCertificateConfig{Certs: certs, Key: key},
+			SerialGenerator: serialGenerator,
+		},
+		ctx: ctx,
+	}, nil
+}
+
+// Close releases the sessions held on the PKCS#11 token.
+func (caBackend *pkcs11CA) Close() error {
+	return caBackend.ctx.Close()
+}
+
+func caSerialGenerator(cfg *ca.Config) (oscrypto.SerialGenerator, error) {
+	if len(cfg.InternalConfig.SerialFile) == 0 {
+		return &oscrypto.RandomSerialGenerator{}, nil
+	}
+	return oscrypto.NewSerialFileGenerator(CertStorePath(cfg.InternalConfig.SerialFile, cfg.InternalConfig.CertStore))
+}
@@ -0,0 +1,18 @@
+//go:build !cgo
+
+package crypto
+
+import (
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+)
+
+// pkcs11CA is unavailable without cgo, which is required to load PKCS#11 modules.
+type pkcs11CA struct {
+	*internalCA
+}
+
+func loadPKCS11CA(cfg *ca.Config) (*pkcs11CA, error) {
+	return nil, errors.New("PKCS#11 CA support requires a build with cgo enabled")
+}
@@ -0,0 +1,120 @@
+//go:build cgo
+
+package crypto
+
+import (
+	"context"
+	"crypto/elliptic"
+	"crypto/x509"
+	"math/big"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/crypto11"
+	"github.com/flightctl/flightctl/internal/config/ca"
+	oscrypto "github.com/openshift/library-go/pkg/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+var softHSMModulePaths = []string{
+	"/usr/lib64/pkcs11/libsofthsm2.so",
+	"/usr/lib/softhsm/libsofthsm2.so",
+	"/usr/lib/x86_64-linux-gnu/softhsm/libsofthsm2.so",
+	"/usr/local/lib/softhsm/libsofthsm2.so",
+}
+
+// newSoftHSMToken initializes a SoftHSM token in a temporary directory and returns the path
+// of the SoftHSM module. The test is skipped if SoftHSM is not installed.
+func newSoftHSMToken(t *testing.T, label, pin string) string {
+	t.Helper()
+	softHSMUtil, err := exec.LookPath("softhsm2-util")
+	if err != nil {
+		t.Skip("softhsm2-util not installed")
+	}
+	module := os.Getenv("SOFTHSM2_MODULE")
+	for _, path := range softHSMModulePaths {
+		if module != "" {
+			break
+		}
+		if _, err := os.Stat(path); err == nil {
+			module = path
+		}
+	}
+	if module == "" {
+		t.Skip("SoftHSM module not found, set SOFTHSM2_MODULE")
+	}
+
+	dir := t.TempDir()
+	tokenDir := filepath.Join(dir, "tokens")
+	require.NoError(t, os.Mkdir(tokenDir, 0700))
+	conf := filepath.Join(dir, "softhsm2.conf")
+	require.NoError(t, os.WriteFile(conf, []byte("directories.tokendir = "+tokenDir+"\nobjectstore.backend = file\n"), 0600))
+	t.Setenv("SOFTHSM2_CONF", conf)
+
+	out, err := exec.Command(softHSMUtil, "--init-token", "--free", "--label", label, "--pin", pin, "--so-pin", pin).CombinedOutput()
+	require.NoError(t, err, string(out))
+	return module
+}
+
+func TestPKCS11CA(t *testing.T) {
+	const (
+		tokenLabel = "flightctl"
+		pin        = "1234"
+		keyLabel   = "client-signer"
+	)
+	module := newSoftHSMToken(t, tokenLabel, pin)
+
+	token, err := crypto11.Configure(&crypto11.Config{Path: module, TokenLabel: tokenLabel, Pin: pin})
+	require.NoError(t, err)
+	key, err := token.GenerateECDSAKeyPairWithLabel([]byte{0x01}, []byte(keyLabel), elliptic.P256())
+	require.NoError(t, err)
+	caCert := newTestCACert(t, "hsm-client-signer", key, nil, nil, x509.KeyUsageCertSign|x509.KeyUsageCRLSign)
+	require.NoError(t, token.Close())
+
+	cfg := ca.NewDefault(t.TempDir())
+	certPEM, err := oscrypto.EncodeCertificates(caCert)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(CertStorePath(cfg.InternalConfig.CertFile, cfg.InternalConfig.CertStore), certPEM, 0600))
+	pinFile := filepath.Join(t.TempDir(), "pin")
+	require.NoError(t, os.WriteFile(pinFile, []byte(pin+"\n"), 0600))
+	cfg.PKCS11Config = &ca.PKCS11Cfg{
+		ModulePath: module,
+		TokenLabel: tokenLabel,
+		PinFile:    pinFile,
+		KeyLabel:   keyLabel,
+	}
+
+	caClient, fresh, err := EnsureCA(cfg)
+	require.NoError(t, err)
+	require.False(t, fresh)
+	backend, ok := caClient.caBackend.(*pkcs11CA)
+	require.True(t, ok)
+	t.Cleanup(func() { _ = backend.Close() })
+	require.NoFileExists(t, CertStorePath(cfg.InternalConfig.KeyFile, cfg.InternalConfig.CertStore))
+
+	cert := issueTestCertificate(t, backend)
+	require.NoError(t, cert.CheckSignatureFrom(caCert))
+
+	crlBytes, err := caClient.IssueRevocationList(context.Background(), []x509.RevocationListEntry{
+		{SerialNumber: cert.SerialNumber, RevocationTime: time.Now()},
+	}, big.NewInt(1), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	crl, err := x509.ParseRevocationList(crlBytes)
+	require.NoError(t, err)
+	require.NoError(t, crl.CheckSignatureFrom(caCert))
+
+	t.Run("fails when the key is not on the token", func(t *testing.T) {
+		otherCfg := *cfg
+		otherCfg.PKCS11Config = &ca.PKCS11Cfg{
+			ModulePath: module,
+			TokenLabel: tokenLabel,
+			PinFile:    pinFile,
+			KeyLabel:   "missing",
+		}
+		_, err := LoadCA(&otherCfg)
+		require.ErrorContains(t, err, "finding CA key on PKCS#11 token")
+	})
+}
@@ -11,6 +11,7 @@ import (
 type SignRequest interface {
 	SignerName() string
 	ResourceName() *string
+	Owner() *string
 	X509() x509.CertificateRequest
 	ExpirationSeconds() *int32
 	IssuedCertificate() (*x509.Certificate, bool)
@@ -21,6 +22,7 @@ type basicSignRequest struct {
 	x509csr      x509.CertificateRequest
 	expiry       *int32
 	resourceName *string
+	owner        *string
 	issuedCert   *x509.Certificate
 }
 
@@ -29,6 +31,7 @@ var _ SignRequest = (*basicSignRequest)(nil)
 func (r *basicSignRequest) SignerName() string            { return r.signerName }
 func (r *basicSignRequest) ResourceName() *string         { return r.resourceName }
 func (r *basicSignRequest) X509() x509.CertificateRequest { return r.x509csr }
+func (r *basicSignRequest) Owner() *string                { return r.owner }
 func (r *basicSignRequest) ExpirationSeconds() *int32     { return r.expiry }
 func (r *basicSignRequest) IssuedCertificate() (*x509.Certificate, bool) {
 	return r.issuedCert, r.issuedCert != nil
@@ -83,6 +86,14 @@ func WithResourceName(name string) SignRequestOption {
 	}
 }
 
+// WithOwner sets the owner of the original resource for the sign request.
+func WithOwner(owner string) SignRequestOption {
+	return func(r *basicSignRequest) error {
+		r.owner = &owner
+		return nil
+	}
+}
+
 // WithIssuedCertificate attaches an already-issued certificate to the request
 func WithIssuedCertificate(cert *x509.Certificate) SignRequestOption {
 	return func(r *basicSignRequest) error {
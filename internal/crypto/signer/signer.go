@@ -73,6 +73,13 @@ func NewCASigners(ca CA) *CASigners {
 					),
 				),
 			),
+			cfg.DeviceWorkloadSignerName: WithSignerNameValidation(
+				WithCertificateReuse(
+					WithCSRValidation(
+						WithSignerNameExtension(NewSignerDeviceWorkload)(ca),
+					),
+				),
+			),
 			cfg.ServerSvcSignerName: WithSignerNameValidation(
 				WithCertificateReuse(
 					WithCSRValidation(
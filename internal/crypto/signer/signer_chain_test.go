@@ -20,9 +20,10 @@ const mockSignerName = "mock"
 
 // mockCA implements CA to intercept IssueRequestedClientCertificate calls.
 type mockCA struct {
-	cfg              *ca.Config
-	signers          *CASigners
-	clientIssueCalls int
+	cfg                      *ca.Config
+	signers                  *CASigners
+	clientIssueCalls         int
+	serverIssueExpirySeconds int
 }
 
 func newMockCA(t *testing.T) *mockCA {
@@ -56,7 +57,12 @@ func (m *mockCA) IssueRequestedClientCertificate(ctx context.Context, csr *x509.
 }
 
 func (m *mockCA) IssueRequestedServerCertificate(ctx context.Context, csr *x509.CertificateRequest, expirySeconds int, opts ...certOption) (*x509.Certificate, error) {
-	return &x509.Certificate{Subject: csr.Subject}, nil
+	m.serverIssueExpirySeconds = expirySeconds
+	cert := &x509.Certificate{Subject: csr.Subject}
+	for _, o := range opts {
+		_ = o(cert)
+	}
+	return cert, nil
 }
 
 // mockSigner is a minimal signer used to exercise wrapper chains only.
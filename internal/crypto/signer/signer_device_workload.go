@@ -0,0 +1,157 @@
+package signer
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"strings"
+)
+
+const signerDeviceWorkloadExpiryDays int32 = 90
+
+// deviceOwnerPrefix is the prefix of the owner of CSRs created by devices (see util.ResourceOwner).
+const deviceOwnerPrefix = "Device/"
+
+// SignerDeviceWorkload issues certificates for applications running on a device. Requests can
+// only be made by a device presenting its management certificate, and the issued certificates
+// are bound to that device through the device fingerprint extension.
+type SignerDeviceWorkload struct {
+	name string
+	ca   CA
+}
+
+func NewSignerDeviceWorkload(CAClient CA) Signer {
+	cfg := CAClient.Config()
+	return &SignerDeviceWorkload{name: cfg.DeviceWorkloadSignerName, ca: CAClient}
+}
+
+func (s *SignerDeviceWorkload) Name() string {
+	return s.name
+}
+
+func (s *SignerDeviceWorkload) Verify(ctx context.Context, request SignRequest) error {
+	cfg := s.ca.Config()
+
+	peerSigner := s.ca.PeerCertificateSignerFromCtx(ctx)
+
+	got := "<nil>"
+	if peerSigner != nil {
+		got = peerSigner.Name()
+	}
+
+	// Workload CSRs are only allowed from a device presenting a valid
+	// device-management client certificate (initial or renewal).
+	if peerSigner == nil || !IsDeviceManagementClientCertSigner(cfg, peerSigner) {
+		return fmt.Errorf(
+			"unexpected client certificate signer: expected %q or %q, got %q",
+			cfg.DeviceManagementSignerName,
+			cfg.DeviceManagementRenewalSignerName,
+			got,
+		)
+	}
+
+	peerCertificate, err := PeerCertificateFromCtx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get peer certificate from context: %w", err)
+	}
+
+	fingerprint, err := DeviceFingerprintFromCN(cfg, peerCertificate.Subject.CommonName)
+	if err != nil {
+		return fmt.Errorf("failed to extract device fingerprint from peer certificate CN: %w", err)
+	}
+
+	ownerFingerprint, err := deviceFingerprintFromOwner(request)
+	if err != nil {
+		return err
+	}
+	if ownerFingerprint != fingerprint {
+		return fmt.Errorf("CSR owner device %q does not match the requesting device %q", ownerFingerprint, fingerprint)
+	}
+
+	x509CSR := request.X509()
+	if x509CSR.Subject.CommonName == "" {
+		return fmt.Errorf("CSR CommonName cannot be empty for workload certificates")
+	}
+
+	return checkWorkloadNames(cfg.DeviceWorkloadReservedNames, &x509CSR)
+}
+
+func (s *SignerDeviceWorkload) Sign(ctx context.Context, request SignRequest) (*x509.Certificate, error) {
+	// The request may be signed after a manual approval, outside of the device's
+	// connection, so the device is identified by the owner of the request.
+	fingerprint, err := deviceFingerprintFromOwner(request)
+	if err != nil {
+		return nil, err
+	}
+
+	expirySeconds := signerDeviceWorkloadExpiryDays * 24 * 60 * 60
+	if request.ExpirationSeconds() != nil && *request.ExpirationSeconds() < expirySeconds {
+		expirySeconds = *request.ExpirationSeconds()
+	}
+
+	// Re-checked here as the reserved names may have changed since the request was verified.
+	x509CSR := request.X509()
+	if err := checkWorkloadNames(s.ca.Config().DeviceWorkloadReservedNames, &x509CSR); err != nil {
+		return nil, err
+	}
+	return s.ca.IssueRequestedServerCertificate(
+		ctx,
+		&x509CSR,
+		int(expirySeconds),
+		WithExtension(OIDDeviceFingerprint, fingerprint),
+	)
+}
+
+func deviceFingerprintFromOwner(request SignRequest) (string, error) {
+	owner := request.Owner()
+	if owner == nil || !strings.HasPrefix(*owner, deviceOwnerPrefix) {
+		return "", fmt.Errorf("workload certificate requests must be owned by a device")
+	}
+	fingerprint := strings.TrimPrefix(*owner, deviceOwnerPrefix)
+	if fingerprint == "" {
+		return "", fmt.Errorf("workload certificate requests must be owned by a device")
+	}
+	return fingerprint, nil
+}
+
+// checkWorkloadNames rejects CSRs naming a reserved host, so that a device cannot obtain a
+// certificate that is valid for the service endpoints it or other devices connect to.
+func checkWorkloadNames(reserved []string, csr *x509.CertificateRequest) error {
+	names := append([]string{csr.Subject.CommonName}, csr.DNSNames...)
+	for _, ip := range csr.IPAddresses {
+		names = append(names, ip.String())
+	}
+	for _, u := range csr.URIs {
+		if host := u.Hostname(); host != "" {
+			names = append(names, host)
+		}
+	}
+	for _, name := range names {
+		for _, r := range reserved {
+			if matchesReservedName(name, r) {
+				return fmt.Errorf("workload certificates cannot be issued for reserved name %q", name)
+			}
+		}
+	}
+	return nil
+}
+
+// matchesReservedName reports whether a certificate for name would be valid for the reserved
+// host, including through a wildcard DNS name.
+func matchesReservedName(name, reserved string) bool {
+	if ip := net.ParseIP(reserved); ip != nil {
+		other := net.ParseIP(name)
+		return other != nil && other.Equal(ip)
+	}
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	reserved = strings.TrimSuffix(strings.ToLower(reserved), ".")
+	if name == reserved {
+		return true
+	}
+	if suffix, ok := strings.CutPrefix(name, "*."); ok {
+		_, parent, found := strings.Cut(reserved, ".")
+		return found && parent == suffix
+	}
+	return false
+}
@@ -0,0 +1,262 @@
+package signer
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckWorkloadNames(t *testing.T) {
+	reserved := []string{"api.example.com", "Agent-API.example.com.", "192.168.1.10", "::1"}
+
+	testCases := []struct {
+		name    string
+		csr     x509.CertificateRequest
+		wantErr bool
+	}{
+		{
+			name: "unrelated names",
+			csr: x509.CertificateRequest{
+				Subject:     pkix.Name{CommonName: "app.example.com"},
+				DNSNames:    []string{"app.example.com", "*.apps.example.com"},
+				IPAddresses: []net.IP{net.ParseIP("192.168.1.11")},
+			},
+		},
+		{
+			name:    "reserved common name",
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "api.example.com"}},
+			wantErr: true,
+		},
+		{
+			name: "reserved DNS name with different case and trailing dot",
+			csr: x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "app"},
+				DNSNames: []string{"AGENT-api.example.com."},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wildcard covering a reserved name",
+			csr: x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "app"},
+				DNSNames: []string{"*.example.com"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "wildcard of a parent domain",
+			csr: x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "app"},
+				DNSNames: []string{"*.com"},
+			},
+		},
+		{
+			name: "reserved IP address",
+			csr: x509.CertificateRequest{
+				Subject:     pkix.Name{CommonName: "app"},
+				IPAddresses: []net.IP{net.ParseIP("192.168.1.10")},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved IPv6 address in another notation",
+			csr: x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "0:0:0:0:0:0:0:1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved URI host",
+			csr: x509.CertificateRequest{
+				Subject: pkix.Name{CommonName: "app"},
+				URIs:    []*url.URL{{Scheme: "https", Host: "api.example.com:443"}},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkWorkloadNames(reserved, &tc.csr)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignerDeviceWorkloadVerify(t *testing.T) {
+	const fingerprint = "abcdef0123456789"
+	m := newMockCA(t)
+	cfg := m.cfg
+	cfg.DeviceWorkloadReservedNames = []string{"api.example.com"}
+
+	peerCert := func(signerName, commonName string) *x509.Certificate {
+		return &x509.Certificate{
+			Subject:         pkix.Name{CommonName: commonName},
+			ExtraExtensions: []pkix.Extension{{Id: OIDSignerName, Value: mustASN1(t, signerName)}},
+		}
+	}
+
+	testCases := []struct {
+		name    string
+		peer    *x509.Certificate
+		owner   string
+		csr     x509.CertificateRequest
+		wantErr bool
+	}{
+		{
+			name:  "device management certificate of the owner",
+			peer:  peerCert(cfg.DeviceManagementSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner: "Device/" + fingerprint,
+			csr:   x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+		},
+		{
+			name:  "renewed device management certificate",
+			peer:  peerCert(cfg.DeviceManagementRenewalSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner: "Device/" + fingerprint,
+			csr:   x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+		},
+		{
+			name:    "no peer certificate",
+			owner:   "Device/" + fingerprint,
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantErr: true,
+		},
+		{
+			name:    "peer certificate of another signer",
+			peer:    peerCert(cfg.DeviceSvcClientSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner:   "Device/" + fingerprint,
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantErr: true,
+		},
+		{
+			name:    "owned by another device",
+			peer:    peerCert(cfg.DeviceManagementSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner:   "Device/0123456789abcdef",
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantErr: true,
+		},
+		{
+			name:    "not owned by a device",
+			peer:    peerCert(cfg.DeviceManagementSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner:   "Fleet/" + fingerprint,
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantErr: true,
+		},
+		{
+			name:    "empty common name",
+			peer:    peerCert(cfg.DeviceManagementSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner:   "Device/" + fingerprint,
+			wantErr: true,
+		},
+		{
+			name:    "reserved name",
+			peer:    peerCert(cfg.DeviceManagementSignerName, cfg.DeviceCommonNamePrefix+fingerprint),
+			owner:   "Device/" + fingerprint,
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}, DNSNames: []string{"api.example.com"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tc.peer != nil {
+				ctx = context.WithValue(ctx, consts.TLSPeerCertificateCtxKey, tc.peer)
+			}
+			request, err := NewSignRequest(cfg.DeviceWorkloadSignerName, tc.csr, WithOwner(tc.owner))
+			require.NoError(t, err)
+
+			err = NewSignerDeviceWorkload(m).Verify(ctx, request)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSignerDeviceWorkloadSign(t *testing.T) {
+	const fingerprint = "abcdef0123456789"
+
+	testCases := []struct {
+		name              string
+		owner             string
+		csr               x509.CertificateRequest
+		expiration        *int32
+		wantExpirySeconds int
+		wantErr           bool
+	}{
+		{
+			name:              "default expiry",
+			owner:             "Device/" + fingerprint,
+			csr:               x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantExpirySeconds: int(signerDeviceWorkloadExpiryDays) * 24 * 60 * 60,
+		},
+		{
+			name:              "shorter requested expiry",
+			owner:             "Device/" + fingerprint,
+			csr:               x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			expiration:        lo.ToPtr(int32(3600)),
+			wantExpirySeconds: 3600,
+		},
+		{
+			name:              "longer requested expiry",
+			owner:             "Device/" + fingerprint,
+			csr:               x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			expiration:        lo.ToPtr(int32(365 * 24 * 60 * 60)),
+			wantExpirySeconds: int(signerDeviceWorkloadExpiryDays) * 24 * 60 * 60,
+		},
+		{
+			name:    "not owned by a device",
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}},
+			wantErr: true,
+		},
+		{
+			// Reserved names are checked again, as approved requests are signed later.
+			name:    "reserved name",
+			owner:   "Device/" + fingerprint,
+			csr:     x509.CertificateRequest{Subject: pkix.Name{CommonName: "app"}, DNSNames: []string{"api.example.com"}},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := newMockCA(t)
+			m.cfg.DeviceWorkloadReservedNames = []string{"api.example.com"}
+
+			opts := []SignRequestOption{}
+			if tc.owner != "" {
+				opts = append(opts, WithOwner(tc.owner))
+			}
+			if tc.expiration != nil {
+				opts = append(opts, WithExpirationSeconds(*tc.expiration))
+			}
+			request, err := NewSignRequest(m.cfg.DeviceWorkloadSignerName, tc.csr, opts...)
+			require.NoError(t, err)
+
+			cert, err := NewSignerDeviceWorkload(m).Sign(context.Background(), request)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.wantExpirySeconds, m.serverIssueExpirySeconds)
+			got, err := GetDeviceFingerprintExtension(cert)
+			require.NoError(t, err)
+			require.Equal(t, fingerprint, got)
+		})
+	}
+}
@@ -25,10 +25,7 @@ func TLSConfigForServer(caBundlex509 []*x509.Certificate, serverConfig *TLSCerti
 		return nil, nil, err
 	}
 
-	caPool := x509.NewCertPool()
-	for _, caCert := range caBundlex509 {
-		caPool.AddCert(caCert)
-	}
+	caPool := NewCertPool(caBundlex509)
 
 	tlsConfig := &tls.Config{
 		Certificates: []tls.Certificate{cert},
@@ -46,10 +43,7 @@ func TLSConfigForServer(caBundlex509 []*x509.Certificate, serverConfig *TLSCerti
 }
 
 func TLSConfigForClient(caBundleX509 []*x509.Certificate, clientConfig *TLSCertificateConfig) (*tls.Config, error) {
-	caPool := x509.NewCertPool()
-	for _, caCert := range caBundleX509 {
-		caPool.AddCert(caCert)
-	}
+	caPool := NewCertPool(caBundleX509)
 	tlsConfig := &tls.Config{
 		RootCAs:    caPool,
 		MinVersion: tls.VersionTLS13,
@@ -72,3 +66,12 @@ func TLSConfigForClient(caBundleX509 []*x509.Certificate, clientConfig *TLSCerti
 	}
 	return tlsConfig, nil
 }
+
+// NewCertPool returns a certificate pool holding the given CA certificates.
+func NewCertPool(caCerts []*x509.Certificate) *x509.CertPool {
+	caPool := x509.NewCertPool()
+	for _, caCert := range caCerts {
+		caPool.AddCert(caCert)
+	}
+	return caPool
+}
@@ -8,3 +8,6 @@ type CertificateSigningRequest = v1beta1.CertificateSigningRequest
 type CertificateSigningRequestList = v1beta1.CertificateSigningRequestList
 type CertificateSigningRequestSpec = v1beta1.CertificateSigningRequestSpec
 type CertificateSigningRequestStatus = v1beta1.CertificateSigningRequestStatus
+
+type CertificateRevocation = v1beta1.CertificateRevocation
+type CertificateRevocationList = v1beta1.CertificateRevocationList
@@ -95,6 +95,19 @@ const (
 	ResourceKindTemplateVersion                        = v1beta1.ResourceKindTemplateVersion
 )
 
+// ========== Watch ==========
+
+type WatchEvent = v1beta1.WatchEvent
+type WatchEventType = v1beta1.WatchEventType
+
+const (
+	WatchEventTypeAdded    = v1beta1.WatchEventTypeAdded
+	WatchEventTypeModified = v1beta1.WatchEventTypeModified
+	WatchEventTypeDeleted  = v1beta1.WatchEventTypeDeleted
+	WatchEventTypeBookmark = v1beta1.WatchEventTypeBookmark
+	WatchEventTypeError    = v1beta1.WatchEventTypeError
+)
+
 // ========== Dependency Sync Status ==========
 
 type DependencySyncStatus = v1beta1.DependencySyncStatus
@@ -48,23 +48,51 @@ const (
 
 type HookAction = v1beta1.HookAction
 type HookActionRun = v1beta1.HookActionRun
+type HookActionSystemd = v1beta1.HookActionSystemd
+type HookActionSystemdSpec = v1beta1.HookActionSystemdSpec
+type HookActionSystemdOperation = v1beta1.HookActionSystemdOperation
+type HookActionHttpProbe = v1beta1.HookActionHttpProbe
+type HookActionHttpProbeSpec = v1beta1.HookActionHttpProbeSpec
+type HookActionFailurePolicy = v1beta1.HookActionFailurePolicy
 type HookCondition = v1beta1.HookCondition
 type HookConditionExpression = v1beta1.HookConditionExpression
 type HookConditionPathOp = v1beta1.HookConditionPathOp
+type HookConditionOsImage = v1beta1.HookConditionOsImage
+type HookConditionApplicationOp = v1beta1.HookConditionApplicationOp
+type HookConditionLabels = v1beta1.HookConditionLabels
+type HookConditionSystemInfo = v1beta1.HookConditionSystemInfo
+type ApplicationOperation = v1beta1.ApplicationOperation
+
+const (
+	ApplicationOperationAdded   = v1beta1.ApplicationOperationAdded
+	ApplicationOperationRemoved = v1beta1.ApplicationOperationRemoved
+	ApplicationOperationUpdated = v1beta1.ApplicationOperationUpdated
+)
 
 // HookActionType discriminator
 type HookActionType = v1beta1.HookActionType
 
 const (
-	HookActionTypeRun = v1beta1.HookActionTypeRun
+	HookActionTypeRun       = v1beta1.HookActionTypeRun
+	HookActionTypeSystemd   = v1beta1.HookActionTypeSystemd
+	HookActionTypeHttpProbe = v1beta1.HookActionTypeHttpProbe
+)
+
+const (
+	HookActionFailurePolicyAbort  = v1beta1.HookActionFailurePolicyAbort
+	HookActionFailurePolicyIgnore = v1beta1.HookActionFailurePolicyIgnore
 )
 
 // HookConditionType discriminator
 type HookConditionType = v1beta1.HookConditionType
 
 const (
-	HookConditionTypeExpression = v1beta1.HookConditionTypeExpression
-	HookConditionTypePathOp     = v1beta1.HookConditionTypePathOp
+	HookConditionTypeExpression    = v1beta1.HookConditionTypeExpression
+	HookConditionTypePathOp        = v1beta1.HookConditionTypePathOp
+	HookConditionTypeOsImage       = v1beta1.HookConditionTypeOsImage
+	HookConditionTypeApplicationOp = v1beta1.HookConditionTypeApplicationOp
+	HookConditionTypeLabels        = v1beta1.HookConditionTypeLabels
+	HookConditionTypeSystemInfo    = v1beta1.HookConditionTypeSystemInfo
 )
 
 // ========== Resource Monitors ==========
@@ -17,6 +17,8 @@ const (
 	CertificateSigningRequestListKind   = v1beta1.CertificateSigningRequestListKind
 )
 
+const CertificateRevocationListKind = v1beta1.CertificateRevocationListKind
+
 // ========== Device ==========
 
 const (
@@ -32,13 +34,17 @@ const (
 	DeviceAnnotationRenderedVersion           = v1beta1.DeviceAnnotationRenderedVersion
 	DeviceAnnotationAwaitingReconnect         = v1beta1.DeviceAnnotationAwaitingReconnect
 	DeviceAnnotationConflictPaused            = v1beta1.DeviceAnnotationConflictPaused
+	DeviceAnnotationAttestationKey            = v1beta1.DeviceAnnotationAttestationKey
 	DeviceAnnotationTemplateVersion           = v1beta1.DeviceAnnotationTemplateVersion
 	DeviceAnnotationRenderedTemplateVersion   = v1beta1.DeviceAnnotationRenderedTemplateVersion
 	DeviceAnnotationRenderedSpecHash          = v1beta1.DeviceAnnotationRenderedSpecHash
 	DeviceAnnotationSelectedForRollout        = v1beta1.DeviceAnnotationSelectedForRollout
 	DeviceAnnotationLastRolloutError          = v1beta1.DeviceAnnotationLastRolloutError
+	DeviceAnnotationHealthySince              = v1beta1.DeviceAnnotationHealthySince
 	DeviceAnnotationApplicationLifecycle      = v1beta1.DeviceAnnotationApplicationLifecycle
 	DeviceAnnotationFleetApplicationLifecycle = v1beta1.DeviceAnnotationFleetApplicationLifecycle
+	DeviceAnnotationResourceSyncOwner         = v1beta1.DeviceAnnotationResourceSyncOwner
+	DeviceAnnotationResourceSyncManagedLabels = v1beta1.DeviceAnnotationResourceSyncManagedLabels
 )
 
 const DeviceDisconnectedTimeout = v1beta1.DeviceDisconnectedTimeout
@@ -69,6 +75,9 @@ const (
 	FleetAnnotationRolloutApprovalMethod       = v1beta1.FleetAnnotationRolloutApprovalMethod
 	FleetAnnotationLastBatchCompletionReport   = v1beta1.FleetAnnotationLastBatchCompletionReport
 	FleetAnnotationDeviceSelectionConfigDigest = v1beta1.FleetAnnotationDeviceSelectionConfigDigest
+	FleetAnnotationPreviousTemplateVersion     = v1beta1.FleetAnnotationPreviousTemplateVersion
+	FleetAnnotationRollback                    = v1beta1.FleetAnnotationRollback
+	FleetAnnotationNextMaintenanceWindow       = v1beta1.FleetAnnotationNextMaintenanceWindow
 	FleetAnnotationApplicationLifecycle        = v1beta1.FleetAnnotationApplicationLifecycle
 )
 
@@ -111,11 +120,12 @@ const (
 type ResourceSyncType = v1beta1.ResourceSyncType
 
 const (
-	ResourceSyncAPIVersion  = v1beta1.ResourceSyncAPIVersion
-	ResourceSyncKind        = v1beta1.ResourceSyncKind
-	ResourceSyncListKind    = v1beta1.ResourceSyncListKind
-	ResourceSyncTypeFleet   = v1beta1.ResourceSyncTypeFleet
-	ResourceSyncTypeCatalog = v1beta1.ResourceSyncTypeCatalog
+	ResourceSyncAPIVersion       = v1beta1.ResourceSyncAPIVersion
+	ResourceSyncKind             = v1beta1.ResourceSyncKind
+	ResourceSyncListKind         = v1beta1.ResourceSyncListKind
+	ResourceSyncTypeFleet        = v1beta1.ResourceSyncTypeFleet
+	ResourceSyncTypeCatalog      = v1beta1.ResourceSyncTypeCatalog
+	ResourceSyncTypeOrganization = v1beta1.ResourceSyncTypeOrganization
 )
 
 // ========== Catalog ==========
@@ -198,18 +208,21 @@ const (
 type DecommissionState = v1beta1.DecommissionState
 
 const (
-	DecommissionStateStarted  = v1beta1.DecommissionStateStarted
-	DecommissionStateComplete = v1beta1.DecommissionStateComplete
-	DecommissionStateError    = v1beta1.DecommissionStateError
+	DecommissionStateStarted    = v1beta1.DecommissionStateStarted
+	DecommissionStateInProgress = v1beta1.DecommissionStateInProgress
+	DecommissionStateComplete   = v1beta1.DecommissionStateComplete
+	DecommissionStateError      = v1beta1.DecommissionStateError
 )
 
 // ========== Rollout Reasons ==========
 
 const (
-	RolloutInactiveReason  = v1beta1.RolloutInactiveReason
-	RolloutActiveReason    = v1beta1.RolloutActiveReason
-	RolloutSuspendedReason = v1beta1.RolloutSuspendedReason
-	RolloutWaitingReason   = v1beta1.RolloutWaitingReason
+	RolloutInactiveReason                 = v1beta1.RolloutInactiveReason
+	RolloutActiveReason                   = v1beta1.RolloutActiveReason
+	RolloutSuspendedReason                = v1beta1.RolloutSuspendedReason
+	RolloutWaitingReason                  = v1beta1.RolloutWaitingReason
+	RolloutRolledBackReason               = v1beta1.RolloutRolledBackReason
+	RolloutOutsideMaintenanceWindowReason = v1beta1.RolloutOutsideMaintenanceWindowReason
 )
 
 // ========== Batch Names ==========
@@ -250,6 +263,9 @@ const DeviceConditionBootstrapReason = v1beta1.DeviceConditionBootstrapReason
 // ========== Template Functions ==========
 
 var (
-	GetGoTemplateFuncMap      = v1beta1.GetGoTemplateFuncMap
-	ExecuteGoTemplateOnDevice = v1beta1.ExecuteGoTemplateOnDevice
+	GetGoTemplateFuncMap                    = v1beta1.GetGoTemplateFuncMap
+	ExecuteGoTemplateOnDevice               = v1beta1.ExecuteGoTemplateOnDevice
+	ExecuteGoTemplateOnDeviceWithParameters = v1beta1.ExecuteGoTemplateOnDeviceWithParameters
+	GetDeviceTemplateParameters             = v1beta1.GetDeviceTemplateParameters
+	MissingTemplateParameters               = v1beta1.MissingTemplateParameters
 )
@@ -33,14 +33,19 @@ type CustomDeviceInfo = v1beta1.CustomDeviceInfo
 
 type DeviceOsSpec = v1beta1.DeviceOsSpec
 type DeviceUpdatePolicySpec = v1beta1.DeviceUpdatePolicySpec
+type WorkloadCertificateSpec = v1beta1.WorkloadCertificateSpec
 
 // ========== Operations ==========
 
 type DeviceRemoteSession = v1beta1.DeviceRemoteSession
 type DeviceConsole = v1beta1.DeviceConsole
 type DeviceDecommission = v1beta1.DeviceDecommission
+type DeviceCertificateRevocationRequest = v1beta1.DeviceCertificateRevocationRequest
 type DeviceResumeRequest = v1beta1.DeviceResumeRequest
 type DeviceResumeResponse = v1beta1.DeviceResumeResponse
+type AttestationChallenge = v1beta1.AttestationChallenge
+type AttestationQuote = v1beta1.AttestationQuote
+type PCRValue = v1beta1.PCRValue
 
 // ========== Aggregation ==========
 
@@ -52,6 +52,8 @@ const (
 	EventReasonDeviceDiskCritical              = v1beta1.EventReasonDeviceDiskCritical
 	EventReasonDeviceDiskNormal                = v1beta1.EventReasonDeviceDiskNormal
 	EventReasonDeviceDiskWarning               = v1beta1.EventReasonDeviceDiskWarning
+	EventReasonDeviceIntegrityFailed           = v1beta1.EventReasonDeviceIntegrityFailed
+	EventReasonDeviceIntegrityVerified         = v1beta1.EventReasonDeviceIntegrityVerified
 	EventReasonDeviceIsRebooting               = v1beta1.EventReasonDeviceIsRebooting
 	EventReasonDeviceMemoryCritical            = v1beta1.EventReasonDeviceMemoryCritical
 	EventReasonDeviceMemoryNormal              = v1beta1.EventReasonDeviceMemoryNormal
@@ -74,6 +76,9 @@ const (
 	EventReasonFleetRolloutCreated             = v1beta1.EventReasonFleetRolloutCreated
 	EventReasonFleetRolloutDeviceSelected      = v1beta1.EventReasonFleetRolloutDeviceSelected
 	EventReasonFleetRolloutFailed              = v1beta1.EventReasonFleetRolloutFailed
+	EventReasonFleetRolloutRollbackCompleted   = v1beta1.EventReasonFleetRolloutRollbackCompleted
+	EventReasonFleetRolloutRollbackFailed      = v1beta1.EventReasonFleetRolloutRollbackFailed
+	EventReasonFleetRolloutRollbackStarted     = v1beta1.EventReasonFleetRolloutRollbackStarted
 	EventReasonFleetRolloutStarted             = v1beta1.EventReasonFleetRolloutStarted
 	EventReasonFleetValid                      = v1beta1.EventReasonFleetValid
 	EventReasonInternalTaskFailed              = v1beta1.EventReasonInternalTaskFailed
@@ -98,6 +103,8 @@ const (
 	EventReasonResourceUpdated                 = v1beta1.EventReasonResourceUpdated
 	EventReasonSystemRestored                  = v1beta1.EventReasonSystemRestored
 	EventReasonApplicationLifecycleChanged     = v1beta1.EventReasonApplicationLifecycleChanged
+	EventReasonDeviceFileTransferred           = v1beta1.EventReasonDeviceFileTransferred
+	EventReasonDeviceFileTransferFailed        = v1beta1.EventReasonDeviceFileTransferFailed
 )
 
 // ========== Event Details Types ==========
@@ -120,6 +127,9 @@ type DependencySyncProbeFailedDetailsDetailType = v1beta1.DependencySyncProbeFai
 type ApplicationLifecycleChangedDetails = v1beta1.ApplicationLifecycleChangedDetails
 type ApplicationLifecycleChangedDetailsDetailType = v1beta1.ApplicationLifecycleChangedDetailsDetailType
 type ApplicationLifecycleChangedDetailsAction = v1beta1.ApplicationLifecycleChangedDetailsAction
+type DeviceFileTransferDetails = v1beta1.DeviceFileTransferDetails
+type DeviceFileTransferDetailsDetailType = v1beta1.DeviceFileTransferDetailsDetailType
+type DeviceFileTransferDetailsDirection = v1beta1.DeviceFileTransferDetailsDirection
 
 const (
 	InternalTaskFailed            = v1beta1.InternalTaskFailed
@@ -129,25 +139,32 @@ const (
 	ReferencedRepositoryUpdated   = v1beta1.ReferencedRepositoryUpdated
 	ResourceUpdated               = v1beta1.ResourceUpdated
 	ApplicationLifecycleChangedDT = v1beta1.ApplicationLifecycleChangedDetailType
+	DeviceFileTransferDT          = v1beta1.DeviceFileTransfer
 
 	// Application lifecycle action constants
 	ApplicationLifecycleActionStop    = v1beta1.ApplicationLifecycleActionStop
 	ApplicationLifecycleActionStart   = v1beta1.ApplicationLifecycleActionStart
 	ApplicationLifecycleActionRestart = v1beta1.ApplicationLifecycleActionRestart
 
+	// Device file transfer direction constants
+	DeviceFileTransferDownload = v1beta1.DeviceFileTransferDownload
+	DeviceFileTransferUpload   = v1beta1.DeviceFileTransferUpload
+
 	// Updated field constants with prefix (descriptive)
-	UpdatedFieldLabels       = v1beta1.Labels
-	UpdatedFieldOwner        = v1beta1.Owner
-	UpdatedFieldSpec         = v1beta1.Spec
-	UpdatedFieldSpecSelector = v1beta1.SpecSelector
-	UpdatedFieldSpecTemplate = v1beta1.SpecTemplate
+	UpdatedFieldLabels         = v1beta1.Labels
+	UpdatedFieldOwner          = v1beta1.Owner
+	UpdatedFieldSpec           = v1beta1.Spec
+	UpdatedFieldSpecSelector   = v1beta1.SpecSelector
+	UpdatedFieldSpecTemplate   = v1beta1.SpecTemplate
+	UpdatedFieldSpecParameters = v1beta1.SpecParameters
 
 	// Direct aliases for compatibility
-	Labels       = v1beta1.Labels
-	Owner        = v1beta1.Owner
-	Spec         = v1beta1.Spec
-	SpecSelector = v1beta1.SpecSelector
-	SpecTemplate = v1beta1.SpecTemplate
+	Labels         = v1beta1.Labels
+	Owner          = v1beta1.Owner
+	Spec           = v1beta1.Spec
+	SpecSelector   = v1beta1.SpecSelector
+	SpecTemplate   = v1beta1.SpecTemplate
+	SpecParameters = v1beta1.SpecParameters
 )
 
 // ========== Utility Functions ==========
@@ -172,6 +189,7 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonDeviceDisconnected:              {},
 	EventReasonDeviceConflictPaused:            {},
 	EventReasonDeviceSpecInvalid:               {},
+	EventReasonDeviceIntegrityFailed:           {},
 	EventReasonFleetInvalid:                    {},
 	EventReasonDeviceMultipleOwnersDetected:    {},
 	EventReasonDeviceUpdateFailed:              {},
@@ -181,7 +199,10 @@ var warningReasons = map[EventReason]struct{}{
 	EventReasonResourceSyncParsingFailed:       {},
 	EventReasonResourceSyncSyncFailed:          {},
 	EventReasonFleetRolloutFailed:              {},
+	EventReasonFleetRolloutRollbackStarted:     {},
+	EventReasonFleetRolloutRollbackFailed:      {},
 	EventReasonDependencySyncProbeFailed:       {},
+	EventReasonDeviceFileTransferFailed:        {},
 }
 
 // GetEventType determines the event type based on the event reason
@@ -8,6 +8,9 @@ type Fleet = v1beta1.Fleet
 type FleetList = v1beta1.FleetList
 type FleetSpec = v1beta1.FleetSpec
 type FleetStatus = v1beta1.FleetStatus
+type DeviceParameterSet = v1beta1.DeviceParameterSet
+type AttestationPolicy = v1beta1.AttestationPolicy
+type PCRReferenceValue = v1beta1.PCRReferenceValue
 
 // ========== Rollout Types ==========
 
@@ -15,7 +18,12 @@ type RolloutPolicy = v1beta1.RolloutPolicy
 type RolloutDeviceSelection = v1beta1.RolloutDeviceSelection
 type RolloutStrategy = v1beta1.RolloutStrategy
 type FleetRolloutStatus = v1beta1.FleetRolloutStatus
+type FleetRolloutRollbackStatus = v1beta1.FleetRolloutRollbackStatus
+type RolloutFailureAction = v1beta1.RolloutFailureAction
 type Batch = v1beta1.Batch
+type BatchHealthCriteria = v1beta1.BatchHealthCriteria
+type RolloutMaintenance = v1beta1.RolloutMaintenance
+type RolloutMaintenanceWindow = v1beta1.RolloutMaintenanceWindow
 type BatchSequence = v1beta1.BatchSequence
 type Batch_Limit = v1beta1.Batch_Limit
 type BatchLimit1 = v1beta1.BatchLimit1
@@ -27,6 +35,13 @@ const (
 	RolloutStrategyBatchSequence = v1beta1.RolloutStrategyBatchSequence
 )
 
+// ========== Rollout Failure Action Constants ==========
+
+const (
+	RolloutFailureActionPause    = v1beta1.RolloutFailureActionPause
+	RolloutFailureActionRollback = v1beta1.RolloutFailureActionRollback
+)
+
 // ========== Fleet Event Details Types ==========
 
 type FleetRolloutBatchCompletedDetails = v1beta1.FleetRolloutBatchCompletedDetails
@@ -39,19 +54,28 @@ type FleetRolloutDeviceSelectedDetails = v1beta1.FleetRolloutDeviceSelectedDetai
 type FleetRolloutDeviceSelectedDetailsDetailType = v1beta1.FleetRolloutDeviceSelectedDetailsDetailType
 type FleetRolloutFailedDetails = v1beta1.FleetRolloutFailedDetails
 type FleetRolloutFailedDetailsDetailType = v1beta1.FleetRolloutFailedDetailsDetailType
+type FleetRolloutRollbackCompletedDetails = v1beta1.FleetRolloutRollbackCompletedDetails
+type FleetRolloutRollbackCompletedDetailsDetailType = v1beta1.FleetRolloutRollbackCompletedDetailsDetailType
+type FleetRolloutRollbackFailedDetails = v1beta1.FleetRolloutRollbackFailedDetails
+type FleetRolloutRollbackFailedDetailsDetailType = v1beta1.FleetRolloutRollbackFailedDetailsDetailType
+type FleetRolloutRollbackStartedDetails = v1beta1.FleetRolloutRollbackStartedDetails
+type FleetRolloutRollbackStartedDetailsDetailType = v1beta1.FleetRolloutRollbackStartedDetailsDetailType
 type FleetRolloutStartedDetails = v1beta1.FleetRolloutStartedDetails
 type FleetRolloutStartedDetailsDetailType = v1beta1.FleetRolloutStartedDetailsDetailType
 type FleetRolloutStartedDetailsRolloutStrategy = v1beta1.FleetRolloutStartedDetailsRolloutStrategy
 
 const (
-	FleetRolloutBatchCompleted  = v1beta1.FleetRolloutBatchCompleted
-	FleetRolloutBatchDispatched = v1beta1.FleetRolloutBatchDispatched
-	FleetRolloutCompleted       = v1beta1.FleetRolloutCompleted
-	FleetRolloutDeviceSelected  = v1beta1.FleetRolloutDeviceSelected
-	FleetRolloutFailed          = v1beta1.FleetRolloutFailed
-	FleetRolloutStarted         = v1beta1.FleetRolloutStarted
-	FleetRolloutStrategyBatched = v1beta1.Batched
-	FleetRolloutStrategyNone    = v1beta1.None
+	FleetRolloutBatchCompleted    = v1beta1.FleetRolloutBatchCompleted
+	FleetRolloutBatchDispatched   = v1beta1.FleetRolloutBatchDispatched
+	FleetRolloutCompleted         = v1beta1.FleetRolloutCompleted
+	FleetRolloutDeviceSelected    = v1beta1.FleetRolloutDeviceSelected
+	FleetRolloutFailed            = v1beta1.FleetRolloutFailed
+	FleetRolloutRollbackCompleted = v1beta1.FleetRolloutRollbackCompleted
+	FleetRolloutRollbackFailed    = v1beta1.FleetRolloutRollbackFailed
+	FleetRolloutRollbackStarted   = v1beta1.FleetRolloutRollbackStarted
+	FleetRolloutStarted           = v1beta1.FleetRolloutStarted
+	FleetRolloutStrategyBatched   = v1beta1.Batched
+	FleetRolloutStrategyNone      = v1beta1.None
 
 	// Direct aliases for compatibility
 	Batched = v1beta1.Batched
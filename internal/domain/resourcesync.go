@@ -9,6 +9,20 @@ type ResourceSyncList = v1beta1.ResourceSyncList
 type ResourceSyncSpec = v1beta1.ResourceSyncSpec
 type ResourceSyncStatus = v1beta1.ResourceSyncStatus
 
+// ========== Plan Types ==========
+
+type ResourceSyncPlanRequest = v1beta1.ResourceSyncPlanRequest
+type ResourceSyncPlan = v1beta1.ResourceSyncPlan
+type ResourceSyncPlannedChange = v1beta1.ResourceSyncPlannedChange
+type ResourceSyncPlannedChangeAction = v1beta1.ResourceSyncPlannedChangeAction
+type ResourceSyncFieldDiff = v1beta1.ResourceSyncFieldDiff
+
+const (
+	ResourceSyncPlannedActionCreate = v1beta1.ResourceSyncPlannedActionCreate
+	ResourceSyncPlannedActionUpdate = v1beta1.ResourceSyncPlannedActionUpdate
+	ResourceSyncPlannedActionDelete = v1beta1.ResourceSyncPlannedActionDelete
+)
+
 // ========== Event Details ==========
 
 type ResourceSyncCompletedDetails = v1beta1.ResourceSyncCompletedDetails
@@ -19,6 +19,7 @@ var (
 	StatusResourceNotFound        = v1beta1.StatusResourceNotFound
 	StatusConflict                = v1beta1.StatusConflict
 	StatusResourceVersionConflict = v1beta1.StatusResourceVersionConflict
+	StatusGone                    = v1beta1.StatusGone
 	StatusInternalServerError     = v1beta1.StatusInternalServerError
 	StatusNotImplemented          = v1beta1.StatusNotImplemented
 	StatusTooManyRequests         = v1beta1.StatusTooManyRequests
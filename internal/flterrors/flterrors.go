@@ -60,6 +60,9 @@ var (
 	ErrDuplicateOIDCProvider   = errors.New("an OIDC auth provider with the same issuer and clientId already exists")
 	ErrDuplicateOAuth2Provider = errors.New("an OAuth2 auth provider with the same userinfoUrl and clientId already exists")
 
+	// resourcesync
+	ErrInvalidResourceSync = errors.New("invalid ResourceSync")
+
 	// database encoding
 	ErrUnsupportedUnicode = errors.New("unsupported Unicode escape sequence")
 )
@@ -0,0 +1,153 @@
+package gitwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	organizationservice "github.com/flightctl/flightctl/internal/service/organization"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+// Path is where the API server receives git webhooks.
+const Path = "/api/v1/webhooks/git"
+
+const repositoriesPerPage = 1000
+
+// Handler receives push webhooks from git forges and triggers the ResourceSyncs and git
+// dependency checks of every Repository whose URL matches the pushed repository.
+type Handler struct {
+	secret          string
+	organizationSvc organizationservice.Service
+	repositorySvc   repositoryservice.Service
+	publisher       Publisher
+	log             logrus.FieldLogger
+}
+
+func NewHandler(secret string, organizationSvc organizationservice.Service, repositorySvc repositoryservice.Service, publisher Publisher, log logrus.FieldLogger) *Handler {
+	return &Handler{
+		secret:          secret,
+		organizationSvc: organizationSvc,
+		repositorySvc:   repositorySvc,
+		publisher:       publisher,
+		log:             log,
+	}
+}
+
+// Response is returned to the forge once a push has been handled.
+type Response struct {
+	MatchedRepositories int `json:"matchedRepositories"`
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	log := log.WithReqIDFromCtx(r.Context(), h.log)
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, "failed reading request body", http.StatusBadRequest)
+		return
+	}
+
+	push, err := ParsePush(r.Header, body, h.secret)
+	switch {
+	case errors.Is(err, ErrUnauthorized):
+		log.Warn("rejected git webhook with an invalid signature")
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	case err != nil:
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	case push == nil:
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	ctx := context.WithValue(r.Context(), consts.InternalRequestCtxKey, true)
+	triggers, err := h.match(ctx, push)
+	if err != nil {
+		log.WithError(err).Error("failed matching git webhook to repositories")
+		http.Error(w, "failed matching repositories", http.StatusInternalServerError)
+		return
+	}
+
+	matched := 0
+	for _, trigger := range triggers {
+		if err := h.publisher.Publish(ctx, trigger); err != nil {
+			log.WithError(err).Errorf("failed publishing git push trigger for organization %s", trigger.OrgID)
+			http.Error(w, "failed triggering sync", http.StatusServiceUnavailable)
+			return
+		}
+		matched += len(trigger.Repositories)
+	}
+	log.Infof("%s push to %s@%s matched %d repositories", push.Provider, push.RepositoryURLs[0], push.Revision, matched)
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(Response{MatchedRepositories: matched})
+}
+
+// match returns one trigger per organization that has git repositories with one of the pushed URLs.
+func (h *Handler) match(ctx context.Context, push *Push) ([]Trigger, error) {
+	pushedURLs := make(map[string]struct{}, len(push.RepositoryURLs))
+	for _, url := range push.RepositoryURLs {
+		pushedURLs[NormalizeURL(url)] = struct{}{}
+	}
+
+	orgs, status := h.organizationSvc.ListOrganizations(ctx, domain.ListOrganizationsParams{})
+	if status.Code != http.StatusOK {
+		return nil, fmt.Errorf("listing organizations: %s", status.Message)
+	}
+
+	var triggers []Trigger
+	for _, org := range orgs.Items {
+		orgID, err := uuid.Parse(lo.FromPtr(org.Metadata.Name))
+		if err != nil {
+			continue
+		}
+		names, err := h.matchingRepositories(ctx, orgID, pushedURLs)
+		if err != nil {
+			return nil, err
+		}
+		if len(names) > 0 {
+			triggers = append(triggers, Trigger{OrgID: orgID, Repositories: names, Revision: push.Revision})
+		}
+	}
+	return triggers, nil
+}
+
+func (h *Handler) matchingRepositories(ctx context.Context, orgID uuid.UUID, pushedURLs map[string]struct{}) ([]string, error) {
+	var names []string
+	params := domain.ListRepositoriesParams{Limit: lo.ToPtr(int32(repositoriesPerPage))}
+	for {
+		repositories, status := h.repositorySvc.ListRepositories(ctx, orgID, params)
+		if status.Code != http.StatusOK {
+			return nil, fmt.Errorf("listing repositories of organization %s: %s", orgID, status.Message)
+		}
+		for _, repo := range repositories.Items {
+			repoType, err := repo.Spec.Discriminator()
+			if err != nil || repoType != string(domain.GitRepoSpecTypeGit) {
+				continue
+			}
+			url, err := repo.Spec.GetRepoURL()
+			if err != nil {
+				continue
+			}
+			if _, ok := pushedURLs[NormalizeURL(url)]; ok {
+				names = append(names, lo.FromPtr(repo.Metadata.Name))
+			}
+		}
+		if repositories.Metadata.Continue == nil {
+			return names, nil
+		}
+		params.Continue = repositories.Metadata.Continue
+	}
+}
@@ -0,0 +1,88 @@
+package gitwebhook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	organizationservice "github.com/flightctl/flightctl/internal/service/organization"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+type fakePublisher struct {
+	triggers []Trigger
+}
+
+func (f *fakePublisher) Publish(_ context.Context, trigger Trigger) error {
+	f.triggers = append(f.triggers, trigger)
+	return nil
+}
+
+func newRepository(t *testing.T, name, url string) domain.Repository {
+	t.Helper()
+	repo := domain.Repository{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)}}
+	require.NoError(t, repo.Spec.FromGitRepoSpec(domain.GitRepoSpec{Url: url, Type: domain.GitRepoSpecTypeGit}))
+	return repo
+}
+
+func TestHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockOrganizationSvc := organizationservice.NewMockService(ctrl)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	publisher := &fakePublisher{}
+	handler := NewHandler(testSecret, mockOrganizationSvc, mockRepositorySvc, publisher, logrus.New())
+
+	matchingOrg, otherOrg := uuid.New(), uuid.New()
+	mockOrganizationSvc.EXPECT().ListOrganizations(gomock.Any(), gomock.Any()).Return(&domain.OrganizationList{
+		Items: []domain.Organization{
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr(matchingOrg.String())}},
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr(otherOrg.String())}},
+		},
+	}, domain.StatusOK())
+	mockRepositorySvc.EXPECT().ListRepositories(gomock.Any(), matchingOrg, gomock.Any()).Return(&domain.RepositoryList{
+		Items: []domain.Repository{
+			newRepository(t, "site-config", "git@github.com:acme/site-config.git"),
+			newRepository(t, "apps", "https://github.com/acme/apps.git"),
+		},
+	}, domain.StatusOK())
+	mockRepositorySvc.EXPECT().ListRepositories(gomock.Any(), otherOrg, gomock.Any()).Return(&domain.RepositoryList{
+		Items: []domain.Repository{newRepository(t, "apps", "https://github.com/acme/apps.git")},
+	}, domain.StatusOK())
+
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(githubPushBody))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+sign(githubPushBody))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	require.Equal(t, http.StatusAccepted, rec.Code)
+	var response Response
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &response))
+	assert.Equal(t, 1, response.MatchedRepositories)
+	assert.Equal(t, []Trigger{{OrgID: matchingOrg, Repositories: []string{"site-config"}, Revision: "main"}}, publisher.triggers)
+}
+
+func TestHandler_Unauthorized(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	publisher := &fakePublisher{}
+	handler := NewHandler(testSecret, organizationservice.NewMockService(ctrl), repositoryservice.NewMockService(ctrl), publisher, logrus.New())
+
+	req := httptest.NewRequest(http.MethodPost, Path, strings.NewReader(githubPushBody))
+	req.Header.Set("X-GitHub-Event", "push")
+	req.Header.Set("X-Hub-Signature-256", "sha256="+sign("tampered"))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Empty(t, publisher.triggers)
+}
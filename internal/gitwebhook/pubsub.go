@@ -0,0 +1,94 @@
+package gitwebhook
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const channelName = "git_push_triggers"
+
+// Trigger asks the periodic tasks to resync repositories of an organization without waiting
+// for their next poll.
+type Trigger struct {
+	OrgID uuid.UUID `json:"orgId"`
+	// Repositories holds the names of the Repository resources that were pushed to.
+	Repositories []string `json:"repositories"`
+	// Revision is the pushed branch or tag. Empty means any revision.
+	Revision string `json:"revision,omitempty"`
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, trigger Trigger) error
+}
+
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(ctx context.Context, trigger Trigger) error) error
+	Close()
+}
+
+func NewPublisher(ctx context.Context, queuesProvider queues.Provider) (Publisher, error) {
+	queuesPublisher, err := queuesProvider.NewPubSubPublisher(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &publisher{
+		broadcaster: queuesPublisher,
+	}, nil
+}
+
+func NewSubscriber(ctx context.Context, queuesProvider queues.Provider) (Subscriber, error) {
+	subscriber, err := queuesProvider.NewPubSubSubscriber(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &consumer{
+		subscriber: subscriber,
+	}, nil
+}
+
+type publisher struct {
+	broadcaster queues.PubSubPublisher
+}
+
+func (p *publisher) Publish(ctx context.Context, trigger Trigger) error {
+	b, err := json.Marshal(trigger)
+	if err != nil {
+		return err
+	}
+	return p.broadcaster.Publish(ctx, b)
+}
+
+type consumer struct {
+	subscriber    queues.PubSubSubscriber
+	subscriptions []queues.Subscription
+}
+
+func (c *consumer) Subscribe(ctx context.Context, handler func(ctx context.Context, trigger Trigger) error) error {
+	queuesHandler := func(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+		var trigger Trigger
+		if err := json.Unmarshal(payload, &trigger); err != nil {
+			log.WithError(err).Error("failed to unmarshal git push trigger")
+			return err
+		}
+		return handler(ctx, trigger)
+	}
+
+	subscription, err := c.subscriber.Subscribe(ctx, queuesHandler)
+	if err != nil {
+		return err
+	}
+	c.subscriptions = append(c.subscriptions, subscription)
+	return nil
+}
+
+func (c *consumer) Close() {
+	for _, sub := range c.subscriptions {
+		sub.Close()
+	}
+	c.subscriptions = nil
+	c.subscriber.Close()
+}
@@ -0,0 +1,164 @@
+package gitwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// Provider identifies the git forge that sent a webhook.
+type Provider string
+
+const (
+	ProviderGitHub Provider = "github"
+	ProviderGitLab Provider = "gitlab"
+	ProviderGitea  Provider = "gitea"
+)
+
+var (
+	// ErrUnauthorized is returned when the webhook signature or token does not match the secret.
+	ErrUnauthorized = errors.New("webhook signature verification failed")
+	// ErrUnknownProvider is returned when the request carries no GitHub, GitLab or Gitea event header.
+	ErrUnknownProvider = errors.New("request is not a GitHub, GitLab or Gitea webhook")
+)
+
+// Push is a push to a git repository as reported by a webhook.
+type Push struct {
+	Provider Provider
+	// RepositoryURLs holds every URL the forge reports for the repository (HTTPS, SSH and web).
+	RepositoryURLs []string
+	// Revision is the pushed branch or tag, without its refs/heads/ or refs/tags/ prefix.
+	Revision string
+}
+
+// githubPushPayload is the subset of the GitHub push payload that is used. Gitea sends the same fields.
+type githubPushPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		CloneURL string `json:"clone_url"`
+		SSHURL   string `json:"ssh_url"`
+		HTMLURL  string `json:"html_url"`
+	} `json:"repository"`
+}
+
+// gitlabPushPayload is the subset of the GitLab push and tag push payloads that is used.
+type gitlabPushPayload struct {
+	Ref     string `json:"ref"`
+	Project struct {
+		GitHTTPURL string `json:"git_http_url"`
+		GitSSHURL  string `json:"git_ssh_url"`
+		WebURL     string `json:"web_url"`
+	} `json:"project"`
+}
+
+// ParsePush authenticates a webhook request against the shared secret and parses its push payload.
+// GitHub and Gitea requests must carry an HMAC-SHA256 signature of the body; GitLab requests must
+// carry the secret as their token. Authenticated events other than pushes, such as GitHub's ping,
+// return a nil Push and no error.
+func ParsePush(header http.Header, body []byte, secret string) (*Push, error) {
+	// Gitea also sends the GitHub headers, so it must be detected first.
+	switch {
+	case header.Get("X-Gitea-Event") != "":
+		signature := header.Get("X-Gitea-Signature")
+		if !validSignature(body, secret, signature) {
+			return nil, ErrUnauthorized
+		}
+		if header.Get("X-Gitea-Event") != "push" {
+			return nil, nil
+		}
+		return parseGitHubPush(ProviderGitea, body)
+	case header.Get("X-GitHub-Event") != "":
+		signature, found := strings.CutPrefix(header.Get("X-Hub-Signature-256"), "sha256=")
+		if !found || !validSignature(body, secret, signature) {
+			return nil, ErrUnauthorized
+		}
+		if header.Get("X-GitHub-Event") != "push" {
+			return nil, nil
+		}
+		return parseGitHubPush(ProviderGitHub, body)
+	case header.Get("X-Gitlab-Event") != "":
+		token := header.Get("X-Gitlab-Token")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+			return nil, ErrUnauthorized
+		}
+		switch header.Get("X-Gitlab-Event") {
+		case "Push Hook", "Tag Push Hook":
+			return parseGitLabPush(body)
+		default:
+			return nil, nil
+		}
+	default:
+		return nil, ErrUnknownProvider
+	}
+}
+
+func validSignature(body []byte, secret string, signature string) bool {
+	expected, err := hex.DecodeString(signature)
+	if err != nil || len(expected) == 0 {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+func parseGitHubPush(provider Provider, body []byte) (*Push, error) {
+	var payload githubPushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decoding %s push payload: %w", provider, err)
+	}
+	repo := payload.Repository
+	return newPush(provider, payload.Ref, repo.CloneURL, repo.SSHURL, repo.HTMLURL)
+}
+
+func parseGitLabPush(body []byte) (*Push, error) {
+	var payload gitlabPushPayload
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decoding %s push payload: %w", ProviderGitLab, err)
+	}
+	project := payload.Project
+	return newPush(ProviderGitLab, payload.Ref, project.GitHTTPURL, project.GitSSHURL, project.WebURL)
+}
+
+func newPush(provider Provider, ref string, urls ...string) (*Push, error) {
+	push := &Push{Provider: provider}
+	for _, url := range urls {
+		if url != "" {
+			push.RepositoryURLs = append(push.RepositoryURLs, url)
+		}
+	}
+	if len(push.RepositoryURLs) == 0 {
+		return nil, fmt.Errorf("%s push payload has no repository URL", provider)
+	}
+	push.Revision = strings.TrimPrefix(strings.TrimPrefix(ref, "refs/heads/"), "refs/tags/")
+	return push, nil
+}
+
+// NormalizeURL reduces a git repository URL to its lowercase host and path, so that the HTTPS,
+// SSH and web URLs of a repository compare equal.
+func NormalizeURL(rawURL string) string {
+	url := strings.TrimSpace(rawURL)
+	if _, rest, found := strings.Cut(url, "://"); found {
+		url = rest
+	} else if colon := strings.Index(url, ":"); colon >= 0 && !strings.Contains(url[:colon], "/") {
+		// scp-like syntax: user@host:path
+		url = url[:colon] + "/" + url[colon+1:]
+	}
+
+	host, path, _ := strings.Cut(url, "/")
+	if at := strings.LastIndex(host, "@"); at >= 0 {
+		host = host[at+1:]
+	}
+	if colon := strings.LastIndex(host, ":"); colon >= 0 {
+		host = host[:colon]
+	}
+
+	path = strings.TrimSuffix(strings.TrimRight(path, "/"), ".git")
+	return strings.ToLower(host) + "/" + strings.Trim(path, "/")
+}
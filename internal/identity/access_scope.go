@@ -0,0 +1,69 @@
+package identity
+
+import (
+	"slices"
+
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ScopedRole is a role whose permissions on devices and fleets are limited to a scope
+type ScopedRole struct {
+	// Role is the name of the role granted within the scope
+	Role string `json:"role"`
+
+	// LabelSelector limits the role to resources whose labels match it, in label selector syntax
+	LabelSelector string `json:"label_selector,omitempty"`
+
+	// Fleets limits the role to the listed fleets and the devices they own
+	Fleets []string `json:"fleets,omitempty"`
+}
+
+// AccessScope is the set of devices and fleets a request may act on when the caller's permission
+// comes only from scoped roles. A resource is in scope if it matches any of the label selectors or
+// belongs to any of the fleets.
+type AccessScope struct {
+	LabelSelectors []string
+	Fleets         []string
+}
+
+// Add extends the scope with the scope of the given role
+func (s *AccessScope) Add(role ScopedRole) {
+	if role.LabelSelector != "" && !slices.Contains(s.LabelSelectors, role.LabelSelector) {
+		s.LabelSelectors = append(s.LabelSelectors, role.LabelSelector)
+	}
+	for _, fleet := range role.Fleets {
+		if !slices.Contains(s.Fleets, fleet) {
+			s.Fleets = append(s.Fleets, fleet)
+		}
+	}
+}
+
+// ContainsDevice returns true if a device with the given labels, owned by the given fleet (empty
+// if the device is not owned by a fleet), is in scope
+func (s *AccessScope) ContainsDevice(deviceLabels map[string]string, ownerFleet string) bool {
+	if ownerFleet != "" && slices.Contains(s.Fleets, ownerFleet) {
+		return true
+	}
+	return s.matchesLabels(deviceLabels)
+}
+
+// ContainsFleet returns true if the fleet with the given name and labels is in scope
+func (s *AccessScope) ContainsFleet(name string, fleetLabels map[string]string) bool {
+	if slices.Contains(s.Fleets, name) {
+		return true
+	}
+	return s.matchesLabels(fleetLabels)
+}
+
+func (s *AccessScope) matchesLabels(resourceLabels map[string]string) bool {
+	for _, selector := range s.LabelSelectors {
+		parsed, err := labels.Parse(selector)
+		if err != nil || parsed.Empty() {
+			continue
+		}
+		if parsed.Matches(labels.Set(resourceLabels)) {
+			return true
+		}
+	}
+	return false
+}
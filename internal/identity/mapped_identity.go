@@ -19,6 +19,9 @@ type MappedIdentity struct {
 	// OrgRoles maps organization ID to roles for that organization
 	OrgRoles map[string][]string `json:"org_roles"`
 
+	// OrgScopedRoles maps organization ID to roles that only apply within a scope
+	OrgScopedRoles map[string][]ScopedRole `json:"org_scoped_roles,omitempty"`
+
 	// SuperAdmin indicates if the user has the global flightctl-admin role
 	SuperAdmin bool `json:"is_super_admin"`
 
@@ -66,6 +69,11 @@ func (m *MappedIdentity) GetRolesForOrg(orgID string) []string {
 	return []string{}
 }
 
+// GetScopedRolesForOrg returns the scoped roles for a specific organization by ID
+func (m *MappedIdentity) GetScopedRolesForOrg(orgID string) []ScopedRole {
+	return m.OrgScopedRoles[orgID]
+}
+
 // IsSuperAdmin returns whether the user has the global flightctl-admin role
 func (m *MappedIdentity) IsSuperAdmin() bool {
 	return m.SuperAdmin
@@ -38,7 +38,8 @@ type RepoSpecType = corev1beta1.RepoSpecType
 
 // Repository spec type constants
 const (
-	RepoSpecTypeOci = corev1beta1.RepoSpecTypeOci
+	RepoSpecTypeOci  = corev1beta1.RepoSpecTypeOci
+	RepoSpecTypeHttp = corev1beta1.RepoSpecTypeHttp
 )
 
 // Access mode type
@@ -50,6 +51,17 @@ const (
 	ReadWrite = corev1beta1.ReadWrite
 )
 
+// ========== File Types (needed for customizations) ==========
+
+// EncodingType represents the encoding of file content
+type EncodingType = corev1beta1.EncodingType
+
+// Encoding type constants
+const (
+	EncodingPlain  = corev1beta1.EncodingPlain
+	EncodingBase64 = corev1beta1.EncodingBase64
+)
+
 // ========== Event Types ==========
 
 // Event represents an event resource
@@ -16,6 +16,11 @@ type ImageBuildSource = api.ImageBuildSource
 type ImageBuildDestination = api.ImageBuildDestination
 type ImageBuildBinding = api.ImageBuildBinding
 type ImageBuildUserConfiguration = api.ImageBuildUserConfiguration
+type ImageBuildCustomizations = api.ImageBuildCustomizations
+type ImageBuildRpmRepository = api.ImageBuildRpmRepository
+type ImageBuildFile = api.ImageBuildFile
+type ImageBuildFileHttpRef = api.ImageBuildFileHttpRef
+type ImageBuildSystemd = api.ImageBuildSystemd
 
 // ========== Status Types ==========
 
@@ -44,7 +49,8 @@ const (
 // ========== Condition Type Constants ==========
 
 const (
-	ImageBuildConditionTypeReady = api.ImageBuildConditionTypeReady
+	ImageBuildConditionTypeReady  = api.ImageBuildConditionTypeReady
+	ImageBuildConditionTypeSigned = api.ImageBuildConditionTypeSigned
 )
 
 // ========== Condition Reason Constants ==========
@@ -60,6 +66,12 @@ const (
 	ImageBuildConditionReasonCanceled       = api.ImageBuildConditionReasonCanceled
 )
 
+const (
+	ImageBuildSignedConditionReasonSigned          = api.ImageBuildSignedConditionReasonSigned
+	ImageBuildSignedConditionReasonSigningFailed   = api.ImageBuildSignedConditionReasonSigningFailed
+	ImageBuildSignedConditionReasonSigningDisabled = api.ImageBuildSignedConditionReasonSigningDisabled
+)
+
 // ========== NewVersion Types ==========
 
 type ImageBuildNewVersionRequest = api.ImageBuildNewVersionRequest
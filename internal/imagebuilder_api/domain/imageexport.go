@@ -14,6 +14,7 @@ type ImageExportList = api.ImageExportList
 type ImageExportSpec = api.ImageExportSpec
 type ImageExportSource = api.ImageExportSource
 type ExportFormatType = api.ExportFormatType
+type ExportCompressionType = api.ExportCompressionType
 
 // ========== Source Types ==========
 
@@ -28,16 +29,28 @@ type ImageExportCondition = api.ImageExportCondition
 type ImageExportConditionType = api.ImageExportConditionType
 type ImageExportConditionReason = api.ImageExportConditionReason
 type ImageExportFormatPhase = api.ImageExportFormatPhase
+type ImageExportArtifact = api.ImageExportArtifact
 
 // ========== Export Format Constants ==========
 
 const (
 	ExportFormatTypeISO                = api.ExportFormatTypeISO
+	ExportFormatTypeOVA                = api.ExportFormatTypeOVA
 	ExportFormatTypeQCOW2              = api.ExportFormatTypeQCOW2
 	ExportFormatTypeQCOW2DiskContainer = api.ExportFormatTypeQCOW2DiskContainer
+	ExportFormatTypeRAW                = api.ExportFormatTypeRAW
+	ExportFormatTypeVHD                = api.ExportFormatTypeVHD
 	ExportFormatTypeVMDK               = api.ExportFormatTypeVMDK
 )
 
+// ========== Export Compression Constants ==========
+
+const (
+	ExportCompressionTypeNone = api.ExportCompressionTypeNone
+	ExportCompressionTypeXz   = api.ExportCompressionTypeXz
+	ExportCompressionTypeZstd = api.ExportCompressionTypeZstd
+)
+
 // ========== Source Type Constants ==========
 
 const (
@@ -660,6 +660,30 @@ func (s *imageBuildService) validate(ctx context.Context, orgId uuid.UUID, image
 		errs = append(errs, ValidatePublicKey(&imageBuild.Spec.UserConfiguration.Publickey, "spec.userConfiguration.publickey")...)
 	}
 
+	// Validate customizations if provided
+	if imageBuild.Spec.Customizations != nil {
+		errs = append(errs, ValidateCustomizations(imageBuild.Spec.Customizations, "spec.customizations")...)
+		for i, file := range lo.FromPtr(imageBuild.Spec.Customizations.Files) {
+			if file.HttpRef == nil || file.HttpRef.Repository == "" {
+				continue
+			}
+			repo, err := s.repositoryStore.Get(ctx, orgId, file.HttpRef.Repository)
+			if errors.Is(err, flterrors.ErrResourceNotFound) {
+				errs = append(errs, fmt.Errorf("spec.customizations.files[%d].httpRef.repository: Repository %q not found", i, file.HttpRef.Repository))
+			} else if err != nil {
+				return nil, fmt.Errorf("failed to get file repository %q: %w", file.HttpRef.Repository, err)
+			} else {
+				specType, err := repo.Spec.Discriminator()
+				if err != nil {
+					return nil, fmt.Errorf("failed to get file repository spec type: %w", err)
+				}
+				if specType != string(domain.RepoSpecTypeHttp) {
+					errs = append(errs, fmt.Errorf("spec.customizations.files[%d].httpRef.repository: Repository %q must be of type 'http', got %q", i, file.HttpRef.Repository, specType))
+				}
+			}
+		}
+	}
+
 	return errs, nil
 }
 
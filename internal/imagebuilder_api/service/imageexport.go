@@ -542,7 +542,7 @@ func (s *imageExportService) Download(ctx context.Context, orgId uuid.UUID, name
 		return nil, err
 	}
 
-	download.Filename = getDownloadFilename(*imageBuild.Metadata.Name, imageExport.Spec.Format)
+	download.Filename = getDownloadFilename(*imageBuild.Metadata.Name, imageExport.Spec.Format, lo.FromPtrOr(imageExport.Spec.Compression, domain.ExportCompressionTypeNone))
 	return download, nil
 }
 
@@ -894,7 +894,7 @@ func (s *imageExportService) getRegistryToken(ctx context.Context, client *http.
 }
 
 // getDownloadFilename returns the suggested download filename based on the base name and export format
-func getDownloadFilename(baseName string, format domain.ExportFormatType) string {
+func getDownloadFilename(baseName string, format domain.ExportFormatType, compression domain.ExportCompressionType) string {
 	ext := ".bin"
 	switch format {
 	case domain.ExportFormatTypeISO:
@@ -903,6 +903,18 @@ func getDownloadFilename(baseName string, format domain.ExportFormatType) string
 		ext = ".qcow2"
 	case domain.ExportFormatTypeVMDK:
 		ext = ".vmdk"
+	case domain.ExportFormatTypeRAW:
+		ext = ".raw"
+	case domain.ExportFormatTypeVHD:
+		ext = ".vhd"
+	case domain.ExportFormatTypeOVA:
+		ext = ".ova"
+	}
+	switch compression {
+	case domain.ExportCompressionTypeZstd:
+		ext += ".zst"
+	case domain.ExportCompressionTypeXz:
+		ext += ".xz"
 	}
 	return baseName + ext
 }
@@ -1067,6 +1079,12 @@ func (s *imageExportService) validate(ctx context.Context, orgId uuid.UUID, imag
 		errs = append(errs, errors.New("spec.format is required"))
 	}
 
+	// Validate compression
+	compression := lo.FromPtrOr(imageExport.Spec.Compression, domain.ExportCompressionTypeNone)
+	if compression != domain.ExportCompressionTypeNone && imageExport.Spec.Format != domain.ExportFormatTypeRAW {
+		errs = append(errs, fmt.Errorf("spec.compression: compression is only supported for format %q", domain.ExportFormatTypeRAW))
+	}
+
 	return errs, nil
 }
 
@@ -173,6 +173,63 @@ func TestCreateImageExportMissingFormats(t *testing.T) {
 	require.Equal(int32(http.StatusBadRequest), statusCode(status))
 }
 
+func TestCreateImageExportCompression(t *testing.T) {
+	testCases := []struct {
+		name           string
+		format         api.ExportFormatType
+		compression    *api.ExportCompressionType
+		expectedStatus int32
+	}{
+		{name: "raw without compression", format: api.ExportFormatTypeRAW, expectedStatus: http.StatusCreated},
+		{name: "raw with zstd", format: api.ExportFormatTypeRAW, compression: lo.ToPtr(api.ExportCompressionTypeZstd), expectedStatus: http.StatusCreated},
+		{name: "raw with xz", format: api.ExportFormatTypeRAW, compression: lo.ToPtr(api.ExportCompressionTypeXz), expectedStatus: http.StatusCreated},
+		{name: "ova with explicit none", format: api.ExportFormatTypeOVA, compression: lo.ToPtr(api.ExportCompressionTypeNone), expectedStatus: http.StatusCreated},
+		{name: "qcow2 with zstd", format: api.ExportFormatTypeQCOW2, compression: lo.ToPtr(api.ExportCompressionTypeZstd), expectedStatus: http.StatusBadRequest},
+		{name: "vhd with xz", format: api.ExportFormatTypeVHD, compression: lo.ToPtr(api.ExportCompressionTypeXz), expectedStatus: http.StatusBadRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			ctx := context.Background()
+			orgId := uuid.New()
+
+			repoStore := NewDummyRepositoryStore()
+			setupRepositoriesForImageBuild(repoStore, ctx, orgId)
+			imageBuildStore := NewDummyImageBuildStore()
+			setupImageBuildForExport(imageBuildStore, ctx, orgId)
+			svc := NewImageExportService(NewDummyImageExportStore(), imageBuildStore, repoStore, nil, nil, nil, config.NewDefaultImageBuilderServiceConfig(), log.InitLogs())
+
+			imageExport := newValidImageExport("test-export")
+			imageExport.Spec.Format = tc.format
+			imageExport.Spec.Compression = tc.compression
+
+			_, status := svc.Create(ctx, orgId, imageExport)
+			require.Equal(tc.expectedStatus, statusCode(status))
+		})
+	}
+}
+
+func TestGetDownloadFilename(t *testing.T) {
+	testCases := []struct {
+		format      api.ExportFormatType
+		compression api.ExportCompressionType
+		expected    string
+	}{
+		{format: api.ExportFormatTypeQCOW2, compression: api.ExportCompressionTypeNone, expected: "my-build.qcow2"},
+		{format: api.ExportFormatTypeISO, compression: api.ExportCompressionTypeNone, expected: "my-build.iso"},
+		{format: api.ExportFormatTypeRAW, compression: api.ExportCompressionTypeNone, expected: "my-build.raw"},
+		{format: api.ExportFormatTypeRAW, compression: api.ExportCompressionTypeZstd, expected: "my-build.raw.zst"},
+		{format: api.ExportFormatTypeRAW, compression: api.ExportCompressionTypeXz, expected: "my-build.raw.xz"},
+		{format: api.ExportFormatTypeVHD, compression: api.ExportCompressionTypeNone, expected: "my-build.vhd"},
+		{format: api.ExportFormatTypeOVA, compression: api.ExportCompressionTypeNone, expected: "my-build.ova"},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expected, getDownloadFilename("my-build", tc.format, tc.compression))
+	}
+}
+
 func TestCreateImageExportWithImageBuildRef(t *testing.T) {
 	require := require.New(t)
 	_, _, imageBuildStore := newTestImageExportService()
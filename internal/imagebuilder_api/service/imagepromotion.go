@@ -604,6 +604,10 @@ func exportFormatToCatalogItemArtifactType(format domain.ExportFormatType) (core
 		return coredomain.CatalogItemArtifactTypeVmdk, nil
 	case domain.ExportFormatTypeQCOW2DiskContainer:
 		return coredomain.CatalogItemArtifactTypeQcow2DiskContainer, nil
+	case domain.ExportFormatTypeRAW:
+		return coredomain.CatalogItemArtifactTypeRaw, nil
+	case domain.ExportFormatTypeVHD:
+		return coredomain.CatalogItemArtifactTypeVhd, nil
 	default:
 		return "", fmt.Errorf("unsupported export format: %s", format)
 	}
@@ -16,6 +16,7 @@ import (
 	flightctlstore "github.com/flightctl/flightctl/internal/store"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
+	"gorm.io/gorm"
 )
 
 // DummyImageBuildStore is a mock implementation of store.ImageBuildStore
@@ -446,6 +447,10 @@ func (s *DummyRepositoryStore) UpdateStatus(ctx context.Context, orgId uuid.UUID
 	return nil, nil
 }
 
+func (s *DummyRepositoryStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	return nil
+}
+
 func (s *DummyRepositoryStore) GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.FleetList, error) {
 	return &domain.FleetList{}, nil
 }
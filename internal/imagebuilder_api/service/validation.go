@@ -1,9 +1,15 @@
 package service
 
 import (
+	"fmt"
+	"net/url"
 	"regexp"
 	"strings"
+	"unicode"
 
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/samber/lo"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -211,3 +217,133 @@ func ValidatePublicKey(publicKey *string, path string) []error {
 
 	return errs
 }
+
+const (
+	// RPM package specification: a package name optionally followed by version, release and architecture
+	// (e.g. "htop", "htop-3.3.0", "htop.x86_64", "python3-pip"). Whitespace and shell metacharacters are rejected.
+	rpmPackageFmt       string = `[A-Za-z0-9_][A-Za-z0-9_.+:~^-]*`
+	rpmPackageMaxLength int    = 256
+
+	// RPM repository ID as used in .repo files, also used as the name of the generated repository file
+	rpmRepositoryNameFmt       string = `[A-Za-z0-9_][A-Za-z0-9_.:-]*`
+	rpmRepositoryNameMaxLength int    = 63
+
+	// Kernel argument: any printable characters except whitespace and quotes
+	kernelArgumentFmt       string = `[^\s"'\\]+`
+	kernelArgumentMaxLength int    = 4096
+
+	// Maximum size of the inline content of a file
+	fileContentMaxLength int = 1024 * 1024
+)
+
+var (
+	rpmPackageRegexp        = regexp.MustCompile("^" + rpmPackageFmt + "$")
+	rpmRepositoryNameRegexp = regexp.MustCompile("^" + rpmRepositoryNameFmt + "$")
+	kernelArgumentRegexp    = regexp.MustCompile("^" + kernelArgumentFmt + "$")
+)
+
+// ValidateCustomizations validates the customizations of an ImageBuild. All values end up in files of
+// the build context rather than in the Containerfile, but are still restricted to what dnf, systemctl
+// and bootc accept to catch mistakes before the build starts.
+// The existence of referenced repositories is not checked here.
+func ValidateCustomizations(customizations *domain.ImageBuildCustomizations, path string) []error {
+	if customizations == nil {
+		return nil
+	}
+
+	var errs []error
+	repoNames := make(map[string]struct{})
+	for i, repo := range lo.FromPtr(customizations.RpmRepositories) {
+		repoPath := fmt.Sprintf("%s.rpmRepositories[%d]", path, i)
+		errs = append(errs, validation.ValidateString(&repo.Name, repoPath+".name", 1, rpmRepositoryNameMaxLength, rpmRepositoryNameRegexp, rpmRepositoryNameFmt)...)
+		if _, exists := repoNames[repo.Name]; exists {
+			errs = append(errs, field.Duplicate(fieldPathFor(repoPath+".name"), repo.Name))
+		}
+		repoNames[repo.Name] = struct{}{}
+		errs = append(errs, validateRepositoryURL(&repo.BaseUrl, repoPath+".baseUrl")...)
+		if repo.GpgKey != nil {
+			errs = append(errs, validateRepositoryURL(repo.GpgKey, repoPath+".gpgKey")...)
+		}
+	}
+
+	for i, pkg := range lo.FromPtr(customizations.Packages) {
+		errs = append(errs, validation.ValidateString(&pkg, fmt.Sprintf("%s.packages[%d]", path, i), 1, rpmPackageMaxLength, rpmPackageRegexp, rpmPackageFmt)...)
+	}
+
+	filePaths := make(map[string]struct{})
+	for i, file := range lo.FromPtr(customizations.Files) {
+		filePath := fmt.Sprintf("%s.files[%d]", path, i)
+		errs = append(errs, validateFile(&file, filePath)...)
+		if _, exists := filePaths[file.Path]; exists {
+			errs = append(errs, field.Duplicate(fieldPathFor(filePath+".path"), file.Path))
+		}
+		filePaths[file.Path] = struct{}{}
+	}
+
+	if customizations.Systemd != nil {
+		for i, unit := range lo.FromPtr(customizations.Systemd.Enable) {
+			errs = append(errs, validation.ValidateSystemdName(&unit, fmt.Sprintf("%s.systemd.enable[%d]", path, i))...)
+		}
+		for i, unit := range lo.FromPtr(customizations.Systemd.Disable) {
+			errs = append(errs, validation.ValidateSystemdName(&unit, fmt.Sprintf("%s.systemd.disable[%d]", path, i))...)
+		}
+	}
+
+	for i, karg := range lo.FromPtr(customizations.KernelArguments) {
+		errs = append(errs, validation.ValidateString(&karg, fmt.Sprintf("%s.kernelArguments[%d]", path, i), 1, kernelArgumentMaxLength, kernelArgumentRegexp, kernelArgumentFmt)...)
+	}
+
+	for i, image := range lo.FromPtr(customizations.ApplicationImages) {
+		errs = append(errs, validation.ValidateOciImageReferenceStrict(&image, fmt.Sprintf("%s.applicationImages[%d]", path, i))...)
+	}
+
+	return errs
+}
+
+// validateRepositoryURL validates the URL of an RPM repository or GPG key, which is written to a .repo file
+func validateRepositoryURL(s *string, path string) []error {
+	if s == nil || *s == "" {
+		return []error{field.Required(fieldPathFor(path), "")}
+	}
+	if strings.ContainsAny(*s, " \t\r\n") {
+		return []error{field.Invalid(fieldPathFor(path), *s, "must not contain whitespace")}
+	}
+	u, err := url.Parse(*s)
+	if err != nil {
+		return []error{field.Invalid(fieldPathFor(path), *s, err.Error())}
+	}
+	if !lo.Contains([]string{"http", "https", "file"}, u.Scheme) {
+		return []error{field.Invalid(fieldPathFor(path), *s, "must be an http, https or file URL")}
+	}
+	return nil
+}
+
+// validateFile validates a file to add to the image
+func validateFile(file *domain.ImageBuildFile, path string) []error {
+	var errs []error
+	errs = append(errs, validation.ValidateFilePath(&file.Path, path+".path")...)
+	// The path is written to a line of the build context's file list
+	if strings.TrimSpace(file.Path) != file.Path || strings.IndexFunc(file.Path, unicode.IsControl) >= 0 {
+		errs = append(errs, field.Invalid(fieldPathFor(path+".path"), file.Path, "must not contain control characters or leading or trailing whitespace"))
+	}
+	errs = append(errs, validation.ValidateLinuxFileMode(file.Mode, path+".mode")...)
+
+	switch {
+	case file.Content != nil && file.HttpRef != nil:
+		errs = append(errs, field.Invalid(fieldPathFor(path), file.Path, "only one of content or httpRef may be set"))
+	case file.Content != nil:
+		if lo.FromPtr(file.ContentEncoding) == domain.EncodingBase64 {
+			errs = append(errs, validation.ValidateBase64Field(*file.Content, path+".content", fileContentMaxLength)...)
+		} else if len(*file.Content) > fileContentMaxLength {
+			errs = append(errs, field.TooLong(fieldPathFor(path+".content"), "", fileContentMaxLength))
+		}
+	case file.HttpRef != nil:
+		errs = append(errs, validation.ValidateResourceNameReference(&file.HttpRef.Repository, path+".httpRef.repository")...)
+		if file.ContentEncoding != nil {
+			errs = append(errs, field.Invalid(fieldPathFor(path+".contentEncoding"), *file.ContentEncoding, "must not be set for httpRef"))
+		}
+	default:
+		errs = append(errs, field.Required(fieldPathFor(path), "one of content or httpRef must be set"))
+	}
+	return errs
+}
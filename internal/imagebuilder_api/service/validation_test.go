@@ -4,6 +4,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/samber/lo"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -1068,3 +1070,157 @@ func TestValidatePublicKey(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateCustomizations(t *testing.T) {
+	tests := []struct {
+		name           string
+		customizations domain.ImageBuildCustomizations
+		wantErr        bool
+	}{
+		// Valid cases
+		{
+			name: "all customizations",
+			customizations: domain.ImageBuildCustomizations{
+				RpmRepositories: &[]domain.ImageBuildRpmRepository{
+					{Name: "epel", BaseUrl: "https://dl.example.com/epel/9/x86_64/", GpgKey: lo.ToPtr("https://dl.example.com/RPM-GPG-KEY-EPEL-9")},
+				},
+				Packages: &[]string{"htop", "python3-pip", "kernel-modules-extra.x86_64", "tcpdump-4.99.0"},
+				Files: &[]domain.ImageBuildFile{
+					{Path: "/etc/motd", Content: lo.ToPtr("Welcome")},
+					{Path: "/usr/local/bin/setup.sh", Mode: lo.ToPtr(0755), Content: lo.ToPtr("IyEvYmluL3NoCg=="), ContentEncoding: lo.ToPtr(domain.EncodingBase64)},
+					{Path: "/etc/chrony.conf", HttpRef: &domain.ImageBuildFileHttpRef{Repository: "configs", Suffix: lo.ToPtr("/chrony.conf")}},
+				},
+				Systemd: &domain.ImageBuildSystemd{
+					Enable:  &[]string{"chronyd.service", "getty@ttyS0.service"},
+					Disable: &[]string{"cockpit.socket"},
+				},
+				KernelArguments:   &[]string{"console=ttyS0,115200", "quiet"},
+				ApplicationImages: &[]string{"quay.io/example/app:v1", "registry.example.com/app@sha256:" + strings.Repeat("a", 64)},
+			},
+			wantErr: false,
+		},
+		{
+			name:           "empty customizations",
+			customizations: domain.ImageBuildCustomizations{},
+			wantErr:        false,
+		},
+		// Invalid cases
+		{
+			name:           "package with whitespace",
+			customizations: domain.ImageBuildCustomizations{Packages: &[]string{"htop tcpdump"}},
+			wantErr:        true,
+		},
+		{
+			name:           "package with command injection",
+			customizations: domain.ImageBuildCustomizations{Packages: &[]string{"htop;rm"}},
+			wantErr:        true,
+		},
+		{
+			name:           "package starting with option",
+			customizations: domain.ImageBuildCustomizations{Packages: &[]string{"--nogpgcheck"}},
+			wantErr:        true,
+		},
+		{
+			name: "repository name with path",
+			customizations: domain.ImageBuildCustomizations{RpmRepositories: &[]domain.ImageBuildRpmRepository{
+				{Name: "../epel", BaseUrl: "https://dl.example.com/epel/"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "duplicate repository name",
+			customizations: domain.ImageBuildCustomizations{RpmRepositories: &[]domain.ImageBuildRpmRepository{
+				{Name: "epel", BaseUrl: "https://dl.example.com/epel/"},
+				{Name: "epel", BaseUrl: "https://mirror.example.com/epel/"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "repository URL with newline",
+			customizations: domain.ImageBuildCustomizations{RpmRepositories: &[]domain.ImageBuildRpmRepository{
+				{Name: "epel", BaseUrl: "https://dl.example.com/epel/\ngpgcheck=0"},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "repository URL with unsupported scheme",
+			customizations: domain.ImageBuildCustomizations{RpmRepositories: &[]domain.ImageBuildRpmRepository{
+				{Name: "epel", BaseUrl: "ftp://dl.example.com/epel/"},
+			}},
+			wantErr: true,
+		},
+		{
+			name:           "relative file path",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{{Path: "etc/motd", Content: lo.ToPtr("")}}},
+			wantErr:        true,
+		},
+		{
+			name:           "file path with newline",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{{Path: "/etc/motd\n0755 0 /etc/shadow", Content: lo.ToPtr("")}}},
+			wantErr:        true,
+		},
+		{
+			name: "duplicate file path",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{
+				{Path: "/etc/motd", Content: lo.ToPtr("a")},
+				{Path: "/etc/motd", Content: lo.ToPtr("b")},
+			}},
+			wantErr: true,
+		},
+		{
+			name:           "invalid file mode",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{{Path: "/etc/motd", Mode: lo.ToPtr(010000), Content: lo.ToPtr("")}}},
+			wantErr:        true,
+		},
+		{
+			name:           "file without content",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{{Path: "/etc/motd"}}},
+			wantErr:        true,
+		},
+		{
+			name: "file with content and httpRef",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{
+				{Path: "/etc/motd", Content: lo.ToPtr("a"), HttpRef: &domain.ImageBuildFileHttpRef{Repository: "configs"}},
+			}},
+			wantErr: true,
+		},
+		{
+			name: "file with invalid base64 content",
+			customizations: domain.ImageBuildCustomizations{Files: &[]domain.ImageBuildFile{
+				{Path: "/etc/motd", Content: lo.ToPtr("not base64!"), ContentEncoding: lo.ToPtr(domain.EncodingBase64)},
+			}},
+			wantErr: true,
+		},
+		{
+			name:           "systemd unit with whitespace",
+			customizations: domain.ImageBuildCustomizations{Systemd: &domain.ImageBuildSystemd{Enable: &[]string{"chronyd.service sshd.service"}}},
+			wantErr:        true,
+		},
+		{
+			name:           "kernel argument with whitespace",
+			customizations: domain.ImageBuildCustomizations{KernelArguments: &[]string{"console=ttyS0 quiet"}},
+			wantErr:        true,
+		},
+		{
+			name:           "kernel argument with quote",
+			customizations: domain.ImageBuildCustomizations{KernelArguments: &[]string{`quiet"]`}},
+			wantErr:        true,
+		},
+		{
+			name:           "application image without registry",
+			customizations: domain.ImageBuildCustomizations{ApplicationImages: &[]string{"nginx:latest"}},
+			wantErr:        true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateCustomizations(&tt.customizations, "spec.customizations")
+			if tt.wantErr {
+				require.NotEmpty(t, errs, "expected validation errors")
+			} else {
+				assert.Empty(t, errs, "expected no validation errors\nGot errors: %v", errs)
+			}
+		})
+	}
+}
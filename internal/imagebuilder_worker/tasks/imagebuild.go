@@ -57,6 +57,10 @@ type ContainerfileResult struct {
 	AgentConfig []byte
 	// Publickey contains the SSH public key content (for user configuration)
 	Publickey []byte
+	// CustomizationFiles contains the files of the customizations directory, keyed by their relative path
+	CustomizationFiles map[string][]byte
+	// ApplicationImages contains the references of the application images to embed in the image
+	ApplicationImages []string
 }
 
 // shouldProcessImageBuild checks if the ImageBuild is in a state that should be processed.
@@ -251,7 +255,15 @@ func (c *Consumer) processImageBuild(ctx context.Context, eventWithOrgId worker_
 	// Update ImageBuild status with the pushed image reference and manifest digest
 	statusUpdater.UpdateImageReference(imageRef, manifestDigest)
 
-	// Step 5: Generate and distribute SBOM when enabled and a destination is configured
+	// Step 5: Sign the pushed image when a signing key is configured
+	if err := c.processSigning(buildCtx, orgID, imageBuild, manifestDigest, statusUpdater, log); err != nil {
+		if c.handleBuildError(ctx, orgID, imageBuildName, err, statusUpdater, log) {
+			return nil // Cancellation handled
+		}
+		return err
+	}
+
+	// Step 6: Generate and distribute SBOM when enabled and a destination is configured
 	if c.shouldRunSBOMPipeline() {
 		c.processSBOM(buildCtx, ctx, orgID, imageBuild, imageRef, manifestDigest, podmanWorker, statusUpdater, log)
 	}
@@ -579,6 +591,16 @@ func (c *Consumer) generateContainerfileWithGenerator(
 		result.Publickey = []byte(spec.UserConfiguration.Publickey)
 	}
 
+	// Add customizations if provided
+	customizationFiles, err := c.generateCustomizationFiles(ctx, orgID, spec.Customizations, log)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate customization files: %w", err)
+	}
+	result.CustomizationFiles = customizationFiles
+	if spec.Customizations != nil {
+		result.ApplicationImages = lo.FromPtr(spec.Customizations.ApplicationImages)
+	}
+
 	// Handle early binding - generate enrollment credentials
 	if isEarlyBinding {
 		// Generate a unique name for this build's enrollment credentials
@@ -1007,7 +1029,8 @@ func writeBuildContextFiles(tmpDir string, containerfileResult *ContainerfileRes
 		return fmt.Errorf("failed to write user-publickey.txt: %w", err)
 	}
 
-	return nil
+	// Write customizations directory (required by RUN --mount instruction, empty if no customizations)
+	return writeCustomizationFiles(tmpDir, containerfileResult.CustomizationFiles)
 }
 
 // buildImageWithPodman builds the image using podman in a container-in-container setup.
@@ -1053,7 +1076,7 @@ func (c *Consumer) buildImageWithPodman(
 		"platform": platform,
 	}).Info("Starting podman build")
 
-	// Write build context files (Containerfile, agent-config.yaml, user-publickey.txt)
+	// Write build context files (Containerfile, agent-config.yaml, user-publickey.txt, customizations)
 	if err := writeBuildContextFiles(podmanWorker.TmpDir, containerfileResult); err != nil {
 		return err
 	}
@@ -1085,6 +1108,12 @@ func (c *Consumer) buildImageWithPodman(
 		}
 	}
 
+	// Pull and save application images into the build context, after logging in to the source
+	// registry so that images hosted next to the base image can be pulled as well
+	if err := saveApplicationImages(ctx, podmanWorker, containerfileResult.ApplicationImages, platform, containerBuildDir, log); err != nil {
+		return err
+	}
+
 	containerContainerfilePath := filepath.Join(containerBuildDir, "Containerfile")
 
 	// ---------------------------------------------------------
@@ -0,0 +1,179 @@
+package tasks
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	coredomain "github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	internaltasks "github.com/flightctl/flightctl/internal/tasks"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// customizationsDir is the directory of the build context containing the customization files,
+	// bind-mounted by the Containerfile when applying the customizations
+	customizationsDir = "customizations"
+
+	// customizationImagesDir is the subdirectory of customizationsDir containing the application image archives
+	customizationImagesDir = "images"
+
+	// defaultCustomizationFileMode is the mode of files added to the image if no mode is specified
+	defaultCustomizationFileMode = 0644
+)
+
+// generateCustomizationFiles generates the files of the customizations directory of the build context,
+// keyed by their path relative to that directory. The Containerfile only reads these files, so that
+// no user provided value is interpreted by the shell.
+func (c *Consumer) generateCustomizationFiles(
+	ctx context.Context,
+	orgID uuid.UUID,
+	customizations *domain.ImageBuildCustomizations,
+	log logrus.FieldLogger,
+) (map[string][]byte, error) {
+	files := make(map[string][]byte)
+	if customizations == nil {
+		return files, nil
+	}
+
+	for _, repo := range lo.FromPtr(customizations.RpmRepositories) {
+		files[filepath.Join("yum.repos.d", repo.Name+".repo")] = rpmRepositoryFile(repo)
+	}
+
+	if packages := lo.FromPtr(customizations.Packages); len(packages) > 0 {
+		files["packages.txt"] = lines(packages)
+	}
+
+	var fileList []string
+	for i, file := range lo.FromPtr(customizations.Files) {
+		content, err := c.customizationFileContent(ctx, orgID, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get content of file %q: %w", file.Path, err)
+		}
+		name := strconv.Itoa(i)
+		files[filepath.Join("files", name)] = content
+		fileList = append(fileList, fmt.Sprintf("%04o %s %s", lo.FromPtrOr(file.Mode, defaultCustomizationFileMode), name, file.Path))
+	}
+	if len(fileList) > 0 {
+		files["files.txt"] = lines(fileList)
+	}
+
+	if customizations.Systemd != nil {
+		if units := lo.FromPtr(customizations.Systemd.Enable); len(units) > 0 {
+			files["systemd-enable.txt"] = lines(units)
+		}
+		if units := lo.FromPtr(customizations.Systemd.Disable); len(units) > 0 {
+			files["systemd-disable.txt"] = lines(units)
+		}
+	}
+
+	if kargs := lo.FromPtr(customizations.KernelArguments); len(kargs) > 0 {
+		files["kargs.toml"] = kargsFile(kargs)
+	}
+
+	log.WithField("files", len(files)).Debug("Generated customization files")
+	return files, nil
+}
+
+// customizationFileContent returns the decoded inline content of a file, or fetches it from its HTTP repository
+func (c *Consumer) customizationFileContent(ctx context.Context, orgID uuid.UUID, file domain.ImageBuildFile) ([]byte, error) {
+	if file.HttpRef == nil {
+		content := lo.FromPtr(file.Content)
+		if lo.FromPtr(file.ContentEncoding) == domain.EncodingBase64 {
+			return base64.StdEncoding.DecodeString(content)
+		}
+		return []byte(content), nil
+	}
+
+	repo, err := c.repositoryStore.Get(ctx, orgID, file.HttpRef.Repository)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository: %w", err)
+	}
+	repoType, err := repo.Spec.Discriminator()
+	if err != nil {
+		return nil, fmt.Errorf("failed to determine repository type: %w", err)
+	}
+	if repoType != string(coredomain.RepoSpecTypeHttp) {
+		return nil, fmt.Errorf("repository %q must be of type 'http', got %q", file.HttpRef.Repository, repoType)
+	}
+	repoURL, err := repo.Spec.GetRepoURL()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repository URL: %w", err)
+	}
+	return internaltasks.SendHTTPRequest(ctx, repo.Spec, repoURL+lo.FromPtr(file.HttpRef.Suffix))
+}
+
+// rpmRepositoryFile generates a dnf .repo file for an RPM repository
+func rpmRepositoryFile(repo domain.ImageBuildRpmRepository) []byte {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "[%s]\n", repo.Name)
+	fmt.Fprintf(&b, "name=%s\n", repo.Name)
+	fmt.Fprintf(&b, "baseurl=%s\n", repo.BaseUrl)
+	b.WriteString("enabled=1\n")
+	fmt.Fprintf(&b, "gpgcheck=%d\n", lo.Ternary(lo.FromPtrOr(repo.GpgCheck, true), 1, 0))
+	if repo.GpgKey != nil {
+		fmt.Fprintf(&b, "gpgkey=%s\n", *repo.GpgKey)
+	}
+	return b.Bytes()
+}
+
+// kargsFile generates a bootc kargs.d file for the given kernel arguments
+func kargsFile(kargs []string) []byte {
+	quoted := lo.Map(kargs, func(karg string, _ int) string { return fmt.Sprintf("%q", karg) })
+	return []byte(fmt.Sprintf("kargs = [%s]\n", strings.Join(quoted, ", ")))
+}
+
+func lines(values []string) []byte {
+	return []byte(strings.Join(values, "\n") + "\n")
+}
+
+// writeCustomizationFiles writes the customizations directory to the build directory.
+// The directory is always created because the Containerfile bind-mounts it unconditionally.
+func writeCustomizationFiles(tmpDir string, files map[string][]byte) error {
+	dir := filepath.Join(tmpDir, customizationsDir)
+	if err := os.MkdirAll(filepath.Join(dir, customizationImagesDir), 0755); err != nil {
+		return fmt.Errorf("failed to create customizations directory: %w", err)
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			return fmt.Errorf("failed to create directory for %s: %w", name, err)
+		}
+		if err := os.WriteFile(path, content, 0600); err != nil {
+			return fmt.Errorf("failed to write %s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// saveApplicationImages pulls the application images in the worker and saves them as archives into the
+// customizations directory, from where the Containerfile loads them into the image's read-only image store.
+// Images are pulled with the registry logins of the worker.
+func saveApplicationImages(
+	ctx context.Context,
+	podmanWorker *podmanWorker,
+	images []string,
+	platform string,
+	containerBuildDir string,
+	log logrus.FieldLogger,
+) error {
+	for i, image := range images {
+		log.WithField("image", image).Info("Embedding application image")
+		if err := podmanWorker.runInWorker(ctx, log, "pull application image", nil, "pull", "--platform", platform, image); err != nil {
+			return fmt.Errorf("failed to pull application image %q: %w", image, err)
+		}
+		archive := filepath.Join(containerBuildDir, customizationsDir, customizationImagesDir, fmt.Sprintf("%d.tar", i))
+		if err := podmanWorker.runInWorker(ctx, log, "save application image", nil, "save", "--format", "oci-archive", "-o", archive, image); err != nil {
+			return fmt.Errorf("failed to save application image %q: %w", image, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/flightctl/flightctl/internal/oci"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/flightctl/flightctl/pkg/imagesign"
+	"github.com/google/uuid"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/sirupsen/logrus"
+	"oras.land/oras-go/v2"
+)
+
+// isSigningEnabled returns whether pushed images are signed.
+func (c *Consumer) isSigningEnabled() bool {
+	return c.cfg.ImageBuilderWorker.SigningKeyFile() != ""
+}
+
+// processSigning signs the pushed image and records the result in the Signed condition.
+// Unlike SBOM failures, signing failures are fatal: an image that was meant to be signed must not be
+// reported as completed without a signature.
+func (c *Consumer) processSigning(
+	ctx context.Context,
+	orgID uuid.UUID,
+	imageBuild *domain.ImageBuild,
+	manifestDigest string,
+	statusUpdater *statusUpdater,
+	log logrus.FieldLogger,
+) error {
+	signedCondition := domain.ImageBuildCondition{
+		Type:               domain.ImageBuildConditionTypeSigned,
+		Status:             domain.ConditionStatusFalse,
+		Reason:             string(domain.ImageBuildSignedConditionReasonSigningDisabled),
+		Message:            "Image signing is not configured",
+		LastTransitionTime: time.Now().UTC(),
+	}
+	if !c.isSigningEnabled() {
+		statusUpdater.UpdateCondition(signedCondition)
+		return nil
+	}
+
+	signatureRef, err := c.signImage(ctx, orgID, imageBuild, manifestDigest, statusUpdater, log)
+	signedCondition.LastTransitionTime = time.Now().UTC()
+	if err != nil {
+		signedCondition.Reason = string(domain.ImageBuildSignedConditionReasonSigningFailed)
+		signedCondition.Message = err.Error()
+		statusUpdater.UpdateCondition(signedCondition)
+		return fmt.Errorf("failed to sign image: %w", err)
+	}
+
+	signedCondition.Status = domain.ConditionStatusTrue
+	signedCondition.Reason = string(domain.ImageBuildSignedConditionReasonSigned)
+	signedCondition.Message = fmt.Sprintf("Image signed, signature pushed to %s", signatureRef)
+	statusUpdater.UpdateCondition(signedCondition)
+	return nil
+}
+
+// signImage signs the image manifest with the given digest in the destination repository using the
+// configured signing key, and pushes the signature to the destination repository in the cosign format,
+// so that it can be verified by devices as well as by `cosign verify`. It returns the signature reference.
+func (c *Consumer) signImage(
+	ctx context.Context,
+	orgID uuid.UUID,
+	imageBuild *domain.ImageBuild,
+	manifestDigest string,
+	statusUpdater *statusUpdater,
+	log logrus.FieldLogger,
+) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+
+	keyFile := c.cfg.ImageBuilderWorker.SigningKeyFile()
+	keyPEM, err := os.ReadFile(keyFile)
+	if err != nil {
+		return "", fmt.Errorf("reading signing key: %w", err)
+	}
+	key, err := fccrypto.ParseKeyPEM(keyPEM)
+	if err != nil {
+		return "", fmt.Errorf("parsing signing key %s: %w", keyFile, err)
+	}
+
+	spec := imageBuild.Spec
+	ociSpec, err := c.getOciRepoSpec(ctx, orgID, spec.Destination.Repository, "destination")
+	if err != nil {
+		return "", fmt.Errorf("getting destination OCI spec: %w", err)
+	}
+	destRef := fmt.Sprintf("%s/%s", ociSpec.Registry, spec.Destination.ImageName)
+
+	payload, err := imagesign.NewPayload(destRef, manifestDigest)
+	if err != nil {
+		return "", err
+	}
+	signature, err := imagesign.Sign(key, payload)
+	if err != nil {
+		return "", err
+	}
+	tag, err := imagesign.SignatureTag(manifestDigest)
+	if err != nil {
+		return "", err
+	}
+	signatureRef := fmt.Sprintf("%s:%s", destRef, tag)
+
+	log.WithFields(logrus.Fields{
+		"destination":    destRef,
+		"manifestDigest": manifestDigest,
+		"signature":      signatureRef,
+	}).Info("Pushing image signature")
+	statusUpdater.ReportOutput([]byte(fmt.Sprintf("Pushing image signature to %s\n", signatureRef)))
+
+	repoRef, err := oci.BuildOciRepoRef(ctx, ociSpec, destRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to configure OCI repository reference: %w", err)
+	}
+	// Skip referrers GC to avoid authentication issues when pushing multiple artifacts
+	repoRef.SkipReferrersGC = true
+
+	payloadDesc, err := oras.PushBytes(ctx, repoRef, imagesign.PayloadMediaType, payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to push signature payload: %w", err)
+	}
+	payloadDesc.Annotations = map[string]string{imagesign.SignatureAnnotation: signature}
+
+	// cosign stores signatures as regular images, whose config lists the payload as only layer
+	config, err := json.Marshal(ocispec.Image{
+		RootFS: ocispec.RootFS{Type: "layers", DiffIDs: []digest.Digest{payloadDesc.Digest}},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal signature config: %w", err)
+	}
+	configDesc, err := oras.PushBytes(ctx, repoRef, ocispec.MediaTypeImageConfig, config)
+	if err != nil {
+		return "", fmt.Errorf("failed to push signature config: %w", err)
+	}
+
+	packOpts := oras.PackManifestOptions{
+		Layers:           []ocispec.Descriptor{payloadDesc},
+		ConfigDescriptor: &configDesc,
+	}
+	manifestDesc, err := oras.PackManifest(ctx, repoRef, oras.PackManifestVersion1_1, "", packOpts)
+	if err != nil {
+		return "", fmt.Errorf("failed to pack signature manifest: %w", err)
+	}
+	if err := repoRef.Tag(ctx, manifestDesc, tag); err != nil {
+		return "", fmt.Errorf("failed to tag signature manifest: %w", err)
+	}
+
+	statusUpdater.ReportOutput([]byte(fmt.Sprintf("Successfully pushed image signature: %s\n", manifestDesc.Digest.String())))
+	return signatureRef, nil
+}
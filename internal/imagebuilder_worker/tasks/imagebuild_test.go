@@ -4,6 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/flightctl/flightctl/api/core/v1beta1"
@@ -15,6 +19,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 // mockRepositoryStore is a mock implementation of repositorystore.Store for testing
@@ -55,6 +60,9 @@ func (m *mockRepositoryStore) Delete(context.Context, uuid.UUID, string, store.E
 func (m *mockRepositoryStore) UpdateStatus(context.Context, uuid.UUID, *v1beta1.Repository, store.EventCallback) (*v1beta1.Repository, error) {
 	return nil, nil
 }
+func (m *mockRepositoryStore) UnsetOwner(context.Context, *gorm.DB, uuid.UUID, string) error {
+	return nil
+}
 func (m *mockRepositoryStore) GetFleetRefs(context.Context, uuid.UUID, string) (*v1beta1.FleetList, error) {
 	return nil, nil
 }
@@ -381,6 +389,82 @@ func TestGenerateContainerfile_WithoutUserConfiguration(t *testing.T) {
 	require.Nil(t, result.Publickey)
 }
 
+func TestGenerateContainerfile_WithCustomizations(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "/configs/chrony.conf", r.URL.Path)
+		_, _ = w.Write([]byte("server ntp.example.com iburst\n"))
+	}))
+	defer server.Close()
+
+	httpSpec := v1beta1.RepositorySpec{}
+	require.NoError(t, httpSpec.FromHttpRepoSpec(v1beta1.HttpRepoSpec{Url: server.URL, Type: v1beta1.HttpRepoSpecTypeHttp}))
+
+	repoStore := newMockRepositoryStore()
+	repoStore.repositories["test-repo"] = createTestRepository("test-repo", "quay.io", nil)
+	repoStore.repositories["configs"] = &v1beta1.Repository{Metadata: v1beta1.ObjectMeta{Name: lo.ToPtr("configs")}, Spec: httpSpec}
+
+	imageBuild := newTestImageBuild("test-build", "late")
+	imageBuild.Spec.Customizations = &api.ImageBuildCustomizations{
+		RpmRepositories: &[]api.ImageBuildRpmRepository{
+			{Name: "epel", BaseUrl: "https://dl.example.com/epel/9/x86_64/", GpgCheck: lo.ToPtr(false)},
+		},
+		Packages: &[]string{"htop", "tcpdump"},
+		Files: &[]api.ImageBuildFile{
+			{Path: "/etc/motd", Content: lo.ToPtr("Welcome")},
+			{Path: "/usr/local/bin/setup.sh", Mode: lo.ToPtr(0755), Content: lo.ToPtr(base64.StdEncoding.EncodeToString([]byte("#!/bin/sh\n"))), ContentEncoding: lo.ToPtr(v1beta1.EncodingBase64)},
+			{Path: "/etc/chrony.conf", HttpRef: &api.ImageBuildFileHttpRef{Repository: "configs", Suffix: lo.ToPtr("/configs/chrony.conf")}},
+		},
+		Systemd: &api.ImageBuildSystemd{
+			Enable:  &[]string{"chronyd.service"},
+			Disable: &[]string{"cockpit.socket"},
+		},
+		KernelArguments:   &[]string{"console=ttyS0,115200", "quiet"},
+		ApplicationImages: &[]string{"quay.io/example/app:v1"},
+	}
+
+	result, err := GenerateContainerfile(context.Background(), repoStore, newMockServiceHandler(), uuid.New(), imageBuild, log.InitLogs())
+	require.NoError(t, err)
+
+	require.Equal(t, map[string][]byte{
+		"yum.repos.d/epel.repo": []byte("[epel]\nname=epel\nbaseurl=https://dl.example.com/epel/9/x86_64/\nenabled=1\ngpgcheck=0\n"),
+		"packages.txt":          []byte("htop\ntcpdump\n"),
+		"files/0":               []byte("Welcome"),
+		"files/1":               []byte("#!/bin/sh\n"),
+		"files/2":               []byte("server ntp.example.com iburst\n"),
+		"files.txt":             []byte("0644 0 /etc/motd\n0755 1 /usr/local/bin/setup.sh\n0644 2 /etc/chrony.conf\n"),
+		"systemd-enable.txt":    []byte("chronyd.service\n"),
+		"systemd-disable.txt":   []byte("cockpit.socket\n"),
+		"kargs.toml":            []byte("kargs = [\"console=ttyS0,115200\", \"quiet\"]\n"),
+	}, result.CustomizationFiles)
+	require.Equal(t, []string{"quay.io/example/app:v1"}, result.ApplicationImages)
+	require.Contains(t, result.Containerfile, "--mount=type=bind,source=customizations,target=/tmp/customizations")
+
+	// The customizations directory is written to the build context
+	tmpDir := t.TempDir()
+	require.NoError(t, writeBuildContextFiles(tmpDir, result))
+	content, err := os.ReadFile(filepath.Join(tmpDir, "customizations", "files.txt"))
+	require.NoError(t, err)
+	require.Equal(t, result.CustomizationFiles["files.txt"], content)
+	require.DirExists(t, filepath.Join(tmpDir, "customizations", "images"))
+}
+
+func TestGenerateContainerfile_WithoutCustomizations(t *testing.T) {
+	repoStore := newMockRepositoryStore()
+	repoStore.repositories["test-repo"] = createTestRepository("test-repo", "quay.io", nil)
+
+	imageBuild := newTestImageBuild("test-build", "late")
+
+	result, err := GenerateContainerfile(context.Background(), repoStore, newMockServiceHandler(), uuid.New(), imageBuild, log.InitLogs())
+	require.NoError(t, err)
+	require.Empty(t, result.CustomizationFiles)
+	require.Empty(t, result.ApplicationImages)
+
+	// The customizations directory must exist even if empty, as the Containerfile mounts it unconditionally
+	tmpDir := t.TempDir()
+	require.NoError(t, writeBuildContextFiles(tmpDir, result))
+	require.DirExists(t, filepath.Join(tmpDir, "customizations"))
+}
+
 func TestInstallCACertInWorker_NilCaCrt(t *testing.T) {
 	err := installCACertInWorker(context.Background(), nil, "fake-container", "registry.example.com", log.InitLogs())
 	require.NoError(t, err)
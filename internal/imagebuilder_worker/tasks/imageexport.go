@@ -209,7 +209,7 @@ func (c *Consumer) processImageExport(ctx context.Context, eventWithOrgId worker
 	}
 
 	// Execute the export
-	outputFilePath, cleanup, err := c.executeExport(exportCtx, orgID, imageExport, source, statusUpdater, log)
+	artifact, cleanup, err := c.executeExport(exportCtx, orgID, imageExport, source, statusUpdater, log)
 	if cleanup != nil {
 		defer cleanup()
 	}
@@ -220,10 +220,10 @@ func (c *Consumer) processImageExport(ctx context.Context, eventWithOrgId worker
 		return fmt.Errorf("failed to execute export: %w", err)
 	}
 
-	log.WithField("outputFile", outputFilePath).Info("Export output file created")
+	log.WithField("outputFile", artifact.Path).Info("Export output file created")
 
 	// Push artifact to destination (as a referrer to the source image)
-	if err := c.pushArtifact(exportCtx, orgID, imageExport, source, outputFilePath, statusUpdater, log); err != nil {
+	if err := c.pushArtifact(exportCtx, orgID, imageExport, source, artifact, statusUpdater, log); err != nil {
 		if c.handleExportError(ctx, orgID, imageExportName, fmt.Errorf("failed to push artifact: %w", err), statusUpdater, log) {
 			return nil // Cancellation handled
 		}
@@ -361,7 +361,7 @@ func (w *imageExportStatusWriter) Write(p []byte) (n int, err error) {
 }
 
 // executeExport executes the actual export using bootc-image-builder
-// Returns the path to the output file, a cleanup function to delete the temp output dir, and an error
+// Returns the output artifact, a cleanup function to delete the temp output dir, and an error
 func (c *Consumer) executeExport(
 	ctx context.Context,
 	orgID uuid.UUID,
@@ -369,7 +369,7 @@ func (c *Consumer) executeExport(
 	exportSource *exportSource,
 	statusUpdater *imageExportStatusUpdater,
 	log logrus.FieldLogger,
-) (string, func(), error) {
+) (*exportArtifact, func(), error) {
 	// Build image reference string for logging and podman operations
 	registryHostname := exportSource.OciRepoSpec.Registry
 	bootcImageRef := fmt.Sprintf("%s/%s:%s", registryHostname, exportSource.ImageName, exportSource.ImageTag)
@@ -379,7 +379,7 @@ func (c *Consumer) executeExport(
 	// Step 2: Start bootc-image-builder container
 	worker, err := c.startBootcImageBuilderContainer(ctx, orgID, imageExport, statusUpdater, log)
 	if err != nil {
-		return "", nil, fmt.Errorf("failed to start bootc-image-builder container: %w", err)
+		return nil, nil, fmt.Errorf("failed to start bootc-image-builder container: %w", err)
 	}
 	defer worker.Cleanup()
 	// Create cleanup function to delete the temporary output directory
@@ -394,12 +394,12 @@ func (c *Consumer) executeExport(
 	// Podman needs to initialize its storage database files, not just the directory structure
 	// This must happen before any podman commands (like podman pull) are executed
 	if err := c.initializePodmanStorage(ctx, worker, log); err != nil {
-		return "", cleanup, fmt.Errorf("failed to initialize podman storage: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to initialize podman storage: %w", err)
 	}
 
 	// Step 2.6: Install CA certificate for source registry if configured
 	if err := installCACertInWorker(ctx, exportSource.OciRepoSpec.CaCrt, worker.ContainerName, registryHostname, log); err != nil {
-		return "", cleanup, fmt.Errorf("failed to install CA cert for source registry: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to install CA cert for source registry: %w", err)
 	}
 
 	// Step 3: Login to registry if credentials are provided
@@ -408,22 +408,22 @@ func (c *Consumer) executeExport(
 		if err == nil && dockerAuth.Username != "" && dockerAuth.Password != "" {
 			decryptedPassword, _, decErr := encryption.Decrypt(ctx, encryption.Ciphertext(dockerAuth.Password))
 			if decErr != nil {
-				return "", cleanup, fmt.Errorf("failed to decrypt OCI password: %w", decErr)
+				return nil, cleanup, fmt.Errorf("failed to decrypt OCI password: %w", decErr)
 			}
 			if err := c.loginToRegistryForExport(ctx, worker, registryHostname, dockerAuth.Username, string(decryptedPassword), exportSource.OciRepoSpec, log); err != nil {
-				return "", cleanup, fmt.Errorf("failed to login to registry: %w", err)
+				return nil, cleanup, fmt.Errorf("failed to login to registry: %w", err)
 			}
 		}
 	}
 
 	// Step 4: Pull the source image
 	if err := c.pullSourceImage(ctx, worker, bootcImageRef, exportSource.OciRepoSpec, log); err != nil {
-		return "", cleanup, fmt.Errorf("failed to pull source image: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to pull source image: %w", err)
 	}
 
 	// Step 5: Run bootc-image-builder conversion
 	if err := c.runBootcImageBuilder(ctx, worker, imageExport.Spec.Format, bootcImageRef, log); err != nil {
-		return "", cleanup, fmt.Errorf("failed to run bootc-image-builder: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to run bootc-image-builder: %w", err)
 	}
 
 	// List output directory contents recursively after bootc conversion
@@ -442,21 +442,41 @@ func (c *Consumer) executeExport(
 	// Step 6: Find the output file
 	outputFilePath, err := c.findOutputFile(worker.TmpOutDir, imageExport.Spec.Format, log)
 	if err != nil {
-		return "", cleanup, fmt.Errorf("failed to find output file: %w", err)
+		return nil, cleanup, fmt.Errorf("failed to find output file: %w", err)
 	}
 
-	// Step 7: For qcow2-disk-container, build the container disk image and save to OCI directory
-	if imageExport.Spec.Format == domain.ExportFormatTypeQCOW2DiskContainer {
+	artifact := &exportArtifact{Path: outputFilePath}
+
+	// Step 7: Post-process the bootc-image-builder output into the requested format
+	switch imageExport.Spec.Format {
+	case domain.ExportFormatTypeQCOW2DiskContainer:
+		// Build the container disk image and save to OCI directory
 		ociDirPath, err := c.buildContainerDiskImage(ctx, worker, outputFilePath, log)
 		if err != nil {
-			return "", cleanup, fmt.Errorf("failed to build container disk image: %w", err)
+			return nil, cleanup, fmt.Errorf("failed to build container disk image: %w", err)
 		}
-		outputFilePath = ociDirPath
+		artifact.Path = ociDirPath
 		log.WithField("ociDir", ociDirPath).Info("Container disk image built and saved to OCI directory")
+	case domain.ExportFormatTypeOVA:
+		// Package the vmdk disk with an OVF descriptor
+		ovaPath := filepath.Join(worker.TmpOutDir, "ova", "disk.ova")
+		statusUpdater.reportOutput([]byte("Packaging VMDK disk as OVA\n"))
+		if err := packageOVA(outputFilePath, ovaPath, lo.FromPtr(imageExport.Metadata.Name)); err != nil {
+			return nil, cleanup, fmt.Errorf("failed to package OVA: %w", err)
+		}
+		artifact.Path = ovaPath
+		log.WithField("ova", ovaPath).Info("VMDK disk packaged as OVA")
+	case domain.ExportFormatTypeRAW:
+		compression := lo.FromPtrOr(imageExport.Spec.Compression, domain.ExportCompressionTypeNone)
+		if compression != domain.ExportCompressionTypeNone {
+			if err := c.compressDiskImage(ctx, worker, artifact, compression, log); err != nil {
+				return nil, cleanup, fmt.Errorf("failed to compress disk image: %w", err)
+			}
+		}
 	}
 
-	log.WithField("outputFile", outputFilePath).Info("Export completed successfully")
-	return outputFilePath, cleanup, nil
+	log.WithField("outputFile", artifact.Path).Info("Export completed successfully")
+	return artifact, cleanup, nil
 }
 
 // startBootcImageBuilderContainer starts the bootc-image-builder container directly with sleep infinity
@@ -732,12 +752,9 @@ func (c *Consumer) runBootcImageBuilder(
 	bootcImageRef string,
 	log logrus.FieldLogger,
 ) error {
-	// Map qcow2-disk-container to qcow2 for bootc-image-builder
-	// The container wrapping happens later in executeExport
-	bootcFormat := format
-	if format == domain.ExportFormatTypeQCOW2DiskContainer {
-		bootcFormat = domain.ExportFormatTypeQCOW2
-	}
+	// Map qcow2-disk-container to qcow2 and ova to vmdk for bootc-image-builder
+	// The container wrapping and OVA packaging happen later in executeExport
+	bootcFormat := bootcImageBuilderType(format)
 
 	log.WithFields(logrus.Fields{
 		"format":      format,
@@ -753,7 +770,7 @@ func (c *Consumer) runBootcImageBuilder(
 		"-w", "/output",
 		worker.ContainerName,
 		"bootc-image-builder",
-		"--type", string(bootcFormat),
+		"--type", bootcFormat,
 		"--rootfs", "xfs",
 		bootcImageRef,
 	}
@@ -785,21 +802,9 @@ func (c *Consumer) runBootcImageBuilder(
 // bootc-image-builder creates files at {type}/disk.{type} relative to the working directory
 // Since we run with -w /output, files are at /output/{type}/disk.{type} in container
 // which maps to {outputDir}/{type}/disk.{type} on the host
-// Exception: ISO format uses bootiso/install.iso instead of iso/disk.iso
-// Exception: qcow2-disk-container uses qcow2/disk.qcow2 (same as qcow2)
+// See bootcImageBuilderOutputPath for the exceptions (iso, raw, vhd) and the formats built from another type
 func (c *Consumer) findOutputFile(outputDir string, format domain.ExportFormatType, log logrus.FieldLogger) (string, error) {
-	var outputFilePath string
-	switch format {
-	case domain.ExportFormatTypeISO:
-		// ISO format uses bootiso/install.iso instead of iso/disk.iso
-		outputFilePath = filepath.Join(outputDir, "bootiso", "install.iso")
-	case domain.ExportFormatTypeQCOW2DiskContainer:
-		// qcow2-disk-container uses qcow2 output from bootc-image-builder
-		outputFilePath = filepath.Join(outputDir, "qcow2", "disk.qcow2")
-	default:
-		// Other formats (vmdk, qcow2) use {format}/disk.{format}
-		outputFilePath = filepath.Join(outputDir, string(format), "disk."+string(format))
-	}
+	outputFilePath := filepath.Join(outputDir, bootcImageBuilderOutputPath(format))
 
 	// Verify the file exists
 	if _, err := os.Stat(outputFilePath); err != nil {
@@ -1065,10 +1070,12 @@ func (c *Consumer) pushArtifact(
 	orgID uuid.UUID,
 	imageExport *domain.ImageExport,
 	exportSource *exportSource,
-	artifactPath string,
+	artifact *exportArtifact,
 	statusUpdater *imageExportStatusUpdater,
 	log logrus.FieldLogger,
 ) error {
+	artifactPath := artifact.Path
+
 	// Get the ImageBuild to use its destination
 	sourceType, err := imageExport.Spec.Source.Discriminator()
 	if err != nil {
@@ -1230,8 +1237,18 @@ func (c *Consumer) pushArtifact(
 	// Get the artifact blob digest (for logging only)
 	artifactBlobDigest := blobDesc.Digest.String()
 
-	// Set the referrer manifest digest in the status (this is what oras discover shows)
-	statusUpdater.setManifestDigest(referrerManifestDigest)
+	// Set the referrer manifest digest (this is what oras discover shows) and the artifact checksum in the status
+	artifactStatus := domain.ImageExportArtifact{
+		Filename: filepath.Base(artifactPath),
+		Size:     fileSize,
+		Checksum: artifactBlobDigest,
+	}
+	if artifact.Compression != "" {
+		artifactStatus.Compression = lo.ToPtr(artifact.Compression)
+		artifactStatus.UncompressedSize = lo.ToPtr(artifact.UncompressedSize)
+		artifactStatus.UncompressedChecksum = lo.ToPtr(artifact.UncompressedDigest.String())
+	}
+	statusUpdater.setArtifact(referrerManifestDigest, artifactStatus)
 
 	log.WithFields(logrus.Fields{
 		"destination":    destRef,
@@ -0,0 +1,323 @@
+package tasks
+
+import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/opencontainers/go-digest"
+	"github.com/sirupsen/logrus"
+)
+
+// exportArtifact describes the file produced by an export, before it is pushed to the destination registry
+type exportArtifact struct {
+	// Path is the host path of the file, or of the OCI directory for qcow2-disk-container
+	Path        string
+	Compression domain.ExportCompressionType
+	// UncompressedSize and UncompressedDigest describe the disk image before compression.
+	// They are only set if the artifact is compressed.
+	UncompressedSize   int64
+	UncompressedDigest digest.Digest
+}
+
+// bootcImageBuilderType returns the bootc-image-builder --type for an export format.
+// qcow2-disk-container wraps the qcow2 output and ova packages the vmdk output after the build.
+func bootcImageBuilderType(format domain.ExportFormatType) string {
+	switch format {
+	case domain.ExportFormatTypeQCOW2DiskContainer:
+		return string(domain.ExportFormatTypeQCOW2)
+	case domain.ExportFormatTypeOVA:
+		return string(domain.ExportFormatTypeVMDK)
+	default:
+		return string(format)
+	}
+}
+
+// bootcImageBuilderOutputPath returns the path of the file created by bootc-image-builder for an export format,
+// relative to its working directory. Most types are written to {type}/disk.{type}, with the exceptions below.
+func bootcImageBuilderOutputPath(format domain.ExportFormatType) string {
+	switch format {
+	case domain.ExportFormatTypeISO:
+		return filepath.Join("bootiso", "install.iso")
+	case domain.ExportFormatTypeRAW:
+		return filepath.Join("image", "disk.raw")
+	case domain.ExportFormatTypeVHD:
+		return filepath.Join("vpc", "disk.vhd")
+	default:
+		bibType := bootcImageBuilderType(format)
+		return filepath.Join(bibType, "disk."+bibType)
+	}
+}
+
+// compressionSuffix returns the file name suffix of a compressed export artifact
+func compressionSuffix(compression domain.ExportCompressionType) string {
+	switch compression {
+	case domain.ExportCompressionTypeZstd:
+		return ".zst"
+	case domain.ExportCompressionTypeXz:
+		return ".xz"
+	default:
+		return ""
+	}
+}
+
+// fileDigest returns the SHA-256 digest and the size of a file
+func fileDigest(filePath string) (digest.Digest, int64, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", 0, err
+	}
+	defer f.Close()
+
+	digester := digest.Canonical.Digester()
+	size, err := io.Copy(digester.Hash(), f)
+	if err != nil {
+		return "", 0, err
+	}
+	return digester.Digest(), size, nil
+}
+
+// compressDiskImage compresses the artifact's disk image inside the bootc-image-builder container, which ships
+// the xz and zstd tools, replacing the uncompressed file. The artifact is updated to describe the compressed file.
+func (c *Consumer) compressDiskImage(
+	ctx context.Context,
+	worker *privilegedPodmanWorker,
+	artifact *exportArtifact,
+	compression domain.ExportCompressionType,
+	log logrus.FieldLogger,
+) error {
+	relPath, err := filepath.Rel(worker.TmpOutDir, artifact.Path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve output file path: %w", err)
+	}
+	containerPath := path.Join("/output", filepath.ToSlash(relPath))
+
+	// Record the checksum of the disk image itself, to verify it after decompression when flashing
+	uncompressedDigest, uncompressedSize, err := fileDigest(artifact.Path)
+	if err != nil {
+		return fmt.Errorf("failed to compute digest of disk image: %w", err)
+	}
+
+	var compressArgs []string
+	switch compression {
+	case domain.ExportCompressionTypeZstd:
+		compressArgs = []string{"zstd", "-T0", "-q", "--rm", containerPath}
+	case domain.ExportCompressionTypeXz:
+		compressArgs = []string{"xz", "-T0", "-z", containerPath}
+	default:
+		return fmt.Errorf("unsupported compression %q", compression)
+	}
+
+	log.WithFields(logrus.Fields{
+		"file":        containerPath,
+		"compression": compression,
+	}).Info("Compressing disk image")
+	worker.statusUpdater.reportOutput([]byte(fmt.Sprintf("Compressing disk image with %s (%d bytes)\n", compression, uncompressedSize)))
+
+	cmd := exec.CommandContext(ctx, "podman", append([]string{"exec", worker.ContainerName}, compressArgs...)...)
+	var outputBuffer bytes.Buffer
+	cmd.Stdout = &imageExportStatusWriter{buf: &outputBuffer, statusUpdater: worker.statusUpdater}
+	cmd.Stderr = cmd.Stdout
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s failed: %w. Output: %s", compression, err, outputBuffer.String())
+	}
+
+	compressedPath := artifact.Path + compressionSuffix(compression)
+	if _, err := os.Stat(compressedPath); err != nil {
+		return fmt.Errorf("compressed file not found at expected path %q: %w", compressedPath, err)
+	}
+
+	artifact.Path = compressedPath
+	artifact.Compression = compression
+	artifact.UncompressedSize = uncompressedSize
+	artifact.UncompressedDigest = uncompressedDigest
+	log.WithField("outputFile", compressedPath).Info("Disk image compressed")
+	return nil
+}
+
+// vmdkCapacity returns the virtual capacity in bytes of a sparse (e.g. streamOptimized) VMDK disk,
+// read from its header
+func vmdkCapacity(vmdkPath string) (int64, error) {
+	f, err := os.Open(vmdkPath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	// The sparse extent header starts with the magic number "KDMV", followed by the version,
+	// flags and the capacity in 512 byte sectors, all little-endian
+	header := make([]byte, 20)
+	if _, err := io.ReadFull(f, header); err != nil {
+		return 0, fmt.Errorf("failed to read VMDK header: %w", err)
+	}
+	if string(header[0:4]) != "KDMV" {
+		return 0, fmt.Errorf("%s is not a sparse VMDK disk", filepath.Base(vmdkPath))
+	}
+	sectors := binary.LittleEndian.Uint64(header[12:20])
+	return int64(sectors) * 512, nil //nolint:gosec // G115: capacity is far below the int64 range
+}
+
+// ovfTemplate is the OVF descriptor of a virtual machine with a single disk, 2 vCPUs and 4 GiB of memory
+var ovfTemplate = template.Must(template.New("ovf").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<Envelope xmlns="http://schemas.dmtf.org/ovf/envelope/1" xmlns:ovf="http://schemas.dmtf.org/ovf/envelope/1" xmlns:rasd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_ResourceAllocationSettingData" xmlns:vssd="http://schemas.dmtf.org/wbem/wscim/1/cim-schema/2/CIM_VirtualSystemSettingData" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">
+  <References>
+    <File ovf:href="{{.DiskFile}}" ovf:id="file1" ovf:size="{{.DiskSize}}"/>
+  </References>
+  <DiskSection>
+    <Info>Virtual disk information</Info>
+    <Disk ovf:capacity="{{.Capacity}}" ovf:capacityAllocationUnits="byte" ovf:diskId="vmdisk1" ovf:fileRef="file1" ovf:format="http://www.vmware.com/interfaces/specifications/vmdk.html#streamOptimized"/>
+  </DiskSection>
+  <NetworkSection>
+    <Info>The list of logical networks</Info>
+    <Network ovf:name="VM Network">
+      <Description>The VM Network network</Description>
+    </Network>
+  </NetworkSection>
+  <VirtualSystem ovf:id="{{.Name}}">
+    <Info>A virtual machine</Info>
+    <Name>{{.Name}}</Name>
+    <OperatingSystemSection ovf:id="80">
+      <Info>The kind of installed guest operating system</Info>
+    </OperatingSystemSection>
+    <VirtualHardwareSection>
+      <Info>Virtual hardware requirements</Info>
+      <System>
+        <vssd:ElementName>Virtual Hardware Family</vssd:ElementName>
+        <vssd:InstanceID>0</vssd:InstanceID>
+        <vssd:VirtualSystemIdentifier>{{.Name}}</vssd:VirtualSystemIdentifier>
+        <vssd:VirtualSystemType>vmx-13</vssd:VirtualSystemType>
+      </System>
+      <Item>
+        <rasd:AllocationUnits>hertz * 10^6</rasd:AllocationUnits>
+        <rasd:Description>Number of Virtual CPUs</rasd:Description>
+        <rasd:ElementName>2 virtual CPU(s)</rasd:ElementName>
+        <rasd:InstanceID>1</rasd:InstanceID>
+        <rasd:ResourceType>3</rasd:ResourceType>
+        <rasd:VirtualQuantity>2</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:AllocationUnits>byte * 2^20</rasd:AllocationUnits>
+        <rasd:Description>Memory Size</rasd:Description>
+        <rasd:ElementName>4096MB of memory</rasd:ElementName>
+        <rasd:InstanceID>2</rasd:InstanceID>
+        <rasd:ResourceType>4</rasd:ResourceType>
+        <rasd:VirtualQuantity>4096</rasd:VirtualQuantity>
+      </Item>
+      <Item>
+        <rasd:Address>0</rasd:Address>
+        <rasd:Description>SCSI Controller</rasd:Description>
+        <rasd:ElementName>SCSI Controller 0</rasd:ElementName>
+        <rasd:InstanceID>3</rasd:InstanceID>
+        <rasd:ResourceSubType>VirtualSCSI</rasd:ResourceSubType>
+        <rasd:ResourceType>6</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AddressOnParent>0</rasd:AddressOnParent>
+        <rasd:ElementName>Hard Disk 1</rasd:ElementName>
+        <rasd:HostResource>ovf:/disk/vmdisk1</rasd:HostResource>
+        <rasd:InstanceID>4</rasd:InstanceID>
+        <rasd:Parent>3</rasd:Parent>
+        <rasd:ResourceType>17</rasd:ResourceType>
+      </Item>
+      <Item>
+        <rasd:AddressOnParent>7</rasd:AddressOnParent>
+        <rasd:AutomaticAllocation>true</rasd:AutomaticAllocation>
+        <rasd:Connection>VM Network</rasd:Connection>
+        <rasd:Description>VmxNet3 ethernet adapter on &quot;VM Network&quot;</rasd:Description>
+        <rasd:ElementName>Network adapter 1</rasd:ElementName>
+        <rasd:InstanceID>5</rasd:InstanceID>
+        <rasd:ResourceSubType>VmxNet3</rasd:ResourceSubType>
+        <rasd:ResourceType>10</rasd:ResourceType>
+      </Item>
+    </VirtualHardwareSection>
+  </VirtualSystem>
+</Envelope>
+`))
+
+// packageOVA packages a streamOptimized VMDK disk into an OVA archive at ovaPath: a tar file containing
+// an OVF descriptor for a virtual machine named name, a manifest with the SHA-256 checksums of the
+// descriptor and the disk, and the disk itself, in the order required by the OVF specification.
+func packageOVA(vmdkPath string, ovaPath string, name string) error {
+	capacity, err := vmdkCapacity(vmdkPath)
+	if err != nil {
+		return err
+	}
+	diskDigest, diskSize, err := fileDigest(vmdkPath)
+	if err != nil {
+		return fmt.Errorf("failed to compute digest of VMDK disk: %w", err)
+	}
+
+	var escapedName strings.Builder
+	if err := xml.EscapeText(&escapedName, []byte(name)); err != nil {
+		return fmt.Errorf("failed to escape virtual machine name: %w", err)
+	}
+	ovfFile := name + ".ovf"
+	diskFile := name + "-disk1.vmdk"
+	var ovf bytes.Buffer
+	if err := ovfTemplate.Execute(&ovf, map[string]any{
+		"Name":     escapedName.String(),
+		"DiskFile": diskFile,
+		"DiskSize": diskSize,
+		"Capacity": capacity,
+	}); err != nil {
+		return fmt.Errorf("failed to render OVF descriptor: %w", err)
+	}
+	manifest := fmt.Sprintf("SHA256(%s)= %s\nSHA256(%s)= %s\n",
+		ovfFile, digest.FromBytes(ovf.Bytes()).Encoded(),
+		diskFile, diskDigest.Encoded())
+
+	if err := os.MkdirAll(filepath.Dir(ovaPath), 0755); err != nil {
+		return fmt.Errorf("failed to create OVA directory: %w", err)
+	}
+	ova, err := os.Create(ovaPath)
+	if err != nil {
+		return fmt.Errorf("failed to create OVA file: %w", err)
+	}
+	defer ova.Close()
+
+	modTime := time.Now().UTC()
+	tw := tar.NewWriter(ova)
+	for _, entry := range []struct {
+		name    string
+		content []byte
+	}{
+		{name: ovfFile, content: ovf.Bytes()},
+		{name: name + ".mf", content: []byte(manifest)},
+	} {
+		if err := tw.WriteHeader(&tar.Header{Name: entry.name, Mode: 0644, Size: int64(len(entry.content)), ModTime: modTime}); err != nil {
+			return fmt.Errorf("failed to write %s to OVA: %w", entry.name, err)
+		}
+		if _, err := tw.Write(entry.content); err != nil {
+			return fmt.Errorf("failed to write %s to OVA: %w", entry.name, err)
+		}
+	}
+
+	disk, err := os.Open(vmdkPath)
+	if err != nil {
+		return fmt.Errorf("failed to open VMDK disk: %w", err)
+	}
+	defer disk.Close()
+	if err := tw.WriteHeader(&tar.Header{Name: diskFile, Mode: 0644, Size: diskSize, ModTime: modTime}); err != nil {
+		return fmt.Errorf("failed to write %s to OVA: %w", diskFile, err)
+	}
+	if _, err := io.Copy(tw, disk); err != nil {
+		return fmt.Errorf("failed to write %s to OVA: %w", diskFile, err)
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("failed to finalize OVA: %w", err)
+	}
+	return ova.Close()
+}
@@ -0,0 +1,130 @@
+package tasks
+
+import (
+	"archive/tar"
+	"encoding/binary"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/imagebuilder_api/domain"
+	"github.com/opencontainers/go-digest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBootcImageBuilderFormat(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		format       domain.ExportFormatType
+		expectedType string
+		expectedPath string
+	}{
+		{format: domain.ExportFormatTypeQCOW2, expectedType: "qcow2", expectedPath: "qcow2/disk.qcow2"},
+		{format: domain.ExportFormatTypeVMDK, expectedType: "vmdk", expectedPath: "vmdk/disk.vmdk"},
+		{format: domain.ExportFormatTypeISO, expectedType: "iso", expectedPath: "bootiso/install.iso"},
+		{format: domain.ExportFormatTypeQCOW2DiskContainer, expectedType: "qcow2", expectedPath: "qcow2/disk.qcow2"},
+		{format: domain.ExportFormatTypeRAW, expectedType: "raw", expectedPath: "image/disk.raw"},
+		{format: domain.ExportFormatTypeVHD, expectedType: "vhd", expectedPath: "vpc/disk.vhd"},
+		{format: domain.ExportFormatTypeOVA, expectedType: "vmdk", expectedPath: "vmdk/disk.vmdk"},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.format), func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tt.expectedType, bootcImageBuilderType(tt.format))
+			require.Equal(t, tt.expectedPath, bootcImageBuilderOutputPath(tt.format))
+		})
+	}
+}
+
+// writeSparseVMDK writes a file starting with a sparse VMDK header of the given capacity in sectors
+func writeSparseVMDK(t *testing.T, path string, sectors uint64) {
+	t.Helper()
+	header := make([]byte, 512)
+	copy(header, "KDMV")
+	binary.LittleEndian.PutUint32(header[4:8], 3)
+	binary.LittleEndian.PutUint64(header[12:20], sectors)
+	require.NoError(t, os.WriteFile(path, append(header, []byte("grain data")...), 0600))
+}
+
+func TestVmdkCapacity(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	vmdkPath := filepath.Join(dir, "disk.vmdk")
+	writeSparseVMDK(t, vmdkPath, 20971520)
+	capacity, err := vmdkCapacity(vmdkPath)
+	require.NoError(t, err)
+	require.Equal(t, int64(10*1024*1024*1024), capacity)
+
+	notVmdkPath := filepath.Join(dir, "disk.raw")
+	require.NoError(t, os.WriteFile(notVmdkPath, make([]byte, 512), 0600))
+	_, err = vmdkCapacity(notVmdkPath)
+	require.Error(t, err)
+}
+
+func TestPackageOVA(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+
+	vmdkPath := filepath.Join(dir, "vmdk", "disk.vmdk")
+	require.NoError(t, os.MkdirAll(filepath.Dir(vmdkPath), 0755))
+	writeSparseVMDK(t, vmdkPath, 2048)
+	vmdk, err := os.ReadFile(vmdkPath)
+	require.NoError(t, err)
+
+	ovaPath := filepath.Join(dir, "ova", "disk.ova")
+	require.NoError(t, packageOVA(vmdkPath, ovaPath, "my-export"))
+
+	ova, err := os.Open(ovaPath)
+	require.NoError(t, err)
+	defer ova.Close()
+
+	var names []string
+	contents := map[string][]byte{}
+	tr := tar.NewReader(ova)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(tr)
+		require.NoError(t, err)
+		names = append(names, hdr.Name)
+		contents[hdr.Name] = content
+	}
+
+	// The OVF descriptor must come first, and the manifest before the disk
+	require.Equal(t, []string{"my-export.ovf", "my-export.mf", "my-export-disk1.vmdk"}, names)
+	require.Equal(t, vmdk, contents["my-export-disk1.vmdk"])
+
+	var envelope struct {
+		References struct {
+			File struct {
+				Href string `xml:"href,attr"`
+				Size int64  `xml:"size,attr"`
+			} `xml:"File"`
+		} `xml:"References"`
+		DiskSection struct {
+			Disk struct {
+				Capacity int64 `xml:"capacity,attr"`
+			} `xml:"Disk"`
+		} `xml:"DiskSection"`
+		VirtualSystem struct {
+			Name string `xml:"Name"`
+		} `xml:"VirtualSystem"`
+	}
+	require.NoError(t, xml.Unmarshal(contents["my-export.ovf"], &envelope))
+	require.Equal(t, "my-export-disk1.vmdk", envelope.References.File.Href)
+	require.Equal(t, int64(len(vmdk)), envelope.References.File.Size)
+	require.Equal(t, int64(2048*512), envelope.DiskSection.Disk.Capacity)
+	require.Equal(t, "my-export", envelope.VirtualSystem.Name)
+
+	expectedManifest := "SHA256(my-export.ovf)= " + digest.FromBytes(contents["my-export.ovf"]).Encoded() + "\n" +
+		"SHA256(my-export-disk1.vmdk)= " + digest.FromBytes(vmdk).Encoded() + "\n"
+	require.Equal(t, expectedManifest, string(contents["my-export.mf"]))
+}
@@ -20,6 +20,7 @@ type imageExportStatusUpdateRequest struct {
 	Condition      *domain.ImageExportCondition
 	LastSeen       *time.Time
 	ManifestDigest *string
+	Artifact       *domain.ImageExportArtifact
 	// done is closed when the update has been processed (used for terminal conditions)
 	done chan struct{}
 }
@@ -135,7 +136,7 @@ func (u *imageExportStatusUpdater) run(cfg *config.Config) {
 					lastSeenUpdateTime = *lastOutputTime
 					lastSetLastSeenCopy := *lastOutputTime
 					lastSetLastSeen = &lastSetLastSeenCopy
-					u.updateStatus(pendingCondition, &lastSeenUpdateTime, nil)
+					u.updateStatus(pendingCondition, &lastSeenUpdateTime, nil, nil)
 					// Also persist logs to DB periodically
 					u.persistLogsToDB()
 					pendingCondition = nil
@@ -158,10 +159,10 @@ func (u *imageExportStatusUpdater) run(cfg *config.Config) {
 			if req.LastSeen != nil {
 				lastSeenUpdateTime = *req.LastSeen
 			}
-			// Update immediately when condition or manifest digest changes
+			// Update immediately when condition, manifest digest or artifact changes
 			// LastSeen-only updates are handled immediately to set initial value
-			if req.Condition != nil || req.ManifestDigest != nil || req.LastSeen != nil {
-				u.updateStatus(pendingCondition, &lastSeenUpdateTime, req.ManifestDigest)
+			if req.Condition != nil || req.ManifestDigest != nil || req.Artifact != nil || req.LastSeen != nil {
+				u.updateStatus(pendingCondition, &lastSeenUpdateTime, req.ManifestDigest, req.Artifact)
 				pendingCondition = nil
 			}
 			// Signal completion if done channel exists (used for synchronous updates)
@@ -177,7 +178,7 @@ func (u *imageExportStatusUpdater) run(cfg *config.Config) {
 // When cancelExport() is called, only exportCtx is canceled - updaterCtx remains valid until
 // cleanupStatusUpdater() is called, which happens AFTER processImageExport() returns.
 // This ensures we can still write the final status (e.g., Canceled) after the export is canceled.
-func (u *imageExportStatusUpdater) updateStatus(condition *domain.ImageExportCondition, lastSeen *time.Time, manifestDigest *string) {
+func (u *imageExportStatusUpdater) updateStatus(condition *domain.ImageExportCondition, lastSeen *time.Time, manifestDigest *string, artifact *domain.ImageExportArtifact) {
 	imageExport, status := u.imageExportService.Get(u.ctx, u.orgID, u.imageExportName)
 	if imageExport == nil || !imagebuilderapi.IsStatusOK(status) {
 		u.log.WithField("status", status).Warn("Failed to load ImageExport for status update")
@@ -232,6 +233,10 @@ func (u *imageExportStatusUpdater) updateStatus(condition *domain.ImageExportCon
 		imageExport.Status.ManifestDigest = manifestDigest
 	}
 
+	if artifact != nil {
+		imageExport.Status.Artifact = artifact
+	}
+
 	_, err := u.imageExportService.UpdateStatus(u.ctx, u.orgID, imageExport)
 	if err != nil {
 		u.log.WithError(err).Warn("Failed to update ImageExport status")
@@ -273,6 +278,17 @@ func (u *imageExportStatusUpdater) setManifestDigest(manifestDigest string) {
 	}
 }
 
+// setArtifact sets the manifest digest and the exported file's description in the ImageExport status
+func (u *imageExportStatusUpdater) setArtifact(manifestDigest string, artifact domain.ImageExportArtifact) {
+	req := newImageExportStatusUpdateRequest()
+	req.ManifestDigest = &manifestDigest
+	req.Artifact = &artifact
+	select {
+	case u.updateChan <- req:
+	case <-u.ctx.Done():
+	}
+}
+
 // reportOutput sends task output to the central output handler
 func (u *imageExportStatusUpdater) reportOutput(output []byte) {
 	select {
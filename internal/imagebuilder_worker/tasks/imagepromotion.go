@@ -736,6 +736,10 @@ func exportFormatToCatalogItemArtifactTypeWorker(format domain.ExportFormatType)
 		return coredomain.CatalogItemArtifactTypeVmdk, nil
 	case domain.ExportFormatTypeQCOW2DiskContainer:
 		return coredomain.CatalogItemArtifactTypeQcow2DiskContainer, nil
+	case domain.ExportFormatTypeRAW:
+		return coredomain.CatalogItemArtifactTypeRaw, nil
+	case domain.ExportFormatTypeVHD:
+		return coredomain.CatalogItemArtifactTypeVhd, nil
 	default:
 		return "", fmt.Errorf("unsupported export format: %s", format)
 	}
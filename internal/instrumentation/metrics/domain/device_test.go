@@ -100,7 +100,7 @@ func (m *MockDevice) UnmarkRolloutSelection(ctx context.Context, orgId uuid.UUID
 func (m *MockDevice) MarkRolloutSelection(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, limit *int) error {
 	return nil
 }
-func (m *MockDevice) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
+func (m *MockDevice) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	return nil, nil
 }
 func (m *MockDevice) CountByLabels(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, groupBy []string) ([]map[string]any, error) {
@@ -129,6 +129,10 @@ func (m *MockDevice) DecommissionDevice(ctx context.Context, orgId uuid.UUID, na
 	return nil, nil
 }
 
+func (m *MockDevice) UpdateIntegrityStatus(ctx context.Context, orgId uuid.UUID, name string, integrity domain.DeviceIntegrityStatus) error {
+	return nil
+}
+
 func TestDeviceCollectorWithGroupByFleet(t *testing.T) {
 	// Provide mock SQL results for org/status aggregation
 	mockResults := []devicestore.CountByOrgAndStatusResult{
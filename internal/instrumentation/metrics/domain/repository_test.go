@@ -12,6 +12,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 // MockRepository implements repository.Store for testing
@@ -52,6 +53,9 @@ func (m *MockRepository) Delete(context.Context, uuid.UUID, string, store.EventC
 func (m *MockRepository) UpdateStatus(context.Context, uuid.UUID, *domain.Repository, store.EventCallback) (*domain.Repository, error) {
 	return nil, nil
 }
+func (m *MockRepository) UnsetOwner(context.Context, *gorm.DB, uuid.UUID, string) error {
+	return nil
+}
 func (m *MockRepository) GetFleetRefs(context.Context, uuid.UUID, string) (*domain.FleetList, error) {
 	return nil, nil
 }
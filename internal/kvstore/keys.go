@@ -111,6 +111,17 @@ func (a *AwaitingReconnectionKey) ComposeKey() string {
 	return fmt.Sprintf("v1/%s/device/%s/awaiting-reconnect", a.OrgID, a.DeviceName)
 }
 
+// AttestationNonceKey holds the nonce of the outstanding attestation challenge of a device.
+// The nonce is consumed by the first quote submitted for it.
+type AttestationNonceKey struct {
+	OrgID      uuid.UUID
+	DeviceName string
+}
+
+func (a *AttestationNonceKey) ComposeKey() string {
+	return fmt.Sprintf("v1/%s/device/%s/attestation-nonce", a.OrgID, a.DeviceName)
+}
+
 // VmQuadletFilesKey is a content-addressed KV key for caching the Quadlet unit
 // files produced by vm-to-quadlet. The key is global (not scoped to
 // org/fleet/templateVersion) because the conversion is deterministic: the same
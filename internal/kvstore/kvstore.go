@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/redis/go-redis/extra/redisotel/v9"
@@ -42,6 +43,20 @@ type kvStore struct {
 	setIfGreaterScript *redis.Script
 }
 
+// New creates the key-value store selected by the queues.provider configuration setting, so that
+// an installation using the PostgreSQL queue provider does not need Redis at all.
+func New(ctx context.Context, log logrus.FieldLogger, cfg *config.Config) (KVStore, error) {
+	provider := cfg.QueuesProvider()
+	switch provider {
+	case config.QueuesProviderRedis:
+		return NewKVStore(ctx, log, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password)
+	case config.QueuesProviderPostgres:
+		return NewPostgresKVStore(ctx, log, cfg.Database.DSN(cfg.Database.User, cfg.Database.Password))
+	default:
+		return nil, fmt.Errorf("unsupported KV store provider %q", provider)
+	}
+}
+
 func NewKVStore(ctx context.Context, log logrus.FieldLogger, hostname string, port uint, password domain.SecureString) (KVStore, error) {
 	ctx, span := tracing.StartSpan(ctx, "flightctl/kvstore", "KVStore")
 	defer span.End()
@@ -0,0 +1,376 @@
+package kvstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+)
+
+// PostgresSchema creates the tables used by the PostgreSQL key-value store. It is applied by
+// the database migration so that the application user does not need schema privileges.
+//
+// Values live in kv_entries and streams in kv_streams (one row per stream holding the last
+// assigned entry ID) and kv_stream_entries. Entry IDs come from one sequence so that they keep
+// increasing when a stream is deleted and created again. A key whose expires_at has passed is
+// treated as absent and its row is deleted by a later cleanup.
+const PostgresSchema = `
+CREATE TABLE IF NOT EXISTS kv_entries (
+	key TEXT PRIMARY KEY,
+	value BYTEA NOT NULL,
+	expires_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS kv_entries_expires_at_idx ON kv_entries (expires_at) WHERE expires_at IS NOT NULL;
+
+CREATE SEQUENCE IF NOT EXISTS kv_stream_entry_ids;
+
+CREATE TABLE IF NOT EXISTS kv_streams (
+	key TEXT PRIMARY KEY,
+	last_id BIGINT NOT NULL,
+	expires_at TIMESTAMPTZ
+);
+CREATE INDEX IF NOT EXISTS kv_streams_expires_at_idx ON kv_streams (expires_at) WHERE expires_at IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS kv_stream_entries (
+	key TEXT NOT NULL REFERENCES kv_streams (key) ON DELETE CASCADE,
+	id BIGINT NOT NULL,
+	value BYTEA NOT NULL,
+	PRIMARY KEY (key, id)
+);
+`
+
+const (
+	// postgresStreamPollInterval is how often a blocking StreamRead checks for new entries
+	postgresStreamPollInterval = 250 * time.Millisecond
+	// postgresCleanupInterval is the minimum time between two deletions of expired keys
+	postgresCleanupInterval = time.Minute
+)
+
+type postgresKVStore struct {
+	log         logrus.FieldLogger
+	pool        *pgxpool.Pool
+	lastCleanup atomic.Int64
+}
+
+// NewPostgresKVStore creates a key-value store on top of the Flight Control database, for
+// installations that do not run Redis.
+func NewPostgresKVStore(ctx context.Context, log logrus.FieldLogger, dsn string) (KVStore, error) {
+	ctx, span := tracing.StartSpan(ctx, "flightctl/kvstore", "PostgresKVStore")
+	defer span.End()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PostgreSQL KV store connection pool: %w", err)
+	}
+
+	// Test the connection and make sure the migration created the tables
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	if err := pool.Ping(timeoutCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to KV store: %w", err)
+	}
+	if _, err := pool.Exec(timeoutCtx, "SELECT 1 FROM kv_entries LIMIT 0"); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("KV store tables are missing, run the database migration: %w", err)
+	}
+	log.Debug("successfully connected to the PostgreSQL KV store")
+
+	return &postgresKVStore{
+		log:  log,
+		pool: pool,
+	}, nil
+}
+
+func (s *postgresKVStore) Close() {
+	s.pool.Close()
+}
+
+func (s *postgresKVStore) DeleteAllKeys(ctx context.Context) error {
+	// Stream entries are deleted through the foreign key
+	for _, table := range []string{"kv_entries", "kv_streams"} {
+		if _, err := s.pool.Exec(ctx, fmt.Sprintf("DELETE FROM %s", table)); err != nil {
+			return fmt.Errorf("failed deleting all keys: %w", err)
+		}
+	}
+	return nil
+}
+
+// Sets the key to value only if the key does Not eXist. Returns a boolean indicating if the value was updated by this call.
+func (s *postgresKVStore) SetNX(ctx context.Context, key string, value []byte) (bool, error) {
+	err := s.pool.QueryRow(ctx, `
+		INSERT INTO kv_entries (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = NULL
+		WHERE kv_entries.expires_at <= now()
+		RETURNING 1`, key, value).Scan(new(int))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed storing key: %w", err)
+	}
+	return true, nil
+}
+
+// Sets the key to value, only if the key does not already exist or if its current value is less than the new value.
+// The value is stored as a decimal string, as in Redis.
+func (s *postgresKVStore) SetIfGreater(ctx context.Context, key string, newVal int64) (bool, error) {
+	err := s.pool.QueryRow(ctx, `
+		INSERT INTO kv_entries (key, value) VALUES ($1, $3)
+		ON CONFLICT (key) DO UPDATE SET value = EXCLUDED.value, expires_at = NULL
+		WHERE kv_entries.expires_at <= now()
+			OR CASE WHEN convert_from(kv_entries.value, 'UTF8') ~ '^-?[0-9]{1,18}$'
+				THEN convert_from(kv_entries.value, 'UTF8')::bigint < $2::bigint
+				ELSE TRUE END
+		RETURNING 1`, key, newVal, []byte(strconv.FormatInt(newVal, 10))).Scan(new(int))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Gets the value for the specified key.
+func (s *postgresKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	var value []byte
+	err := s.pool.QueryRow(ctx, `
+		SELECT value FROM kv_entries
+		WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`, key).Scan(&value)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed getting key: %w", err)
+	}
+	return value, nil
+}
+
+func (s *postgresKVStore) GetOrSetNX(ctx context.Context, key string, value []byte) ([]byte, error) {
+	// The no-op update on conflict locks the row and makes RETURNING yield the stored value
+	var result []byte
+	err := s.pool.QueryRow(ctx, `
+		INSERT INTO kv_entries (key, value) VALUES ($1, $2)
+		ON CONFLICT (key) DO UPDATE SET
+			value = CASE WHEN kv_entries.expires_at <= now() THEN EXCLUDED.value ELSE kv_entries.value END,
+			expires_at = CASE WHEN kv_entries.expires_at <= now() THEN NULL ELSE kv_entries.expires_at END
+		RETURNING value`, key, value).Scan(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed executing GetOrSetNX: %w", err)
+	}
+	return result, nil
+}
+
+func (s *postgresKVStore) DeleteKeysForTemplateVersion(ctx context.Context, key string) error {
+	for _, table := range []string{"kv_entries", "kv_streams"} {
+		if _, err := s.pool.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE starts_with(key, $1)", table), key); err != nil {
+			return fmt.Errorf("failed deleting keys: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *postgresKVStore) PrintAllKeys(ctx context.Context) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT key FROM kv_entries WHERE expires_at IS NULL OR expires_at > now()
+		UNION ALL
+		SELECT key FROM kv_streams WHERE expires_at IS NULL OR expires_at > now()`)
+	if err != nil {
+		fmt.Printf("failed listing keys: %v\n", err)
+		return
+	}
+	keys, err := pgx.CollectRows(rows, pgx.RowTo[string])
+	if err != nil {
+		fmt.Printf("failed listing keys: %v\n", err)
+		return
+	}
+	fmt.Printf("Keys: %v\n", keys)
+}
+
+// StreamAdd appends a value to a stream and returns the entry ID.
+// The ID is taken while holding the stream row lock, so readers never observe them out of order.
+func (s *postgresKVStore) StreamAdd(ctx context.Context, key string, value []byte) (string, error) {
+	var id int64
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		// An expired stream starts empty, as it would have been deleted by Redis
+		if _, err := tx.Exec(ctx, "DELETE FROM kv_streams WHERE key = $1 AND expires_at <= now()", key); err != nil {
+			return err
+		}
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO kv_streams (key, last_id) VALUES ($1, nextval('kv_stream_entry_ids'))
+			ON CONFLICT (key) DO UPDATE SET last_id = nextval('kv_stream_entry_ids')
+			RETURNING last_id`, key).Scan(&id); err != nil {
+			return err
+		}
+		_, err := tx.Exec(ctx, "INSERT INTO kv_stream_entries (key, id, value) VALUES ($1, $2, $3)", key, id, value)
+		return err
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to add to stream: %w", err)
+	}
+	return strconv.FormatInt(id, 10), nil
+}
+
+// StreamRange returns a range of entries from a stream
+// start and stop can be "-" (beginning), "+" (end), or specific entry IDs
+func (s *postgresKVStore) StreamRange(ctx context.Context, key string, start, stop string) ([]StreamEntry, error) {
+	first, err := parseStreamRangeBound(start, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream range: %w", err)
+	}
+	last, err := parseStreamRangeBound(stop, math.MaxInt64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream range: %w", err)
+	}
+
+	entries, err := s.queryStream(ctx, key, first-1, last, 0)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stream range: %w", err)
+	}
+	return entries, nil
+}
+
+// StreamRead reads entries from a stream with blocking support
+// lastID is the last entry ID read (use "0" to read from beginning, "$" for new entries only)
+// block is the blocking timeout (0 for non-blocking); the stream is polled while blocking
+// count limits the number of entries returned (0 for no limit)
+func (s *postgresKVStore) StreamRead(ctx context.Context, key string, lastID string, block time.Duration, count int64) ([]StreamEntry, error) {
+	var after int64
+	if lastID == "$" {
+		err := s.pool.QueryRow(ctx, `
+			SELECT last_id FROM kv_streams
+			WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`, key).Scan(&after)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to read from stream: %w", err)
+		}
+	} else {
+		var err error
+		if after, err = parseStreamID(lastID); err != nil {
+			return nil, fmt.Errorf("failed to read from stream: %w", err)
+		}
+	}
+
+	deadline := time.Now().Add(block)
+	for {
+		entries, err := s.queryStream(ctx, key, after, math.MaxInt64, count)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read from stream: %w", err)
+		}
+		if len(entries) > 0 || block <= 0 || !time.Now().Before(deadline) {
+			return entries, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("failed to read from stream: %w", ctx.Err())
+		case <-time.After(min(postgresStreamPollInterval, time.Until(deadline))):
+		}
+	}
+}
+
+// queryStream returns the entries of a live stream with IDs in (after, last], oldest first
+func (s *postgresKVStore) queryStream(ctx context.Context, key string, after int64, last int64, count int64) ([]StreamEntry, error) {
+	query := `
+		SELECT e.id, e.value FROM kv_stream_entries e
+		JOIN kv_streams s ON s.key = e.key
+		WHERE e.key = $1 AND e.id > $2 AND e.id <= $3 AND (s.expires_at IS NULL OR s.expires_at > now())
+		ORDER BY e.id`
+	args := []any{key, after, last}
+	if count > 0 {
+		query += " LIMIT $4"
+		args = append(args, count)
+	}
+
+	rows, err := s.pool.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	result := []StreamEntry{}
+	for rows.Next() {
+		var id int64
+		var value []byte
+		if err := rows.Scan(&id, &value); err != nil {
+			return nil, err
+		}
+		result = append(result, StreamEntry{
+			ID:    strconv.FormatInt(id, 10),
+			Value: value,
+		})
+	}
+	return result, rows.Err()
+}
+
+// SetExpire sets an expiration time on a key
+func (s *postgresKVStore) SetExpire(ctx context.Context, key string, expiration time.Duration) error {
+	err := pgx.BeginFunc(ctx, s.pool, func(tx pgx.Tx) error {
+		for _, table := range []string{"kv_entries", "kv_streams"} {
+			query := fmt.Sprintf(`
+				UPDATE %s SET expires_at = now() + $2 * interval '1 millisecond'
+				WHERE key = $1 AND (expires_at IS NULL OR expires_at > now())`, table)
+			if _, err := tx.Exec(ctx, query, key, expiration.Milliseconds()); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to set expiration: %w", err)
+	}
+
+	s.cleanup(ctx)
+	return nil
+}
+
+// cleanup deletes expired keys, at most once per postgresCleanupInterval. Expired keys are
+// already invisible to readers, so failures are only logged.
+func (s *postgresKVStore) cleanup(ctx context.Context) {
+	now := time.Now()
+	last := s.lastCleanup.Load()
+	if now.Sub(time.Unix(0, last)) < postgresCleanupInterval || !s.lastCleanup.CompareAndSwap(last, now.UnixNano()) {
+		return
+	}
+	for _, table := range []string{"kv_entries", "kv_streams"} {
+		if _, err := s.pool.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE expires_at <= now()", table)); err != nil {
+			s.log.WithError(err).Warn("failed deleting expired KV store keys")
+		}
+	}
+}
+
+// Delete deletes a key
+func (s *postgresKVStore) Delete(ctx context.Context, key string) error {
+	for _, table := range []string{"kv_entries", "kv_streams"} {
+		if _, err := s.pool.Exec(ctx, fmt.Sprintf("DELETE FROM %s WHERE key = $1", table), key); err != nil {
+			return fmt.Errorf("failed to delete key: %w", err)
+		}
+	}
+	return nil
+}
+
+// parseStreamRangeBound parses a StreamRange bound, where "-" and "+" stand for the given
+// unbounded value
+func parseStreamRangeBound(bound string, unbounded int64) (int64, error) {
+	if bound == "-" || bound == "+" {
+		return unbounded, nil
+	}
+	return parseStreamID(bound)
+}
+
+// parseStreamID parses an entry ID returned by StreamAdd
+func parseStreamID(id string) (int64, error) {
+	value, err := strconv.ParseInt(id, 10, 64)
+	if err != nil || value < 0 {
+		return 0, fmt.Errorf("invalid stream ID %q", id)
+	}
+	return value, nil
+}
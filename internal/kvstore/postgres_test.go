@@ -0,0 +1,27 @@
+package kvstore
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStreamRangeBound(t *testing.T) {
+	first, err := parseStreamRangeBound("-", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), first)
+
+	last, err := parseStreamRangeBound("+", math.MaxInt64)
+	require.NoError(t, err)
+	require.Equal(t, int64(math.MaxInt64), last)
+
+	id, err := parseStreamRangeBound("42", 0)
+	require.NoError(t, err)
+	require.Equal(t, int64(42), id)
+
+	for _, invalid := range []string{"", "$", "abc", "-1", "1700000000000-0"} {
+		_, err := parseStreamID(invalid)
+		require.Error(t, err, invalid)
+	}
+}
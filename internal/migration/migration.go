@@ -10,9 +10,11 @@ import (
 
 	"github.com/flightctl/flightctl/internal/domain"
 	imagebuilderstore "github.com/flightctl/flightctl/internal/imagebuilder_api/store"
+	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	checkpointstore "github.com/flightctl/flightctl/internal/store/checkpoint"
 	dependencyrefstore "github.com/flightctl/flightctl/internal/store/dependencyref"
@@ -27,6 +29,7 @@ import (
 	syncstatestore "github.com/flightctl/flightctl/internal/store/syncstate"
 	templateversionstore "github.com/flightctl/flightctl/internal/store/templateversion"
 	vulnerabilityfindingstore "github.com/flightctl/flightctl/internal/store/vulnerabilityfinding"
+	"github.com/flightctl/flightctl/pkg/queues"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
@@ -114,9 +117,20 @@ func runMainStoreMigrations(ctx context.Context, tx *gorm.DB, log logrus.FieldLo
 	if err := syncstatestore.NewSyncStateStore(tx, log).InitialMigration(ctx); err != nil {
 		return err
 	}
+	if err := certificaterevocationstore.NewCertificateRevocationStore(tx, log).InitialMigration(ctx); err != nil {
+		return err
+	}
 	if err := dependencyrefstore.NewDependencyRefStore(tx, log).InitialMigration(ctx); err != nil {
 		return err
 	}
+	// Tables of the PostgreSQL queue provider and KV store; created even when Redis is used so
+	// that the provider can be switched without another migration
+	if err := tx.WithContext(ctx).Exec(queues.PostgresSchema).Error; err != nil {
+		return err
+	}
+	if err := tx.WithContext(ctx).Exec(kvstore.PostgresSchema).Error; err != nil {
+		return err
+	}
 
 	return customizeMigration(ctx, tx)
 }
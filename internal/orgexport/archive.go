@@ -0,0 +1,294 @@
+package orgexport
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"time"
+)
+
+// FormatVersion is the version of the archive layout written by Write. Read accepts archives
+// up to this version.
+const FormatVersion = 1
+
+const (
+	manifestPath  = "manifest.json"
+	signaturePath = "manifest.sig"
+	resourcesDir  = "resources"
+
+	// maxFileSize bounds every file read from an archive.
+	maxFileSize = 512 << 20
+)
+
+var (
+	// ErrUnsigned is returned when a verification key is given but the archive carries no signature.
+	ErrUnsigned = errors.New("archive is not signed")
+	// ErrInvalidSignature is returned when the manifest signature does not match the verification key.
+	ErrInvalidSignature = errors.New("archive signature verification failed")
+)
+
+// Manifest describes the contents of an export archive. The manifest is what gets signed; it
+// pins every resource file by its SHA-256 digest.
+type Manifest struct {
+	FormatVersion      int            `json:"formatVersion"`
+	SourceOrganization string         `json:"sourceOrganization"`
+	FlightctlVersion   string         `json:"flightctlVersion,omitempty"`
+	ExportedAt         time.Time      `json:"exportedAt"`
+	Files              []ManifestFile `json:"files"`
+}
+
+type ManifestFile struct {
+	Kind   string `json:"kind"`
+	Path   string `json:"path"`
+	Count  int    `json:"count"`
+	SHA256 string `json:"sha256"`
+}
+
+// Archive is the in-memory form of an export archive.
+type Archive struct {
+	Manifest Manifest
+	// Resources holds the exported resources by kind.
+	Resources map[string][]Resource
+	// Signed is set by Read when the archive signature was verified.
+	Signed bool
+}
+
+// Write serializes the archive as a gzipped tarball. The manifest file list is rebuilt from the
+// resources. If signer is not nil the manifest is signed with it.
+func Write(w io.Writer, archive *Archive, signer crypto.Signer) error {
+	files := map[string][]byte{}
+	archive.Manifest.FormatVersion = FormatVersion
+	archive.Manifest.Files = nil
+	for _, kind := range Kinds {
+		resources, ok := archive.Resources[kind]
+		if !ok {
+			continue
+		}
+		if resources == nil {
+			resources = []Resource{}
+		}
+		data, err := json.MarshalIndent(resources, "", "  ")
+		if err != nil {
+			return fmt.Errorf("encoding %s resources: %w", kind, err)
+		}
+		filePath := path.Join(resourcesDir, kind+".json")
+		digest := sha256.Sum256(data)
+		files[filePath] = data
+		archive.Manifest.Files = append(archive.Manifest.Files, ManifestFile{
+			Kind:   kind,
+			Path:   filePath,
+			Count:  len(resources),
+			SHA256: hex.EncodeToString(digest[:]),
+		})
+	}
+
+	manifest, err := json.MarshalIndent(archive.Manifest, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encoding manifest: %w", err)
+	}
+
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+	if err := writeFile(tw, manifestPath, manifest); err != nil {
+		return err
+	}
+	if signer != nil {
+		signature, err := sign(signer, manifest)
+		if err != nil {
+			return fmt.Errorf("signing manifest: %w", err)
+		}
+		if err := writeFile(tw, signaturePath, signature); err != nil {
+			return err
+		}
+	}
+	for _, file := range archive.Manifest.Files {
+		if err := writeFile(tw, file.Path, files[file.Path]); err != nil {
+			return err
+		}
+	}
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
+
+func writeFile(tw *tar.Writer, name string, data []byte) error {
+	header := &tar.Header{
+		Name:    name,
+		Mode:    0600,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	}
+	if err := tw.WriteHeader(header); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	if _, err := tw.Write(data); err != nil {
+		return fmt.Errorf("writing %s: %w", name, err)
+	}
+	return nil
+}
+
+// Read parses and validates an archive written by Write. Every resource file must match the
+// digest and count recorded in the manifest. If verifyKey is not nil the archive must carry a
+// signature made by the matching private key.
+func Read(r io.Reader, verifyKey crypto.PublicKey) (*Archive, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("opening archive: %w", err)
+	}
+	defer gz.Close()
+
+	files := map[string][]byte{}
+	tr := tar.NewReader(gz)
+	for {
+		header, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading archive: %w", err)
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+		if _, found := files[header.Name]; found {
+			return nil, fmt.Errorf("archive contains %s more than once", header.Name)
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxFileSize+1))
+		if err != nil {
+			return nil, fmt.Errorf("reading %s: %w", header.Name, err)
+		}
+		if len(data) > maxFileSize {
+			return nil, fmt.Errorf("%s exceeds the maximum size of %d bytes", header.Name, maxFileSize)
+		}
+		files[header.Name] = data
+	}
+
+	manifestData, ok := files[manifestPath]
+	if !ok {
+		return nil, fmt.Errorf("archive has no %s", manifestPath)
+	}
+	archive := &Archive{Resources: map[string][]Resource{}}
+	if err := json.Unmarshal(manifestData, &archive.Manifest); err != nil {
+		return nil, fmt.Errorf("decoding manifest: %w", err)
+	}
+	if archive.Manifest.FormatVersion < 1 || archive.Manifest.FormatVersion > FormatVersion {
+		return nil, fmt.Errorf("unsupported archive format version %d, this version of flightctl reads up to version %d",
+			archive.Manifest.FormatVersion, FormatVersion)
+	}
+
+	signature, signed := files[signaturePath]
+	if verifyKey != nil {
+		if !signed {
+			return nil, ErrUnsigned
+		}
+		if err := verify(verifyKey, manifestData, signature); err != nil {
+			return nil, err
+		}
+		archive.Signed = true
+	}
+
+	known := map[string]bool{manifestPath: true, signaturePath: true}
+	for _, file := range archive.Manifest.Files {
+		if !isKind(file.Kind) {
+			return nil, fmt.Errorf("archive contains resources of unsupported kind %q", file.Kind)
+		}
+		if _, found := archive.Resources[file.Kind]; found {
+			return nil, fmt.Errorf("manifest lists kind %s more than once", file.Kind)
+		}
+		data, ok := files[file.Path]
+		if !ok {
+			return nil, fmt.Errorf("archive is missing %s", file.Path)
+		}
+		digest := sha256.Sum256(data)
+		if hex.EncodeToString(digest[:]) != file.SHA256 {
+			return nil, fmt.Errorf("digest of %s does not match the manifest", file.Path)
+		}
+		var resources []Resource
+		if err := json.Unmarshal(data, &resources); err != nil {
+			return nil, fmt.Errorf("decoding %s: %w", file.Path, err)
+		}
+		if len(resources) != file.Count {
+			return nil, fmt.Errorf("%s holds %d resources, the manifest lists %d", file.Path, len(resources), file.Count)
+		}
+		archive.Resources[file.Kind] = resources
+		known[file.Path] = true
+	}
+	for name := range files {
+		if !known[name] {
+			return nil, fmt.Errorf("archive contains %s, which is not listed in the manifest", name)
+		}
+	}
+	return archive, nil
+}
+
+// sign signs the manifest. Ed25519 keys sign the manifest itself, ECDSA and RSA keys sign its
+// SHA-256 digest.
+func sign(signer crypto.Signer, data []byte) ([]byte, error) {
+	if _, ok := signer.Public().(ed25519.PublicKey); ok {
+		return signer.Sign(rand.Reader, data, crypto.Hash(0))
+	}
+	digest := sha256.Sum256(data)
+	return signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+}
+
+func verify(key crypto.PublicKey, data []byte, signature []byte) error {
+	digest := sha256.Sum256(data)
+	var valid bool
+	switch k := key.(type) {
+	case ed25519.PublicKey:
+		valid = ed25519.Verify(k, data, signature)
+	case *ecdsa.PublicKey:
+		valid = ecdsa.VerifyASN1(k, digest[:], signature)
+	case *rsa.PublicKey:
+		valid = rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature) == nil
+	default:
+		return fmt.Errorf("unsupported verification key type %T", key)
+	}
+	if !valid {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// ParsePublicKeyPEM parses a PEM encoded public key or certificate for use with Read.
+func ParsePublicKeyPEM(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("not a valid PEM encoded block")
+	}
+	switch block.Type {
+	case "PUBLIC KEY":
+		return x509.ParsePKIXPublicKey(block.Bytes)
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q, expected a public key or certificate", block.Type)
+	}
+}
+
+// NewSigner returns the crypto.Signer for a private key loaded with pkg/crypto.
+func NewSigner(key crypto.PrivateKey) (crypto.Signer, error) {
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported signing key type %T", key)
+	}
+	return signer, nil
+}
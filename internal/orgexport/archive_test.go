@@ -0,0 +1,141 @@
+package orgexport
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/pem"
+	"io"
+	"testing"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestArchive() *Archive {
+	return &Archive{
+		Manifest: Manifest{
+			SourceOrganization: "00000000-0000-0000-0000-000000000000",
+			ExportedAt:         time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		},
+		Resources: map[string][]Resource{
+			api.FleetKind: {
+				{"kind": "Fleet", "metadata": map[string]interface{}{"name": "web"}, "spec": map[string]interface{}{}},
+			},
+			api.DeviceKind: {},
+		},
+	}
+}
+
+func TestWriteRead(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, newTestArchive(), nil))
+
+	archive, err := Read(bytes.NewReader(buf.Bytes()), nil)
+	require.NoError(t, err)
+	assert.False(t, archive.Signed)
+	assert.Equal(t, FormatVersion, archive.Manifest.FormatVersion)
+	assert.Equal(t, "00000000-0000-0000-0000-000000000000", archive.Manifest.SourceOrganization)
+	require.Len(t, archive.Manifest.Files, 2)
+	assert.Equal(t, "resources/Fleet.json", archive.Manifest.Files[0].Path)
+	require.Len(t, archive.Resources[api.FleetKind], 1)
+	assert.Equal(t, "web", archive.Resources[api.FleetKind][0].Name())
+	assert.Empty(t, archive.Resources[api.DeviceKind])
+
+	_, err = Read(bytes.NewReader(buf.Bytes()), mustECDSAKey(t).Public())
+	assert.ErrorIs(t, err, ErrUnsigned)
+}
+
+func TestWriteReadSigned(t *testing.T) {
+	ecKey := mustECDSAKey(t)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	for name, signer := range map[string]crypto.Signer{"ecdsa": ecKey, "ed25519": edKey} {
+		t.Run(name, func(t *testing.T) {
+			s, err := NewSigner(signer)
+			require.NoError(t, err)
+
+			var buf bytes.Buffer
+			require.NoError(t, Write(&buf, newTestArchive(), s))
+
+			der, err := x509.MarshalPKIXPublicKey(signer.Public())
+			require.NoError(t, err)
+			publicKey, err := ParsePublicKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+			require.NoError(t, err)
+
+			archive, err := Read(bytes.NewReader(buf.Bytes()), publicKey)
+			require.NoError(t, err)
+			assert.True(t, archive.Signed)
+
+			_, err = Read(bytes.NewReader(buf.Bytes()), mustECDSAKey(t).Public())
+			assert.ErrorIs(t, err, ErrInvalidSignature)
+		})
+	}
+}
+
+func TestReadRejectsTampering(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, Write(&buf, newTestArchive(), nil))
+	files := readFiles(t, buf.Bytes())
+
+	files["resources/Fleet.json"] = []byte(`[{"kind":"Fleet","metadata":{"name":"evil"}}]`)
+	_, err := Read(bytes.NewReader(writeFiles(t, files)), nil)
+	assert.ErrorContains(t, err, "does not match the manifest")
+
+	files = readFiles(t, buf.Bytes())
+	files["resources/Extra.json"] = []byte(`[]`)
+	_, err = Read(bytes.NewReader(writeFiles(t, files)), nil)
+	assert.ErrorContains(t, err, "not listed in the manifest")
+}
+
+func TestReadRejectsNewerFormat(t *testing.T) {
+	files := map[string][]byte{manifestPath: []byte(`{"formatVersion":2,"files":[]}`)}
+	_, err := Read(bytes.NewReader(writeFiles(t, files)), nil)
+	assert.ErrorContains(t, err, "unsupported archive format version 2")
+}
+
+func readFiles(t *testing.T, data []byte) map[string][]byte {
+	t.Helper()
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+	tr := tar.NewReader(gz)
+	files := map[string][]byte{}
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			return files
+		}
+		require.NoError(t, err)
+		files[header.Name], err = io.ReadAll(tr)
+		require.NoError(t, err)
+	}
+}
+
+func writeFiles(t *testing.T, files map[string][]byte) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, data := range files {
+		require.NoError(t, writeFile(tw, name, data))
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func mustECDSAKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	return key
+}
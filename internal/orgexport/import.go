@@ -0,0 +1,242 @@
+package orgexport
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+)
+
+// Target is the organization that an archive is imported into.
+type Target interface {
+	// Get returns the resource of the given kind with the name of r, or nil if it does not exist.
+	Get(ctx context.Context, kind string, r Resource) (Resource, error)
+	// Apply creates or replaces a resource.
+	Apply(ctx context.Context, kind string, r Resource) error
+	// ApproveEnrollmentRequest approves an enrollment request with the given approval.
+	ApproveEnrollmentRequest(ctx context.Context, name string, approval map[string]interface{}) error
+}
+
+type ImportOptions struct {
+	// DryRun reports what would change without changing anything.
+	DryRun bool
+	// Overwrite replaces resources that already exist with different content instead of
+	// reporting them as conflicts.
+	Overwrite bool
+}
+
+// Action is what the import did, or would do, with a resource.
+type Action string
+
+const (
+	ActionCreate    Action = "create"
+	ActionUpdate    Action = "update"
+	ActionUnchanged Action = "unchanged"
+	ActionConflict  Action = "conflict"
+	ActionFailed    Action = "failed"
+)
+
+type Result struct {
+	Kind    string
+	Name    string
+	Action  Action
+	Message string
+}
+
+// Report lists the outcome of an import, one result per resource in import order.
+type Report struct {
+	SourceOrganization string
+	DryRun             bool
+	Results            []Result
+}
+
+// Count returns the number of resources with the given action.
+func (r *Report) Count(action Action) int {
+	count := 0
+	for _, result := range r.Results {
+		if result.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+func (r *Report) add(kind string, name string, action Action, format string, args ...interface{}) {
+	r.Results = append(r.Results, Result{Kind: kind, Name: name, Action: action, Message: fmt.Sprintf(format, args...)})
+}
+
+// Import applies the resources of an archive to the target organization. Resources keep their
+// names; everything that referred to the source organization now belongs to the target one.
+// Resources that do not exist are created, resources that exist with the same labels,
+// annotations and spec are left alone, and resources that exist with different content are
+// reported as conflicts unless opts.Overwrite is set. Errors on individual resources are
+// recorded in the report and do not stop the import.
+func Import(ctx context.Context, archive *Archive, target Target, opts ImportOptions) *Report {
+	report := &Report{SourceOrganization: archive.Manifest.SourceOrganization, DryRun: opts.DryRun}
+	for _, kind := range Kinds {
+		for _, resource := range archive.Resources[kind] {
+			name := resource.Name()
+			if name == "" {
+				report.add(kind, "", ActionFailed, "resource has no name")
+				continue
+			}
+			importResource(ctx, report, target, kind, name, resource, opts)
+		}
+	}
+	return report
+}
+
+func importResource(ctx context.Context, report *Report, target Target, kind string, name string, resource Resource, opts ImportOptions) {
+	existing, err := target.Get(ctx, kind, resource)
+	if err != nil {
+		report.add(kind, name, ActionFailed, "reading existing resource: %v", err)
+		return
+	}
+
+	desired := withoutStatus(resource)
+	approval := resource.Approval()
+	var action Action
+	var message string
+	if existing == nil {
+		action = ActionCreate
+		if kind == api.RepositoryKind && stripMaskedValues(desired["spec"]) > 0 {
+			message = "credentials were not exported and must be set again"
+		}
+	} else {
+		existing = Sanitize(kind, existing)
+		differences := differingFields(existing, desired)
+		switch {
+		case len(differences) > 0 && !opts.Overwrite:
+			report.add(kind, name, ActionConflict, "exists with different %s", strings.Join(differences, ", "))
+			return
+		case len(differences) > 0:
+			action = ActionUpdate
+		case approval != nil && existing.Approval() == nil:
+			// Only the approval is missing.
+			action = ActionUpdate
+			desired = nil
+		default:
+			report.add(kind, name, ActionUnchanged, "")
+			return
+		}
+	}
+
+	if opts.DryRun {
+		report.add(kind, name, action, "%s", message)
+		return
+	}
+	if desired != nil {
+		if err := target.Apply(ctx, kind, desired); err != nil {
+			report.add(kind, name, ActionFailed, "%v", err)
+			return
+		}
+	}
+	if kind == api.EnrollmentRequestKind && approval != nil {
+		if err := target.ApproveEnrollmentRequest(ctx, name, approval); err != nil {
+			report.add(kind, name, ActionFailed, "approving: %v", err)
+			return
+		}
+	}
+	report.add(kind, name, action, "%s", message)
+}
+
+// differingFields compares the parts of two resources that the import sets and returns the
+// names of those that differ.
+func differingFields(existing Resource, desired Resource) []string {
+	var differences []string
+	for _, field := range []string{"labels", "annotations"} {
+		if !equalValues(existing.metadata()[field], desired.metadata()[field]) {
+			differences = append(differences, field)
+		}
+	}
+	if !equalValues(existing["spec"], desired["spec"]) {
+		differences = append(differences, "spec")
+	}
+	sort.Strings(differences)
+	return differences
+}
+
+// equalValues treats missing and empty values as equal, and masked secrets as equal to anything,
+// since the server keeps the stored secret when it receives the placeholder.
+func equalValues(a interface{}, b interface{}) bool {
+	if isEmpty(a) && isEmpty(b) {
+		return true
+	}
+	if s, ok := b.(string); ok && s == api.MaskedValuePlaceholder {
+		return a != nil
+	}
+	switch bv := b.(type) {
+	case map[string]interface{}:
+		av, ok := a.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for key := range av {
+			if _, found := bv[key]; !found && !isEmpty(av[key]) {
+				return false
+			}
+		}
+		for key, value := range bv {
+			if !equalValues(av[key], value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		av, ok := a.([]interface{})
+		if !ok || len(av) != len(bv) {
+			return false
+		}
+		for i := range bv {
+			if !equalValues(av[i], bv[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return reflect.DeepEqual(a, b)
+	}
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+// withoutStatus returns a deep copy of r without its status, which the API does not accept on
+// create or replace.
+func withoutStatus(r Resource) Resource {
+	out := deepCopy(map[string]interface{}(r)).(map[string]interface{})
+	delete(out, "status")
+	return out
+}
+
+func deepCopy(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		out := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			out[key] = deepCopy(child)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, child := range v {
+			out[i] = deepCopy(child)
+		}
+		return out
+	default:
+		return v
+	}
+}
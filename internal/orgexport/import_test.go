@@ -0,0 +1,100 @@
+package orgexport
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeTarget struct {
+	resources map[string]Resource
+	applied   []string
+	approved  []string
+}
+
+func (f *fakeTarget) Get(_ context.Context, kind string, r Resource) (Resource, error) {
+	return f.resources[kind+"/"+r.Name()], nil
+}
+
+func (f *fakeTarget) Apply(_ context.Context, kind string, r Resource) error {
+	f.applied = append(f.applied, kind+"/"+r.Name())
+	f.resources[kind+"/"+r.Name()] = r
+	return nil
+}
+
+func (f *fakeTarget) ApproveEnrollmentRequest(_ context.Context, name string, _ map[string]interface{}) error {
+	f.approved = append(f.approved, name)
+	return nil
+}
+
+func mustResource(t *testing.T, data string) Resource {
+	t.Helper()
+	var r Resource
+	require.NoError(t, json.Unmarshal([]byte(data), &r))
+	return r
+}
+
+func TestSanitize(t *testing.T) {
+	device := mustResource(t, `{"kind":"Device","metadata":{"name":"d1","owner":"Fleet/web","resourceVersion":"7",
+		"labels":{"site":"a"},"annotations":{"device-controller/renderedVersion":"3","team":"x"}},
+		"spec":{"os":{"image":"img"}},"status":{"summary":{"status":"Online"}}}`)
+	sanitized := Sanitize(api.DeviceKind, device)
+	assert.Equal(t, mustResource(t, `{"kind":"Device","metadata":{"name":"d1","labels":{"site":"a"},"annotations":{"team":"x"}}}`), sanitized)
+
+	er := mustResource(t, `{"kind":"EnrollmentRequest","metadata":{"name":"er1"},"spec":{"csr":"pem"},
+		"status":{"certificate":"cert","approval":{"approved":true,"labels":{"site":"a"}}}}`)
+	sanitized = Sanitize(api.EnrollmentRequestKind, er)
+	assert.Equal(t, mustResource(t, `{"kind":"EnrollmentRequest","metadata":{"name":"er1"},"spec":{"csr":"pem"},
+		"status":{"approval":{"approved":true,"labels":{"site":"a"}}}}`), sanitized)
+}
+
+func TestImport(t *testing.T) {
+	archive := &Archive{
+		Manifest: Manifest{SourceOrganization: "source"},
+		Resources: map[string][]Resource{
+			api.RepositoryKind: {
+				mustResource(t, `{"kind":"Repository","metadata":{"name":"new"},"spec":{"url":"https://example.com/a.git",
+					"httpConfig":{"username":"user","password":"*****"}}}`),
+				mustResource(t, `{"kind":"Repository","metadata":{"name":"same"},"spec":{"url":"https://example.com/b.git",
+					"httpConfig":{"password":"*****"}}}`),
+			},
+			api.FleetKind: {
+				mustResource(t, `{"kind":"Fleet","metadata":{"name":"web","labels":{"env":"prod"}},"spec":{}}`),
+			},
+			api.EnrollmentRequestKind: {
+				mustResource(t, `{"kind":"EnrollmentRequest","metadata":{"name":"er1"},"spec":{"csr":"pem"},
+					"status":{"approval":{"approved":true}}}`),
+			},
+		},
+	}
+	newTarget := func() *fakeTarget {
+		return &fakeTarget{resources: map[string]Resource{
+			"Repository/same": mustResource(t, `{"kind":"Repository","metadata":{"name":"same","resourceVersion":"3"},
+				"spec":{"url":"https://example.com/b.git","httpConfig":{"password":"*****"}},"status":{}}`),
+			"Fleet/web": mustResource(t, `{"kind":"Fleet","metadata":{"name":"web","labels":{"env":"dev"}},"spec":{}}`),
+		}}
+	}
+
+	target := newTarget()
+	report := Import(context.Background(), archive, target, ImportOptions{DryRun: true})
+	assert.Empty(t, target.applied)
+	assert.Empty(t, target.approved)
+	assert.Equal(t, []Result{
+		{Kind: api.RepositoryKind, Name: "new", Action: ActionCreate, Message: "credentials were not exported and must be set again"},
+		{Kind: api.RepositoryKind, Name: "same", Action: ActionUnchanged},
+		{Kind: api.FleetKind, Name: "web", Action: ActionConflict, Message: "exists with different labels"},
+		{Kind: api.EnrollmentRequestKind, Name: "er1", Action: ActionCreate},
+	}, report.Results)
+
+	report = Import(context.Background(), archive, target, ImportOptions{Overwrite: true})
+	assert.Equal(t, []string{"Repository/new", "Fleet/web", "EnrollmentRequest/er1"}, target.applied)
+	assert.Equal(t, []string{"er1"}, target.approved)
+	assert.Equal(t, 1, report.Count(ActionUpdate))
+	assert.Equal(t, 0, report.Count(ActionConflict))
+	assert.NotContains(t, target.resources["Repository/new"]["spec"].(map[string]interface{})["httpConfig"], "password")
+	assert.NotContains(t, target.resources["EnrollmentRequest/er1"], "status")
+}
@@ -0,0 +1,161 @@
+package orgexport
+
+import (
+	"strings"
+
+	apiv1alpha1 "github.com/flightctl/flightctl/api/core/v1alpha1"
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+)
+
+// Kinds lists the exported resource kinds in the order they are imported, so that resources
+// are created after the resources they reference. Template versions are not exported because
+// the API cannot create them; each imported fleet renders its own. ResourceSyncs are not
+// exported either, so that the target organization does not start syncing from git on import.
+var Kinds = []string{
+	api.RepositoryKind,
+	apiv1alpha1.CatalogKind,
+	apiv1alpha1.CatalogItemKind,
+	api.FleetKind,
+	api.DeviceKind,
+	api.EnrollmentRequestKind,
+}
+
+// controllerAnnotationPrefixes are the annotation prefixes owned by the service controllers.
+// They describe the state of the source installation and are not exported.
+var controllerAnnotationPrefixes = []string{
+	"fleet-controller/",
+	"device-controller/",
+	"resourcesync-controller/",
+}
+
+// Resource is a resource in its API JSON form.
+type Resource map[string]interface{}
+
+func isKind(kind string) bool {
+	for _, k := range Kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+// Name returns metadata.name.
+func (r Resource) Name() string {
+	name, _ := r.metadata()["name"].(string)
+	return name
+}
+
+// Catalog returns metadata.catalog, which names the parent of a CatalogItem.
+func (r Resource) Catalog() string {
+	catalog, _ := r.metadata()["catalog"].(string)
+	return catalog
+}
+
+// Owner returns metadata.owner, such as "Fleet/web" or "ResourceSync/site-config".
+func (r Resource) Owner() string {
+	owner, _ := r.metadata()["owner"].(string)
+	return owner
+}
+
+func (r Resource) metadata() map[string]interface{} {
+	metadata, _ := r["metadata"].(map[string]interface{})
+	return metadata
+}
+
+// Approval returns status.approval of an EnrollmentRequest, or nil if it was not approved.
+func (r Resource) Approval() map[string]interface{} {
+	status, _ := r["status"].(map[string]interface{})
+	approval, _ := status["approval"].(map[string]interface{})
+	if approved, _ := approval["approved"].(bool); !approved {
+		return nil
+	}
+	return approval
+}
+
+// Sanitize reduces a resource read from the source installation to what can be recreated in
+// another one. Server-managed metadata and status are dropped, except for the approval of
+// EnrollmentRequests. The spec of devices that belong to a fleet is dropped as well, because
+// the fleet renders it.
+func Sanitize(kind string, r Resource) Resource {
+	out := Resource{}
+	for key, value := range r {
+		switch key {
+		case "status":
+		case "metadata":
+			out[key] = sanitizeMetadata(r.metadata())
+		default:
+			out[key] = value
+		}
+	}
+	switch kind {
+	case api.EnrollmentRequestKind:
+		if approval := r.Approval(); approval != nil {
+			out["status"] = map[string]interface{}{"approval": approval}
+		}
+	case api.DeviceKind:
+		if strings.HasPrefix(r.Owner(), api.FleetKind+"/") {
+			delete(out, "spec")
+		}
+	}
+	return out
+}
+
+func sanitizeMetadata(metadata map[string]interface{}) map[string]interface{} {
+	out := map[string]interface{}{}
+	for key, value := range metadata {
+		switch key {
+		case "resourceVersion", "generation", "creationTimestamp", "deletionTimestamp", "owner":
+		case "annotations":
+			if annotations := sanitizeAnnotations(value); len(annotations) > 0 {
+				out[key] = annotations
+			}
+		default:
+			out[key] = value
+		}
+	}
+	return out
+}
+
+func sanitizeAnnotations(value interface{}) map[string]interface{} {
+	annotations, _ := value.(map[string]interface{})
+	out := map[string]interface{}{}
+	for key, value := range annotations {
+		if isControllerAnnotation(key) {
+			continue
+		}
+		out[key] = value
+	}
+	return out
+}
+
+func isControllerAnnotation(key string) bool {
+	for _, prefix := range controllerAnnotationPrefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// stripMaskedValues removes every string field holding the placeholder the API returns instead
+// of a secret. It returns the number of removed fields.
+func stripMaskedValues(value interface{}) int {
+	removed := 0
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if s, ok := child.(string); ok && s == api.MaskedValuePlaceholder {
+				delete(v, key)
+				removed++
+				continue
+			}
+			removed += stripMaskedValues(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			removed += stripMaskedValues(child)
+		}
+	}
+	return removed
+}
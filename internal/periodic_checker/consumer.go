@@ -6,7 +6,6 @@ import (
 	"runtime/debug"
 	"sync"
 
-	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 )
 
@@ -14,18 +13,24 @@ const (
 	DefaultConsumerCount = 5
 )
 
-func executeWithRecover(ctx context.Context, executor PeriodicTaskExecutor, log logrus.FieldLogger, taskType PeriodicTaskType, orgID uuid.UUID) {
+func executeWithRecover(ctx context.Context, executor PeriodicTaskExecutor, log logrus.FieldLogger, reference PeriodicTaskReference) {
 	defer func() {
 		if r := recover(); r != nil {
 			log.WithFields(logrus.Fields{
 				"panic":     r,
-				"task_type": taskType,
-				"org_id":    orgID.String(),
+				"task_type": reference.Type,
+				"org_id":    reference.OrgID.String(),
 				"stack":     string(debug.Stack()),
 			}).Error("task execution panic")
 		}
 	}()
-	executor.Execute(ctx, log, orgID)
+	if len(reference.Repositories) > 0 {
+		if scoped, ok := executor.(RepositoryScopedExecutor); ok {
+			scoped.ExecuteForRepositories(ctx, log, reference.OrgID, reference.Repositories, reference.Revision)
+			return
+		}
+	}
+	executor.Execute(ctx, log, reference.OrgID)
 }
 
 func (c *PeriodicTaskConsumer) processTask(ctx context.Context, reference PeriodicTaskReference) {
@@ -40,7 +45,7 @@ func (c *PeriodicTaskConsumer) processTask(ctx context.Context, reference Period
 		return
 	}
 
-	executeWithRecover(ctx, executor, c.log, reference.Type, reference.OrgID)
+	executeWithRecover(ctx, executor, c.log, reference)
 }
 
 type PeriodicTaskConsumer struct {
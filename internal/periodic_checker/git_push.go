@@ -0,0 +1,33 @@
+package periodic
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/flightctl/flightctl/internal/gitwebhook"
+)
+
+// gitPushTaskTypes are run for the pushed repositories as soon as a git push is received.
+var gitPushTaskTypes = []PeriodicTaskType{
+	PeriodicTaskTypeResourceSync,
+	PeriodicTaskTypeDependencySyncGit,
+}
+
+// publishGitPushTasks schedules the tasks of a git push trigger right away. Tasks that cannot
+// be scheduled because the channel is full are picked up by the next regular run.
+func publishGitPushTasks(ctx context.Context, channelManager *ChannelManager, trigger gitwebhook.Trigger) error {
+	var errs []error
+	for _, taskType := range gitPushTaskTypes {
+		reference := PeriodicTaskReference{
+			Type:         taskType,
+			OrgID:        trigger.OrgID,
+			Repositories: trigger.Repositories,
+			Revision:     trigger.Revision,
+		}
+		if err := channelManager.PublishTask(ctx, reference); err != nil {
+			errs = append(errs, fmt.Errorf("publishing %s task for organization %s: %w", taskType, trigger.OrgID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,77 @@
+package periodic
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/gitwebhook"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// scopedPeriodicTaskExecutor records the repositories it was asked to run for
+type scopedPeriodicTaskExecutor struct {
+	mockPeriodicTaskExecutor
+	scopedCalls []gitwebhook.Trigger
+}
+
+func (s *scopedPeriodicTaskExecutor) ExecuteForRepositories(ctx context.Context, log logrus.FieldLogger, orgID uuid.UUID, repositories []string, revision string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scopedCalls = append(s.scopedCalls, gitwebhook.Trigger{OrgID: orgID, Repositories: repositories, Revision: revision})
+}
+
+func (s *scopedPeriodicTaskExecutor) GetScopedCalls() []gitwebhook.Trigger {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]gitwebhook.Trigger(nil), s.scopedCalls...)
+}
+
+func TestPublishGitPushTasks(t *testing.T) {
+	log := logrus.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	resourceSyncExecutor := &scopedPeriodicTaskExecutor{}
+	// An executor that cannot be scoped falls back to a full run.
+	dependencySyncExecutor := &mockPeriodicTaskExecutor{}
+	executors := map[PeriodicTaskType]PeriodicTaskExecutor{
+		PeriodicTaskTypeResourceSync:      resourceSyncExecutor,
+		PeriodicTaskTypeDependencySyncGit: dependencySyncExecutor,
+	}
+
+	channelManager, err := NewChannelManager(ChannelManagerConfig{Log: log})
+	require.NoError(t, err)
+	defer channelManager.Close()
+
+	consumer, err := NewPeriodicTaskConsumer(PeriodicTaskConsumerConfig{
+		ChannelManager: channelManager,
+		Log:            log,
+		Executors:      executors,
+	})
+	require.NoError(t, err)
+	go consumer.Run(ctx)
+
+	trigger := gitwebhook.Trigger{OrgID: uuid.New(), Repositories: []string{"site-config"}, Revision: "main"}
+	require.NoError(t, publishGitPushTasks(ctx, channelManager, trigger))
+
+	require.Eventually(t, func() bool {
+		return len(resourceSyncExecutor.GetScopedCalls()) == 1 && dependencySyncExecutor.GetExecuteCallCount() == 1
+	}, 1*time.Second, 10*time.Millisecond)
+	require.Equal(t, trigger, resourceSyncExecutor.GetScopedCalls()[0])
+	require.Zero(t, resourceSyncExecutor.GetExecuteCallCount())
+	require.Equal(t, trigger.OrgID, dependencySyncExecutor.GetExecuteCallArgs()[0].orgID)
+}
+
+func TestPublishGitPushTasks_ChannelFull(t *testing.T) {
+	channelManager, err := NewChannelManager(ChannelManagerConfig{Log: logrus.New(), ChannelBufferSize: 1})
+	require.NoError(t, err)
+	defer channelManager.Close()
+
+	trigger := gitwebhook.Trigger{OrgID: uuid.New(), Repositories: []string{"site-config"}}
+	err = publishGitPushTasks(context.Background(), channelManager, trigger)
+	require.ErrorContains(t, err, string(PeriodicTaskTypeDependencySyncGit))
+	require.NotContains(t, err.Error(), string(PeriodicTaskTypeResourceSync)+" task")
+}
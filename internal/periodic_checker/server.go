@@ -11,15 +11,18 @@ import (
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/gitwebhook"
 	periodicmetrics "github.com/flightctl/flightctl/internal/instrumentation/metrics/periodic"
 	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/org/cache"
 	"github.com/flightctl/flightctl/internal/rendered"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	checkpointservice "github.com/flightctl/flightctl/internal/service/checkpoint"
 	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	deviceattestationservice "github.com/flightctl/flightctl/internal/service/deviceattestation"
 	eventservice "github.com/flightctl/flightctl/internal/service/event"
 	"github.com/flightctl/flightctl/internal/service/events"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
@@ -27,7 +30,9 @@ import (
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
 	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
 	syncstateservice "github.com/flightctl/flightctl/internal/service/syncstate"
+	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	checkpointstore "github.com/flightctl/flightctl/internal/store/checkpoint"
 	dependencyrefstore "github.com/flightctl/flightctl/internal/store/dependencyref"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
@@ -41,6 +46,7 @@ import (
 	"github.com/flightctl/flightctl/internal/tasks"
 	trustifyv2 "github.com/flightctl/flightctl/internal/trustify/v2"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/flightctl/flightctl/pkg/poll"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -78,7 +84,7 @@ func (s *Server) Run(ctx context.Context) error {
 	defer cancel()
 
 	processID := fmt.Sprintf("periodic-%s-%s", util.GetHostname(), uuid.New().String())
-	queuesProvider, err := queues.NewRedisProvider(ctx, s.log, processID, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password, queues.DefaultRetryConfig())
+	queuesProvider, err := queues.NewProvider(ctx, s.log, processID, s.cfg, queues.DefaultRetryConfig())
 	if err != nil {
 		return err
 	}
@@ -87,7 +93,7 @@ func (s *Server) Run(ctx context.Context) error {
 		queuesProvider.Wait()
 	}()
 
-	kvStore, err := kvstore.NewKVStore(ctx, s.log, s.cfg.KV.Hostname, s.cfg.KV.Port, s.cfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, s.log, s.cfg)
 	if err != nil {
 		return err
 	}
@@ -103,6 +109,9 @@ func (s *Server) Run(ctx context.Context) error {
 	if err = rendered.Bus.Initialize(ctx, kvStore, queuesProvider, time.Duration(s.cfg.Service.RenderedWaitTimeout), s.log); err != nil {
 		return err
 	}
+	if err = watch.Bus.Initialize(ctx, queuesProvider, s.log); err != nil {
+		return err
+	}
 
 	orgCache := cache.NewOrganizationTTL(cache.DefaultTTL)
 	orgCache.Start()
@@ -113,6 +122,8 @@ func (s *Server) Run(ctx context.Context) error {
 	resourceSyncStore := resourcesyncstore.NewResourceSyncStore(s.db, s.log.WithField("pkg", "resourcesync-store"))
 	catalogStore := catalogstore.NewCatalogStore(s.db, s.log.WithField("pkg", "catalog-store"))
 	deviceStore := devicestore.NewDeviceStore(s.db, s.log.WithField("pkg", "device-store"))
+	revocationStore := certificaterevocationstore.NewCertificateRevocationStore(s.db, s.log.WithField("pkg", "certificate-revocation-store"))
+	authProviderStore := authproviderstore.NewAuthProviderStore(s.db, s.log.WithField("pkg", "authprovider-store"))
 	eventStore := eventstore.NewEventStore(s.db, s.log.WithField("pkg", "event-store"))
 	checkpointStore := checkpointstore.NewCheckpointStore(s.db, s.log.WithField("pkg", "checkpoint-store"))
 	organizationStore := organizationstore.NewOrganizationStore(s.db)
@@ -124,9 +135,11 @@ func (s *Server) Run(ctx context.Context) error {
 
 	repositorySvc := repositoryservice.WrapWithTracing(repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, s.log))
 	fleetSvc := fleetservice.WrapWithTracing(fleetservice.NewServiceHandler(fleetStore, eventsSvc, s.log))
-	resourceSyncSvc := resourcesyncservice.WrapWithTracing(resourcesyncservice.NewServiceHandler(resourceSyncStore, catalogStore, fleetStore, eventsSvc, s.log))
+	resourceSyncSvc := resourcesyncservice.WrapWithTracing(resourcesyncservice.NewServiceHandler(resourceSyncStore, catalogStore, fleetStore, deviceStore, repositoryStore, authProviderStore, eventsSvc, nil, s.log))
 	catalogSvc := catalogservice.WrapWithTracing(catalogservice.NewServiceHandler(catalogStore, eventsSvc, s.log))
-	deviceSvc := deviceservice.WrapWithTracing(deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, eventsSvc, kvStore, "", s.log))
+	deviceSvc := deviceservice.WrapWithTracing(deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, revocationStore, eventsSvc, kvStore, "", s.log))
+	attestationSvc := deviceattestationservice.WrapWithTracing(deviceattestationservice.NewServiceHandler(deviceStore, fleetStore, kvStore, eventsSvc, s.log))
+	authProviderSvc := authproviderservice.WrapWithTracing(authproviderservice.NewServiceHandler(authProviderStore, eventsSvc, s.log))
 	eventSvc := eventservice.WrapWithTracing(eventservice.NewServiceHandler(eventStore, eventsSvc))
 	checkpointSvc := checkpointservice.WrapWithTracing(checkpointservice.NewServiceHandler(checkpointStore))
 	organizationSvc := organizationservice.WrapWithTracing(organizationservice.NewServiceHandler(organizationStore))
@@ -168,7 +181,7 @@ func (s *Server) Run(ctx context.Context) error {
 
 	// Initialize the task executors.
 	periodicTaskExecutors := InitializeTaskExecutors(s.log,
-		repositorySvc, fleetSvc, resourceSyncSvc, catalogSvc, deviceSvc, eventSvc,
+		repositorySvc, fleetSvc, resourceSyncSvc, catalogSvc, authProviderSvc, deviceSvc, attestationSvc, eventSvc,
 		checkpointSvc, organizationSvc, dependencyrefSvc, syncstateSvc,
 		s.cfg, queuesProvider, workerClient, nil, vulnerabilityFindingStore, vulnClient, depSyncMetrics)
 
@@ -185,6 +198,18 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 	defer channelManager.Close()
 
+	// Git push triggers received by the API server's webhook endpoint
+	gitPushSubscriber, err := gitwebhook.NewSubscriber(ctx, queuesProvider)
+	if err != nil {
+		return err
+	}
+	defer gitPushSubscriber.Close()
+	if err = gitPushSubscriber.Subscribe(ctx, func(ctx context.Context, trigger gitwebhook.Trigger) error {
+		return publishGitPushTasks(ctx, channelManager, trigger)
+	}); err != nil {
+		return err
+	}
+
 	// Periodic task consumer
 	consumerConfig := PeriodicTaskConsumerConfig{
 		ChannelManager: channelManager,
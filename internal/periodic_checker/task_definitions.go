@@ -13,10 +13,12 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/metrics/worker"
 	"github.com/flightctl/flightctl/internal/rollout/device_selection"
 	"github.com/flightctl/flightctl/internal/rollout/disruption_budget"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	checkpointservice "github.com/flightctl/flightctl/internal/service/checkpoint"
 	dependencyrefservice "github.com/flightctl/flightctl/internal/service/dependencyref"
 	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	deviceattestationservice "github.com/flightctl/flightctl/internal/service/deviceattestation"
 	eventservice "github.com/flightctl/flightctl/internal/service/event"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	organizationservice "github.com/flightctl/flightctl/internal/service/organization"
@@ -52,6 +54,7 @@ const (
 	PeriodicTaskTypeVulnerabilitySync      PeriodicTaskType = "vulnerability-sync"
 	PeriodicTaskTypeDependencySyncGit      PeriodicTaskType = "dependency-sync-git"
 	PeriodicTaskTypeDependencySyncHttp     PeriodicTaskType = "dependency-sync-http"
+	PeriodicTaskTypeAttestationExpiry      PeriodicTaskType = "attestation-expiry"
 )
 
 type PeriodicTaskMetadata struct {
@@ -70,6 +73,7 @@ var periodicTasks = map[PeriodicTaskType]PeriodicTaskMetadata{
 	PeriodicTaskTypeVulnerabilitySync:      {Interval: tasks.VulnerabilitySyncInterval, SystemWide: true},
 	PeriodicTaskTypeDependencySyncGit:      {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
 	PeriodicTaskTypeDependencySyncHttp:     {Interval: config.DefaultDependencySyncTaskInterval, SystemWide: false},
+	PeriodicTaskTypeAttestationExpiry:      {Interval: tasks.AttestationExpiryPollingInterval, SystemWide: false},
 }
 
 // MergeTasksWithConfig merges configured task intervals with defaults.
@@ -110,12 +114,23 @@ func MergeTasksWithConfig(cfg *config.Config) map[PeriodicTaskType]PeriodicTaskM
 type PeriodicTaskReference struct {
 	Type  PeriodicTaskType
 	OrgID uuid.UUID
+	// Repositories restricts the run to the named repositories, for executors that
+	// implement RepositoryScopedExecutor. It is set for runs triggered by a git push.
+	Repositories []string
+	// Revision is the pushed branch or tag when Repositories is set.
+	Revision string
 }
 
 type PeriodicTaskExecutor interface {
 	Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID)
 }
 
+// RepositoryScopedExecutor is implemented by executors that can restrict a run to the
+// repositories a git push was received for.
+type RepositoryScopedExecutor interface {
+	ExecuteForRepositories(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, repositories []string, revision string)
+}
+
 type RepositoryTesterExecutor struct {
 	log           logrus.FieldLogger
 	repositorySvc repositoryservice.Service
@@ -142,18 +157,30 @@ type ResourceSyncExecutor struct {
 	fleetSvc        fleetservice.Service
 	resourcesyncSvc resourcesyncservice.Service
 	catalogSvc      catalogservice.Service
+	authProviderSvc authproviderservice.Service
+	deviceSvc       deviceservice.Service
 	log             logrus.FieldLogger
 	cfg             *config.Config
 }
 
 func (e *ResourceSyncExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
 	taskCtx := createTaskContext(ctx, PeriodicTaskTypeResourceSync)
+	resourceSync := e.newResourceSync()
+	resourceSync.Poll(taskCtx, orgId)
+}
+
+func (e *ResourceSyncExecutor) ExecuteForRepositories(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, repositories []string, revision string) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeResourceSync)
+	resourceSync := e.newResourceSync()
+	resourceSync.SyncForRepositories(taskCtx, orgId, repositories, revision)
+}
+
+func (e *ResourceSyncExecutor) newResourceSync() *tasks.ResourceSync {
 	var ignoreResourceUpdates []string
 	if e.cfg != nil && e.cfg.GitOps != nil {
 		ignoreResourceUpdates = e.cfg.GitOps.IgnoreResourceUpdates
 	}
-	resourceSync := tasks.NewResourceSync(e.repositorySvc, e.fleetSvc, e.resourcesyncSvc, e.catalogSvc, e.log, e.cfg, ignoreResourceUpdates)
-	resourceSync.Poll(taskCtx, orgId)
+	return tasks.NewResourceSync(e.repositorySvc, e.fleetSvc, e.resourcesyncSvc, e.catalogSvc, e.authProviderSvc, e.deviceSvc, e.log, e.cfg, ignoreResourceUpdates)
 }
 
 type DeviceConnectionExecutor struct {
@@ -167,6 +194,17 @@ func (e *DeviceConnectionExecutor) Execute(ctx context.Context, log logrus.Field
 	deviceConnection.Poll(taskCtx, orgId)
 }
 
+type AttestationExpiryExecutor struct {
+	log            logrus.FieldLogger
+	attestationSvc deviceattestationservice.Service
+}
+
+func (e *AttestationExpiryExecutor) Execute(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeAttestationExpiry)
+	attestationExpiry := tasks.NewAttestationExpiry(e.log, e.attestationSvc)
+	attestationExpiry.Poll(taskCtx, orgId)
+}
+
 type RolloutDeviceSelectionExecutor struct {
 	deviceSvc deviceservice.Service
 	fleetSvc  fleetservice.Service
@@ -289,6 +327,12 @@ func (e *DependencySyncGitExecutor) Execute(ctx context.Context, log logrus.Fiel
 	depSync.Poll(taskCtx, orgId)
 }
 
+func (e *DependencySyncGitExecutor) ExecuteForRepositories(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, repositories []string, revision string) {
+	taskCtx := createTaskContext(ctx, PeriodicTaskTypeDependencySyncGit)
+	depSync := tasks.NewDependencySyncGit(e.log, e.dependencyrefSvc, e.eventSvc, e.syncstateSvc, e.cfg, e.metrics)
+	depSync.Refresh(taskCtx, orgId, repositories)
+}
+
 type DependencySyncHttpExecutor struct {
 	log              logrus.FieldLogger
 	dependencyrefSvc dependencyrefservice.Service
@@ -310,7 +354,9 @@ func InitializeTaskExecutors(
 	fleetSvc fleetservice.Service,
 	resourcesyncSvc resourcesyncservice.Service,
 	catalogSvc catalogservice.Service,
+	authProviderSvc authproviderservice.Service,
 	deviceSvc deviceservice.Service,
+	attestationSvc deviceattestationservice.Service,
 	eventSvc eventservice.Service,
 	checkpointSvc checkpointservice.Service,
 	organizationSvc organizationservice.Service,
@@ -334,6 +380,8 @@ func InitializeTaskExecutors(
 			fleetSvc:        fleetSvc,
 			resourcesyncSvc: resourcesyncSvc,
 			catalogSvc:      catalogSvc,
+			authProviderSvc: authProviderSvc,
+			deviceSvc:       deviceSvc,
 			log:             log.WithField("pkg", "resource-sync"),
 			cfg:             cfg,
 		},
@@ -341,6 +389,10 @@ func InitializeTaskExecutors(
 			log:       log.WithField("pkg", "device-connection"),
 			deviceSvc: deviceSvc,
 		},
+		PeriodicTaskTypeAttestationExpiry: &AttestationExpiryExecutor{
+			log:            log.WithField("pkg", "attestation-expiry"),
+			attestationSvc: attestationSvc,
+		},
 		PeriodicTaskTypeRolloutDeviceSelection: &RolloutDeviceSelectionExecutor{
 			deviceSvc: deviceSvc,
 			fleetSvc:  fleetSvc,
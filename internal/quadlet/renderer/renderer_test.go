@@ -147,6 +147,25 @@ func TestProcessInstallManifest(t *testing.T) {
 				verifyFileMode(t, destPath, RegularFileMode)
 			},
 		},
+		{
+			name: "copy templated file using template functions",
+			setup: func(t *testing.T, sourceDir string, config *RendererConfig) []InstallAction {
+				srcFile := createTempFile(t, sourceDir, "functions.txt", "Image: {{ .Api.Image | upper }}\nTag: {{ default \"latest\" .Api.Tag | b64enc }}")
+				return []InstallAction{
+					{
+						Action:      ActionCopyFile,
+						Source:      srcFile,
+						Destination: filepath.Join(config.QuadletFilesOutputDir, "functions.txt"),
+						Template:    true,
+						Mode:        RegularFileMode,
+					},
+				}
+			},
+			verify: func(t *testing.T, config *RendererConfig) {
+				destPath := filepath.Join(config.QuadletFilesOutputDir, "functions.txt")
+				verifyFileContent(t, destPath, "Image: TEST-API-IMAGE\nTag: djEuMA==")
+			},
+		},
 		{
 			name: "copy file with executable permissions",
 			setup: func(t *testing.T, sourceDir string, config *RendererConfig) []InstallAction {
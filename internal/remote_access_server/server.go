@@ -21,6 +21,7 @@ import (
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service"
 	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
+	servicecommon "github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
@@ -59,6 +60,9 @@ type storeAppConsoleService struct {
 
 func (s *storeAppConsoleService) GetDevice(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
 	result, err := s.deviceStore.Get(ctx, orgId, name)
+	if err == nil && !servicecommon.DeviceInAccessScope(ctx, result) {
+		return nil, servicecommon.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 	return result, service.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
@@ -1142,11 +1142,6 @@ func (k *KubernetesRestoreDeployer) GetConfig(ctx context.Context) (*config.Conf
 		return nil, fmt.Errorf("failed to read DB password: %w", err)
 	}
 
-	kvPassword, err := k.getSecretStringValue(ctx, k.internalNamespace, "flightctl-kv-secret", "password")
-	if err != nil {
-		return nil, fmt.Errorf("failed to read KV password: %w", err)
-	}
-
 	// Read actual database hostname from api config (handles both internal and external DB)
 	apiConfigMap, err := k.clientset.CoreV1().ConfigMaps(k.namespace).Get(ctx, "flightctl-api-config", metav1.GetOptions{})
 	if err != nil {
@@ -1166,7 +1161,15 @@ func (k *KubernetesRestoreDeployer) GetConfig(ctx context.Context) (*config.Conf
 	// Override with credentials from secrets (config may have placeholders)
 	cfg.Database.User = dbUser
 	cfg.Database.Password = api.SecureString(dbPassword)
-	cfg.KV.Password = api.SecureString(kvPassword)
+
+	// There is no KV secret when the PostgreSQL queues provider keeps the KV store in the database
+	if cfg.QueuesProvider() == config.QueuesProviderRedis {
+		kvPassword, err := k.getSecretStringValue(ctx, k.internalNamespace, "flightctl-kv-secret", "password")
+		if err != nil {
+			return nil, fmt.Errorf("failed to read KV password: %w", err)
+		}
+		cfg.KV.Password = api.SecureString(kvPassword)
+	}
 
 	k.cachedCfg = cfg
 	return cfg, nil
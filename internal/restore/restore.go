@@ -6,6 +6,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/sirupsen/logrus"
@@ -26,7 +27,7 @@ const startServicesTimeout = 2 * time.Minute
 //  8. Restores encryption keys (deployer.RestoreEncryptionKeys)
 //  9. Restores service configuration (deployer.RestoreConfig)
 //  10. Retrieves service credentials via deployer.GetConfig
-//  11. Exposes DB and KV via deployer.ExposeService (no-op for Podman, port-forward for Kubernetes)
+//  11. Exposes DB and KV via deployer.ExposeService (no-op for Podman, port-forward for Kubernetes; KV only with Redis)
 //  12. Runs post-restoration device preparation (PrepareDevices)
 //  13. Starts FlightCtl services (deployer.StartServices) — deferred, always runs even on failure
 //
@@ -144,18 +145,21 @@ func Restore(
 		dbPort = int(cfg.Database.Port)
 	}
 
-	log.Info("Exposing KV store for post-restoration device preparation")
-	kvHost, kvPort, kvCleanup, err := deployer.ExposeService(ctx, "flightctl-kv")
-	if err != nil {
-		return fmt.Errorf("failed to expose KV service: %w", err)
-	}
-	defer kvCleanup()
-
 	exposedCfg := *cfg
 	exposedCfg.Database.Hostname = dbHost
 	exposedCfg.Database.Port = uint(dbPort)
-	exposedCfg.KV.Hostname = kvHost
-	exposedCfg.KV.Port = uint(kvPort)
+
+	// With the PostgreSQL queues provider the KV store lives in the database exposed above
+	if cfg.QueuesProvider() == config.QueuesProviderRedis {
+		log.Info("Exposing KV store for post-restoration device preparation")
+		kvHost, kvPort, kvCleanup, err := deployer.ExposeService(ctx, "flightctl-kv")
+		if err != nil {
+			return fmt.Errorf("failed to expose KV service: %w", err)
+		}
+		defer kvCleanup()
+		exposedCfg.KV.Hostname = kvHost
+		exposedCfg.KV.Port = uint(kvPort)
+	}
 
 	// For external database with TLS, prepare certificates.
 	// Kubernetes: extracts certs from ConfigMap/Secret to temporary files.
@@ -183,7 +187,7 @@ func Restore(
 		}
 	}()
 
-	kv, err := kvstore.NewKVStore(ctx, log, kvHost, uint(kvPort), cfg.KV.Password)
+	kv, err := kvstore.New(ctx, log, &exposedCfg)
 	if err != nil {
 		return fmt.Errorf("failed to initialize KV store connection: %w", err)
 	}
@@ -164,7 +164,62 @@ func (b *batchSequenceSelector) OnNewRollout(ctx context.Context) error {
 		domain.FleetAnnotationDeployingTemplateVersion:    b.templateVersionName,
 		domain.FleetAnnotationDeviceSelectionConfigDigest: batchSequenceDigest,
 	}
-	return common.ApiStatusToErr(b.fleetSvc.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, nil))
+	if previousTemplateVersion, exists := b.previousTemplateVersion(); exists {
+		annotations[domain.FleetAnnotationPreviousTemplateVersion] = previousTemplateVersion
+	}
+	return common.ApiStatusToErr(b.fleetSvc.UpdateFleetAnnotations(ctx, b.orgId, b.fleetName, annotations, []string{domain.FleetAnnotationRollback}))
+}
+
+// previousTemplateVersion returns the template version that devices are returned to if the new rollout fails.
+// It is the template version of the previous rollout, unless that rollout was itself rolled back or only the
+// rollout definition was updated.  In these cases the existing previous template version is kept.
+func (b *batchSequenceSelector) previousTemplateVersion() (string, bool) {
+	dtv, exists := b.fleet.GetAnnotation(domain.FleetAnnotationDeployingTemplateVersion)
+	if !exists || dtv == b.templateVersionName || b.IsRolledBack() {
+		return b.fleet.GetAnnotation(domain.FleetAnnotationPreviousTemplateVersion)
+	}
+	return dtv, true
+}
+
+func (b *batchSequenceSelector) IsRolledBack() bool {
+	_, exists := b.fleet.GetAnnotation(domain.FleetAnnotationRollback)
+	return exists
+}
+
+// ReconcileRollback marks the rollback of a failed rollout as completed once all the devices that were selected
+// for the rollback have completed their update to the previous template version
+func (b *batchSequenceSelector) ReconcileRollback(ctx context.Context) error {
+	rollback := b.fleet.GetRolloutRollback()
+	if rollback == nil || rollback.Completed {
+		return nil
+	}
+	counts, status := b.deviceSvc.GetDeviceCompletionCounts(ctx, b.orgId, util.ResourceOwner(domain.FleetKind, b.fleetName), rollback.ToTemplateVersion, &b.updateTimeout, nil)
+	if status.Code != http.StatusOK {
+		return common.ApiStatusToErr(status)
+	}
+	if !isCompleted(counts) {
+		return nil
+	}
+	b.log.Infof("%v/%s: Rollback to template version %s completed", b.orgId, b.fleetName, rollback.ToTemplateVersion)
+	if err := b.UnmarkRolloutSelection(ctx); err != nil {
+		return err
+	}
+	rollback.Completed = true
+	if err := setRollback(ctx, b.fleetSvc, b.orgId, b.fleetName, *rollback); err != nil {
+		return err
+	}
+	return newConditionEmitter(b.orgId, b.fleetName, rollback.Batch, b.fleetSvc).rolledBack(ctx, *rollback)
+}
+
+func setRollback(ctx context.Context, fleetSvc fleetservice.Service, orgId uuid.UUID, fleetName string, rollback domain.FleetRolloutRollbackStatus) error {
+	out, err := json.Marshal(&rollback)
+	if err != nil {
+		return fmt.Errorf("failed to marshal rollback status: %w", err)
+	}
+	annotations := map[string]string{
+		domain.FleetAnnotationRollback: string(out),
+	}
+	return common.ApiStatusToErr(fleetSvc.UpdateFleetAnnotations(ctx, orgId, fleetName, annotations, nil))
 }
 
 func (b *batchSequenceSelector) getCurrentBatch(ctx context.Context) (int, error) {
@@ -442,6 +497,61 @@ func (b *batchSelection) MayApproveAutomatically() (bool, error) {
 	return lastSuccessPercentage >= successThreshold, nil
 }
 
+// A batch is failed if its approval method is "automatic" and the success percentage of the previous batch
+// is lower than the success threshold
+func (b *batchSelection) IsFailed() (bool, error) {
+	if b.batchNum == -1 || !b.isApprovalMethodAutomatic() {
+		return false, nil
+	}
+	successThreshold, err := b.getSuccessThreshold()
+	if err != nil {
+		return false, err
+	}
+	lastSuccessPercentage, exists, err := b.getLastSuccessPercentage()
+	if err != nil || !exists {
+		return false, err
+	}
+	return lastSuccessPercentage < successThreshold, nil
+}
+
+// Rollback selects the devices that were already updated to the template version of the failed rollout, so they
+// are returned to the template version that was deployed before the rollout started.  It returns false if there
+// is no such template version.
+func (b *batchSelection) Rollback(ctx context.Context) (bool, error) {
+	previousTemplateVersion, exists := b.fleet.GetAnnotation(domain.FleetAnnotationPreviousTemplateVersion)
+	if !exists || previousTemplateVersion == b.templateVersionName {
+		return false, nil
+	}
+	report, exists, err := b.getLastCompletionReport()
+	if err != nil {
+		return false, fmt.Errorf("failed to get last completion report: %w", err)
+	}
+	if !exists {
+		return false, fmt.Errorf("last completion report doesn't exist")
+	}
+	b.log.Infof("%v/%s: Rolling back from template version %s to %s", b.orgId, b.fleetName, b.templateVersionName, previousTemplateVersion)
+	if err = b.unmark(ctx); err != nil {
+		return false, err
+	}
+	listParams, annotationSelector := newQuerySelectorParts().
+		withOwner(b.fleetName).
+		withRolledOut(b.templateVersionName).
+		listParams()
+	if err = common.ApiStatusToErr(b.deviceSvc.MarkDevicesRolloutSelection(ctx, b.orgId, listParams, annotationSelector, nil)); err != nil {
+		return false, err
+	}
+	rollback := domain.FleetRolloutRollbackStatus{
+		FromTemplateVersion: b.templateVersionName,
+		ToTemplateVersion:   previousTemplateVersion,
+		Batch:               report.BatchName,
+		StartTime:           time.Now().UTC(),
+	}
+	if err = setRollback(ctx, b.fleetSvc, b.orgId, b.fleetName, rollback); err != nil {
+		return false, err
+	}
+	return true, newConditionEmitter(b.orgId, b.fleetName, rollback.Batch, b.fleetSvc).rolledBack(ctx, rollback)
+}
+
 func (b *batchSelection) Approve(ctx context.Context) error {
 	b.log.Infof("%v/%s:In Approve", b.orgId, b.fleetName)
 	annotations := map[string]string{
@@ -451,9 +561,130 @@ func (b *batchSelection) Approve(ctx context.Context) error {
 }
 
 // A group of device is considered as completed successfully if the rendered template version is the same as the
-// template version of the fleet and same-rendered-version is true
+// template version of the fleet, same-rendered-version is true and the health criteria of the batch are met
 func (b *batchSelection) isUpdateCompletedSuccessfully(c domain.DeviceCompletionCount) bool {
-	return c.SameTemplateVersion && c.SameRenderedVersion
+	return c.SameTemplateVersion && c.SameRenderedVersion && b.isHealthy(c)
+}
+
+func (b *batchSelection) isUnhealthy(c domain.DeviceCompletionCount) bool {
+	return c.SameTemplateVersion && c.SameRenderedVersion && !b.isHealthy(c)
+}
+
+func (b *batchSelection) healthCriteria() *domain.BatchHealthCriteria {
+	if b.batch == nil {
+		return nil
+	}
+	return b.batch.HealthCriteria
+}
+
+// meetsHealthCriteria checks if the current status of a device meets the health criteria of the batch.  A device that
+// did not report an applications summary yet has no unhealthy applications.
+func (b *batchSelection) meetsHealthCriteria(applicationsStatus domain.ApplicationsSummaryStatusType, resourcesCritical bool) bool {
+	criteria := b.healthCriteria()
+	if criteria == nil {
+		return true
+	}
+	if lo.FromPtr(criteria.ApplicationsHealthy) &&
+		applicationsStatus != "" &&
+		applicationsStatus != domain.ApplicationsSummaryStatusHealthy &&
+		applicationsStatus != domain.ApplicationsSummaryStatusNoApplications {
+		return false
+	}
+	return !lo.FromPtr(criteria.ResourcesNotCritical) || !resourcesCritical
+}
+
+// isHealthy checks if a group of devices meets the health criteria of the batch, and has met them for the whole soak
+// duration of the batch
+func (b *batchSelection) isHealthy(c domain.DeviceCompletionCount) bool {
+	return b.meetsHealthCriteria(c.ApplicationsStatus, c.ResourcesCritical) && c.Soaked && c.HealthySoaked
+}
+
+// isSoaking checks if a group of devices completed the update, but either the soak duration of the batch has not passed
+// yet since the update, or the devices are healthy and have not been healthy for the whole soak duration yet
+func (b *batchSelection) isSoaking(c domain.DeviceCompletionCount) bool {
+	if !c.SameTemplateVersion || !c.SameRenderedVersion {
+		return false
+	}
+	return !c.Soaked || (b.meetsHealthCriteria(c.ApplicationsStatus, c.ResourcesCritical) && !c.HealthySoaked)
+}
+
+// trackHealth records the time at which each updated device of the batch was first observed to meet the health
+// criteria of the batch, and clears it once a device stops meeting them
+func (b *batchSelection) trackHealth(ctx context.Context) error {
+	listParams, annotationSelector := newQuerySelectorParts().
+		withOwner(b.fleetName).
+		withSelectedForRollout().
+		listParams()
+	now := time.Now().UTC().Format(time.RFC3339)
+	for {
+		devices, status := b.deviceSvc.ListDevices(ctx, b.orgId, listParams, annotationSelector)
+		if status.Code != http.StatusOK {
+			return common.ApiStatusToErr(status)
+		}
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			annotations := lo.FromPtr(device.Metadata.Annotations)
+			_, tracked := annotations[domain.DeviceAnnotationHealthySince]
+			healthy := b.isDeviceUpdated(device) && b.meetsHealthCriteria(device.Status.ApplicationsSummary.Status, isDeviceResourcesCritical(device))
+			switch {
+			case healthy && !tracked:
+				status = b.deviceSvc.UpdateDeviceAnnotations(ctx, b.orgId, lo.FromPtr(device.Metadata.Name), map[string]string{domain.DeviceAnnotationHealthySince: now}, nil)
+			case !healthy && tracked:
+				status = b.deviceSvc.UpdateDeviceAnnotations(ctx, b.orgId, lo.FromPtr(device.Metadata.Name), nil, []string{domain.DeviceAnnotationHealthySince})
+			default:
+				continue
+			}
+			if status.Code != http.StatusOK {
+				return fmt.Errorf("failed to update health tracking of device %s: %w", lo.FromPtr(device.Metadata.Name), common.ApiStatusToErr(status))
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+}
+
+// isDeviceUpdated checks if a device runs the rendered version of the template version of the rollout
+func (b *batchSelection) isDeviceUpdated(device *domain.Device) bool {
+	annotations := lo.FromPtr(device.Metadata.Annotations)
+	if annotations[domain.DeviceAnnotationRenderedTemplateVersion] != b.templateVersionName {
+		return false
+	}
+	renderedVersion, exists := annotations[domain.DeviceAnnotationRenderedVersion]
+	return exists && device.Status != nil && device.Status.Config.RenderedVersion == renderedVersion
+}
+
+func isDeviceResourcesCritical(device *domain.Device) bool {
+	if device.Status == nil {
+		return false
+	}
+	return lo.Contains([]domain.DeviceResourceStatusType{
+		device.Status.Resources.Cpu,
+		device.Status.Resources.Memory,
+		device.Status.Resources.Disk,
+	}, domain.DeviceResourceStatusCritical)
+}
+
+func (b *batchSelection) soakDuration() *time.Duration {
+	criteria := b.healthCriteria()
+	if criteria == nil || criteria.SoakDuration == nil {
+		return nil
+	}
+	soakDuration, err := time.ParseDuration(*criteria.SoakDuration)
+	if err != nil {
+		b.log.WithError(err).Warnf("%v/%s: invalid soak duration %q, ignoring", b.orgId, b.fleetName, *criteria.SoakDuration)
+		return nil
+	}
+	return &soakDuration
+}
+
+func (b *batchSelection) completionCounts(ctx context.Context) ([]domain.DeviceCompletionCount, error) {
+	counts, status := b.deviceSvc.GetDeviceCompletionCounts(ctx, b.orgId, util.ResourceOwner(domain.FleetKind, b.fleetName), b.templateVersionName, &b.updateTimeout, b.soakDuration())
+	if status.Code != http.StatusOK {
+		return nil, common.ApiStatusToErr(status)
+	}
+	return counts, nil
 }
 
 func (b *batchSelection) isFailed(c domain.DeviceCompletionCount) bool {
@@ -464,13 +695,24 @@ func (b *batchSelection) isTimedOut(c domain.DeviceCompletionCount) bool {
 	return c.SameTemplateVersion && c.UpdateTimedOut
 }
 
-// IsComplete checks is the total number of devices in a batch is the same as the number of completed
+// IsComplete checks is the total number of devices in a batch is the same as the number of completed, and that
+// none of the updated devices is still within the soak duration of the batch
 func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
-	counts, status := b.deviceSvc.GetDeviceCompletionCounts(ctx, b.orgId, util.ResourceOwner(domain.FleetKind, b.fleetName), b.templateVersionName, &b.updateTimeout)
-	if status.Code != http.StatusOK {
-		return false, common.ApiStatusToErr(status)
+	if b.soakDuration() != nil {
+		if err := b.trackHealth(ctx); err != nil {
+			return false, err
+		}
+	}
+	counts, err := b.completionCounts(ctx)
+	if err != nil {
+		return false, err
 	}
+	return isCompleted(counts) && !lo.ContainsBy(counts, b.isSoaking), nil
+}
 
+// isCompleted checks if all the devices selected for rollout have completed their update, either successfully
+// or not
+func isCompleted(counts []domain.DeviceCompletionCount) bool {
 	// A device is counted in total if it has completed successfully, or it is in update.
 	total := lo.Sum(lo.Map(counts, func(c domain.DeviceCompletionCount, _ int) int64 {
 		return c.Count
@@ -478,9 +720,9 @@ func (b *batchSelection) IsComplete(ctx context.Context) (bool, error) {
 
 	// A device is counted as completed if it has completed successfully or, it is in error state or its update is timed out
 	complete := lo.Sum(lo.Map(counts, func(c domain.DeviceCompletionCount, _ int) int64 {
-		return lo.Ternary(b.isUpdateCompletedSuccessfully(c) || c.SameTemplateVersion && (c.UpdatingReason == domain.UpdateStateError || c.UpdateTimedOut), c.Count, 0)
+		return lo.Ternary(c.SameTemplateVersion && (c.SameRenderedVersion || c.UpdatingReason == domain.UpdateStateError || c.UpdateTimedOut), c.Count, 0)
 	}))
-	return total == complete, nil
+	return total == complete
 }
 
 func (b *batchSelection) completionReport(counts []domain.DeviceCompletionCount) domain.RolloutBatchCompletionReport {
@@ -492,6 +734,8 @@ func (b *batchSelection) completionReport(counts []domain.DeviceCompletionCount)
 		ret.Total += c.Count
 		if b.isUpdateCompletedSuccessfully(c) {
 			ret.Successful += c.Count
+		} else if b.isUnhealthy(c) {
+			ret.Unhealthy += c.Count
 		} else if b.isFailed(c) {
 			ret.Failed += c.Count
 		} else if b.isTimedOut(c) {
@@ -506,9 +750,9 @@ func (b *batchSelection) completionReport(counts []domain.DeviceCompletionCount)
 }
 
 func (b *batchSelection) SetCompletionReport(ctx context.Context) error {
-	counts, status := b.deviceSvc.GetDeviceCompletionCounts(ctx, b.orgId, util.ResourceOwner(domain.FleetKind, b.fleetName), b.templateVersionName, &b.updateTimeout)
-	if status.Code != http.StatusOK {
-		return common.ApiStatusToErr(status)
+	counts, err := b.completionCounts(ctx)
+	if err != nil {
+		return err
 	}
 
 	report := b.completionReport(counts)
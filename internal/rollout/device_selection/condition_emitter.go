@@ -3,6 +3,7 @@ package device_selection
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service/common"
@@ -59,8 +60,8 @@ func (c *conditionEmitter) suspended(ctx context.Context, threshold int, complet
 	return c.save(ctx, c.create(
 		domain.ConditionStatusFalse,
 		domain.RolloutSuspendedReason,
-		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d",
-			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut),
+		fmt.Sprintf("%s failed: %d%% of batch devices were updated successfully, while success threshold was set to %d%%; Breakdown: total=%d successful=%d failed=%d timed out=%d unhealthy=%d",
+			completionReport.BatchName, completionReport.SuccessPercentage, threshold, completionReport.Total, completionReport.Successful, completionReport.Failed, completionReport.TimedOut, completionReport.Unhealthy),
 	))
 }
 
@@ -71,3 +72,27 @@ func (c *conditionEmitter) waiting(ctx context.Context) error {
 		fmt.Sprintf("Waiting for %s to be approved", c.batchName),
 	))
 }
+
+func (c *conditionEmitter) outsideMaintenanceWindow(ctx context.Context, nextWindow *time.Time) error {
+	message := "Waiting for a maintenance window to start the next batch, but no maintenance window is scheduled"
+	if nextWindow != nil {
+		message = fmt.Sprintf("Waiting for the maintenance window at %s to start the next batch", nextWindow.UTC().Format(time.RFC3339))
+	}
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutOutsideMaintenanceWindowReason,
+		message,
+	))
+}
+
+func (c *conditionEmitter) rolledBack(ctx context.Context, rollback domain.FleetRolloutRollbackStatus) error {
+	message := fmt.Sprintf("%s failed: rolling back from template version %s to %s", rollback.Batch, rollback.FromTemplateVersion, rollback.ToTemplateVersion)
+	if rollback.Completed {
+		message = fmt.Sprintf("%s failed: rolled back from template version %s to %s", rollback.Batch, rollback.FromTemplateVersion, rollback.ToTemplateVersion)
+	}
+	return c.save(ctx, c.create(
+		domain.ConditionStatusFalse,
+		domain.RolloutRolledBackReason,
+		message,
+	))
+}
@@ -24,6 +24,8 @@ type RolloutDeviceSelector interface {
 	IsDefinitionUpdated() (bool, error)
 	OnNewRollout(ctx context.Context) error
 	UnmarkRolloutSelection(ctx context.Context) error
+	IsRolledBack() bool
+	ReconcileRollback(ctx context.Context) error
 }
 
 type Selection interface {
@@ -32,6 +34,8 @@ type Selection interface {
 	IsApproved() bool
 	IsRolledOut(ctx context.Context) (bool, error)
 	MayApproveAutomatically() (bool, error)
+	IsFailed() (bool, error)
+	Rollback(ctx context.Context) (bool, error)
 	IsComplete(ctx context.Context) (bool, error)
 	SetCompletionReport(ctx context.Context) error
 	OnRollout(ctx context.Context) error
@@ -81,6 +85,9 @@ func cleanupRollout(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet, d
 		domain.FleetAnnotationRolloutApprovalMethod,
 		domain.FleetAnnotationDeployingTemplateVersion,
 		domain.FleetAnnotationDeviceSelectionConfigDigest,
+		domain.FleetAnnotationPreviousTemplateVersion,
+		domain.FleetAnnotationRollback,
+		domain.FleetAnnotationNextMaintenanceWindow,
 	}
 	if lo.NoneBy(annotationsToDelete, func(ann string) bool {
 		return lo.HasKey(lo.CoalesceMapOrEmpty(lo.FromPtr(fleet.Metadata.Annotations)), ann)
@@ -0,0 +1,115 @@
+package device_selection
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/robfig/cron/v3"
+	"github.com/samber/lo"
+)
+
+const (
+	dateLayout = "2006-01-02"
+
+	// The maximum number of consecutive window openings that are skipped due to blackout dates when looking for the
+	// next maintenance window
+	maxBlackoutSkips = 1000
+)
+
+type maintenanceWindow struct {
+	schedule cron.Schedule
+	duration time.Duration
+}
+
+// maintenanceSchedule determines when the batches of a rollout may be started according to the maintenance definition
+// of the rollout policy
+type maintenanceSchedule struct {
+	location      *time.Location
+	windows       []maintenanceWindow
+	blackoutDates map[string]struct{}
+}
+
+func newMaintenanceSchedule(maintenance *domain.RolloutMaintenance) (*maintenanceSchedule, error) {
+	location, err := time.LoadLocation(lo.FromPtrOr(maintenance.TimeZone, "Local"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid maintenance time zone: %w", err)
+	}
+	// allow only the standard 5 input cron syntax e.g. "* * * * *"
+	parser := cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+	ret := &maintenanceSchedule{
+		location:      location,
+		blackoutDates: make(map[string]struct{}),
+	}
+	for _, w := range maintenance.Windows {
+		schedule, err := parser.Parse(w.At)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window schedule %q: %w", w.At, err)
+		}
+		duration, err := time.ParseDuration(w.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("invalid maintenance window duration %q: %w", w.Duration, err)
+		}
+		ret.windows = append(ret.windows, maintenanceWindow{schedule: schedule, duration: duration})
+	}
+	for _, d := range lo.FromPtr(maintenance.BlackoutDates) {
+		ret.blackoutDates[d.Format(dateLayout)] = struct{}{}
+	}
+	return ret, nil
+}
+
+func (m *maintenanceSchedule) isBlackout(t time.Time) bool {
+	_, exists := m.blackoutDates[t.In(m.location).Format(dateLayout)]
+	return exists
+}
+
+// isOpen checks if a batch may be started at the given time
+func (m *maintenanceSchedule) isOpen(t time.Time) bool {
+	t = t.In(m.location)
+	if m.isBlackout(t) {
+		return false
+	}
+	for _, w := range m.windows {
+		// The window is open if it opened within the last window duration
+		if !w.schedule.Next(t.Add(-w.duration)).After(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// nextOpening returns the first time after the given time at which a batch may be started
+func (m *maintenanceSchedule) nextOpening(t time.Time) (time.Time, bool) {
+	t = t.In(m.location)
+	var ret time.Time
+	for _, w := range m.windows {
+		start := t
+		for i := 0; i != maxBlackoutSkips; i++ {
+			start = w.schedule.Next(start)
+			if start.IsZero() {
+				break
+			}
+			if !m.isBlackout(start) {
+				if ret.IsZero() || start.Before(ret) {
+					ret = start
+				}
+				break
+			}
+		}
+	}
+
+	// A window that is open during a blackout date may still be open when the blackout date ends
+	if m.isBlackout(t) {
+		day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, m.location)
+		for i := 0; i != maxBlackoutSkips; i++ {
+			day = day.AddDate(0, 0, 1)
+			if !m.isBlackout(day) {
+				break
+			}
+		}
+		if m.isOpen(day) && (ret.IsZero() || day.Before(ret)) {
+			ret = day
+		}
+	}
+	return ret, !ret.IsZero()
+}
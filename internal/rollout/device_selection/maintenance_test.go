@@ -0,0 +1,79 @@
+package device_selection
+
+import (
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	openapi_types "github.com/oapi-codegen/runtime/types"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaintenanceSchedule(t *testing.T) {
+	date := func(s string) openapi_types.Date {
+		d, err := time.Parse(dateLayout, s)
+		require.NoError(t, err)
+		return openapi_types.Date{Time: d}
+	}
+	at := func(s string) time.Time {
+		ret, err := time.Parse(time.RFC3339, s)
+		require.NoError(t, err)
+		return ret
+	}
+
+	// Every day from 02:00 to 04:00 in UTC+2, except on 2026-05-02
+	maintenance := &domain.RolloutMaintenance{
+		TimeZone:      lo.ToPtr("Etc/GMT-2"),
+		Windows:       []domain.RolloutMaintenanceWindow{{At: "0 2 * * *", Duration: "2h"}},
+		BlackoutDates: &[]openapi_types.Date{date("2026-05-02")},
+	}
+	schedule, err := newMaintenanceSchedule(maintenance)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		now          string
+		expectedOpen bool
+		expectedNext string
+	}{
+		{"before window", "2026-05-01T10:00:00+02:00", false, "2026-05-03T02:00:00+02:00"},
+		{"window start", "2026-05-01T02:00:00+02:00", true, ""},
+		{"inside window", "2026-05-01T03:59:00+02:00", true, ""},
+		{"window end", "2026-05-01T04:00:00+02:00", false, "2026-05-03T02:00:00+02:00"},
+		{"inside window on blackout date", "2026-05-02T03:00:00+02:00", false, "2026-05-03T02:00:00+02:00"},
+		{"before window other time zone", "2026-05-02T23:30:00Z", false, "2026-05-03T02:00:00+02:00"},
+		{"inside window other time zone", "2026-05-03T00:30:00-00:30", true, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := at(tt.now)
+			require.Equal(t, tt.expectedOpen, schedule.isOpen(now))
+			if tt.expectedOpen {
+				return
+			}
+			next, found := schedule.nextOpening(now)
+			require.True(t, found)
+			require.True(t, at(tt.expectedNext).Equal(next), "expected %s, got %s", tt.expectedNext, next)
+			require.True(t, schedule.isOpen(next))
+		})
+	}
+}
+
+func TestMaintenanceScheduleBlackoutEndsInsideWindow(t *testing.T) {
+	// A window from 22:00 to 04:00 in UTC, with a blackout date that ends while the window is open
+	maintenance := &domain.RolloutMaintenance{
+		TimeZone:      lo.ToPtr("UTC"),
+		Windows:       []domain.RolloutMaintenanceWindow{{At: "0 22 * * *", Duration: "6h"}},
+		BlackoutDates: &[]openapi_types.Date{{Time: time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)}},
+	}
+	schedule, err := newMaintenanceSchedule(maintenance)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 5, 1, 23, 0, 0, 0, time.UTC)
+	require.False(t, schedule.isOpen(now))
+	next, found := schedule.nextOpening(now)
+	require.True(t, found)
+	require.Equal(t, time.Date(2026, 5, 2, 0, 0, 0, 0, time.UTC), next.UTC())
+	require.True(t, schedule.isOpen(next))
+}
@@ -2,7 +2,9 @@ package device_selection
 
 import (
 	"context"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service/common"
@@ -61,6 +63,88 @@ func (r *reconciler) emitFleetRolloutBatchDispatchedEvent(ctx context.Context, o
 	}
 }
 
+// rollbackOnFailure rolls back a rollout whose batch failed to reach its success threshold if the rollout policy
+// requires it
+func (r *reconciler) rollbackOnFailure(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet, templateVersionName string, selection Selection) {
+	fleetName := lo.FromPtr(fleet.Metadata.Name)
+	if lo.FromPtr(fleet.Spec.RolloutPolicy.OnFailure) != domain.RolloutFailureActionRollback {
+		return
+	}
+	failed, err := selection.IsFailed()
+	if err != nil {
+		r.log.WithError(err).Errorf("%v/%s: IsFailed", orgId, fleetName)
+		return
+	}
+	if !failed {
+		return
+	}
+	rolledBack, err := selection.Rollback(ctx)
+	if err != nil {
+		r.log.WithError(err).Errorf("%v/%s: Rollback", orgId, fleetName)
+		return
+	}
+	if !rolledBack && !wasSuspended(fleet) {
+		r.log.Warnf("%v/%s: No previous template version to roll back to", orgId, fleetName)
+		r.eventSvc.CreateEvent(ctx, orgId, common.GetFleetRolloutRollbackFailedEvent(ctx, fleetName, templateVersionName,
+			fmt.Sprintf("Fleet rollout of template version %s failed and could not be rolled back since there is no previous template version. The rollout was suspended.", templateVersionName)))
+	}
+}
+
+// inMaintenanceWindow checks if the next batch of the rollout may be started now.  If it may not, the time at which the
+// next maintenance window opens is recorded in the fleet, and the rollout condition is updated accordingly.
+func (r *reconciler) inMaintenanceWindow(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) (bool, error) {
+	fleetName := lo.FromPtr(fleet.Metadata.Name)
+	currentValue, waiting := fleet.GetAnnotation(domain.FleetAnnotationNextMaintenanceWindow)
+	stopWaiting := func() error {
+		if !waiting {
+			return nil
+		}
+		return common.ApiStatusToErr(r.fleetSvc.UpdateFleetAnnotations(ctx, orgId, fleetName, nil, []string{domain.FleetAnnotationNextMaintenanceWindow}))
+	}
+	maintenance := fleet.Spec.RolloutPolicy.Maintenance
+	if maintenance == nil {
+		return true, stopWaiting()
+	}
+	schedule, err := newMaintenanceSchedule(maintenance)
+	if err != nil {
+		return false, err
+	}
+	now := time.Now()
+	if schedule.isOpen(now) {
+		return true, stopWaiting()
+	}
+	var (
+		nextWindow *time.Time
+		newValue   string
+	)
+	if next, found := schedule.nextOpening(now); found {
+		nextWindow = &next
+		newValue = next.UTC().Format(time.RFC3339)
+	}
+	if waiting && currentValue == newValue {
+		return false, nil
+	}
+	r.log.Infof("%v/%s: Outside of maintenance window, next window at %s", orgId, fleetName, lo.Ternary(newValue != "", newValue, "<none>"))
+	if nextWindow != nil {
+		err = common.ApiStatusToErr(r.fleetSvc.UpdateFleetAnnotations(ctx, orgId, fleetName, map[string]string{domain.FleetAnnotationNextMaintenanceWindow: newValue}, nil))
+	} else {
+		err = stopWaiting()
+	}
+	if err != nil {
+		return false, err
+	}
+	return false, newConditionEmitter(orgId, fleetName, "", r.fleetSvc).outsideMaintenanceWindow(ctx, nextWindow)
+}
+
+// wasSuspended checks if the rollout was already suspended before the current reconciliation
+func wasSuspended(fleet domain.Fleet) bool {
+	if fleet.Status == nil {
+		return false
+	}
+	condition := domain.FindStatusCondition(fleet.Status.Conditions, domain.ConditionTypeFleetRolloutInProgress)
+	return condition != nil && condition.Reason == domain.RolloutSuspendedReason
+}
+
 func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet domain.Fleet) {
 	fleetName := lo.FromPtr(fleet.Metadata.Name)
 
@@ -110,6 +194,12 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			r.log.WithError(err).Errorf("%v/%s: Reset", orgId, fleetName)
 			return
 		}
+	} else if selector.IsRolledBack() {
+		// The rollout failed and was rolled back.  No more batches are rolled out until there is a new rollout
+		if err = selector.ReconcileRollback(ctx); err != nil {
+			r.log.WithError(err).Errorf("%v/%s: ReconcileRollback", orgId, fleetName)
+		}
+		return
 	}
 
 	for {
@@ -136,7 +226,9 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			} else {
 				if err = selection.OnSuspended(ctx); err != nil {
 					r.log.WithError(err).Errorf("%v/%s: OnSuspended", orgId, fleetName)
+					break
 				}
+				r.rollbackOnFailure(ctx, orgId, fleet, templateVersionName, selection)
 				break
 			}
 		}
@@ -182,6 +274,16 @@ func (r *reconciler) reconcileFleet(ctx context.Context, orgId uuid.UUID, fleet
 			break
 		}
 
+		// Batches may only be started within the maintenance windows of the rollout policy
+		inWindow, err := r.inMaintenanceWindow(ctx, orgId, fleet)
+		if err != nil {
+			r.log.WithError(err).Errorf("%v/%s: InMaintenanceWindow", orgId, fleetName)
+			break
+		}
+		if !inWindow {
+			break
+		}
+
 		// Proceed to the next batch
 		if err = selector.Advance(ctx); err != nil {
 			r.log.WithError(err).Errorf("%v/%s: Advance", orgId, fleetName)
@@ -9,6 +9,7 @@ import (
 	"github.com/flightctl/flightctl/internal/auth/provider"
 	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/service/events"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
@@ -299,7 +300,12 @@ func (h *ServiceHandler) PatchAuthProvider(ctx context.Context, orgId uuid.UUID,
 
 func (h *ServiceHandler) DeleteAuthProvider(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
 
-	err := h.store.Delete(ctx, orgId, name, h.callbackAuthProviderDeleted)
+	existing, err := h.store.Get(ctx, orgId, name)
+	if err == nil && existing.Metadata.Owner != nil && !common.IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err = h.store.Delete(ctx, orgId, name, h.callbackAuthProviderDeleted)
 	return common.StoreErrorToApiStatus(err, false, domain.AuthProviderKind, &name)
 }
 
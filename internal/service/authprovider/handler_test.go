@@ -17,6 +17,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 // adminCtx returns a context carrying a super-admin mapped identity, matching the fixture
@@ -127,6 +128,15 @@ func (f *fakeAuthProviderStore) Delete(ctx context.Context, orgId uuid.UUID, nam
 	return nil
 }
 
+func (f *fakeAuthProviderStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	for _, p := range f.providers {
+		if lo.FromPtr(p.Metadata.Owner) == owner {
+			p.Metadata.Owner = nil
+		}
+	}
+	return f.err
+}
+
 func (f *fakeAuthProviderStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.AuthProvider, eventCallback store.EventCallback) (*domain.AuthProvider, error) {
 	return resource, f.err
 }
@@ -467,6 +477,22 @@ func TestDeleteAuthProvider(t *testing.T) {
 	require.Len(t, fakeEvents.deleted, 1)
 }
 
+func TestDeleteOwnedAuthProvider(t *testing.T) {
+	h, fakeStore, _ := newTestHandler()
+	provider := testutil.ReturnTestAuthProvider(uuid.Nil, "p1", "", nil)
+	provider.Metadata.Owner = lo.ToPtr("ResourceSync/rs1")
+	fakeStore.providers["p1"] = &provider
+
+	status := h.DeleteAuthProvider(context.Background(), uuid.New(), "p1")
+	require.Equal(t, domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error()), status)
+	require.Contains(t, fakeStore.providers, "p1")
+
+	rsCtx := context.WithValue(context.Background(), consts.ResourceSyncRequestCtxKey, true)
+	status = h.DeleteAuthProvider(rsCtx, uuid.New(), "p1")
+	require.Equal(t, domain.StatusOK(), status)
+	require.NotContains(t, fakeStore.providers, "p1")
+}
+
 func TestGetAuthConfig(t *testing.T) {
 	h, _, _ := newTestHandler()
 	authConfig := &domain.AuthConfig{}
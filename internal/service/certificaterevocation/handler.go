@@ -0,0 +1,144 @@
+package certificaterevocation
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/service/common"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// DefaultRevocationReason is recorded when a revocation request does not give a reason.
+	DefaultRevocationReason = "Revoked by user"
+
+	maxReasonLength = 256
+
+	// crlCacheTTL bounds how often the CRL is re-signed; revocations become visible in the
+	// published CRL within this interval.
+	crlCacheTTL = 30 * time.Second
+	// crlValidity is the interval after which consumers must fetch a fresh CRL.
+	crlValidity = time.Hour
+)
+
+// nowFunc allows overriding for unit tests
+var nowFunc = time.Now
+
+type ServiceHandler struct {
+	store       certificaterevocationstore.Store
+	deviceStore devicestore.Store
+	ca          *crypto.CAClient
+	log         logrus.FieldLogger
+
+	crlMu        sync.Mutex
+	crl          []byte
+	crlIssuedAt  time.Time
+	crlIssuerKey string
+}
+
+// NewServiceHandler creates a new certificaterevocation ServiceHandler instance.
+// ca is only used to sign the published CRL; pass nil if a caller never needs it.
+func NewServiceHandler(store certificaterevocationstore.Store, deviceStore devicestore.Store, ca *crypto.CAClient, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{store: store, deviceStore: deviceStore, ca: ca, log: log}
+}
+
+var _ Service = (*ServiceHandler)(nil)
+
+func (h *ServiceHandler) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, request domain.DeviceCertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status) {
+	reason := lo.FromPtr(request.Reason)
+	if len(reason) > maxReasonLength {
+		return nil, domain.StatusBadRequest(fmt.Sprintf("reason must be at most %d characters", maxReasonLength))
+	}
+	if reason == "" {
+		reason = DefaultRevocationReason
+	}
+
+	if _, err := h.deviceStore.Get(ctx, orgId, name); err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	revocations, err := h.store.RevokeDeviceCertificates(ctx, orgId, name, reason)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	h.log.Infof("revoked certificates of device %s/%s: %s", orgId, name, reason)
+	list := model.CertificateRevocationsToApiResource(revocations)
+	return &list, domain.StatusOK()
+}
+
+func (h *ServiceHandler) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID) (*domain.CertificateRevocationList, domain.Status) {
+	revocations, err := h.store.List(ctx, orgId)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, "", nil)
+	}
+	list := model.CertificateRevocationsToApiResource(revocations)
+	return &list, domain.StatusOK()
+}
+
+func (h *ServiceHandler) IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (bool, domain.Status) {
+	revoked, err := h.store.IsRevoked(ctx, certificaterevocationstore.IssuerKeyID(cert), certificaterevocationstore.SerialNumber(cert))
+	if err != nil {
+		return false, common.StoreErrorToApiStatus(err, false, "", nil)
+	}
+	return revoked, domain.StatusOK()
+}
+
+// GetCertificateRevocationList returns a DER-encoded CRL of all unexpired revocations of
+// certificates issued by the service CA. The CRL is re-signed at most every crlCacheTTL.
+func (h *ServiceHandler) GetCertificateRevocationList(ctx context.Context) ([]byte, domain.Status) {
+	if h.ca == nil {
+		return nil, domain.StatusServiceUnavailable("certificate revocation list is not available")
+	}
+
+	h.crlMu.Lock()
+	defer h.crlMu.Unlock()
+
+	now := nowFunc()
+	issuerKeyID := h.ca.IssuerKeyID()
+	if h.crl != nil && h.crlIssuerKey == issuerKeyID && now.Sub(h.crlIssuedAt) < crlCacheTTL {
+		return h.crl, domain.StatusOK()
+	}
+
+	revocations, err := h.store.ListByIssuer(ctx, issuerKeyID)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, "", nil)
+	}
+	entries := make([]x509.RevocationListEntry, 0, len(revocations))
+	for _, r := range revocations {
+		serial, ok := new(big.Int).SetString(r.SerialNumber, 16)
+		if !ok {
+			h.log.Warnf("skipping revocation with invalid serial number %q", r.SerialNumber)
+			continue
+		}
+		entries = append(entries, x509.RevocationListEntry{
+			SerialNumber:   serial,
+			RevocationTime: r.RevokedAt,
+		})
+	}
+
+	// CRL numbers must increase monotonically; the issue time in nanoseconds does.
+	crl, err := h.ca.IssueRevocationList(ctx, entries, big.NewInt(now.UnixNano()), now.Add(crlValidity))
+	if err != nil {
+		if errors.Is(err, crypto.ErrCRLSigningNotPermitted) {
+			return nil, domain.StatusServiceUnavailable(fmt.Sprintf("%v: reissue it with \"flightctl-standalone ca enable-crl-signing\"", err))
+		}
+		h.log.Errorf("failed to issue certificate revocation list: %v", err)
+		return nil, domain.StatusInternalServerError("failed to issue certificate revocation list")
+	}
+	h.crl = crl
+	h.crlIssuedAt = now
+	h.crlIssuerKey = issuerKeyID
+	return crl, domain.StatusOK()
+}
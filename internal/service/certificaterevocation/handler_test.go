@@ -0,0 +1,146 @@
+package certificaterevocation
+
+import (
+	"context"
+	"crypto/x509"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config/ca"
+	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRevocationStore is a minimal in-memory stand-in for certificaterevocationstore.Store.
+type fakeRevocationStore struct {
+	revocations    []model.CertificateRevocation
+	revokeReason   string
+	listByIssuerN  int
+	listByIssuerID string
+}
+
+var _ certificaterevocationstore.Store = (*fakeRevocationStore)(nil)
+
+func (s *fakeRevocationStore) InitialMigration(ctx context.Context) error { return nil }
+
+func (s *fakeRevocationStore) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, deviceName string, reason string) ([]model.CertificateRevocation, error) {
+	s.revokeReason = reason
+	return s.revocations, nil
+}
+
+func (s *fakeRevocationStore) List(ctx context.Context, orgId uuid.UUID) ([]model.CertificateRevocation, error) {
+	return s.revocations, nil
+}
+
+func (s *fakeRevocationStore) ListByIssuer(ctx context.Context, issuerKeyID string) ([]model.CertificateRevocation, error) {
+	s.listByIssuerN++
+	s.listByIssuerID = issuerKeyID
+	return s.revocations, nil
+}
+
+func (s *fakeRevocationStore) IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error) {
+	for _, r := range s.revocations {
+		if r.IssuerKeyID == issuerKeyID && r.SerialNumber == serialNumber {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// fakeDeviceStore implements only the devicestore.Store method this package uses.
+type fakeDeviceStore struct {
+	devicestore.Store
+	names []string
+}
+
+func (s *fakeDeviceStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error) {
+	if lo.Contains(s.names, name) {
+		return &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name)}}, nil
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func TestRevokeDeviceCertificates(t *testing.T) {
+	revokedAt := time.Now().UTC()
+	store := &fakeRevocationStore{revocations: []model.CertificateRevocation{{
+		IssuerKeyID:  "aa",
+		SerialNumber: "1f",
+		DeviceName:   "dev1",
+		Reason:       "device stolen",
+		RevokedAt:    revokedAt,
+		ExpiresAt:    revokedAt.Add(time.Hour),
+	}}}
+	h := NewServiceHandler(store, &fakeDeviceStore{names: []string{"dev1"}}, nil, logrus.New())
+
+	t.Run("revokes with the given reason", func(t *testing.T) {
+		list, status := h.RevokeDeviceCertificates(context.Background(), uuid.New(), "dev1", domain.DeviceCertificateRevocationRequest{Reason: lo.ToPtr("device stolen")})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, "device stolen", store.revokeReason)
+		require.Equal(t, domain.CertificateRevocationListKind, list.Kind)
+		require.Len(t, list.Items, 1)
+		require.Equal(t, "1f", list.Items[0].SerialNumber)
+		require.Equal(t, "device stolen", lo.FromPtr(list.Items[0].Reason))
+	})
+
+	t.Run("defaults the reason", func(t *testing.T) {
+		_, status := h.RevokeDeviceCertificates(context.Background(), uuid.New(), "dev1", domain.DeviceCertificateRevocationRequest{})
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, DefaultRevocationReason, store.revokeReason)
+	})
+
+	t.Run("rejects an overlong reason", func(t *testing.T) {
+		_, status := h.RevokeDeviceCertificates(context.Background(), uuid.New(), "dev1", domain.DeviceCertificateRevocationRequest{Reason: lo.ToPtr(strings.Repeat("x", maxReasonLength+1))})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+
+	t.Run("returns not found for an unknown device", func(t *testing.T) {
+		_, status := h.RevokeDeviceCertificates(context.Background(), uuid.New(), "missing", domain.DeviceCertificateRevocationRequest{})
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+	})
+}
+
+func TestGetCertificateRevocationList(t *testing.T) {
+	caClient, _, err := crypto.EnsureCA(ca.NewDefault(t.TempDir()))
+	require.NoError(t, err)
+	caCert := caClient.GetCABundleX509()[0]
+
+	revokedAt := time.Now().UTC().Truncate(time.Second)
+	store := &fakeRevocationStore{revocations: []model.CertificateRevocation{
+		{IssuerKeyID: caClient.IssuerKeyID(), SerialNumber: "1f", RevokedAt: revokedAt, ExpiresAt: revokedAt.Add(time.Hour)},
+		{IssuerKeyID: caClient.IssuerKeyID(), SerialNumber: "not-hex", RevokedAt: revokedAt, ExpiresAt: revokedAt.Add(time.Hour)},
+	}}
+	h := NewServiceHandler(store, &fakeDeviceStore{}, caClient, logrus.New())
+
+	der, status := h.GetCertificateRevocationList(context.Background())
+	require.Equal(t, int32(http.StatusOK), status.Code)
+	require.Equal(t, caClient.IssuerKeyID(), store.listByIssuerID)
+
+	crl, err := x509.ParseRevocationList(der)
+	require.NoError(t, err)
+	require.NoError(t, crl.CheckSignatureFrom(caCert))
+	require.Len(t, crl.RevokedCertificateEntries, 1)
+	require.Equal(t, int64(0x1f), crl.RevokedCertificateEntries[0].SerialNumber.Int64())
+	require.True(t, crl.NextUpdate.After(time.Now()))
+
+	// a second request within the cache interval does not re-sign the list
+	again, status := h.GetCertificateRevocationList(context.Background())
+	require.Equal(t, int32(http.StatusOK), status.Code)
+	require.Equal(t, der, again)
+	require.Equal(t, 1, store.listByIssuerN)
+}
+
+func TestGetCertificateRevocationListWithoutCA(t *testing.T) {
+	h := NewServiceHandler(&fakeRevocationStore{}, &fakeDeviceStore{}, nil, logrus.New())
+	_, status := h.GetCertificateRevocationList(context.Background())
+	require.Equal(t, int32(http.StatusServiceUnavailable), status.Code)
+}
@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock.go -package=certificaterevocation
+//
+
+// Package certificaterevocation is a generated GoMock package.
+package certificaterevocation
+
+import (
+	context "context"
+	x509 "crypto/x509"
+	reflect "reflect"
+
+	domain "github.com/flightctl/flightctl/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// GetCertificateRevocationList mocks base method.
+func (m *MockService) GetCertificateRevocationList(ctx context.Context) ([]byte, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCertificateRevocationList", ctx)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetCertificateRevocationList indicates an expected call of GetCertificateRevocationList.
+func (mr *MockServiceMockRecorder) GetCertificateRevocationList(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCertificateRevocationList", reflect.TypeOf((*MockService)(nil).GetCertificateRevocationList), ctx)
+}
+
+// IsCertificateRevoked mocks base method.
+func (m *MockService) IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (bool, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsCertificateRevoked", ctx, cert)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// IsCertificateRevoked indicates an expected call of IsCertificateRevoked.
+func (mr *MockServiceMockRecorder) IsCertificateRevoked(ctx, cert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsCertificateRevoked", reflect.TypeOf((*MockService)(nil).IsCertificateRevoked), ctx, cert)
+}
+
+// ListCertificateRevocations mocks base method.
+func (m *MockService) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID) (*domain.CertificateRevocationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCertificateRevocations", ctx, orgId)
+	ret0, _ := ret[0].(*domain.CertificateRevocationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// ListCertificateRevocations indicates an expected call of ListCertificateRevocations.
+func (mr *MockServiceMockRecorder) ListCertificateRevocations(ctx, orgId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCertificateRevocations", reflect.TypeOf((*MockService)(nil).ListCertificateRevocations), ctx, orgId)
+}
+
+// RevokeDeviceCertificates mocks base method.
+func (m *MockService) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, request domain.DeviceCertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeDeviceCertificates", ctx, orgId, name, request)
+	ret0, _ := ret[0].(*domain.CertificateRevocationList)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// RevokeDeviceCertificates indicates an expected call of RevokeDeviceCertificates.
+func (mr *MockServiceMockRecorder) RevokeDeviceCertificates(ctx, orgId, name, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeDeviceCertificates", reflect.TypeOf((*MockService)(nil).RevokeDeviceCertificates), ctx, orgId, name, request)
+}
@@ -0,0 +1,16 @@
+package certificaterevocation
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, request domain.DeviceCertificateRevocationRequest) (*domain.CertificateRevocationList, domain.Status)
+	ListCertificateRevocations(ctx context.Context, orgId uuid.UUID) (*domain.CertificateRevocationList, domain.Status)
+	IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (bool, domain.Status)
+	GetCertificateRevocationList(ctx context.Context) ([]byte, domain.Status)
+}
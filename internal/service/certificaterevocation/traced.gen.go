@@ -0,0 +1,78 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/service-tracing
+// gowrap: http://github.com/hexdigest/gowrap
+
+package certificaterevocation
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedService wraps a Service implementation with OpenTelemetry tracing.
+type TracedService struct {
+	inner Service
+}
+
+// WrapWithTracing returns a Service that wraps inner with tracing spans, or nil if inner is nil.
+func WrapWithTracing(inner Service) Service {
+	if inner == nil {
+		return nil
+	}
+	return &TracedService{inner: inner}
+}
+
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "flightctl/service/certificaterevocation", method)
+}
+
+func endSpan(span trace.Span, st domain.Status) {
+	span.SetAttributes(attribute.Int("status.code", int(st.Code)))
+
+	if st.Status != "Success" {
+		span.RecordError(errors.New(st.Message))
+		span.SetStatus(codes.Error, st.Message)
+	}
+
+	span.End()
+}
+
+func (_d *TracedService) GetCertificateRevocationList(ctx context.Context) (ba1 []byte, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetCertificateRevocationList")
+
+	ba1, s1 = _d.inner.GetCertificateRevocationList(ctx)
+	endSpan(span, s1)
+	return ba1, s1
+}
+
+func (_d *TracedService) IsCertificateRevoked(ctx context.Context, cert *x509.Certificate) (b1 bool, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "IsCertificateRevoked")
+
+	b1, s1 = _d.inner.IsCertificateRevoked(ctx, cert)
+	endSpan(span, s1)
+	return b1, s1
+}
+
+func (_d *TracedService) ListCertificateRevocations(ctx context.Context, orgId uuid.UUID) (cp1 *domain.CertificateRevocationList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ListCertificateRevocations")
+
+	cp1, s1 = _d.inner.ListCertificateRevocations(ctx, orgId)
+	endSpan(span, s1)
+	return cp1, s1
+}
+
+func (_d *TracedService) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, name string, request domain.DeviceCertificateRevocationRequest) (cp1 *domain.CertificateRevocationList, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "RevokeDeviceCertificates")
+
+	cp1, s1 = _d.inner.RevokeDeviceCertificates(ctx, orgId, name, request)
+	endSpan(span, s1)
+	return cp1, s1
+}
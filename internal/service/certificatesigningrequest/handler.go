@@ -406,6 +406,7 @@ func (h *ServiceHandler) UpdateCertificateSigningRequestApproval(ctx context.Con
 	newConditions := newCSR.Status.Conditions
 
 	// Updating the approval should only update the conditions.
+	newCSR.Metadata.Owner = oldCSR.Metadata.Owner
 	newCSR.Spec = oldCSR.Spec
 	newCSR.Status = oldCSR.Status
 	newCSR.Status.Conditions = newConditions
@@ -441,6 +442,10 @@ func newSignRequestFromCertificateSigningRequest(csr *domain.CertificateSigningR
 		opts = append(opts, signer.WithResourceName(*csr.Metadata.Name))
 	}
 
+	if csr.Metadata.Owner != nil {
+		opts = append(opts, signer.WithOwner(*csr.Metadata.Owner))
+	}
+
 	signReq, err := signer.NewSignRequestFromBytes(csr.Spec.SignerName, csrData, opts...)
 	return signReq, isTPM, err
 }
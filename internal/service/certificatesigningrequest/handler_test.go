@@ -13,12 +13,14 @@ import (
 	cacfg "github.com/flightctl/flightctl/internal/config/ca"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/crypto"
+	"github.com/flightctl/flightctl/internal/crypto/signer"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
 	enrollmentrequeststore "github.com/flightctl/flightctl/internal/store/enrollmentrequest"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
@@ -519,6 +521,50 @@ func TestUpdateCertificateSigningRequestApproval(t *testing.T) {
 	})
 }
 
+func TestUpdateCertificateSigningRequestApprovalDeviceWorkload(t *testing.T) {
+	approve := func(t *testing.T, owner *string) *domain.CertificateSigningRequest {
+		h, fakeStore, _, _, cfg := newTestHandler(t)
+		cn := "mqtt-client"
+		csr := domain.CertificateSigningRequest{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(cn), Owner: owner},
+			Spec:     domain.CertificateSigningRequestSpec{SignerName: cfg.DeviceWorkloadSignerName, Request: csrPEM(t, cn), Usages: validUsages()},
+			Status:   &domain.CertificateSigningRequestStatus{Conditions: []domain.Condition{}},
+		}
+		fakeStore.items[cn] = &csr
+
+		approval := csr
+		approval.Status = &domain.CertificateSigningRequestStatus{
+			Conditions: []domain.Condition{{
+				Type:   domain.ConditionTypeCertificateSigningRequestApproved,
+				Status: domain.ConditionStatusTrue,
+			}},
+		}
+
+		result, status := h.UpdateCertificateSigningRequestApproval(context.Background(), uuid.New(), cn, approval)
+		require.Equal(t, statusSuccessCode, status.Code)
+		return result
+	}
+
+	t.Run("When approving a request owned by a device it should issue a certificate bound to the device", func(t *testing.T) {
+		fingerprint := "abcdef0123456789abcdef"
+		result := approve(t, lo.ToPtr("Device/"+fingerprint))
+		require.NotNil(t, result.Status.Certificate)
+
+		cert, err := fccrypto.ParseCertificatePEM(*result.Status.Certificate)
+		require.NoError(t, err)
+		require.ElementsMatch(t, []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+		value, err := signer.GetDeviceFingerprintExtension(cert)
+		require.NoError(t, err)
+		require.Equal(t, fingerprint, value)
+	})
+
+	t.Run("When approving a request not owned by a device it should fail signing", func(t *testing.T) {
+		result := approve(t, nil)
+		require.Nil(t, result.Status.Certificate)
+		require.True(t, domain.IsStatusConditionTrue(result.Status.Conditions, domain.ConditionTypeCertificateSigningRequestFailed))
+	})
+}
+
 func TestVerifyTPMCSRRequest(t *testing.T) {
 	t.Run("When the owner is not a device it should mark TPM verification false", func(t *testing.T) {
 		h, _, _, _, _ := newTestHandler(t)
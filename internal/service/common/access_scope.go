@@ -0,0 +1,83 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/samber/lo"
+)
+
+// ApplyDeviceAccessScope limits the list to the devices in the request's access scope, if any.
+// Devices are in scope if their labels match one of the scope's selectors or if they are owned
+// by one of its fleets.
+func ApplyDeviceAccessScope(ctx context.Context, listParams *store.ListParams) error {
+	scope, ok := contextutil.GetAccessScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	owners := lo.Map(scope.Fleets, func(fleet string, _ int) string { return util.ResourceOwner(domain.FleetKind, fleet) })
+	return applyAccessScope(listParams, scope.LabelSelectors, "metadata.owner", owners)
+}
+
+// ApplyFleetAccessScope limits the list to the fleets in the request's access scope, if any.
+// Fleets are in scope if their labels match one of the scope's selectors or if they are listed in it.
+func ApplyFleetAccessScope(ctx context.Context, listParams *store.ListParams) error {
+	scope, ok := contextutil.GetAccessScopeFromContext(ctx)
+	if !ok {
+		return nil
+	}
+	return applyAccessScope(listParams, scope.LabelSelectors, "metadata.name", scope.Fleets)
+}
+
+func applyAccessScope(listParams *store.ListParams, labelSelectors []string, field string, values []string) error {
+	accessScope := &store.AccessScope{}
+	for _, ls := range labelSelectors {
+		labelSelector, err := selector.NewLabelSelector(ls)
+		if err != nil {
+			return fmt.Errorf("invalid access scope label selector %q: %w", ls, err)
+		}
+		accessScope.LabelSelectors = append(accessScope.LabelSelectors, labelSelector)
+	}
+	if len(values) > 0 {
+		fieldSelector, err := selector.NewFieldSelector(fmt.Sprintf("%s in (%s)", field, strings.Join(values, ",")))
+		if err != nil {
+			return fmt.Errorf("invalid access scope: %w", err)
+		}
+		accessScope.FieldSelectors = append(accessScope.FieldSelectors, fieldSelector)
+	}
+	listParams.AccessScope = accessScope
+	return nil
+}
+
+// DeviceInAccessScope returns true if the request may act on the device.
+func DeviceInAccessScope(ctx context.Context, device *domain.Device) bool {
+	scope, ok := contextutil.GetAccessScopeFromContext(ctx)
+	if !ok {
+		return true
+	}
+	ownerFleet := ""
+	if kind, name, err := util.GetResourceOwner(device.Metadata.Owner); err == nil && kind == domain.FleetKind {
+		ownerFleet = name
+	}
+	return scope.ContainsDevice(lo.FromPtr(device.Metadata.Labels), ownerFleet)
+}
+
+// FleetInAccessScope returns true if the request may act on the fleet.
+func FleetInAccessScope(ctx context.Context, fleet *domain.Fleet) bool {
+	scope, ok := contextutil.GetAccessScopeFromContext(ctx)
+	if !ok {
+		return true
+	}
+	return scope.ContainsFleet(lo.FromPtr(fleet.Metadata.Name), lo.FromPtr(fleet.Metadata.Labels))
+}
+
+// StatusOutOfAccessScope is returned when a request acts on a resource outside its access scope.
+func StatusOutOfAccessScope(kind string, name string) domain.Status {
+	return domain.StatusForbidden(fmt.Sprintf("%s %q is outside the scope of your role", kind, name))
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/identity"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyAccessScope(t *testing.T) {
+	ctx := contextutil.WithAccessScope(context.Background(), &identity.AccessScope{
+		LabelSelectors: []string{"site=paris", "tier in (edge, core)"},
+		Fleets:         []string{"lyon-a", "lyon-b"},
+	})
+
+	var deviceParams store.ListParams
+	require.NoError(t, ApplyDeviceAccessScope(ctx, &deviceParams))
+	require.NotNil(t, deviceParams.AccessScope)
+	require.Len(t, deviceParams.AccessScope.LabelSelectors, 2)
+	require.Len(t, deviceParams.AccessScope.FieldSelectors, 1)
+
+	var fleetParams store.ListParams
+	require.NoError(t, ApplyFleetAccessScope(ctx, &fleetParams))
+	require.Len(t, fleetParams.AccessScope.FieldSelectors, 1)
+
+	var unscopedParams store.ListParams
+	require.NoError(t, ApplyDeviceAccessScope(context.Background(), &unscopedParams))
+	require.Nil(t, unscopedParams.AccessScope)
+}
+
+func TestResourceInAccessScope(t *testing.T) {
+	ctx := contextutil.WithAccessScope(context.Background(), &identity.AccessScope{
+		LabelSelectors: []string{"site=paris"},
+		Fleets:         []string{"lyon"},
+	})
+
+	device := func(labels map[string]string, owner *string) *domain.Device {
+		return &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("d"), Labels: &labels, Owner: owner}}
+	}
+	require.True(t, DeviceInAccessScope(ctx, device(map[string]string{"site": "paris"}, nil)))
+	require.True(t, DeviceInAccessScope(ctx, device(map[string]string{"site": "lyon"}, lo.ToPtr("Fleet/lyon"))))
+	require.False(t, DeviceInAccessScope(ctx, device(map[string]string{"site": "lyon"}, lo.ToPtr("Fleet/other"))))
+	require.False(t, DeviceInAccessScope(ctx, device(nil, nil)))
+	require.True(t, DeviceInAccessScope(context.Background(), device(nil, nil)))
+
+	fleet := func(name string, labels map[string]string) *domain.Fleet {
+		return &domain.Fleet{Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Labels: &labels}}
+	}
+	require.True(t, FleetInAccessScope(ctx, fleet("lyon", nil)))
+	require.True(t, FleetInAccessScope(ctx, fleet("paris-fleet", map[string]string{"site": "paris"})))
+	require.False(t, FleetInAccessScope(ctx, fleet("other", map[string]string{"site": "nice"})))
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"context"
 	"fmt"
+	"maps"
 	"strings"
 	"time"
 
@@ -191,6 +192,13 @@ func updateServerSideLifecycleStatus(device *domain.Device) bool {
 			Status: domain.DeviceLifecycleStatusDecommissioning,
 		}
 	}
+
+	if condition.IsDecomInProgress() {
+		device.Status.Lifecycle = domain.DeviceLifecycleStatus{
+			Info:   lo.ToPtr(condition.Message),
+			Status: domain.DeviceLifecycleStatusDecommissioning,
+		}
+	}
 	return device.Status.Lifecycle.Status != lastLifecycleStatus && device.Status.Lifecycle.Info != lastLifecycleInfo
 }
 
@@ -316,9 +324,8 @@ func KeepDBDeviceStatus(device, dbDevice *domain.Device) {
 	if device.Status.ApplicationsSummary.Status == domain.ApplicationsSummaryStatusUnknown {
 		device.Status.ApplicationsSummary.Status = dbDevice.Status.ApplicationsSummary.Status
 	}
-	if device.Status.Integrity.Status == domain.DeviceIntegrityStatusUnknown {
-		device.Status.Integrity = dbDevice.Status.Integrity
-	}
+	// the integrity is owned by the service: it is set at enrollment and by attestations
+	device.Status.Integrity = dbDevice.Status.Integrity
 
 	// Preserve service-side statuses that should take precedence over agent-reported status
 	// These statuses are set by the service based on annotations and should not be overwritten
@@ -567,3 +574,25 @@ func EmitSpecValidEvents(ctx context.Context, device *domain.Device, oldConditio
 		createEvent(ctx, getDeviceSpecInvalidEvent(ctx, deviceName, message))
 	}
 }
+
+// ResourceSyncManagedLabelKeys returns the label keys a ResourceSync previously applied to the device.
+func ResourceSyncManagedLabelKeys(device *domain.Device) []string {
+	value, ok := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationResourceSyncManagedLabels]
+	if !ok || value == "" {
+		return nil
+	}
+	return strings.Split(value, ",")
+}
+
+// DesiredResourceSyncDeviceLabels drops the previously managed keys from the current labels and
+// overlays the managed ones.
+func DesiredResourceSyncDeviceLabels(current map[string]string, previouslyManaged []string, managed map[string]string) map[string]string {
+	desired := make(map[string]string, len(current)+len(managed))
+	for k, v := range current {
+		if !lo.Contains(previouslyManaged, k) {
+			desired[k] = v
+		}
+	}
+	maps.Copy(desired, managed)
+	return desired
+}
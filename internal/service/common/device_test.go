@@ -576,3 +576,40 @@ func TestUpdateServerSideDeviceUpdatedStatus_ManagedDeviceErrorPriority(t *testi
 		})
 	}
 }
+
+func TestUpdateServerSideLifecycleStatus_DecommissionInProgress(t *testing.T) {
+	device := &domain.Device{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("test-device")},
+		Status: &domain.DeviceStatus{
+			Conditions: []domain.Condition{{
+				Type:    domain.ConditionTypeDeviceDecommissioning,
+				Status:  domain.ConditionStatusTrue,
+				Reason:  string(domain.DecommissionStateInProgress),
+				Message: "Factory reset step 2 of 4: removing managed files",
+			}},
+		},
+	}
+
+	updateServerSideLifecycleStatus(device)
+
+	assert.Equal(t, domain.DeviceLifecycleStatusDecommissioning, device.Status.Lifecycle.Status)
+	assert.Equal(t, "Factory reset step 2 of 4: removing managed files", lo.FromPtr(device.Status.Lifecycle.Info))
+}
+
+func TestDesiredResourceSyncDeviceLabels(t *testing.T) {
+	current := map[string]string{"site": "a", "alias": "old", "team": "ops"}
+
+	desired := DesiredResourceSyncDeviceLabels(current, []string{"site", "alias"}, map[string]string{"alias": "new"})
+	assert.Equal(t, map[string]string{"alias": "new", "team": "ops"}, desired)
+
+	desired = DesiredResourceSyncDeviceLabels(current, []string{"site", "alias"}, nil)
+	assert.Equal(t, map[string]string{"team": "ops"}, desired)
+}
+
+func TestResourceSyncManagedLabelKeys(t *testing.T) {
+	device := &domain.Device{Metadata: domain.ObjectMeta{Annotations: &map[string]string{
+		domain.DeviceAnnotationResourceSyncManagedLabels: "alias,site",
+	}}}
+	assert.Equal(t, []string{"alias", "site"}, ResourceSyncManagedLabelKeys(device))
+	assert.Empty(t, ResourceSyncManagedLabelKeys(&domain.Device{}))
+}
@@ -308,6 +308,30 @@ func GetDeviceConflictPausedEvent(ctx context.Context, deviceName string) *domai
 	})
 }
 
+// GetDeviceIntegrityVerifiedEvent creates an event for the integrity of a device being verified again
+func GetDeviceIntegrityVerifiedEvent(ctx context.Context, deviceName string) *domain.Event {
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonDeviceIntegrityVerified,
+		message:      "Device integrity has been verified.",
+		details:      nil,
+	})
+}
+
+// GetDeviceIntegrityFailedEvent creates an event for the integrity verification of a device failing
+func GetDeviceIntegrityFailedEvent(ctx context.Context, deviceName string, message string) *domain.Event {
+	msg := fmt.Sprintf("Device integrity verification failed: %s.", message)
+
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       domain.EventReasonDeviceIntegrityFailed,
+		message:      msg,
+		details:      nil,
+	})
+}
+
 // GetFleetSpecValidEvent creates an event for fleet spec becoming valid
 func GetFleetSpecValidEvent(ctx context.Context, fleetName string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
@@ -425,6 +449,7 @@ func GetFleetRolloutBatchCompletedEvent(ctx context.Context, name string, deploy
 		Successful:        report.Successful,
 		Failed:            report.Failed,
 		TimedOut:          report.TimedOut,
+		Unhealthy:         lo.Ternary(report.Unhealthy != 0, lo.ToPtr(report.Unhealthy), nil),
 	}
 	eventDetails := domain.EventDetails{}
 	if err := eventDetails.FromFleetRolloutBatchCompletedDetails(details); err != nil {
@@ -536,6 +561,36 @@ func GetFleetApplicationLifecycleChangedEvent(ctx context.Context, fleetName str
 	})
 }
 
+// GetDeviceFileTransferEvent creates an audit event for a file copied to or from a device.
+// A non-empty transferErr records a failed transfer.
+func GetDeviceFileTransferEvent(ctx context.Context, deviceName string, direction domain.DeviceFileTransferDetailsDirection, path string, bytes int64, transferErr string) *domain.Event {
+	details := domain.DeviceFileTransferDetails{
+		DetailType: domain.DeviceFileTransferDT,
+		Direction:  direction,
+		Path:       path,
+		Bytes:      bytes,
+	}
+	reason := domain.EventReasonDeviceFileTransferred
+	message := fmt.Sprintf("Transferred file %s (%s, %d bytes).", path, direction, bytes)
+	if transferErr != "" {
+		details.Error = &transferErr
+		reason = domain.EventReasonDeviceFileTransferFailed
+		message = fmt.Sprintf("Failed to transfer file %s (%s): %s", path, direction, transferErr)
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromDeviceFileTransferDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.DeviceKind,
+		resourceName: deviceName,
+		reason:       reason,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
 // GetFleetRolloutBatchDispatchedEvent creates an event for fleet rollout batch dispatch
 func GetFleetRolloutBatchDispatchedEvent(ctx context.Context, fleetName string, templateVersion string, batch string) *domain.Event {
 	details := domain.FleetRolloutBatchDispatchedDetails{
@@ -597,6 +652,69 @@ func GetFleetRolloutFailedEvent(ctx context.Context, name string, deployingTempl
 	})
 }
 
+// GetFleetRolloutRollbackStartedEvent creates an event for the start of a failed fleet rollout's rollback
+func GetFleetRolloutRollbackStartedEvent(ctx context.Context, name string, templateVersion string, rollbackTemplateVersion string, batch string) *domain.Event {
+	details := domain.FleetRolloutRollbackStartedDetails{
+		DetailType:              domain.FleetRolloutRollbackStarted,
+		TemplateVersion:         templateVersion,
+		RollbackTemplateVersion: rollbackTemplateVersion,
+		Batch:                   batch,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRollbackStartedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutRollbackStarted,
+		message:      fmt.Sprintf("Fleet rollout of template version %s failed in %s. Rolling back to template version %s.", templateVersion, batch, rollbackTemplateVersion),
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetRolloutRollbackCompletedEvent creates an event for the completion of a failed fleet rollout's rollback
+func GetFleetRolloutRollbackCompletedEvent(ctx context.Context, name string, templateVersion string, rollbackTemplateVersion string) *domain.Event {
+	details := domain.FleetRolloutRollbackCompletedDetails{
+		DetailType:              domain.FleetRolloutRollbackCompleted,
+		TemplateVersion:         templateVersion,
+		RollbackTemplateVersion: rollbackTemplateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRollbackCompletedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutRollbackCompleted,
+		message:      fmt.Sprintf("Fleet rollout rollback completed. Devices were returned to template version %s.", rollbackTemplateVersion),
+		details:      &eventDetails,
+	})
+}
+
+// GetFleetRolloutRollbackFailedEvent creates an event for a failed fleet rollout that could not be rolled back
+func GetFleetRolloutRollbackFailedEvent(ctx context.Context, name string, templateVersion string, message string) *domain.Event {
+	details := domain.FleetRolloutRollbackFailedDetails{
+		DetailType:      domain.FleetRolloutRollbackFailed,
+		TemplateVersion: templateVersion,
+	}
+	eventDetails := domain.EventDetails{}
+	if err := eventDetails.FromFleetRolloutRollbackFailedDetails(details); err != nil {
+		// If serialization fails, return nil rather than panicking
+		return nil
+	}
+	return getBaseEvent(ctx, resourceEvent{
+		resourceKind: domain.FleetKind,
+		resourceName: name,
+		reason:       domain.EventReasonFleetRolloutRollbackFailed,
+		message:      message,
+		details:      &eventDetails,
+	})
+}
+
 // GetRepositoryAccessibleEvent creates an event for repository accessibility
 func GetRepositoryAccessibleEvent(ctx context.Context, name string) *domain.Event {
 	return getBaseEvent(ctx, resourceEvent{
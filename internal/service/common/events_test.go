@@ -105,6 +105,57 @@ func TestGetDependencySyncProbeFailedEvent(t *testing.T) {
 	}
 }
 
+func TestGetDeviceFileTransferEvent(t *testing.T) {
+	tests := []struct {
+		name        string
+		direction   domain.DeviceFileTransferDetailsDirection
+		bytes       int64
+		transferErr string
+		wantReason  domain.EventReason
+		wantType    domain.EventType
+	}{
+		{
+			name:       "When a download completes it should create a normal event",
+			direction:  domain.DeviceFileTransferDownload,
+			bytes:      1024,
+			wantReason: domain.EventReasonDeviceFileTransferred,
+			wantType:   domain.Normal,
+		},
+		{
+			name:        "When an upload fails it should create a warning event with the error",
+			direction:   domain.DeviceFileTransferUpload,
+			bytes:       12,
+			transferErr: "path /etc/shadow is not allowed",
+			wantReason:  domain.EventReasonDeviceFileTransferFailed,
+			wantType:    domain.Warning,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			event := GetDeviceFileTransferEvent(context.Background(), "device-1", tc.direction, "/var/log/x.tar", tc.bytes, tc.transferErr)
+
+			require.NotNil(t, event)
+			require.Equal(t, tc.wantReason, event.Reason)
+			require.Equal(t, tc.wantType, event.Type)
+			require.Equal(t, string(domain.DeviceKind), event.InvolvedObject.Kind)
+			require.Equal(t, "device-1", event.InvolvedObject.Name)
+			require.Contains(t, event.Message, "/var/log/x.tar")
+
+			require.NotNil(t, event.Details)
+			details, err := event.Details.AsDeviceFileTransferDetails()
+			require.NoError(t, err)
+			require.Equal(t, tc.direction, details.Direction)
+			require.Equal(t, tc.bytes, details.Bytes)
+			if tc.transferErr != "" {
+				require.Equal(t, tc.transferErr, lo.FromPtr(details.Error))
+			} else {
+				require.Nil(t, details.Error)
+			}
+		})
+	}
+}
+
 func TestComputeResourceUpdatedDetails(t *testing.T) {
 	t.Run("When generation changes it should report a Spec update", func(t *testing.T) {
 		old := domain.ObjectMeta{Name: lo.ToPtr("x"), Generation: lo.ToPtr(int64(1))}
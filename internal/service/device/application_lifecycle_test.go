@@ -50,7 +50,7 @@ func newLifecycleTestDevice(t *testing.T, appName string) (h Service, st *fakeSt
 
 	st = newFakeStore()
 	ev = &fakeEvents{}
-	h = NewDeviceServiceHandler(st.device, st.fleet, ev, nil, "agent.example.com", logrus.New())
+	h = NewDeviceServiceHandler(st.device, st.fleet, nil, ev, nil, "agent.example.com", logrus.New())
 	orgId = uuid.New()
 	_, err := st.device.Create(context.Background(), orgId, &device, nil)
 	require.NoError(err)
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/healthchecker"
@@ -17,45 +18,58 @@ import (
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"golang.org/x/sync/semaphore"
 )
 
+const (
+	// Reasons recorded when a device's certificates are revoked as a side effect of its lifecycle
+	deviceDeletedRevocationReason        = "Device deleted"
+	deviceDecommissionedRevocationReason = "Device decommissioned"
+)
+
 // DeviceServiceHandler implements Service.
 type DeviceServiceHandler struct {
-	deviceStore   devicestore.Store
-	fleetStore    fleetstore.Store
-	events        events.Service
-	kvStore       kvstore.KVStore
-	agentGate     *semaphore.Weighted
-	agentEndpoint string
-	log           logrus.FieldLogger
+	deviceStore     devicestore.Store
+	fleetStore      fleetstore.Store
+	revocationStore certificaterevocationstore.Store
+	events          events.Service
+	kvStore         kvstore.KVStore
+	agentGate       *semaphore.Weighted
+	agentEndpoint   string
+	log             logrus.FieldLogger
 }
 
 // NewDeviceServiceHandler creates a new DeviceServiceHandler instance.
+// revocationStore may be nil, in which case deleting or decommissioning a device does not
+// revoke its certificates.
 func NewDeviceServiceHandler(
 	deviceStore devicestore.Store,
 	fleetStore fleetstore.Store,
+	revocationStore certificaterevocationstore.Store,
 	events events.Service,
 	kvStore kvstore.KVStore,
 	agentEndpoint string,
 	log logrus.FieldLogger,
 ) Service {
 	return &DeviceServiceHandler{
-		deviceStore:   deviceStore,
-		fleetStore:    fleetStore,
-		events:        events,
-		kvStore:       kvStore,
-		agentGate:     semaphore.NewWeighted(common.MaxConcurrentAgents),
-		agentEndpoint: agentEndpoint,
-		log:           log,
+		deviceStore:     deviceStore,
+		fleetStore:      fleetStore,
+		revocationStore: revocationStore,
+		events:          events,
+		kvStore:         kvStore,
+		agentGate:       semaphore.NewWeighted(common.MaxConcurrentAgents),
+		agentEndpoint:   agentEndpoint,
+		log:             log,
 	}
 }
 
@@ -74,6 +88,9 @@ func (h *DeviceServiceHandler) CreateDevice(ctx context.Context, orgId uuid.UUID
 	if errs := device.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if !common.DeviceInAccessScope(ctx, &device) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, lo.FromPtr(device.Metadata.Name))
+	}
 
 	_ = common.UpdateServiceSideStatus(ctx, orgId, &device, h.fleetStore, h.log)
 
@@ -98,6 +115,9 @@ func (h *DeviceServiceHandler) ListDevices(ctx context.Context, orgId uuid.UUID,
 	if status.Code != http.StatusOK {
 		return nil, status
 	}
+	if err := common.ApplyDeviceAccessScope(ctx, &storeParams.ListParams); err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
 
 	// Check if SummaryOnly is true
 	if params.SummaryOnly != nil && *params.SummaryOnly {
@@ -193,9 +213,30 @@ func (h *DeviceServiceHandler) ListDevicesByServiceCondition(ctx context.Context
 
 func (h *DeviceServiceHandler) GetDevice(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
 	result, err := h.deviceStore.Get(ctx, orgId, name)
+	if err == nil && !common.DeviceInAccessScope(ctx, result) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 	return result, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
+// checkAccessScope verifies that the device, if it exists, is in the request's access scope.
+func (h *DeviceServiceHandler) checkAccessScope(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if _, ok := contextutil.GetAccessScopeFromContext(ctx); !ok {
+		return domain.StatusOK()
+	}
+	device, err := h.deviceStore.Get(ctx, orgId, name)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return domain.StatusOK()
+	}
+	if err != nil {
+		return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	if !common.DeviceInAccessScope(ctx, device) {
+		return common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
+	return domain.StatusOK()
+}
+
 // DeviceVerificationCallback ensures the device wasn't decommissioned before an update proceeds.
 func DeviceVerificationCallback(ctx context.Context, before, after *domain.Device) error {
 	if before != nil && before.Spec != nil && before.Spec.Decommissioning != nil {
@@ -223,6 +264,12 @@ func (h *DeviceServiceHandler) ReplaceDevice(ctx context.Context, orgId uuid.UUI
 	if name != *device.Metadata.Name {
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
+	if !common.DeviceInAccessScope(ctx, &device) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 
 	_ = common.UpdateServiceSideStatus(ctx, orgId, &device, h.fleetStore, h.log)
 
@@ -255,17 +302,30 @@ func (h *DeviceServiceHandler) UpdateDevice(ctx context.Context, orgId uuid.UUID
 }
 
 func (h *DeviceServiceHandler) DeleteDevice(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return status
+	}
+	// Revoke before deleting, as deleting the device also deletes the enrollment request
+	// that holds its enrollment certificate.
+	if h.revocationStore != nil {
+		if _, err := h.revocationStore.RevokeDeviceCertificates(ctx, orgId, name, deviceDeletedRevocationReason); err != nil {
+			h.log.WithError(err).Errorf("failed to revoke certificates of device %s/%s", orgId, name)
+			return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+		}
+	}
 	_, err := h.deviceStore.Delete(ctx, orgId, name, h.callbackDeviceDeleted)
 	return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
 
 // (GET /api/v1/devices/{name}/status)
 func (h *DeviceServiceHandler) GetDeviceStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, domain.Status) {
-	result, err := h.deviceStore.Get(ctx, orgId, name)
-	return result, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	return h.GetDevice(ctx, orgId, name)
 }
 
 func (h *DeviceServiceHandler) GetDeviceLastSeen(ctx context.Context, orgId uuid.UUID, name string) (*domain.DeviceLastSeen, domain.Status) {
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
 	lastSeen, err := h.deviceStore.GetLastSeen(ctx, orgId, name)
 	if err != nil {
 		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
@@ -313,6 +373,9 @@ func (h *DeviceServiceHandler) ReplaceDeviceStatus(ctx context.Context, orgId uu
 	if err != nil {
 		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 	}
+	if !common.DeviceInAccessScope(ctx, originalDevice) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 
 	deviceToStore := &domain.Device{}
 	*deviceToStore = *originalDevice
@@ -330,6 +393,9 @@ func (h *DeviceServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid
 	if err != nil {
 		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 	}
+	if !common.DeviceInAccessScope(ctx, currentObj) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 
 	newObj := &domain.Device{}
 	err = common.ApplyJSONPatch(ctx, currentObj, newObj, patch, "/devices/"+name)
@@ -360,6 +426,11 @@ func (h *DeviceServiceHandler) PatchDeviceStatus(ctx context.Context, orgId uuid
 	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
 
+	// the integrity is owned by the service and cannot be patched
+	if newObj.Status != nil && currentObj.Status != nil {
+		newObj.Status.Integrity = currentObj.Status.Integrity
+	}
+
 	_ = common.UpdateServiceSideStatus(ctx, orgId, newObj, h.fleetStore, h.log)
 
 	result, err := h.deviceStore.Update(ctx, orgId, newObj, nil, true, DeviceVerificationCallback, h.callbackDeviceUpdated)
@@ -374,6 +445,10 @@ func (h *DeviceServiceHandler) GetRenderedDevice(ctx context.Context, orgId uuid
 		processedAwaitReconnect bool
 	)
 
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
+
 	if _, isAgent = ctx.Value(consts.AgentCtxKey).(string); isAgent {
 		if err := healthchecker.HealthChecks.Instance().Add(ctx, orgId, name); err != nil {
 			h.log.WithError(err).Errorf("failed to add healthcheck to device %s", name)
@@ -433,6 +508,9 @@ func (h *DeviceServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID,
 	if err != nil {
 		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 	}
+	if !common.DeviceInAccessScope(ctx, currentObj) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 
 	newObj := &domain.Device{}
 	err = common.ApplyJSONPatch(ctx, currentObj, newObj, patch, "/devices/"+name)
@@ -458,6 +536,9 @@ func (h *DeviceServiceHandler) PatchDevice(ctx context.Context, orgId uuid.UUID,
 	if newObj.Spec != nil && newObj.Spec.Decommissioning != nil {
 		return nil, domain.StatusBadRequest("spec.decommissioning cannot be changed via patch request")
 	}
+	if !common.DeviceInAccessScope(ctx, newObj) {
+		return nil, common.StatusOutOfAccessScope(domain.DeviceKind, name)
+	}
 
 	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -502,6 +583,9 @@ func (h *DeviceServiceHandler) ForceUpdateServerSideDeviceStatus(ctx context.Con
 }
 
 func (h *DeviceServiceHandler) DecommissionDevice(ctx context.Context, orgId uuid.UUID, name string, decom domain.DeviceDecommission) (*domain.Device, domain.Status) {
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
 	result, err := h.deviceStore.DecommissionDevice(ctx, orgId, name, decom, h.callbackDeviceDecommission)
 	return result, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
 }
@@ -537,6 +621,7 @@ func (h *DeviceServiceHandler) UpdateRenderedDevice(ctx context.Context, orgId u
 func (h *DeviceServiceHandler) SetDeviceServiceConditions(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status {
 	callback := func(ctx context.Context, orgId uuid.UUID, device *domain.Device, oldConditions, newConditions []domain.Condition) {
 		h.diffAndEmitConditionEvents(ctx, orgId, device, oldConditions, newConditions)
+		watch.Bus.Instance().Notify(ctx, orgId, domain.DeviceKind, name, domain.WatchEventTypeModified)
 	}
 
 	err := h.deviceStore.SetServiceConditions(ctx, orgId, name, conditions, callback)
@@ -608,8 +693,8 @@ func (h *DeviceServiceHandler) MarkDevicesRolloutSelection(ctx context.Context,
 	return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
 }
 
-func (h *DeviceServiceHandler) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, domain.Status) {
-	result, err := h.deviceStore.CompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout)
+func (h *DeviceServiceHandler) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, domain.Status) {
+	result, err := h.deviceStore.CompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout, soakDuration)
 	return result, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, nil)
 }
 
@@ -645,6 +730,9 @@ func (h *DeviceServiceHandler) ResumeDevices(ctx context.Context, orgId uuid.UUI
 	if status.Code != http.StatusOK {
 		return domain.DeviceResumeResponse{}, status
 	}
+	if err := common.ApplyDeviceAccessScope(ctx, listParams); err != nil {
+		return domain.DeviceResumeResponse{}, domain.StatusInternalServerError(err.Error())
+	}
 
 	// Remove conflictPaused annotation from all matching devices in a single SQL query
 	resumedCount, deviceIDs, err := h.deviceStore.RemoveConflictPausedAnnotation(ctx, orgId, lo.FromPtr(listParams))
@@ -711,16 +799,46 @@ func (h *DeviceServiceHandler) ListLabels(ctx context.Context, orgId uuid.UUID,
 // callbackDeviceUpdated is the device-specific callback that handles device events
 func (h *DeviceServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	EmitDeviceUpdatedEvent(ctx, h.events, h.log, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.DeviceKind, name, lo.Ternary(created, domain.WatchEventTypeAdded, domain.WatchEventTypeModified))
+		h.revokeCertificatesOnDecommission(ctx, orgId, name, oldResource, newResource)
+	}
+}
+
+// revokeCertificatesOnDecommission revokes the device's certificates once its lifecycle
+// transitions to Decommissioned, so that a device that failed to wipe its identity cannot
+// keep using it.
+func (h *DeviceServiceHandler) revokeCertificatesOnDecommission(ctx context.Context, orgId uuid.UUID, name string, oldResource, newResource interface{}) {
+	if h.revocationStore == nil {
+		return
+	}
+	oldDevice, newDevice, ok := common.CastResources[domain.Device](oldResource, newResource)
+	if !ok || !isDecommissioned(newDevice) || isDecommissioned(oldDevice) {
+		return
+	}
+	if _, err := h.revocationStore.RevokeDeviceCertificates(ctx, orgId, name, deviceDecommissionedRevocationReason); err != nil {
+		h.log.WithError(err).Errorf("failed to revoke certificates of decommissioned device %s/%s", orgId, name)
+	}
+}
+
+func isDecommissioned(device *domain.Device) bool {
+	return device != nil && device.Status != nil && device.Status.Lifecycle.Status == domain.DeviceLifecycleStatusDecommissioned
 }
 
 // callbackDeviceDecommission is the device-specific callback that handles device decommission events
 func (h *DeviceServiceHandler) callbackDeviceDecommission(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	EmitDeviceDecommissionEvent(ctx, h.events, resourceKind, orgId, name, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.DeviceKind, name, domain.WatchEventTypeModified)
+	}
 }
 
 // callbackDeviceDeleted is the device-specific callback that handles device deletion events
 func (h *DeviceServiceHandler) callbackDeviceDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.DeviceKind, name, domain.WatchEventTypeDeleted)
+	}
 }
 
 // processAwaitingReconnectIfNeeded processes the awaiting reconnect annotation only if the KV store contains the awaiting reconnection key.
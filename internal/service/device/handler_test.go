@@ -2,12 +2,15 @@ package device
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/store"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
@@ -19,7 +22,7 @@ import (
 func newTestHandler() (*fakeStore, *fakeEvents, Service) {
 	st := newFakeStore()
 	ev := &fakeEvents{}
-	svc := NewDeviceServiceHandler(st.device, st.fleet, ev, nil, "agent.example.com", logrus.New())
+	svc := NewDeviceServiceHandler(st.device, st.fleet, nil, ev, nil, "agent.example.com", logrus.New())
 	return st, ev, svc
 }
 
@@ -125,6 +128,66 @@ func TestDeleteDevice(t *testing.T) {
 	})
 }
 
+func TestDeviceCertificateRevocation(t *testing.T) {
+	newHandler := func(revocations *fakeRevocationStore) (*fakeStore, Service) {
+		st := newFakeStore()
+		return st, NewDeviceServiceHandler(st.device, st.fleet, revocations, &fakeEvents{}, nil, "agent.example.com", logrus.New())
+	}
+
+	t.Run("When a device is deleted its certificates should be revoked", func(t *testing.T) {
+		revocations := &fakeRevocationStore{}
+		st, svc := newHandler(revocations)
+		ctx := context.Background()
+		orgId := uuid.New()
+		_, err := st.device.Create(ctx, orgId, &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")}}, nil)
+		require.NoError(t, err)
+
+		status := svc.DeleteDevice(ctx, orgId, "foo")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, []string{"foo: " + deviceDeletedRevocationReason}, revocations.revoked)
+	})
+
+	t.Run("When revocation fails the device should not be deleted", func(t *testing.T) {
+		revocations := &fakeRevocationStore{err: errors.New("database unavailable")}
+		st, svc := newHandler(revocations)
+		ctx := context.Background()
+		orgId := uuid.New()
+		_, err := st.device.Create(ctx, orgId, &domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")}}, nil)
+		require.NoError(t, err)
+
+		status := svc.DeleteDevice(ctx, orgId, "foo")
+		require.NotEqual(t, int32(http.StatusOK), status.Code)
+		_, status = svc.GetDevice(ctx, orgId, "foo")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+	})
+
+	t.Run("When a device completes decommissioning its certificates should be revoked once", func(t *testing.T) {
+		revocations := &fakeRevocationStore{}
+		st, svc := newHandler(revocations)
+		ctx := context.WithValue(context.Background(), consts.InternalRequestCtxKey, true)
+		orgId := uuid.New()
+		_, err := st.device.Create(ctx, orgId, &domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")},
+			Spec:     &domain.DeviceSpec{},
+			Status:   lo.ToPtr(domain.NewDeviceStatus()),
+		}, nil)
+		require.NoError(t, err)
+
+		status := lo.ToPtr(domain.NewDeviceStatus())
+		status.Conditions = []domain.Condition{{
+			Type:   domain.ConditionTypeDeviceDecommissioning,
+			Status: domain.ConditionStatusTrue,
+			Reason: string(domain.DecommissionStateComplete),
+		}}
+		incoming := domain.Device{Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")}, Status: status}
+		for i := 0; i < 2; i++ {
+			_, apiStatus := svc.ReplaceDeviceStatus(ctx, orgId, "foo", incoming)
+			require.Equal(t, int32(http.StatusOK), apiStatus.Code)
+		}
+		require.Equal(t, []string{"foo: " + deviceDecommissionedRevocationReason}, revocations.revoked)
+	})
+}
+
 func TestPatchDevice(t *testing.T) {
 	setup := func(t *testing.T) (*fakeStore, Service, uuid.UUID) {
 		st, _, svc := newTestHandler()
@@ -224,6 +287,17 @@ func TestPatchDeviceStatus(t *testing.T) {
 		_, status := svc.PatchDeviceStatus(context.Background(), orgId, "bar", patch)
 		require.Equal(t, int32(http.StatusNotFound), status.Code)
 	})
+
+	t.Run("When patching status.integrity it should keep the stored integrity", func(t *testing.T) {
+		svc, orgId := setup(t)
+		var value interface{} = string(domain.DeviceIntegrityStatusVerified)
+		patch := domain.PatchRequest{
+			{Op: "replace", Path: "/status/integrity/status", Value: &value},
+		}
+		result, status := svc.PatchDeviceStatus(context.Background(), orgId, "foo", patch)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, domain.DeviceIntegrityStatusUnknown, result.Status.Integrity.Status)
+	})
 }
 
 // TestDeviceRepositoryRefs directly exercises AC-2: GetDeviceRepositoryRefs and
@@ -292,7 +366,7 @@ func TestResumeDevices(t *testing.T) {
 
 	t.Run("When events is nil it should not panic", func(t *testing.T) {
 		st := newFakeStore()
-		svc := NewDeviceServiceHandler(st.device, st.fleet, nil, nil, "agent.example.com", logrus.New())
+		svc := NewDeviceServiceHandler(st.device, st.fleet, nil, nil, nil, "agent.example.com", logrus.New())
 		ctx := context.Background()
 		orgId := uuid.New()
 		annotations := map[string]string{domain.DeviceAnnotationConflictPaused: "true"}
@@ -409,7 +483,7 @@ func TestMarkDevicesRolloutSelection(t *testing.T) {
 
 func TestGetDeviceCompletionCounts(t *testing.T) {
 	_, _, svc := newTestHandler()
-	result, status := svc.GetDeviceCompletionCounts(context.Background(), uuid.New(), "owner", "tv1", nil)
+	result, status := svc.GetDeviceCompletionCounts(context.Background(), uuid.New(), "owner", "tv1", nil, nil)
 	require.Equal(t, int32(http.StatusOK), status.Code)
 	require.NotNil(t, result)
 }
@@ -613,6 +687,38 @@ func TestReplaceDeviceStatus(t *testing.T) {
 		require.Equal(t, int32(http.StatusOK), status.Code)
 		require.NotNil(t, result)
 	})
+
+	t.Run("When the agent reports an integrity it should keep the stored integrity", func(t *testing.T) {
+		st, _, svc := newTestHandler()
+		ctx := context.Background()
+		orgId := uuid.New()
+		stored := domain.NewDeviceStatus()
+		stored.Integrity = domain.DeviceIntegrityStatus{
+			Status:       domain.DeviceIntegrityStatusFailed,
+			MeasuredBoot: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusFailed},
+		}
+		_, err := st.device.Create(ctx, orgId, &domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")},
+			Spec:     &domain.DeviceSpec{},
+			Status:   &stored,
+		}, nil)
+		require.NoError(t, err)
+
+		reported := domain.NewDeviceStatus()
+		reported.Integrity = domain.DeviceIntegrityStatus{
+			Status:       domain.DeviceIntegrityStatusVerified,
+			MeasuredBoot: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+		}
+		incoming := domain.Device{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr("foo")},
+			Status:   &reported,
+		}
+		ctx = context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+		result, status := svc.ReplaceDeviceStatus(ctx, orgId, "foo", incoming)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Equal(t, domain.DeviceIntegrityStatusFailed, result.Status.Integrity.Status)
+		require.Equal(t, domain.DeviceIntegrityCheckStatusFailed, result.Status.Integrity.MeasuredBoot.Status)
+	})
 }
 
 func TestGetRenderedDevice(t *testing.T) {
@@ -627,3 +733,60 @@ func TestGetRenderedDevice(t *testing.T) {
 	require.Equal(t, int32(http.StatusOK), status.Code)
 	require.Equal(t, "foo", lo.FromPtr(result.Metadata.Name))
 }
+
+func TestDeviceAccessScope(t *testing.T) {
+	setup := func(t *testing.T) (*fakeStore, Service, uuid.UUID, context.Context) {
+		st, _, svc := newTestHandler()
+		orgId := uuid.New()
+		for name, site := range map[string]string{"paris-1": "paris", "lyon-1": "lyon"} {
+			_, err := st.device.Create(context.Background(), orgId, &domain.Device{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Labels: &map[string]string{"site": site}},
+				Spec:     &domain.DeviceSpec{},
+			}, nil)
+			require.NoError(t, err)
+		}
+		ctx := contextutil.WithAccessScope(context.Background(), &identity.AccessScope{LabelSelectors: []string{"site=paris"}})
+		return st, svc, orgId, ctx
+	}
+
+	t.Run("When the device is in scope it should be returned", func(t *testing.T) {
+		_, svc, orgId, ctx := setup(t)
+		_, status := svc.GetDevice(ctx, orgId, "paris-1")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+	})
+
+	t.Run("When the device is out of scope it should be forbidden", func(t *testing.T) {
+		_, svc, orgId, ctx := setup(t)
+		_, status := svc.GetDevice(ctx, orgId, "lyon-1")
+		require.Equal(t, int32(http.StatusForbidden), status.Code)
+		_, status = svc.DecommissionDevice(ctx, orgId, "lyon-1", domain.DeviceDecommission{})
+		require.Equal(t, int32(http.StatusForbidden), status.Code)
+		status = svc.DeleteDevice(ctx, orgId, "lyon-1")
+		require.Equal(t, int32(http.StatusForbidden), status.Code)
+	})
+
+	t.Run("When a patch moves the device out of scope it should be forbidden", func(t *testing.T) {
+		_, svc, orgId, ctx := setup(t)
+		var value interface{} = "lyon"
+		patch := domain.PatchRequest{{Op: "replace", Path: "/metadata/labels/site", Value: &value}}
+		_, status := svc.PatchDevice(ctx, orgId, "paris-1", patch)
+		require.Equal(t, int32(http.StatusForbidden), status.Code)
+	})
+
+	t.Run("When listing devices the scope should be passed to the store", func(t *testing.T) {
+		st, svc, orgId, ctx := setup(t)
+		_, status := svc.ListDevices(ctx, orgId, domain.ListDevicesParams{}, nil)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.NotNil(t, st.device.lastListParams.AccessScope)
+		require.Len(t, st.device.lastListParams.AccessScope.LabelSelectors, 1)
+	})
+
+	t.Run("When the request has no scope all devices should be accessible", func(t *testing.T) {
+		st, svc, orgId, _ := setup(t)
+		_, status := svc.GetDevice(context.Background(), orgId, "lyon-1")
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		_, status = svc.ListDevices(context.Background(), orgId, domain.ListDevicesParams{}, nil)
+		require.Equal(t, int32(http.StatusOK), status.Code)
+		require.Nil(t, st.device.lastListParams.AccessScope)
+	})
+}
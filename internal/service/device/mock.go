@@ -148,18 +148,18 @@ func (mr *MockServiceMockRecorder) GetDevice(ctx, orgId, name any) *gomock.Call
 }
 
 // GetDeviceCompletionCounts mocks base method.
-func (m *MockService) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, domain.Status) {
+func (m *MockService) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner, templateVersion string, updateTimeout, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, domain.Status) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeviceCompletionCounts", ctx, orgId, owner, templateVersion, updateTimeout)
+	ret := m.ctrl.Call(m, "GetDeviceCompletionCounts", ctx, orgId, owner, templateVersion, updateTimeout, soakDuration)
 	ret0, _ := ret[0].([]domain.DeviceCompletionCount)
 	ret1, _ := ret[1].(domain.Status)
 	return ret0, ret1
 }
 
 // GetDeviceCompletionCounts indicates an expected call of GetDeviceCompletionCounts.
-func (mr *MockServiceMockRecorder) GetDeviceCompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout any) *gomock.Call {
+func (mr *MockServiceMockRecorder) GetDeviceCompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout, soakDuration any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCompletionCounts", reflect.TypeOf((*MockService)(nil).GetDeviceCompletionCounts), ctx, orgId, owner, templateVersion, updateTimeout)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceCompletionCounts", reflect.TypeOf((*MockService)(nil).GetDeviceCompletionCounts), ctx, orgId, owner, templateVersion, updateTimeout, soakDuration)
 }
 
 // GetDeviceLastSeen mocks base method.
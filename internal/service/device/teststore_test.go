@@ -10,8 +10,10 @@ import (
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
+	"github.com/flightctl/flightctl/internal/store/model"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 )
@@ -55,8 +57,9 @@ func newFakeStore() *fakeStore {
 // methods this package's handler_test.go exercises.
 type fakeDeviceStore struct {
 	devicestore.Store
-	devices  map[string]*domain.Device
-	repoRefs map[string][]string
+	devices        map[string]*domain.Device
+	repoRefs       map[string][]string
+	lastListParams devicestore.DeviceListParams
 }
 
 func (s *fakeDeviceStore) Create(ctx context.Context, orgId uuid.UUID, device *domain.Device, eventCallback store.EventCallback) (*domain.Device, error) {
@@ -167,6 +170,7 @@ func (s *fakeDeviceStore) ListDevicesByServiceCondition(ctx context.Context, org
 }
 
 func (s *fakeDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams devicestore.DeviceListParams) (*domain.DeviceList, error) {
+	s.lastListParams = listParams
 	items := make([]domain.Device, 0, len(s.devices))
 	for _, d := range s.devices {
 		items = append(items, *deepCopyDevice(d))
@@ -194,7 +198,7 @@ func (s *fakeDeviceStore) Summary(ctx context.Context, orgId uuid.UUID, listPara
 	return &domain.DevicesSummary{}, nil
 }
 
-func (s *fakeDeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
+func (s *fakeDeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	return []domain.DeviceCompletionCount{}, nil
 }
 
@@ -356,3 +360,18 @@ func (f *fakeEvents) CreateEvent(ctx context.Context, orgId uuid.UUID, event *do
 
 func (f *fakeEvents) HandleGenericResourceDeletedEvents(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 }
+
+// fakeRevocationStore records the device certificate revocations requested by the handler.
+type fakeRevocationStore struct {
+	certificaterevocationstore.Store
+	revoked []string
+	err     error
+}
+
+func (s *fakeRevocationStore) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, deviceName string, reason string) ([]model.CertificateRevocation, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.revoked = append(s.revoked, deviceName+": "+reason)
+	return nil, nil
+}
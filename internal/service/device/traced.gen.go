@@ -107,10 +107,10 @@ func (_d *TracedDeviceService) GetDevice(ctx context.Context, orgId uuid.UUID, n
 	return dp1, s1
 }
 
-func (_d *TracedDeviceService) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) (da1 []domain.DeviceCompletionCount, s1 domain.Status) {
+func (_d *TracedDeviceService) GetDeviceCompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) (da1 []domain.DeviceCompletionCount, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "GetDeviceCompletionCounts")
 
-	da1, s1 = _d.inner.GetDeviceCompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout)
+	da1, s1 = _d.inner.GetDeviceCompletionCounts(ctx, orgId, owner, templateVersion, updateTimeout, soakDuration)
 	endSpan(span, s1)
 	return da1, s1
 }
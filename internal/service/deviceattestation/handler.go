@@ -0,0 +1,398 @@
+package deviceattestation
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/store"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/tpm"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	nonceSize = 32
+
+	// nonceTTL bounds the time a device has to answer a challenge with a quote.
+	nonceTTL = 5 * time.Minute
+
+	// DefaultMaxAge is the time a verification of the boot measurements remains valid if the
+	// attestation policy does not set one. It spans three default agent attestation intervals.
+	DefaultMaxAge = 30 * time.Minute
+
+	listPageSize = 1000
+)
+
+// nowFunc allows overriding for unit tests
+var nowFunc = time.Now
+
+// errAttestationKeyUnknown is returned for TPM-verified devices without a recorded attestation key,
+// such as devices enrolled before the attestation key was recorded at enrollment.
+var errAttestationKeyUnknown = errors.New("the attestation key of the device is unknown, re-enroll the device to attest it")
+
+// ServiceHandler issues attestation challenges to TPM-enrolled devices of fleets with an
+// attestation policy and evaluates the PCR quotes they answer with against that policy.
+type ServiceHandler struct {
+	deviceStore devicestore.Store
+	fleetStore  fleetstore.Store
+	kvStore     kvstore.KVStore
+	events      events.Service
+	log         logrus.FieldLogger
+}
+
+// NewServiceHandler creates a new deviceattestation ServiceHandler instance.
+func NewServiceHandler(deviceStore devicestore.Store, fleetStore fleetstore.Store, kvStore kvstore.KVStore, events events.Service, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{
+		deviceStore: deviceStore,
+		fleetStore:  fleetStore,
+		kvStore:     kvStore,
+		events:      events,
+		log:         log,
+	}
+}
+
+var _ Service = (*ServiceHandler)(nil)
+
+// GetDeviceAttestationChallenge returns a fresh nonce and the PCRs the device must quote, or
+// no content if the device is not subject to attestation. A TPM-verified device whose attestation
+// key is unknown fails its measured boot check instead.
+func (h *ServiceHandler) GetDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status) {
+	device, err := h.deviceStore.Get(ctx, orgId, name)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	policy, err := h.attestationPolicy(ctx, orgId, device)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if policy == nil {
+		return nil, domain.StatusNoContent()
+	}
+	attestPub, err := attestationKey(device)
+	if err != nil {
+		return nil, h.failUnknownAttestationKey(ctx, orgId, device, err)
+	}
+	if attestPub == nil {
+		return nil, domain.StatusNoContent()
+	}
+
+	nonce := make([]byte, nonceSize)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, domain.StatusInternalServerError(fmt.Sprintf("generating nonce: %v", err))
+	}
+
+	// a new challenge replaces any outstanding one
+	key := (&kvstore.AttestationNonceKey{OrgID: orgId, DeviceName: name}).ComposeKey()
+	if err := h.kvStore.Delete(ctx, key); err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if _, err := h.kvStore.SetNX(ctx, key, nonce); err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if err := h.kvStore.SetExpire(ctx, key, nonceTTL); err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+
+	pcrs := lo.Map(policy.Pcrs, func(ref domain.PCRReferenceValue, _ int) int32 { return ref.Index })
+	sort.Slice(pcrs, func(i, j int) bool { return pcrs[i] < pcrs[j] })
+	return &domain.AttestationChallenge{Nonce: nonce, Pcrs: pcrs}, domain.StatusOK()
+}
+
+// CreateDeviceAttestation verifies a quote answering the outstanding challenge of the device,
+// evaluates the quoted PCR values against the attestation policy of its fleet and records the
+// result in the measured boot check of the device's integrity status.
+func (h *ServiceHandler) CreateDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, quote domain.AttestationQuote) (*domain.DeviceIntegrityStatus, domain.Status) {
+	device, err := h.deviceStore.Get(ctx, orgId, name)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+
+	// the nonce is single use, whatever the outcome of the verification
+	key := (&kvstore.AttestationNonceKey{OrgID: orgId, DeviceName: name}).ComposeKey()
+	nonce, err := h.kvStore.Get(ctx, key)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if nonce == nil || subtle.ConstantTimeCompare(nonce, quote.Nonce) != 1 {
+		return nil, domain.StatusBadRequest("quote does not answer an outstanding attestation challenge")
+	}
+	if err := h.kvStore.Delete(ctx, key); err != nil {
+		h.log.WithError(err).Warnf("failed to delete attestation nonce of device %s/%s", orgId, name)
+	}
+
+	policy, err := h.attestationPolicy(ctx, orgId, device)
+	if err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
+	if policy == nil {
+		return nil, domain.StatusBadRequest("device is not subject to an attestation policy")
+	}
+	attestPub, err := attestationKey(device)
+	if err != nil {
+		return nil, h.failUnknownAttestationKey(ctx, orgId, device, err)
+	}
+	if attestPub == nil {
+		return nil, domain.StatusBadRequest("device is not enrolled with a TPM")
+	}
+
+	pcrValues, err := decodePCRValues(quote.Pcrs)
+	if err != nil {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+
+	verifyErr := tpm.VerifyQuote(attestPub, nonce, quote.Quote, quote.Signature, pcrValues)
+	if verifyErr == nil {
+		verifyErr = evaluatePolicy(policy, pcrValues)
+	}
+
+	integrity, err := h.recordMeasuredBoot(ctx, orgId, device, verifyErr)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.DeviceKind, &name)
+	}
+	return integrity, domain.StatusOK()
+}
+
+// recordMeasuredBoot records the result of a measured boot verification in the integrity status
+// of the device and emits an event when the check starts or stops failing.
+func (h *ServiceHandler) recordMeasuredBoot(ctx context.Context, orgId uuid.UUID, device *domain.Device, verifyErr error) (*domain.DeviceIntegrityStatus, error) {
+	name := lo.FromPtr(device.Metadata.Name)
+	var integrity domain.DeviceIntegrityStatus
+	if device.Status != nil {
+		integrity = device.Status.Integrity
+	}
+	wasFailed := integrity.MeasuredBoot != nil && integrity.MeasuredBoot.Status == domain.DeviceIntegrityCheckStatusFailed
+	setMeasuredBootStatus(&integrity, verifyErr, nowFunc())
+
+	// only the integrity is written: the rest of the status is reported by the agent concurrently
+	if err := h.deviceStore.UpdateIntegrityStatus(ctx, orgId, name, integrity); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case verifyErr != nil && !wasFailed:
+		h.log.Warnf("measured boot verification of device %s/%s failed: %v", orgId, name, verifyErr)
+		h.events.CreateEvent(ctx, orgId, common.GetDeviceIntegrityFailedEvent(ctx, name, verifyErr.Error()))
+	case verifyErr == nil && wasFailed:
+		h.events.CreateEvent(ctx, orgId, common.GetDeviceIntegrityVerifiedEvent(ctx, name))
+	}
+	return &integrity, nil
+}
+
+// failUnknownAttestationKey fails the measured boot check of a device whose attestation key is
+// unknown, so that it is not silently left unattested.
+func (h *ServiceHandler) failUnknownAttestationKey(ctx context.Context, orgId uuid.UUID, device *domain.Device, keyErr error) domain.Status {
+	if _, err := h.recordMeasuredBoot(ctx, orgId, device, keyErr); err != nil {
+		return common.StoreErrorToApiStatus(err, false, domain.DeviceKind, device.Metadata.Name)
+	}
+	return domain.StatusConflict(keyErr.Error())
+}
+
+// ExpireStaleAttestations fails the measured boot check of the attested devices of fleets with an
+// attestation policy whose boot measurements were not verified within the policy's maximum age, so
+// that a device that stops attesting does not keep a verified status.
+func (h *ServiceHandler) ExpireStaleAttestations(ctx context.Context, orgId uuid.UUID) domain.Status {
+	listParams := store.ListParams{Limit: listPageSize}
+	for {
+		fleets, err := h.fleetStore.List(ctx, orgId, listParams)
+		if err != nil {
+			return common.StoreErrorToApiStatus(err, false, domain.FleetKind, nil)
+		}
+		for i := range fleets.Items {
+			fleet := &fleets.Items[i]
+			if fleet.Spec.Attestation == nil {
+				continue
+			}
+			if err := h.expireFleetAttestations(ctx, orgId, fleet); err != nil {
+				return domain.StatusInternalServerError(err.Error())
+			}
+		}
+		if fleets.Metadata.Continue == nil {
+			return domain.StatusOK()
+		}
+		if listParams.Continue, err = store.ParseContinueString(fleets.Metadata.Continue); err != nil {
+			return domain.StatusInternalServerError(err.Error())
+		}
+	}
+}
+
+func (h *ServiceHandler) expireFleetAttestations(ctx context.Context, orgId uuid.UUID, fleet *domain.Fleet) error {
+	fleetName := lo.FromPtr(fleet.Metadata.Name)
+	maxAge := DefaultMaxAge
+	if fleet.Spec.Attestation.MaxAge != nil {
+		parsed, err := time.ParseDuration(*fleet.Spec.Attestation.MaxAge)
+		if err != nil {
+			return fmt.Errorf("parsing attestation max age of fleet %s: %w", fleetName, err)
+		}
+		maxAge = parsed
+	}
+	cutoff := nowFunc().Add(-maxAge)
+
+	listParams := devicestore.DeviceListParams{ListParams: store.ListParams{
+		Limit:         listPageSize,
+		FieldSelector: selector.NewFieldSelectorFromMapOrDie(map[string]string{"metadata.owner": util.ResourceOwner(domain.FleetKind, fleetName)}),
+	}}
+	for {
+		devices, err := h.deviceStore.List(ctx, orgId, listParams)
+		if err != nil {
+			return fmt.Errorf("listing devices of fleet %s: %w", fleetName, err)
+		}
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			if !attestationExpired(device, cutoff) {
+				continue
+			}
+			expiredErr := fmt.Errorf("boot measurements were not verified within %s", maxAge)
+			if _, err := h.recordMeasuredBoot(ctx, orgId, device, expiredErr); err != nil {
+				h.log.WithError(err).Errorf("failed to expire attestation of device %s/%s", orgId, lo.FromPtr(device.Metadata.Name))
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			return nil
+		}
+		if listParams.Continue, err = store.ParseContinueString(devices.Metadata.Continue); err != nil {
+			return err
+		}
+	}
+}
+
+// attestationExpired returns whether the device is subject to attestation and its boot
+// measurements were last verified before the cutoff. Only a verified measured boot check can
+// expire: devices that were never attested, whose check already failed, or whose attestation key
+// is unknown are left alone.
+func attestationExpired(device *domain.Device, cutoff time.Time) bool {
+	if attestPub, err := attestationKey(device); err != nil || attestPub == nil {
+		return false
+	}
+	if device.Status == nil {
+		return false
+	}
+	measuredBoot := device.Status.Integrity.MeasuredBoot
+	if measuredBoot == nil || measuredBoot.Status != domain.DeviceIntegrityCheckStatusVerified {
+		return false
+	}
+	return measuredBoot.LastVerified == nil || measuredBoot.LastVerified.Before(cutoff)
+}
+
+// attestationPolicy returns the attestation policy of the fleet owning the device, if any.
+func (h *ServiceHandler) attestationPolicy(ctx context.Context, orgId uuid.UUID, device *domain.Device) (*domain.AttestationPolicy, error) {
+	kind, fleetName, err := util.GetResourceOwner(device.Metadata.Owner)
+	if err != nil || kind != domain.FleetKind {
+		return nil, nil
+	}
+	fleet, err := h.fleetStore.Get(ctx, orgId, fleetName)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting fleet %s: %w", fleetName, err)
+	}
+	return fleet.Spec.Attestation, nil
+}
+
+// attestationKey returns the attestation key (TPM2B_PUBLIC) recorded on the device when it
+// enrolled, or nil if the device did not enroll with a verified TPM.
+func attestationKey(device *domain.Device) ([]byte, error) {
+	encoded, ok := util.EnsureMap(lo.FromPtr(device.Metadata.Annotations))[domain.DeviceAnnotationAttestationKey]
+	if !ok {
+		if device.Status != nil && device.Status.Integrity.Tpm != nil && device.Status.Integrity.Tpm.Status == domain.DeviceIntegrityCheckStatusVerified {
+			return nil, errAttestationKeyUnknown
+		}
+		return nil, nil
+	}
+	attestPub, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(attestPub) == 0 {
+		return nil, errAttestationKeyUnknown
+	}
+	return attestPub, nil
+}
+
+// decodePCRValues decodes the hex-encoded SHA-256 PCR values of a quote.
+func decodePCRValues(pcrs []domain.PCRValue) (map[int][]byte, error) {
+	values := make(map[int][]byte, len(pcrs))
+	for _, pcr := range pcrs {
+		index := int(pcr.Index)
+		if index < 0 || index > tpm.MaxPCRIndex {
+			return nil, fmt.Errorf("invalid PCR index %d", index)
+		}
+		if _, ok := values[index]; ok {
+			return nil, fmt.Errorf("duplicate value of PCR %d", index)
+		}
+		value, err := hex.DecodeString(pcr.Value)
+		if err != nil || len(value) != sha256.Size {
+			return nil, fmt.Errorf("value of PCR %d is not a hex-encoded SHA-256 digest", index)
+		}
+		values[index] = value
+	}
+	return values, nil
+}
+
+// evaluatePolicy checks that each PCR of the policy has one of its reference values.
+func evaluatePolicy(policy *domain.AttestationPolicy, pcrValues map[int][]byte) error {
+	for _, ref := range policy.Pcrs {
+		value, ok := pcrValues[int(ref.Index)]
+		if !ok {
+			return fmt.Errorf("PCR %d was not quoted", ref.Index)
+		}
+		actual := hex.EncodeToString(value)
+		if !lo.ContainsBy(ref.Values, func(expected string) bool { return strings.EqualFold(expected, actual) }) {
+			return fmt.Errorf("PCR %d has unexpected value %s", ref.Index, actual)
+		}
+	}
+	return nil
+}
+
+// setMeasuredBootStatus records the result of a measured boot verification and recomputes the
+// summary integrity status from all checks. The summary is Failed if any check failed and Verified
+// only if the device identity, TPM and measured boot checks were all verified.
+func setMeasuredBootStatus(integrity *domain.DeviceIntegrityStatus, verifyErr error, now time.Time) {
+	if verifyErr != nil {
+		integrity.MeasuredBoot = &domain.DeviceIntegrityCheckStatus{
+			Status: domain.DeviceIntegrityCheckStatusFailed,
+			Info:   lo.ToPtr(verifyErr.Error()),
+		}
+	} else {
+		integrity.MeasuredBoot = &domain.DeviceIntegrityCheckStatus{
+			Status:       domain.DeviceIntegrityCheckStatusVerified,
+			Info:         lo.ToPtr("Boot measurements match the fleet's reference values"),
+			LastVerified: &now,
+		}
+	}
+
+	integrity.LastVerified = &now
+	checks := []*domain.DeviceIntegrityCheckStatus{integrity.DeviceIdentity, integrity.Tpm, integrity.MeasuredBoot}
+	switch {
+	case lo.ContainsBy(checks, func(check *domain.DeviceIntegrityCheckStatus) bool {
+		return check != nil && check.Status == domain.DeviceIntegrityCheckStatusFailed
+	}):
+		integrity.Status = domain.DeviceIntegrityStatusFailed
+		integrity.Info = lo.ToPtr("Integrity verification failed")
+	case lo.EveryBy(checks, func(check *domain.DeviceIntegrityCheckStatus) bool {
+		return check != nil && check.Status == domain.DeviceIntegrityCheckStatusVerified
+	}):
+		integrity.Status = domain.DeviceIntegrityStatusVerified
+		integrity.Info = lo.ToPtr("All integrity checks completed successfully")
+	default:
+		integrity.Status = domain.DeviceIntegrityStatusUnknown
+		integrity.Info = lo.ToPtr("Not all integrity checks have been verified")
+	}
+}
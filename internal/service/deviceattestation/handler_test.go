@@ -0,0 +1,407 @@
+package deviceattestation
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/store"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
+	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeDeviceStore implements only the devicestore.Store methods this package uses.
+type fakeDeviceStore struct {
+	devicestore.Store
+	devices map[string]*domain.Device
+}
+
+func (s *fakeDeviceStore) Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error) {
+	if device, ok := s.devices[name]; ok {
+		return device, nil
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *fakeDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams devicestore.DeviceListParams) (*domain.DeviceList, error) {
+	list := &domain.DeviceList{}
+	for _, device := range s.devices {
+		list.Items = append(list.Items, *device)
+	}
+	return list, nil
+}
+
+func (s *fakeDeviceStore) UpdateIntegrityStatus(ctx context.Context, orgId uuid.UUID, name string, integrity domain.DeviceIntegrityStatus) error {
+	device, ok := s.devices[name]
+	if !ok {
+		return flterrors.ErrResourceNotFound
+	}
+	if device.Status == nil {
+		device.Status = lo.ToPtr(domain.NewDeviceStatus())
+	}
+	device.Status.Integrity = integrity
+	return nil
+}
+
+// fakeFleetStore implements only the fleetstore.Store method this package uses.
+type fakeFleetStore struct {
+	fleetstore.Store
+	fleets map[string]*domain.Fleet
+}
+
+func (s *fakeFleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, opts ...fleetstore.GetOption) (*domain.Fleet, error) {
+	if fleet, ok := s.fleets[name]; ok {
+		return fleet, nil
+	}
+	return nil, flterrors.ErrResourceNotFound
+}
+
+func (s *fakeFleetStore) List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, opts ...fleetstore.ListOption) (*domain.FleetList, error) {
+	list := &domain.FleetList{}
+	for _, fleet := range s.fleets {
+		list.Items = append(list.Items, *fleet)
+	}
+	return list, nil
+}
+
+// fakeEventsService records the events this package emits.
+type fakeEventsService struct {
+	events.Service
+	created []*domain.Event
+}
+
+func (f *fakeEventsService) CreateEvent(ctx context.Context, orgId uuid.UUID, event *domain.Event) {
+	f.created = append(f.created, event)
+}
+
+// fakeKVStore implements only the kvstore.KVStore methods this package uses.
+type fakeKVStore struct {
+	kvstore.KVStore
+	values map[string][]byte
+}
+
+func (s *fakeKVStore) Get(ctx context.Context, key string) ([]byte, error) {
+	return s.values[key], nil
+}
+
+func (s *fakeKVStore) Delete(ctx context.Context, key string) error {
+	delete(s.values, key)
+	return nil
+}
+
+func newTestHandler(devices map[string]*domain.Device, fleets map[string]*domain.Fleet, kv map[string][]byte) (*ServiceHandler, *fakeEventsService) {
+	eventsSvc := &fakeEventsService{}
+	return NewServiceHandler(
+		&fakeDeviceStore{devices: devices},
+		&fakeFleetStore{fleets: fleets},
+		&fakeKVStore{values: kv},
+		eventsSvc,
+		logrus.New(),
+	), eventsSvc
+}
+
+func tpmVerifiedStatus() *domain.DeviceStatus {
+	status := domain.NewDeviceStatus()
+	status.Integrity = domain.DeviceIntegrityStatus{
+		Status:         domain.DeviceIntegrityStatusVerified,
+		DeviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+		Tpm:            &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+	}
+	return &status
+}
+
+func digest(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestGetDeviceAttestationChallenge(t *testing.T) {
+	policy := &domain.AttestationPolicy{Pcrs: []domain.PCRReferenceValue{{Index: 4, Values: []string{digest("kernel")}}}}
+	fleets := map[string]*domain.Fleet{
+		"attested": {Spec: domain.FleetSpec{Attestation: policy}},
+		"plain":    {Spec: domain.FleetSpec{}},
+	}
+	devices := map[string]*domain.Device{
+		"dev1":      {Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev1"), Owner: lo.ToPtr("Fleet/attested")}},
+		"unowned":   {Metadata: domain.ObjectMeta{Name: lo.ToPtr("unowned")}},
+		"plain-dev": {Metadata: domain.ObjectMeta{Name: lo.ToPtr("plain-dev"), Owner: lo.ToPtr("Fleet/plain")}},
+		// enrolled with a verified TPM before attestation keys were recorded
+		"unknown-key": {Metadata: domain.ObjectMeta{Name: lo.ToPtr("unknown-key"), Owner: lo.ToPtr("Fleet/attested")}, Status: tpmVerifiedStatus()},
+	}
+	h, eventsSvc := newTestHandler(devices, fleets, map[string][]byte{})
+
+	t.Run("returns not found for an unknown device", func(t *testing.T) {
+		_, status := h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "missing")
+		require.Equal(t, int32(http.StatusNotFound), status.Code)
+	})
+
+	t.Run("no challenge for a device without a fleet", func(t *testing.T) {
+		challenge, status := h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "unowned")
+		require.Equal(t, int32(http.StatusNoContent), status.Code)
+		require.Nil(t, challenge)
+	})
+
+	t.Run("no challenge for a fleet without an attestation policy", func(t *testing.T) {
+		challenge, status := h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "plain-dev")
+		require.Equal(t, int32(http.StatusNoContent), status.Code)
+		require.Nil(t, challenge)
+	})
+
+	t.Run("no challenge for a device not enrolled with a TPM", func(t *testing.T) {
+		challenge, status := h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "dev1")
+		require.Equal(t, int32(http.StatusNoContent), status.Code)
+		require.Nil(t, challenge)
+	})
+
+	t.Run("fails the measured boot check of a TPM device with an unknown attestation key", func(t *testing.T) {
+		challenge, status := h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "unknown-key")
+		require.Equal(t, int32(http.StatusConflict), status.Code)
+		require.Nil(t, challenge)
+
+		integrity := devices["unknown-key"].Status.Integrity
+		require.Equal(t, domain.DeviceIntegrityStatusFailed, integrity.Status)
+		require.Equal(t, domain.DeviceIntegrityCheckStatusFailed, integrity.MeasuredBoot.Status)
+		require.Len(t, eventsSvc.created, 1)
+		require.Equal(t, domain.EventReasonDeviceIntegrityFailed, eventsSvc.created[0].Reason)
+
+		// the failure is only reported once
+		_, status = h.GetDeviceAttestationChallenge(context.Background(), uuid.New(), "unknown-key")
+		require.Equal(t, int32(http.StatusConflict), status.Code)
+		require.Len(t, eventsSvc.created, 1)
+	})
+}
+
+func TestCreateDeviceAttestationRequiresChallenge(t *testing.T) {
+	orgId := uuid.New()
+	devices := map[string]*domain.Device{
+		"dev1": {Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev1"), Owner: lo.ToPtr("Fleet/attested")}},
+	}
+	key := (&kvstore.AttestationNonceKey{OrgID: orgId, DeviceName: "dev1"}).ComposeKey()
+	kv := map[string][]byte{key: []byte("outstanding-nonce")}
+	h, _ := newTestHandler(devices, nil, kv)
+
+	t.Run("rejects a quote of another nonce", func(t *testing.T) {
+		_, status := h.CreateDeviceAttestation(context.Background(), orgId, "dev1", domain.AttestationQuote{Nonce: []byte("stale-nonce")})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+		require.Contains(t, kv, key)
+	})
+
+	t.Run("consumes the nonce", func(t *testing.T) {
+		_, status := h.CreateDeviceAttestation(context.Background(), orgId, "dev1", domain.AttestationQuote{Nonce: []byte("outstanding-nonce")})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+		require.NotContains(t, kv, key)
+
+		_, status = h.CreateDeviceAttestation(context.Background(), orgId, "dev1", domain.AttestationQuote{Nonce: []byte("outstanding-nonce")})
+		require.Equal(t, int32(http.StatusBadRequest), status.Code)
+	})
+}
+
+func TestDecodePCRValues(t *testing.T) {
+	values, err := decodePCRValues([]domain.PCRValue{{Index: 0, Value: digest("firmware")}, {Index: 4, Value: strings.ToUpper(digest("kernel"))}})
+	require.NoError(t, err)
+	require.Len(t, values, 2)
+	require.Equal(t, digest("kernel"), hex.EncodeToString(values[4]))
+
+	_, err = decodePCRValues([]domain.PCRValue{{Index: 24, Value: digest("firmware")}})
+	require.Error(t, err)
+	_, err = decodePCRValues([]domain.PCRValue{{Index: 0, Value: digest("a")}, {Index: 0, Value: digest("b")}})
+	require.Error(t, err)
+	_, err = decodePCRValues([]domain.PCRValue{{Index: 0, Value: "abcd"}})
+	require.Error(t, err)
+}
+
+func TestEvaluatePolicy(t *testing.T) {
+	policy := &domain.AttestationPolicy{Pcrs: []domain.PCRReferenceValue{
+		{Index: 0, Values: []string{digest("firmware")}},
+		{Index: 4, Values: []string{strings.ToUpper(digest("kernel-1")), digest("kernel-2")}},
+	}}
+	values := func(firmware, kernel string) map[int][]byte {
+		decoded, err := decodePCRValues([]domain.PCRValue{{Index: 0, Value: digest(firmware)}, {Index: 4, Value: digest(kernel)}})
+		require.NoError(t, err)
+		return decoded
+	}
+
+	require.NoError(t, evaluatePolicy(policy, values("firmware", "kernel-1")))
+	require.NoError(t, evaluatePolicy(policy, values("firmware", "kernel-2")))
+	require.ErrorContains(t, evaluatePolicy(policy, values("firmware", "kernel-3")), "PCR 4")
+	require.ErrorContains(t, evaluatePolicy(policy, values("other", "kernel-1")), "PCR 0")
+
+	missing := values("firmware", "kernel-1")
+	delete(missing, 4)
+	require.ErrorContains(t, evaluatePolicy(policy, missing), "not quoted")
+}
+
+func TestSetMeasuredBootStatus(t *testing.T) {
+	now := time.Now()
+	integrity := domain.DeviceIntegrityStatus{
+		Status:         domain.DeviceIntegrityStatusVerified,
+		DeviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+		Tpm:            &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+	}
+
+	setMeasuredBootStatus(&integrity, errors.New("PCR 4 has unexpected value"), now)
+	require.Equal(t, domain.DeviceIntegrityStatusFailed, integrity.Status)
+	require.Equal(t, domain.DeviceIntegrityCheckStatusFailed, integrity.MeasuredBoot.Status)
+	require.Equal(t, "PCR 4 has unexpected value", lo.FromPtr(integrity.MeasuredBoot.Info))
+	require.Equal(t, now, lo.FromPtr(integrity.LastVerified))
+	require.Nil(t, integrity.MeasuredBoot.LastVerified)
+
+	setMeasuredBootStatus(&integrity, nil, now)
+	require.Equal(t, domain.DeviceIntegrityStatusVerified, integrity.Status)
+	require.Equal(t, domain.DeviceIntegrityCheckStatusVerified, integrity.MeasuredBoot.Status)
+	require.Equal(t, now, lo.FromPtr(integrity.MeasuredBoot.LastVerified))
+
+	// a failed enrollment check keeps the summary failed
+	integrity.Tpm.Status = domain.DeviceIntegrityCheckStatusFailed
+	setMeasuredBootStatus(&integrity, nil, now)
+	require.Equal(t, domain.DeviceIntegrityStatusFailed, integrity.Status)
+}
+
+func TestSetMeasuredBootStatusRequiresAllChecksVerified(t *testing.T) {
+	testCases := []struct {
+		name           string
+		deviceIdentity *domain.DeviceIntegrityCheckStatus
+		tpm            *domain.DeviceIntegrityCheckStatus
+		want           domain.DeviceIntegrityStatusSummaryType
+	}{
+		{
+			name:           "all checks verified",
+			deviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+			tpm:            &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+			want:           domain.DeviceIntegrityStatusVerified,
+		},
+		{
+			name: "enrollment checks missing",
+			want: domain.DeviceIntegrityStatusUnknown,
+		},
+		{
+			name:           "tpm check missing",
+			deviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+			want:           domain.DeviceIntegrityStatusUnknown,
+		},
+		{
+			name:           "device identity unknown",
+			deviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusUnknown},
+			tpm:            &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusVerified},
+			want:           domain.DeviceIntegrityStatusUnknown,
+		},
+		{
+			name:           "tpm failed",
+			deviceIdentity: &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusUnknown},
+			tpm:            &domain.DeviceIntegrityCheckStatus{Status: domain.DeviceIntegrityCheckStatusFailed},
+			want:           domain.DeviceIntegrityStatusFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			integrity := domain.DeviceIntegrityStatus{DeviceIdentity: tc.deviceIdentity, Tpm: tc.tpm}
+			setMeasuredBootStatus(&integrity, nil, time.Now())
+			require.Equal(t, tc.want, integrity.Status)
+		})
+	}
+}
+
+func TestExpireStaleAttestations(t *testing.T) {
+	now := time.Now()
+	enrolled := func(name string) *domain.Device {
+		status := tpmVerifiedStatus()
+		// the summary timestamp is set at enrollment and on every check, expiry must not use it
+		status.Integrity.LastVerified = lo.ToPtr(now.Add(-24 * time.Hour))
+		return &domain.Device{
+			Metadata: domain.ObjectMeta{
+				Name:        lo.ToPtr(name),
+				Owner:       lo.ToPtr("Fleet/attested"),
+				Annotations: &map[string]string{domain.DeviceAnnotationAttestationKey: "YWs="},
+			},
+			Status: status,
+		}
+	}
+	attested := func(name string, lastVerified time.Time, measuredBoot domain.DeviceIntegrityCheckStatusType) *domain.Device {
+		device := enrolled(name)
+		device.Status.Integrity.LastVerified = lo.ToPtr(now)
+		device.Status.Integrity.MeasuredBoot = &domain.DeviceIntegrityCheckStatus{Status: measuredBoot, LastVerified: &lastVerified}
+		return device
+	}
+
+	testCases := []struct {
+		name        string
+		maxAge      *string
+		device      *domain.Device
+		wantExpired bool
+	}{
+		{
+			name:   "recently verified",
+			device: attested("dev", now.Add(-5*time.Minute), domain.DeviceIntegrityCheckStatusVerified),
+		},
+		{
+			name:        "not verified within the default max age",
+			device:      attested("dev", now.Add(-time.Hour), domain.DeviceIntegrityCheckStatusVerified),
+			wantExpired: true,
+		},
+		{
+			name:   "verified within the policy's max age",
+			maxAge: lo.ToPtr("2h"),
+			device: attested("dev", now.Add(-time.Hour), domain.DeviceIntegrityCheckStatusVerified),
+		},
+		{
+			name:   "never attested since enrollment",
+			device: enrolled("dev"),
+		},
+		{
+			name:   "measured boot check unknown",
+			device: attested("dev", now.Add(-time.Hour), domain.DeviceIntegrityCheckStatusUnknown),
+		},
+		{
+			name:   "already failed",
+			device: attested("dev", now.Add(-time.Hour), domain.DeviceIntegrityCheckStatusFailed),
+		},
+		{
+			name: "not enrolled with a TPM",
+			device: &domain.Device{
+				Metadata: domain.ObjectMeta{Name: lo.ToPtr("dev"), Owner: lo.ToPtr("Fleet/attested")},
+				Status:   lo.ToPtr(domain.NewDeviceStatus()),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policy := &domain.AttestationPolicy{Pcrs: []domain.PCRReferenceValue{{Index: 4, Values: []string{digest("kernel")}}}, MaxAge: tc.maxAge}
+			fleets := map[string]*domain.Fleet{
+				"attested": {Metadata: domain.ObjectMeta{Name: lo.ToPtr("attested")}, Spec: domain.FleetSpec{Attestation: policy}},
+			}
+			devices := map[string]*domain.Device{"dev": tc.device}
+			wasStatus := tc.device.Status.Integrity.Status
+			h, eventsSvc := newTestHandler(devices, fleets, map[string][]byte{})
+
+			status := h.ExpireStaleAttestations(context.Background(), uuid.New())
+			require.Equal(t, int32(http.StatusOK), status.Code)
+
+			integrity := devices["dev"].Status.Integrity
+			if !tc.wantExpired {
+				require.Equal(t, wasStatus, integrity.Status)
+				require.Empty(t, eventsSvc.created)
+				return
+			}
+			require.Equal(t, domain.DeviceIntegrityStatusFailed, integrity.Status)
+			require.Equal(t, domain.DeviceIntegrityCheckStatusFailed, integrity.MeasuredBoot.Status)
+			require.Contains(t, lo.FromPtr(integrity.MeasuredBoot.Info), "not verified within 30m0s")
+			require.Len(t, eventsSvc.created, 1)
+			require.Equal(t, domain.EventReasonDeviceIntegrityFailed, eventsSvc.created[0].Reason)
+		})
+	}
+}
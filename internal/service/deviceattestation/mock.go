@@ -0,0 +1,86 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+//
+// Generated by this command:
+//
+//	mockgen -source=service.go -destination=mock.go -package=deviceattestation
+//
+
+// Package deviceattestation is a generated GoMock package.
+package deviceattestation
+
+import (
+	context "context"
+	reflect "reflect"
+
+	domain "github.com/flightctl/flightctl/internal/domain"
+	uuid "github.com/google/uuid"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockService is a mock of Service interface.
+type MockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockServiceMockRecorder
+}
+
+// MockServiceMockRecorder is the mock recorder for MockService.
+type MockServiceMockRecorder struct {
+	mock *MockService
+}
+
+// NewMockService creates a new mock instance.
+func NewMockService(ctrl *gomock.Controller) *MockService {
+	mock := &MockService{ctrl: ctrl}
+	mock.recorder = &MockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockService) EXPECT() *MockServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateDeviceAttestation mocks base method.
+func (m *MockService) CreateDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, quote domain.AttestationQuote) (*domain.DeviceIntegrityStatus, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeviceAttestation", ctx, orgId, name, quote)
+	ret0, _ := ret[0].(*domain.DeviceIntegrityStatus)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// CreateDeviceAttestation indicates an expected call of CreateDeviceAttestation.
+func (mr *MockServiceMockRecorder) CreateDeviceAttestation(ctx, orgId, name, quote any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeviceAttestation", reflect.TypeOf((*MockService)(nil).CreateDeviceAttestation), ctx, orgId, name, quote)
+}
+
+// ExpireStaleAttestations mocks base method.
+func (m *MockService) ExpireStaleAttestations(ctx context.Context, orgId uuid.UUID) domain.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireStaleAttestations", ctx, orgId)
+	ret0, _ := ret[0].(domain.Status)
+	return ret0
+}
+
+// ExpireStaleAttestations indicates an expected call of ExpireStaleAttestations.
+func (mr *MockServiceMockRecorder) ExpireStaleAttestations(ctx, orgId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireStaleAttestations", reflect.TypeOf((*MockService)(nil).ExpireStaleAttestations), ctx, orgId)
+}
+
+// GetDeviceAttestationChallenge mocks base method.
+func (m *MockService) GetDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceAttestationChallenge", ctx, orgId, name)
+	ret0, _ := ret[0].(*domain.AttestationChallenge)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// GetDeviceAttestationChallenge indicates an expected call of GetDeviceAttestationChallenge.
+func (mr *MockServiceMockRecorder) GetDeviceAttestationChallenge(ctx, orgId, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceAttestationChallenge", reflect.TypeOf((*MockService)(nil).GetDeviceAttestationChallenge), ctx, orgId, name)
+}
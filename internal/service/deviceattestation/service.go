@@ -0,0 +1,14 @@
+package deviceattestation
+
+import (
+	"context"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+)
+
+type Service interface {
+	GetDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (*domain.AttestationChallenge, domain.Status)
+	CreateDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, quote domain.AttestationQuote) (*domain.DeviceIntegrityStatus, domain.Status)
+	ExpireStaleAttestations(ctx context.Context, orgId uuid.UUID) domain.Status
+}
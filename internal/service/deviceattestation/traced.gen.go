@@ -0,0 +1,69 @@
+// Code generated by gowrap. DO NOT EDIT.
+// template: ../templates/service-tracing
+// gowrap: http://github.com/hexdigest/gowrap
+
+package deviceattestation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedService wraps a Service implementation with OpenTelemetry tracing.
+type TracedService struct {
+	inner Service
+}
+
+// WrapWithTracing returns a Service that wraps inner with tracing spans, or nil if inner is nil.
+func WrapWithTracing(inner Service) Service {
+	if inner == nil {
+		return nil
+	}
+	return &TracedService{inner: inner}
+}
+
+func startSpan(ctx context.Context, method string) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "flightctl/service/deviceattestation", method)
+}
+
+func endSpan(span trace.Span, st domain.Status) {
+	span.SetAttributes(attribute.Int("status.code", int(st.Code)))
+
+	if st.Status != "Success" {
+		span.RecordError(errors.New(st.Message))
+		span.SetStatus(codes.Error, st.Message)
+	}
+
+	span.End()
+}
+
+func (_d *TracedService) CreateDeviceAttestation(ctx context.Context, orgId uuid.UUID, name string, quote domain.AttestationQuote) (dp1 *domain.DeviceIntegrityStatus, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "CreateDeviceAttestation")
+
+	dp1, s1 = _d.inner.CreateDeviceAttestation(ctx, orgId, name, quote)
+	endSpan(span, s1)
+	return dp1, s1
+}
+
+func (_d *TracedService) ExpireStaleAttestations(ctx context.Context, orgId uuid.UUID) (s1 domain.Status) {
+	ctx, span := startSpan(ctx, "ExpireStaleAttestations")
+
+	s1 = _d.inner.ExpireStaleAttestations(ctx, orgId)
+	endSpan(span, s1)
+	return s1
+}
+
+func (_d *TracedService) GetDeviceAttestationChallenge(ctx context.Context, orgId uuid.UUID, name string) (ap1 *domain.AttestationChallenge, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "GetDeviceAttestationChallenge")
+
+	ap1, s1 = _d.inner.GetDeviceAttestationChallenge(ctx, orgId, name)
+	endSpan(span, s1)
+	return ap1, s1
+}
@@ -165,6 +165,20 @@ func addStatusIfNeeded(enrollmentRequest *domain.EnrollmentRequest) {
 	}
 }
 
+// enrollmentAttestationKey returns the attestation key (TPM2B_PUBLIC) of the TCG CSR of a
+// TPM-verified enrollment request.
+func enrollmentAttestationKey(enrollmentRequest *domain.EnrollmentRequest) ([]byte, error) {
+	csrBytes, isTPM := tpm.ParseTCGCSRBytes(enrollmentRequest.Spec.Csr)
+	if !isTPM {
+		return nil, errors.New("TPM-verified enrollment request does not hold a TCG CSR")
+	}
+	parsed, err := tpm.ParseTCGCSR(csrBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing TCG CSR: %w", err)
+	}
+	return parsed.CSRContents.Payload.AttestPub, nil
+}
+
 func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context, orgId uuid.UUID, enrollmentRequest *domain.EnrollmentRequest) error {
 	deviceStatus := domain.NewDeviceStatus()
 	deviceStatus.Lifecycle = domain.DeviceLifecycleStatus{Status: "Enrolled"}
@@ -264,6 +278,18 @@ func (h *ServiceHandler) createDeviceFromEnrollmentRequest(ctx context.Context,
 		apiResource.Metadata.Labels = enrollmentRequest.Status.Approval.Labels
 	}
 
+	// Record the attestation key the device's TPM was verified with, so that its boot
+	// measurement quotes can be verified without going back to the enrollment request
+	if isTPMVerified {
+		attestPub, err := enrollmentAttestationKey(enrollmentRequest)
+		if err != nil {
+			return err
+		}
+		apiResource.Metadata.Annotations = &map[string]string{
+			domain.DeviceAnnotationAttestationKey: base64.StdEncoding.EncodeToString(attestPub),
+		}
+	}
+
 	// Transfer awaitingReconnect annotation from enrollment request to device if present
 	if enrollmentRequest.Metadata.Annotations != nil {
 		if awaitingReconnect, exists := (*enrollmentRequest.Metadata.Annotations)[domain.DeviceAnnotationAwaitingReconnect]; exists && awaitingReconnect == "true" {
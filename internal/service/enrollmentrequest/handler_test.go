@@ -485,3 +485,24 @@ func TestCreateDeviceFromEnrollmentRequestNeverManaged(t *testing.T) {
 	require.Nil(t, device.Metadata.Owner)
 	require.False(t, device.IsManaged())
 }
+
+func TestCreateDeviceFromEnrollmentRequestRequiresAttestationKey(t *testing.T) {
+	h, _, fakeDevices, _, _ := newTestHandler(t)
+	ctx := context.Background()
+	orgId := uuid.New()
+
+	// a TPM-verified enrollment request must carry the attestation key recorded on the device
+	er := &domain.EnrollmentRequest{
+		Metadata: domain.ObjectMeta{Name: lo.ToPtr("tpm-device")},
+		Spec:     domain.EnrollmentRequestSpec{Csr: "TestCSR"},
+		Status: &domain.EnrollmentRequestStatus{Conditions: []domain.Condition{{
+			Type:   domain.ConditionTypeEnrollmentRequestTPMVerified,
+			Status: domain.ConditionStatusTrue,
+		}}},
+	}
+
+	err := h.createDeviceFromEnrollmentRequest(ctx, orgId, er)
+	require.ErrorContains(t, err, "TCG CSR")
+	_, err = fakeDevices.Get(ctx, orgId, "tpm-device")
+	require.ErrorIs(t, err, flterrors.ErrResourceNotFound)
+}
@@ -6,8 +6,10 @@ import (
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/service/common"
 	eventstore "github.com/flightctl/flightctl/internal/store/event"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -44,6 +46,8 @@ func (h *ServiceHandler) CreateEvent(ctx context.Context, orgId uuid.UUID, event
 	if h.workerClient != nil {
 		h.workerClient.EmitEvent(ctx, orgId, event)
 	}
+
+	watch.Bus.Instance().Notify(ctx, orgId, domain.EventKind, lo.FromPtr(event.Metadata.Name), domain.WatchEventTypeAdded)
 }
 
 // HandleGenericResourceDeletedEvents handles generic resource deletion event emission logic
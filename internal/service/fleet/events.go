@@ -43,7 +43,7 @@ func EmitFleetUpdatedEvent(ctx context.Context, eventsService events.Service, lo
 		var updateDetails *domain.ResourceUpdatedDetails
 		if !created && oldFleet != nil && newFleet != nil {
 			updateDetails = common.ComputeResourceUpdatedDetails(oldFleet.Metadata, newFleet.Metadata)
-			// Check if spec.template or spec.selector changed - if so, remove spec from updateDetails and add spec.template or spec.selector
+			// Check if spec.template, spec.selector or spec.parameters changed - if so, remove spec from updateDetails and add the specific fields
 			if updateDetails != nil && lo.Contains(updateDetails.UpdatedFields, domain.Spec) {
 				removeSpec := false
 				if !reflect.DeepEqual(oldFleet.Spec.Template, newFleet.Spec.Template) {
@@ -54,6 +54,10 @@ func EmitFleetUpdatedEvent(ctx context.Context, eventsService events.Service, lo
 					updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SpecSelector)
 					removeSpec = true
 				}
+				if !reflect.DeepEqual(oldFleet.Spec.Parameters, newFleet.Spec.Parameters) {
+					updateDetails.UpdatedFields = append(updateDetails.UpdatedFields, domain.SpecParameters)
+					removeSpec = true
+				}
 				if removeSpec {
 					updateDetails.UpdatedFields = lo.Filter(updateDetails.UpdatedFields, func(field domain.ResourceUpdatedDetailsUpdatedFields, _ int) bool {
 						return field != domain.Spec
@@ -85,6 +89,7 @@ func EmitFleetUpdatedEvent(ctx context.Context, eventsService events.Service, lo
 	emitFleetRolloutBatchCompletedEvent(ctx, eventsService, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
 	emitFleetRolloutCompletedEvent(ctx, eventsService, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
 	emitFleetRolloutFailedEvent(ctx, eventsService, orgId, name, deployingTemplateVersion, oldFleet, newFleet)
+	emitFleetRolloutRollbackEvents(ctx, eventsService, orgId, name, oldFleet, newFleet)
 }
 
 func emitFleetRolloutNewEvent(ctx context.Context, eventsService events.Service, orgId uuid.UUID, name string, oldFleet, newFleet *domain.Fleet) {
@@ -188,3 +193,20 @@ func emitFleetRolloutFailedEvent(ctx context.Context, eventsService events.Servi
 
 	eventsService.CreateEvent(ctx, orgId, common.GetFleetRolloutFailedEvent(ctx, name, deployingTemplateVersion, newCondition.Message))
 }
+
+func emitFleetRolloutRollbackEvents(ctx context.Context, eventsService events.Service, orgId uuid.UUID, name string, oldFleet, newFleet *domain.Fleet) {
+	if newFleet == nil {
+		return
+	}
+	newRollback := newFleet.GetRolloutRollback()
+	if newRollback == nil {
+		return
+	}
+	oldRollback := oldFleet.GetRolloutRollback()
+	if oldRollback == nil {
+		eventsService.CreateEvent(ctx, orgId, common.GetFleetRolloutRollbackStartedEvent(ctx, name, newRollback.FromTemplateVersion, newRollback.ToTemplateVersion, newRollback.Batch))
+	}
+	if newRollback.Completed && (oldRollback == nil || !oldRollback.Completed) {
+		eventsService.CreateEvent(ctx, orgId, common.GetFleetRolloutRollbackCompletedEvent(ctx, name, newRollback.FromTemplateVersion, newRollback.ToTemplateVersion))
+	}
+}
@@ -218,4 +218,59 @@ func TestEmitFleetUpdatedEvent(t *testing.T) {
 		}
 		require.Contains(t, reasons, domain.EventReasonFleetRolloutFailed)
 	})
+
+	t.Run("When a rollback annotation appears it should emit a FleetRolloutRollbackStarted event", func(t *testing.T) {
+		ev := &fakeEventsService{}
+		name := "f1"
+		rollback := `{"fromTemplateVersion":"v2","toTemplateVersion":"v1","batch":"batch 1","startTime":"2025-01-01T00:00:00Z","completed":false}`
+		oldFleet := &domain.Fleet{
+			Metadata: domain.ObjectMeta{Name: lo.ToPtr(name), Annotations: &map[string]string{domain.FleetAnnotationDeployingTemplateVersion: "v2"}},
+		}
+		newFleet := &domain.Fleet{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr(name),
+				Annotations: &map[string]string{
+					domain.FleetAnnotationDeployingTemplateVersion: "v2",
+					domain.FleetAnnotationRollback:                 rollback,
+				},
+			},
+		}
+		EmitFleetUpdatedEvent(context.Background(), ev, logrus.New(), domain.FleetKind, uuid.New(), name, oldFleet, newFleet, false, nil)
+		var reasons []domain.EventReason
+		for _, e := range ev.created {
+			reasons = append(reasons, e.Reason)
+		}
+		require.Contains(t, reasons, domain.EventReasonFleetRolloutRollbackStarted)
+		require.NotContains(t, reasons, domain.EventReasonFleetRolloutRollbackCompleted)
+	})
+
+	t.Run("When a rollback completes it should emit a FleetRolloutRollbackCompleted event", func(t *testing.T) {
+		ev := &fakeEventsService{}
+		name := "f1"
+		oldFleet := &domain.Fleet{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr(name),
+				Annotations: &map[string]string{
+					domain.FleetAnnotationDeployingTemplateVersion: "v2",
+					domain.FleetAnnotationRollback:                 `{"fromTemplateVersion":"v2","toTemplateVersion":"v1","batch":"batch 1","startTime":"2025-01-01T00:00:00Z","completed":false}`,
+				},
+			},
+		}
+		newFleet := &domain.Fleet{
+			Metadata: domain.ObjectMeta{
+				Name: lo.ToPtr(name),
+				Annotations: &map[string]string{
+					domain.FleetAnnotationDeployingTemplateVersion: "v2",
+					domain.FleetAnnotationRollback:                 `{"fromTemplateVersion":"v2","toTemplateVersion":"v1","batch":"batch 1","startTime":"2025-01-01T00:00:00Z","completed":true}`,
+				},
+			},
+		}
+		EmitFleetUpdatedEvent(context.Background(), ev, logrus.New(), domain.FleetKind, uuid.New(), name, oldFleet, newFleet, false, nil)
+		var reasons []domain.EventReason
+		for _, e := range ev.created {
+			reasons = append(reasons, e.Reason)
+		}
+		require.Contains(t, reasons, domain.EventReasonFleetRolloutRollbackCompleted)
+		require.NotContains(t, reasons, domain.EventReasonFleetRolloutRollbackStarted)
+	})
 }
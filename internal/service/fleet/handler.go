@@ -3,7 +3,9 @@ package fleet
 import (
 	"context"
 	"errors"
+	"net/http"
 
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/common"
@@ -11,7 +13,9 @@ import (
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -39,6 +43,9 @@ func (h *ServiceHandler) CreateFleet(ctx context.Context, orgId uuid.UUID, fleet
 	if errs := fleet.Validate(); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if !common.FleetInAccessScope(ctx, &fleet) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, lo.FromPtr(fleet.Metadata.Name))
+	}
 
 	result, err := h.store.Create(ctx, orgId, &fleet, h.callbackFleetUpdated)
 	return result, common.StoreErrorToApiStatus(err, true, domain.FleetKind, fleet.Metadata.Name)
@@ -49,6 +56,9 @@ func (h *ServiceHandler) ListFleets(ctx context.Context, orgId uuid.UUID, params
 	if status != domain.StatusOK() {
 		return nil, status
 	}
+	if err := common.ApplyFleetAccessScope(ctx, listParams); err != nil {
+		return nil, domain.StatusInternalServerError(err.Error())
+	}
 
 	result, err := h.store.List(ctx, orgId, *listParams, fleetstore.ListWithDevicesSummary(util.DefaultBoolIfNil(params.AddDevicesSummary, false)))
 	if err == nil {
@@ -67,9 +77,30 @@ func (h *ServiceHandler) ListFleets(ctx context.Context, orgId uuid.UUID, params
 
 func (h *ServiceHandler) GetFleet(ctx context.Context, orgId uuid.UUID, name string, params domain.GetFleetParams) (*domain.Fleet, domain.Status) {
 	result, err := h.store.Get(ctx, orgId, name, fleetstore.GetWithDeviceSummary(util.DefaultBoolIfNil(params.AddDevicesSummary, false)))
+	if err == nil && !common.FleetInAccessScope(ctx, result) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
 	return result, common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
+// checkAccessScope verifies that the fleet, if it exists, is in the request's access scope.
+func (h *ServiceHandler) checkAccessScope(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
+	if _, ok := contextutil.GetAccessScopeFromContext(ctx); !ok {
+		return domain.StatusOK()
+	}
+	fleet, err := h.store.Get(ctx, orgId, name)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return domain.StatusOK()
+	}
+	if err != nil {
+		return common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
+	}
+	if !common.FleetInAccessScope(ctx, fleet) {
+		return common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
+	return domain.StatusOK()
+}
+
 func (h *ServiceHandler) ReplaceFleet(ctx context.Context, orgId uuid.UUID, name string, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
 	// don't overwrite fields that are managed by the service
 	isInternal := common.IsInternalRequest(ctx)
@@ -87,6 +118,12 @@ func (h *ServiceHandler) ReplaceFleet(ctx context.Context, orgId uuid.UUID, name
 	if name != *fleet.Metadata.Name {
 		return nil, domain.StatusBadRequest("resource name specified in metadata does not match name in path")
 	}
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
+	if !common.FleetInAccessScope(ctx, &fleet) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
 
 	result, created, err := h.store.CreateOrUpdate(ctx, orgId, &fleet, nil, !isInternal, h.callbackFleetUpdated)
 	return result, common.StoreErrorToApiStatus(err, created, domain.FleetKind, &name)
@@ -101,6 +138,10 @@ func (h *ServiceHandler) DeleteFleet(ctx context.Context, orgId uuid.UUID, name
 		return common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 	}
 
+	if !common.FleetInAccessScope(ctx, f) {
+		return common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
+
 	if f.Metadata.Owner != nil && !common.IsResourceSyncRequest(ctx) {
 		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
 	}
@@ -111,11 +152,20 @@ func (h *ServiceHandler) DeleteFleet(ctx context.Context, orgId uuid.UUID, name
 
 func (h *ServiceHandler) GetFleetStatus(ctx context.Context, orgId uuid.UUID, name string) (*domain.Fleet, domain.Status) {
 	result, err := h.store.Get(ctx, orgId, name)
+	if err == nil && !common.FleetInAccessScope(ctx, result) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
 	return result, common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
 func (h *ServiceHandler) ReplaceFleetStatus(ctx context.Context, orgId uuid.UUID, name string, fleet domain.Fleet) (*domain.Fleet, domain.Status) {
+	if status := h.checkAccessScope(ctx, orgId, name); status.Code != http.StatusOK {
+		return nil, status
+	}
 	result, err := h.store.UpdateStatus(ctx, orgId, &fleet)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.FleetKind, name, domain.WatchEventTypeModified)
+	}
 	return result, common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 }
 
@@ -125,6 +175,9 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, orgId uuid.UUID, name s
 	if err != nil {
 		return nil, common.StoreErrorToApiStatus(err, false, domain.FleetKind, &name)
 	}
+	if !common.FleetInAccessScope(ctx, currentObj) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
 
 	newObj := &domain.Fleet{}
 	err = common.ApplyJSONPatch(ctx, currentObj, newObj, patch, "/fleets/"+name)
@@ -139,6 +192,9 @@ func (h *ServiceHandler) PatchFleet(ctx context.Context, orgId uuid.UUID, name s
 	if errs := currentObj.ValidateUpdate(newObj); len(errs) > 0 {
 		return nil, domain.StatusBadRequest(errors.Join(errs...).Error())
 	}
+	if !common.FleetInAccessScope(ctx, newObj) {
+		return nil, common.StatusOutOfAccessScope(domain.FleetKind, name)
+	}
 
 	common.NilOutManagedObjectMetaProperties(&newObj.Metadata)
 	newObj.Metadata.ResourceVersion = nil
@@ -180,9 +236,15 @@ func (h *ServiceHandler) GetFleetRepositoryRefs(ctx context.Context, orgId uuid.
 // callbackFleetUpdated is the fleet-specific callback that handles fleet events
 func (h *ServiceHandler) callbackFleetUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	EmitFleetUpdatedEvent(ctx, h.events, h.log, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.FleetKind, name, lo.Ternary(created, domain.WatchEventTypeAdded, domain.WatchEventTypeModified))
+	}
 }
 
 // callbackFleetDeleted is the fleet-specific callback that handles fleet deletion events
 func (h *ServiceHandler) callbackFleetDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.FleetKind, name, domain.WatchEventTypeDeleted)
+	}
 }
@@ -103,7 +103,12 @@ func (h *ServiceHandler) ReplaceRepository(ctx context.Context, orgId uuid.UUID,
 }
 
 func (h *ServiceHandler) DeleteRepository(ctx context.Context, orgId uuid.UUID, name string) domain.Status {
-	err := h.store.Delete(ctx, orgId, name, h.callbackRepositoryDeleted)
+	existing, err := h.store.Get(ctx, orgId, name)
+	if err == nil && existing.Metadata.Owner != nil && !common.IsResourceSyncRequest(ctx) {
+		return domain.StatusConflict(flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error())
+	}
+
+	err = h.store.Delete(ctx, orgId, name, h.callbackRepositoryDeleted)
 	return common.StoreErrorToApiStatus(err, false, domain.RepositoryKind, &name)
 }
 
@@ -8,6 +8,7 @@ import (
 	"sync"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/events"
@@ -16,6 +17,7 @@ import (
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 )
 
 const (
@@ -104,6 +106,15 @@ func (f *fakeRepositoryStore) Delete(ctx context.Context, orgId uuid.UUID, name
 	return nil
 }
 
+func (f *fakeRepositoryStore) UnsetOwner(_ context.Context, _ *gorm.DB, _ uuid.UUID, owner string) error {
+	for _, r := range f.items {
+		if lo.FromPtr(r.Metadata.Owner) == owner {
+			r.Metadata.Owner = nil
+		}
+	}
+	return nil
+}
+
 func (f *fakeRepositoryStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.Repository, eventCallback store.EventCallback) (*domain.Repository, error) {
 	name := lo.FromPtr(resource.Metadata.Name)
 	existing, ok := f.items[name]
@@ -300,6 +311,25 @@ func TestDeleteRepository(t *testing.T) {
 	require.Len(t, ev.deleted, 1)
 }
 
+func TestDeleteOwnedRepository(t *testing.T) {
+	h, repoStore, _ := newTestHandler()
+	ctx := context.Background()
+	orgId := uuid.New()
+	repo := newGitRepository("repo1", "https://example.com/1.git")
+	repo.Metadata.Owner = lo.ToPtr("ResourceSync/rs1")
+	repoStore.items["repo1"] = &repo
+
+	status := h.DeleteRepository(ctx, orgId, "repo1")
+	require.Equal(t, int32(http.StatusConflict), status.Code)
+	require.Equal(t, flterrors.ErrDeletingResourceWithOwnerNotAllowed.Error(), status.Message)
+	require.Contains(t, repoStore.items, "repo1")
+
+	rsCtx := context.WithValue(ctx, consts.ResourceSyncRequestCtxKey, true)
+	status = h.DeleteRepository(rsCtx, orgId, "repo1")
+	require.Equal(t, statusSuccessCode, status.Code)
+	require.NotContains(t, repoStore.items, "repo1")
+}
+
 // ── PatchRepository ─────────────────────────────────────────────────────────
 
 func testRepositoryPatch(t *testing.T, patch domain.PatchRequest) (*domain.Repository, domain.Repository, domain.Status) {
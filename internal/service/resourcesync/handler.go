@@ -3,31 +3,56 @@ package resourcesync
 import (
 	"context"
 	"errors"
+	"fmt"
+	"maps"
+	"net/http"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/service/device"
 	"github.com/flightctl/flightctl/internal/service/events"
+	"github.com/flightctl/flightctl/internal/store"
+	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
+	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
 	resourcesyncstore "github.com/flightctl/flightctl/internal/store/resourcesync"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"gorm.io/gorm"
 )
 
 type ServiceHandler struct {
-	store        resourcesyncstore.Store
-	catalogStore catalogstore.Store
-	fleetStore   fleetstore.Store
-	events       events.Service
-	log          logrus.FieldLogger
+	store             resourcesyncstore.Store
+	catalogStore      catalogstore.Store
+	fleetStore        fleetstore.Store
+	deviceStore       devicestore.Store
+	repositoryStore   repositorystore.Store
+	authProviderStore authproviderstore.Store
+	events            events.Service
+	planner           Planner
+	log               logrus.FieldLogger
 }
 
 // NewServiceHandler creates a new resourcesync ServiceHandler instance.
-func NewServiceHandler(store resourcesyncstore.Store, catalogStore catalogstore.Store, fleetStore fleetstore.Store, events events.Service, log logrus.FieldLogger) *ServiceHandler {
-	return &ServiceHandler{store: store, catalogStore: catalogStore, fleetStore: fleetStore, events: events, log: log}
+func NewServiceHandler(store resourcesyncstore.Store, catalogStore catalogstore.Store, fleetStore fleetstore.Store, deviceStore devicestore.Store, repositoryStore repositorystore.Store, authProviderStore authproviderstore.Store, events events.Service, planner Planner, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{
+		store:             store,
+		catalogStore:      catalogStore,
+		fleetStore:        fleetStore,
+		deviceStore:       deviceStore,
+		repositoryStore:   repositoryStore,
+		authProviderStore: authProviderStore,
+		events:            events,
+		planner:           planner,
+		log:               log,
+	}
 }
 
 var _ Service = (*ServiceHandler)(nil)
@@ -71,6 +96,27 @@ func (h *ServiceHandler) GetResourceSync(ctx context.Context, orgId uuid.UUID, n
 	return result, common.StoreErrorToApiStatus(err, false, domain.ResourceSyncKind, &name)
 }
 
+// PlanResourceSync computes the changes the ResourceSync would apply for the given revision
+// of its repository without writing anything.
+func (h *ServiceHandler) PlanResourceSync(ctx context.Context, orgId uuid.UUID, name string, targetRevision *string) (*domain.ResourceSyncPlan, domain.Status) {
+	if h.planner == nil {
+		return nil, domain.StatusNotImplemented("planning ResourceSyncs is not supported by this service")
+	}
+	rs, err := h.store.Get(ctx, orgId, name)
+	if err != nil {
+		return nil, common.StoreErrorToApiStatus(err, false, domain.ResourceSyncKind, &name)
+	}
+	plan, err := h.planner.Plan(ctx, orgId, rs, targetRevision)
+	if errors.Is(err, flterrors.ErrInvalidResourceSync) {
+		return nil, domain.StatusBadRequest(err.Error())
+	}
+	if err != nil {
+		// The repository could not be read, which the client cannot fix by changing its request
+		return nil, domain.StatusServiceUnavailable(err.Error())
+	}
+	return plan, domain.StatusOK()
+}
+
 func (h *ServiceHandler) ReplaceResourceSync(ctx context.Context, orgId uuid.UUID, name string, rs domain.ResourceSync) (*domain.ResourceSync, domain.Status) {
 	// don't overwrite fields that are managed by the service
 	if !common.IsInternalRequest(ctx) {
@@ -96,14 +142,86 @@ func (h *ServiceHandler) DeleteResourceSync(ctx context.Context, orgId uuid.UUID
 		if err := h.catalogStore.UnsetOwner(ctx, tx, orgId, owner); err != nil {
 			return err
 		}
-		return h.fleetStore.UnsetOwner(ctx, tx, orgId, owner)
+		if err := h.fleetStore.UnsetOwner(ctx, tx, orgId, owner); err != nil {
+			return err
+		}
+		if err := h.authProviderStore.UnsetOwner(ctx, tx, orgId, owner); err != nil {
+			return err
+		}
+		return h.repositoryStore.UnsetOwner(ctx, tx, orgId, owner)
 	}
 
 	err := h.store.Delete(ctx, orgId, name, callback, h.callbackResourceSyncDeleted)
 	status := common.StoreErrorToApiStatus(err, false, domain.ResourceSyncKind, &name)
+	if status.Code != http.StatusOK {
+		return status
+	}
+
+	// Devices are released after the ResourceSync is gone, so a retried delete releases
+	// whatever was left behind by a previous attempt.
+	if err := h.releaseDevices(ctx, orgId, name); err != nil {
+		return domain.StatusInternalServerError(fmt.Sprintf("failed to release devices managed by ResourceSync %s: %v", name, err))
+	}
 	return status
 }
 
+// releaseDevices removes the labels managed by the ResourceSync from its devices along with the
+// ownership annotations, the same way the ResourceSync task does when a device leaves the repository.
+func (h *ServiceHandler) releaseDevices(ctx context.Context, orgId uuid.UUID, owner string) error {
+	listParams := devicestore.DeviceListParams{
+		ListParams: store.ListParams{
+			Limit: 100,
+			AnnotationSelector: selector.NewAnnotationSelectorFromMapOrDie(map[string]string{
+				domain.DeviceAnnotationResourceSyncOwner: owner,
+			}),
+		},
+	}
+
+	var errs []error
+	for {
+		list, err := h.deviceStore.List(ctx, orgId, listParams)
+		if err != nil {
+			return errors.Join(append(errs, fmt.Errorf("failed to list managed devices: %w", err))...)
+		}
+		for i := range list.Items {
+			if err := h.releaseDevice(ctx, orgId, &list.Items[i]); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if list.Metadata.Continue == nil {
+			break
+		}
+		cont, err := store.ParseContinueString(list.Metadata.Continue)
+		if err != nil {
+			return errors.Join(append(errs, err)...)
+		}
+		listParams.Continue = cont
+	}
+	return errors.Join(errs...)
+}
+
+func (h *ServiceHandler) releaseDevice(ctx context.Context, orgId uuid.UUID, dev *domain.Device) error {
+	name := lo.FromPtr(dev.Metadata.Name)
+	current := lo.FromPtr(dev.Metadata.Labels)
+	desired := common.DesiredResourceSyncDeviceLabels(current, common.ResourceSyncManagedLabelKeys(dev), nil)
+
+	if !maps.Equal(current, desired) {
+		dev.Metadata.Labels = &desired
+		common.NilOutManagedObjectMetaProperties(&dev.Metadata)
+		dev.Metadata.ResourceVersion = nil
+		_ = common.UpdateServiceSideStatus(ctx, orgId, dev, h.fleetStore, h.log)
+		if _, err := h.deviceStore.Update(ctx, orgId, dev, nil, true, device.DeviceVerificationCallback, h.callbackDeviceUpdated); err != nil {
+			return fmt.Errorf("failed to remove labels of device '%s': %w", name, err)
+		}
+	}
+
+	deleteKeys := []string{domain.DeviceAnnotationResourceSyncOwner, domain.DeviceAnnotationResourceSyncManagedLabels}
+	if err := h.deviceStore.UpdateAnnotations(ctx, orgId, name, nil, deleteKeys); err != nil {
+		return fmt.Errorf("failed to remove annotations of device '%s': %w", name, err)
+	}
+	return nil
+}
+
 // Only metadata.labels and spec can be patched. If we try to patch other fields, HTTP 400 Bad Request is returned.
 func (h *ServiceHandler) PatchResourceSync(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ResourceSync, domain.Status) {
 	currentObj, err := h.store.Get(ctx, orgId, name)
@@ -247,6 +365,14 @@ func emitResourceSyncConditionEvents(ctx context.Context, eventsService events.S
 	}
 }
 
+// callbackDeviceUpdated emits the device-updated events the device service emits for label changes.
+func (h *ServiceHandler) callbackDeviceUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
+	device.EmitDeviceUpdatedEvent(ctx, h.events, h.log, resourceKind, orgId, name, oldResource, newResource, created, err)
+	if err == nil {
+		watch.Bus.Instance().Notify(ctx, orgId, domain.DeviceKind, name, lo.Ternary(created, domain.WatchEventTypeAdded, domain.WatchEventTypeModified))
+	}
+}
+
 // callbackResourceSyncDeleted is the resource sync-specific callback that handles resource sync deletion events
 func (h *ServiceHandler) callbackResourceSyncDeleted(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	h.events.HandleGenericResourceDeletedEvents(ctx, resourceKind, orgId, name, oldResource, newResource, created, err)
@@ -2,19 +2,25 @@ package resourcesync
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
+	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
 	resourcesyncstore "github.com/flightctl/flightctl/internal/store/resourcesync"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 	"gorm.io/gorm"
 )
 
@@ -151,6 +157,59 @@ func (f *fakeFleetStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid
 	return nil
 }
 
+// fakeDeviceStore embeds devicestore.Store (nil) and keeps devices in memory for List, Update
+// and UpdateAnnotations.
+type fakeDeviceStore struct {
+	devicestore.Store
+	devices map[string]*domain.Device
+}
+
+func (f *fakeDeviceStore) List(ctx context.Context, orgId uuid.UUID, listParams devicestore.DeviceListParams) (*domain.DeviceList, error) {
+	list := &domain.DeviceList{}
+	for _, device := range f.devices {
+		if _, ok := lo.FromPtr(device.Metadata.Annotations)[domain.DeviceAnnotationResourceSyncOwner]; ok {
+			list.Items = append(list.Items, *device)
+		}
+	}
+	return list, nil
+}
+
+func (f *fakeDeviceStore) Update(ctx context.Context, orgId uuid.UUID, device *domain.Device, fieldsToUnset []string, fromAPI bool, validationCallback devicestore.DeviceStoreValidationCallback, eventCallback store.EventCallback) (*domain.Device, error) {
+	existing := f.devices[lo.FromPtr(device.Metadata.Name)]
+	existing.Metadata.Labels = device.Metadata.Labels
+	return existing, nil
+}
+
+func (f *fakeDeviceStore) UpdateAnnotations(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) error {
+	existing := lo.FromPtr(f.devices[name].Metadata.Annotations)
+	for _, key := range deleteKeys {
+		delete(existing, key)
+	}
+	return nil
+}
+
+// fakeRepositoryStore embeds repositorystore.Store (nil) and overrides only UnsetOwner.
+type fakeRepositoryStore struct {
+	repositorystore.Store
+	unsetOwnerCalls []string
+}
+
+func (f *fakeRepositoryStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	f.unsetOwnerCalls = append(f.unsetOwnerCalls, owner)
+	return nil
+}
+
+// fakeAuthProviderStore embeds authproviderstore.Store (nil) and overrides only UnsetOwner.
+type fakeAuthProviderStore struct {
+	authproviderstore.Store
+	unsetOwnerCalls []string
+}
+
+func (f *fakeAuthProviderStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	f.unsetOwnerCalls = append(f.unsetOwnerCalls, owner)
+	return nil
+}
+
 // fakeEventsService is a recording fake for events.Service. ResourceSync's own event
 // decision logic (in handler.go's callbackResourceSyncUpdated) now calls CreateEvent
 // directly, so tests assert on the actual emitted events rather than intercepting a
@@ -184,7 +243,7 @@ func newTestHandler() (*ServiceHandler, *fakeResourceSyncStore, *fakeCatalogStor
 	catStore := &fakeCatalogStore{}
 	flStore := &fakeFleetStore{}
 	evStore := &fakeEventsService{}
-	return NewServiceHandler(rsStore, catStore, flStore, evStore, logrus.New()), rsStore, catStore, flStore, evStore
+	return NewServiceHandler(rsStore, catStore, flStore, &fakeDeviceStore{}, &fakeRepositoryStore{}, &fakeAuthProviderStore{}, evStore, nil, logrus.New()), rsStore, catStore, flStore, evStore
 }
 
 func testResourceSync(name string) domain.ResourceSync {
@@ -346,7 +405,7 @@ func TestPatchResourceSync(t *testing.T) {
 }
 
 func TestDeleteResourceSync(t *testing.T) {
-	t.Run("When deleting a resource sync it should unset ownership on Catalog and Fleet and fire a deleted callback", func(t *testing.T) {
+	t.Run("When deleting a resource sync it should unset ownership on all owned kinds and fire a deleted callback", func(t *testing.T) {
 		h, fakeStore, fakeCatalog, fakeFleet, fakeEvents := newTestHandler()
 		orgId := uuid.New()
 		rs := testResourceSync("foo")
@@ -361,8 +420,40 @@ func TestDeleteResourceSync(t *testing.T) {
 		require.Equal(t, "ResourceSync/foo", fakeCatalog.unsetOwnerCalls[0])
 		require.Len(t, fakeFleet.unsetOwnerCalls, 1)
 		require.Equal(t, "ResourceSync/foo", fakeFleet.unsetOwnerCalls[0])
+		fakeRepo := h.repositoryStore.(*fakeRepositoryStore)
+		require.Equal(t, []string{"ResourceSync/foo"}, fakeRepo.unsetOwnerCalls)
+		fakeAuthProvider := h.authProviderStore.(*fakeAuthProviderStore)
+		require.Equal(t, []string{"ResourceSync/foo"}, fakeAuthProvider.unsetOwnerCalls)
 		require.Len(t, fakeEvents.deleted, 1)
 	})
+
+	t.Run("When deleting a resource sync it should release the labels and annotations of its devices", func(t *testing.T) {
+		h, fakeStore, _, _, _ := newTestHandler()
+		orgId := uuid.New()
+		rs := testResourceSync("foo")
+		fakeStore.items["foo"] = &rs
+		fakeDevices := &fakeDeviceStore{devices: map[string]*domain.Device{
+			"dev1": {
+				Metadata: domain.ObjectMeta{
+					Name:   lo.ToPtr("dev1"),
+					Labels: &map[string]string{"site": "lab", "team": "edge"},
+					Annotations: &map[string]string{
+						domain.DeviceAnnotationResourceSyncOwner:         "foo",
+						domain.DeviceAnnotationResourceSyncManagedLabels: "site",
+						"other": "kept",
+					},
+				},
+			},
+		}}
+		h.deviceStore = fakeDevices
+
+		status := h.DeleteResourceSync(context.Background(), orgId, "foo")
+		require.Equal(t, statusSuccessCode, status.Code)
+
+		dev := fakeDevices.devices["dev1"]
+		require.Equal(t, map[string]string{"team": "edge"}, lo.FromPtr(dev.Metadata.Labels))
+		require.Equal(t, map[string]string{"other": "kept"}, lo.FromPtr(dev.Metadata.Annotations))
+	})
 }
 
 func TestReplaceResourceSyncStatus(t *testing.T) {
@@ -378,3 +469,39 @@ func TestReplaceResourceSyncStatus(t *testing.T) {
 	require.Equal(t, statusSuccessCode, status.Code)
 	require.NotNil(t, result.Status)
 }
+
+func TestPlanResourceSync(t *testing.T) {
+	t.Run("When no planner is configured it should return not implemented", func(t *testing.T) {
+		h, fakeStore, _, _, _ := newTestHandler()
+		fakeStore.items["foo"] = lo.ToPtr(testResourceSync("foo"))
+
+		_, status := h.PlanResourceSync(context.Background(), uuid.New(), "foo", nil)
+		require.Equal(t, int32(501), status.Code)
+	})
+
+	t.Run("When the resource exists it should plan the requested revision", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		planner := NewMockPlanner(ctrl)
+		h, fakeStore, _, _, _ := newTestHandler()
+		h.planner = planner
+		fakeStore.items["foo"] = lo.ToPtr(testResourceSync("foo"))
+		orgId := uuid.New()
+
+		expected := &domain.ResourceSyncPlan{TargetRevision: "feature"}
+		planner.EXPECT().Plan(gomock.Any(), orgId, gomock.Any(), lo.ToPtr("feature")).Return(expected, nil)
+		result, status := h.PlanResourceSync(context.Background(), orgId, "foo", lo.ToPtr("feature"))
+		require.Equal(t, statusSuccessCode, status.Code)
+		require.Equal(t, expected, result)
+
+		planner.EXPECT().Plan(gomock.Any(), orgId, gomock.Any(), nil).Return(nil, errors.New("failed to clone repository"))
+		_, status = h.PlanResourceSync(context.Background(), orgId, "foo", nil)
+		require.Equal(t, int32(503), status.Code)
+
+		planner.EXPECT().Plan(gomock.Any(), orgId, gomock.Any(), nil).Return(nil, fmt.Errorf("%w: repository foo not found", flterrors.ErrInvalidResourceSync))
+		_, status = h.PlanResourceSync(context.Background(), orgId, "foo", nil)
+		require.Equal(t, statusBadRequestCode, status.Code)
+
+		_, status = h.PlanResourceSync(context.Background(), orgId, "missing", nil)
+		require.Equal(t, statusNotFoundCode, status.Code)
+	})
+}
@@ -115,6 +115,21 @@ func (mr *MockServiceMockRecorder) PatchResourceSync(ctx, orgId, name, patch any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchResourceSync", reflect.TypeOf((*MockService)(nil).PatchResourceSync), ctx, orgId, name, patch)
 }
 
+// PlanResourceSync mocks base method.
+func (m *MockService) PlanResourceSync(ctx context.Context, orgId uuid.UUID, name string, targetRevision *string) (*domain.ResourceSyncPlan, domain.Status) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlanResourceSync", ctx, orgId, name, targetRevision)
+	ret0, _ := ret[0].(*domain.ResourceSyncPlan)
+	ret1, _ := ret[1].(domain.Status)
+	return ret0, ret1
+}
+
+// PlanResourceSync indicates an expected call of PlanResourceSync.
+func (mr *MockServiceMockRecorder) PlanResourceSync(ctx, orgId, name, targetRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlanResourceSync", reflect.TypeOf((*MockService)(nil).PlanResourceSync), ctx, orgId, name, targetRevision)
+}
+
 // ReplaceResourceSync mocks base method.
 func (m *MockService) ReplaceResourceSync(ctx context.Context, orgId uuid.UUID, name string, rs domain.ResourceSync) (*domain.ResourceSync, domain.Status) {
 	m.ctrl.T.Helper()
@@ -144,3 +159,41 @@ func (mr *MockServiceMockRecorder) ReplaceResourceSyncStatus(ctx, orgId, name, r
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceResourceSyncStatus", reflect.TypeOf((*MockService)(nil).ReplaceResourceSyncStatus), ctx, orgId, name, resourceSync)
 }
+
+// MockPlanner is a mock of Planner interface.
+type MockPlanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockPlannerMockRecorder
+}
+
+// MockPlannerMockRecorder is the mock recorder for MockPlanner.
+type MockPlannerMockRecorder struct {
+	mock *MockPlanner
+}
+
+// NewMockPlanner creates a new mock instance.
+func NewMockPlanner(ctrl *gomock.Controller) *MockPlanner {
+	mock := &MockPlanner{ctrl: ctrl}
+	mock.recorder = &MockPlannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPlanner) EXPECT() *MockPlannerMockRecorder {
+	return m.recorder
+}
+
+// Plan mocks base method.
+func (m *MockPlanner) Plan(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, targetRevision *string) (*domain.ResourceSyncPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Plan", ctx, orgId, rs, targetRevision)
+	ret0, _ := ret[0].(*domain.ResourceSyncPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Plan indicates an expected call of Plan.
+func (mr *MockPlannerMockRecorder) Plan(ctx, orgId, rs, targetRevision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Plan", reflect.TypeOf((*MockPlanner)(nil).Plan), ctx, orgId, rs, targetRevision)
+}
@@ -15,4 +15,10 @@ type Service interface {
 	DeleteResourceSync(ctx context.Context, orgId uuid.UUID, name string) domain.Status
 	PatchResourceSync(ctx context.Context, orgId uuid.UUID, name string, patch domain.PatchRequest) (*domain.ResourceSync, domain.Status)
 	ReplaceResourceSyncStatus(ctx context.Context, orgId uuid.UUID, name string, resourceSync domain.ResourceSync) (*domain.ResourceSync, domain.Status)
+	PlanResourceSync(ctx context.Context, orgId uuid.UUID, name string, targetRevision *string) (*domain.ResourceSyncPlan, domain.Status)
+}
+
+// Planner computes the changes a ResourceSync would apply without applying them.
+type Planner interface {
+	Plan(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, targetRevision *string) (*domain.ResourceSyncPlan, error)
 }
@@ -84,6 +84,14 @@ func (_d *TracedService) PatchResourceSync(ctx context.Context, orgId uuid.UUID,
 	return rp1, s1
 }
 
+func (_d *TracedService) PlanResourceSync(ctx context.Context, orgId uuid.UUID, name string, targetRevision *string) (rp1 *domain.ResourceSyncPlan, s1 domain.Status) {
+	ctx, span := startSpan(ctx, "PlanResourceSync")
+
+	rp1, s1 = _d.inner.PlanResourceSync(ctx, orgId, name, targetRevision)
+	endSpan(span, s1)
+	return rp1, s1
+}
+
 func (_d *TracedService) ReplaceResourceSync(ctx context.Context, orgId uuid.UUID, name string, rs domain.ResourceSync) (rp1 *domain.ResourceSync, s1 domain.Status) {
 	ctx, span := startSpan(ctx, "ReplaceResourceSync")
 
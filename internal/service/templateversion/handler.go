@@ -4,13 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service/common"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/service/fleet"
 	"github.com/flightctl/flightctl/internal/store"
+	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/store/selector"
 	templateversionstore "github.com/flightctl/flightctl/internal/store/templateversion"
 	"github.com/google/uuid"
@@ -19,15 +23,16 @@ import (
 )
 
 type ServiceHandler struct {
-	store   templateversionstore.Store
-	kvStore kvstore.KVStore
-	events  events.Service
-	log     logrus.FieldLogger
+	store      templateversionstore.Store
+	fleetStore fleetstore.Store
+	kvStore    kvstore.KVStore
+	events     events.Service
+	log        logrus.FieldLogger
 }
 
 // NewServiceHandler creates a new templateversion ServiceHandler instance.
-func NewServiceHandler(store templateversionstore.Store, kvStore kvstore.KVStore, events events.Service, log logrus.FieldLogger) *ServiceHandler {
-	return &ServiceHandler{store: store, kvStore: kvStore, events: events, log: log}
+func NewServiceHandler(store templateversionstore.Store, fleetStore fleetstore.Store, kvStore kvstore.KVStore, events events.Service, log logrus.FieldLogger) *ServiceHandler {
+	return &ServiceHandler{store: store, fleetStore: fleetStore, kvStore: kvStore, events: events, log: log}
 }
 
 var _ Service = (*ServiceHandler)(nil)
@@ -47,6 +52,10 @@ func (h *ServiceHandler) CreateTemplateVersion(ctx context.Context, orgId uuid.U
 func (h *ServiceHandler) ListTemplateVersions(ctx context.Context, orgId uuid.UUID, fleet string, params domain.ListTemplateVersionsParams) (*domain.TemplateVersionList, domain.Status) {
 	var err error
 
+	if status := h.checkFleetAccessScope(ctx, orgId, fleet); status.Code != http.StatusOK {
+		return nil, status
+	}
+
 	listParams, status := common.PrepareListParams(params.Continue, params.LabelSelector, params.FieldSelector, params.Limit)
 	if status != domain.StatusOK() {
 		return nil, status
@@ -87,11 +96,18 @@ func (h *ServiceHandler) ListTemplateVersions(ctx context.Context, orgId uuid.UU
 }
 
 func (h *ServiceHandler) GetTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string, name string) (*domain.TemplateVersion, domain.Status) {
+	if status := h.checkFleetAccessScope(ctx, orgId, fleet); status.Code != http.StatusOK {
+		return nil, status
+	}
 	result, err := h.store.Get(ctx, orgId, fleet, name)
 	return result, common.StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, &name)
 }
 
 func (h *ServiceHandler) DeleteTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string, name string) domain.Status {
+	if status := h.checkFleetAccessScope(ctx, orgId, fleet); status.Code != http.StatusOK {
+		return status
+	}
+
 	tvkey := kvstore.TemplateVersionKey{OrgID: orgId, Fleet: fleet, TemplateVersion: name}
 	err := h.kvStore.DeleteKeysForTemplateVersion(ctx, tvkey.ComposeKey())
 	if err != nil {
@@ -103,10 +119,32 @@ func (h *ServiceHandler) DeleteTemplateVersion(ctx context.Context, orgId uuid.U
 }
 
 func (h *ServiceHandler) GetLatestTemplateVersion(ctx context.Context, orgId uuid.UUID, fleet string) (*domain.TemplateVersion, domain.Status) {
+	if status := h.checkFleetAccessScope(ctx, orgId, fleet); status.Code != http.StatusOK {
+		return nil, status
+	}
 	result, err := h.store.GetLatest(ctx, orgId, fleet)
 	return result, common.StoreErrorToApiStatus(err, false, domain.TemplateVersionKind, nil)
 }
 
+// checkFleetAccessScope verifies that the fleet owning the template versions, if it exists,
+// is in the request's access scope.
+func (h *ServiceHandler) checkFleetAccessScope(ctx context.Context, orgId uuid.UUID, fleetName string) domain.Status {
+	if _, ok := contextutil.GetAccessScopeFromContext(ctx); !ok {
+		return domain.StatusOK()
+	}
+	f, err := h.fleetStore.Get(ctx, orgId, fleetName)
+	if errors.Is(err, flterrors.ErrResourceNotFound) {
+		return domain.StatusOK()
+	}
+	if err != nil {
+		return common.StoreErrorToApiStatus(err, false, domain.FleetKind, &fleetName)
+	}
+	if !common.FleetInAccessScope(ctx, f) {
+		return common.StatusOutOfAccessScope(domain.FleetKind, fleetName)
+	}
+	return domain.StatusOK()
+}
+
 // callbackTemplateVersionUpdated is the template version-specific callback that handles template version events
 func (h *ServiceHandler) callbackTemplateVersionUpdated(ctx context.Context, resourceKind domain.ResourceKind, orgId uuid.UUID, name string, oldResource, newResource interface{}, created bool, err error) {
 	if err != nil {
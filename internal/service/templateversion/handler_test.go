@@ -5,11 +5,14 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/flightctl/flightctl/internal/contextutil"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/identity"
 	"github.com/flightctl/flightctl/internal/kvstore"
 	"github.com/flightctl/flightctl/internal/service/events"
 	"github.com/flightctl/flightctl/internal/store"
+	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/google/uuid"
 	"github.com/samber/lo"
@@ -21,6 +24,7 @@ const (
 	statusSuccessCode    = int32(200)
 	statusCreatedCode    = int32(201)
 	statusBadRequestCode = int32(400)
+	statusForbiddenCode  = int32(403)
 	statusNotFoundCode   = int32(404)
 )
 
@@ -107,6 +111,21 @@ func (f *fakeTemplateVersionStore) UpdateStatus(ctx context.Context, orgId uuid.
 	return nil
 }
 
+// fakeFleetStore embeds fleetstore.Store (nil) and overrides only Get, which the handler
+// uses to check the access scope of the owning fleet.
+type fakeFleetStore struct {
+	fleetstore.Store
+	fleets map[string]*domain.Fleet
+}
+
+func (f *fakeFleetStore) Get(ctx context.Context, orgId uuid.UUID, name string, opts ...fleetstore.GetOption) (*domain.Fleet, error) {
+	fleet, ok := f.fleets[name]
+	if !ok {
+		return nil, flterrors.ErrResourceNotFound
+	}
+	return fleet, nil
+}
+
 // fakeKVStore embeds kvstore.KVStore (nil) and overrides only DeleteKeysForTemplateVersion,
 // the sole method DeleteTemplateVersion calls.
 type fakeKVStore struct {
@@ -144,10 +163,13 @@ func (f *fakeEventsService) HandleGenericResourceDeletedEvents(ctx context.Conte
 
 func newTestHandler() (*ServiceHandler, *fakeTemplateVersionStore, *fakeKVStore, *fakeEventsService) {
 	tvStore := newFakeTemplateVersionStore()
+	fleetStore := &fakeFleetStore{fleets: map[string]*domain.Fleet{
+		"myfleet": {Metadata: domain.ObjectMeta{Name: lo.ToPtr("myfleet"), Labels: &map[string]string{"site": "paris"}}},
+	}}
 	kv := &fakeKVStore{}
 	ev := &fakeEventsService{}
 	logger := logrus.New()
-	return NewServiceHandler(tvStore, kv, ev, logger), tvStore, kv, ev
+	return NewServiceHandler(tvStore, fleetStore, kv, ev, logger), tvStore, kv, ev
 }
 
 func testTemplateVersion(fleet, name string) domain.TemplateVersion {
@@ -260,3 +282,52 @@ func TestDeleteTemplateVersion(t *testing.T) {
 		require.NotContains(t, fakeStore.items, tvKey{fleet: "myfleet", name: "v1"})
 	})
 }
+
+func TestTemplateVersionAccessScope(t *testing.T) {
+	tests := []struct {
+		name       string
+		scope      *identity.AccessScope
+		wantStatus int32
+	}{
+		{
+			name:       "fleet matching the label selector",
+			scope:      &identity.AccessScope{LabelSelectors: []string{"site=paris"}},
+			wantStatus: statusSuccessCode,
+		},
+		{
+			name:       "fleet in the scope",
+			scope:      &identity.AccessScope{Fleets: []string{"myfleet"}},
+			wantStatus: statusSuccessCode,
+		},
+		{
+			name:       "fleet outside the scope",
+			scope:      &identity.AccessScope{LabelSelectors: []string{"site=berlin"}, Fleets: []string{"otherfleet"}},
+			wantStatus: statusForbiddenCode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, fakeStore, _, _ := newTestHandler()
+			tv := testTemplateVersion("myfleet", "v1")
+			fakeStore.items[tvKey{fleet: "myfleet", name: "v1"}] = &tv
+			ctx := contextutil.WithAccessScope(context.Background(), tt.scope)
+			orgId := uuid.New()
+
+			_, status := h.ListTemplateVersions(ctx, orgId, "myfleet", domain.ListTemplateVersionsParams{})
+			require.Equal(t, tt.wantStatus, status.Code)
+
+			_, status = h.GetTemplateVersion(ctx, orgId, "myfleet", "v1")
+			require.Equal(t, tt.wantStatus, status.Code)
+
+			_, status = h.GetLatestTemplateVersion(ctx, orgId, "myfleet")
+			require.Equal(t, tt.wantStatus, status.Code)
+
+			status = h.DeleteTemplateVersion(ctx, orgId, "myfleet", "v1")
+			require.Equal(t, tt.wantStatus, status.Code)
+			if tt.wantStatus == statusForbiddenCode {
+				require.Contains(t, fakeStore.items, tvKey{fleet: "myfleet", name: "v1"})
+			}
+		})
+	}
+}
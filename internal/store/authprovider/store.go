@@ -27,6 +27,7 @@ type Store interface {
 	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.AuthProvider, error)
 	List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.AuthProviderList, error)
 	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error
+	UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.AuthProvider, eventCallback store.EventCallback) (*domain.AuthProvider, error)
 	GetAuthProviderByIssuerAndClientId(ctx context.Context, orgId uuid.UUID, issuer string, clientId string) (*domain.AuthProvider, error)
 	GetAuthProviderByAuthorizationUrl(ctx context.Context, orgId uuid.UUID, authorizationUrl string) (*domain.AuthProvider, error)
@@ -168,6 +169,18 @@ func (s *AuthProviderStore) Delete(ctx context.Context, orgId uuid.UUID, name st
 	return err
 }
 
+func (s *AuthProviderStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	db := s.getDB(ctx)
+	if tx != nil {
+		db = tx
+	}
+	result := db.Model(&model.AuthProvider{}).Where("org_id = ? AND owner = ?", orgId, owner).Updates(map[string]interface{}{
+		"owner":            nil,
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	return store.ErrorFromGormError(result.Error)
+}
+
 func (s *AuthProviderStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.AuthProvider, eventCallback store.EventCallback) (*domain.AuthProvider, error) {
 	return s.genericStore.UpdateStatus(ctx, orgId, resource)
 }
@@ -0,0 +1,178 @@
+package certificaterevocation
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/internal/store/model"
+	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type Store interface {
+	InitialMigration(ctx context.Context) error
+	// RevokeDeviceCertificates revokes every certificate issued to the device through its
+	// enrollment request and certificate signing requests, and returns all of the device's
+	// unexpired revocations. Certificates that are already revoked keep their original entry.
+	RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, deviceName string, reason string) ([]model.CertificateRevocation, error)
+	List(ctx context.Context, orgId uuid.UUID) ([]model.CertificateRevocation, error)
+	// ListByIssuer returns the unexpired revocations of certificates issued by the CA with the
+	// given subject key ID, across all organizations.
+	ListByIssuer(ctx context.Context, issuerKeyID string) ([]model.CertificateRevocation, error)
+	IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error)
+}
+
+type CertificateRevocationStore struct {
+	dbHandler *gorm.DB
+	log       logrus.FieldLogger
+}
+
+// Make sure we conform to the Store interface
+var _ Store = (*CertificateRevocationStore)(nil)
+
+func NewCertificateRevocationStore(db *gorm.DB, log logrus.FieldLogger) Store {
+	return &CertificateRevocationStore{dbHandler: db, log: log}
+}
+
+func (s *CertificateRevocationStore) getDB(ctx context.Context) *gorm.DB {
+	return s.dbHandler.WithContext(ctx)
+}
+
+func (s *CertificateRevocationStore) InitialMigration(ctx context.Context) error {
+	return s.getDB(ctx).AutoMigrate(&model.CertificateRevocation{})
+}
+
+func (s *CertificateRevocationStore) RevokeDeviceCertificates(ctx context.Context, orgId uuid.UUID, deviceName string, reason string) ([]model.CertificateRevocation, error) {
+	certs, err := s.deviceCertificates(ctx, orgId, deviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	revocations := make([]model.CertificateRevocation, 0, len(certs))
+	for _, cert := range certs {
+		if !cert.NotAfter.After(now) {
+			continue
+		}
+		revocations = append(revocations, model.CertificateRevocation{
+			IssuerKeyID:  IssuerKeyID(cert),
+			SerialNumber: SerialNumber(cert),
+			OrgID:        orgId,
+			DeviceName:   deviceName,
+			Reason:       reason,
+			RevokedAt:    now,
+			ExpiresAt:    cert.NotAfter.UTC(),
+		})
+	}
+	if len(revocations) > 0 {
+		result := s.getDB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&revocations)
+		if result.Error != nil {
+			return nil, store.ErrorFromGormError(result.Error)
+		}
+	}
+
+	var existing []model.CertificateRevocation
+	result := s.getDB(ctx).
+		Where("org_id = ? AND device_name = ? AND expires_at > ?", orgId, deviceName, now).
+		Order("revoked_at, serial_number").
+		Find(&existing)
+	if result.Error != nil {
+		return nil, store.ErrorFromGormError(result.Error)
+	}
+	return existing, nil
+}
+
+// deviceCertificates collects the certificates issued to the device: the enrollment
+// certificate from its enrollment request and any certificates from signing requests
+// the device owns.
+func (s *CertificateRevocationStore) deviceCertificates(ctx context.Context, orgId uuid.UUID, deviceName string) ([]*x509.Certificate, error) {
+	var pemCerts [][]byte
+
+	var er model.EnrollmentRequest
+	result := s.getDB(ctx).Where("org_id = ? AND name = ?", orgId, deviceName).Take(&er)
+	if result.Error != nil && !errors.Is(result.Error, gorm.ErrRecordNotFound) {
+		return nil, store.ErrorFromGormError(result.Error)
+	}
+	if result.Error == nil && er.Status != nil && er.Status.Data.Certificate != nil {
+		pemCerts = append(pemCerts, []byte(*er.Status.Data.Certificate))
+	}
+
+	var csrs []model.CertificateSigningRequest
+	result = s.getDB(ctx).Where("org_id = ? AND owner = ?", orgId, util.ResourceOwner(domain.DeviceKind, deviceName)).Find(&csrs)
+	if result.Error != nil {
+		return nil, store.ErrorFromGormError(result.Error)
+	}
+	for _, csr := range csrs {
+		if csr.Status != nil && csr.Status.Data.Certificate != nil {
+			pemCerts = append(pemCerts, *csr.Status.Data.Certificate)
+		}
+	}
+
+	certs := make([]*x509.Certificate, 0, len(pemCerts))
+	for _, pemCert := range pemCerts {
+		cert, err := fccrypto.ParsePEMCertificate(pemCert)
+		if err != nil {
+			// A certificate that cannot be parsed cannot be presented either
+			s.log.Warnf("skipping unparsable certificate of device %s/%s: %v", orgId, deviceName, err)
+			continue
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+func (s *CertificateRevocationStore) List(ctx context.Context, orgId uuid.UUID) ([]model.CertificateRevocation, error) {
+	var revocations []model.CertificateRevocation
+	result := s.getDB(ctx).
+		Where("org_id = ? AND expires_at > ?", orgId, time.Now().UTC()).
+		Order("revoked_at, serial_number").
+		Find(&revocations)
+	if result.Error != nil {
+		return nil, store.ErrorFromGormError(result.Error)
+	}
+	return revocations, nil
+}
+
+func (s *CertificateRevocationStore) ListByIssuer(ctx context.Context, issuerKeyID string) ([]model.CertificateRevocation, error) {
+	var revocations []model.CertificateRevocation
+	result := s.getDB(ctx).
+		Where("issuer_key_id = ? AND expires_at > ?", issuerKeyID, time.Now().UTC()).
+		Order("revoked_at, serial_number").
+		Find(&revocations)
+	if result.Error != nil {
+		return nil, store.ErrorFromGormError(result.Error)
+	}
+	return revocations, nil
+}
+
+func (s *CertificateRevocationStore) IsRevoked(ctx context.Context, issuerKeyID string, serialNumber string) (bool, error) {
+	var count int64
+	result := s.getDB(ctx).Model(&model.CertificateRevocation{}).
+		Where("issuer_key_id = ? AND serial_number = ?", issuerKeyID, serialNumber).
+		Count(&count)
+	if result.Error != nil {
+		return false, store.ErrorFromGormError(result.Error)
+	}
+	return count > 0, nil
+}
+
+// IssuerKeyID returns the key under which revocations of the certificate are recorded for
+// its issuer: the hex-encoded authority key ID.
+func IssuerKeyID(cert *x509.Certificate) string {
+	return hex.EncodeToString(cert.AuthorityKeyId)
+}
+
+// SerialNumber returns the hex-encoded serial number of the certificate.
+func SerialNumber(cert *x509.Certificate) string {
+	return fmt.Sprintf("%x", cert.SerialNumber)
+}
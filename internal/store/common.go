@@ -126,9 +126,40 @@ func (lq *listQuery) BuildNoOrder(ctx context.Context, db *gorm.DB, orgId uuid.U
 		query = query.Where(q, p...)
 	}
 
+	if listParams.AccessScope != nil {
+		scopeQuery, err := lq.buildAccessScope(ctx, db, listParams.AccessScope)
+		if err != nil {
+			return nil, err
+		}
+		query = query.Where(scopeQuery)
+	}
+
 	return query, nil
 }
 
+// buildAccessScope returns a condition matching the resources that match any of the scope's selectors.
+func (lq *listQuery) buildAccessScope(ctx context.Context, db *gorm.DB, scope *AccessScope) (*gorm.DB, error) {
+	scopeQuery := db.Session(&gorm.Session{NewDB: true}).Where("FALSE")
+
+	for _, ls := range scope.LabelSelectors {
+		q, p, err := ls.Parse(ctx, selector.NewHiddenSelectorName("metadata.labels"), lq.resolver)
+		if err != nil {
+			return nil, err
+		}
+		scopeQuery = scopeQuery.Or(q, p...)
+	}
+
+	for _, fs := range scope.FieldSelectors {
+		q, p, err := fs.Parse(ctx, lq.resolver)
+		if err != nil {
+			return nil, err
+		}
+		scopeQuery = scopeQuery.Or(q, p...)
+	}
+
+	return scopeQuery, nil
+}
+
 func getSortColumns(listParams ListParams) ([]SortColumn, SortOrder, string) {
 	order := SortAsc
 	if listParams.SortOrder != nil {
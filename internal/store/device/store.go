@@ -2,6 +2,7 @@ package device
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -77,6 +78,7 @@ type Store interface {
 	GetRepositoryRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.RepositoryList, error)
 	RemoveConflictPausedAnnotation(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (int64, []string, error)
 	SetOutOfDate(ctx context.Context, orgId uuid.UUID, owner string) error
+	UpdateIntegrityStatus(ctx context.Context, orgId uuid.UUID, name string, integrity domain.DeviceIntegrityStatus) error
 	ListConnectivityChanged(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, cutoffTime time.Time) (*domain.DeviceList, error)
 	GetWithTimestamp(ctx context.Context, orgId uuid.UUID, name string) (*domain.Device, error)
 
@@ -84,7 +86,7 @@ type Store interface {
 	Count(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (int64, error)
 	UnmarkRolloutSelection(ctx context.Context, orgId uuid.UUID, fleetName string) error
 	MarkRolloutSelection(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, limit *int) error
-	CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error)
+	CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, error)
 	CountByLabels(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, groupBy []string) ([]map[string]any, error)
 	Summary(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.DevicesSummary, error)
 
@@ -648,10 +650,16 @@ func (s *DeviceStore) Count(ctx context.Context, orgId uuid.UUID, listParams sto
 // - updating_reason: it is the reason field from a condition having type 'Updating'
 // - same_rendered_version: it is the result of comparison for equality between the annotation 'device-controller/renderedVersion' and the field 'status.config.renderedVersion'
 // - update_timed_out: it is a boolean value indicating if the update of the device has been timed out
-func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration) ([]domain.DeviceCompletionCount, error) {
+// - applications_status: taken from the field 'status.applicationsSummary.status'
+// - resources_critical: it is true if any of the fields 'status.resources.cpu', 'status.resources.memory' or 'status.resources.disk' is 'Critical'
+// - soaked: it is a boolean value indicating if the soak duration has passed since the last transition of the 'Updating' condition
+// - healthy_soaked: it is a boolean value indicating if the soak duration has passed since the time in the annotation 'fleet-controller/healthySince'
+func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, owner string, templateVersion string, updateTimeout *time.Duration, soakDuration *time.Duration) ([]domain.DeviceCompletionCount, error) {
 	var (
 		results            []domain.DeviceCompletionCount
 		updateTimeoutValue any
+		soakedValue        any
+		healthySoakedValue any
 	)
 
 	if updateTimeout != nil {
@@ -659,11 +667,22 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
 	} else {
 		updateTimeoutValue = gorm.Expr("false")
 	}
+	if soakDuration != nil {
+		soakedValue = gorm.Expr("coalesce((elem ->> 'lastTransitionTime')::timestamptz, render_timestamp) < ?", time.Now().Add(-(*soakDuration)))
+		healthySoakedValue = gorm.Expr("(annotations ->> ?)::timestamptz < ?", domain.DeviceAnnotationHealthySince, time.Now().Add(-(*soakDuration)))
+	} else {
+		soakedValue = gorm.Expr("true")
+		healthySoakedValue = gorm.Expr("true")
+	}
 	err := s.getDB(ctx).Raw(fmt.Sprintf(`select count(*) as count,
                                  status -> 'config' ->> 'renderedVersion' = annotations->>'%s' AS same_rendered_version,
                                  elem ->> 'reason' as updating_reason,
                                  annotations->>'%s' = ? as same_template_version,
-								 ? as update_timed_out
+								 ? as update_timed_out,
+								 status -> 'applicationsSummary' ->> 'status' as applications_status,
+								 coalesce('%s' in (status -> 'resources' ->> 'cpu', status -> 'resources' ->> 'memory', status -> 'resources' ->> 'disk'), false) as resources_critical,
+								 coalesce(?, false) as soaked,
+								 coalesce(?, false) as healthy_soaked
                           from devices d LEFT JOIN LATERAL (
                             SELECT elem
 						    FROM jsonb_array_elements(d.status->'conditions') AS elem
@@ -672,10 +691,12 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
 							) subquery ON TRUE
 						     where
 						        org_id = ? and owner = ? and annotations ? '%s' and deleted_at is null
-						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out`,
-		domain.DeviceAnnotationRenderedVersion, domain.DeviceAnnotationRenderedTemplateVersion, domain.DeviceAnnotationSelectedForRollout),
+						        group by same_rendered_version, updating_reason, same_template_version, update_timed_out, applications_status, resources_critical, soaked, healthy_soaked`,
+		domain.DeviceAnnotationRenderedVersion, domain.DeviceAnnotationRenderedTemplateVersion, domain.DeviceResourceStatusCritical, domain.DeviceAnnotationSelectedForRollout),
 		templateVersion,
 		updateTimeoutValue,
+		soakedValue,
+		healthySoakedValue,
 		orgId,
 		owner,
 		gorm.Expr("?")).Scan(&results).Error
@@ -688,7 +709,7 @@ func (s *DeviceStore) CompletionCounts(ctx context.Context, orgId uuid.UUID, own
 func (s *DeviceStore) unmarkRolloutSelection(ctx context.Context, orgId uuid.UUID, fleetName string) (bool, error) {
 	err := s.getDB(ctx).Model(&model.Device{}).Where("org_id = ? and owner = ? and annotations ? ?",
 		orgId, util.ResourceOwner(domain.FleetKind, fleetName), gorm.Expr("?"), domain.DeviceAnnotationSelectedForRollout).Updates(map[string]any{
-		"annotations":      gorm.Expr("annotations - ?", domain.DeviceAnnotationSelectedForRollout),
+		"annotations":      gorm.Expr("annotations - ? - ?", domain.DeviceAnnotationSelectedForRollout, domain.DeviceAnnotationHealthySince),
 		"resource_version": gorm.Expr("resource_version + 1"),
 	}).Error
 	err = store.ErrorFromGormError(err)
@@ -698,7 +719,7 @@ func (s *DeviceStore) unmarkRolloutSelection(ctx context.Context, orgId uuid.UUI
 	return false, nil
 }
 
-// UnmarkRolloutSelection unmarks all previously marked devices for rollout in a fleet
+// UnmarkRolloutSelection unmarks all previously marked devices for rollout in a fleet, and clears their health tracking
 func (s *DeviceStore) UnmarkRolloutSelection(ctx context.Context, orgId uuid.UUID, fleetName string) error {
 	return retryUpdate(func() (bool, error) {
 		return s.unmarkRolloutSelection(ctx, orgId, fleetName)
@@ -716,7 +737,7 @@ func (s *DeviceStore) markRolloutSelection(ctx context.Context, orgId uuid.UUID,
 			query.Select("name"))
 	}
 	err = query.Updates(map[string]any{
-		"annotations":      gorm.Expr(fmt.Sprintf(`jsonb_set(COALESCE(annotations, '{}'::jsonb), '{%s}', '""')`, domain.DeviceAnnotationSelectedForRollout)),
+		"annotations":      gorm.Expr(fmt.Sprintf(`jsonb_set(COALESCE(annotations, '{}'::jsonb) - ?, '{%s}', '""')`, domain.DeviceAnnotationSelectedForRollout), domain.DeviceAnnotationHealthySince),
 		"resource_version": gorm.Expr("resource_version + 1")}).Error
 	err = store.ErrorFromGormError(err)
 	if err != nil {
@@ -726,7 +747,7 @@ func (s *DeviceStore) markRolloutSelection(ctx context.Context, orgId uuid.UUID,
 }
 
 // MarkRolloutSelection marks all devices that can be filtered by the list params.  If limit is provided then the number of marked devices
-// will not be greater than the provided limit.
+// will not be greater than the provided limit.  The health tracking of the marked devices starts over.
 func (s *DeviceStore) MarkRolloutSelection(ctx context.Context, orgId uuid.UUID, listParams store.ListParams, limit *int) error {
 	return retryUpdate(func() (bool, error) {
 		return s.markRolloutSelection(ctx, orgId, listParams, limit)
@@ -827,6 +848,32 @@ func (s *DeviceStore) Summary(ctx context.Context, orgId uuid.UUID, listParams s
 	}, nil
 }
 
+func (s *DeviceStore) updateIntegrityStatus(ctx context.Context, orgId uuid.UUID, name string, integrity []byte) (bool, error) {
+	result := s.getDB(ctx).Model(&model.Device{}).Where("org_id = ? AND name = ?", orgId, name).Updates(map[string]any{
+		"status":           gorm.Expr(`jsonb_set(COALESCE(status, '{}'::jsonb), '{integrity}', ?::jsonb)`, string(integrity)),
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	if result.Error != nil {
+		return strings.Contains(result.Error.Error(), "deadlock"), store.ErrorFromGormError(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return false, flterrors.ErrResourceNotFound
+	}
+	return false, nil
+}
+
+// UpdateIntegrityStatus replaces only the integrity of the device's status, leaving the rest of the
+// status, which the agent reports concurrently, untouched.
+func (s *DeviceStore) UpdateIntegrityStatus(ctx context.Context, orgId uuid.UUID, name string, integrity domain.DeviceIntegrityStatus) error {
+	integrityJson, err := json.Marshal(integrity)
+	if err != nil {
+		return err
+	}
+	return retryUpdate(func() (bool, error) {
+		return s.updateIntegrityStatus(ctx, orgId, name, integrityJson)
+	})
+}
+
 func (s *DeviceStore) UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.Device, eventCallback store.EventCallback) (*domain.Device, error) {
 	var oldDevice domain.Device
 	name := lo.FromPtr(resource.Metadata.Name)
@@ -76,6 +76,7 @@ func (a *AuthProvider) ToApiResource(opts ...APIResourceOption) (*domain.AuthPro
 			CreationTimestamp: lo.ToPtr(a.CreatedAt.UTC()),
 			Labels:            lo.ToPtr(util.EnsureMap(a.Resource.Labels)),
 			Annotations:       lo.ToPtr(util.EnsureMap(a.Resource.Annotations)),
+			Owner:             a.Owner,
 			Generation:        a.Generation,
 			ResourceVersion:   lo.Ternary(a.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(a.ResourceVersion), 10)), nil),
 		},
@@ -0,0 +1,54 @@
+// Copyright (c) Flight Control Authors. Licensed under Apache-2.0.
+
+package model
+
+import (
+	"time"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// CertificateRevocation records a device certificate that must no longer be
+// accepted. Entries are keyed by the issuing CA's subject key ID and the
+// certificate's serial number, which together identify the certificate.
+type CertificateRevocation struct {
+	// Hex-encoded subject key ID of the issuing CA certificate.
+	IssuerKeyID string `gorm:"primaryKey"`
+	// Hex-encoded serial number of the revoked certificate.
+	SerialNumber string    `gorm:"primaryKey"`
+	OrgID        uuid.UUID `gorm:"type:uuid;index:certificate_revocations_org_device,priority:1"`
+	DeviceName   string    `gorm:"index:certificate_revocations_org_device,priority:2"`
+	Reason       string
+	RevokedAt    time.Time
+	// The revoked certificate's NotAfter; expired entries are no longer listed.
+	ExpiresAt time.Time `gorm:"index"`
+}
+
+func (CertificateRevocation) TableName() string {
+	return "certificate_revocations"
+}
+
+func (r CertificateRevocation) ToApiResource() domain.CertificateRevocation {
+	return domain.CertificateRevocation{
+		SerialNumber: r.SerialNumber,
+		DeviceName:   r.DeviceName,
+		Reason:       lo.EmptyableToPtr(r.Reason),
+		RevokedAt:    r.RevokedAt,
+		ExpiresAt:    r.ExpiresAt,
+	}
+}
+
+func CertificateRevocationsToApiResource(revocations []CertificateRevocation) domain.CertificateRevocationList {
+	items := make([]domain.CertificateRevocation, 0, len(revocations))
+	for _, r := range revocations {
+		items = append(items, r.ToApiResource())
+	}
+	return domain.CertificateRevocationList{
+		ApiVersion: domain.CertificateSigningRequestAPIVersion,
+		Kind:       domain.CertificateRevocationListKind,
+		Metadata:   domain.ListMeta{},
+		Items:      items,
+	}
+}
@@ -85,7 +85,7 @@ func (f *Fleet) ToApiResource(opts ...APIResourceOption) (*domain.Fleet, error)
 	}
 	status.DevicesSummary = options.devicesSummary
 
-	return &domain.Fleet{
+	fleet := &domain.Fleet{
 		ApiVersion: FleetAPIVersion(),
 		Kind:       domain.FleetKind,
 		Metadata: domain.ObjectMeta{
@@ -99,7 +99,22 @@ func (f *Fleet) ToApiResource(opts ...APIResourceOption) (*domain.Fleet, error)
 		},
 		Spec:   spec,
 		Status: &status,
-	}, nil
+	}
+
+	// The rollback of a failed rollout is tracked in an annotation by the device selection reconciler
+	if rollback := fleet.GetRolloutRollback(); rollback != nil {
+		rollout := lo.FromPtr(status.Rollout)
+		rollout.Rollback = rollback
+		status.Rollout = &rollout
+	}
+
+	// The device selection reconciler tracks in an annotation when a rollout waits for a maintenance window
+	if nextWindow := fleet.GetRolloutNextMaintenanceWindow(); nextWindow != nil {
+		rollout := lo.FromPtr(status.Rollout)
+		rollout.NextMaintenanceWindow = nextWindow
+		status.Rollout = &rollout
+	}
+	return fleet, nil
 }
 
 func FleetsToApiResource(fleets []Fleet, cont *string, numRemaining *int64) (domain.FleetList, error) {
@@ -53,6 +53,7 @@ func NewRepositoryFromApiResource(resource *domain.Repository) (*Repository, err
 	return &Repository{
 		Resource: Resource{
 			Name:            *resource.Metadata.Name,
+			Owner:           resource.Metadata.Owner,
 			Labels:          lo.FromPtrOr(resource.Metadata.Labels, make(map[string]string)),
 			Annotations:     lo.FromPtrOr(resource.Metadata.Annotations, make(map[string]string)),
 			ResourceVersion: resourceVersion,
@@ -89,6 +90,7 @@ func (r *Repository) ToApiResource(opts ...APIResourceOption) (*domain.Repositor
 			CreationTimestamp: lo.ToPtr(r.CreatedAt.UTC()),
 			Labels:            lo.ToPtr(util.EnsureMap(r.Resource.Labels)),
 			Annotations:       lo.ToPtr(util.EnsureMap(r.Resource.Annotations)),
+			Owner:             r.Owner,
 			ResourceVersion:   lo.Ternary(r.ResourceVersion != nil, lo.ToPtr(strconv.FormatInt(lo.FromPtr(r.ResourceVersion), 10)), nil),
 		},
 		Spec:   spec,
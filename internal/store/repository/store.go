@@ -21,6 +21,7 @@ type Store interface {
 	Get(ctx context.Context, orgId uuid.UUID, name string) (*domain.Repository, error)
 	List(ctx context.Context, orgId uuid.UUID, listParams store.ListParams) (*domain.RepositoryList, error)
 	Delete(ctx context.Context, orgId uuid.UUID, name string, eventCallback store.EventCallback) error
+	UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error
 	UpdateStatus(ctx context.Context, orgId uuid.UUID, resource *domain.Repository, eventCallback store.EventCallback) (*domain.Repository, error)
 
 	GetFleetRefs(ctx context.Context, orgId uuid.UUID, name string) (*domain.FleetList, error)
@@ -137,6 +138,18 @@ func (s *RepositoryStore) Delete(ctx context.Context, orgId uuid.UUID, name stri
 	return err
 }
 
+func (s *RepositoryStore) UnsetOwner(ctx context.Context, tx *gorm.DB, orgId uuid.UUID, owner string) error {
+	db := s.getDB(ctx)
+	if tx != nil {
+		db = tx
+	}
+	result := db.Model(&model.Repository{}).Where("org_id = ? AND owner = ?", orgId, owner).Updates(map[string]interface{}{
+		"owner":            nil,
+		"resource_version": gorm.Expr("resource_version + 1"),
+	})
+	return store.ErrorFromGormError(result.Error)
+}
+
 func (s *RepositoryStore) GetInternal(ctx context.Context, orgId uuid.UUID, name string) (*model.Repository, error) {
 	repository := model.Repository{
 		Resource: model.Resource{OrgID: orgId, Name: name},
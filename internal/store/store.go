@@ -41,6 +41,14 @@ type ListParams struct {
 	AnnotationSelector *selector.AnnotationSelector
 	SortOrder          *SortOrder
 	SortColumns        []SortColumn
+	AccessScope        *AccessScope
+}
+
+// AccessScope limits a list to the resources matching at least one of its label or field selectors.
+// An empty scope matches nothing.
+type AccessScope struct {
+	LabelSelectors []*selector.LabelSelector
+	FieldSelectors []*selector.FieldSelector
 }
 
 type Continue struct {
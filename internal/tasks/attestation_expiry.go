@@ -0,0 +1,37 @@
+package tasks
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/service/deviceattestation"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// AttestationExpiryPollingInterval is the interval at which stale device attestations are expired.
+	AttestationExpiryPollingInterval = 5 * time.Minute
+)
+
+type AttestationExpiry struct {
+	log            logrus.FieldLogger
+	attestationSvc deviceattestation.Service
+}
+
+func NewAttestationExpiry(log logrus.FieldLogger, attestationSvc deviceattestation.Service) *AttestationExpiry {
+	return &AttestationExpiry{
+		log:            log,
+		attestationSvc: attestationSvc,
+	}
+}
+
+// Poll fails the measured boot check of the devices that did not attest within the maximum age
+// of their fleet's attestation policy.
+func (t *AttestationExpiry) Poll(ctx context.Context, orgID uuid.UUID) {
+	t.log.Info("Running AttestationExpiry Polling")
+	if status := t.attestationSvc.ExpireStaleAttestations(ctx, orgID); status.Code != http.StatusOK {
+		t.log.Errorf("Failed to expire stale attestations: %s", status.Message)
+	}
+}
@@ -19,8 +19,9 @@ const (
 )
 
 var (
-	ErrUnknownConfigName      = errors.New("failed to find configuration item name")
-	ErrUnknownApplicationType = errors.New("unknown application type")
+	ErrUnknownConfigName         = errors.New("failed to find configuration item name")
+	ErrUnknownApplicationType    = errors.New("unknown application type")
+	ErrMissingTemplateParameters = errors.New("missing template parameters")
 )
 
 func getOwnerFleet(device *domain.Device) (string, bool, error) {
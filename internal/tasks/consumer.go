@@ -192,6 +192,11 @@ func shouldRolloutFleet(ctx context.Context, event domain.Event, log logrus.Fiel
 		return true
 	}
 
+	// If a failed rollout is being rolled back, return true
+	if event.Reason == domain.EventReasonFleetRolloutRollbackStarted && event.InvolvedObject.Kind == domain.FleetKind {
+		return true
+	}
+
 	// If a device was created, return true
 	if event.Reason == domain.EventReasonResourceCreated && event.InvolvedObject.Kind == domain.DeviceKind {
 		return true
@@ -240,9 +245,9 @@ func shouldReconcileDeviceOwnership(ctx context.Context, event domain.Event, log
 }
 
 func shouldValidateFleet(ctx context.Context, event domain.Event, log logrus.FieldLogger) bool {
-	// If a fleet's template was updated, return true
+	// If a fleet's template or parameters were updated, return true
 	if event.Reason == domain.EventReasonResourceUpdated && event.InvolvedObject.Kind == domain.FleetKind {
-		return hasUpdatedFields(event.Details, log, domain.SpecTemplate)
+		return hasUpdatedFields(event.Details, log, domain.SpecTemplate, domain.SpecParameters)
 	}
 
 	// If a fleet was created, return true
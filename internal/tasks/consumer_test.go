@@ -87,6 +87,11 @@ func TestShouldRolloutFleet(t *testing.T) {
 			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutBatchDispatched, "fleet1"),
 			expected: true,
 		},
+		{
+			name:     "FleetRolloutRollbackStarted",
+			event:    createTestEvent(domain.FleetKind, domain.EventReasonFleetRolloutRollbackStarted, "fleet1"),
+			expected: true,
+		},
 		{
 			name:     "DeviceCreated",
 			event:    createTestEvent(domain.DeviceKind, domain.EventReasonResourceCreated, "device1"),
@@ -201,6 +206,11 @@ func TestShouldValidateFleet(t *testing.T) {
 			event:    createTestEventWithDetails(domain.FleetKind, domain.EventReasonResourceUpdated, "fleet1", createResourceUpdatedDetails(t, domain.SpecTemplate)),
 			expected: true,
 		},
+		{
+			name:     "FleetUpdatedWithParameters",
+			event:    createTestEventWithDetails(domain.FleetKind, domain.EventReasonResourceUpdated, "fleet1", createResourceUpdatedDetails(t, domain.SpecParameters)),
+			expected: true,
+		},
 		{
 			name:     "FleetUpdatedWithOtherFields",
 			event:    createTestEventWithDetails(domain.FleetKind, domain.EventReasonResourceUpdated, "fleet1", createResourceUpdatedDetails(t, domain.SpecSelector)),
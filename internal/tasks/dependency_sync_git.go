@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"slices"
 	"sync"
 	"time"
 
@@ -59,11 +60,23 @@ type probeResult struct {
 }
 
 func (d *DependencySyncGit) Poll(ctx context.Context, orgId uuid.UUID) {
+	d.sync(ctx, orgId, d.cfg.GetDependenciesSyncPollInterval(), nil)
+}
+
+// Refresh probes the git dependencies on the given repositories immediately,
+// regardless of when they were last checked. It is used to react to a push to
+// a repository without waiting for the next poll.
+func (d *DependencySyncGit) Refresh(ctx context.Context, orgId uuid.UUID, repositories []string) {
+	d.sync(ctx, orgId, 0, repositories)
+}
+
+// sync probes the git dependencies not checked within pollInterval. If
+// repositories is non-empty, only dependencies on those repositories are probed.
+func (d *DependencySyncGit) sync(ctx context.Context, orgId uuid.UUID, pollInterval time.Duration, repositories []string) {
 	if d.metrics != nil {
 		d.metrics.ObserveProbeCycle(periodic.RefTypeGit)
 	}
 
-	pollInterval := d.cfg.GetDependenciesSyncPollInterval()
 	probeStart := time.Now()
 
 	probes, status := d.dependencyrefSvc.ListDueGitDependencies(ctx, orgId, pollInterval)
@@ -71,14 +84,17 @@ func (d *DependencySyncGit) Poll(ctx context.Context, orgId uuid.UUID) {
 		d.log.Errorf("failed listing due git dependencies: %s", status.Message)
 		return
 	}
-	if len(probes) == 0 {
-		return
-	}
 
 	repoGroups := make(map[string][]*model.GitDependencyProbe)
 	for i := range probes {
+		if len(repositories) > 0 && !slices.Contains(repositories, probes[i].RepositoryName) {
+			continue
+		}
 		repoGroups[probes[i].RepositoryName] = append(repoGroups[probes[i].RepositoryName], &probes[i])
 	}
+	if len(repoGroups) == 0 {
+		return
+	}
 
 	var (
 		mu      sync.Mutex
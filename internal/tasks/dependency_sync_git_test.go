@@ -327,6 +327,38 @@ func TestDependencySyncGit_Poll(t *testing.T) {
 	})
 }
 
+func TestDependencySyncGit_Refresh(t *testing.T) {
+	orgId := uuid.New()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+	mockEventSvc := eventservice.NewMockService(ctrl)
+	mockSyncStateSvc := syncstateservice.NewMockService(ctrl)
+
+	probes := []model.GitDependencyProbe{
+		makeProbe("pushed-repo", "main", lo.ToPtr("samesha1"), model.StringArray{"fleet-1"}, nil, gitRepoSpec(t, "https://example.com/pushed.git")),
+		makeProbe("other-repo", "main", lo.ToPtr("samesha2"), model.StringArray{"fleet-2"}, nil, gitRepoSpec(t, "https://example.com/other.git")),
+	}
+	// A refresh ignores when the dependencies were last checked.
+	mockDependencyRefSvc.EXPECT().ListDueGitDependencies(gomock.Any(), orgId, time.Duration(0)).Return(probes, statusOK)
+
+	var probedURLs []string
+	lsRemote := func(_ context.Context, repoURL string, _ []string, _ transport.AuthMethod) (map[string]string, error) {
+		probedURLs = append(probedURLs, repoURL)
+		return map[string]string{"main": "samesha1"}, nil
+	}
+
+	mockSyncStateSvc.EXPECT().BulkUpdateSyncStateLastCheckedAt(gomock.Any(), orgId, []string{"git:pushed-repo/main"}, gomock.Any()).Return(statusOK)
+
+	d := &DependencySyncGit{
+		log: logrus.New(), dependencyrefSvc: mockDependencyRefSvc, eventSvc: mockEventSvc, syncstateSvc: mockSyncStateSvc,
+		cfg: &config.Config{}, lsRemote: lsRemote, maxConcurrent: 10,
+	}
+	d.Refresh(context.Background(), orgId, []string{"pushed-repo"})
+
+	assert.Equal(t, []string{"https://example.com/pushed.git"}, probedURLs)
+}
+
 func TestNewDependencySyncGit(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -720,7 +720,7 @@ func (t *DeviceRenderLogic) renderHttpProviderConfig(ctx context.Context, config
 	}
 
 	if httpData == nil {
-		httpData, err = sendHTTPrequest(ctx, repo.Spec, repoURL)
+		httpData, err = SendHTTPRequest(ctx, repo.Spec, repoURL)
 		if err != nil {
 			return &httpConfigProviderSpec.Name, nil, nil, fmt.Errorf("failed fetching data: %w", err)
 		}
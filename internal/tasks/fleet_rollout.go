@@ -126,9 +126,9 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 	}
 	f.log.Infof("Rolling out fleet %s/%s", f.orgId, f.event.InvolvedObject.Name)
 
-	templateVersion, status := f.templateversionSvc.GetLatestTemplateVersion(ctx, f.orgId, f.event.InvolvedObject.Name)
-	if status.Code != http.StatusOK {
-		return fmt.Errorf("failed to get templateVersion: %s", status.Message)
+	templateVersion, rollback, err := f.getRolloutTemplateVersion(ctx, fleet)
+	if err != nil {
+		return err
 	}
 
 	owner := util.SetResourceOwner(domain.FleetKind, f.event.InvolvedObject.Name)
@@ -152,7 +152,7 @@ func (f FleetRolloutsLogic) RolloutFleet(ctx context.Context) error {
 		}.String())
 	}
 	annotationSelector := selector.NewAnnotationSelectorOrDie(strings.Join(annotationFilter, ","))
-	delayDeviceRender := fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
+	delayDeviceRender := rollback == nil && fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
 
 	failureCount := 0
 	var allDeviceRefs []model.DependencyRef
@@ -242,16 +242,16 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	}
 	f.owner = *device.Metadata.Owner
 
-	templateVersion, status := f.templateversionSvc.GetLatestTemplateVersion(ctx, f.orgId, ownerName)
-	if status.Code != http.StatusOK {
-		return fmt.Errorf("failed to get templateVersion: %s", status.Message)
-	}
-
 	fleet, status := f.fleetSvc.GetFleet(ctx, f.orgId, ownerName, domain.GetFleetParams{})
 	if status.Code != http.StatusOK {
 		return fmt.Errorf("failed to get fleet: %s", status.Message)
 	}
 
+	templateVersion, rollback, err := f.getRolloutTemplateVersion(ctx, fleet)
+	if err != nil {
+		return err
+	}
+
 	if err := f.syncFleetApplicationLifecycleDefault(ctx, device, fleet); err != nil {
 		f.log.Errorf("failed to sync fleet application lifecycle default to device %s: %v", f.event.InvolvedObject.Name, err)
 	}
@@ -260,12 +260,12 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("failed to find rollout progress stage for fleet: %w", err)
 	}
-	if rolloutProgressStage == rollout.ConfiguredBatch {
+	if rolloutProgressStage == rollout.ConfiguredBatch && rollback == nil {
 		// If a rollout is in progress, then the device will be rolled out by one of the next batches
 		f.log.Infof("Rollout is in progress for fleet %v/%s. Skipping device %s rollout", f.orgId, lo.FromPtr(fleet.Metadata.Name), f.event.InvolvedObject.Name)
 		return nil
 	}
-	delayDeviceRender := fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
+	delayDeviceRender := rollback == nil && fleet.Spec.RolloutPolicy != nil && fleet.Spec.RolloutPolicy.DisruptionBudget != nil
 	refs, err := f.updateDeviceToFleetTemplate(ctx, device, templateVersion, delayDeviceRender)
 	if err != nil {
 		return err
@@ -277,6 +277,26 @@ func (f FleetRolloutsLogic) RolloutDevice(ctx context.Context) error {
 	return nil
 }
 
+// getRolloutTemplateVersion returns the template version that the devices of the fleet should be rolled out to.
+// This is the latest template version of the fleet, unless its rollout failed and was rolled back.  In that case
+// it is the template version that the rollout was rolled back to, and the rollback status is returned as well.
+func (f FleetRolloutsLogic) getRolloutTemplateVersion(ctx context.Context, fleet *domain.Fleet) (*domain.TemplateVersion, *domain.FleetRolloutRollbackStatus, error) {
+	fleetName := lo.FromPtr(fleet.Metadata.Name)
+	rollback := fleet.GetRolloutRollback()
+	if rollback != nil {
+		templateVersion, status := f.templateversionSvc.GetTemplateVersion(ctx, f.orgId, fleetName, rollback.ToTemplateVersion)
+		if status.Code != http.StatusOK {
+			return nil, nil, fmt.Errorf("failed to get rollback templateVersion %s: %s", rollback.ToTemplateVersion, status.Message)
+		}
+		return templateVersion, rollback, nil
+	}
+	templateVersion, status := f.templateversionSvc.GetLatestTemplateVersion(ctx, f.orgId, fleetName)
+	if status.Code != http.StatusOK {
+		return nil, nil, fmt.Errorf("failed to get templateVersion: %s", status.Message)
+	}
+	return templateVersion, nil, nil
+}
+
 // syncFleetApplicationLifecycleDefault bootstraps the device's local cache of the owning
 // fleet's application lifecycle default so device-render can read it without a Fleet lookup
 // of its own. This only ever runs once per device, the first time it is rolled out with no
@@ -307,24 +327,8 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 			currentRenderedVersion = v
 		}
 	}
-	errs := []error{}
-
-	var osSpec *domain.DeviceOsSpec
-	if templateVersion.Status.Os != nil {
-		img, err := ReplaceParametersInString(templateVersion.Status.Os.Image, device)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("failed replacing parameters in OS image: %w", err))
-		} else {
-			osSpec = &domain.DeviceOsSpec{Image: img}
-		}
-	}
-
-	deviceConfig, depRefs, configErrs := f.getDeviceConfig(device, templateVersion)
-	errs = append(errs, configErrs...)
-
-	deviceApps, appErrs := f.getDeviceApps(device, templateVersion)
-	errs = append(errs, appErrs...)
 
+	newDeviceSpec, depRefs, errs := f.renderDeviceSpec(device, templateVersion)
 	if len(errs) > 0 {
 		annotations := map[string]string{
 			domain.DeviceAnnotationLastRolloutError: errors.Join(errs...).Error(),
@@ -339,15 +343,6 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 		return nil, fmt.Errorf("failed generating device spec for %s/%s: %w", f.orgId, *device.Metadata.Name, errors.Join(errs...))
 	}
 
-	newDeviceSpec := domain.DeviceSpec{
-		Config:       deviceConfig,
-		Os:           osSpec,
-		Systemd:      templateVersion.Status.Systemd,
-		Resources:    templateVersion.Status.Resources,
-		Applications: deviceApps,
-		UpdatePolicy: templateVersion.Status.UpdatePolicy,
-	}
-
 	errs = newDeviceSpec.Validate(false)
 	if len(errs) > 0 {
 		return nil, fmt.Errorf("failed validating device spec for %s/%s: %w", f.orgId, *device.Metadata.Name, errors.Join(errs...))
@@ -375,6 +370,79 @@ func (f FleetRolloutsLogic) updateDeviceToFleetTemplate(ctx context.Context, dev
 	return depRefs, nil
 }
 
+// renderDeviceSpec evaluates the template version against the device, substituting the
+// device's labels and the fleet parameters that apply to it.
+func (f FleetRolloutsLogic) renderDeviceSpec(device *domain.Device, templateVersion *domain.TemplateVersion) (domain.DeviceSpec, []model.DependencyRef, []error) {
+	errs := []error{}
+
+	var osSpec *domain.DeviceOsSpec
+	if templateVersion.Status.Os != nil {
+		parameters := domain.GetDeviceTemplateParameters(device, templateVersion.Status.Parameters)
+		img, err := ReplaceParametersInString(templateVersion.Status.Os.Image, device, parameters)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in OS image: %w", err))
+		} else {
+			osSpec = &domain.DeviceOsSpec{Image: img}
+		}
+	}
+
+	deviceConfig, depRefs, configErrs := f.getDeviceConfig(device, templateVersion)
+	errs = append(errs, configErrs...)
+
+	deviceApps, appErrs := f.getDeviceApps(device, templateVersion)
+	errs = append(errs, appErrs...)
+
+	deviceCerts, certErrs := f.getDeviceCertificates(device, templateVersion)
+	errs = append(errs, certErrs...)
+
+	return domain.DeviceSpec{
+		Config:       deviceConfig,
+		Os:           osSpec,
+		Systemd:      templateVersion.Status.Systemd,
+		Resources:    templateVersion.Status.Resources,
+		Applications: deviceApps,
+		Certificates: deviceCerts,
+		UpdatePolicy: templateVersion.Status.UpdatePolicy,
+	}, depRefs, errs
+}
+
+// getDeviceCertificates evaluates the subject and subject alternative names of the fleet
+// template's workload certificates against the device's labels and the fleet parameters.
+func (f FleetRolloutsLogic) getDeviceCertificates(device *domain.Device, templateVersion *domain.TemplateVersion) (*[]domain.WorkloadCertificateSpec, []error) {
+	if templateVersion.Status.Certificates == nil {
+		return nil, nil
+	}
+	parameters := domain.GetDeviceTemplateParameters(device, templateVersion.Status.Parameters)
+
+	errs := []error{}
+	replace := func(certName, field, s string) string {
+		out, err := ReplaceParametersInString(s, device, parameters)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed replacing parameters in %s of certificate %s: %w", field, certName, err))
+		}
+		return out
+	}
+	replaceAll := func(certName, field string, in *[]string) *[]string {
+		if in == nil {
+			return nil
+		}
+		out := make([]string, 0, len(*in))
+		for _, s := range *in {
+			out = append(out, replace(certName, field, s))
+		}
+		return &out
+	}
+
+	deviceCerts := make([]domain.WorkloadCertificateSpec, 0, len(*templateVersion.Status.Certificates))
+	for _, cert := range *templateVersion.Status.Certificates {
+		cert.CommonName = replace(cert.Name, "commonName", cert.CommonName)
+		cert.DnsNames = replaceAll(cert.Name, "dnsNames", cert.DnsNames)
+		cert.IpAddresses = replaceAll(cert.Name, "ipAddresses", cert.IpAddresses)
+		deviceCerts = append(deviceCerts, cert)
+	}
+	return &deviceCerts, errs
+}
+
 // getDeviceApps evaluates the fleet template's applications against the device's labels
 // (parameter substitution). The device's DeviceAnnotationApplicationLifecycle annotation is
 // not overlaid here: it is applied by the device render task directly onto
@@ -384,6 +452,7 @@ func (f FleetRolloutsLogic) getDeviceApps(device *domain.Device, templateVersion
 	if templateVersion.Status.Applications == nil {
 		return nil, nil
 	}
+	parameters := domain.GetDeviceTemplateParameters(device, templateVersion.Status.Parameters)
 
 	deviceApps := []domain.ApplicationProviderSpec{}
 	appErrs := []error{}
@@ -399,15 +468,15 @@ func (f FleetRolloutsLogic) getDeviceApps(device *domain.Device, templateVersion
 
 		switch appType {
 		case domain.AppTypeContainer:
-			newAppItem, errs = f.replaceContainerApplicationParameters(device, appItem)
+			newAppItem, errs = f.replaceContainerApplicationParameters(device, parameters, appItem)
 		case domain.AppTypeHelm:
-			newAppItem, errs = f.replaceHelmApplicationParameters(device, appItem)
+			newAppItem, errs = f.replaceHelmApplicationParameters(device, parameters, appItem)
 		case domain.AppTypeCompose:
-			newAppItem, errs = f.replaceComposeApplicationParameters(device, appItem)
+			newAppItem, errs = f.replaceComposeApplicationParameters(device, parameters, appItem)
 		case domain.AppTypeQuadlet:
-			newAppItem, errs = f.replaceQuadletApplicationParameters(device, appItem)
+			newAppItem, errs = f.replaceQuadletApplicationParameters(device, parameters, appItem)
 		case domain.AppTypeVm:
-			newAppItem, errs = f.replaceVmApplicationParameters(device, appItem)
+			newAppItem, errs = f.replaceVmApplicationParameters(device, parameters, appItem)
 		default:
 			errs = append(errs, fmt.Errorf("unsupported app type for app %d: %s", appIndex, appType))
 		}
@@ -425,7 +494,7 @@ func (f FleetRolloutsLogic) getDeviceApps(device *domain.Device, templateVersion
 	return &deviceApps, nil
 }
 
-func replaceEnvVarsMap(device *domain.Device, envVars *map[string]string) (*map[string]string, []error) {
+func replaceEnvVarsMap(device *domain.Device, parameters map[string]string, envVars *map[string]string) (*map[string]string, []error) {
 	if envVars == nil {
 		return nil, nil
 	}
@@ -433,7 +502,7 @@ func replaceEnvVarsMap(device *domain.Device, envVars *map[string]string) (*map[
 	origEnvVars := *envVars
 	newEnvVars := make(map[string]string, len(origEnvVars))
 	for k, v := range origEnvVars {
-		newValue, err := ReplaceParametersInString(v, device)
+		newValue, err := ReplaceParametersInString(v, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in env var %s: %w", k, err))
 			continue
@@ -443,7 +512,7 @@ func replaceEnvVarsMap(device *domain.Device, envVars *map[string]string) (*map[
 	return &newEnvVars, errs
 }
 
-func (f FleetRolloutsLogic) replaceContainerApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceContainerApplicationParameters(device *domain.Device, parameters map[string]string, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	containerApp, err := app.AsContainerApplication()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert to container application: %w", err)}
@@ -452,17 +521,17 @@ func (f FleetRolloutsLogic) replaceContainerApplicationParameters(device *domain
 
 	var errs []error
 
-	containerApp.Image, err = ReplaceParametersInString(containerApp.Image, device)
+	containerApp.Image, err = ReplaceParametersInString(containerApp.Image, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in image for app %s: %w", appName, err))
 	}
 
-	newEnvVars, envErrs := replaceEnvVarsMap(device, containerApp.EnvVars)
+	newEnvVars, envErrs := replaceEnvVarsMap(device, parameters, containerApp.EnvVars)
 	errs = append(errs, envErrs...)
 	containerApp.EnvVars = newEnvVars
 
 	if containerApp.Volumes != nil {
-		newVolumes, volErrs := f.replaceVolumeParameters(device, appName, *containerApp.Volumes)
+		newVolumes, volErrs := f.replaceVolumeParameters(device, parameters, appName, *containerApp.Volumes)
 		errs = append(errs, volErrs...)
 		if len(volErrs) == 0 {
 			containerApp.Volumes = &newVolumes
@@ -481,7 +550,7 @@ func (f FleetRolloutsLogic) replaceContainerApplicationParameters(device *domain
 	return &newItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceHelmApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceHelmApplicationParameters(device *domain.Device, parameters map[string]string, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	helmApp, err := app.AsHelmApplication()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert to helm application: %w", err)}
@@ -490,7 +559,7 @@ func (f FleetRolloutsLogic) replaceHelmApplicationParameters(device *domain.Devi
 
 	var errs []error
 
-	helmApp.Image, err = ReplaceParametersInString(helmApp.Image, device)
+	helmApp.Image, err = ReplaceParametersInString(helmApp.Image, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in image for app %s: %w", appName, err))
 	}
@@ -507,7 +576,7 @@ func (f FleetRolloutsLogic) replaceHelmApplicationParameters(device *domain.Devi
 	return &newItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.Device, parameters map[string]string, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	composeApp, err := app.AsComposeApplication()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert to compose application: %w", err)}
@@ -516,12 +585,12 @@ func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.D
 
 	var errs []error
 
-	newEnvVars, envErrs := replaceEnvVarsMap(device, composeApp.EnvVars)
+	newEnvVars, envErrs := replaceEnvVarsMap(device, parameters, composeApp.EnvVars)
 	errs = append(errs, envErrs...)
 	composeApp.EnvVars = newEnvVars
 
 	if composeApp.Volumes != nil {
-		newVolumes, volErrs := f.replaceVolumeParameters(device, appName, *composeApp.Volumes)
+		newVolumes, volErrs := f.replaceVolumeParameters(device, parameters, appName, *composeApp.Volumes)
 		errs = append(errs, volErrs...)
 		if len(volErrs) == 0 {
 			composeApp.Volumes = &newVolumes
@@ -539,7 +608,7 @@ func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.D
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get image spec for compose app %s: %w", appName, err)}
 		}
-		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device)
+		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in image for app %s: %w", appName, err))
 		}
@@ -555,7 +624,7 @@ func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.D
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get inline spec for compose app %s: %w", appName, err)}
 		}
-		inlineErrs := f.replaceInlineContentParameters(device, appName, &inlineSpec)
+		inlineErrs := f.replaceInlineContentParameters(device, parameters, appName, &inlineSpec)
 		errs = append(errs, inlineErrs...)
 		if len(errs) > 0 {
 			return nil, errs
@@ -573,7 +642,7 @@ func (f FleetRolloutsLogic) replaceComposeApplicationParameters(device *domain.D
 	return &newItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.Device, parameters map[string]string, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	quadletApp, err := app.AsQuadletApplication()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert to quadlet application: %w", err)}
@@ -582,12 +651,12 @@ func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.D
 
 	var errs []error
 
-	newEnvVars, envErrs := replaceEnvVarsMap(device, quadletApp.EnvVars)
+	newEnvVars, envErrs := replaceEnvVarsMap(device, parameters, quadletApp.EnvVars)
 	errs = append(errs, envErrs...)
 	quadletApp.EnvVars = newEnvVars
 
 	if quadletApp.Volumes != nil {
-		newVolumes, volErrs := f.replaceVolumeParameters(device, appName, *quadletApp.Volumes)
+		newVolumes, volErrs := f.replaceVolumeParameters(device, parameters, appName, *quadletApp.Volumes)
 		errs = append(errs, volErrs...)
 		if len(volErrs) == 0 {
 			quadletApp.Volumes = &newVolumes
@@ -605,7 +674,7 @@ func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.D
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get image spec for quadlet app %s: %w", appName, err)}
 		}
-		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device)
+		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in image for app %s: %w", appName, err))
 		}
@@ -621,7 +690,7 @@ func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.D
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get inline spec for quadlet app %s: %w", appName, err)}
 		}
-		inlineErrs := f.replaceInlineContentParameters(device, appName, &inlineSpec)
+		inlineErrs := f.replaceInlineContentParameters(device, parameters, appName, &inlineSpec)
 		errs = append(errs, inlineErrs...)
 		if len(errs) > 0 {
 			return nil, errs
@@ -639,7 +708,7 @@ func (f FleetRolloutsLogic) replaceQuadletApplicationParameters(device *domain.D
 	return &newItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceVmApplicationParameters(device *domain.Device, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceVmApplicationParameters(device *domain.Device, parameters map[string]string, app domain.ApplicationProviderSpec) (*domain.ApplicationProviderSpec, []error) {
 	vmApp, err := app.AsVmApplication()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert to vm application: %w", err)}
@@ -657,7 +726,7 @@ func (f FleetRolloutsLogic) replaceVmApplicationParameters(device *domain.Device
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get image spec for vm app %s: %w", appName, err)}
 		}
-		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device)
+		imageSpec.Image, err = ReplaceParametersInString(imageSpec.Image, device, parameters)
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed replacing parameters in image for vm app %s: %w", appName, err)}
 		}
@@ -670,7 +739,7 @@ func (f FleetRolloutsLogic) replaceVmApplicationParameters(device *domain.Device
 		if err != nil {
 			return nil, []error{fmt.Errorf("failed to get inline spec for vm app %s: %w", appName, err)}
 		}
-		if inlineErrs := f.replaceInlineContentParameters(device, appName, &inlineSpec); len(inlineErrs) > 0 {
+		if inlineErrs := f.replaceInlineContentParameters(device, parameters, appName, &inlineSpec); len(inlineErrs) > 0 {
 			return nil, inlineErrs
 		}
 		if err := vmApp.FromInlineApplicationProviderSpec(inlineSpec); err != nil {
@@ -686,13 +755,13 @@ func (f FleetRolloutsLogic) replaceVmApplicationParameters(device *domain.Device
 	return &newItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceInlineContentParameters(device *domain.Device, appName string, inlineSpec *domain.InlineApplicationProviderSpec) []error {
+func (f FleetRolloutsLogic) replaceInlineContentParameters(device *domain.Device, parameters map[string]string, appName string, inlineSpec *domain.InlineApplicationProviderSpec) []error {
 	var errs []error
 	for fileIndex, file := range inlineSpec.Inline {
 		var decodedBytes []byte
 		var err error
 
-		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device)
+		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline app %s: %w", fileIndex, appName, err))
 		}
@@ -709,7 +778,7 @@ func (f FleetRolloutsLogic) replaceInlineContentParameters(device *domain.Device
 			decodedBytes = []byte(content)
 		}
 
-		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device)
+		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline app %s: %w", fileIndex, appName, err))
 			continue
@@ -723,7 +792,7 @@ func (f FleetRolloutsLogic) replaceInlineContentParameters(device *domain.Device
 	return errs
 }
 
-func (f FleetRolloutsLogic) replaceVolumeParameters(device *domain.Device, appName string, volumes []domain.ApplicationVolume) ([]domain.ApplicationVolume, []error) {
+func (f FleetRolloutsLogic) replaceVolumeParameters(device *domain.Device, parameters map[string]string, appName string, volumes []domain.ApplicationVolume) ([]domain.ApplicationVolume, []error) {
 	var errs []error
 	newVolumes := make([]domain.ApplicationVolume, 0, len(volumes))
 
@@ -747,7 +816,7 @@ func (f FleetRolloutsLogic) replaceVolumeParameters(device *domain.Device, appNa
 				continue
 			}
 
-			imgSpec.Image.Reference, err = ReplaceParametersInString(imgSpec.Image.Reference, device)
+			imgSpec.Image.Reference, err = ReplaceParametersInString(imgSpec.Image.Reference, device, parameters)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed replacing parameters in image reference for volume %d in app %s: %w", volIndex, appName, err))
 				continue
@@ -765,7 +834,7 @@ func (f FleetRolloutsLogic) replaceVolumeParameters(device *domain.Device, appNa
 				continue
 			}
 
-			imgMountSpec.Image.Reference, err = ReplaceParametersInString(imgMountSpec.Image.Reference, device)
+			imgMountSpec.Image.Reference, err = ReplaceParametersInString(imgMountSpec.Image.Reference, device, parameters)
 			if err != nil {
 				errs = append(errs, fmt.Errorf("failed replacing parameters in image reference for volume %d in app %s: %w", volIndex, appName, err))
 				continue
@@ -803,6 +872,7 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 	if templateVersion.Status.Config == nil {
 		return nil, nil, nil
 	}
+	parameters := domain.GetDeviceTemplateParameters(device, templateVersion.Status.Parameters)
 
 	deviceConfig := []domain.ConfigProviderSpec{}
 	depRefs := make(map[string]model.DependencyRef)
@@ -820,21 +890,21 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 		switch configType {
 		case domain.GitConfigProviderType:
 			var refs []model.DependencyRef
-			newConfigItem, refs, errs = f.replaceGitConfigParameters(device, configItem)
+			newConfigItem, refs, errs = f.replaceGitConfigParameters(device, parameters, configItem)
 			for _, ref := range refs {
 				depRefs[ref.ResourceKey] = ref
 			}
 		case domain.KubernetesSecretProviderType:
 			var refs []model.DependencyRef
-			newConfigItem, refs, errs = f.replaceKubeSecretConfigParameters(device, configItem)
+			newConfigItem, refs, errs = f.replaceKubeSecretConfigParameters(device, parameters, configItem)
 			for _, ref := range refs {
 				depRefs[ref.ResourceKey] = ref
 			}
 		case domain.InlineConfigProviderType:
-			newConfigItem, errs = f.replaceInlineConfigParameters(device, configItem)
+			newConfigItem, errs = f.replaceInlineConfigParameters(device, parameters, configItem)
 		case domain.HttpConfigProviderType:
 			var refs []model.DependencyRef
-			newConfigItem, refs, errs = f.replaceHTTPConfigParameters(device, configItem)
+			newConfigItem, refs, errs = f.replaceHTTPConfigParameters(device, parameters, configItem)
 			for _, ref := range refs {
 				depRefs[ref.ResourceKey] = ref
 			}
@@ -859,7 +929,7 @@ func (f FleetRolloutsLogic) getDeviceConfig(device *domain.Device, templateVersi
 	return &deviceConfig, refs, nil
 }
 
-func (f FleetRolloutsLogic) replaceGitConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+func (f FleetRolloutsLogic) replaceGitConfigParameters(device *domain.Device, parameters map[string]string, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
 	gitSpec, err := configItem.AsGitConfigProviderSpec()
 	if err != nil {
 		return nil, nil, []error{fmt.Errorf("failed to convert config to git config: %w", err)}
@@ -868,12 +938,12 @@ func (f FleetRolloutsLogic) replaceGitConfigParameters(device *domain.Device, co
 	errs := []error{}
 	originalRevision := gitSpec.GitRef.TargetRevision
 
-	gitSpec.GitRef.TargetRevision, err = ReplaceParametersInString(gitSpec.GitRef.TargetRevision, device)
+	gitSpec.GitRef.TargetRevision, err = ReplaceParametersInString(gitSpec.GitRef.TargetRevision, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in targetRevision in git config %s: %w", gitSpec.Name, err))
 	}
 
-	gitSpec.GitRef.Path, err = ReplaceParametersInString(gitSpec.GitRef.Path, device)
+	gitSpec.GitRef.Path, err = ReplaceParametersInString(gitSpec.GitRef.Path, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in path in git config %s: %w", gitSpec.Name, err))
 	}
@@ -905,7 +975,7 @@ func (f FleetRolloutsLogic) replaceGitConfigParameters(device *domain.Device, co
 	return &newConfigItem, refs, nil
 }
 
-func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Device, parameters map[string]string, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
 	secretSpec, err := configItem.AsKubernetesSecretProviderSpec()
 	if err != nil {
 		return nil, nil, []error{fmt.Errorf("failed to convert config to kubernetes secret config: %w", err)}
@@ -915,17 +985,17 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	originalNamespace := secretSpec.SecretRef.Namespace
 	originalName := secretSpec.SecretRef.Name
 
-	secretSpec.SecretRef.Name, err = ReplaceParametersInString(secretSpec.SecretRef.Name, device)
+	secretSpec.SecretRef.Name, err = ReplaceParametersInString(secretSpec.SecretRef.Name, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in name in k8s secret config %s: %w", secretSpec.Name, err))
 	}
 
-	secretSpec.SecretRef.Namespace, err = ReplaceParametersInString(secretSpec.SecretRef.Namespace, device)
+	secretSpec.SecretRef.Namespace, err = ReplaceParametersInString(secretSpec.SecretRef.Namespace, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in namespace in k8s secret config %s: %w", secretSpec.Name, err))
 	}
 
-	secretSpec.SecretRef.MountPath, err = ReplaceParametersInString(secretSpec.SecretRef.MountPath, device)
+	secretSpec.SecretRef.MountPath, err = ReplaceParametersInString(secretSpec.SecretRef.MountPath, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in mountPath in k8s secret config %s: %w", secretSpec.Name, err))
 	}
@@ -957,7 +1027,7 @@ func (f FleetRolloutsLogic) replaceKubeSecretConfigParameters(device *domain.Dev
 	return &newConfigItem, refs, nil
 }
 
-func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
+func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device, parameters map[string]string, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []error) {
 	inlineSpec, err := configItem.AsInlineConfigProviderSpec()
 	if err != nil {
 		return nil, []error{fmt.Errorf("failed to convert config to inline config: %w", err)}
@@ -969,7 +1039,7 @@ func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device,
 		var decodedBytes []byte
 		var err error
 
-		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device)
+		inlineSpec.Inline[fileIndex].Path, err = ReplaceParametersInString(file.Path, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in path for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
 		}
@@ -985,7 +1055,7 @@ func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device,
 			decodedBytes = []byte(file.Content)
 		}
 
-		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device)
+		contentsReplaced, err := ReplaceParametersInString(string(decodedBytes), device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in contents for file %d in inline config %s: %w", fileIndex, inlineSpec.Name, err))
 			continue
@@ -1011,7 +1081,7 @@ func (f FleetRolloutsLogic) replaceInlineConfigParameters(device *domain.Device,
 	return &newConfigItem, nil
 }
 
-func (f FleetRolloutsLogic) replaceHTTPConfigParameters(device *domain.Device, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
+func (f FleetRolloutsLogic) replaceHTTPConfigParameters(device *domain.Device, parameters map[string]string, configItem domain.ConfigProviderSpec) (*domain.ConfigProviderSpec, []model.DependencyRef, []error) {
 	httpSpec, err := configItem.AsHttpConfigProviderSpec()
 	if err != nil {
 		return nil, nil, []error{fmt.Errorf("failed to convert config to http config: %w", err)}
@@ -1024,14 +1094,14 @@ func (f FleetRolloutsLogic) replaceHTTPConfigParameters(device *domain.Device, c
 	}
 
 	if httpSpec.HttpRef.Suffix != nil {
-		suffix, err := ReplaceParametersInString(*httpSpec.HttpRef.Suffix, device)
+		suffix, err := ReplaceParametersInString(*httpSpec.HttpRef.Suffix, device, parameters)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed replacing parameters in suffix in http config %s: %w", httpSpec.Name, err))
 		}
 		httpSpec.HttpRef.Suffix = &suffix
 	}
 
-	httpSpec.HttpRef.FilePath, err = ReplaceParametersInString(httpSpec.HttpRef.FilePath, device)
+	httpSpec.HttpRef.FilePath, err = ReplaceParametersInString(httpSpec.HttpRef.FilePath, device, parameters)
 	if err != nil {
 		errs = append(errs, fmt.Errorf("failed replacing parameters in file path in http config %s: %w", httpSpec.Name, err))
 	}
@@ -1093,13 +1163,20 @@ func (f FleetRolloutsLogic) updateDeviceInStore(ctx context.Context, device *dom
 	return common.ApiStatusToErr(status)
 }
 
-func ReplaceParametersInString(s string, device *domain.Device) (string, error) {
+// ReplaceParametersInString renders s as a template against the device and the fleet
+// parameters that apply to it. It fails with ErrMissingTemplateParameters if s references
+// a parameter that has no value for the device.
+func ReplaceParametersInString(s string, device *domain.Device, parameters map[string]string) (string, error) {
 	t, err := template.New("t").Option("missingkey=error").Funcs(domain.GetGoTemplateFuncMap()).Parse(s)
 	if err != nil {
 		return "", fmt.Errorf("invalid parameter syntax: %v", err)
 	}
 
-	output, err := domain.ExecuteGoTemplateOnDevice(t, device)
+	if missing := domain.MissingTemplateParameters(t, parameters); len(missing) > 0 {
+		return "", fmt.Errorf("%w: %s", ErrMissingTemplateParameters, strings.Join(missing, ", "))
+	}
+
+	output, err := domain.ExecuteGoTemplateOnDeviceWithParameters(t, device, parameters)
 	if err != nil {
 		return "", fmt.Errorf("cannot apply parameters, possibly because they access invalid fields: %w", err)
 	}
@@ -310,6 +310,76 @@ func TestFleetRolloutsLogic_FullDelayDeviceRenderPropagation(t *testing.T) {
 	}
 }
 
+// TestFleetRolloutsLogic_RolloutFleet_Rollback tests that the devices of a fleet whose rollout was rolled back
+// are rolled out to the rollback template version without delaying their render
+func TestFleetRolloutsLogic_RolloutFleet_Rollback(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	orgId := uuid.New()
+	log := logrus.New()
+	fleetName := "test-fleet"
+	event := domain.Event{
+		InvolvedObject: domain.ObjectReference{
+			Kind: domain.FleetKind,
+			Name: fleetName,
+		},
+		Reason: domain.EventReasonFleetRolloutRollbackStarted,
+	}
+
+	fleet := createTestFleetForRollout(fleetName, &domain.RolloutPolicy{
+		DeviceSelection: &domain.RolloutDeviceSelection{},
+		DisruptionBudget: &domain.DisruptionBudget{
+			MaxUnavailable: lo.ToPtr(25),
+		},
+		OnFailure: lo.ToPtr(domain.RolloutFailureActionRollback),
+	})
+	fleet.Metadata.Annotations = &map[string]string{
+		domain.FleetAnnotationTemplateVersion: "tv-2",
+		domain.FleetAnnotationRollback:        `{"fromTemplateVersion":"tv-2","toTemplateVersion":"tv-1","batch":"batch 1","startTime":"2025-01-01T00:00:00Z","completed":false}`,
+	}
+
+	mockFleetSvc := fleetservice.NewMockService(ctrl)
+	mockTemplateVersionSvc := templateversionservice.NewMockService(ctrl)
+	mockDeviceSvc := deviceservice.NewMockService(ctrl)
+	mockDependencyRefSvc := dependencyrefservice.NewMockService(ctrl)
+
+	mockFleetSvc.EXPECT().GetFleet(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(fleet, domain.Status{Code: http.StatusOK})
+
+	// The rollback template version is used rather than the latest one
+	templateVersion := createTestTemplateVersion("tv-1")
+	templateVersion.Status.Os = nil
+	mockTemplateVersionSvc.EXPECT().GetTemplateVersion(gomock.Any(), gomock.Any(), fleetName, "tv-1").Return(templateVersion, domain.Status{Code: http.StatusOK})
+	mockDependencyRefSvc.EXPECT().ReplaceDeviceDependencyRefsByFleet(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(domain.Status{Code: http.StatusOK})
+
+	testDevice := createTestDevice("test-device", "Fleet/test-fleet")
+	testDevice.Metadata.Annotations = &map[string]string{
+		domain.DeviceAnnotationTemplateVersion: "tv-2",
+	}
+	mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.DeviceList{
+		Items: []domain.Device{*testDevice},
+	}, domain.Status{Code: http.StatusOK})
+
+	delayDeviceRender := true
+	mockDeviceSvc.EXPECT().ReplaceDevice(gomock.Any(), gomock.Any(), "test-device", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, name string, device domain.Device, fieldsToUnset []string) (*domain.Device, domain.Status) {
+			delayDeviceRender, _ = ctx.Value(consts.DelayDeviceRenderCtxKey).(bool)
+			return &device, domain.Status{Code: http.StatusOK}
+		})
+	var updatedAnnotations map[string]string
+	mockDeviceSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), gomock.Any(), "test-device", gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, name string, annotations map[string]string, deleteKeys []string) domain.Status {
+			updatedAnnotations = annotations
+			return domain.Status{Code: http.StatusOK}
+		})
+
+	logic := NewFleetRolloutsLogic(log, mockFleetSvc, mockTemplateVersionSvc, mockDeviceSvc, mockDependencyRefSvc, orgId, event)
+	require.NoError(t, logic.RolloutFleet(context.Background()))
+
+	assert.False(t, delayDeviceRender, "devices that are rolled back should not wait for the disruption budget")
+	assert.Equal(t, "tv-1", updatedAnnotations[domain.DeviceAnnotationTemplateVersion])
+}
+
 // TestFleetRolloutsLogic_DelayDeviceRenderPropagationThroughContext tests that the delayDeviceRender
 // value is correctly propagated through the context when calling updateDeviceInStore
 func TestFleetRolloutsLogic_DelayDeviceRenderPropagationThroughContext(t *testing.T) {
@@ -614,7 +684,7 @@ func TestFleetRolloutsLogic_ReplaceComposeImageApplicationParameters(t *testing.
 			err = app.FromComposeApplication(composeApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceComposeApplicationParameters(tt.device, app)
+			result, errs := logic.replaceComposeApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -748,7 +818,7 @@ func TestFleetRolloutsLogic_ReplaceQuadletInlineApplicationParameters(t *testing
 			err = app.FromQuadletApplication(quadletApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceQuadletApplicationParameters(tt.device, app)
+			result, errs := logic.replaceQuadletApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -836,7 +906,7 @@ func TestFleetRolloutsLogic_ReplaceQuadletImageApplicationParameters(t *testing.
 			err = app.FromQuadletApplication(quadletApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceQuadletApplicationParameters(tt.device, app)
+			result, errs := logic.replaceQuadletApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -923,7 +993,7 @@ func TestFleetRolloutsLogic_ReplaceContainerApplicationParameters(t *testing.T)
 			err := app.FromContainerApplication(containerApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceContainerApplicationParameters(tt.device, app)
+			result, errs := logic.replaceContainerApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -1003,7 +1073,7 @@ func TestFleetRolloutsLogic_ReplaceHelmApplicationParameters(t *testing.T) {
 			err := app.FromHelmApplication(helmApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceHelmApplicationParameters(tt.device, app)
+			result, errs := logic.replaceHelmApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -1098,7 +1168,7 @@ func TestFleetRolloutsLogic_ReplaceComposeInlineApplicationParameters(t *testing
 			err = app.FromComposeApplication(composeApp)
 			require.NoError(err)
 
-			result, errs := logic.replaceComposeApplicationParameters(tt.device, app)
+			result, errs := logic.replaceComposeApplicationParameters(tt.device, nil, app)
 
 			if tt.expectError {
 				assert.NotEmpty(t, errs)
@@ -1127,6 +1197,85 @@ func TestFleetRolloutsLogic_ReplaceComposeInlineApplicationParameters(t *testing
 	}
 }
 
+func TestFleetRolloutsLogic_GetDeviceCertificates(t *testing.T) {
+	templateCert := func() domain.WorkloadCertificateSpec {
+		return domain.WorkloadCertificateSpec{
+			Name:        "mqtt",
+			CommonName:  "mqtt-{{ .metadata.name }}",
+			DnsNames:    &[]string{"{{ .metadata.labels.site }}.example.com", "broker.example.com"},
+			IpAddresses: &[]string{"{{ .parameters.ip }}"},
+			CertPath:    "/etc/mqtt/tls.crt",
+			KeyPath:     "/etc/mqtt/tls.key",
+			User:        "mqtt",
+		}
+	}
+	parameters := &[]domain.DeviceParameterSet{{Values: map[string]string{"ip": "10.0.0.1"}}}
+
+	tests := []struct {
+		name         string
+		certificates *[]domain.WorkloadCertificateSpec
+		parameters   *[]domain.DeviceParameterSet
+		expected     *[]domain.WorkloadCertificateSpec
+		expectError  bool
+	}{
+		{
+			name: "no certificates",
+		},
+		{
+			name:         "renders names from labels and parameters",
+			certificates: &[]domain.WorkloadCertificateSpec{templateCert()},
+			parameters:   parameters,
+			expected: &[]domain.WorkloadCertificateSpec{{
+				Name:        "mqtt",
+				CommonName:  "mqtt-mydevice",
+				DnsNames:    &[]string{"paris.example.com", "broker.example.com"},
+				IpAddresses: &[]string{"10.0.0.1"},
+				CertPath:    "/etc/mqtt/tls.crt",
+				KeyPath:     "/etc/mqtt/tls.key",
+				User:        "mqtt",
+			}},
+		},
+		{
+			name: "keeps unset names unset",
+			certificates: &[]domain.WorkloadCertificateSpec{{
+				Name:       "mqtt",
+				CommonName: "{{ .metadata.labels.site }}",
+				CertPath:   "/etc/mqtt/tls.crt",
+				KeyPath:    "/etc/mqtt/tls.key",
+			}},
+			expected: &[]domain.WorkloadCertificateSpec{{
+				Name:       "mqtt",
+				CommonName: "paris",
+				CertPath:   "/etc/mqtt/tls.crt",
+				KeyPath:    "/etc/mqtt/tls.key",
+			}},
+		},
+		{
+			name:         "missing parameter",
+			certificates: &[]domain.WorkloadCertificateSpec{templateCert()},
+			expectError:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logic := FleetRolloutsLogic{log: logrus.New()}
+			device := createTestDeviceWithLabels("mydevice", "fleet/test", map[string]string{"site": "paris"})
+			templateVersion := &domain.TemplateVersion{
+				Status: &domain.TemplateVersionStatus{Certificates: tt.certificates, Parameters: tt.parameters},
+			}
+
+			result, errs := logic.getDeviceCertificates(device, templateVersion)
+			if tt.expectError {
+				require.NotEmpty(t, errs)
+				return
+			}
+			require.Empty(t, errs)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestReplaceGitConfigParameters_DeviceLevelRefs(t *testing.T) {
 	fleetName := "my-fleet"
 	logic := FleetRolloutsLogic{
@@ -1147,7 +1296,7 @@ func TestReplaceGitConfigParameters_DeviceLevelRefs(t *testing.T) {
 		}
 		configItem := makeGitConfigItem(t, "git-cfg", "my-repo", "{{ .metadata.labels.branch }}")
 
-		newCfg, refs, errs := logic.replaceGitConfigParameters(device, configItem)
+		newCfg, refs, errs := logic.replaceGitConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.NotNil(t, newCfg)
 		require.Len(t, refs, 1)
@@ -1167,7 +1316,7 @@ func TestReplaceGitConfigParameters_DeviceLevelRefs(t *testing.T) {
 		}
 		configItem := makeGitConfigItem(t, "git-cfg", "my-repo", "main")
 
-		newCfg, refs, errs := logic.replaceGitConfigParameters(device, configItem)
+		newCfg, refs, errs := logic.replaceGitConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.NotNil(t, newCfg)
 		assert.Empty(t, refs)
@@ -1313,7 +1462,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeGitConfigItem(t, "git-cfg", "my-repo", "main")
-		_, refs, errs := logic.replaceGitConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceGitConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		assert.Empty(t, refs, "non-parameterized revision should not produce device-level refs")
 	})
@@ -1333,7 +1482,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeGitConfigItem(t, "git-cfg", "my-repo", "{{ .metadata.labels.branch }}")
-		_, refs, errs := logic.replaceGitConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceGitConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.Len(t, refs, 1)
 		assert.Equal(t, fleetName, *refs[0].FleetName)
@@ -1413,7 +1562,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"branch": "main"},
 			},
 		}
-		_, refsBefore, errsBefore := logic.replaceGitConfigParameters(deviceBefore, configItem)
+		_, refsBefore, errsBefore := logic.replaceGitConfigParameters(deviceBefore, nil, configItem)
 		require.Empty(t, errsBefore)
 		require.Len(t, refsBefore, 1)
 		assert.Equal(t, "git:my-repo/main", refsBefore[0].ResourceKey)
@@ -1425,7 +1574,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"branch": "feature-x"},
 			},
 		}
-		_, refsAfter, errsAfter := logic.replaceGitConfigParameters(deviceAfter, configItem)
+		_, refsAfter, errsAfter := logic.replaceGitConfigParameters(deviceAfter, nil, configItem)
 		require.Empty(t, errsAfter)
 		require.Len(t, refsAfter, 1)
 		assert.Equal(t, "git:my-repo/feature-x", refsAfter[0].ResourceKey)
@@ -1448,7 +1597,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"branch": "main", "env": "prod"},
 			},
 		}
-		_, refsBefore, errsBefore := logic.replaceGitConfigParameters(deviceBefore, configItem)
+		_, refsBefore, errsBefore := logic.replaceGitConfigParameters(deviceBefore, nil, configItem)
 		require.Empty(t, errsBefore)
 		require.Len(t, refsBefore, 1)
 
@@ -1459,7 +1608,7 @@ func TestDeviceDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"branch": "main", "env": "staging"},
 			},
 		}
-		_, refsAfter, errsAfter := logic.replaceGitConfigParameters(deviceAfter, configItem)
+		_, refsAfter, errsAfter := logic.replaceGitConfigParameters(deviceAfter, nil, configItem)
 		require.Empty(t, errsAfter)
 		require.Len(t, refsAfter, 1)
 		assert.Equal(t, refsBefore[0].ResourceKey, refsAfter[0].ResourceKey)
@@ -1543,7 +1692,7 @@ func TestDeviceSecretDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeSecretConfigItem(t, "secret-cfg", "prod", "db-creds")
-		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		assert.Empty(t, refs, "non-parameterized secret should not produce device-level refs")
 	})
@@ -1563,7 +1712,7 @@ func TestDeviceSecretDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeSecretConfigItem(t, "secret-cfg", "{{ .metadata.labels.ns }}", "db-creds")
-		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.Len(t, refs, 1)
 		assert.Equal(t, fleetName, *refs[0].FleetName)
@@ -1589,7 +1738,7 @@ func TestDeviceSecretDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeSecretConfigItem(t, "secret-cfg", "prod", "{{ .metadata.labels.secret }}")
-		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.Len(t, refs, 1)
 		assert.Equal(t, "secret:prod/my-secret", refs[0].ResourceKey)
@@ -1612,7 +1761,7 @@ func TestDeviceSecretDependencyRefLifecycle(t *testing.T) {
 			},
 		}
 		configItem := makeSecretConfigItem(t, "secret-cfg", "{{ .metadata.labels.ns }}", "{{ .metadata.labels.secret }}")
-		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceKubeSecretConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.Len(t, refs, 1)
 		assert.Equal(t, "secret:staging/api-key", refs[0].ResourceKey)
@@ -1666,7 +1815,7 @@ func TestDeviceHttpDependencyRefLifecycle(t *testing.T) {
 		}
 		suffix := "/config.json"
 		configItem := makeHttpConfigItem(t, "http-cfg", "http-repo", &suffix)
-		_, refs, errs := logic.replaceHTTPConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceHTTPConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		assert.Empty(t, refs, "non-parameterized suffix should not produce device-level refs")
 	})
@@ -1687,7 +1836,7 @@ func TestDeviceHttpDependencyRefLifecycle(t *testing.T) {
 		}
 		suffix := "/{{ .metadata.labels.env }}/config.json"
 		configItem := makeHttpConfigItem(t, "http-cfg", "http-repo", &suffix)
-		_, refs, errs := logic.replaceHTTPConfigParameters(device, configItem)
+		_, refs, errs := logic.replaceHTTPConfigParameters(device, nil, configItem)
 		require.Empty(t, errs)
 		require.Len(t, refs, 1)
 		assert.Equal(t, fleetName, *refs[0].FleetName)
@@ -1714,7 +1863,7 @@ func TestDeviceHttpDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"env": "staging"},
 			},
 		}
-		_, refsBefore, errsBefore := logic.replaceHTTPConfigParameters(deviceBefore, configItem)
+		_, refsBefore, errsBefore := logic.replaceHTTPConfigParameters(deviceBefore, nil, configItem)
 		require.Empty(t, errsBefore)
 		require.Len(t, refsBefore, 1)
 		assert.Equal(t, "http:http-repo/staging/config.json", refsBefore[0].ResourceKey)
@@ -1726,7 +1875,7 @@ func TestDeviceHttpDependencyRefLifecycle(t *testing.T) {
 				Labels: &map[string]string{"env": "prod"},
 			},
 		}
-		_, refsAfter, errsAfter := logic.replaceHTTPConfigParameters(deviceAfter, configItem)
+		_, refsAfter, errsAfter := logic.replaceHTTPConfigParameters(deviceAfter, nil, configItem)
 		require.Empty(t, errsAfter)
 		require.Len(t, refsAfter, 1)
 		assert.Equal(t, "http:http-repo/prod/config.json", refsAfter[0].ResourceKey)
@@ -1968,6 +2117,79 @@ func TestFleetRolloutsLogic_SyncFleetApplicationLifecycleDefault(t *testing.T) {
 	})
 }
 
+func TestReplaceParametersInString(t *testing.T) {
+	device := &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name:   lo.ToPtr("device-1"),
+			Labels: &map[string]string{"site": "paris"},
+		},
+	}
+	parameters := domain.GetDeviceTemplateParameters(device, &[]domain.DeviceParameterSet{
+		{Values: map[string]string{"version": "v1", "endpoint": "https://default.example.com"}},
+		{
+			Selector: &domain.LabelSelector{MatchLabels: &map[string]string{"site": "paris"}},
+			Values:   map[string]string{"endpoint": "https://paris.example.com"},
+		},
+		{Devices: &[]string{"device-2"}, Values: map[string]string{"version": "v2"}},
+	})
+
+	tests := []struct {
+		name          string
+		input         string
+		parameters    map[string]string
+		expected      string
+		expectedError error
+	}{
+		{
+			name:       "parameters and labels",
+			input:      "{{ .metadata.labels.site }}:{{ .parameters.version }}",
+			parameters: parameters,
+			expected:   "paris:v1",
+		},
+		{
+			name:       "later parameter set overrides earlier one",
+			input:      "{{ .parameters.endpoint }}",
+			parameters: parameters,
+			expected:   "https://paris.example.com",
+		},
+		{
+			name:       "root reference inside with",
+			input:      `{{ with .metadata.labels }}{{ $.parameters.version }}{{ end }}`,
+			parameters: parameters,
+			expected:   "v1",
+		},
+		{
+			name:       "parameter with default",
+			input:      `{{ getOrDefault .parameters "region" "us" }}`,
+			parameters: parameters,
+			expected:   "us",
+		},
+		{
+			name:          "missing parameter",
+			input:         "{{ .parameters.region }}-{{ .parameters.zone }}",
+			parameters:    parameters,
+			expectedError: ErrMissingTemplateParameters,
+		},
+		{
+			name:          "no parameters",
+			input:         "{{ .parameters.version }}",
+			expectedError: ErrMissingTemplateParameters,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ReplaceParametersInString(tt.input, device, tt.parameters)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, result)
+		})
+	}
+}
+
 func TestFleetRolloutIterationContext(t *testing.T) {
 	t.Run("child not expired when parent deadline passed", func(t *testing.T) {
 		parent, cancel := context.WithTimeout(context.Background(), 0)
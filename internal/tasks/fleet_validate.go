@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
 	"net/http"
 	"strconv"
@@ -17,6 +18,7 @@ import (
 	"github.com/flightctl/flightctl/internal/util"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -105,14 +107,22 @@ func (t *FleetValidateLogic) CreateNewTemplateVersionIfFleetValid(ctx context.Co
 		Spec: domain.TemplateVersionSpec{Fleet: *fleet.Metadata.Name},
 		Status: &domain.TemplateVersionStatus{
 			Applications: fleet.Spec.Template.Spec.Applications,
+			Certificates: fleet.Spec.Template.Spec.Certificates,
 			Config:       fleet.Spec.Template.Spec.Config,
 			Os:           fleet.Spec.Template.Spec.Os,
 			Resources:    fleet.Spec.Template.Spec.Resources,
 			Systemd:      fleet.Spec.Template.Spec.Systemd,
 			UpdatePolicy: fleet.Spec.Template.Spec.UpdatePolicy,
+			Parameters:   fleet.Spec.Parameters,
 		},
 	}
 
+	// Every parameter referenced by the template must resolve for every device in the fleet,
+	// otherwise the rollout would fail part-way through.
+	if err := t.validateDeviceParameters(ctx, &templateVersion); err != nil {
+		return t.setStatus(ctx, err)
+	}
+
 	immediateRollout := fleet.Spec.RolloutPolicy == nil || fleet.Spec.RolloutPolicy.DeviceSelection == nil
 	tv, status := t.templateversionSvc.CreateTemplateVersion(ctx, t.orgId, templateVersion, immediateRollout)
 	if status.Code != http.StatusCreated {
@@ -278,6 +288,61 @@ func (t *FleetValidateLogic) validateHttpProviderConfig(ctx context.Context, con
 	return &httpConfigProviderSpec.Name, &httpConfigProviderSpec.HttpRef.Repository, nil
 }
 
+// maxReportedInvalidDevices caps the number of device names listed in the fleet's Valid condition.
+const maxReportedInvalidDevices = 10
+
+// validateDeviceParameters renders the template version for each device owned by the fleet and
+// fails if any device lacks a value for a template parameter it references.
+func (t *FleetValidateLogic) validateDeviceParameters(ctx context.Context, templateVersion *domain.TemplateVersion) error {
+	owner := util.SetResourceOwner(domain.FleetKind, templateVersion.Spec.Fleet)
+	listParams := domain.ListDevicesParams{
+		Limit:         lo.ToPtr(int32(ItemsPerPage)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
+	}
+	renderer := FleetRolloutsLogic{log: t.log}
+
+	invalidDevices := []string{}
+	var firstError error
+	for {
+		devices, status := t.deviceSvc.ListDevices(ctx, t.orgId, listParams, nil)
+		if status.Code != http.StatusOK {
+			return fmt.Errorf("failed fetching devices: %s", status.Message)
+		}
+		for i := range devices.Items {
+			device := &devices.Items[i]
+			_, _, errs := renderer.renderDeviceSpec(device, templateVersion)
+			for _, err := range errs {
+				if errors.Is(err, ErrMissingTemplateParameters) {
+					invalidDevices = append(invalidDevices, *device.Metadata.Name)
+					if len(invalidDevices) == 1 {
+						firstError = err
+					}
+					break
+				}
+			}
+		}
+		if devices.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = devices.Metadata.Continue
+	}
+
+	if len(invalidDevices) != 0 {
+		deviceStr := "device"
+		errorStr := "Error"
+		if len(invalidDevices) > 1 {
+			deviceStr += "s"
+			errorStr = "First error"
+		}
+		names := strings.Join(lo.Slice(invalidDevices, 0, maxReportedInvalidDevices), ", ")
+		if len(invalidDevices) > maxReportedInvalidDevices {
+			names += ", ..."
+		}
+		return fmt.Errorf("%d %s missing template parameters: %s. %s: %w", len(invalidDevices), deviceStr, names, errorStr, firstError)
+	}
+	return nil
+}
+
 func (t *FleetValidateLogic) getFingerprint() string {
 	if t.event.Reason != domain.EventReasonDependencyChangeDetected || t.event.Details == nil {
 		return ""
@@ -13,6 +13,7 @@ import (
 	templateversionservice "github.com/flightctl/flightctl/internal/service/templateversion"
 	"github.com/flightctl/flightctl/pkg/k8sclient"
 	"github.com/google/uuid"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -74,6 +75,9 @@ func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_ImmediateRollou
 			// Mock OverwriteFleetRepositoryRefs to succeed
 			mockFleetSvc.EXPECT().OverwriteFleetRepositoryRefs(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(domain.Status{Code: http.StatusOK})
 
+			// Mock ListDevices to return no devices for the template parameter check
+			mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.DeviceList{}, domain.Status{Code: http.StatusOK})
+
 			// Mock CreateTemplateVersion to capture the immediateRollout parameter
 			var capturedImmediateRollout bool
 			mockTemplateVersionSvc.EXPECT().CreateTemplateVersion(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
@@ -108,6 +112,50 @@ func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_ImmediateRollou
 	}
 }
 
+func TestFleetValidateLogic_CreateNewTemplateVersionIfFleetValid_MissingParameters(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	fleetName := "test-fleet"
+	fleet := createTestFleet(fleetName, nil)
+	fleet.Spec.Template.Spec.Os.Image = "quay.io/example/os:{{ .parameters.version }}"
+	fleet.Spec.Parameters = &[]domain.DeviceParameterSet{
+		{Devices: &[]string{"device-1"}, Values: map[string]string{"version": "v2"}},
+	}
+	event := createTestEvent(domain.FleetKind, "some-reason", fleetName)
+	orgId := uuid.New()
+
+	mockFleetSvc := fleetservice.NewMockService(ctrl)
+	mockTemplateVersionSvc := templateversionservice.NewMockService(ctrl)
+	mockDeviceSvc := deviceservice.NewMockService(ctrl)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	mockK8SClient := k8sclient.NewMockK8SClient(ctrl)
+
+	mockFleetSvc.EXPECT().GetFleet(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(fleet, domain.Status{Code: http.StatusOK})
+	mockFleetSvc.EXPECT().OverwriteFleetRepositoryRefs(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).Return(domain.Status{Code: http.StatusOK})
+	mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(&domain.DeviceList{
+		Items: []domain.Device{
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr("device-1")}},
+			{Metadata: domain.ObjectMeta{Name: lo.ToPtr("device-2")}},
+		},
+	}, domain.Status{Code: http.StatusOK})
+
+	var capturedCondition domain.Condition
+	mockFleetSvc.EXPECT().UpdateFleetConditions(gomock.Any(), gomock.Any(), fleetName, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, orgId uuid.UUID, name string, conditions []domain.Condition) domain.Status {
+			capturedCondition = conditions[0]
+			return domain.Status{Code: http.StatusOK}
+		})
+
+	logic := NewFleetValidateLogic(logrus.New(), mockFleetSvc, mockTemplateVersionSvc, mockDeviceSvc, mockRepositorySvc, mockK8SClient, orgId, event)
+	err := logic.CreateNewTemplateVersionIfFleetValid(context.Background())
+
+	require.ErrorIs(t, err, ErrMissingTemplateParameters)
+	assert.Equal(t, domain.ConditionStatusFalse, capturedCondition.Status)
+	assert.Contains(t, capturedCondition.Message, "1 device missing template parameters: device-2")
+	assert.Contains(t, capturedCondition.Message, "version")
+}
+
 func TestGenerateTemplateVersionName(t *testing.T) {
 	require := require.New(t)
 
@@ -13,7 +13,9 @@ import (
 	"github.com/flightctl/flightctl/internal/instrumentation/encryption"
 )
 
-func sendHTTPrequest(ctx context.Context, repoSpec domain.RepositorySpec, repoURL string) ([]byte, error) {
+// SendHTTPRequest fetches the content at the given URL of an HTTP repository, using the repository's
+// authentication and TLS configuration.
+func SendHTTPRequest(ctx context.Context, repoSpec domain.RepositorySpec, repoURL string) ([]byte, error) {
 	req, err := http.NewRequestWithContext(ctx, "GET", repoURL, nil)
 	if err != nil {
 		return nil, fmt.Errorf("creating request: %w", err)
@@ -196,7 +196,7 @@ func (r *HttpRepoTester) TestAccess(ctx context.Context, repository *domain.Repo
 	}
 
 	repoSpec := repository.Spec
-	_, err = sendHTTPrequest(ctx, repoSpec, repoURL)
+	_, err = SendHTTPRequest(ctx, repoSpec, repoURL)
 	return err
 }
 
@@ -7,14 +7,17 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"slices"
 	"strings"
 
 	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/flterrors"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
 	"github.com/flightctl/flightctl/internal/service/common"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
 	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
@@ -35,6 +38,8 @@ type ResourceSync struct {
 	fleetSvc              fleetservice.Service
 	resourcesyncSvc       resourcesyncservice.Service
 	catalogSvc            catalogservice.Service
+	authProviderSvc       authproviderservice.Service
+	deviceSvc             deviceservice.Service
 	cfg                   *config.Config
 	ignoreResourceUpdates []string
 }
@@ -42,15 +47,21 @@ type ResourceSync struct {
 type GenericResourceMap map[string]interface{}
 
 var validFileExtensions = []string{"json", "yaml", "yml"}
-var supportedResources = []string{domain.FleetKind, domain.CatalogKind, domain.CatalogItemKind}
+var supportedResources = []string{domain.FleetKind, domain.CatalogKind, domain.CatalogItemKind, domain.RepositoryKind, domain.AuthProviderKind, domain.DeviceKind}
 
-func NewResourceSync(repositorySvc repositoryservice.Service, fleetSvc fleetservice.Service, resourcesyncSvc resourcesyncservice.Service, catalogSvc catalogservice.Service, log logrus.FieldLogger, cfg *config.Config, ignoreResourceUpdates []string) *ResourceSync {
+// organizationOnlyResources are only synced by ResourceSyncs of type organization.
+// Other sync types skip them so that a single repository can serve several ResourceSyncs.
+var organizationOnlyResources = []string{domain.RepositoryKind, domain.AuthProviderKind, domain.DeviceKind}
+
+func NewResourceSync(repositorySvc repositoryservice.Service, fleetSvc fleetservice.Service, resourcesyncSvc resourcesyncservice.Service, catalogSvc catalogservice.Service, authProviderSvc authproviderservice.Service, deviceSvc deviceservice.Service, log logrus.FieldLogger, cfg *config.Config, ignoreResourceUpdates []string) *ResourceSync {
 	return &ResourceSync{
 		log:                   log,
 		repositorySvc:         repositorySvc,
 		fleetSvc:              fleetSvc,
 		resourcesyncSvc:       resourcesyncSvc,
 		catalogSvc:            catalogSvc,
+		authProviderSvc:       authProviderSvc,
+		deviceSvc:             deviceSvc,
 		cfg:                   cfg,
 		ignoreResourceUpdates: ignoreResourceUpdates,
 	}
@@ -60,6 +71,25 @@ func (r *ResourceSync) Poll(ctx context.Context, orgId uuid.UUID) {
 	log := log.WithReqIDFromCtx(ctx, r.log)
 
 	log.Info("Running ResourceSync Polling")
+	r.runMatching(ctx, log, orgId, func(*domain.ResourceSync) bool { return true })
+}
+
+// SyncForRepositories runs the ResourceSyncs of the organization that read from one of the given
+// repositories. If revision is set, only ResourceSyncs targeting that revision are run.
+// It is used to react to a push to a repository without waiting for the next poll.
+func (r *ResourceSync) SyncForRepositories(ctx context.Context, orgId uuid.UUID, repositories []string, revision string) {
+	log := log.WithReqIDFromCtx(ctx, r.log)
+
+	log.Infof("Running ResourceSyncs for repositories %v", repositories)
+	r.runMatching(ctx, log, orgId, func(rs *domain.ResourceSync) bool {
+		if !slices.Contains(repositories, rs.Spec.Repository) {
+			return false
+		}
+		return revision == "" || rs.Spec.TargetRevision == revision
+	})
+}
+
+func (r *ResourceSync) runMatching(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, matches func(*domain.ResourceSync) bool) {
 
 	limit := int32(ItemsPerPage)
 	continueToken := (*string)(nil)
@@ -76,6 +106,9 @@ func (r *ResourceSync) Poll(ctx context.Context, orgId uuid.UUID) {
 
 		for i := range resourcesyncs.Items {
 			rs := &resourcesyncs.Items[i]
+			if !matches(rs) {
+				continue
+			}
 			err := r.run(ctx, log, orgId, rs)
 			if err != nil {
 				log.Errorf("resourcesync/%s: error during run: %v", *rs.Metadata.Name, err)
@@ -120,12 +153,15 @@ func (r *ResourceSync) run(ctx context.Context, log logrus.FieldLogger, orgId uu
 		return r.syncFleetResources(ctx, log, orgId, rs, resources, resourceName)
 	case domain.ResourceSyncTypeCatalog:
 		return r.syncCatalogResources(ctx, log, orgId, rs, resources, resourceName)
+	case domain.ResourceSyncTypeOrganization:
+		return r.syncOrganizationResources(ctx, log, orgId, rs, resources, resourceName)
 	default:
 		return fmt.Errorf("resource %s: unsupported sync type %q", resourceName, syncType)
 	}
 }
 
 func (r *ResourceSync) syncFleetResources(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, resourceName string) error {
+	resources = excludeKinds(resources, organizationOnlyResources...)
 	fleetResources := filterByKind(resources, domain.FleetKind)
 
 	if unexpected := unexpectedKinds(resources, domain.FleetKind); len(unexpected) > 0 {
@@ -152,6 +188,7 @@ func (r *ResourceSync) syncFleetResources(ctx context.Context, log logrus.FieldL
 }
 
 func (r *ResourceSync) syncCatalogResources(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, resourceName string) error {
+	resources = excludeKinds(resources, organizationOnlyResources...)
 	catalogResources := filterByKind(resources, domain.CatalogKind)
 	itemResources := filterByKind(resources, domain.CatalogItemKind)
 
@@ -643,6 +680,18 @@ func filterByKind(resources []GenericResourceMap, kind string) []GenericResource
 	return filtered
 }
 
+// excludeKinds returns only resources whose kind is not in the given set.
+func excludeKinds(resources []GenericResourceMap, kinds ...string) []GenericResourceMap {
+	filtered := make([]GenericResourceMap, 0, len(resources))
+	for _, r := range resources {
+		if k, ok := r["kind"].(string); ok && lo.Contains(kinds, k) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
 func (r *ResourceSync) parseCatalogs(resources []GenericResourceMap) ([]*domain.Catalog, error) {
 	catalogs := make([]*domain.Catalog, 0)
 	names := make(map[string]bool)
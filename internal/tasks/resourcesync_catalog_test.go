@@ -69,7 +69,7 @@ func loadFixtures(t *testing.T, efs embed.FS, root string) []GenericResourceMap
 	err := copyEmbedToMemfs(efs, root, mfs, "/catalog")
 	require.NoError(t, err)
 
-	rs := NewResourceSync(nil, nil, nil, nil, logrus.New(), nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, logrus.New(), nil, nil)
 	resources, err := rs.extractResourcesFromDir(mfs, "/catalog")
 	require.NoError(t, err)
 	return resources
@@ -114,7 +114,7 @@ func TestSyncCatalogs_InitialCreate(t *testing.T) {
 	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
 	mockCatalogSvc := catalogservice.NewMockService(ctrl)
 	log := logrus.New()
-	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, log, nil, nil)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, nil, nil, log, nil, nil)
 
 	orgId := uuid.New()
 	resourceName := "test-rs"
@@ -193,7 +193,7 @@ func TestSyncCatalogs_UpdateAndRemove(t *testing.T) {
 	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
 	mockCatalogSvc := catalogservice.NewMockService(ctrl)
 	log := logrus.New()
-	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, log, nil, nil)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, nil, nil, log, nil, nil)
 
 	orgId := uuid.New()
 	resourceName := "test-rs"
@@ -289,7 +289,7 @@ func TestSyncCatalogs_RemoveAll(t *testing.T) {
 	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
 	mockCatalogSvc := catalogservice.NewMockService(ctrl)
 	log := logrus.New()
-	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, log, nil, nil)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, nil, nil, log, nil, nil)
 
 	orgId := uuid.New()
 	resourceName := "test-rs"
@@ -341,7 +341,7 @@ func TestSyncCatalogs_DeleteOrdering(t *testing.T) {
 	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
 	mockCatalogSvc := catalogservice.NewMockService(ctrl)
 	log := logrus.New()
-	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, log, nil, nil)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, nil, nil, log, nil, nil)
 
 	orgId := uuid.New()
 	resourceName := "test-rs"
@@ -370,7 +370,7 @@ func TestSyncCatalogs_DeleteOrdering(t *testing.T) {
 func TestSyncCatalogs_MultiversionParse(t *testing.T) {
 	resources := loadFixtures(t, catalogMultiversionFS, "testdata/catalog_multiversion")
 
-	rs := NewResourceSync(nil, nil, nil, nil, logrus.New(), nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, logrus.New(), nil, nil)
 
 	catalogResources := filterByKind(resources, domain.CatalogKind)
 	itemResources := filterByKind(resources, domain.CatalogItemKind)
@@ -449,7 +449,7 @@ func TestSyncCatalogs_MultiversionSync(t *testing.T) {
 	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
 	mockCatalogSvc := catalogservice.NewMockService(ctrl)
 	log := logrus.New()
-	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, log, nil, nil)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, mockResourceSyncSvc, mockCatalogSvc, nil, nil, log, nil, nil)
 
 	orgId := uuid.New()
 	resourceName := "test-rs"
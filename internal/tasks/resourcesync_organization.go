@@ -0,0 +1,558 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/internal/util/validation"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	yamlutil "k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// syncOrganizationResources applies every supported kind found in the repository.
+// Resources are created in dependency order (Repositories and AuthProviders before
+// the Catalogs and Fleets that may reference them) and stale resources are removed
+// in reverse order once everything else has been applied.
+func (r *ResourceSync) syncOrganizationResources(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, resourceName string) error {
+	setParseErr := func(err error) error {
+		parseErr := fmt.Errorf("resource %s: error: %w", resourceName, err)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", parseErr)
+		log.Error(parseErr)
+		return parseErr
+	}
+
+	repositories, err := r.parseRepositories(filterByKind(resources, domain.RepositoryKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	authProviders, err := r.parseAuthProviders(ctx, filterByKind(resources, domain.AuthProviderKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	catalogs, err := r.parseCatalogs(filterByKind(resources, domain.CatalogKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	items, err := r.parseCatalogItems(filterByKind(resources, domain.CatalogItemKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	fleets, err := r.parseFleets(filterByKind(resources, domain.FleetKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	devices, err := r.parseDevices(filterByKind(resources, domain.DeviceKind))
+	if err != nil {
+		return setParseErr(err)
+	}
+	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncResourceParsed, "success", "fail", nil)
+
+	repositoriesToRemove, err := r.SyncRepositories(ctx, log, orgId, rs, repositories, resourceName)
+	if err != nil {
+		return err
+	}
+	authProvidersToRemove, err := r.SyncAuthProviders(ctx, log, orgId, rs, authProviders, resourceName)
+	if err != nil {
+		return err
+	}
+	catalogsToRemove, err := r.SyncCatalogs(ctx, log, orgId, rs, catalogs, resourceName)
+	if err != nil {
+		return err
+	}
+	itemsToRemove, err := r.SyncCatalogItems(ctx, log, orgId, rs, items, resourceName)
+	if err != nil {
+		return err
+	}
+	if err := r.SyncFleets(ctx, log, orgId, rs, fleets, resourceName); err != nil {
+		return err
+	}
+	if err := r.SyncDeviceLabels(ctx, log, orgId, rs, devices, resourceName); err != nil {
+		return err
+	}
+
+	if err := r.deleteStaleCatalogItems(ctx, log, orgId, rs, itemsToRemove, resourceName); err != nil {
+		return err
+	}
+	if err := r.deleteStaleCatalogs(ctx, log, orgId, rs, catalogsToRemove, resourceName); err != nil {
+		return err
+	}
+	if err := r.deleteStaleAuthProviders(ctx, log, orgId, rs, authProvidersToRemove, resourceName); err != nil {
+		return err
+	}
+	if err := r.deleteStaleRepositories(ctx, log, orgId, rs, repositoriesToRemove, resourceName); err != nil {
+		return err
+	}
+
+	domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", nil)
+	rs.Status.ObservedGeneration = rs.Metadata.Generation
+	return nil
+}
+
+func (r *ResourceSync) parseRepositories(resources []GenericResourceMap) ([]*domain.Repository, error) {
+	repositories := make([]*domain.Repository, 0)
+	names := make(map[string]bool)
+	for _, resource := range resources {
+		buf, err := json.Marshal(resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse generic resource: %w", err)
+		}
+		var repository domain.Repository
+		if err := yamlutil.Unmarshal(buf, &repository); err != nil {
+			return nil, fmt.Errorf("decoding Repository resource: %w", err)
+		}
+		if repository.Metadata.Name == nil {
+			return nil, fmt.Errorf("decoding Repository resource: missing field .metadata.name")
+		}
+		if errs := repository.Validate(); len(errs) > 0 {
+			return nil, fmt.Errorf("failed validating repository %s: %w", *repository.Metadata.Name, errors.Join(errs...))
+		}
+		if names[*repository.Metadata.Name] {
+			return nil, fmt.Errorf("found multiple repository definitions with name '%s'", *repository.Metadata.Name)
+		}
+		names[*repository.Metadata.Name] = true
+		repositories = append(repositories, &repository)
+	}
+	return repositories, nil
+}
+
+func (r *ResourceSync) parseAuthProviders(ctx context.Context, resources []GenericResourceMap) ([]*domain.AuthProvider, error) {
+	authProviders := make([]*domain.AuthProvider, 0)
+	names := make(map[string]bool)
+	for _, resource := range resources {
+		buf, err := json.Marshal(resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse generic resource: %w", err)
+		}
+		var authProvider domain.AuthProvider
+		if err := yamlutil.Unmarshal(buf, &authProvider); err != nil {
+			return nil, fmt.Errorf("decoding AuthProvider resource: %w", err)
+		}
+		if authProvider.Metadata.Name == nil {
+			return nil, fmt.Errorf("decoding AuthProvider resource: missing field .metadata.name")
+		}
+		if errs := authProvider.Validate(ctx); len(errs) > 0 {
+			return nil, fmt.Errorf("failed validating auth provider %s: %w", *authProvider.Metadata.Name, errors.Join(errs...))
+		}
+		if names[*authProvider.Metadata.Name] {
+			return nil, fmt.Errorf("found multiple auth provider definitions with name '%s'", *authProvider.Metadata.Name)
+		}
+		names[*authProvider.Metadata.Name] = true
+		authProviders = append(authProviders, &authProvider)
+	}
+	return authProviders, nil
+}
+
+// parseDevices parses Device resources. Only metadata.name and metadata.labels are
+// honored; devices are enrolled by the agent and never created from git.
+func (r *ResourceSync) parseDevices(resources []GenericResourceMap) ([]*domain.Device, error) {
+	devices := make([]*domain.Device, 0)
+	names := make(map[string]bool)
+	for _, resource := range resources {
+		buf, err := json.Marshal(resource)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse generic resource: %w", err)
+		}
+		var device domain.Device
+		if err := yamlutil.Unmarshal(buf, &device); err != nil {
+			return nil, fmt.Errorf("decoding Device resource: %w", err)
+		}
+		if device.Metadata.Name == nil {
+			return nil, fmt.Errorf("decoding Device resource: missing field .metadata.name")
+		}
+		errs := validation.ValidateResourceName(device.Metadata.Name)
+		errs = append(errs, validation.ValidateLabels(device.Metadata.Labels)...)
+		if len(errs) > 0 {
+			return nil, fmt.Errorf("failed validating device %s: %w", *device.Metadata.Name, errors.Join(errs...))
+		}
+		if names[*device.Metadata.Name] {
+			return nil, fmt.Errorf("found multiple device definitions with name '%s'", *device.Metadata.Name)
+		}
+		names[*device.Metadata.Name] = true
+		devices = append(devices, &device)
+	}
+	return devices, nil
+}
+
+// SyncRepositories creates/updates repositories and returns the list of stale repository names to delete.
+// The repository the ResourceSync itself reads from is never returned for deletion.
+func (r *ResourceSync) SyncRepositories(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, repositories []*domain.Repository, resourceName string) ([]string, error) {
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	if err := r.validateRepositoryNameConflicts(ctx, orgId, repositories, *owner); err != nil {
+		validateErr := fmt.Errorf("resource %s: error: %w", resourceName, err)
+		log.Error(validateErr)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", validateErr)
+		return nil, validateErr
+	}
+
+	repositoriesPreOwned := make([]string, 0)
+	listParams := domain.ListRepositoriesParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
+	}
+	for {
+		listRes, status := r.repositorySvc.ListRepositories(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			err := fmt.Errorf("resource %s: failed to list owned repositories: %s", resourceName, status.Message)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return nil, err
+		}
+		for _, item := range listRes.Items {
+			repositoriesPreOwned = append(repositoriesPreOwned, lo.FromPtr(item.Metadata.Name))
+		}
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+
+	desired := lo.Map(repositories, func(repo *domain.Repository, _ int) string { return *repo.Metadata.Name })
+	toRemove := lo.Filter(namesDelta(repositoriesPreOwned, desired), func(name string, _ int) bool {
+		return name != rs.Spec.Repository
+	})
+
+	if len(repositories) > 0 {
+		log.Infof("Resource %s: applying %d repositories", resourceName, len(repositories))
+		if err := r.createOrUpdateRepositories(ctx, orgId, owner, repositories...); err != nil {
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			log.Errorf("Resource %s: failed to apply repositories: %s", resourceName, err.Error())
+			return nil, err
+		}
+		log.Infof("Resource %s: %d repositories applied successfully", resourceName, len(repositories))
+	}
+	return toRemove, nil
+}
+
+func (r *ResourceSync) createOrUpdateRepositories(ctx context.Context, orgId uuid.UUID, owner *string, resources ...*domain.Repository) error {
+	var errs []error
+	for _, resource := range resources {
+		externalCtx := context.WithValue(ctx, consts.InternalRequestCtxKey, false)
+		externalCtx = context.WithValue(externalCtx, consts.ResourceSyncRequestCtxKey, true)
+		updatedRepository, status := r.repositorySvc.ReplaceRepository(externalCtx, orgId, *resource.Metadata.Name, *resource)
+		if status.Code != http.StatusOK && status.Code != http.StatusCreated {
+			if status.Message == flterrors.ErrUpdatingResourceWithOwnerNotAllowed.Error() {
+				errs = append(errs, errors.New("one or more repositories are managed by a different resource"))
+			} else {
+				errs = append(errs, common.ApiStatusToErr(status))
+			}
+			continue
+		}
+
+		// Set owner if not already set
+		if updatedRepository != nil && util.DefaultIfNil(updatedRepository.Metadata.Owner, "") != util.DefaultIfNil(owner, "") {
+			updatedRepository.Metadata.Owner = owner
+			_, status := r.repositorySvc.ReplaceRepository(ctx, orgId, *resource.Metadata.Name, *updatedRepository)
+			if status.Code != http.StatusOK {
+				errs = append(errs, common.ApiStatusToErr(status))
+			}
+		}
+	}
+	return errors.Join(lo.Uniq(errs)...)
+}
+
+func (r *ResourceSync) validateRepositoryNameConflicts(ctx context.Context, orgId uuid.UUID, repositories []*domain.Repository, owner string) error {
+	var conflicts []string
+	for _, repository := range repositories {
+		name := *repository.Metadata.Name
+		existing, status := r.repositorySvc.GetRepository(ctx, orgId, name)
+		if status.Code == http.StatusOK {
+			if existing.Metadata.Owner != nil && *existing.Metadata.Owner != owner {
+				conflicts = append(conflicts, name)
+			}
+		} else if status.Code != http.StatusNotFound {
+			return fmt.Errorf("failed to check existing repository '%s': %s", name, status.Message)
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("repository name(s) %v conflict with existing repositories managed by different ResourceSyncs", conflicts)
+	}
+	return nil
+}
+
+// deleteStaleRepositories deletes repositories that are no longer present in git.
+func (r *ResourceSync) deleteStaleRepositories(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, toRemove []string, resourceName string) error {
+	if len(toRemove) == 0 {
+		return nil
+	}
+	log.Infof("Resource %s: found #%d repositories to remove. removing", resourceName, len(toRemove))
+	deleteCtx := context.WithValue(ctx, consts.ResourceSyncRequestCtxKey, true)
+	for _, name := range toRemove {
+		status := r.repositorySvc.DeleteRepository(deleteCtx, orgId, name)
+		if status.Code != http.StatusOK {
+			err := fmt.Errorf("resource %s: failed to remove old repository %s: %s", resourceName, name, status.Message)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return common.ApiStatusToErr(status)
+		}
+	}
+	return nil
+}
+
+// SyncAuthProviders creates/updates auth providers and returns the list of stale auth provider names to delete.
+func (r *ResourceSync) SyncAuthProviders(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, authProviders []*domain.AuthProvider, resourceName string) ([]string, error) {
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	if err := r.validateAuthProviderNameConflicts(ctx, orgId, authProviders, *owner); err != nil {
+		validateErr := fmt.Errorf("resource %s: error: %w", resourceName, err)
+		log.Error(validateErr)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", validateErr)
+		return nil, validateErr
+	}
+
+	authProvidersPreOwned := make([]string, 0)
+	listParams := domain.ListAuthProvidersParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", *owner)),
+	}
+	for {
+		listRes, status := r.authProviderSvc.ListAuthProviders(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			err := fmt.Errorf("resource %s: failed to list owned auth providers: %s", resourceName, status.Message)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return nil, err
+		}
+		for _, item := range listRes.Items {
+			authProvidersPreOwned = append(authProvidersPreOwned, lo.FromPtr(item.Metadata.Name))
+		}
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+
+	desired := lo.Map(authProviders, func(ap *domain.AuthProvider, _ int) string { return *ap.Metadata.Name })
+	toRemove := namesDelta(authProvidersPreOwned, desired)
+
+	if len(authProviders) > 0 {
+		log.Infof("Resource %s: applying %d auth providers", resourceName, len(authProviders))
+		if err := r.createOrUpdateAuthProviders(ctx, orgId, owner, authProviders...); err != nil {
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			log.Errorf("Resource %s: failed to apply auth providers: %s", resourceName, err.Error())
+			return nil, err
+		}
+		log.Infof("Resource %s: %d auth providers applied successfully", resourceName, len(authProviders))
+	}
+	return toRemove, nil
+}
+
+func (r *ResourceSync) createOrUpdateAuthProviders(ctx context.Context, orgId uuid.UUID, owner *string, resources ...*domain.AuthProvider) error {
+	var errs []error
+	for _, resource := range resources {
+		externalCtx := context.WithValue(ctx, consts.InternalRequestCtxKey, false)
+		externalCtx = context.WithValue(externalCtx, consts.ResourceSyncRequestCtxKey, true)
+		updatedAuthProvider, status := r.authProviderSvc.ReplaceAuthProvider(externalCtx, orgId, *resource.Metadata.Name, *resource)
+		if status.Code != http.StatusOK && status.Code != http.StatusCreated {
+			if status.Message == flterrors.ErrUpdatingResourceWithOwnerNotAllowed.Error() {
+				errs = append(errs, errors.New("one or more auth providers are managed by a different resource"))
+			} else {
+				errs = append(errs, common.ApiStatusToErr(status))
+			}
+			continue
+		}
+
+		// Set owner if not already set
+		if updatedAuthProvider != nil && util.DefaultIfNil(updatedAuthProvider.Metadata.Owner, "") != util.DefaultIfNil(owner, "") {
+			updatedAuthProvider.Metadata.Owner = owner
+			_, status := r.authProviderSvc.ReplaceAuthProvider(ctx, orgId, *resource.Metadata.Name, *updatedAuthProvider)
+			if status.Code != http.StatusOK {
+				errs = append(errs, common.ApiStatusToErr(status))
+			}
+		}
+	}
+	return errors.Join(lo.Uniq(errs)...)
+}
+
+func (r *ResourceSync) validateAuthProviderNameConflicts(ctx context.Context, orgId uuid.UUID, authProviders []*domain.AuthProvider, owner string) error {
+	var conflicts []string
+	for _, authProvider := range authProviders {
+		name := *authProvider.Metadata.Name
+		existing, status := r.authProviderSvc.GetAuthProvider(ctx, orgId, name)
+		if status.Code == http.StatusOK {
+			if existing.Metadata.Owner != nil && *existing.Metadata.Owner != owner {
+				conflicts = append(conflicts, name)
+			}
+		} else if status.Code != http.StatusNotFound {
+			return fmt.Errorf("failed to check existing auth provider '%s': %s", name, status.Message)
+		}
+	}
+	if len(conflicts) > 0 {
+		return fmt.Errorf("auth provider name(s) %v conflict with existing auth providers managed by different ResourceSyncs", conflicts)
+	}
+	return nil
+}
+
+// deleteStaleAuthProviders deletes auth providers that are no longer present in git.
+func (r *ResourceSync) deleteStaleAuthProviders(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, toRemove []string, resourceName string) error {
+	if len(toRemove) == 0 {
+		return nil
+	}
+	log.Infof("Resource %s: found #%d auth providers to remove. removing", resourceName, len(toRemove))
+	deleteCtx := context.WithValue(ctx, consts.ResourceSyncRequestCtxKey, true)
+	for _, name := range toRemove {
+		status := r.authProviderSvc.DeleteAuthProvider(deleteCtx, orgId, name)
+		if status.Code != http.StatusOK {
+			err := fmt.Errorf("resource %s: failed to remove old auth provider %s: %s", resourceName, name, status.Message)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return common.ApiStatusToErr(status)
+		}
+	}
+	return nil
+}
+
+// SyncDeviceLabels applies the labels declared in git to existing devices.
+//
+// Devices carry the Fleet that selected them as their owner, so ResourceSync tracks the
+// devices it manages through annotations instead: the owning ResourceSync's name and the
+// label keys it applied. Labels that were set by other means are left untouched. Devices
+// that were managed by this ResourceSync but are no longer in git get their managed labels
+// and annotations removed; devices themselves are never deleted.
+func (r *ResourceSync) SyncDeviceLabels(ctx context.Context, log logrus.FieldLogger, orgId uuid.UUID, rs *domain.ResourceSync, devices []*domain.Device, resourceName string) error {
+	managedPreviously := make(map[string]domain.Device)
+	listParams := domain.ListDevicesParams{
+		Limit: lo.ToPtr(int32(100)),
+	}
+	annotationSelector := selector.NewAnnotationSelectorFromMapOrDie(map[string]string{
+		domain.DeviceAnnotationResourceSyncOwner: resourceName,
+	})
+	for {
+		listRes, status := r.deviceSvc.ListDevices(ctx, orgId, listParams, annotationSelector)
+		if status.Code != http.StatusOK {
+			err := fmt.Errorf("resource %s: failed to list managed devices: %s", resourceName, status.Message)
+			log.Error(err)
+			domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+			return err
+		}
+		for _, device := range listRes.Items {
+			managedPreviously[lo.FromPtr(device.Metadata.Name)] = device
+		}
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+
+	var errs []error
+	var conflicts []string
+	applied := 0
+	for _, device := range devices {
+		name := *device.Metadata.Name
+		existing, status := r.deviceSvc.GetDevice(ctx, orgId, name)
+		if status.Code == http.StatusNotFound {
+			log.Warnf("Resource %s: device %s does not exist yet. skipping", resourceName, name)
+			continue
+		}
+		if status.Code != http.StatusOK {
+			errs = append(errs, fmt.Errorf("failed to get device '%s': %s", name, status.Message))
+			continue
+		}
+		annotations := lo.FromPtr(existing.Metadata.Annotations)
+		if currentOwner, ok := annotations[domain.DeviceAnnotationResourceSyncOwner]; ok && currentOwner != resourceName {
+			conflicts = append(conflicts, name)
+			continue
+		}
+		if err := r.applyDeviceLabels(ctx, orgId, existing, lo.FromPtr(device.Metadata.Labels), resourceName); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		applied++
+	}
+	if len(conflicts) > 0 {
+		errs = append(errs, fmt.Errorf("device(s) %v are managed by different ResourceSyncs", conflicts))
+	}
+
+	desired := lo.Map(devices, func(device *domain.Device, _ int) string { return *device.Metadata.Name })
+	for name, device := range managedPreviously {
+		if lo.Contains(desired, name) {
+			continue
+		}
+		if err := r.releaseDeviceLabels(ctx, orgId, &device); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	err := errors.Join(errs...)
+	if err != nil {
+		err = fmt.Errorf("resource %s: %w", resourceName, err)
+		log.Error(err)
+		domain.SetStatusConditionByError(&rs.Status.Conditions, domain.ConditionTypeResourceSyncSynced, "success", "fail", err)
+		return err
+	}
+	if len(devices) > 0 {
+		log.Infof("Resource %s: labels applied to %d devices", resourceName, applied)
+	}
+	return nil
+}
+
+// applyDeviceLabels replaces the labels previously managed on the device with the given ones
+// and records ownership in the device annotations.
+func (r *ResourceSync) applyDeviceLabels(ctx context.Context, orgId uuid.UUID, device *domain.Device, labels map[string]string, resourceName string) error {
+	name := lo.FromPtr(device.Metadata.Name)
+	current := lo.FromPtr(device.Metadata.Labels)
+	desired := common.DesiredResourceSyncDeviceLabels(current, common.ResourceSyncManagedLabelKeys(device), labels)
+
+	if !maps.Equal(current, desired) {
+		patch := domain.PatchRequest{{Op: "replace", Path: "/metadata/labels", Value: desired}}
+		if _, status := r.deviceSvc.PatchDevice(ctx, orgId, name, patch); status.Code != http.StatusOK {
+			return fmt.Errorf("failed to update labels of device '%s': %s", name, status.Message)
+		}
+	}
+
+	keys := slices.Sorted(maps.Keys(labels))
+	annotations := map[string]string{
+		domain.DeviceAnnotationResourceSyncOwner:         resourceName,
+		domain.DeviceAnnotationResourceSyncManagedLabels: strings.Join(keys, ","),
+	}
+	existing := lo.FromPtr(device.Metadata.Annotations)
+	if existing[domain.DeviceAnnotationResourceSyncOwner] == resourceName &&
+		existing[domain.DeviceAnnotationResourceSyncManagedLabels] == annotations[domain.DeviceAnnotationResourceSyncManagedLabels] {
+		return nil
+	}
+	if status := r.deviceSvc.UpdateDeviceAnnotations(ctx, orgId, name, annotations, nil); status.Code != http.StatusOK {
+		return fmt.Errorf("failed to update annotations of device '%s': %s", name, status.Message)
+	}
+	return nil
+}
+
+// releaseDeviceLabels removes the labels managed by a ResourceSync along with its annotations.
+func (r *ResourceSync) releaseDeviceLabels(ctx context.Context, orgId uuid.UUID, device *domain.Device) error {
+	name := lo.FromPtr(device.Metadata.Name)
+	current := lo.FromPtr(device.Metadata.Labels)
+	desired := common.DesiredResourceSyncDeviceLabels(current, common.ResourceSyncManagedLabelKeys(device), nil)
+
+	if !maps.Equal(current, desired) {
+		patch := domain.PatchRequest{{Op: "replace", Path: "/metadata/labels", Value: desired}}
+		if _, status := r.deviceSvc.PatchDevice(ctx, orgId, name, patch); status.Code != http.StatusOK {
+			return fmt.Errorf("failed to remove labels of device '%s': %s", name, status.Message)
+		}
+	}
+
+	deleteKeys := []string{domain.DeviceAnnotationResourceSyncOwner, domain.DeviceAnnotationResourceSyncManagedLabels}
+	if status := r.deviceSvc.UpdateDeviceAnnotations(ctx, orgId, name, nil, deleteKeys); status.Code != http.StatusOK {
+		return fmt.Errorf("failed to remove annotations of device '%s': %s", name, status.Message)
+	}
+	return nil
+}
+
+// namesDelta returns the owned names that are no longer present in the desired set.
+func namesDelta(owned []string, desired []string) []string {
+	return lo.Filter(owned, func(name string, _ int) bool {
+		return !lo.Contains(desired, name)
+	})
+}
@@ -0,0 +1,171 @@
+package tasks
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newTestDevice(name string, labels, annotations map[string]string) *domain.Device {
+	return &domain.Device{
+		Metadata: domain.ObjectMeta{
+			Name:        lo.ToPtr(name),
+			Labels:      lo.ToPtr(labels),
+			Annotations: lo.ToPtr(annotations),
+		},
+	}
+}
+
+func newTestGitRepository(t *testing.T, name string, owner *string) *domain.Repository {
+	t.Helper()
+	repo := &domain.Repository{
+		ApiVersion: "v1beta1",
+		Kind:       domain.RepositoryKind,
+		Metadata:   domain.ObjectMeta{Name: lo.ToPtr(name), Owner: owner},
+	}
+	require.NoError(t, repo.Spec.FromGitRepoSpec(domain.GitRepoSpec{Url: "https://example.com/" + name + ".git", Type: domain.GitRepoSpecTypeGit}))
+	return repo
+}
+
+func TestExcludeKinds(t *testing.T) {
+	resources := []GenericResourceMap{
+		{"kind": domain.FleetKind},
+		{"kind": domain.RepositoryKind},
+		{"kind": domain.DeviceKind},
+	}
+	filtered := excludeKinds(resources, organizationOnlyResources...)
+	require.Len(t, filtered, 1)
+	assert.Equal(t, domain.FleetKind, filtered[0]["kind"])
+}
+
+func TestParseDevices(t *testing.T) {
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, logrus.New(), nil, nil)
+
+	devices, err := rs.parseDevices([]GenericResourceMap{{
+		"kind":     domain.DeviceKind,
+		"metadata": map[string]interface{}{"name": "dev1", "labels": map[string]interface{}{"alias": "edge-1"}},
+	}})
+	require.NoError(t, err)
+	require.Len(t, devices, 1)
+	assert.Equal(t, "edge-1", lo.FromPtr(devices[0].Metadata.Labels)["alias"])
+
+	_, err = rs.parseDevices([]GenericResourceMap{
+		{"kind": domain.DeviceKind, "metadata": map[string]interface{}{"name": "dev1"}},
+		{"kind": domain.DeviceKind, "metadata": map[string]interface{}{"name": "dev1"}},
+	})
+	assert.ErrorContains(t, err, "multiple device definitions")
+}
+
+func TestSyncDeviceLabels(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockDeviceSvc := deviceservice.NewMockService(ctrl)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, mockDeviceSvc, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	resourceName := "org-config"
+	rsObj := newTestRS(resourceName)
+
+	managedAnnotations := map[string]string{
+		domain.DeviceAnnotationResourceSyncOwner:         resourceName,
+		domain.DeviceAnnotationResourceSyncManagedLabels: "site",
+	}
+	// dev1 is new to this ResourceSync, dev2 was managed but removed from git,
+	// dev3 belongs to another ResourceSync and dev4 is not enrolled yet.
+	dev1 := newTestDevice("dev1", map[string]string{"team": "ops"}, nil)
+	dev2 := newTestDevice("dev2", map[string]string{"site": "b", "team": "dev"}, managedAnnotations)
+	dev3 := newTestDevice("dev3", nil, map[string]string{domain.DeviceAnnotationResourceSyncOwner: "other"})
+
+	mockDeviceSvc.EXPECT().ListDevices(gomock.Any(), orgId, gomock.Any(), gomock.Any()).
+		Return(&domain.DeviceList{Items: []domain.Device{*dev2}}, okStatus())
+	mockDeviceSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev1").Return(dev1, okStatus())
+	mockDeviceSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev3").Return(dev3, okStatus())
+	mockDeviceSvc.EXPECT().GetDevice(gomock.Any(), orgId, "dev4").Return(nil, notFoundStatus())
+
+	mockDeviceSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev1", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			require.Len(t, patch, 1)
+			assert.Equal(t, "/metadata/labels", patch[0].Path)
+			assert.Equal(t, map[string]string{"team": "ops", "site": "a", "alias": "edge-1"}, patch[0].Value)
+			return dev1, okStatus()
+		})
+	mockDeviceSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "dev1", map[string]string{
+		domain.DeviceAnnotationResourceSyncOwner:         resourceName,
+		domain.DeviceAnnotationResourceSyncManagedLabels: "alias,site",
+	}, nil).Return(okStatus())
+
+	mockDeviceSvc.EXPECT().PatchDevice(gomock.Any(), orgId, "dev2", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, patch domain.PatchRequest) (*domain.Device, domain.Status) {
+			assert.Equal(t, map[string]string{"team": "dev"}, patch[0].Value)
+			return dev2, okStatus()
+		})
+	mockDeviceSvc.EXPECT().UpdateDeviceAnnotations(gomock.Any(), orgId, "dev2", nil,
+		[]string{domain.DeviceAnnotationResourceSyncOwner, domain.DeviceAnnotationResourceSyncManagedLabels}).Return(okStatus())
+
+	devices := []*domain.Device{
+		newTestDevice("dev1", map[string]string{"site": "a", "alias": "edge-1"}, nil),
+		newTestDevice("dev3", map[string]string{"site": "c"}, nil),
+		newTestDevice("dev4", map[string]string{"site": "d"}, nil),
+	}
+	err := rs.SyncDeviceLabels(context.Background(), logrus.New(), orgId, rsObj, devices, resourceName)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[dev3] are managed by different ResourceSyncs")
+	assert.True(t, domain.IsStatusConditionFalse(rsObj.Status.Conditions, domain.ConditionTypeResourceSyncSynced))
+}
+
+func TestSyncRepositories_KeepsSourceRepository(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	rs := NewResourceSync(mockRepositorySvc, nil, nil, nil, nil, nil, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	resourceName := "org-config"
+	rsObj := newTestRS(resourceName)
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+
+	desired := newTestGitRepository(t, "apps", nil)
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, "apps").Return(nil, notFoundStatus())
+	mockRepositorySvc.EXPECT().ListRepositories(gomock.Any(), orgId, gomock.Any()).Return(&domain.RepositoryList{
+		Items: []domain.Repository{
+			*newTestGitRepository(t, rsObj.Spec.Repository, owner),
+			*newTestGitRepository(t, "stale", owner),
+		},
+	}, okStatus())
+	mockRepositorySvc.EXPECT().ReplaceRepository(gomock.Any(), orgId, "apps", gomock.Any()).Return(desired, createdStatus())
+	mockRepositorySvc.EXPECT().ReplaceRepository(gomock.Any(), orgId, "apps", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ uuid.UUID, _ string, repo domain.Repository) (*domain.Repository, domain.Status) {
+			assert.Equal(t, owner, repo.Metadata.Owner)
+			return &repo, okStatus()
+		})
+
+	toRemove, err := rs.SyncRepositories(context.Background(), logrus.New(), orgId, rsObj, []*domain.Repository{desired}, resourceName)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"stale"}, toRemove)
+}
+
+func TestSyncAuthProviders_NameConflict(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockAuthProviderSvc := authproviderservice.NewMockService(ctrl)
+	rs := NewResourceSync(nil, nil, nil, nil, mockAuthProviderSvc, nil, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	rsObj := newTestRS("org-config")
+	existing := &domain.AuthProvider{Metadata: domain.ObjectMeta{Name: lo.ToPtr("sso"), Owner: util.SetResourceOwner(domain.ResourceSyncKind, "other")}}
+	mockAuthProviderSvc.EXPECT().GetAuthProvider(gomock.Any(), orgId, "sso").Return(existing, okStatus())
+
+	desired := &domain.AuthProvider{Metadata: domain.ObjectMeta{Name: lo.ToPtr("sso")}}
+	_, err := rs.SyncAuthProviders(context.Background(), logrus.New(), orgId, rsObj, []*domain.AuthProvider{desired}, "org-config")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "conflict with existing auth providers")
+	assert.True(t, domain.IsStatusConditionFalse(rsObj.Status.Conditions, domain.ConditionTypeResourceSyncSynced))
+}
@@ -0,0 +1,588 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/flightctl/flightctl/internal/store/selector"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+)
+
+// resourceSyncPlan accumulates the changes and validation errors found while planning.
+type resourceSyncPlan struct {
+	changes []domain.ResourceSyncPlannedChange
+	errors  []string
+}
+
+func (p *resourceSyncPlan) addError(err error) {
+	p.errors = append(p.errors, err.Error())
+}
+
+// addChange records the difference between the current and desired projections of a
+// resource. A nil current means the resource would be created, a nil desired that it
+// would be deleted. Unchanged resources are not recorded.
+func (p *resourceSyncPlan) addChange(kind, name string, current, desired interface{}) {
+	p.addRedactedChange(kind, name, current, desired, current, desired)
+}
+
+// addRedactedChange is like addChange, but reports the values of the redacted projections so
+// that secrets never leave the server. The diff itself is computed on the unredacted values
+// so that a changed secret is still detected.
+func (p *resourceSyncPlan) addRedactedChange(kind, name string, current, desired, redactedCurrent, redactedDesired interface{}) {
+	diffs := diffValues("", current, desired)
+	if len(diffs) == 0 {
+		return
+	}
+	for i := range diffs {
+		diffs[i].Current, _ = valueAtPointer(redactedCurrent, diffs[i].Path)
+		diffs[i].Desired, _ = valueAtPointer(redactedDesired, diffs[i].Path)
+	}
+
+	action := domain.ResourceSyncPlannedActionUpdate
+	switch {
+	case current == nil:
+		action = domain.ResourceSyncPlannedActionCreate
+	case desired == nil:
+		action = domain.ResourceSyncPlannedActionDelete
+	}
+	p.changes = append(p.changes, domain.ResourceSyncPlannedChange{
+		Kind:   kind,
+		Name:   name,
+		Action: action,
+		Diff:   diffs,
+	})
+}
+
+// Plan computes the changes the ResourceSync would apply if it synced the given revision of its
+// repository, defaulting to the ResourceSync's target revision. Nothing is written. Problems with
+// the repository contents are reported in the returned plan. Errors caused by the ResourceSync
+// itself, such as a missing repository or an unsupported sync type, wrap
+// flterrors.ErrInvalidResourceSync; other errors mean that the repository could not be read.
+func (r *ResourceSync) Plan(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, revision *string) (*domain.ResourceSyncPlan, error) {
+	return r.plan(ctx, orgId, rs, revision, CloneGitRepo)
+}
+
+func (r *ResourceSync) plan(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, revision *string, gitCloneRepo cloneGitRepoFunc) (*domain.ResourceSyncPlan, error) {
+	if rs == nil {
+		return nil, fmt.Errorf("ResourceSync is nil")
+	}
+	resourceName := lo.FromPtr(rs.Metadata.Name)
+	targetRevision := rs.Spec.TargetRevision
+	if lo.FromPtr(revision) != "" {
+		targetRevision = *revision
+	}
+
+	// Read through an internal request so that repository credentials are available for
+	// cloning and secrets can be compared. They are redacted before the plan is returned.
+	ctx = context.WithValue(ctx, consts.InternalRequestCtxKey, true)
+
+	repo, status := r.repositorySvc.GetRepository(ctx, orgId, rs.Spec.Repository)
+	if status.Code == http.StatusNotFound {
+		return nil, fmt.Errorf("%w: repository %s not found", flterrors.ErrInvalidResourceSync, rs.Spec.Repository)
+	}
+	if status.Code != http.StatusOK {
+		return nil, fmt.Errorf("failed to get repository %s: %w", rs.Spec.Repository, common.ApiStatusToErr(status))
+	}
+	mfs, hash, err := gitCloneRepo(ctx, repo, &targetRevision, lo.ToPtr(1), r.cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clone repository %s at revision %s: %w", rs.Spec.Repository, targetRevision, err)
+	}
+
+	result := &domain.ResourceSyncPlan{
+		TargetRevision: targetRevision,
+		Commit:         lo.ToPtr(hash),
+		Changes:        []domain.ResourceSyncPlannedChange{},
+		Errors:         []string{},
+	}
+
+	fileInfo, err := mfs.Stat(rs.Spec.Path)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("path %s not found in repository: %v", rs.Spec.Path, err))
+		return result, nil
+	}
+	var resources []GenericResourceMap
+	if fileInfo.IsDir() {
+		resources, err = r.extractResourcesFromDir(mfs, rs.Spec.Path)
+	} else {
+		resources, err = r.extractResourcesFromFile(mfs, rs.Spec.Path)
+	}
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result, nil
+	}
+
+	syncType := domain.ResourceSyncTypeFleet
+	if rs.Spec.Type != nil {
+		syncType = *rs.Spec.Type
+	}
+	var allowed []string
+	switch syncType {
+	case domain.ResourceSyncTypeFleet:
+		resources = excludeKinds(resources, organizationOnlyResources...)
+		allowed = []string{domain.FleetKind}
+	case domain.ResourceSyncTypeCatalog:
+		resources = excludeKinds(resources, organizationOnlyResources...)
+		allowed = []string{domain.CatalogKind, domain.CatalogItemKind}
+	case domain.ResourceSyncTypeOrganization:
+		allowed = supportedResources
+	default:
+		return nil, fmt.Errorf("%w: resource %s: unsupported sync type %q", flterrors.ErrInvalidResourceSync, resourceName, syncType)
+	}
+
+	plan := &resourceSyncPlan{}
+	if unexpected := unexpectedKinds(resources, allowed...); len(unexpected) > 0 {
+		sort.Strings(unexpected)
+		plan.addError(fmt.Errorf("sync type is %s but found unexpected kind(s): %v", syncType, unexpected))
+	}
+
+	owner := *util.SetResourceOwner(domain.ResourceSyncKind, resourceName)
+	if slices.Contains(allowed, domain.RepositoryKind) {
+		r.planRepositories(ctx, orgId, rs, filterByKind(resources, domain.RepositoryKind), owner, plan)
+	}
+	if slices.Contains(allowed, domain.AuthProviderKind) {
+		r.planAuthProviders(ctx, orgId, filterByKind(resources, domain.AuthProviderKind), owner, plan)
+	}
+	if slices.Contains(allowed, domain.CatalogKind) {
+		r.planCatalogs(ctx, orgId, filterByKind(resources, domain.CatalogKind), owner, plan)
+		r.planCatalogItems(ctx, orgId, filterByKind(resources, domain.CatalogItemKind), owner, plan)
+	}
+	if slices.Contains(allowed, domain.FleetKind) {
+		r.planFleets(ctx, orgId, filterByKind(resources, domain.FleetKind), owner, plan)
+	}
+	if slices.Contains(allowed, domain.DeviceKind) {
+		r.planDevices(ctx, orgId, filterByKind(resources, domain.DeviceKind), resourceName, plan)
+	}
+
+	result.Changes = append(result.Changes, plan.changes...)
+	result.Errors = append(result.Errors, plan.errors...)
+	return result, nil
+}
+
+func (r *ResourceSync) planFleets(ctx context.Context, orgId uuid.UUID, resources []GenericResourceMap, owner string, plan *resourceSyncPlan) {
+	fleets, err := r.parseFleets(resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+	if err := r.validateFleetNameConflicts(ctx, orgId, fleets, owner); err != nil {
+		plan.addError(err)
+		return
+	}
+
+	for _, fleet := range fleets {
+		name := *fleet.Metadata.Name
+		existing, status := r.fleetSvc.GetFleet(ctx, orgId, name, domain.GetFleetParams{})
+		var current interface{}
+		switch status.Code {
+		case http.StatusOK:
+			current = planProjection(existing.Metadata.Labels, existing.Spec)
+		case http.StatusNotFound:
+		default:
+			plan.addError(fmt.Errorf("failed to get fleet '%s': %s", name, status.Message))
+			continue
+		}
+		plan.addChange(domain.FleetKind, name, current, planProjection(fleet.Metadata.Labels, fleet.Spec))
+	}
+
+	listParams := domain.ListFleetsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	owned := make([]domain.Fleet, 0)
+	for {
+		listRes, status := r.fleetSvc.ListFleets(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list owned fleets: %s", status.Message))
+			return
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+	for _, name := range fleetsDelta(owned, fleets) {
+		fleet, _ := lo.Find(owned, func(f domain.Fleet) bool { return lo.FromPtr(f.Metadata.Name) == name })
+		plan.addChange(domain.FleetKind, name, planProjection(fleet.Metadata.Labels, fleet.Spec), nil)
+	}
+}
+
+func (r *ResourceSync) planCatalogs(ctx context.Context, orgId uuid.UUID, resources []GenericResourceMap, owner string, plan *resourceSyncPlan) {
+	catalogs, err := r.parseCatalogs(resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+	if err := r.validateCatalogNameConflicts(ctx, orgId, catalogs, owner); err != nil {
+		plan.addError(err)
+		return
+	}
+
+	for _, catalog := range catalogs {
+		name := *catalog.Metadata.Name
+		existing, status := r.catalogSvc.GetCatalog(ctx, orgId, name)
+		var current interface{}
+		switch status.Code {
+		case http.StatusOK:
+			current = planProjection(existing.Metadata.Labels, existing.Spec)
+		case http.StatusNotFound:
+		default:
+			plan.addError(fmt.Errorf("failed to get catalog '%s': %s", name, status.Message))
+			continue
+		}
+		plan.addChange(domain.CatalogKind, name, current, planProjection(catalog.Metadata.Labels, catalog.Spec))
+	}
+
+	listParams := domain.ListCatalogsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	owned := make([]domain.Catalog, 0)
+	for {
+		listRes, status := r.catalogSvc.ListCatalogs(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list owned catalogs: %s", status.Message))
+			return
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+	for _, name := range catalogsDelta(owned, catalogs) {
+		catalog, _ := lo.Find(owned, func(c domain.Catalog) bool { return lo.FromPtr(c.Metadata.Name) == name })
+		plan.addChange(domain.CatalogKind, name, planProjection(catalog.Metadata.Labels, catalog.Spec), nil)
+	}
+}
+
+func (r *ResourceSync) planCatalogItems(ctx context.Context, orgId uuid.UUID, resources []GenericResourceMap, owner string, plan *resourceSyncPlan) {
+	items, err := r.parseCatalogItems(resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+	if err := r.validateCatalogItemConflicts(ctx, orgId, items, owner); err != nil {
+		plan.addError(err)
+		return
+	}
+
+	for _, item := range items {
+		key := fmt.Sprintf("%s/%s", item.Metadata.Catalog, *item.Metadata.Name)
+		existing, status := r.catalogSvc.GetCatalogItem(ctx, orgId, item.Metadata.Catalog, *item.Metadata.Name)
+		var current interface{}
+		switch status.Code {
+		case http.StatusOK:
+			current = planProjection(existing.Metadata.Labels, existing.Spec)
+		case http.StatusNotFound:
+		default:
+			plan.addError(fmt.Errorf("failed to get catalog item '%s': %s", key, status.Message))
+			continue
+		}
+		plan.addChange(domain.CatalogItemKind, key, current, planProjection(item.Metadata.Labels, item.Spec))
+	}
+
+	listParams := domain.ListAllCatalogItemsParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	owned := make([]domain.CatalogItem, 0)
+	for {
+		listRes, status := r.catalogSvc.ListAllCatalogItems(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list owned catalog items: %s", status.Message))
+			return
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+	for _, key := range catalogItemsDelta(owned, items) {
+		item, _ := lo.Find(owned, func(i domain.CatalogItem) bool {
+			return fmt.Sprintf("%s/%s", i.Metadata.Catalog, lo.FromPtr(i.Metadata.Name)) == key
+		})
+		plan.addChange(domain.CatalogItemKind, key, planProjection(item.Metadata.Labels, item.Spec), nil)
+	}
+}
+
+func (r *ResourceSync) planRepositories(ctx context.Context, orgId uuid.UUID, rs *domain.ResourceSync, resources []GenericResourceMap, owner string, plan *resourceSyncPlan) {
+	repositories, err := r.parseRepositories(resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+	if err := r.validateRepositoryNameConflicts(ctx, orgId, repositories, owner); err != nil {
+		plan.addError(err)
+		return
+	}
+
+	addChange := func(name string, current, desired *domain.Repository) {
+		plan.addRedactedChange(domain.RepositoryKind, name,
+			repositoryProjection(current, false), repositoryProjection(desired, false),
+			repositoryProjection(current, true), repositoryProjection(desired, true))
+	}
+	for _, repository := range repositories {
+		name := *repository.Metadata.Name
+		existing, status := r.repositorySvc.GetRepository(ctx, orgId, name)
+		switch status.Code {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			existing = nil
+		default:
+			plan.addError(fmt.Errorf("failed to get repository '%s': %s", name, status.Message))
+			continue
+		}
+		addChange(name, existing, repository)
+	}
+
+	listParams := domain.ListRepositoriesParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	owned := make([]domain.Repository, 0)
+	for {
+		listRes, status := r.repositorySvc.ListRepositories(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list owned repositories: %s", status.Message))
+			return
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+	desired := lo.Map(repositories, func(repo *domain.Repository, _ int) string { return *repo.Metadata.Name })
+	for i := range owned {
+		name := lo.FromPtr(owned[i].Metadata.Name)
+		if name == rs.Spec.Repository || lo.Contains(desired, name) {
+			continue
+		}
+		addChange(name, &owned[i], nil)
+	}
+}
+
+func (r *ResourceSync) planAuthProviders(ctx context.Context, orgId uuid.UUID, resources []GenericResourceMap, owner string, plan *resourceSyncPlan) {
+	authProviders, err := r.parseAuthProviders(ctx, resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+	if err := r.validateAuthProviderNameConflicts(ctx, orgId, authProviders, owner); err != nil {
+		plan.addError(err)
+		return
+	}
+
+	addChange := func(name string, current, desired *domain.AuthProvider) {
+		plan.addRedactedChange(domain.AuthProviderKind, name,
+			authProviderProjection(current, false), authProviderProjection(desired, false),
+			authProviderProjection(current, true), authProviderProjection(desired, true))
+	}
+	for _, authProvider := range authProviders {
+		name := *authProvider.Metadata.Name
+		existing, status := r.authProviderSvc.GetAuthProvider(ctx, orgId, name)
+		switch status.Code {
+		case http.StatusOK:
+		case http.StatusNotFound:
+			existing = nil
+		default:
+			plan.addError(fmt.Errorf("failed to get auth provider '%s': %s", name, status.Message))
+			continue
+		}
+		addChange(name, existing, authProvider)
+	}
+
+	listParams := domain.ListAuthProvidersParams{
+		Limit:         lo.ToPtr(int32(100)),
+		FieldSelector: lo.ToPtr(fmt.Sprintf("metadata.owner=%s", owner)),
+	}
+	owned := make([]domain.AuthProvider, 0)
+	for {
+		listRes, status := r.authProviderSvc.ListAuthProviders(ctx, orgId, listParams)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list owned auth providers: %s", status.Message))
+			return
+		}
+		owned = append(owned, listRes.Items...)
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+	desired := lo.Map(authProviders, func(ap *domain.AuthProvider, _ int) string { return *ap.Metadata.Name })
+	for i := range owned {
+		name := lo.FromPtr(owned[i].Metadata.Name)
+		if lo.Contains(desired, name) {
+			continue
+		}
+		addChange(name, &owned[i], nil)
+	}
+}
+
+// planDevices reports the label changes SyncDeviceLabels would make. Devices are never
+// created or deleted, so every change is an update.
+func (r *ResourceSync) planDevices(ctx context.Context, orgId uuid.UUID, resources []GenericResourceMap, resourceName string, plan *resourceSyncPlan) {
+	devices, err := r.parseDevices(resources)
+	if err != nil {
+		plan.addError(err)
+		return
+	}
+
+	var conflicts []string
+	for _, device := range devices {
+		name := *device.Metadata.Name
+		existing, status := r.deviceSvc.GetDevice(ctx, orgId, name)
+		if status.Code == http.StatusNotFound {
+			continue
+		}
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to get device '%s': %s", name, status.Message))
+			continue
+		}
+		if currentOwner, ok := lo.FromPtr(existing.Metadata.Annotations)[domain.DeviceAnnotationResourceSyncOwner]; ok && currentOwner != resourceName {
+			conflicts = append(conflicts, name)
+			continue
+		}
+		current := lo.FromPtr(existing.Metadata.Labels)
+		desired := common.DesiredResourceSyncDeviceLabels(current, common.ResourceSyncManagedLabelKeys(existing), lo.FromPtr(device.Metadata.Labels))
+		plan.addChange(domain.DeviceKind, name, planProjection(&current, nil), planProjection(&desired, nil))
+	}
+	if len(conflicts) > 0 {
+		plan.addError(fmt.Errorf("device(s) %v are managed by different ResourceSyncs", conflicts))
+	}
+
+	listParams := domain.ListDevicesParams{
+		Limit: lo.ToPtr(int32(100)),
+	}
+	annotationSelector := selector.NewAnnotationSelectorFromMapOrDie(map[string]string{
+		domain.DeviceAnnotationResourceSyncOwner: resourceName,
+	})
+	desiredNames := lo.Map(devices, func(device *domain.Device, _ int) string { return *device.Metadata.Name })
+	for {
+		listRes, status := r.deviceSvc.ListDevices(ctx, orgId, listParams, annotationSelector)
+		if status.Code != http.StatusOK {
+			plan.addError(fmt.Errorf("failed to list managed devices: %s", status.Message))
+			return
+		}
+		for i := range listRes.Items {
+			device := &listRes.Items[i]
+			if lo.Contains(desiredNames, lo.FromPtr(device.Metadata.Name)) {
+				continue
+			}
+			current := lo.FromPtr(device.Metadata.Labels)
+			desired := common.DesiredResourceSyncDeviceLabels(current, common.ResourceSyncManagedLabelKeys(device), nil)
+			plan.addChange(domain.DeviceKind, lo.FromPtr(device.Metadata.Name), planProjection(&current, nil), planProjection(&desired, nil))
+		}
+		if listRes.Metadata.Continue == nil {
+			break
+		}
+		listParams.Continue = listRes.Metadata.Continue
+	}
+}
+
+// planProjection returns the generic JSON form of the fields a ResourceSync manages:
+// metadata.labels and, if set, spec.
+func planProjection(labels *map[string]string, spec interface{}) interface{} {
+	projection := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": labels},
+	}
+	if spec != nil {
+		projection["spec"] = spec
+	}
+	buf, err := json.Marshal(projection)
+	if err != nil {
+		return nil
+	}
+	var generic interface{}
+	if err := json.Unmarshal(buf, &generic); err != nil {
+		return nil
+	}
+	return generic
+}
+
+func repositoryProjection(repository *domain.Repository, redact bool) interface{} {
+	if repository == nil {
+		return nil
+	}
+	repo := *repository
+	if redact {
+		if err := repo.HideSensitiveData(); err != nil {
+			return nil
+		}
+	}
+	return planProjection(repo.Metadata.Labels, repo.Spec)
+}
+
+func authProviderProjection(authProvider *domain.AuthProvider, redact bool) interface{} {
+	if authProvider == nil {
+		return nil
+	}
+	ap := *authProvider
+	if redact {
+		if err := ap.HideSensitiveData(); err != nil {
+			return nil
+		}
+	}
+	return planProjection(ap.Metadata.Labels, ap.Spec)
+}
+
+// diffValues returns the leaf differences between two generic JSON values, keyed by JSON
+// pointer. Objects are compared key by key; arrays and scalars are compared as a whole.
+// A nil value is treated as absent.
+func diffValues(path string, current, desired interface{}) []domain.ResourceSyncFieldDiff {
+	currentMap, currentIsMap := current.(map[string]interface{})
+	desiredMap, desiredIsMap := desired.(map[string]interface{})
+	if (currentIsMap || current == nil) && (desiredIsMap || desired == nil) && (currentIsMap || desiredIsMap) {
+		keys := lo.Uniq(append(lo.Keys(currentMap), lo.Keys(desiredMap)...))
+		sort.Strings(keys)
+		diffs := []domain.ResourceSyncFieldDiff{}
+		for _, key := range keys {
+			diffs = append(diffs, diffValues(path+"/"+escapePointerToken(key), currentMap[key], desiredMap[key])...)
+		}
+		return diffs
+	}
+	if reflect.DeepEqual(current, desired) {
+		return nil
+	}
+	return []domain.ResourceSyncFieldDiff{{Path: path, Current: current, Desired: desired}}
+}
+
+// valueAtPointer returns the value at the given JSON pointer within a generic JSON value.
+func valueAtPointer(value interface{}, pointer string) (interface{}, bool) {
+	if pointer == "" {
+		return value, value != nil
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		m, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, ok = m[unescapePointerToken(token)]
+		if !ok {
+			return nil, false
+		}
+	}
+	return value, value != nil
+}
+
+func escapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func unescapePointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+}
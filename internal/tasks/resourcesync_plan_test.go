@@ -0,0 +1,185 @@
+package tasks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/flterrors"
+	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/go-git/go-billy/v5"
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+const planFleetYaml = `apiVersion: flightctl.io/v1beta1
+kind: Fleet
+metadata:
+  name: web
+  labels:
+    env: prod
+spec:
+  template:
+    spec:
+      os:
+        image: quay.io/test/os:v2
+`
+
+func newTestFleet(t *testing.T, name, image string, owner *string) *domain.Fleet {
+	t.Helper()
+	var fleet domain.Fleet
+	require.NoError(t, json.Unmarshal([]byte(`{"apiVersion":"v1beta1","kind":"Fleet","metadata":{"labels":{"env":"prod"}},
+		"spec":{"template":{"spec":{"os":{"image":"`+image+`"}}}}}`), &fleet))
+	fleet.Metadata.Name = lo.ToPtr(name)
+	fleet.Metadata.Owner = owner
+	return &fleet
+}
+
+func TestDiffValues(t *testing.T) {
+	current := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"env": "dev", "a/b": "x"}},
+		"spec":     map[string]interface{}{"list": []interface{}{"a"}, "same": "v"},
+	}
+	desired := map[string]interface{}{
+		"metadata": map[string]interface{}{"labels": map[string]interface{}{"env": "prod"}},
+		"spec":     map[string]interface{}{"list": []interface{}{"a", "b"}, "same": "v", "added": true},
+	}
+
+	diffs := diffValues("", current, desired)
+	assert.Equal(t, []domain.ResourceSyncFieldDiff{
+		{Path: "/metadata/labels/a~1b", Current: "x"},
+		{Path: "/metadata/labels/env", Current: "dev", Desired: "prod"},
+		{Path: "/spec/added", Desired: true},
+		{Path: "/spec/list", Current: []interface{}{"a"}, Desired: []interface{}{"a", "b"}},
+	}, diffs)
+	assert.Empty(t, diffValues("", current, current))
+
+	value, ok := valueAtPointer(current, "/metadata/labels/a~1b")
+	assert.True(t, ok)
+	assert.Equal(t, "x", value)
+}
+
+func TestPlanRedactsSecrets(t *testing.T) {
+	newRepo := func(password string) *domain.Repository {
+		repo := newTestGitRepository(t, "private", nil)
+		require.NoError(t, repo.Spec.FromGitRepoSpec(domain.GitRepoSpec{
+			Url:        "https://example.com/private.git",
+			Type:       domain.GitRepoSpecTypeGit,
+			HttpConfig: &domain.HttpConfig{Username: lo.ToPtr("user"), Password: lo.ToPtr(password)},
+		}))
+		return repo
+	}
+	current, desired := newRepo("old-secret"), newRepo("new-secret")
+
+	plan := &resourceSyncPlan{}
+	plan.addRedactedChange(domain.RepositoryKind, "private",
+		repositoryProjection(current, false), repositoryProjection(desired, false),
+		repositoryProjection(current, true), repositoryProjection(desired, true))
+
+	require.Len(t, plan.changes, 1)
+	change := plan.changes[0]
+	assert.Equal(t, domain.ResourceSyncPlannedActionUpdate, change.Action)
+	require.Len(t, change.Diff, 1)
+	assert.Equal(t, "/spec/httpConfig/password", change.Diff[0].Path)
+	assert.Equal(t, "*****", change.Diff[0].Current)
+	assert.Equal(t, "*****", change.Diff[0].Desired)
+}
+
+func TestPlanFleets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	mockFleetSvc := fleetservice.NewMockService(ctrl)
+	mockCatalogSvc := catalogservice.NewMockService(ctrl)
+	rs := NewResourceSync(mockRepositorySvc, mockFleetSvc, nil, mockCatalogSvc, nil, nil, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	rsObj := newTestRS("site-config")
+	rsObj.Spec.Path = "/fleets"
+	owner := util.SetResourceOwner(domain.ResourceSyncKind, "site-config")
+
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, rsObj.Spec.Repository).
+		Return(newTestGitRepository(t, rsObj.Spec.Repository, nil), okStatus())
+	existing := newTestFleet(t, "web", "quay.io/test/os:v1", owner)
+	mockFleetSvc.EXPECT().GetFleet(gomock.Any(), orgId, "web", gomock.Any()).Return(existing, okStatus()).Times(2)
+	mockFleetSvc.EXPECT().ListFleets(gomock.Any(), orgId, gomock.Any()).Return(&domain.FleetList{
+		Items: []domain.Fleet{*existing, *newTestFleet(t, "old", "quay.io/test/os:v1", owner)},
+	}, okStatus())
+
+	var clonedRevision string
+	cloneRepo := func(_ context.Context, _ *domain.Repository, revision *string, _ *int, _ *config.Config) (billy.Filesystem, string, error) {
+		clonedRevision = *revision
+		mfs := memfs.New()
+		f, err := mfs.Create("/fleets/web.yaml")
+		require.NoError(t, err)
+		_, err = f.Write([]byte(planFleetYaml))
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+		return mfs, "abc123", nil
+	}
+
+	plan, err := rs.plan(context.Background(), orgId, rsObj, lo.ToPtr("feature"), cloneRepo)
+	require.NoError(t, err)
+	assert.Equal(t, "feature", clonedRevision)
+	assert.Equal(t, "feature", plan.TargetRevision)
+	assert.Equal(t, "abc123", lo.FromPtr(plan.Commit))
+	assert.Empty(t, plan.Errors)
+
+	require.Len(t, plan.Changes, 2)
+	assert.Equal(t, domain.ResourceSyncPlannedActionUpdate, plan.Changes[0].Action)
+	assert.Equal(t, "web", plan.Changes[0].Name)
+	assert.Equal(t, []domain.ResourceSyncFieldDiff{{
+		Path:    "/spec/template/spec/os/image",
+		Current: "quay.io/test/os:v1",
+		Desired: "quay.io/test/os:v2",
+	}}, plan.Changes[0].Diff)
+	assert.Equal(t, domain.ResourceSyncPlannedActionDelete, plan.Changes[1].Action)
+	assert.Equal(t, "old", plan.Changes[1].Name)
+
+	// Unexpected kinds are reported instead of aborting the plan.
+	rsObj.Spec.Type = lo.ToPtr(domain.ResourceSyncTypeCatalog)
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, rsObj.Spec.Repository).
+		Return(newTestGitRepository(t, rsObj.Spec.Repository, nil), okStatus())
+	mockCatalogSvc.EXPECT().ListCatalogs(gomock.Any(), orgId, gomock.Any()).Return(&domain.CatalogList{}, okStatus())
+	mockCatalogSvc.EXPECT().ListAllCatalogItems(gomock.Any(), orgId, gomock.Any()).Return(&domain.CatalogItemList{}, okStatus())
+	plan, err = rs.plan(context.Background(), orgId, rsObj, nil, cloneRepo)
+	require.NoError(t, err)
+	assert.Equal(t, rsObj.Spec.TargetRevision, clonedRevision)
+	require.Len(t, plan.Errors, 1)
+	assert.Contains(t, plan.Errors[0], "unexpected kind(s): [Fleet]")
+}
+
+func TestPlanErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	rs := NewResourceSync(mockRepositorySvc, nil, nil, nil, nil, nil, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	rsObj := newTestRS("site-config")
+	cloneRepo := func(context.Context, *domain.Repository, *string, *int, *config.Config) (billy.Filesystem, string, error) {
+		return nil, "", errors.New("connection refused")
+	}
+
+	// A missing repository is a problem of the ResourceSync
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, rsObj.Spec.Repository).
+		Return(nil, domain.StatusResourceNotFound(domain.RepositoryKind, rsObj.Spec.Repository))
+	_, err := rs.plan(context.Background(), orgId, rsObj, nil, cloneRepo)
+	require.ErrorIs(t, err, flterrors.ErrInvalidResourceSync)
+
+	// A repository that cannot be cloned is not
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, rsObj.Spec.Repository).
+		Return(newTestGitRepository(t, rsObj.Spec.Repository, nil), okStatus())
+	_, err = rs.plan(context.Background(), orgId, rsObj, nil, cloneRepo)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, flterrors.ErrInvalidResourceSync)
+}
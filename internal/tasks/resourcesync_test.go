@@ -6,17 +6,20 @@ import (
 	"testing"
 
 	"github.com/flightctl/flightctl/internal/domain"
+	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
+	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
 	"github.com/google/uuid"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
 )
 
 func TestResourceSync_GetRepositoryAndValidateAccess_NilResourceSync(t *testing.T) {
 	// Create a minimal ResourceSync instance with nil dependencies
 	log := logrus.New()
 
-	resourceSync := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	resourceSync := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	// Test with nil ResourceSync
 	testOrgId := uuid.New()
@@ -31,7 +34,7 @@ func TestResourceSync_ParseFleetsFromResources_ValidResources(t *testing.T) {
 	// Create a minimal ResourceSync instance with nil dependencies
 	log := logrus.New()
 
-	resourceSync := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	resourceSync := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	// Create valid resources
 	resources := []GenericResourceMap{
@@ -70,7 +73,7 @@ func TestResourceSync_ParseFleetsFromResources_SkipsNonFleetKinds(t *testing.T)
 	// Create a minimal ResourceSync instance with nil dependencies
 	log := logrus.New()
 
-	resourceSync := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	resourceSync := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	// Create resources with non-Fleet kinds -- these should be silently skipped
 	resources := []GenericResourceMap{
@@ -341,7 +344,7 @@ func TestResourceSync_WithIgnoredFields(t *testing.T) {
 	log := logrus.New()
 
 	ignorePaths := []string{"metadata/labels/environment", "status"}
-	resourceSync := NewResourceSync(nil, nil, nil, nil, log, nil, ignorePaths)
+	resourceSync := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, ignorePaths)
 
 	// Create resources with fields that should be ignored
 	resources := []GenericResourceMap{
@@ -386,6 +389,30 @@ func TestResourceSync_WithIgnoredFields(t *testing.T) {
 	// but the field removal happens in extractResourcesFromFile which is called by parseAndValidateResources
 }
 
+func TestResourceSync_SyncForRepositories(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	mockRepositorySvc := repositoryservice.NewMockService(ctrl)
+	mockResourceSyncSvc := resourcesyncservice.NewMockService(ctrl)
+	resourceSync := NewResourceSync(mockRepositorySvc, nil, mockResourceSyncSvc, nil, nil, nil, logrus.New(), nil, nil)
+
+	orgId := uuid.New()
+	pushed := newTestRS("pushed")
+	pushed.Spec.Repository = "pushed-repo"
+	otherBranch := newTestRS("other-branch")
+	otherBranch.Spec.Repository = "pushed-repo"
+	otherBranch.Spec.TargetRevision = "release"
+	otherRepo := newTestRS("other-repo")
+	mockResourceSyncSvc.EXPECT().ListResourceSyncs(gomock.Any(), orgId, gomock.Any()).Return(&domain.ResourceSyncList{
+		Items: []domain.ResourceSync{*pushed, *otherBranch, *otherRepo},
+	}, okStatus())
+
+	// Only the ResourceSync reading the pushed branch of the pushed repository runs.
+	mockRepositorySvc.EXPECT().GetRepository(gomock.Any(), orgId, "pushed-repo").Return(nil, notFoundStatus())
+	mockResourceSyncSvc.EXPECT().ReplaceResourceSyncStatus(gomock.Any(), orgId, "pushed", gomock.Any()).Return(pushed, okStatus())
+
+	resourceSync.SyncForRepositories(context.Background(), orgId, []string{"pushed-repo"}, "main")
+}
+
 func TestFilterByKind(t *testing.T) {
 	resources := []GenericResourceMap{
 		{"kind": domain.FleetKind, "metadata": map[string]interface{}{"name": "f1"}},
@@ -410,7 +437,7 @@ func TestFilterByKind(t *testing.T) {
 
 func TestParseCatalogs_Valid(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -431,7 +458,7 @@ func TestParseCatalogs_Valid(t *testing.T) {
 
 func TestParseCatalogs_DuplicateNames(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -455,7 +482,7 @@ func TestParseCatalogs_DuplicateNames(t *testing.T) {
 
 func TestParseCatalogs_SkipsNonCatalogKinds(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -471,7 +498,7 @@ func TestParseCatalogs_SkipsNonCatalogKinds(t *testing.T) {
 
 func TestParseCatalogItems_Valid(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -502,7 +529,7 @@ func TestParseCatalogItems_Valid(t *testing.T) {
 
 func TestParseCatalogItems_MissingCatalog(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -523,7 +550,7 @@ func TestParseCatalogItems_MissingCatalog(t *testing.T) {
 
 func TestParseCatalogItems_DuplicateKey(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
@@ -547,7 +574,7 @@ func TestParseCatalogItems_DuplicateKey(t *testing.T) {
 
 func TestParseCatalogItems_SameNameDifferentCatalog(t *testing.T) {
 	log := logrus.New()
-	rs := NewResourceSync(nil, nil, nil, nil, log, nil, nil)
+	rs := NewResourceSync(nil, nil, nil, nil, nil, nil, log, nil, nil)
 
 	resources := []GenericResourceMap{
 		{
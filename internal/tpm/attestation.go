@@ -0,0 +1,110 @@
+package tpm
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"fmt"
+	"sort"
+
+	"github.com/google/go-tpm/tpm2"
+)
+
+// MaxPCRIndex is the highest index of the PCRs that can be quoted
+const MaxPCRIndex = 23
+
+// pcrSelection creates a selection of the given PCRs of the SHA-256 bank
+func pcrSelection(pcrs []int) (*tpm2.TPMLPCRSelection, error) {
+	var selection [3]byte
+	for _, pcr := range pcrs {
+		if pcr < 0 || pcr > MaxPCRIndex {
+			return nil, fmt.Errorf("invalid PCR index %d", pcr)
+		}
+		selection[pcr/8] |= 1 << (pcr % 8)
+	}
+	return createPCRSelection(selection), nil
+}
+
+// selectedPCRs returns the indexes of the PCRs of the SHA-256 bank in the selection, in ascending order
+func selectedPCRs(selection tpm2.TPMLPCRSelection) ([]int, error) {
+	var pcrs []int
+	for _, sel := range selection.PCRSelections {
+		if sel.Hash != tpm2.TPMAlgSHA256 {
+			return nil, fmt.Errorf("unsupported PCR bank: %v", sel.Hash)
+		}
+		for byteIdx, b := range sel.PCRSelect {
+			for bitIdx := 0; bitIdx < 8; bitIdx++ {
+				if b&(1<<bitIdx) != 0 {
+					pcrs = append(pcrs, byteIdx*8+bitIdx)
+				}
+			}
+		}
+	}
+	sort.Ints(pcrs)
+	return pcrs, nil
+}
+
+// pcrDigest computes the digest of the PCR values that TPM2_Quote signs: the SHA-256 hash of
+// the values concatenated in ascending order of their index.
+func pcrDigest(pcrValues map[int][]byte) []byte {
+	pcrs := make([]int, 0, len(pcrValues))
+	for pcr := range pcrValues {
+		pcrs = append(pcrs, pcr)
+	}
+	sort.Ints(pcrs)
+
+	h := sha256.New()
+	for _, pcr := range pcrs {
+		h.Write(pcrValues[pcr])
+	}
+	return h.Sum(nil)
+}
+
+// VerifyQuote verifies that quote is a TPM2_Quote of the SHA-256 PCR values pcrValues, qualified
+// with nonce and signed by the attestation key attestPub (a TPM2B_PUBLIC blob).
+func VerifyQuote(attestPub, nonce, quote, signature []byte, pcrValues map[int][]byte) error {
+	akCryptoKey, err := publicKeyFromBlob(attestPub)
+	if err != nil {
+		return fmt.Errorf("decoding attestation key: %w", err)
+	}
+
+	if err = verifyTPM2CertifySignature(quote, signature, akCryptoKey); err != nil {
+		return fmt.Errorf("verifying quote signature: %w", err)
+	}
+
+	quoted, err := tpm2.Unmarshal[tpm2.TPM2BAttest](quote)
+	if err != nil {
+		return fmt.Errorf("unmarshalling TPM2B_ATTEST: %w", err)
+	}
+	attest, err := quoted.Contents()
+	if err != nil {
+		return fmt.Errorf("extracting TPMS_ATTEST: %w", err)
+	}
+	if attest.Type != tpm2.TPMSTAttestQuote {
+		return fmt.Errorf("attestation is not a quote")
+	}
+	if subtle.ConstantTimeCompare(attest.ExtraData.Buffer, nonce) != 1 {
+		return fmt.Errorf("quote does not include the expected nonce")
+	}
+
+	info, err := attest.Attested.Quote()
+	if err != nil {
+		return fmt.Errorf("extracting quote info: %w", err)
+	}
+	quotedPCRs, err := selectedPCRs(info.PCRSelect)
+	if err != nil {
+		return err
+	}
+	if len(quotedPCRs) != len(pcrValues) {
+		return fmt.Errorf("quote covers %d PCRs, got values of %d", len(quotedPCRs), len(pcrValues))
+	}
+	for _, pcr := range quotedPCRs {
+		if _, ok := pcrValues[pcr]; !ok {
+			return fmt.Errorf("missing value of quoted PCR %d", pcr)
+		}
+	}
+	if subtle.ConstantTimeCompare(info.PCRDigest.Buffer, pcrDigest(pcrValues)) != 1 {
+		return fmt.Errorf("PCR values do not match the quoted digest")
+	}
+
+	return nil
+}
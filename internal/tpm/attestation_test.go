@@ -0,0 +1,106 @@
+package tpm
+
+import (
+	"crypto/sha256"
+	"io"
+	"testing"
+
+	agent_config "github.com/flightctl/flightctl/internal/agent/config"
+	"github.com/flightctl/flightctl/internal/agent/device/fileio"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/google/go-tpm-tools/simulator"
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPCRSelection(t *testing.T) {
+	require := require.New(t)
+
+	selection, err := pcrSelection([]int{0, 7, 8, 23})
+	require.NoError(err)
+	pcrs, err := selectedPCRs(*selection)
+	require.NoError(err)
+	require.Equal([]int{0, 7, 8, 23}, pcrs)
+
+	_, err = pcrSelection([]int{24})
+	require.Error(err)
+	_, err = pcrSelection([]int{-1})
+	require.Error(err)
+}
+
+func TestQuote_SimulatorIntegration(t *testing.T) {
+	require := require.New(t)
+
+	sim, err := simulator.Get()
+	require.NoError(err)
+	defer sim.Close()
+
+	err = setupFakeRSAEKCertificate(sim)
+	require.NoError(err)
+
+	tmpDir := t.TempDir()
+	rw := fileio.NewReadWriter(
+		fileio.NewReader(fileio.WithReaderRootDir(tmpDir)),
+		fileio.NewWriter(fileio.WithWriterRootDir(tmpDir)),
+	)
+	connFactory := func() (io.ReadWriteCloser, error) {
+		return sim, nil
+	}
+	c, err := newClientWithConnection(connFactory, log.NewPrefixLogger("test"), rw, &agent_config.Config{
+		TPM: agent_config.TPM{
+			Enabled:         true,
+			DevicePath:      agent_config.DefaultTPMDevicePath,
+			StorageFilePath: agent_config.DefaultTPMKeyFile,
+		},
+	}, "test-model", "test-serial")
+	require.NoError(err)
+
+	// extend a PCR so that quoted values are not all zero
+	_, err = tpm2.PCRExtend{
+		PCRHandle: tpm2.AuthHandle{Handle: tpm2.TPMHandle(4), Auth: tpm2.PasswordAuth(nil)},
+		Digests: tpm2.TPMLDigestValues{
+			Digests: []tpm2.TPMTHA{{HashAlg: tpm2.TPMAlgSHA256, Digest: make([]byte, sha256.Size)}},
+		},
+	}.Execute(transport.FromReadWriter(sim))
+	require.NoError(err)
+
+	lakPub, err := c.session.GetPublicKey(LAK)
+	require.NoError(err)
+	lakPubBlob := tpm2.Marshal(*lakPub)
+
+	// more PCRs than a single TPM2_PCR_Read returns
+	pcrs := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+	nonce := []byte("fresh-nonce-0123")
+
+	quote, err := c.Quote(pcrs, nonce)
+	require.NoError(err)
+	require.Len(quote.PCRValues, len(pcrs))
+	require.NotEqual(make([]byte, sha256.Size), quote.PCRValues[4])
+
+	// a valid quote verifies
+	require.NoError(VerifyQuote(lakPubBlob, nonce, quote.Quote, quote.Signature, quote.PCRValues))
+
+	// a quote of another nonce is rejected
+	require.ErrorContains(VerifyQuote(lakPubBlob, []byte("stale-nonce-0123"), quote.Quote, quote.Signature, quote.PCRValues), "nonce")
+
+	// altered PCR values are rejected
+	altered := make(map[int][]byte, len(quote.PCRValues))
+	for pcr, value := range quote.PCRValues {
+		altered[pcr] = value
+	}
+	altered[0] = make([]byte, sha256.Size)
+	altered[0][0] = 1
+	require.ErrorContains(VerifyQuote(lakPubBlob, nonce, quote.Quote, quote.Signature, altered), "digest")
+
+	// missing PCR values are rejected
+	delete(altered, 0)
+	require.Error(VerifyQuote(lakPubBlob, nonce, quote.Quote, quote.Signature, altered))
+
+	// a quote signed by another key is rejected
+	ldevidPub, err := c.session.GetPublicKey(LDevID)
+	require.NoError(err)
+	require.ErrorContains(VerifyQuote(tpm2.Marshal(*ldevidPub), nonce, quote.Quote, quote.Signature, quote.PCRValues), "signature")
+
+	require.NoError(safeCloseSession(c.session))
+}
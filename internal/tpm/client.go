@@ -353,6 +353,11 @@ func (c *client) Close() error {
 	return nil
 }
 
+// Quote returns a quote of the specified SHA-256 PCRs qualified with the nonce and signed by the LAK
+func (c *client) Quote(pcrs []int, nonce []byte) (*PCRQuote, error) {
+	return c.session.Quote(pcrs, nonce)
+}
+
 // SolveChallenge uses TPM2_ActivateCredential to decrypt an encrypted secret
 // and prove ownership of the credentials. This is used by clients to solve challenges.
 func (c *client) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
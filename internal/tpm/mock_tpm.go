@@ -129,6 +129,21 @@ func (mr *MockClientMockRecorder) Public() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Public", reflect.TypeOf((*MockClient)(nil).Public))
 }
 
+// Quote mocks base method.
+func (m *MockClient) Quote(pcrs []int, nonce []byte) (*PCRQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", pcrs, nonce)
+	ret0, _ := ret[0].(*PCRQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockClientMockRecorder) Quote(pcrs, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockClient)(nil).Quote), pcrs, nonce)
+}
+
 // SolveChallenge mocks base method.
 func (m *MockClient) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
@@ -767,6 +782,21 @@ func (mr *MockSessionMockRecorder) LoadKey(keyType any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoadKey", reflect.TypeOf((*MockSession)(nil).LoadKey), keyType)
 }
 
+// Quote mocks base method.
+func (m *MockSession) Quote(pcrs []int, nonce []byte) (*PCRQuote, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Quote", pcrs, nonce)
+	ret0, _ := ret[0].(*PCRQuote)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Quote indicates an expected call of Quote.
+func (mr *MockSessionMockRecorder) Quote(pcrs, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Quote", reflect.TypeOf((*MockSession)(nil).Quote), pcrs, nonce)
+}
+
 // RemoveApplicationKey mocks base method.
 func (m *MockSession) RemoveApplicationKey(appName string) error {
 	m.ctrl.T.Helper()
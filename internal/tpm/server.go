@@ -479,21 +479,9 @@ func verifySigningKeyChain(payload *ParsedTCGPayload) error {
 		return fmt.Errorf("missing signing certify signature")
 	}
 
-	// decode the TPMT_PUBLIC blob from AttestPub (LAK)
-	akPub, err := tpm2.Unmarshal[tpm2.TPM2BPublic](payload.AttestPub)
+	akCryptoKey, err := publicKeyFromBlob(payload.AttestPub)
 	if err != nil {
-		return fmt.Errorf("decoding AK public blob: %w", err)
-	}
-
-	// extract crypto.PublicKey from TPMT_PUBLIC
-	akPubStruct, err := akPub.Contents()
-	if err != nil {
-		return fmt.Errorf("extracting crypto.PublicKey from AK TPMT_PUBLIC: %w", err)
-	}
-
-	akCryptoKey, err := tpm2.Pub(*akPubStruct)
-	if err != nil {
-		return fmt.Errorf("converting AK TPMTPublic to Go key: %w", err)
+		return err
 	}
 
 	return verifyCertifiedKey(
@@ -504,6 +492,27 @@ func verifySigningKeyChain(payload *ParsedTCGPayload) error {
 	)
 }
 
+// publicKeyFromBlob decodes the public key of a TPM2B_PUBLIC blob, such as the LAK of a TCG-CSR
+func publicKeyFromBlob(blob []byte) (crypto.PublicKey, error) {
+	// decode the TPMT_PUBLIC blob
+	pub, err := tpm2.Unmarshal[tpm2.TPM2BPublic](blob)
+	if err != nil {
+		return nil, fmt.Errorf("decoding AK public blob: %w", err)
+	}
+
+	// extract crypto.PublicKey from TPMT_PUBLIC
+	pubStruct, err := pub.Contents()
+	if err != nil {
+		return nil, fmt.Errorf("extracting crypto.PublicKey from AK TPMT_PUBLIC: %w", err)
+	}
+
+	key, err := tpm2.Pub(*pubStruct)
+	if err != nil {
+		return nil, fmt.Errorf("converting AK TPMTPublic to Go key: %w", err)
+	}
+	return key, nil
+}
+
 func verifyCertifiedKey(certifyInfo, signature, pubBlob []byte, signerKey crypto.PublicKey) error {
 	// parse TPM2B_PUBLIC blob and compute its TPM Name
 	pub, err := tpm2.Unmarshal[tpm2.TPM2BPublic](pubBlob)
@@ -1,6 +1,7 @@
 package tpm
 
 import (
+	"bytes"
 	"encoding/asn1"
 	"errors"
 	"fmt"
@@ -31,6 +32,9 @@ const (
 	ekRSANVIndex            = gotpmclient.EKCertNVIndexRSA
 	ekECCNVIndex            = gotpmclient.EKCertNVIndexECC
 
+	// maxQuoteAttempts bounds how often a quote is retried when PCRs change while quoting them.
+	maxQuoteAttempts = 3
+
 	// TCG EK Credential Profile v2.6, Section 2.2.1.4 — NV indices for manufacturer EK templates.
 	ekRSATemplateNVIndex uint32 = 0x01C00004
 	ekECCTemplateNVIndex uint32 = 0x01C0000C
@@ -1128,6 +1132,102 @@ func (s *tpmSession) SolveChallenge(credentialBlob, encryptedSecret []byte) ([]b
 	return activateResp.CertInfo.Buffer, nil
 }
 
+// Quote quotes the specified SHA-256 PCRs with the LAK, qualified with the nonce.
+// PCRs can be extended between reading and quoting them, so the quote is retried until
+// the values read match the quoted digest.
+func (s *tpmSession) Quote(pcrs []int, nonce []byte) (*PCRQuote, error) {
+	selection, err := pcrSelection(pcrs)
+	if err != nil {
+		return nil, err
+	}
+
+	lakHandle, err := s.LoadKey(LAK)
+	if err != nil {
+		return nil, fmt.Errorf("loading LAK for quote: %w", err)
+	}
+
+	for attempt := 0; attempt < maxQuoteAttempts; attempt++ {
+		values, err := s.readPCRs(pcrs)
+		if err != nil {
+			return nil, err
+		}
+
+		cmd := tpm2.Quote{
+			SignHandle: tpm2.AuthHandle{
+				Handle: lakHandle.Handle,
+				Name:   lakHandle.Name,
+				Auth:   tpm2.PasswordAuth(nil),
+			},
+			QualifyingData: tpm2.TPM2BData{Buffer: nonce},
+			// use the signing scheme of the LAK
+			InScheme:  tpm2.TPMTSigScheme{Scheme: tpm2.TPMAlgNull},
+			PCRSelect: *selection,
+		}
+		resp, err := cmd.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return nil, fmt.Errorf("TPM2_Quote failed: %w", err)
+		}
+
+		attest, err := resp.Quoted.Contents()
+		if err != nil {
+			return nil, fmt.Errorf("extracting TPMS_ATTEST: %w", err)
+		}
+		info, err := attest.Attested.Quote()
+		if err != nil {
+			return nil, fmt.Errorf("extracting quote info: %w", err)
+		}
+		if !bytes.Equal(info.PCRDigest.Buffer, pcrDigest(values)) {
+			s.log.Debugf("PCRs changed while quoting them, retrying")
+			continue
+		}
+
+		return &PCRQuote{
+			Quote:     tpm2.Marshal(resp.Quoted),
+			Signature: tpm2.Marshal(resp.Signature),
+			PCRValues: values,
+		}, nil
+	}
+
+	return nil, fmt.Errorf("PCRs changed while quoting them %d times", maxQuoteAttempts)
+}
+
+// readPCRs reads the values of the specified SHA-256 PCRs. The TPM returns a limited
+// number of values per read, so PCRs are read until all values are known.
+func (s *tpmSession) readPCRs(pcrs []int) (map[int][]byte, error) {
+	values := make(map[int][]byte, len(pcrs))
+	remaining := pcrs
+	for len(remaining) > 0 {
+		selection, err := pcrSelection(remaining)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := tpm2.PCRRead{PCRSelectionIn: *selection}.Execute(transport.FromReadWriter(s.conn))
+		if err != nil {
+			return nil, fmt.Errorf("TPM2_PCR_Read failed: %w", err)
+		}
+
+		read, err := selectedPCRs(resp.PCRSelectionOut)
+		if err != nil {
+			return nil, err
+		}
+		if len(read) == 0 || len(read) != len(resp.PCRValues.Digests) {
+			return nil, fmt.Errorf("reading PCRs %v: unexpected response", remaining)
+		}
+		for i, pcr := range read {
+			values[pcr] = resp.PCRValues.Digests[i].Buffer
+		}
+
+		var next []int
+		for _, pcr := range remaining {
+			if _, ok := values[pcr]; !ok {
+				next = append(next, pcr)
+			}
+		}
+		remaining = next
+	}
+	return values, nil
+}
+
 func (s *tpmSession) findAvailablePersistentHandle() (tpm2.TPMHandle, error) {
 	cmd := tpm2.GetCapability{
 		Capability:    tpm2.TPMCapHandles,
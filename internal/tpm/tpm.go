@@ -29,6 +29,18 @@ type Client interface {
 	VendorInfoCollector(ctx context.Context) string
 	// CreateApplicationKey generates a TCG CSR IDEVID bundle and a TSS2 PEM encoded file for the specified application
 	CreateApplicationKey(name string) ([]byte, []byte, error)
+	// Quote returns a quote of the specified SHA-256 PCRs qualified with the nonce and signed by the LAK
+	Quote(pcrs []int, nonce []byte) (*PCRQuote, error)
+}
+
+// PCRQuote is a quote of PCRs of the SHA-256 bank signed by the LAK
+type PCRQuote struct {
+	// Quote is the TPM2B_ATTEST structure returned by TPM2_Quote
+	Quote []byte
+	// Signature is the TPMT_SIGNATURE of the quote
+	Signature []byte
+	// PCRValues are the values of the quoted PCRs by index
+	PCRValues map[int][]byte
 }
 
 const (
@@ -146,6 +158,8 @@ type Session interface {
 	GenerateChallenge(secret []byte) ([]byte, []byte, error)
 	// SolveChallenge decrypts the encryptedSecret to prove ownership of the credentials
 	SolveChallenge(credentialBlob, encryptedSecret []byte) ([]byte, error)
+	// Quote quotes the specified SHA-256 PCRs with the LAK, qualified with the nonce
+	Quote(pcrs []int, nonce []byte) (*PCRQuote, error)
 	// Clear performs a best-effort clear of the TPM, resetting keys and auth
 	Clear() error
 	// Close closes the session and flushes handles
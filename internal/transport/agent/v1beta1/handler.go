@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
@@ -15,9 +16,12 @@ import (
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	"github.com/flightctl/flightctl/internal/service/device"
+	"github.com/flightctl/flightctl/internal/service/deviceattestation"
 	"github.com/flightctl/flightctl/internal/service/enrollmentrequest"
 	"github.com/flightctl/flightctl/internal/transport"
 	"github.com/flightctl/flightctl/internal/util"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/samber/lo"
 	"github.com/sirupsen/logrus"
 )
 
@@ -30,6 +34,7 @@ type AgentTransportHandler struct {
 	device                    device.Service
 	enrollmentrequest         enrollmentrequest.Service
 	certificatesigningrequest certificatesigningrequest.Service
+	deviceattestation         deviceattestation.Service
 	converter                 convertv1beta1.Converter
 	ca                        *crypto.CAClient
 	log                       logrus.FieldLogger
@@ -42,6 +47,7 @@ func NewAgentTransportHandler(
 	deviceSvc device.Service,
 	enrollmentrequestSvc enrollmentrequest.Service,
 	certificatesigningrequestSvc certificatesigningrequest.Service,
+	deviceattestationSvc deviceattestation.Service,
 	converter convertv1beta1.Converter,
 	ca *crypto.CAClient,
 	log logrus.FieldLogger,
@@ -50,6 +56,7 @@ func NewAgentTransportHandler(
 		device:                    deviceSvc,
 		enrollmentrequest:         enrollmentrequestSvc,
 		certificatesigningrequest: certificatesigningrequestSvc,
+		deviceattestation:         deviceattestationSvc,
 		converter:                 converter,
 		ca:                        ca,
 		log:                       log,
@@ -88,6 +95,7 @@ func (s *AgentTransportHandler) GetRenderedDevice(w http.ResponseWriter, r *http
 	domainParams := s.converter.Device().GetRenderedParamsToDomain(params)
 	body, status := s.device.GetRenderedDevice(ctx, transport.OrgIDFromContext(ctx), fingerprint, domainParams)
 	apiResult := s.converter.Device().FromDomain(body)
+	setWorkloadSignerName(apiResult, s.ca.Cfg.DeviceWorkloadSignerName)
 	s.SetResponse(w, apiResult, status)
 }
 
@@ -173,6 +181,81 @@ func (s *AgentTransportHandler) PatchDeviceStatus(w http.ResponseWriter, r *http
 	s.SetResponse(w, apiResult, status)
 }
 
+// (GET /api/v1/devices/{name}/attestation/challenge)
+func (s *AgentTransportHandler) GetDeviceAttestationChallenge(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	// Extract device fingerprint from context (set by middleware)
+	val := ctx.Value(consts.IdentityCtxKey)
+	if val == nil {
+		s.log.Error("agent identity is missing from context")
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+	identity, ok := val.(*middleware.AgentIdentity)
+	if !ok {
+		s.log.Error("invalid agent identity type in context")
+		status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
+		s.SetResponse(w, status, status)
+		return
+	}
+	fingerprint := identity.GetUsername() // This is the device fingerprint for agents
+
+	// Validate that the authenticated device matches the requested device name
+	if fingerprint != name {
+		s.log.Errorf("attempt to access device %q with certificate fingerprint %q has been detected", name, fingerprint)
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+
+	body, status := s.deviceattestation.GetDeviceAttestationChallenge(ctx, transport.OrgIDFromContext(ctx), fingerprint)
+	apiResult := s.converter.Device().AttestationChallengeFromDomain(body)
+	s.SetResponse(w, apiResult, status)
+}
+
+// (POST /api/v1/devices/{name}/attestation)
+func (s *AgentTransportHandler) CreateDeviceAttestation(w http.ResponseWriter, r *http.Request, name string) {
+	ctx := r.Context()
+
+	// Extract device fingerprint from context (set by middleware)
+	val := ctx.Value(consts.IdentityCtxKey)
+	if val == nil {
+		s.log.Error("agent identity is missing from context")
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+	identity, ok := val.(*middleware.AgentIdentity)
+	if !ok {
+		s.log.Error("invalid agent identity type in context")
+		status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
+		s.SetResponse(w, status, status)
+		return
+	}
+	fingerprint := identity.GetUsername() // This is the device fingerprint for agents
+
+	// Validate that the authenticated device matches the requested device name
+	if fingerprint != name {
+		s.log.Errorf("attempt to access device %q with certificate fingerprint %q has been detected", name, fingerprint)
+		status := api.StatusUnauthorized(http.StatusText(http.StatusUnauthorized))
+		s.SetResponse(w, status, status)
+		return
+	}
+
+	var quote api.AttestationQuote
+	if err := json.NewDecoder(r.Body).Decode(&quote); err != nil {
+		s.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainQuote := s.converter.Device().AttestationQuoteToDomain(quote)
+	body, status := s.deviceattestation.CreateDeviceAttestation(ctx, transport.OrgIDFromContext(ctx), fingerprint, domainQuote)
+	apiResult := s.converter.Device().IntegrityStatusFromDomain(body)
+	s.SetResponse(w, apiResult, status)
+}
+
 // (POST /api/v1/enrollmentrequests)
 func (s *AgentTransportHandler) CreateEnrollmentRequest(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
@@ -297,6 +380,16 @@ func (s *AgentTransportHandler) CreateCertificateSigningRequest(w http.ResponseW
 			return
 		}
 	}
+
+	// Workload CSRs are auto-approved only if they request a certificate declared in the
+	// device's spec; any other request is left for manual approval.
+	if csr.Spec.SignerName == s.ca.Cfg.DeviceWorkloadSignerName && !failedTPMVerification && matchesWorkloadCertificate(device.Spec, csr.Spec.Request) {
+		if _, status := s.autoApprove(ctx, csr); status.Code != http.StatusOK {
+			status := api.StatusInternalServerError(http.StatusText(http.StatusInternalServerError))
+			s.SetResponse(w, status, status)
+			return
+		}
+	}
 	apiResult := s.converter.CertificateSigningRequest().FromDomain(csr)
 	s.SetResponse(w, apiResult, status)
 }
@@ -357,3 +450,52 @@ func (s *AgentTransportHandler) autoApprove(ctx context.Context, csr *api.Certif
 
 	return s.certificatesigningrequest.UpdateCertificateSigningRequestApproval(ctx, transport.OrgIDFromContext(ctx), *csr.Metadata.Name, *csr)
 }
+
+// setWorkloadSignerName tells the agent which signer issues the workload certificates of the
+// rendered device, as the signer name is configured on the service.
+func setWorkloadSignerName(device *api.Device, signerName string) {
+	if device == nil || device.Spec == nil || device.Spec.Certificates == nil {
+		return
+	}
+	for i := range *device.Spec.Certificates {
+		(*device.Spec.Certificates)[i].SignerName = lo.ToPtr(signerName)
+	}
+}
+
+// matchesWorkloadCertificate reports whether the CSR requests exactly the subject and subject
+// alternative names of one of the workload certificates in the device spec.
+func matchesWorkloadCertificate(spec *api.DeviceSpec, request []byte) bool {
+	if spec == nil || spec.Certificates == nil {
+		return false
+	}
+	x509CSR, err := fccrypto.ParseCSR(request)
+	if err != nil {
+		return false
+	}
+	ips := make([]string, 0, len(x509CSR.IPAddresses))
+	for _, ip := range x509CSR.IPAddresses {
+		ips = append(ips, ip.String())
+	}
+	for _, cert := range *spec.Certificates {
+		if cert.CommonName != x509CSR.Subject.CommonName {
+			continue
+		}
+		if !sameElements(lo.FromPtr(cert.DnsNames), x509CSR.DNSNames) {
+			continue
+		}
+		specIPs := make([]string, 0, len(lo.FromPtr(cert.IpAddresses)))
+		for _, ip := range lo.FromPtr(cert.IpAddresses) {
+			if parsed := net.ParseIP(ip); parsed != nil {
+				specIPs = append(specIPs, parsed.String())
+			}
+		}
+		if sameElements(specIPs, ips) {
+			return true
+		}
+	}
+	return false
+}
+
+func sameElements(a, b []string) bool {
+	return len(a) == len(b) && lo.Every(a, b) && lo.Every(b, a)
+}
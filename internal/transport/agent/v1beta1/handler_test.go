@@ -0,0 +1,104 @@
+package agenttransportv1beta1
+
+import (
+	"crypto"
+	"net"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	fccrypto "github.com/flightctl/flightctl/pkg/crypto"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesWorkloadCertificate(t *testing.T) {
+	_, priv, err := fccrypto.NewKeyPair()
+	require.NoError(t, err)
+	makeCSR := func(cn string, opts ...fccrypto.CSROption) []byte {
+		csr, err := fccrypto.MakeCSR(priv.(crypto.Signer), cn, opts...)
+		require.NoError(t, err)
+		return csr
+	}
+
+	spec := &api.DeviceSpec{Certificates: &[]api.WorkloadCertificateSpec{
+		{Name: "plain", CommonName: "plain-app"},
+		{
+			Name:        "mqtt",
+			CommonName:  "mqtt-client",
+			DnsNames:    &[]string{"a.example.com", "b.example.com"},
+			IpAddresses: &[]string{"10.0.0.1", "0:0:0:0:0:0:0:1"},
+		},
+	}}
+
+	testCases := []struct {
+		name    string
+		spec    *api.DeviceSpec
+		request []byte
+		want    bool
+	}{
+		{
+			name:    "common name only",
+			spec:    spec,
+			request: makeCSR("plain-app"),
+			want:    true,
+		},
+		{
+			name:    "names in a different order and IP notation",
+			spec:    spec,
+			request: makeCSR("mqtt-client", fccrypto.WithDNSNames("b.example.com", "a.example.com"), fccrypto.WithIPAddresses(net.ParseIP("::1"), net.ParseIP("10.0.0.1"))),
+			want:    true,
+		},
+		{
+			name:    "no spec",
+			request: makeCSR("plain-app"),
+		},
+		{
+			name:    "no certificates in the spec",
+			spec:    &api.DeviceSpec{},
+			request: makeCSR("plain-app"),
+		},
+		{
+			name:    "unknown common name",
+			spec:    spec,
+			request: makeCSR("other-app"),
+		},
+		{
+			name:    "additional DNS name",
+			spec:    spec,
+			request: makeCSR("plain-app", fccrypto.WithDNSNames("api.example.com")),
+		},
+		{
+			name:    "missing DNS name",
+			spec:    spec,
+			request: makeCSR("mqtt-client", fccrypto.WithDNSNames("a.example.com"), fccrypto.WithIPAddresses(net.ParseIP("10.0.0.1"), net.ParseIP("::1"))),
+		},
+		{
+			name:    "different IP address",
+			spec:    spec,
+			request: makeCSR("mqtt-client", fccrypto.WithDNSNames("a.example.com", "b.example.com"), fccrypto.WithIPAddresses(net.ParseIP("10.0.0.2"), net.ParseIP("::1"))),
+		},
+		{
+			name:    "invalid request",
+			spec:    spec,
+			request: []byte("not a CSR"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.want, matchesWorkloadCertificate(tc.spec, tc.request))
+		})
+	}
+}
+
+func TestSetWorkloadSignerName(t *testing.T) {
+	device := &api.Device{Spec: &api.DeviceSpec{Certificates: &[]api.WorkloadCertificateSpec{{Name: "a"}, {Name: "b"}}}}
+	setWorkloadSignerName(device, "example.com/workload")
+	for _, cert := range *device.Spec.Certificates {
+		require.Equal(t, "example.com/workload", lo.FromPtr(cert.SignerName))
+	}
+
+	// Devices without a spec, e.g. for a 204 response, are left alone.
+	setWorkloadSignerName(nil, "example.com/workload")
+	setWorkloadSignerName(&api.Device{}, "example.com/workload")
+}
@@ -0,0 +1,14 @@
+package transportv1beta1
+
+import (
+	"net/http"
+
+	"github.com/flightctl/flightctl/internal/transport"
+)
+
+// (GET /api/v1/certificaterevocations)
+func (h *TransportHandler) ListCertificateRevocations(w http.ResponseWriter, r *http.Request) {
+	body, status := h.certificaterevocation.ListCertificateRevocations(r.Context(), transport.OrgIDFromContext(r.Context()))
+	apiResult := h.converter.CertificateSigningRequest().RevocationListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -1,6 +1,7 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -8,37 +9,43 @@ import (
 	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/console"
 	"github.com/flightctl/flightctl/internal/transport"
 	"github.com/go-chi/chi/v5"
 	"github.com/gorilla/websocket"
 )
 
-// GetDeviceConsole and GetDeviceApplicationConsole satisfy the server.Transport interface,
-// which is generated from the OpenAPI spec that declares both WS paths.
+// GetDeviceConsole, GetDevicePortForward and GetDeviceApplicationConsole satisfy the server.Transport interface,
+// which is generated from the OpenAPI spec that declares the WS paths.
 // These stubs are unreachable at runtime: WebsocketHandler.RegisterRoutes (called from
 // internal/api_server/server.go) mounts the real handler at /ws/v1/devices/{name}/console
-// before the generated router, so requests are served by WebsocketHandler.HandleDeviceConsole.
+// and /ws/v1/devices/{name}/portforward before the generated router, so requests are served by
+// WebsocketHandler.HandleDeviceConsole and WebsocketHandler.HandleDevicePortForward.
 // Similarly, the application console endpoint is mounted by AppConsoleHandler.RegisterRoutes
 // in internal/remote_access_server/server.go, not by this stub.
 func (h *TransportHandler) GetDeviceConsole(w http.ResponseWriter, r *http.Request, _ string) {
 	http.NotFound(w, r)
 }
 
+func (h *TransportHandler) GetDevicePortForward(w http.ResponseWriter, r *http.Request, _ string, _ api.GetDevicePortForwardParams) {
+	http.NotFound(w, r)
+}
+
 func (h *TransportHandler) GetDeviceApplicationConsole(w http.ResponseWriter, r *http.Request, _, _ string, _ api.GetDeviceApplicationConsoleParams) {
 	http.NotFound(w, r)
 }
 
-func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protocols []string) (string, error) {
+func (h *WebsocketHandler) injectProtocolsToMetadata(metadataStr string, protocols []string) (*api.DeviceConsoleSessionMetadata, string, error) {
 	var metadata api.DeviceConsoleSessionMetadata
 	if err := json.Unmarshal([]byte(metadataStr), &metadata); err != nil {
-		return "", err
+		return nil, "", err
 	}
 	metadata.Protocols = protocols
 	b, err := json.Marshal(&metadata)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
-	return string(b), nil
+	return &metadata, string(b), nil
 }
 
 func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Request) {
@@ -50,13 +57,27 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 	orgId := transport.OrgIDFromContext(r.Context())
 
 	// Extract metadata
-	metadata, err := h.injectProtocolsToMetadata(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata),
+	sessionMetadata, metadata, err := h.injectProtocolsToMetadata(r.URL.Query().Get(api.DeviceQueryConsoleSessionMetadata),
 		websocket.Subprotocols(r))
 	if err != nil {
 		h.log.Errorf("failed injecting protocols to metadata for device %s: %v", deviceName, err)
 		http.Error(w, "protocols injection error", http.StatusInternalServerError)
 		return
 	}
+	// Port-forward sessions are only built by HandleDevicePortForward, which enforces the
+	// allowed ports and the anyport permission.
+	if sessionMetadata.PortForward != nil {
+		http.Error(w, "port forwarding is not allowed on the console endpoint", http.StatusBadRequest)
+		return
+	}
+	var fileCopy *fileCopyAudit
+	if sessionMetadata.FileCopy != nil {
+		if err := validateFileCopy(sessionMetadata.FileCopy); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		fileCopy = &fileCopyAudit{fileCopy: sessionMetadata.FileCopy}
+	}
 	consoleSession, status := h.consoleSessionManager.StartSession(r.Context(), orgId, deviceName, metadata)
 	if status.Code != http.StatusOK {
 		http.Error(w, status.Message, int(status.Code))
@@ -72,6 +93,16 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 	select {
 	case selectedProtocol, ok = <-consoleSession.ProtocolCh:
 		if !ok {
+			select {
+			case agentErr := <-consoleSession.ErrCh:
+				h.log.Infof("device %s rejected console session %s: %s", deviceName, consoleSession.UUID, agentErr)
+				if fileCopy != nil {
+					h.recordFileCopy(r.Context(), orgId, deviceName, fileCopy.fileCopy, 0, agentErr)
+				}
+				http.Error(w, fmt.Sprintf("device %s rejected the session: %s", deviceName, agentErr), http.StatusBadGateway)
+				return
+			default:
+			}
 			h.log.Errorf("failed selecting protocol for device: %s", deviceName)
 			http.Error(w,
 				fmt.Sprintf("failed selecting protocol for device: %s", deviceName),
@@ -102,6 +133,21 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if fileCopy == nil {
+		h.bridgeSession(r.Context(), conn, consoleSession, nil)
+		return
+	}
+	h.bridgeSession(r.Context(), conn, consoleSession, fileCopy.observe)
+	bytes, transferErr := fileCopy.result()
+	h.recordFileCopy(r.Context(), orgId, deviceName, fileCopy.fileCopy, bytes, transferErr)
+}
+
+// bridgeSession forwards binary websocket messages to the session and session messages back to the
+// websocket until either side closes, then closes the session. If observe is set, it is called with
+// every forwarded message.
+func (h *WebsocketHandler) bridgeSession(ctx context.Context, conn *websocket.Conn, consoleSession *console.ConsoleSession,
+	observe func(fromDevice bool, message []byte)) {
+	deviceName := consoleSession.DeviceName
 	stopWriter := make(chan struct{})
 
 	wg := sync.WaitGroup{}
@@ -126,6 +172,9 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 			}
 			// if it's binary or text message, forward it to the console session
 			if msgType == websocket.BinaryMessage {
+				if observe != nil {
+					observe(false, message)
+				}
 				consoleSession.SendCh <- message
 			} else {
 				h.log.Warningf("Received unexpected message type %d from console websocket session %s for device %s",
@@ -164,6 +213,9 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 					return
 				}
 
+				if observe != nil {
+					observe(true, message)
+				}
 				// echo the message received from the device console back to the websocket client
 				if err := conn.WriteMessage(websocket.BinaryMessage, message); err != nil {
 					h.log.Errorf("Failed to write message to console websocket for %s: %v", deviceName, err)
@@ -176,7 +228,7 @@ func (h *WebsocketHandler) HandleDeviceConsole(w http.ResponseWriter, r *http.Re
 
 	wg.Wait()
 	h.log.Infof("Ending console session %s to device %s", consoleSession.UUID, deviceName)
-	status = h.consoleSessionManager.CloseSession(r.Context(), consoleSession)
+	status := h.consoleSessionManager.CloseSession(ctx, consoleSession)
 	if status.Code != http.StatusOK {
 		h.log.Errorf("Error closing console session %s for device %s: %v", consoleSession.UUID, deviceName, status.Message)
 	}
@@ -0,0 +1,36 @@
+package transportv1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/go-chi/chi/v5"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleDeviceConsoleRejectsPortForward(t *testing.T) {
+	metadata, err := json.Marshal(&api.DeviceConsoleSessionMetadata{
+		PortForward: &api.DevicePortForward{Host: "10.0.0.1", Port: 22},
+	})
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/ws/v1/devices/dev1/console?"+
+		url.Values{api.DeviceQueryConsoleSessionMetadata: []string{string(metadata)}}.Encode(), nil)
+	routeCtx := chi.NewRouteContext()
+	routeCtx.URLParams.Add("name", "dev1")
+	req = req.WithContext(context.WithValue(req.Context(), chi.RouteCtxKey, routeCtx))
+	rec := httptest.NewRecorder()
+
+	// no session manager: the request must be rejected before a session is started
+	h := NewWebsocketHandler(nil, logrus.New(), nil, nil, nil, nil)
+	h.HandleDeviceConsole(rec, req)
+
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+	require.Contains(t, rec.Body.String(), "port forwarding")
+}
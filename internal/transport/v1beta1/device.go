@@ -1,11 +1,16 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"io"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
 )
 
 // (POST /api/v1/devices)
@@ -24,6 +29,34 @@ func (h *TransportHandler) CreateDevice(w http.ResponseWriter, r *http.Request)
 
 // (GET /api/v1/devices)
 func (h *TransportHandler) ListDevices(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListDevicesParams) {
+	watching := lo.FromPtr(params.Watch)
+	if status := validateWatchParams(watching, params.ResourceVersion, params.Limit, params.Continue); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	if watching {
+		if lo.FromPtr(params.SummaryOnly) {
+			h.SetResponse(w, nil, domain.StatusBadRequest("summaryOnly may not be specified when watch is true"))
+			return
+		}
+		h.serveWatch(w, r, domain.DeviceKind, params.ResourceVersion, func(ctx context.Context, name string, cont *string) ([]any, *string, domain.Status) {
+			listParams := params
+			listParams.FieldSelector = withNameSelector(params.FieldSelector, name)
+			listParams.Continue = cont
+			body, status := h.device.ListDevices(ctx, transport.OrgIDFromContext(ctx), h.converter.Device().ListParamsToDomain(listParams), nil)
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			apiResult := h.converter.Device().ListFromDomain(body)
+			items := make([]any, 0, len(apiResult.Items))
+			for i := range apiResult.Items {
+				items = append(items, &apiResult.Items[i])
+			}
+			return items, apiResult.Metadata.Continue, status
+		})
+		return
+	}
+
 	domainParams := h.converter.Device().ListParamsToDomain(params)
 	body, status := h.device.ListDevices(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams, nil)
 	apiResult := h.converter.Device().ListFromDomain(body)
@@ -135,6 +168,20 @@ func (h *TransportHandler) DecommissionDevice(w http.ResponseWriter, r *http.Req
 	h.SetResponse(w, apiResult, status)
 }
 
+// (POST /api/v1/devices/{name}/revokecertificates)
+func (h *TransportHandler) RevokeDeviceCertificates(w http.ResponseWriter, r *http.Request, name string) {
+	var req apiv1beta1.DeviceCertificateRevocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	domainReq := h.converter.Device().CertificateRevocationRequestToDomain(req)
+	body, status := h.certificaterevocation.RevokeDeviceCertificates(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainReq)
+	apiResult := h.converter.CertificateSigningRequest().RevocationListFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
+
 // (POST /api/v1/devices/{name}/applications/{appname}/actions/stop)
 func (h *TransportHandler) StopDeviceApplication(w http.ResponseWriter, r *http.Request, name string, appName string) {
 	body, status := h.device.StopDeviceApplication(r.Context(), transport.OrgIDFromContext(r.Context()), name, appName)
@@ -1,14 +1,41 @@
 package transportv1beta1
 
 import (
+	"context"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
 )
 
 // (GET /api/v1/events)
 func (h *TransportHandler) ListEvents(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListEventsParams) {
+	watching := lo.FromPtr(params.Watch)
+	if status := validateWatchParams(watching, params.ResourceVersion, params.Limit, params.Continue); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	if watching {
+		h.serveWatch(w, r, domain.EventKind, params.ResourceVersion, func(ctx context.Context, name string, cont *string) ([]any, *string, domain.Status) {
+			listParams := params
+			listParams.FieldSelector = withNameSelector(params.FieldSelector, name)
+			listParams.Continue = cont
+			body, status := h.event.ListEvents(ctx, transport.OrgIDFromContext(ctx), h.converter.Event().ListParamsToDomain(listParams))
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			apiResult := h.converter.Event().ListFromDomain(body)
+			items := make([]any, 0, len(apiResult.Items))
+			for i := range apiResult.Items {
+				items = append(items, &apiResult.Items[i])
+			}
+			return items, apiResult.Metadata.Continue, status
+		})
+		return
+	}
+
 	domainParams := h.converter.Event().ListParamsToDomain(params)
 	body, status := h.event.ListEvents(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Event().ListFromDomain(body)
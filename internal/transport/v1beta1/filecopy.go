@@ -0,0 +1,97 @@
+package transportv1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"sync"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/contextutil"
+	"github.com/flightctl/flightctl/internal/service/common"
+	"github.com/google/uuid"
+)
+
+// validateFileCopy checks the file copy request of a console session before it is sent to the
+// device. The device enforces its own allowed and denied paths.
+func validateFileCopy(fileCopy *api.DeviceFileCopy) error {
+	switch fileCopy.Direction {
+	case api.DeviceFileTransferDownload, api.DeviceFileTransferUpload:
+	default:
+		return fmt.Errorf("invalid file copy direction %q", fileCopy.Direction)
+	}
+	if !path.IsAbs(fileCopy.Path) {
+		return fmt.Errorf("file copy path %q must be absolute", fileCopy.Path)
+	}
+	if fileCopy.Size < 0 {
+		return fmt.Errorf("file copy size must not be negative")
+	}
+	return nil
+}
+
+// fileCopyAudit observes the messages of a file copy session to count the transferred bytes and
+// pick up the final status reported by the device.
+type fileCopyAudit struct {
+	fileCopy *api.DeviceFileCopy
+
+	mu     sync.Mutex
+	bytes  int64
+	status *api.DeviceFileCopyStatus
+}
+
+func (a *fileCopyAudit) observe(fromDevice bool, message []byte) {
+	if len(message) == 0 {
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch message[0] {
+	case api.DeviceFileCopyDataChannel:
+		if fromDevice == (a.fileCopy.Direction == api.DeviceFileTransferDownload) {
+			a.bytes += int64(len(message) - 1)
+		}
+	case api.DeviceFileCopyStatusChannel:
+		if !fromDevice {
+			return
+		}
+		var status api.DeviceFileCopyStatus
+		if err := json.Unmarshal(message[1:], &status); err == nil {
+			a.status = &status
+		}
+	}
+}
+
+// result returns the number of bytes transferred and the reason the transfer failed, if it did.
+func (a *fileCopyAudit) result() (int64, string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	switch {
+	case a.status == nil:
+		return a.bytes, "transfer did not complete"
+	case a.status.Error != "":
+		return a.bytes, a.status.Error
+	case a.status.Size != a.bytes:
+		return a.bytes, fmt.Sprintf("transferred %d of %d bytes", a.bytes, a.status.Size)
+	default:
+		return a.bytes, ""
+	}
+}
+
+// recordFileCopy emits an event recording who copied which file to or from the device.
+func (h *WebsocketHandler) recordFileCopy(ctx context.Context, orgId uuid.UUID, deviceName string, fileCopy *api.DeviceFileCopy, bytes int64, transferErr string) {
+	userName := "none"
+	if identity, ok := contextutil.GetMappedIdentityFromContext(ctx); ok && identity != nil {
+		userName = identity.GetUsername()
+	}
+	ctx = context.WithValue(ctx, consts.EventActorCtxKey, fmt.Sprintf("user:%s", userName))
+	// The request context may already be canceled once the client disconnects.
+	ctx = context.WithoutCancel(ctx)
+
+	event := common.GetDeviceFileTransferEvent(ctx, deviceName, fileCopy.Direction, fileCopy.Path, bytes, transferErr)
+	if event == nil {
+		return
+	}
+	h.eventSvc.CreateEvent(ctx, orgId, event)
+}
@@ -1,11 +1,14 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/samber/lo"
 )
 
 // (POST /api/v1/fleets)
@@ -24,6 +27,30 @@ func (h *TransportHandler) CreateFleet(w http.ResponseWriter, r *http.Request) {
 
 // (GET /api/v1/fleets)
 func (h *TransportHandler) ListFleets(w http.ResponseWriter, r *http.Request, params apiv1beta1.ListFleetsParams) {
+	watching := lo.FromPtr(params.Watch)
+	if status := validateWatchParams(watching, params.ResourceVersion, params.Limit, params.Continue); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	if watching {
+		h.serveWatch(w, r, domain.FleetKind, params.ResourceVersion, func(ctx context.Context, name string, cont *string) ([]any, *string, domain.Status) {
+			listParams := params
+			listParams.FieldSelector = withNameSelector(params.FieldSelector, name)
+			listParams.Continue = cont
+			body, status := h.fleet.ListFleets(ctx, transport.OrgIDFromContext(ctx), h.converter.Fleet().ListParamsToDomain(listParams))
+			if status.Code != http.StatusOK {
+				return nil, nil, status
+			}
+			apiResult := h.converter.Fleet().ListFromDomain(body)
+			items := make([]any, 0, len(apiResult.Items))
+			for i := range apiResult.Items {
+				items = append(items, &apiResult.Items[i])
+			}
+			return items, apiResult.Metadata.Continue, status
+		})
+		return
+	}
+
 	domainParams := h.converter.Fleet().ListParamsToDomain(params)
 	body, status := h.fleet.ListFleets(r.Context(), transport.OrgIDFromContext(r.Context()), domainParams)
 	apiResult := h.converter.Fleet().ListFromDomain(body)
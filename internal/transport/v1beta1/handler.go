@@ -5,10 +5,12 @@ import (
 	"github.com/flightctl/flightctl/internal/api/server"
 	"github.com/flightctl/flightctl/internal/auth"
 	"github.com/flightctl/flightctl/internal/auth/common"
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/console"
 	"github.com/flightctl/flightctl/internal/crypto"
 	"github.com/flightctl/flightctl/internal/service"
 	"github.com/flightctl/flightctl/internal/service/authprovider"
+	"github.com/flightctl/flightctl/internal/service/certificaterevocation"
 	"github.com/flightctl/flightctl/internal/service/certificatesigningrequest"
 	"github.com/flightctl/flightctl/internal/service/device"
 	"github.com/flightctl/flightctl/internal/service/enrollmentrequest"
@@ -28,6 +30,7 @@ import (
 // the auth-adjacent, non-resource endpoints in auth_token.go/auth_userinfo.go/checkpermission.go.
 type TransportHandler struct {
 	authprovider              authprovider.Service
+	certificaterevocation     certificaterevocation.Service
 	certificatesigningrequest certificatesigningrequest.Service
 	device                    device.Service
 	enrollmentrequest         enrollmentrequest.Service
@@ -48,6 +51,9 @@ type WebsocketHandler struct {
 	ca                    *crypto.CAClient
 	log                   logrus.FieldLogger
 	consoleSessionManager *console.ConsoleSessionManager
+	authZ                 auth.AuthZMiddleware
+	portForward           *config.PortForward
+	eventSvc              event.Service
 }
 
 // Make sure we conform to servers Transport interface
@@ -55,6 +61,7 @@ var _ server.Transport = (*TransportHandler)(nil)
 
 func NewTransportHandler(
 	authproviderSvc authprovider.Service,
+	certificaterevocationSvc certificaterevocation.Service,
 	certificatesigningrequestSvc certificatesigningrequest.Service,
 	deviceSvc device.Service,
 	enrollmentrequestSvc enrollmentrequest.Service,
@@ -72,6 +79,7 @@ func NewTransportHandler(
 ) *TransportHandler {
 	return &TransportHandler{
 		authprovider:              authproviderSvc,
+		certificaterevocation:     certificaterevocationSvc,
 		certificatesigningrequest: certificatesigningrequestSvc,
 		device:                    deviceSvc,
 		enrollmentrequest:         enrollmentrequestSvc,
@@ -89,15 +97,21 @@ func NewTransportHandler(
 	}
 }
 
-func NewWebsocketHandler(ca *crypto.CAClient, log logrus.FieldLogger, consoleSessionManager *console.ConsoleSessionManager) *WebsocketHandler {
+func NewWebsocketHandler(ca *crypto.CAClient, log logrus.FieldLogger, consoleSessionManager *console.ConsoleSessionManager,
+	authZ auth.AuthZMiddleware, portForward *config.PortForward, eventSvc event.Service) *WebsocketHandler {
 	return &WebsocketHandler{
 		ca:                    ca,
 		log:                   log,
 		consoleSessionManager: consoleSessionManager,
+		authZ:                 authZ,
+		portForward:           portForward,
+		eventSvc:              eventSvc,
 	}
 }
 
 func (h *WebsocketHandler) RegisterRoutes(r chi.Router) {
 	// Websocket handler for console
 	r.Get("/ws/v1/devices/{name}/console", h.HandleDeviceConsole)
+	// Websocket handler for port forwarding
+	r.Get("/ws/v1/devices/{name}/portforward", h.HandleDevicePortForward)
 }
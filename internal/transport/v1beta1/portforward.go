@@ -0,0 +1,122 @@
+package transportv1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/go-chi/chi/v5"
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// portForwardAnyPortResource is the permission required to forward to ports that are not
+	// listed in service.portForward.allowedPorts.
+	portForwardAnyPortResource = "devices/portforward/anyport"
+
+	defaultPortForwardHost = "localhost"
+)
+
+// portForwardTarget parses the target host and port of a port-forward request.
+func portForwardTarget(r *http.Request) (*api.DevicePortForward, error) {
+	query := r.URL.Query()
+	host := query.Get(api.DeviceQueryPortForwardHost)
+	if host == "" {
+		host = defaultPortForwardHost
+	}
+	port, err := strconv.Atoi(query.Get(api.DeviceQueryPortForwardPort))
+	if err != nil || port < 1 || port > 65535 {
+		return nil, fmt.Errorf("%s must be a port number between 1 and 65535", api.DeviceQueryPortForwardPort)
+	}
+	return &api.DevicePortForward{Host: host, Port: port}, nil
+}
+
+func (h *WebsocketHandler) HandleDevicePortForward(w http.ResponseWriter, r *http.Request) {
+	deviceName := chi.URLParam(r, "name")
+
+	target, err := portForwardTarget(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	h.log.Infof("websocket port-forward to %s:%d requested for device: %s", target.Host, target.Port, deviceName)
+
+	if !h.portForward.AllowsPort(target.Port) {
+		allowed, err := h.authZ.CheckPermission(r.Context(), portForwardAnyPortResource, "get")
+		if err != nil {
+			h.log.Errorf("failed checking %s permission: %v", portForwardAnyPortResource, err)
+			http.Error(w, "authorization check failed", http.StatusInternalServerError)
+			return
+		}
+		if !allowed {
+			http.Error(w, fmt.Sprintf("forwarding to port %d is not allowed", target.Port), http.StatusForbidden)
+			return
+		}
+	}
+
+	orgId := transport.OrgIDFromContext(r.Context())
+
+	// The metadata is built here rather than taken from the client so that the target cannot
+	// bypass the port checks above.
+	metadata, err := json.Marshal(&api.DeviceConsoleSessionMetadata{
+		PortForward: target,
+		Protocols:   []string{api.DevicePortForwardProtocol},
+	})
+	if err != nil {
+		http.Error(w, "failed building session metadata", http.StatusInternalServerError)
+		return
+	}
+	session, status := h.consoleSessionManager.StartSession(r.Context(), orgId, deviceName, string(metadata))
+	if status.Code != http.StatusOK {
+		http.Error(w, status.Message, int(status.Code))
+		return
+	}
+	closeSession := func() {
+		if status := h.consoleSessionManager.CloseSession(r.Context(), session); status.Code != http.StatusOK {
+			h.log.Errorf("Error closing port-forward session %s for device %s: %v", session.UUID, deviceName, status.Message)
+		}
+	}
+
+	timer := time.NewTimer(time.Minute)
+	defer timer.Stop()
+	select {
+	case selectedProtocol, ok := <-session.ProtocolCh:
+		if !ok || selectedProtocol != api.DevicePortForwardProtocol {
+			closeSession()
+			message := fmt.Sprintf("device %s does not support port forwarding", deviceName)
+			select {
+			case agentErr := <-session.ErrCh:
+				message = fmt.Sprintf("device %s failed connecting to %s:%d: %s", deviceName, target.Host, target.Port, agentErr)
+			default:
+			}
+			h.log.Info(message)
+			http.Error(w, message, http.StatusBadGateway)
+			return
+		}
+	case <-timer.C:
+		closeSession()
+		h.log.Errorf("timed out waiting for port-forward session for device: %s", deviceName)
+		http.Error(w,
+			fmt.Sprintf("timed out waiting for port-forward session for device: %s", deviceName),
+			http.StatusGatewayTimeout)
+		return
+	}
+
+	upgrader := websocket.Upgrader{
+		CheckOrigin: func(r *http.Request) bool {
+			return true // Allow connections from any origin
+		},
+		Subprotocols: []string{api.DevicePortForwardProtocol},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.log.Errorf("Failed to upgrade connection to WebSocket: %v", err)
+		closeSession()
+		return
+	}
+	h.bridgeSession(r.Context(), conn, session, nil)
+}
@@ -1,13 +1,52 @@
 package transportv1beta1
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
 	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/transport"
 )
 
+// organizationResourceSyncPermissions lists what an organization ResourceSync writes. The sync
+// task writes these resources with the service's privileges, so the caller creating or changing
+// the ResourceSync must be allowed to write them itself.
+var organizationResourceSyncPermissions = []struct {
+	resource string
+	ops      []string
+}{
+	{resource: "fleets", ops: []string{"create", "update"}},
+	{resource: "catalogs", ops: []string{"create", "update"}},
+	{resource: "catalogitems", ops: []string{"create", "update"}},
+	{resource: "repositories", ops: []string{"create", "update"}},
+	{resource: "authproviders", ops: []string{"create", "update"}},
+	{resource: "devices", ops: []string{"update"}},
+}
+
+// checkResourceSyncTypePermissions verifies that the caller may write every kind of resource
+// that a ResourceSync of the given type manages.
+func (h *TransportHandler) checkResourceSyncTypePermissions(ctx context.Context, rsType *apiv1beta1.ResourceSyncType) apiv1beta1.Status {
+	if rsType == nil || *rsType != apiv1beta1.ResourceSyncTypeOrganization {
+		return apiv1beta1.StatusOK()
+	}
+	for _, perm := range organizationResourceSyncPermissions {
+		for _, op := range perm.ops {
+			allowed, err := h.authZ.CheckPermission(ctx, perm.resource, op)
+			if err != nil {
+				return apiv1beta1.StatusInternalServerError(fmt.Sprintf("checking %s permission: %v", perm.resource, err))
+			}
+			if !allowed {
+				return apiv1beta1.StatusForbidden(fmt.Sprintf("managing an organization ResourceSync requires permission to %s %s", op, perm.resource))
+			}
+		}
+	}
+	return apiv1beta1.StatusOK()
+}
+
 // (POST /api/v1/resourcesyncs)
 func (h *TransportHandler) CreateResourceSync(w http.ResponseWriter, r *http.Request) {
 	var rs apiv1beta1.ResourceSync
@@ -16,6 +55,11 @@ func (h *TransportHandler) CreateResourceSync(w http.ResponseWriter, r *http.Req
 		return
 	}
 
+	if status := h.checkResourceSyncTypePermissions(r.Context(), rs.Spec.Type); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
 	domainRS := h.converter.ResourceSync().ToDomain(rs)
 	body, status := h.resourcesync.CreateResourceSync(r.Context(), transport.OrgIDFromContext(r.Context()), domainRS)
 	apiResult := h.converter.ResourceSync().FromDomain(body)
@@ -45,6 +89,11 @@ func (h *TransportHandler) ReplaceResourceSync(w http.ResponseWriter, r *http.Re
 		return
 	}
 
+	if status := h.checkResourceSyncTypePermissions(r.Context(), rs.Spec.Type); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
 	domainRS := h.converter.ResourceSync().ToDomain(rs)
 	body, status := h.resourcesync.ReplaceResourceSync(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainRS)
 	apiResult := h.converter.ResourceSync().FromDomain(body)
@@ -65,8 +114,32 @@ func (h *TransportHandler) PatchResourceSync(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// spec.type is immutable, so the current ResourceSync decides what the patched one manages
+	current, status := h.resourcesync.GetResourceSync(r.Context(), transport.OrgIDFromContext(r.Context()), name)
+	if status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+	if status := h.checkResourceSyncTypePermissions(r.Context(), current.Spec.Type); status.Code != http.StatusOK {
+		h.SetResponse(w, nil, status)
+		return
+	}
+
 	domainPatch := h.converter.Common().PatchRequestToDomain(patch)
 	body, status := h.resourcesync.PatchResourceSync(r.Context(), transport.OrgIDFromContext(r.Context()), name, domainPatch)
 	apiResult := h.converter.ResourceSync().FromDomain(body)
 	h.SetResponse(w, apiResult, status)
 }
+
+// (POST /api/v1/resourcesyncs/{name}/plan)
+func (h *TransportHandler) PlanResourceSync(w http.ResponseWriter, r *http.Request, name string) {
+	var req apiv1beta1.ResourceSyncPlanRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.SetParseFailureResponse(w, err)
+		return
+	}
+
+	body, status := h.resourcesync.PlanResourceSync(r.Context(), transport.OrgIDFromContext(r.Context()), name, req.TargetRevision)
+	apiResult := h.converter.ResourceSync().PlanFromDomain(body)
+	h.SetResponse(w, apiResult, status)
+}
@@ -0,0 +1,99 @@
+package transportv1beta1
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	convertv1beta1 "github.com/flightctl/flightctl/internal/api/convert/v1beta1"
+	"github.com/flightctl/flightctl/internal/auth"
+	"github.com/flightctl/flightctl/internal/service/resourcesync"
+	"github.com/samber/lo"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+)
+
+func newResourceSyncTestHandler(rsSvc resourcesync.Service, authZ auth.AuthZMiddleware) *TransportHandler {
+	return NewTransportHandler(nil, nil, nil, nil, nil, nil, nil, nil, nil, rsSvc, nil, convertv1beta1.NewConverter(), nil, nil, nil, authZ)
+}
+
+func resourceSyncBody(t *testing.T, rsType *api.ResourceSyncType) *bytes.Buffer {
+	b, err := json.Marshal(api.ResourceSync{
+		ApiVersion: "v1beta1",
+		Kind:       api.ResourceSyncKind,
+		Metadata:   api.ObjectMeta{Name: lo.ToPtr("rs")},
+		Spec:       api.ResourceSyncSpec{Repository: "repo", Path: "/", TargetRevision: "main", Type: rsType},
+	})
+	require.NoError(t, err)
+	return bytes.NewBuffer(b)
+}
+
+func TestCreateResourceSyncTypePermissions(t *testing.T) {
+	testCases := []struct {
+		name       string
+		rsType     *api.ResourceSyncType
+		denied     string
+		wantStatus int
+	}{
+		{
+			name:       "fleet resource sync needs no extra permissions",
+			rsType:     lo.ToPtr(api.ResourceSyncTypeFleet),
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "organization resource sync with all permissions",
+			rsType:     lo.ToPtr(api.ResourceSyncTypeOrganization),
+			wantStatus: http.StatusCreated,
+		},
+		{
+			name:       "organization resource sync without authprovider permission",
+			rsType:     lo.ToPtr(api.ResourceSyncTypeOrganization),
+			denied:     "authproviders",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "organization resource sync without repository permission",
+			rsType:     lo.ToPtr(api.ResourceSyncTypeOrganization),
+			denied:     "repositories",
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			authZ := auth.NewMockAuthZMiddleware(ctrl)
+			authZ.EXPECT().CheckPermission(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ any, resource string, _ string) (bool, error) { return resource != tc.denied, nil }).AnyTimes()
+			rsSvc := resourcesync.NewMockService(ctrl)
+			if tc.wantStatus == http.StatusCreated {
+				rsSvc.EXPECT().CreateResourceSync(gomock.Any(), gomock.Any(), gomock.Any()).Return(&api.ResourceSync{}, api.StatusCreated())
+			}
+
+			req := httptest.NewRequest(http.MethodPost, "/api/v1/resourcesyncs", resourceSyncBody(t, tc.rsType))
+			rec := httptest.NewRecorder()
+			newResourceSyncTestHandler(rsSvc, authZ).CreateResourceSync(rec, req)
+			require.Equal(t, tc.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestPatchResourceSyncTypePermissions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	authZ := auth.NewMockAuthZMiddleware(ctrl)
+	authZ.EXPECT().CheckPermission(gomock.Any(), "fleets", gomock.Any()).Return(true, nil).AnyTimes()
+	authZ.EXPECT().CheckPermission(gomock.Any(), "catalogs", gomock.Any()).Return(false, nil).AnyTimes()
+	rsSvc := resourcesync.NewMockService(ctrl)
+	rsSvc.EXPECT().GetResourceSync(gomock.Any(), gomock.Any(), "rs").Return(&api.ResourceSync{
+		Spec: api.ResourceSyncSpec{Type: lo.ToPtr(api.ResourceSyncTypeOrganization)},
+	}, api.StatusOK())
+
+	patch := bytes.NewBufferString(`[{"op":"replace","path":"/spec/repository","value":"other"}]`)
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/resourcesyncs/rs", patch)
+	rec := httptest.NewRecorder()
+	newResourceSyncTestHandler(rsSvc, authZ).PatchResourceSync(rec, req, "rs")
+	require.Equal(t, http.StatusForbidden, rec.Code)
+}
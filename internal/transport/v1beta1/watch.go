@@ -0,0 +1,231 @@
+package transportv1beta1
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	apiv1beta1 "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/transport"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/samber/lo"
+)
+
+// watchBookmarkInterval is how often an idle watch stream receives a BOOKMARK
+// event, both to keep intermediaries from closing the connection and to let
+// clients advance the resourceVersion they resume from.
+const watchBookmarkInterval = 30 * time.Second
+
+// watchListFunc lists the resources of a watched kind that match the selectors
+// of the watch request. If name is set, only the resource with that name is
+// returned (if it still matches the selectors).
+type watchListFunc func(ctx context.Context, name string, cont *string) ([]any, *string, domain.Status)
+
+// withNameSelector restricts a field selector to the resource with the given name.
+func withNameSelector(fieldSelector *string, name string) *string {
+	if name == "" {
+		return fieldSelector
+	}
+	nameSelector := fmt.Sprintf("metadata.name=%s", name)
+	if lo.FromPtr(fieldSelector) == "" {
+		return &nameSelector
+	}
+	return lo.ToPtr(fmt.Sprintf("%s,%s", *fieldSelector, nameSelector))
+}
+
+// validateWatchParams rejects list parameters that can't be combined with the
+// presence or absence of watch=true.
+func validateWatchParams(watching bool, resourceVersion *string, limit *int32, cont *string) domain.Status {
+	if !watching {
+		if resourceVersion != nil {
+			return domain.StatusBadRequest("resourceVersion may only be specified when watch is true")
+		}
+		return domain.StatusOK()
+	}
+	if limit != nil || cont != nil {
+		return domain.StatusBadRequest("limit and continue may not be specified when watch is true")
+	}
+	return domain.StatusOK()
+}
+
+// serveWatch streams changes to the resources of the given kind as
+// Server-Sent Events. Unless resuming from a resourceVersion, the stream
+// starts with an ADDED event for every existing resource followed by a
+// BOOKMARK event marking the end of the initial state.
+func (h *TransportHandler) serveWatch(w http.ResponseWriter, r *http.Request, kind string, resourceVersion *string, list watchListFunc) {
+	ctx := r.Context()
+	orgId := transport.OrgIDFromContext(ctx)
+
+	watcher, err := watch.Bus.Instance().Watch(orgId, kind, lo.FromPtr(resourceVersion))
+	switch {
+	case errors.Is(err, watch.ErrResourceVersionExpired):
+		h.SetResponse(w, nil, domain.StatusGone(err.Error()))
+		return
+	case err != nil:
+		h.SetResponse(w, nil, domain.StatusServiceUnavailable(err.Error()))
+		return
+	}
+	defer watcher.Stop()
+
+	// Run the initial list before committing to a streaming response so that
+	// invalid selectors are reported as regular errors.
+	var initial []any
+	if resourceVersion == nil {
+		var cont *string
+		for {
+			items, next, status := list(ctx, "", cont)
+			if status.Code != http.StatusOK {
+				h.SetResponse(w, nil, status)
+				return
+			}
+			initial = append(initial, items...)
+			if next == nil {
+				break
+			}
+			cont = next
+		}
+	}
+
+	// Watch streams are long-lived, so lift the server's write timeout.
+	_ = http.NewResponseController(w).SetWriteDeadline(time.Time{})
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+
+	stream := &watchStream{w: w}
+	lastResourceVersion := lo.FromPtr(resourceVersion)
+	if resourceVersion == nil {
+		lastResourceVersion = watcher.ResourceVersion()
+		for _, item := range initial {
+			stream.send(domain.WatchEventTypeAdded, lastResourceVersion, item)
+		}
+		stream.send(domain.WatchEventTypeBookmark, lastResourceVersion, nil)
+	}
+	if stream.err != nil {
+		return
+	}
+
+	// known tracks the resources the client has been told about, so that a
+	// resource that no longer matches the selectors is reported as DELETED.
+	known := make(map[string]struct{})
+	for _, item := range initial {
+		if name := watchObjectName(item); name != "" {
+			known[name] = struct{}{}
+		}
+	}
+
+	ticker := time.NewTicker(watchBookmarkInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			stream.send(domain.WatchEventTypeBookmark, lastResourceVersion, nil)
+		case entry, ok := <-watcher.ResultChan():
+			if !ok {
+				return
+			}
+			lastResourceVersion = entry.ResourceVersion
+			if entry.Type == domain.WatchEventTypeDeleted {
+				if _, found := known[entry.Name]; found || resourceVersion != nil {
+					delete(known, entry.Name)
+					stream.send(domain.WatchEventTypeDeleted, entry.ResourceVersion, deletedWatchObject(kind, entry.Name))
+				}
+				break
+			}
+
+			items, _, status := list(ctx, entry.Name, nil)
+			if status.Code != http.StatusOK {
+				stream.send(domain.WatchEventTypeError, entry.ResourceVersion, h.converter.Common().StatusFromDomain(status))
+				return
+			}
+			// A resumed watch has no record of what the client already knows
+			// about, so it trusts the notification type instead.
+			_, found := known[entry.Name]
+			found = found || (resourceVersion != nil && entry.Type != domain.WatchEventTypeAdded)
+			switch {
+			case len(items) > 0 && found:
+				known[entry.Name] = struct{}{}
+				stream.send(domain.WatchEventTypeModified, entry.ResourceVersion, items[0])
+			case len(items) > 0:
+				known[entry.Name] = struct{}{}
+				stream.send(domain.WatchEventTypeAdded, entry.ResourceVersion, items[0])
+			case found:
+				delete(known, entry.Name)
+				stream.send(domain.WatchEventTypeDeleted, entry.ResourceVersion, deletedWatchObject(kind, entry.Name))
+			}
+		}
+		if stream.err != nil {
+			return
+		}
+	}
+}
+
+// watchStream writes watch events in Server-Sent Events format, remembering
+// the first write error so the caller can stop streaming.
+type watchStream struct {
+	w   http.ResponseWriter
+	err error
+}
+
+func (s *watchStream) send(eventType domain.WatchEventType, resourceVersion string, object any) {
+	if s.err != nil {
+		return
+	}
+	event := struct {
+		Type            domain.WatchEventType `json:"type"`
+		ResourceVersion string                `json:"resourceVersion"`
+		Object          any                   `json:"object,omitempty"`
+	}{
+		Type:            eventType,
+		ResourceVersion: resourceVersion,
+		Object:          object,
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		s.err = err
+		return
+	}
+	if _, s.err = fmt.Fprintf(s.w, "data: %s\n\n", data); s.err != nil {
+		return
+	}
+	if flusher, ok := s.w.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// watchAPIVersions maps the watched kinds to their API versions.
+var watchAPIVersions = map[string]string{
+	apiv1beta1.DeviceKind: apiv1beta1.DeviceAPIVersion,
+	apiv1beta1.FleetKind:  apiv1beta1.FleetAPIVersion,
+	apiv1beta1.EventKind:  apiv1beta1.EventAPIVersion,
+}
+
+// deletedWatchObject returns the minimal representation of a deleted resource.
+func deletedWatchObject(kind string, name string) map[string]any {
+	return map[string]any{
+		"apiVersion": fmt.Sprintf("%s/%s", apiv1beta1.APIGroup, watchAPIVersions[kind]),
+		"kind":       kind,
+		"metadata":   map[string]any{"name": name},
+	}
+}
+
+func watchObjectName(item any) string {
+	switch obj := item.(type) {
+	case *apiv1beta1.Device:
+		return lo.FromPtr(obj.Metadata.Name)
+	case *apiv1beta1.Fleet:
+		return lo.FromPtr(obj.Metadata.Name)
+	case *apiv1beta1.Event:
+		return lo.FromPtr(obj.Metadata.Name)
+	}
+	return ""
+}
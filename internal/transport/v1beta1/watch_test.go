@@ -0,0 +1,23 @@
+package transportv1beta1
+
+import (
+	"testing"
+
+	api "github.com/flightctl/flightctl/api/core/v1beta1"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeletedWatchObject(t *testing.T) {
+	for kind, apiVersion := range map[string]string{
+		api.DeviceKind: api.DeviceAPIVersion,
+		api.FleetKind:  api.FleetAPIVersion,
+		api.EventKind:  api.EventAPIVersion,
+	} {
+		t.Run(kind, func(t *testing.T) {
+			obj := deletedWatchObject(kind, "name")
+			require.Equal(t, api.APIGroup+"/"+apiVersion, obj["apiVersion"])
+			require.Equal(t, kind, obj["kind"])
+			require.Equal(t, map[string]any{"name": "name"}, obj["metadata"])
+		})
+	}
+}
@@ -433,6 +433,7 @@ func ValidateSignerName(s string) []error {
 		"flightctl.io/device-enrollment":         {},
 		"flightctl.io/device-management-renewal": {},
 		"flightctl.io/device-svc-client":         {},
+		"flightctl.io/device-workload":           {},
 		"flightctl.io/server-svc":                {},
 	}
 
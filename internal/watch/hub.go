@@ -0,0 +1,245 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/internal/util"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// historySize is the number of notifications kept in memory so that
+	// interrupted watches can resume without relisting.
+	historySize = 4096
+	// watcherBufferSize is the number of notifications that may be queued for
+	// a single watcher before it is considered too slow and closed.
+	watcherBufferSize = 256
+)
+
+var (
+	ErrResourceVersionExpired = errors.New("the requested resourceVersion is no longer available, restart the watch without a resourceVersion")
+	ErrWatchUnavailable       = errors.New("watch is not available on this server")
+)
+
+// Entry is a Notification as observed by a Hub, tagged with its position in
+// the hub's history.
+type Entry struct {
+	Notification
+	OrgId           uuid.UUID
+	ResourceVersion string
+}
+
+// Hub fans resource notifications out to the watch streams served by this
+// process. Every process that modifies watchable resources publishes through
+// the hub; only the API server starts it to receive notifications.
+//
+// Resource versions handed out by a hub are only meaningful to that hub, so a
+// watch that is resumed against a different API server replica (or after a
+// restart) receives ErrResourceVersionExpired and must relist.
+type Hub struct {
+	publisher  Publisher
+	subscriber Subscriber
+	id         string
+	log        logrus.FieldLogger
+
+	mu       sync.Mutex
+	seq      uint64
+	history  []Entry
+	watchers map[*Watcher]struct{}
+}
+
+type BusType struct {
+	util.Singleton[Hub]
+}
+
+var Bus BusType
+
+func (b *BusType) Initialize(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) error {
+	h, err := newHub(ctx, provider, log)
+	if err != nil {
+		return err
+	}
+	_ = b.GetOrInit(h)
+	return nil
+}
+
+func newHub(ctx context.Context, provider queues.Provider, log logrus.FieldLogger) (*Hub, error) {
+	publisher, err := NewPublisher(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create publisher for watch notifications: %w", err)
+	}
+	subscriber, err := NewSubscriber(ctx, provider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscriber for watch notifications: %w", err)
+	}
+	return &Hub{
+		publisher:  publisher,
+		subscriber: subscriber,
+		id:         strings.SplitN(uuid.NewString(), "-", 2)[0],
+		log:        log,
+		watchers:   make(map[*Watcher]struct{}),
+	}, nil
+}
+
+// Start subscribes to watch notifications so that they can be delivered to
+// the watchers registered with this hub.
+func (h *Hub) Start(ctx context.Context) error {
+	if err := h.subscriber.Subscribe(ctx, h.consumeHandler); err != nil {
+		h.log.Errorf("failed to consume watch notifications: %v", err)
+		return err
+	}
+	return nil
+}
+
+// Notify publishes a watch notification for the given resource. It is a
+// no-op if the hub was not initialized in this process, and failures are only
+// logged since watch notifications are best-effort.
+func (h *Hub) Notify(ctx context.Context, orgId uuid.UUID, kind string, name string, eventType domain.WatchEventType) {
+	if h.publisher == nil {
+		return
+	}
+	if err := h.publisher.Publish(ctx, orgId, Notification{Kind: kind, Name: name, Type: eventType}); err != nil {
+		h.log.Warnf("failed to publish watch notification for %s %s/%s: %v", kind, orgId, name, err)
+	}
+}
+
+func (h *Hub) consumeHandler(_ context.Context, orgId uuid.UUID, n Notification) error {
+	h.record(orgId, n)
+	return nil
+}
+
+func (h *Hub) record(orgId uuid.UUID, n Notification) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.seq++
+	entry := Entry{
+		Notification:    n,
+		OrgId:           orgId,
+		ResourceVersion: h.resourceVersion(h.seq),
+	}
+	h.history = append(h.history, entry)
+	if len(h.history) > historySize {
+		h.history = h.history[len(h.history)-historySize:]
+	}
+
+	for w := range h.watchers {
+		if !w.matches(entry) {
+			continue
+		}
+		select {
+		case w.ch <- entry:
+		default:
+			h.log.Warnf("watcher for %s in org %s is too slow, closing it", w.kind, w.orgId)
+			h.removeLocked(w)
+		}
+	}
+}
+
+// Watch registers a watcher for resources of the given kind in the given
+// organization. If resourceVersion is set, notifications recorded after that
+// position are replayed before live notifications.
+func (h *Hub) Watch(orgId uuid.UUID, kind string, resourceVersion string) (*Watcher, error) {
+	if h.subscriber == nil {
+		return nil, ErrWatchUnavailable
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	w := &Watcher{
+		hub:             h,
+		orgId:           orgId,
+		kind:            kind,
+		resourceVersion: h.resourceVersion(h.seq),
+	}
+
+	var replay []Entry
+	if resourceVersion != "" {
+		seq, err := h.parseResourceVersion(resourceVersion)
+		if err != nil {
+			return nil, err
+		}
+		oldest := h.seq - uint64(len(h.history))
+		if seq < oldest || seq > h.seq {
+			return nil, ErrResourceVersionExpired
+		}
+		for _, entry := range h.history[len(h.history)-int(h.seq-seq):] {
+			if w.matches(entry) {
+				replay = append(replay, entry)
+			}
+		}
+	}
+
+	w.ch = make(chan Entry, len(replay)+watcherBufferSize)
+	for _, entry := range replay {
+		w.ch <- entry
+	}
+	h.watchers[w] = struct{}{}
+	return w, nil
+}
+
+func (h *Hub) removeLocked(w *Watcher) {
+	if _, ok := h.watchers[w]; !ok {
+		return
+	}
+	delete(h.watchers, w)
+	close(w.ch)
+}
+
+func (h *Hub) resourceVersion(seq uint64) string {
+	return fmt.Sprintf("%s.%d", h.id, seq)
+}
+
+func (h *Hub) parseResourceVersion(resourceVersion string) (uint64, error) {
+	id, seqStr, found := strings.Cut(resourceVersion, ".")
+	if !found || id != h.id {
+		return 0, ErrResourceVersionExpired
+	}
+	seq, err := strconv.ParseUint(seqStr, 10, 64)
+	if err != nil {
+		return 0, ErrResourceVersionExpired
+	}
+	return seq, nil
+}
+
+// Watcher receives the notifications recorded by a Hub for a single
+// organization and resource kind.
+type Watcher struct {
+	hub             *Hub
+	orgId           uuid.UUID
+	kind            string
+	resourceVersion string
+	ch              chan Entry
+}
+
+func (w *Watcher) matches(entry Entry) bool {
+	return entry.OrgId == w.orgId && entry.Kind == w.kind
+}
+
+// ResultChan returns the channel notifications are delivered on. The channel
+// is closed when the watcher is stopped or falls too far behind.
+func (w *Watcher) ResultChan() <-chan Entry {
+	return w.ch
+}
+
+// ResourceVersion returns the position of the hub at the time the watcher
+// was registered.
+func (w *Watcher) ResourceVersion() string {
+	return w.resourceVersion
+}
+
+// Stop unregisters the watcher and closes its result channel.
+func (w *Watcher) Stop() {
+	w.hub.mu.Lock()
+	defer w.hub.mu.Unlock()
+	w.hub.removeLocked(w)
+}
@@ -0,0 +1,153 @@
+package watch
+
+import (
+	"context"
+	"testing"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSubscriber struct{}
+
+func (f *fakeSubscriber) Subscribe(_ context.Context, _ func(ctx context.Context, orgId uuid.UUID, n Notification) error) error {
+	return nil
+}
+
+func newTestHub() *Hub {
+	return &Hub{
+		subscriber: &fakeSubscriber{},
+		id:         "test",
+		log:        logrus.New(),
+		watchers:   make(map[*Watcher]struct{}),
+	}
+}
+
+func drain(w *Watcher) []Entry {
+	var entries []Entry
+	for {
+		select {
+		case e, ok := <-w.ResultChan():
+			if !ok {
+				return entries
+			}
+			entries = append(entries, e)
+		default:
+			return entries
+		}
+	}
+}
+
+func TestHubDeliversOnlyMatchingNotifications(t *testing.T) {
+	h := newTestHub()
+	orgId := uuid.New()
+	otherOrgId := uuid.New()
+
+	w, err := h.Watch(orgId, domain.DeviceKind, "")
+	require.NoError(t, err)
+	defer w.Stop()
+
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeAdded})
+	h.record(orgId, Notification{Kind: domain.FleetKind, Name: "fleet1", Type: domain.WatchEventTypeAdded})
+	h.record(otherOrgId, Notification{Kind: domain.DeviceKind, Name: "dev2", Type: domain.WatchEventTypeAdded})
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeDeleted})
+
+	entries := drain(w)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "dev1", entries[0].Name)
+	assert.Equal(t, domain.WatchEventTypeAdded, entries[0].Type)
+	assert.Equal(t, "test.1", entries[0].ResourceVersion)
+	assert.Equal(t, domain.WatchEventTypeDeleted, entries[1].Type)
+	assert.Equal(t, "test.4", entries[1].ResourceVersion)
+}
+
+func TestHubResumeReplaysHistory(t *testing.T) {
+	h := newTestHub()
+	orgId := uuid.New()
+
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeAdded})
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev2", Type: domain.WatchEventTypeAdded})
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeModified})
+
+	w, err := h.Watch(orgId, domain.DeviceKind, "test.1")
+	require.NoError(t, err)
+	defer w.Stop()
+	assert.Equal(t, "test.3", w.ResourceVersion())
+
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev2", Type: domain.WatchEventTypeDeleted})
+
+	entries := drain(w)
+	require.Len(t, entries, 3)
+	assert.Equal(t, "dev2", entries[0].Name)
+	assert.Equal(t, "dev1", entries[1].Name)
+	assert.Equal(t, domain.WatchEventTypeModified, entries[1].Type)
+	assert.Equal(t, domain.WatchEventTypeDeleted, entries[2].Type)
+}
+
+func TestHubResumeFromCurrentPosition(t *testing.T) {
+	h := newTestHub()
+	orgId := uuid.New()
+
+	h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeAdded})
+
+	w, err := h.Watch(orgId, domain.DeviceKind, "test.1")
+	require.NoError(t, err)
+	defer w.Stop()
+	assert.Empty(t, drain(w))
+}
+
+func TestHubResumeExpired(t *testing.T) {
+	h := newTestHub()
+	orgId := uuid.New()
+
+	for i := 0; i < historySize+10; i++ {
+		h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeModified})
+	}
+
+	testCases := []struct {
+		name            string
+		resourceVersion string
+	}{
+		{name: "trimmed from history", resourceVersion: "test.5"},
+		{name: "issued by another hub", resourceVersion: "other.5000"},
+		{name: "in the future", resourceVersion: "test.999999"},
+		{name: "malformed", resourceVersion: "garbage"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := h.Watch(orgId, domain.DeviceKind, tc.resourceVersion)
+			assert.ErrorIs(t, err, ErrResourceVersionExpired)
+		})
+	}
+}
+
+func TestHubClosesSlowWatcher(t *testing.T) {
+	h := newTestHub()
+	orgId := uuid.New()
+
+	w, err := h.Watch(orgId, domain.DeviceKind, "")
+	require.NoError(t, err)
+
+	for i := 0; i < watcherBufferSize+1; i++ {
+		h.record(orgId, Notification{Kind: domain.DeviceKind, Name: "dev1", Type: domain.WatchEventTypeModified})
+	}
+
+	entries := drain(w)
+	assert.Len(t, entries, watcherBufferSize)
+	_, ok := <-w.ResultChan()
+	assert.False(t, ok)
+
+	// stopping an already closed watcher is harmless
+	w.Stop()
+}
+
+func TestHubNotInitialized(t *testing.T) {
+	h := &Hub{}
+	h.Notify(context.Background(), uuid.New(), domain.DeviceKind, "dev1", domain.WatchEventTypeAdded)
+
+	_, err := h.Watch(uuid.New(), domain.DeviceKind, "")
+	assert.ErrorIs(t, err, ErrWatchUnavailable)
+}
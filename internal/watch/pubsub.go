@@ -0,0 +1,96 @@
+package watch
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/flightctl/flightctl/internal/domain"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+const channelName = "resource_watch_notifier"
+
+// Notification is the internal signal sent over the pub/sub channel whenever a
+// watchable resource is created, updated or deleted. It only identifies the
+// resource; watchers read the current object from the store when they need it.
+type Notification struct {
+	Kind string
+	Name string
+	Type domain.WatchEventType
+}
+
+type Publisher interface {
+	Publish(ctx context.Context, orgId uuid.UUID, n Notification) error
+}
+
+type Subscriber interface {
+	Subscribe(ctx context.Context, handler func(ctx context.Context, orgId uuid.UUID, n Notification) error) error
+}
+
+func NewPublisher(ctx context.Context, queuesProvider queues.Provider) (Publisher, error) {
+	queuesPublisher, err := queuesProvider.NewPubSubPublisher(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &publisher{
+		broadcaster: queuesPublisher,
+	}, nil
+}
+
+func NewSubscriber(ctx context.Context, queuesProvider queues.Provider) (Subscriber, error) {
+	subscriber, err := queuesProvider.NewPubSubSubscriber(ctx, channelName)
+	if err != nil {
+		return nil, err
+	}
+	return &consumer{
+		subscriber: subscriber,
+	}, nil
+}
+
+type serializedNotification struct {
+	OrgId uuid.UUID             `json:"org_id"`
+	Kind  string                `json:"kind"`
+	Name  string                `json:"name"`
+	Type  domain.WatchEventType `json:"type"`
+}
+
+type publisher struct {
+	broadcaster queues.PubSubPublisher
+}
+
+func (p *publisher) Publish(ctx context.Context, orgId uuid.UUID, n Notification) error {
+	b, err := json.Marshal(serializedNotification{
+		OrgId: orgId,
+		Kind:  n.Kind,
+		Name:  n.Name,
+		Type:  n.Type,
+	})
+	if err != nil {
+		return err
+	}
+	return p.broadcaster.Publish(ctx, b)
+}
+
+type consumer struct {
+	subscriber queues.PubSubSubscriber
+}
+
+func (c *consumer) Subscribe(ctx context.Context, handler func(ctx context.Context, orgId uuid.UUID, n Notification) error) error {
+	queuesHandler := func(ctx context.Context, payload []byte, log logrus.FieldLogger) error {
+		var s serializedNotification
+		if err := json.Unmarshal(payload, &s); err != nil {
+			log.WithError(err).Error("failed to unmarshal watch notification")
+			return err
+		}
+		return handler(ctx, s.OrgId, Notification{
+			Kind: s.Kind,
+			Name: s.Name,
+			Type: s.Type,
+		})
+	}
+
+	_, err := c.subscriber.Subscribe(ctx, queuesHandler)
+	return err
+}
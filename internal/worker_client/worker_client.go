@@ -81,6 +81,7 @@ var eventReasons = map[domain.EventReason]struct{}{
 	domain.EventReasonDependencyChangeDetected:    {},
 	domain.EventReasonFleetRolloutDeviceSelected:  {},
 	domain.EventReasonFleetRolloutBatchDispatched: {},
+	domain.EventReasonFleetRolloutRollbackStarted: {},
 	domain.EventReasonDeviceConflictResolved:      {},
 	domain.EventReasonDeviceDecommissioned:        {},
 	domain.EventReasonApplicationLifecycleChanged: {},
@@ -0,0 +1,168 @@
+// Package imagesign implements signing and verification of container images using the
+// cosign "simple signing" format, so that signatures can be created and verified both by
+// Flight Control and by sigstore/cosign tooling (e.g. `cosign verify --key`).
+//
+// A signature covers a JSON payload identifying the image repository and manifest digest.
+// It is stored as a single-layer image manifest tagged "sha256-<hex>.sig" in the image's
+// repository, with the payload as layer and the base64 encoded signature as layer annotation.
+package imagesign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/opencontainers/go-digest"
+)
+
+const (
+	// PayloadMediaType is the media type of the signature payload layer
+	PayloadMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	// SignatureAnnotation is the layer annotation holding the base64 encoded signature
+	SignatureAnnotation = "dev.cosignproject.cosign/signature"
+	// PayloadType is the type of the critical section of a signature payload
+	PayloadType = "cosign container image signature"
+)
+
+var (
+	ErrNoValidSignature = errors.New("no valid signature from a trusted key")
+	ErrPayloadMismatch  = errors.New("signature payload does not match image")
+)
+
+// Payload is the signed document identifying the image
+type Payload struct {
+	Critical Critical          `json:"critical"`
+	Optional map[string]string `json:"optional"`
+}
+
+type Critical struct {
+	Identity Identity `json:"identity"`
+	Image    Image    `json:"image"`
+	Type     string   `json:"type"`
+}
+
+type Identity struct {
+	DockerReference string `json:"docker-reference"`
+}
+
+type Image struct {
+	DockerManifestDigest string `json:"docker-manifest-digest"`
+}
+
+// NewPayload returns the payload to sign for the image manifest with the given digest in the given repository,
+// e.g. "quay.io/org/image" (without tag or digest).
+func NewPayload(repository string, manifestDigest string) ([]byte, error) {
+	if _, err := digest.Parse(manifestDigest); err != nil {
+		return nil, fmt.Errorf("invalid manifest digest %q: %w", manifestDigest, err)
+	}
+	return json.Marshal(Payload{
+		Critical: Critical{
+			Identity: Identity{DockerReference: repository},
+			Image:    Image{DockerManifestDigest: manifestDigest},
+			Type:     PayloadType,
+		},
+	})
+}
+
+// SignatureTag returns the tag under which the signature of the manifest with the given digest is stored
+func SignatureTag(manifestDigest string) (string, error) {
+	d, err := digest.Parse(manifestDigest)
+	if err != nil {
+		return "", fmt.Errorf("invalid manifest digest %q: %w", manifestDigest, err)
+	}
+	return fmt.Sprintf("%s-%s.sig", d.Algorithm(), d.Encoded()), nil
+}
+
+// Sign signs the payload with the given key and returns the base64 encoded signature.
+// ECDSA and RSA keys sign the SHA-256 digest of the payload, Ed25519 keys sign the payload itself.
+func Sign(key crypto.PrivateKey, payload []byte) (string, error) {
+	var sig []byte
+	var err error
+	hash := sha256.Sum256(payload)
+	switch k := key.(type) {
+	case *ecdsa.PrivateKey:
+		sig, err = ecdsa.SignASN1(rand.Reader, k, hash[:])
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, hash[:])
+	case ed25519.PrivateKey:
+		sig = ed25519.Sign(k, payload)
+	default:
+		return "", fmt.Errorf("unsupported signing key type %T", key)
+	}
+	if err != nil {
+		return "", fmt.Errorf("signing payload: %w", err)
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// Verify checks that the base64 encoded signature of the payload was created by one of the given keys
+func Verify(keys []crypto.PublicKey, payload []byte, signature string) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("decoding signature: %w", err)
+	}
+	hash := sha256.Sum256(payload)
+	for _, key := range keys {
+		switch k := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(k, hash[:], sig) {
+				return nil
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(k, crypto.SHA256, hash[:], sig) == nil {
+				return nil
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(k, payload, sig) {
+				return nil
+			}
+		}
+	}
+	return ErrNoValidSignature
+}
+
+// VerifyPayload checks that a verified payload identifies the image manifest with the given digest in the given
+// repository
+func VerifyPayload(payload []byte, repository string, manifestDigest string) error {
+	var p Payload
+	if err := json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("parsing signature payload: %w", err)
+	}
+	if p.Critical.Type != PayloadType {
+		return fmt.Errorf("%w: unexpected type %q", ErrPayloadMismatch, p.Critical.Type)
+	}
+	if p.Critical.Image.DockerManifestDigest != manifestDigest {
+		return fmt.Errorf("%w: signed digest %q, image digest %q", ErrPayloadMismatch, p.Critical.Image.DockerManifestDigest, manifestDigest)
+	}
+	if p.Critical.Identity.DockerReference != repository {
+		return fmt.Errorf("%w: signed repository %q, image repository %q", ErrPayloadMismatch, p.Critical.Identity.DockerReference, repository)
+	}
+	return nil
+}
+
+// ParsePublicKeyPEM parses a PEM encoded PKIX public key, as written by `cosign generate-key-pair`
+// or `openssl pkey -pubout`
+func ParsePublicKeyPEM(pemKey []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, fmt.Errorf("not a valid PEM encoded block")
+	}
+	if !strings.HasSuffix(block.Type, "PUBLIC KEY") {
+		return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing public key: %w", err)
+	}
+	return key, nil
+}
@@ -0,0 +1,98 @@
+package imagesign
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRepository = "quay.io/example/os"
+	testDigest     = "sha256:4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945"
+	otherDigest    = "sha256:0a5b0c0e2d2a4c6f7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+)
+
+func TestSignAndVerify(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	untrustedKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name string
+		key  crypto.Signer
+	}{
+		{"ecdsa", ecKey},
+		{"rsa", rsaKey},
+		{"ed25519", edKey},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require := require.New(t)
+			payload, err := NewPayload(testRepository, testDigest)
+			require.NoError(err)
+			signature, err := Sign(tc.key, payload)
+			require.NoError(err)
+
+			trusted := []crypto.PublicKey{untrustedKey.Public(), tc.key.Public()}
+			require.NoError(Verify(trusted, payload, signature))
+			require.NoError(VerifyPayload(payload, testRepository, testDigest))
+
+			require.ErrorIs(Verify([]crypto.PublicKey{untrustedKey.Public()}, payload, signature), ErrNoValidSignature)
+			require.ErrorIs(Verify(trusted, append(payload, ' '), signature), ErrNoValidSignature)
+		})
+	}
+}
+
+func TestVerifyPayload(t *testing.T) {
+	require := require.New(t)
+	payload, err := NewPayload(testRepository, testDigest)
+	require.NoError(err)
+
+	require.NoError(VerifyPayload(payload, testRepository, testDigest))
+	require.ErrorIs(VerifyPayload(payload, testRepository, otherDigest), ErrPayloadMismatch)
+	require.ErrorIs(VerifyPayload(payload, "quay.io/example/other", testDigest), ErrPayloadMismatch)
+	require.Error(VerifyPayload([]byte("not json"), testRepository, testDigest))
+
+	_, err = NewPayload(testRepository, "latest")
+	require.Error(err)
+}
+
+func TestSignatureTag(t *testing.T) {
+	require := require.New(t)
+	tag, err := SignatureTag(testDigest)
+	require.NoError(err)
+	require.Equal("sha256-4f53cda18c2baa0c0354bb5f9a3ecbe5ed12ab4d8e11ba873c2f11161202b945.sig", tag)
+
+	_, err = SignatureTag("sha256:invalid")
+	require.Error(err)
+}
+
+func TestParsePublicKeyPEM(t *testing.T) {
+	require := require.New(t)
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(err)
+
+	parsed, err := ParsePublicKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+	require.NoError(err)
+	require.True(key.PublicKey.Equal(parsed))
+
+	_, err = ParsePublicKeyPEM(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+	require.Error(err)
+	_, err = ParsePublicKeyPEM([]byte("not pem"))
+	require.Error(err)
+}
@@ -0,0 +1,1248 @@
+package queues
+
+/*
+PostgreSQL Provider Implementation - Tables with LISTEN/NOTIFY Wake-ups
+
+This provider implements the same queue, pub/sub and checkpoint semantics as the Redis provider
+on top of the Flight Control database, so that small installations do not need a key-value store
+for their task queues. The tables are created by the database migration (see PostgresSchema).
+
+Data Structures:
+1. Messages Table: queue_messages
+   - One row per enqueued message, with body, timestamp and tracing context
+   - consumer/claimed_at are set when a consumer claims the message (the equivalent of the Redis
+     pending entries list) and are used for timeout detection
+   - Messages are deleted when completed or timed out
+
+2. Failed Messages Table: queue_failed_messages
+   - Stores failed messages with their retry count and the time they should be retried at
+   - entry_id is the ID of the first delivery of the message, so that all retries share one
+     in-flight task
+
+3. In-Flight Tasks Table: queue_in_flight_tasks
+   - One row per (queue, entry ID) with the message timestamp and a completed flag
+   - Failed tasks remain incomplete to act as checkpoint barriers
+   - Completed tasks are deleted when the checkpoint advances past them
+
+4. Checkpoint Table: queue_checkpoints
+   - Single "global" row holding the latest safe checkpoint (microseconds)
+
+5. Broadcasts Table: queue_broadcasts
+   - Pub/sub messages; only the row ID is sent with NOTIFY since notification payloads are
+     limited to 8000 bytes
+   - Rows are deleted by publishers once they are older than broadcastRetention
+
+Notifications:
+- Each provider holds one dedicated connection that LISTENs on the channels of its queues and
+  subscriptions. Enqueue notifies the queue's channel in the same statement as the insert so
+  idle consumers wake up immediately; consumers also poll every postgresPollInterval so that a
+  lost notification only delays delivery.
+
+Message Flow:
+1. Message inserted into queue_messages and the queue channel notified
+2. Consumer claims the oldest unclaimed message with FOR UPDATE SKIP LOCKED and records it as an
+   in-flight task in the same transaction
+3. Handler processes message
+4. Message completed in one transaction:
+   - If successful: in-flight task marked completed
+   - If failed: message added to queue_failed_messages with exponential backoff
+   - Always: message deleted
+*/
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/instrumentation/tracing"
+	"github.com/flightctl/flightctl/pkg/log"
+	"github.com/flightctl/flightctl/pkg/reqid"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// PostgresSchema creates the tables used by the PostgreSQL provider. It is applied by the
+// database migration so that the application user does not need schema privileges.
+const PostgresSchema = `
+CREATE TABLE IF NOT EXISTS queue_messages (
+	id BIGSERIAL PRIMARY KEY,
+	queue_name TEXT NOT NULL,
+	body BYTEA NOT NULL,
+	timestamp BIGINT NOT NULL,
+	trace_context JSONB,
+	retry_count INTEGER NOT NULL DEFAULT 0,
+	original_entry_id TEXT,
+	consumer TEXT,
+	claimed_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS queue_messages_unclaimed_idx ON queue_messages (queue_name, id) WHERE consumer IS NULL;
+CREATE INDEX IF NOT EXISTS queue_messages_claimed_idx ON queue_messages (queue_name, claimed_at) WHERE consumer IS NOT NULL;
+
+CREATE TABLE IF NOT EXISTS queue_failed_messages (
+	id BIGSERIAL PRIMARY KEY,
+	queue_name TEXT NOT NULL,
+	entry_id TEXT NOT NULL,
+	body BYTEA NOT NULL,
+	process_id TEXT NOT NULL,
+	retry_count INTEGER NOT NULL,
+	retry_at TIMESTAMPTZ NOT NULL
+);
+CREATE INDEX IF NOT EXISTS queue_failed_messages_retry_idx ON queue_failed_messages (queue_name, retry_at);
+
+CREATE TABLE IF NOT EXISTS queue_in_flight_tasks (
+	queue_name TEXT NOT NULL,
+	entry_id TEXT NOT NULL,
+	timestamp BIGINT NOT NULL,
+	completed BOOLEAN NOT NULL DEFAULT FALSE,
+	PRIMARY KEY (queue_name, entry_id)
+);
+CREATE INDEX IF NOT EXISTS queue_in_flight_tasks_timestamp_idx ON queue_in_flight_tasks (timestamp);
+
+CREATE TABLE IF NOT EXISTS queue_checkpoints (
+	name TEXT PRIMARY KEY,
+	timestamp BIGINT NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS queue_broadcasts (
+	id BIGSERIAL PRIMARY KEY,
+	channel TEXT NOT NULL,
+	body BYTEA NOT NULL,
+	trace_context JSONB,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+CREATE INDEX IF NOT EXISTS queue_broadcasts_created_at_idx ON queue_broadcasts (created_at);
+`
+
+const (
+	// postgresCheckpointName is the name of the global checkpoint row
+	postgresCheckpointName = "global"
+	// postgresPollInterval bounds how long an idle consumer waits for a notification before
+	// checking the queue again
+	postgresPollInterval = 5 * time.Second
+	// postgresMaxChannelLength is the longest channel name PostgreSQL accepts (NAMEDATALEN - 1)
+	postgresMaxChannelLength = 63
+	// broadcastRetention is how long pub/sub messages are kept for subscribers to read them
+	broadcastRetention = 5 * time.Minute
+	// subscriptionBufferSize is the number of pub/sub messages buffered per subscription
+	subscriptionBufferSize = 1024
+)
+
+type postgresProvider struct {
+	pool        *pgxpool.Pool
+	listener    *postgresListener
+	log         logrus.FieldLogger
+	wg          *sync.WaitGroup
+	queues      []*postgresQueue
+	channels    []*postgresChannel
+	stopped     atomic.Bool
+	mu          sync.Mutex
+	processID   string
+	retryConfig RetryConfig
+}
+
+func NewPostgresProvider(ctx context.Context, log logrus.FieldLogger, processID string, dsn string, retryConfig RetryConfig) (Provider, error) {
+	if processID == "" {
+		return nil, errors.New("processID cannot be empty")
+	}
+
+	ctx, span := tracing.StartSpan(ctx, "flightctl/queues", "PostgresProvider")
+	defer span.End()
+
+	pool, err := pgxpool.New(ctx, dsn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PostgreSQL queue connection pool: %w", err)
+	}
+
+	// Test the connection and make sure the migration created the tables
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	if err := pool.Ping(timeoutCtx); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("failed to connect to PostgreSQL queue: %w", err)
+	}
+	if _, err := pool.Exec(timeoutCtx, "SELECT 1 FROM queue_messages LIMIT 0"); err != nil {
+		pool.Close()
+		return nil, fmt.Errorf("queue tables are missing, run the database migration: %w", err)
+	}
+	log.Info("successfully connected to the PostgreSQL queue")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	listener := newPostgresListener(pool, log, &wg)
+
+	return &postgresProvider{
+		pool:        pool,
+		listener:    listener,
+		log:         log,
+		wg:          &wg,
+		processID:   processID,
+		retryConfig: retryConfig,
+	}, nil
+}
+
+// postgresChannelName returns the notification channel for a queue or pub/sub channel.  Names
+// that would exceed the PostgreSQL limit are replaced by a hash.
+func postgresChannelName(kind string, name string) string {
+	channel := fmt.Sprintf("flightctl_%s_%s", kind, name)
+	if len(channel) <= postgresMaxChannelLength {
+		return channel
+	}
+	sum := sha256.Sum256([]byte(name))
+	return fmt.Sprintf("flightctl_%s_%s", kind, hex.EncodeToString(sum[:16]))
+}
+
+// findExistingQueue finds an existing queue by name, thread-safe
+func (p *postgresProvider) findExistingQueue(queueName string) *postgresQueue {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for _, q := range p.queues {
+		if q.name == queueName && !q.closed.Load() {
+			return q
+		}
+	}
+	return nil
+}
+
+func (p *postgresProvider) newQueue(queueName string) (*postgresQueue, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Load() {
+		return nil, errors.New("provider is stopped")
+	}
+
+	// Check for existing active queue (deduplication)
+	for _, q := range p.queues {
+		if q.name == queueName && !q.closed.Load() {
+			p.log.WithField("queueName", queueName).Debug("reusing existing queue instance")
+			return q, nil
+		}
+	}
+
+	p.log.WithField("queueName", queueName).Debug("creating new queue instance")
+	queue := &postgresQueue{
+		pool:        p.pool,
+		listener:    p.listener,
+		name:        queueName,
+		channel:     postgresChannelName("queue", queueName),
+		log:         p.log.WithField("queueName", queueName),
+		wg:          p.wg,
+		processID:   p.processID,
+		retryConfig: p.retryConfig,
+	}
+	p.queues = append(p.queues, queue)
+	return queue, nil
+}
+
+func (p *postgresProvider) newChannel(channelName string) (*postgresChannel, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Load() {
+		return nil, errors.New("provider is stopped")
+	}
+	channel := &postgresChannel{
+		pool:     p.pool,
+		listener: p.listener,
+		name:     channelName,
+		channel:  postgresChannelName("pubsub", channelName),
+		log:      p.log,
+		wg:       p.wg,
+	}
+	p.channels = append(p.channels, channel)
+	return channel, nil
+}
+
+func (p *postgresProvider) NewQueueConsumer(_ context.Context, queueName string) (QueueConsumer, error) {
+	return p.newQueue(queueName)
+}
+
+func (p *postgresProvider) NewQueueProducer(_ context.Context, queueName string) (QueueProducer, error) {
+	return p.newQueue(queueName)
+}
+
+func (p *postgresProvider) NewPubSubPublisher(_ context.Context, channelName string) (PubSubPublisher, error) {
+	return p.newChannel(channelName)
+}
+
+func (p *postgresProvider) NewPubSubSubscriber(_ context.Context, channelName string) (PubSubSubscriber, error) {
+	return p.newChannel(channelName)
+}
+
+func (p *postgresProvider) Stop() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.stopped.Swap(true) {
+		return
+	}
+	// Signal all queue goroutines to exit.
+	for _, q := range p.queues {
+		p.log.WithField("queueName", q.name).Debug("closing queue instance")
+		q.Close()
+	}
+	defer p.wg.Done()
+
+	// Close all channels
+	for _, channel := range p.channels {
+		p.log.WithField("channelName", channel.name).Debug("closing channel instance")
+		channel.Close()
+	}
+
+	p.listener.stop()
+	// Close the pool in the background since it waits for connections in use by consumers to be
+	// released; Wait() still waits for the consumer goroutines themselves.
+	go p.pool.Close()
+}
+
+func (p *postgresProvider) Wait() {
+	p.wg.Wait()
+}
+
+func (p *postgresProvider) CheckHealth(ctx context.Context) error {
+	if p.pool == nil {
+		return errors.New("postgres pool not initialized")
+	}
+	if err := p.pool.Ping(ctx); err != nil {
+		return fmt.Errorf("postgres ping: %w", err)
+	}
+	return nil
+}
+
+func (p *postgresProvider) GetLatestProcessedTimestamp(ctx context.Context) (time.Time, error) {
+	var microseconds int64
+	err := p.pool.QueryRow(ctx, "SELECT timestamp FROM queue_checkpoints WHERE name = $1", postgresCheckpointName).Scan(&microseconds)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return time.Time{}, ErrCheckpointMissing
+	}
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to get checkpoint: %w", err)
+	}
+	if microseconds == 0 {
+		return time.Time{}, nil
+	}
+	return time.UnixMicro(microseconds), nil
+}
+
+func (p *postgresProvider) AdvanceCheckpointAndCleanup(ctx context.Context) error {
+	var (
+		updated      bool
+		missing      bool
+		cleanedCount int64
+		reason       string
+		newTimestamp int64
+	)
+	err := pgx.BeginFunc(ctx, p.pool, func(tx pgx.Tx) error {
+		// Lock the checkpoint so that concurrent advancements are serialized
+		var currentCheckpoint int64
+		err := tx.QueryRow(ctx, "SELECT timestamp FROM queue_checkpoints WHERE name = $1 FOR UPDATE", postgresCheckpointName).Scan(&currentCheckpoint)
+		if errors.Is(err, pgx.ErrNoRows) {
+			missing = true
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to get checkpoint: %w", err)
+		}
+
+		// The safe checkpoint is the latest completed task before the first incomplete one
+		var safeTimestamp *int64
+		err = tx.QueryRow(ctx, `
+			SELECT max(timestamp) FROM queue_in_flight_tasks
+			WHERE completed AND timestamp < COALESCE(
+				(SELECT min(timestamp) FROM queue_in_flight_tasks WHERE NOT completed),
+				9223372036854775807)`).Scan(&safeTimestamp)
+		if err != nil {
+			return fmt.Errorf("failed to scan in-flight tasks: %w", err)
+		}
+		if safeTimestamp == nil {
+			reason = "no completed tasks found"
+			return nil
+		}
+		if *safeTimestamp <= currentCheckpoint {
+			reason = "timestamp not newer than current checkpoint"
+			return nil
+		}
+
+		if _, err := tx.Exec(ctx, "UPDATE queue_checkpoints SET timestamp = $2 WHERE name = $1", postgresCheckpointName, *safeTimestamp); err != nil {
+			return fmt.Errorf("failed to update checkpoint: %w", err)
+		}
+		tag, err := tx.Exec(ctx, "DELETE FROM queue_in_flight_tasks WHERE completed AND timestamp <= $1", *safeTimestamp)
+		if err != nil {
+			return fmt.Errorf("failed to clean up completed tasks: %w", err)
+		}
+		updated = true
+		cleanedCount = tag.RowsAffected()
+		newTimestamp = *safeTimestamp
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to advance checkpoint: %w", err)
+	}
+
+	if missing {
+		return ErrCheckpointMissing
+	} else if updated {
+		p.log.WithField("newCheckpoint", newTimestamp).
+			WithField("cleanedTasks", cleanedCount).
+			Info("Advanced checkpoint and cleaned up completed tasks")
+	} else {
+		p.log.WithField("reason", reason).
+			Debug("Checkpoint not advanced")
+	}
+	return nil
+}
+
+func (p *postgresProvider) SetCheckpointTimestamp(ctx context.Context, timestamp time.Time) error {
+	var microseconds int64
+	if !timestamp.IsZero() {
+		microseconds = timestamp.UnixMicro()
+	}
+
+	_, err := p.pool.Exec(ctx, `
+		INSERT INTO queue_checkpoints (name, timestamp) VALUES ($1, $2)
+		ON CONFLICT (name) DO UPDATE SET timestamp = EXCLUDED.timestamp`,
+		postgresCheckpointName, microseconds)
+	if err != nil {
+		return fmt.Errorf("failed to set checkpoint timestamp: %w", err)
+	}
+
+	p.log.WithField("timestamp", timestamp.Format(time.RFC3339Nano)).Debug("Set checkpoint timestamp in PostgreSQL")
+	return nil
+}
+
+func (p *postgresProvider) ProcessTimedOutMessages(ctx context.Context, queueName string, timeout time.Duration, handler func(entryID string, body []byte) error) (int, error) {
+	queue := p.findExistingQueue(queueName)
+	if queue == nil {
+		var err error
+		queue, err = p.newQueue(queueName)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return queue.ProcessTimedOutMessages(ctx, timeout, handler)
+}
+
+func (p *postgresProvider) RetryFailedMessages(ctx context.Context, queueName string, config RetryConfig, handler func(entryID string, body []byte, retryCount int) error) (int, error) {
+	queue := p.findExistingQueue(queueName)
+	if queue == nil {
+		var err error
+		queue, err = p.newQueue(queueName)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return queue.RetryFailedMessages(ctx, config, handler)
+}
+
+type postgresQueue struct {
+	pool        *pgxpool.Pool
+	listener    *postgresListener
+	name        string
+	channel     string
+	log         logrus.FieldLogger
+	wg          *sync.WaitGroup
+	processID   string
+	closed      atomic.Bool
+	retryConfig RetryConfig
+}
+
+// postgresMessage is a message claimed from queue_messages
+type postgresMessage struct {
+	id              int64
+	body            []byte
+	timestamp       int64
+	traceContext    map[string]string
+	originalEntryID *string
+}
+
+// trackingEntryID returns the ID under which the message is tracked as an in-flight task
+func (m *postgresMessage) trackingEntryID() string {
+	if m.originalEntryID != nil && *m.originalEntryID != "" {
+		return *m.originalEntryID
+	}
+	return strconv.FormatInt(m.id, 10)
+}
+
+func (q *postgresQueue) Enqueue(ctx context.Context, payload []byte, timestamp int64) error {
+	if q.closed.Load() {
+		return errors.New("queue is closed")
+	}
+
+	// Inject tracing context
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	_, err := q.pool.Exec(ctx, `
+		WITH message AS (
+			INSERT INTO queue_messages (queue_name, body, timestamp, trace_context) VALUES ($1, $2, $3, $4) RETURNING id
+		)
+		SELECT pg_notify($5, '') FROM message`,
+		q.name, payload, timestamp, map[string]string(carrier), q.channel)
+	if err != nil {
+		return fmt.Errorf("failed to publish message: %w", err)
+	}
+	return nil
+}
+
+func (q *postgresQueue) Consume(ctx context.Context, handler ConsumeHandler) error {
+	q.wg.Add(1)
+
+	go func() {
+		defer q.wg.Done()
+
+		// Wake up when messages are enqueued; polling covers notifications missed while the
+		// listener was reconnecting
+		wake := make(chan struct{}, 1)
+		remove, err := q.listener.add(ctx, q.channel, func(string) {
+			select {
+			case wake <- struct{}{}:
+			default:
+			}
+		})
+		if err != nil {
+			q.log.WithError(err).Warn("failed to listen for queue notifications, falling back to polling")
+		} else {
+			defer remove()
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			default:
+				if q.closed.Load() {
+					return
+				}
+
+				consumed, err := q.consumeOnce(ctx, handler)
+				if err != nil {
+					q.log.WithError(err).Error("error while consuming message")
+				}
+				if consumed {
+					continue
+				}
+				timer := time.NewTimer(postgresPollInterval)
+				select {
+				case <-ctx.Done():
+				case <-wake:
+				case <-timer.C:
+				}
+				timer.Stop()
+			}
+		}
+	}()
+
+	return nil
+}
+
+// claim takes the oldest unclaimed message of the queue and records it as an in-flight task.
+// It returns nil if the queue is empty.
+func (q *postgresQueue) claim(ctx context.Context) (*postgresMessage, error) {
+	var msg *postgresMessage
+	err := pgx.BeginFunc(ctx, q.pool, func(tx pgx.Tx) error {
+		m := &postgresMessage{}
+		err := tx.QueryRow(ctx, `
+			UPDATE queue_messages SET consumer = $2, claimed_at = now()
+			WHERE id = (
+				SELECT id FROM queue_messages
+				WHERE queue_name = $1 AND consumer IS NULL
+				ORDER BY id
+				LIMIT 1
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING id, body, timestamp, trace_context, original_entry_id`,
+			q.name, q.processID).Scan(&m.id, &m.body, &m.timestamp, &m.traceContext, &m.originalEntryID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// Add to in-flight tasks before processing using message timestamp
+		_, err = tx.Exec(ctx, `
+			INSERT INTO queue_in_flight_tasks (queue_name, entry_id, timestamp) VALUES ($1, $2, $3)
+			ON CONFLICT (queue_name, entry_id) DO UPDATE SET timestamp = EXCLUDED.timestamp, completed = FALSE`,
+			q.name, m.trackingEntryID(), m.timestamp)
+		if err != nil {
+			return fmt.Errorf("failed to add to in-flight tasks: %w", err)
+		}
+		msg = m
+		return nil
+	})
+	return msg, err
+}
+
+// consumeOnce processes at most one message and reports whether there was one.
+func (q *postgresQueue) consumeOnce(ctx context.Context, handler ConsumeHandler) (bool, error) {
+	msg, err := q.claim(ctx)
+	if err != nil {
+		if ctx.Err() != nil {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to claim message: %w", err)
+	}
+	if msg == nil {
+		return false, nil
+	}
+
+	ctx, parentSpan := tracing.StartSpan(ctx, "flightctl/queues", q.name)
+	defer parentSpan.End()
+
+	entryID := strconv.FormatInt(msg.id, 10)
+	receivedCtx := otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(msg.traceContext))
+	requestID := reqid.NextRequestID()
+
+	// Start span for handler logic
+	receivedCtx, handlerSpan := tracing.StartSpan(
+		receivedCtx, "flightctl/queues", q.name, trace.WithLinks(
+			trace.LinkFromContext(ctx, attribute.String("request.id", requestID))))
+	defer handlerSpan.End()
+
+	handlerSpan.SetAttributes(attribute.String("request.id", requestID))
+	parentSpan.SetAttributes(attribute.String("request.id", requestID))
+
+	receivedCtx = context.WithValue(receivedCtx, middleware.RequestIDKey, requestID)
+	log := log.WithReqIDFromCtx(receivedCtx, q.log)
+
+	// The message is deleted when Complete() is called, or it stays claimed for timeout processing
+	if err := handler(receivedCtx, msg.body, entryID, q, log); err != nil {
+		handlerSpan.RecordError(err)
+		handlerSpan.SetStatus(codes.Error, err.Error())
+		return true, fmt.Errorf("handler error on ID %s: %w", entryID, err)
+	}
+	return true, nil
+}
+
+func (q *postgresQueue) Complete(ctx context.Context, entryID string, body []byte, processingErr error) error {
+	if q.closed.Load() {
+		return errors.New("queue is closed")
+	}
+	id, err := strconv.ParseInt(entryID, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid entry ID %q: %w", entryID, err)
+	}
+
+	return pgx.BeginFunc(ctx, q.pool, func(tx pgx.Tx) error {
+		msg := &postgresMessage{id: id}
+		var retryCount int
+		err := tx.QueryRow(ctx,
+			"DELETE FROM queue_messages WHERE id = $1 AND queue_name = $2 RETURNING timestamp, retry_count, original_entry_id",
+			id, q.name).Scan(&msg.timestamp, &retryCount, &msg.originalEntryID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			// Already completed, or timed out and moved to the failed messages
+			q.log.WithField("entryID", entryID).Debug("message not found on completion, ignoring")
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to delete message ID %s after completion: %w", entryID, err)
+		}
+
+		if processingErr != nil {
+			newRetryCount := retryCount + 1
+			backoffDelay := calculateBackoff(newRetryCount, q.retryConfig)
+			if err := q.addFailedMessage(ctx, tx, msg.trackingEntryID(), body, newRetryCount, backoffDelay); err != nil {
+				return err
+			}
+			q.log.WithField("entryID", entryID).
+				WithField("processID", q.processID).
+				WithField("currentRetryCount", retryCount).
+				WithField("newRetryCount", newRetryCount).
+				WithField("backoffDelay", backoffDelay).
+				Info("message processing failed, added to failed set with exponential backoff")
+			// Failed tasks remain incomplete in the in-flight tasks so they act as checkpoint barriers
+			return nil
+		}
+
+		// Successful completion - mark as completed for checkpoint tracking
+		return markPostgresTaskComplete(ctx, tx, q.name, msg.trackingEntryID(), msg.timestamp)
+	})
+}
+
+func (q *postgresQueue) addFailedMessage(ctx context.Context, tx pgx.Tx, entryID string, body []byte, retryCount int, backoff time.Duration) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO queue_failed_messages (queue_name, entry_id, body, process_id, retry_count, retry_at)
+		VALUES ($1, $2, $3, $4, $5, now() + make_interval(secs => $6))`,
+		q.name, entryID, body, q.processID, retryCount, backoff.Seconds())
+	if err != nil {
+		return fmt.Errorf("failed to add message to failed set: %w", err)
+	}
+	return nil
+}
+
+// markPostgresTaskComplete marks an in-flight task as completed at the given timestamp
+func markPostgresTaskComplete(ctx context.Context, tx pgx.Tx, queueName string, entryID string, timestamp int64) error {
+	_, err := tx.Exec(ctx, `
+		INSERT INTO queue_in_flight_tasks (queue_name, entry_id, timestamp, completed) VALUES ($1, $2, $3, TRUE)
+		ON CONFLICT (queue_name, entry_id) DO UPDATE SET timestamp = EXCLUDED.timestamp, completed = TRUE`,
+		queueName, entryID, timestamp)
+	if err != nil {
+		return fmt.Errorf("failed to mark in-flight task %s as completed: %w", entryID, err)
+	}
+	return nil
+}
+
+func (q *postgresQueue) Close() {
+	q.closed.Store(true)
+}
+
+// ProcessTimedOutMessages moves messages that were claimed longer than the timeout ago and not
+// completed to the failed messages.
+func (q *postgresQueue) ProcessTimedOutMessages(ctx context.Context, timeout time.Duration, handler func(entryID string, body []byte) error) (int, error) {
+	if q.closed.Load() {
+		return 0, errors.New("queue is closed")
+	}
+
+	rows, err := q.pool.Query(ctx, `
+		SELECT id FROM queue_messages
+		WHERE queue_name = $1 AND consumer IS NOT NULL AND claimed_at <= now() - make_interval(secs => $2)
+		ORDER BY id`,
+		q.name, timeout.Seconds())
+	if err != nil {
+		return 0, fmt.Errorf("failed to get timed out messages: %w", err)
+	}
+	ids, err := pgx.CollectRows(rows, pgx.RowTo[int64])
+	if err != nil {
+		return 0, fmt.Errorf("failed to get timed out messages: %w", err)
+	}
+
+	timedOutCount := 0
+	for _, id := range ids {
+		entryID := strconv.FormatInt(id, 10)
+		var (
+			body         []byte
+			retryCount   int
+			moved        bool
+			backoffDelay time.Duration
+		)
+		err := pgx.BeginFunc(ctx, q.pool, func(tx pgx.Tx) error {
+			msg := &postgresMessage{id: id}
+			err := tx.QueryRow(ctx,
+				"DELETE FROM queue_messages WHERE id = $1 AND consumer IS NOT NULL RETURNING body, retry_count, original_entry_id",
+				id).Scan(&body, &retryCount, &msg.originalEntryID)
+			if errors.Is(err, pgx.ErrNoRows) {
+				// Completed since we listed it
+				return nil
+			}
+			if err != nil {
+				return err
+			}
+			backoffDelay = calculateBackoff(retryCount+1, q.retryConfig)
+			if err := q.addFailedMessage(ctx, tx, msg.trackingEntryID(), body, retryCount+1, backoffDelay); err != nil {
+				return err
+			}
+			moved = true
+			return nil
+		})
+		if err != nil {
+			q.log.WithField("entryID", entryID).WithField("processID", q.processID).WithError(err).Warn("failed to move timed out message to failed set, continuing")
+			continue
+		}
+		if !moved {
+			continue
+		}
+
+		if handler != nil {
+			if err := handler(entryID, body); err != nil {
+				q.log.WithField("entryID", entryID).WithField("processID", q.processID).WithError(err).Warn("handler failed for timed out message, continuing")
+			}
+		}
+
+		q.log.WithField("entryID", entryID).WithField("processID", q.processID).WithField("currentRetryCount", retryCount).WithField("newRetryCount", retryCount+1).Info("moved timed out message to failed set")
+		timedOutCount++
+	}
+
+	return timedOutCount, nil
+}
+
+// failedPostgresMessage is a message taken from queue_failed_messages
+type failedPostgresMessage struct {
+	entryID    string
+	body       []byte
+	processID  string
+	retryCount int
+}
+
+// RetryFailedMessages moves failed messages that are due back to the queue, and drops the ones
+// that exceeded the maximum number of retries.
+func (q *postgresQueue) RetryFailedMessages(ctx context.Context, config RetryConfig, handler func(entryID string, body []byte, retryCount int) error) (int, error) {
+	if q.closed.Load() {
+		return 0, errors.New("queue is closed")
+	}
+
+	var (
+		retried           []failedPostgresMessage
+		permanentlyFailed []failedPostgresMessage
+	)
+	err := pgx.BeginFunc(ctx, q.pool, func(tx pgx.Tx) error {
+		// Claim the due messages so no other worker retries them
+		rows, err := tx.Query(ctx, `
+			DELETE FROM queue_failed_messages WHERE id IN (
+				SELECT id FROM queue_failed_messages
+				WHERE queue_name = $1 AND retry_at <= now()
+				ORDER BY retry_at
+				FOR UPDATE SKIP LOCKED
+			)
+			RETURNING entry_id, body, process_id, retry_count`, q.name)
+		if err != nil {
+			return fmt.Errorf("failed to get failed messages: %w", err)
+		}
+		due, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (failedPostgresMessage, error) {
+			var m failedPostgresMessage
+			err := row.Scan(&m.entryID, &m.body, &m.processID, &m.retryCount)
+			return m, err
+		})
+		if err != nil {
+			return fmt.Errorf("failed to get failed messages: %w", err)
+		}
+		q.log.WithField("failedMessageCount", len(due)).Debug("Found failed messages for retry")
+
+		now := time.Now().UnixMicro()
+		for _, m := range due {
+			if m.retryCount >= config.MaxRetries {
+				// Mark task as completed in in-flight tracking so checkpoint can advance past it
+				if err := markPostgresTaskComplete(ctx, tx, q.name, m.entryID, now); err != nil {
+					return err
+				}
+				permanentlyFailed = append(permanentlyFailed, m)
+				continue
+			}
+			_, err := tx.Exec(ctx, `
+				INSERT INTO queue_messages (queue_name, body, timestamp, retry_count, original_entry_id)
+				VALUES ($1, $2, $3, $4, $5)`,
+				q.name, m.body, now, m.retryCount, m.entryID)
+			if err != nil {
+				return fmt.Errorf("failed to add retry message to queue: %w", err)
+			}
+			retried = append(retried, m)
+		}
+		if len(retried) > 0 {
+			if _, err := tx.Exec(ctx, "SELECT pg_notify($1, '')", q.channel); err != nil {
+				return fmt.Errorf("failed to notify queue: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	for _, m := range permanentlyFailed {
+		q.log.WithField("entryID", m.entryID).
+			WithField("processID", m.processID).
+			WithField("retryCount", m.retryCount).
+			Warn("message exceeded max retries, removed from failed set")
+		if handler != nil {
+			if err := handler(m.entryID, m.body, m.retryCount); err != nil {
+				q.log.WithField("entryID", m.entryID).WithField("processID", q.processID).WithError(err).Warn("handler failed for permanently failed message, continuing")
+			}
+		}
+	}
+	for _, m := range retried {
+		q.log.WithField("originalEntryID", m.entryID).
+			WithField("processID", m.processID).
+			WithField("retryCount", m.retryCount).
+			Info("retried failed message to original queue")
+	}
+
+	return len(retried), nil
+}
+
+// postgresChannel implements PubSubPublisher and PubSubSubscriber interfaces using NOTIFY
+type postgresChannel struct {
+	pool        *pgxpool.Pool
+	listener    *postgresListener
+	name        string
+	channel     string
+	log         logrus.FieldLogger
+	wg          *sync.WaitGroup
+	closed      atomic.Bool
+	lastCleanup atomic.Int64
+}
+
+// Publish sends a message to all subscribers on the channel
+func (c *postgresChannel) Publish(ctx context.Context, payload []byte) error {
+	if c.closed.Load() {
+		return errors.New("channel is closed")
+	}
+
+	// Inject tracing context
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	_, err := c.pool.Exec(ctx, `
+		WITH message AS (
+			INSERT INTO queue_broadcasts (channel, body, trace_context) VALUES ($1, $2, $3) RETURNING id
+		)
+		SELECT pg_notify($4, id::text) FROM message`,
+		c.name, payload, map[string]string(carrier), c.channel)
+	if err != nil {
+		return fmt.Errorf("failed to broadcast message: %w", err)
+	}
+
+	c.cleanup(ctx)
+	return nil
+}
+
+// cleanup deletes broadcast messages that subscribers had enough time to read
+func (c *postgresChannel) cleanup(ctx context.Context) {
+	now := time.Now()
+	last := c.lastCleanup.Load()
+	if now.Sub(time.UnixMicro(last)) < broadcastRetention || !c.lastCleanup.CompareAndSwap(last, now.UnixMicro()) {
+		return
+	}
+	if _, err := c.pool.Exec(ctx, "DELETE FROM queue_broadcasts WHERE created_at < now() - make_interval(secs => $1)", broadcastRetention.Seconds()); err != nil {
+		c.log.WithError(err).Warn("failed to clean up broadcast messages")
+	}
+}
+
+// Subscribe creates a new subscription for broadcast messages on the channel
+func (c *postgresChannel) Subscribe(ctx context.Context, handler PubSubHandler) (Subscription, error) {
+	if c.closed.Load() {
+		return nil, errors.New("channel is closed")
+	}
+
+	subscription := &postgresSubscription{
+		pool:     c.pool,
+		name:     c.name,
+		log:      c.log,
+		wg:       c.wg,
+		handler:  handler,
+		messages: make(chan int64, subscriptionBufferSize),
+	}
+
+	remove, err := c.listener.add(ctx, c.channel, subscription.notify)
+	if err != nil {
+		return nil, fmt.Errorf("failed to subscribe to channel %s: %w", c.name, err)
+	}
+	subscription.remove = remove
+
+	// Start the subscription goroutine
+	subscription.start(ctx)
+
+	return subscription, nil
+}
+
+func (c *postgresChannel) Close() {
+	c.closed.Store(true)
+}
+
+// postgresSubscription represents an active subscription to a channel
+type postgresSubscription struct {
+	pool     *pgxpool.Pool
+	name     string
+	log      logrus.FieldLogger
+	wg       *sync.WaitGroup
+	handler  PubSubHandler
+	messages chan int64
+	remove   func()
+	closed   atomic.Bool
+	cancel   context.CancelFunc
+}
+
+// notify is called by the listener with the ID of a broadcast message
+func (s *postgresSubscription) notify(payload string) {
+	id, err := strconv.ParseInt(payload, 10, 64)
+	if err != nil {
+		return
+	}
+	select {
+	case s.messages <- id:
+	default:
+		s.log.WithField("channelName", s.name).Warn("subscription buffer is full, dropping broadcast message")
+	}
+}
+
+func (s *postgresSubscription) start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+		defer s.remove()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case id := <-s.messages:
+				if s.closed.Load() {
+					return
+				}
+
+				if err := s.handleBroadcastMessage(ctx, id); err != nil {
+					s.log.WithError(err).Error("error while handling broadcast message")
+				}
+			}
+		}
+	}()
+}
+
+func (s *postgresSubscription) handleBroadcastMessage(ctx context.Context, id int64) error {
+	ctx, parentSpan := tracing.StartSpan(ctx, "flightctl/queues/broadcast", s.name)
+	defer parentSpan.End()
+
+	var (
+		body         []byte
+		traceContext map[string]string
+	)
+	err := s.pool.QueryRow(ctx, "SELECT body, trace_context FROM queue_broadcasts WHERE id = $1", id).Scan(&body, &traceContext)
+	if err != nil {
+		parentSpan.RecordError(err)
+		parentSpan.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("failed to read broadcast message %d: %w", id, err)
+	}
+
+	receivedCtx := otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(traceContext))
+	requestID := reqid.NextRequestID()
+
+	// Start span for handler logic
+	receivedCtx, handlerSpan := tracing.StartSpan(
+		receivedCtx, "flightctl/queues/broadcast", s.name, trace.WithLinks(
+			trace.LinkFromContext(ctx, attribute.String("request.id", requestID))))
+	defer handlerSpan.End()
+
+	handlerSpan.SetAttributes(attribute.String("request.id", requestID))
+	parentSpan.SetAttributes(attribute.String("request.id", requestID))
+
+	receivedCtx = context.WithValue(receivedCtx, middleware.RequestIDKey, requestID)
+	log := log.WithReqIDFromCtx(receivedCtx, s.log)
+
+	// Run handler
+	if err := s.handler(receivedCtx, body, log); err != nil {
+		handlerSpan.RecordError(err)
+		handlerSpan.SetStatus(codes.Error, err.Error())
+		return fmt.Errorf("broadcast handler error: %w", err)
+	}
+
+	return nil
+}
+
+func (s *postgresSubscription) Close() {
+	if s.closed.Swap(true) {
+		return
+	}
+
+	if s.cancel != nil {
+		s.cancel()
+	}
+}
+
+// postgresListener owns a dedicated connection that LISTENs on the channels used by the
+// provider's consumers and subscriptions, and dispatches notifications to them.
+type postgresListener struct {
+	pool     *pgxpool.Pool
+	log      logrus.FieldLogger
+	mu       sync.Mutex
+	handlers map[string]map[uint64]func(payload string)
+	nextID   uint64
+	// pending are signalled once the channels registered before them are listened on
+	pending []chan error
+	wake    chan struct{}
+	cancel  context.CancelFunc
+	done    chan struct{}
+}
+
+func newPostgresListener(pool *pgxpool.Pool, log logrus.FieldLogger, wg *sync.WaitGroup) *postgresListener {
+	ctx, cancel := context.WithCancel(context.Background())
+	l := &postgresListener{
+		pool:     pool,
+		log:      log,
+		handlers: map[string]map[uint64]func(string){},
+		wake:     make(chan struct{}, 1),
+		cancel:   cancel,
+		done:     make(chan struct{}),
+	}
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		l.run(ctx)
+	}()
+	return l
+}
+
+// add registers a handler for notifications on the channel and returns once the channel is
+// listened on. The returned function removes the handler.
+func (l *postgresListener) add(ctx context.Context, channel string, handler func(payload string)) (func(), error) {
+	ready := make(chan error, 1)
+
+	l.mu.Lock()
+	id := l.nextID
+	l.nextID++
+	if l.handlers[channel] == nil {
+		l.handlers[channel] = map[uint64]func(string){}
+	}
+	l.handlers[channel][id] = handler
+	l.pending = append(l.pending, ready)
+	l.mu.Unlock()
+
+	remove := func() {
+		l.mu.Lock()
+		delete(l.handlers[channel], id)
+		if len(l.handlers[channel]) == 0 {
+			delete(l.handlers, channel)
+		}
+		l.mu.Unlock()
+		l.wakeUp()
+	}
+
+	l.wakeUp()
+	select {
+	case err := <-ready:
+		if err != nil {
+			remove()
+			return nil, err
+		}
+		return remove, nil
+	case <-ctx.Done():
+		remove()
+		return nil, ctx.Err()
+	case <-l.done:
+		remove()
+		return nil, errors.New("listener is stopped")
+	}
+}
+
+func (l *postgresListener) wakeUp() {
+	select {
+	case l.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (l *postgresListener) stop() {
+	l.cancel()
+}
+
+func (l *postgresListener) run(ctx context.Context) {
+	defer close(l.done)
+
+	for ctx.Err() == nil {
+		err := l.listen(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		l.log.WithError(err).Warn("queue notification listener failed, reconnecting")
+
+		// Fail the registrations waiting for this connection instead of blocking them
+		l.mu.Lock()
+		pending := l.pending
+		l.pending = nil
+		l.mu.Unlock()
+		for _, ready := range pending {
+			ready <- err
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(time.Second):
+		}
+	}
+}
+
+// listen runs on one dedicated connection until it fails or ctx is cancelled
+func (l *postgresListener) listen(ctx context.Context) error {
+	poolConn, err := l.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to acquire listener connection: %w", err)
+	}
+	// The connection is removed from the pool so that it is never reused with active LISTENs
+	conn := poolConn.Hijack()
+	defer conn.Close(context.Background())
+
+	listening := map[string]struct{}{}
+	for {
+		if err := l.sync(ctx, conn, listening); err != nil {
+			return err
+		}
+
+		// Wait for a notification, or for handlers to change
+		waitCtx, cancelWait := context.WithCancel(ctx)
+		go func() {
+			select {
+			case <-l.wake:
+				cancelWait()
+			case <-waitCtx.Done():
+			}
+		}()
+		notification, err := conn.WaitForNotification(waitCtx)
+		woken := waitCtx.Err() != nil
+		cancelWait()
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			if woken && !conn.IsClosed() {
+				continue
+			}
+			return fmt.Errorf("failed to wait for notification: %w", err)
+		}
+		l.dispatch(notification.Channel, notification.Payload)
+	}
+}
+
+// sync makes the connection listen on exactly the channels that have handlers
+func (l *postgresListener) sync(ctx context.Context, conn *pgx.Conn, listening map[string]struct{}) error {
+	l.mu.Lock()
+	desired := make(map[string]struct{}, len(l.handlers))
+	for channel := range l.handlers {
+		desired[channel] = struct{}{}
+	}
+	pending := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+
+	var err error
+	for channel := range desired {
+		if _, ok := listening[channel]; ok {
+			continue
+		}
+		if _, err = conn.Exec(ctx, "LISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+			err = fmt.Errorf("failed to listen on %s: %w", channel, err)
+			break
+		}
+		listening[channel] = struct{}{}
+	}
+	if err == nil {
+		for channel := range listening {
+			if _, ok := desired[channel]; ok {
+				continue
+			}
+			if _, err = conn.Exec(ctx, "UNLISTEN "+pgx.Identifier{channel}.Sanitize()); err != nil {
+				err = fmt.Errorf("failed to unlisten on %s: %w", channel, err)
+				break
+			}
+			delete(listening, channel)
+		}
+	}
+
+	for _, ready := range pending {
+		ready <- err
+	}
+	return err
+}
+
+func (l *postgresListener) dispatch(channel string, payload string) {
+	l.mu.Lock()
+	handlers := make([]func(string), 0, len(l.handlers[channel]))
+	for _, handler := range l.handlers[channel] {
+		handlers = append(handlers, handler)
+	}
+	l.mu.Unlock()
+
+	for _, handler := range handlers {
+		handler(payload)
+	}
+}
@@ -0,0 +1,26 @@
+package queues
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPostgresChannelName(t *testing.T) {
+	require.Equal(t, "flightctl_queue_task-queue", postgresChannelName("queue", "task-queue"))
+	require.Equal(t, "flightctl_pubsub_events", postgresChannelName("pubsub", "events"))
+
+	longName := strings.Repeat("a", 100)
+	channel := postgresChannelName("queue", longName)
+	require.LessOrEqual(t, len(channel), postgresMaxChannelLength)
+	require.True(t, strings.HasPrefix(channel, "flightctl_queue_"))
+	require.Equal(t, channel, postgresChannelName("queue", longName))
+	require.NotEqual(t, channel, postgresChannelName("queue", strings.Repeat("b", 100)))
+}
+
+func TestPostgresMessageTrackingEntryID(t *testing.T) {
+	original := "42"
+	require.Equal(t, "7", (&postgresMessage{id: 7}).trackingEntryID())
+	require.Equal(t, "42", (&postgresMessage{id: 7, originalEntryID: &original}).trackingEntryID())
+}
@@ -3,13 +3,15 @@ package queues
 import (
 	"context"
 	"errors"
+	"fmt"
 	"time"
 
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/sirupsen/logrus"
 )
 
-// ErrCheckpointMissing indicates that the checkpoint key is missing from Redis
-var ErrCheckpointMissing = errors.New("checkpoint key missing from Redis")
+// ErrCheckpointMissing indicates that the checkpoint is missing from the queue backend
+var ErrCheckpointMissing = errors.New("checkpoint missing from queue backend")
 
 type Provider interface {
 	NewQueueConsumer(ctx context.Context, queueName string) (QueueConsumer, error)
@@ -32,6 +34,19 @@ type Provider interface {
 	SetCheckpointTimestamp(ctx context.Context, timestamp time.Time) error
 }
 
+// NewProvider creates the queue provider selected by the queues.provider configuration setting.
+func NewProvider(ctx context.Context, log logrus.FieldLogger, processID string, cfg *config.Config, retryConfig RetryConfig) (Provider, error) {
+	provider := cfg.QueuesProvider()
+	switch provider {
+	case config.QueuesProviderRedis:
+		return NewRedisProvider(ctx, log, processID, cfg.KV.Hostname, cfg.KV.Port, cfg.KV.Password, retryConfig)
+	case config.QueuesProviderPostgres:
+		return NewPostgresProvider(ctx, log, processID, cfg.Database.DSN(cfg.Database.User, cfg.Database.Password), retryConfig)
+	default:
+		return nil, fmt.Errorf("unsupported queues provider %q", provider)
+	}
+}
+
 type ConsumeHandler func(ctx context.Context, payload []byte, entryID string, consumer QueueConsumer, log logrus.FieldLogger) error
 
 type QueueConsumer interface {
@@ -31,6 +31,7 @@ import (
 	"github.com/flightctl/flightctl/internal/service/events"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	"github.com/flightctl/flightctl/internal/store"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	enrollmentrequeststore "github.com/flightctl/flightctl/internal/store/enrollmentrequest"
@@ -225,6 +226,7 @@ func NewTestHarness(ctx context.Context, testDirPath string, goRoutineErrorHandl
 	}
 	deviceStore := devicestore.NewDeviceStore(db, serverLog.WithField("pkg", "device-store"))
 	fleetStore := fleetstore.NewFleetStore(db, serverLog.WithField("pkg", "fleet-store"))
+	revocationStore := certificaterevocationstore.NewCertificateRevocationStore(db, serverLog.WithField("pkg", "certificate-revocation-store"))
 	enrollmentRequestStore := enrollmentrequeststore.NewEnrollmentRequestStore(db, serverLog.WithField("pkg", "enrollmentrequest-store"))
 	csrStore := certificatesigningrequeststore.NewCertificateSigningRequestStore(db, serverLog.WithField("pkg", "csr-store"))
 	eventStore := eventstore.NewEventStore(db, serverLog.WithField("pkg", "event-store"))
@@ -251,7 +253,7 @@ func NewTestHarness(ctx context.Context, testDirPath string, goRoutineErrorHandl
 	provider := testutil.NewTestProvider(serverLog)
 
 	// Create KV store using Redis params from options (ephemeral per-suite Redis)
-	kvStore, err := kvstore.NewKVStore(ctx, serverLog, serverCfg.KV.Hostname, serverCfg.KV.Port, serverCfg.KV.Password)
+	kvStore, err := kvstore.New(ctx, serverLog, &serverCfg)
 	if err != nil {
 		cancel()
 		return nil, fmt.Errorf("NewTestHarness: failed to create KV store: %w", err)
@@ -402,7 +404,7 @@ func NewTestHarness(ctx context.Context, testDirPath string, goRoutineErrorHandl
 	}
 	workerClient := worker_client.NewWorkerClient(publisher, serverLog)
 	eventsSvc := events.NewServiceHandler(eventStore, workerClient, serverLog)
-	testHarness.Device = deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, eventsSvc, kvStore, "", serverLog)
+	testHarness.Device = deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, revocationStore, eventsSvc, kvStore, "", serverLog)
 	testHarness.Fleet = fleetservice.NewServiceHandler(fleetStore, eventsSvc, serverLog)
 	testHarness.EnrollmentRequest = enrollmentrequestservice.NewServiceHandler(enrollmentRequestStore, deviceStore, csrStore, ca, kvStore, eventsSvc, serverLog, []string{}, "", "")
 	testHarness.CertificateSigningRequest = certificatesigningrequestservice.NewServiceHandler(csrStore, enrollmentRequestStore, ca, eventsSvc, serverLog, "", "")
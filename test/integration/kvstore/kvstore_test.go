@@ -2,10 +2,14 @@ package kvstore_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
+	"github.com/flightctl/flightctl/internal/config"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	"github.com/flightctl/flightctl/internal/store"
 	flightlog "github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/test/integration/integrationstack"
 	testutil "github.com/flightctl/flightctl/test/util"
@@ -45,11 +49,37 @@ var _ = AfterSuite(func() {
 	}
 })
 
-var _ = Describe("FleetSelector", func() {
+var _ = Describe("Redis KVStore", func() {
+	describeKVStore(func(ctx context.Context, log *logrus.Logger) (kvstore.KVStore, func()) {
+		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
+		Expect(err).ToNot(HaveOccurred())
+		return kvStore, kvStore.Close
+	})
+})
+
+var _ = Describe("PostgreSQL KVStore", func() {
+	describeKVStore(func(ctx context.Context, log *logrus.Logger) (kvstore.KVStore, func()) {
+		cfg, dbName, db, err := testdb.CreateTestDB(ctx, log, "", store.InitDB)
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg.Queues.Provider = config.QueuesProviderPostgres
+		kvStore, err := kvstore.New(ctx, log, cfg)
+		Expect(err).ToNot(HaveOccurred())
+		return kvStore, func() {
+			kvStore.Close()
+			Expect(testdb.DeleteTestDB(context.Background(), log, cfg, db, dbName)).To(Succeed())
+		}
+	})
+})
+
+// describeKVStore registers the cases shared by all KV store backends. newKVStore returns the
+// store under test and a function releasing it.
+func describeKVStore(newKVStore func(ctx context.Context, log *logrus.Logger) (kvstore.KVStore, func())) {
 	var (
 		ctx     context.Context
 		orgId   uuid.UUID
 		kvStore kvstore.KVStore
+		cleanup func()
 		log     *logrus.Logger
 	)
 
@@ -57,13 +87,11 @@ var _ = Describe("FleetSelector", func() {
 		ctx = testutil.StartSpecTracerForGinkgo(suiteCtx)
 		orgId, _ = uuid.NewUUID()
 		log = flightlog.InitLogs()
-		var err error
-		kvStore, err = kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
-		Expect(err).ToNot(HaveOccurred())
+		kvStore, cleanup = newKVStore(ctx, log)
 	})
 
 	AfterEach(func() {
-		kvStore.Close()
+		cleanup()
 	})
 
 	When("fetching a git revision", func() {
@@ -190,4 +218,106 @@ var _ = Describe("FleetSelector", func() {
 			Expect(ret).To(BeEmpty())
 		})
 	})
-})
+
+	When("setting a rendered version", func() {
+		It("only stores greater values", func() {
+			key := fmt.Sprintf("v1/%s/rendered-version", orgId)
+
+			updated, err := kvStore.SetIfGreater(ctx, key, 5)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			updated, err = kvStore.SetIfGreater(ctx, key, 3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+
+			updated, err = kvStore.SetIfGreater(ctx, key, 12)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			value, err := kvStore.Get(ctx, key)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal([]byte("12")))
+		})
+	})
+
+	When("a key expires", func() {
+		It("is no longer returned and can be set again", func() {
+			key := fmt.Sprintf("v1/%s/expiring", orgId)
+
+			updated, err := kvStore.SetNX(ctx, key, []byte("first"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+			Expect(kvStore.SetExpire(ctx, key, time.Second)).To(Succeed())
+
+			Eventually(func() ([]byte, error) {
+				return kvStore.Get(ctx, key)
+			}).WithTimeout(5 * time.Second).Should(BeEmpty())
+
+			updated, err = kvStore.SetNX(ctx, key, []byte("second"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+		})
+	})
+
+	When("using a stream", func() {
+		It("returns the entries in order", func() {
+			key := fmt.Sprintf("v1/%s/stream", orgId)
+
+			firstID, err := kvStore.StreamAdd(ctx, key, []byte("line 1"))
+			Expect(err).ToNot(HaveOccurred())
+			secondID, err := kvStore.StreamAdd(ctx, key, []byte("line 2"))
+			Expect(err).ToNot(HaveOccurred())
+
+			entries, err := kvStore.StreamRange(ctx, key, "-", "+")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{
+				{ID: firstID, Value: []byte("line 1")},
+				{ID: secondID, Value: []byte("line 2")},
+			}))
+
+			entries, err = kvStore.StreamRead(ctx, key, "0", 0, 1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{{ID: firstID, Value: []byte("line 1")}}))
+
+			entries, err = kvStore.StreamRead(ctx, key, firstID, 0, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(Equal([]kvstore.StreamEntry{{ID: secondID, Value: []byte("line 2")}}))
+		})
+
+		It("waits for new entries when blocking", func() {
+			key := fmt.Sprintf("v1/%s/stream", orgId)
+
+			_, err := kvStore.StreamAdd(ctx, key, []byte("old"))
+			Expect(err).ToNot(HaveOccurred())
+
+			entries, err := kvStore.StreamRead(ctx, key, "$", 200*time.Millisecond, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+
+			go func() {
+				defer GinkgoRecover()
+				time.Sleep(500 * time.Millisecond)
+				_, err := kvStore.StreamAdd(ctx, key, []byte("new"))
+				Expect(err).ToNot(HaveOccurred())
+			}()
+
+			entries, err = kvStore.StreamRead(ctx, key, "$", 5*time.Second, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Value).To(Equal([]byte("new")))
+		})
+
+		It("is removed when deleted", func() {
+			key := fmt.Sprintf("v1/%s/stream", orgId)
+
+			_, err := kvStore.StreamAdd(ctx, key, []byte("line"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kvStore.Delete(ctx, key)).To(Succeed())
+
+			entries, err := kvStore.StreamRange(ctx, key, "-", "+")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+}
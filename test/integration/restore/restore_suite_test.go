@@ -141,7 +141,7 @@ func (s *RestoreTestSuite) Setup() {
 	Expect(err).ToNot(HaveOccurred())
 
 	eventsSvc := events.NewServiceHandler(s.EventStore, workerClient, s.Log)
-	s.Device = deviceservice.NewDeviceServiceHandler(s.DeviceStore, fleetStore, eventsSvc, kvStore, "", s.Log)
+	s.Device = deviceservice.NewDeviceServiceHandler(s.DeviceStore, fleetStore, nil, eventsSvc, kvStore, "", s.Log)
 	s.Event = eventservice.NewServiceHandler(s.EventStore, eventsSvc)
 	s.EnrollmentRequest = enrollmentrequestservice.NewServiceHandler(s.EnrollmentRequestStore, s.DeviceStore, csrStore, caClient, kvStore, eventsSvc, s.Log, []string{}, "", "")
 }
@@ -185,6 +185,20 @@ var _ = Describe("Rollout batch sequence test", func() {
 		Expect(db.WithContext(ctx).Model(&model.Device{}).Where("org_id = ? and name = ?", store.NullOrgId, deviceName).Update("render_timestamp", timeToSet).Error).ToNot(HaveOccurred())
 	}
 
+	setHealthySince := func(deviceName string, durationDelta time.Duration) {
+		annotations := map[string]string{
+			api.DeviceAnnotationHealthySince: time.Now().Add(-durationDelta).UTC().Format(time.RFC3339),
+		}
+		Expect(deviceStore.UpdateAnnotations(ctx, store.NullOrgId, deviceName, annotations, nil)).ToNot(HaveOccurred())
+	}
+
+	isHealthTracked := func(deviceName string) bool {
+		device, err := deviceStore.Get(ctx, store.NullOrgId, deviceName)
+		Expect(err).ToNot(HaveOccurred())
+		_, exists := util.GetFromMap(lo.FromPtr(device.Metadata.Annotations), api.DeviceAnnotationHealthySince)
+		return exists
+	}
+
 	setRenderedVersion := func(deviceName string) {
 		Expect(db.WithContext(ctx).Model(&model.Device{}).Where("name = ?", deviceName).Update("status",
 			gorm.Expr(`jsonb_set(status, '{config,renderedVersion}', '"5"')`)).Error).ToNot(HaveOccurred())
@@ -385,7 +399,7 @@ var _ = Describe("Rollout batch sequence test", func() {
 		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
 		eventsSvc := events.NewServiceHandler(eventStore, mockWorkerClient, log)
-		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, eventsSvc, kvStore, "", log)
+		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, nil, eventsSvc, kvStore, "", log)
 		fleetSvc = fleetservice.NewServiceHandler(newFleetStore, eventsSvc, log)
 		eventSvc = eventservice.NewServiceHandler(eventStore, eventsSvc)
 	})
@@ -480,6 +494,100 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Expect(selection.IsRolledOut(ctx)).To(Equal(true))
 			Expect(selection.IsComplete(ctx)).To(Equal(true))
 		})
+		It("health criteria", func() {
+			healthGatedBatchSequence := api.BatchSequence{
+				Sequence: &[]api.Batch{
+					{
+						Limit: percentageLimit("100%"),
+						HealthCriteria: &api.BatchHealthCriteria{
+							ApplicationsHealthy:  lo.ToPtr(true),
+							ResourcesNotCritical: lo.ToPtr(true),
+							SoakDuration:         lo.ToPtr("1m"),
+						},
+					},
+				},
+			}
+			selector := initTest(healthGatedBatchSequence, 4, lo.ToPtr("20h"))
+			Expect(selector.Advance(ctx)).ToNot(HaveOccurred())
+			selection, err := selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			devices, err := selection.Devices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(devices.Items).To(HaveLen(4))
+			applicationStatuses := []api.ApplicationsSummaryStatusType{
+				api.ApplicationsSummaryStatusHealthy,
+				api.ApplicationsSummaryStatusError,
+				api.ApplicationsSummaryStatusHealthy,
+				api.ApplicationsSummaryStatusNoApplications,
+			}
+			memoryStatuses := []api.DeviceResourceStatusType{
+				api.DeviceResourceStatusHealthy,
+				api.DeviceResourceStatusHealthy,
+				api.DeviceResourceStatusCritical,
+				api.DeviceResourceStatusWarning,
+			}
+			names := lo.Map(devices.Items, func(d api.Device, _ int) string { return lo.FromPtr(d.Metadata.Name) })
+			for i, d := range devices.Items {
+				name := lo.FromPtr(d.Metadata.Name)
+				setRolledOut(name)
+				setRendered(name)
+				setRenderedVersion(name)
+				device, err := deviceStore.Get(ctx, store.NullOrgId, name)
+				Expect(err).ToNot(HaveOccurred())
+				device.Status.ApplicationsSummary.Status = applicationStatuses[i]
+				device.Status.Resources = api.DeviceResourceStatus{
+					Cpu:    api.DeviceResourceStatusHealthy,
+					Memory: memoryStatuses[i],
+					Disk:   api.DeviceResourceStatusHealthy,
+				}
+				_, err = deviceStore.UpdateStatus(ctx, store.NullOrgId, device, nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			// A device that did not report an applications summary has no unhealthy applications
+			Expect(db.WithContext(ctx).Model(&model.Device{}).Where("name = ?", names[3]).Update("status",
+				gorm.Expr(`status - 'applicationsSummary'`)).Error).ToNot(HaveOccurred())
+
+			// The devices completed their update, but are still within the soak duration
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			Expect(lo.Map(names, func(name string, _ int) bool { return isHealthTracked(name) })).To(Equal([]bool{true, false, false, true}))
+
+			// The healthy devices must stay healthy for the whole soak duration after they were first observed healthy
+			for _, name := range names {
+				setRenderTimestamp(name, 2*time.Minute)
+			}
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			setHealthySince(names[0], 2*time.Minute)
+			setHealthySince(names[3], 2*time.Minute)
+			Expect(selection.IsComplete(ctx)).To(BeTrue())
+
+			// A device that becomes unhealthy starts over
+			device, err := deviceStore.Get(ctx, store.NullOrgId, names[0])
+			Expect(err).ToNot(HaveOccurred())
+			device.Status.Resources.Cpu = api.DeviceResourceStatusCritical
+			_, err = deviceStore.UpdateStatus(ctx, store.NullOrgId, device, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsComplete(ctx)).To(BeTrue())
+			Expect(isHealthTracked(names[0])).To(BeFalse())
+			device.Status.Resources.Cpu = api.DeviceResourceStatusHealthy
+			_, err = deviceStore.UpdateStatus(ctx, store.NullOrgId, device, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsComplete(ctx)).To(BeFalse())
+			setHealthySince(names[0], 2*time.Minute)
+			Expect(selection.IsComplete(ctx)).To(BeTrue())
+
+			Expect(selection.SetCompletionReport(ctx)).ToNot(HaveOccurred())
+			fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			val, exists := util.GetFromMap(lo.FromPtr(fleet.Metadata.Annotations), api.FleetAnnotationLastBatchCompletionReport)
+			Expect(exists).To(BeTrue())
+			var report api.RolloutBatchCompletionReport
+			Expect(json.Unmarshal([]byte(val), &report)).ToNot(HaveOccurred())
+			Expect(report.Total).To(Equal(int64(4)))
+			Expect(report.Successful).To(Equal(int64(2)))
+			Expect(report.Unhealthy).To(Equal(int64(2)))
+			Expect(report.SuccessPercentage).To(Equal(int64(50)))
+		})
 		DescribeTable("may approve automatically",
 			func(lastSuccessPercentage int, automaticApproval bool, threshold *string, expectedMayApprove bool) {
 				selector := initTestWithThreshold(singleElementBatchSequence, 1, lo.ToPtr("20s"), threshold)
@@ -501,6 +609,77 @@ var _ = Describe("Rollout batch sequence test", func() {
 			Entry("approval is automatic - last success percentage below 90, threshold below success percentage", 89, true, lo.ToPtr("88%"), true),
 		)
 
+		It("rollback on failure", func() {
+			const previousTemplateVersion = "previous-tv"
+			selector := initTest(singleElementBatchSequence, 3, lo.ToPtr("20s"))
+			mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			Expect(fleetStore.UpdateAnnotations(ctx, store.NullOrgId, FleetName, map[string]string{
+				api.FleetAnnotationPreviousTemplateVersion: previousTemplateVersion,
+			}, nil, nil)).ToNot(HaveOccurred())
+			processBatch(selector, 3, nil)
+			setLastSuccessPercentage(FleetName, 50)
+			setAutomaticApproval(FleetName)
+			Expect(selector.Advance(ctx)).ToNot(HaveOccurred())
+			selection, err := selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsFailed()).To(BeTrue())
+			Expect(selection.Rollback(ctx)).To(BeTrue())
+
+			// The devices that were updated by the failed rollout are selected for the rollback
+			devices, err := selection.Devices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(devices.Items).To(HaveLen(3))
+			fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			rollback := fleet.GetRolloutRollback()
+			Expect(rollback).ToNot(BeNil())
+			Expect(rollback.FromTemplateVersion).To(Equal(tvName))
+			Expect(rollback.ToTemplateVersion).To(Equal(previousTemplateVersion))
+			Expect(rollback.Completed).To(BeFalse())
+			Expect(fleet.Status.Rollout).ToNot(BeNil())
+			Expect(fleet.Status.Rollout.Rollback).To(Equal(rollback))
+
+			selector, err = device_selection.NewRolloutDeviceSelector(fleet.Spec.RolloutPolicy.DeviceSelection, lo.ToPtr("20s"), deviceSvc, fleetSvc, store.NullOrgId, fleet, tvName, log)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selector.IsRolledBack()).To(BeTrue())
+			Expect(selector.ReconcileRollback(ctx)).ToNot(HaveOccurred())
+			fleet, err = fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fleet.GetRolloutRollback().Completed).To(BeFalse())
+
+			// Once the devices complete their update to the previous template version, the rollback is completed
+			for _, d := range devices.Items {
+				name := lo.FromPtr(d.Metadata.Name)
+				Expect(deviceStore.UpdateAnnotations(ctx, store.NullOrgId, name, map[string]string{
+					api.DeviceAnnotationTemplateVersion:         previousTemplateVersion,
+					api.DeviceAnnotationRenderedTemplateVersion: previousTemplateVersion,
+					api.DeviceAnnotationRenderedVersion:         "5",
+				}, nil)).ToNot(HaveOccurred())
+				setRenderedVersion(name)
+			}
+			Expect(selector.ReconcileRollback(ctx)).ToNot(HaveOccurred())
+			fleet, err = fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fleet.GetRolloutRollback().Completed).To(BeTrue())
+			devices, err = selection.Devices(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(devices.Items).To(BeEmpty())
+		})
+		It("rollback on failure - no previous template version", func() {
+			selector := initTest(singleElementBatchSequence, 3, lo.ToPtr("20s"))
+			processBatch(selector, 3, nil)
+			setLastSuccessPercentage(FleetName, 50)
+			setAutomaticApproval(FleetName)
+			Expect(selector.Advance(ctx)).ToNot(HaveOccurred())
+			selection, err := selector.CurrentSelection(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(selection.IsFailed()).To(BeTrue())
+			Expect(selection.Rollback(ctx)).To(BeFalse())
+			fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fleet.GetRolloutRollback()).To(BeNil())
+		})
+
 		type Bounds struct {
 			start  int
 			length int
@@ -716,6 +895,47 @@ var _ = Describe("Rollout batch sequence test", func() {
 			reconciler.Reconcile(ctx, store.NullOrgId)
 			Expect(getBatchLocation(FleetName)).To(Equal(5))
 		})
+		It("waits for maintenance window", func() {
+			initFleet(FleetName, incompleteBatchSequenceWithSelection, 10, true)
+			setLabels([]map[string]string{labels1, labels2}, []int{4, 1})
+			setMaintenance := func(windowStart time.Time) {
+				fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
+				Expect(err).ToNot(HaveOccurred())
+				fleet.Spec.RolloutPolicy.Maintenance = &api.RolloutMaintenance{
+					TimeZone: lo.ToPtr("UTC"),
+					Windows: []api.RolloutMaintenanceWindow{
+						{
+							At:       fmt.Sprintf("%d %d * * *", windowStart.Minute(), windowStart.Hour()),
+							Duration: "1h",
+						},
+					},
+				}
+				_, err = fleetStore.Update(ctx, store.NullOrgId, fleet, nil, false, nil)
+				Expect(err).ToNot(HaveOccurred())
+			}
+			windowStart := time.Now().UTC().Add(2 * time.Hour).Truncate(time.Minute)
+			setMaintenance(windowStart)
+			reconciler := device_selection.NewReconciler(deviceSvc, fleetSvc, eventSvc, log)
+			mockWorkerClient.EXPECT().EmitEvent(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+			reconciler.Reconcile(ctx, store.NullOrgId)
+			Expect(getBatchLocation(FleetName)).To(Equal(-1))
+			fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fleet.Status.Rollout).ToNot(BeNil())
+			Expect(fleet.Status.Rollout.NextMaintenanceWindow).ToNot(BeNil())
+			Expect(fleet.Status.Rollout.NextMaintenanceWindow.Equal(windowStart)).To(BeTrue())
+			condition := api.FindStatusCondition(fleet.Status.Conditions, api.ConditionTypeFleetRolloutInProgress)
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Reason).To(Equal(api.RolloutOutsideMaintenanceWindowReason))
+
+			// Once the window is open, the rollout proceeds
+			setMaintenance(time.Now().UTC().Add(-time.Minute))
+			reconciler.Reconcile(ctx, store.NullOrgId)
+			Expect(getBatchLocation(FleetName)).To(Equal(0))
+			fleet, err = fleetStore.Get(ctx, store.NullOrgId, FleetName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fleet.GetRolloutNextMaintenanceWindow()).To(BeNil())
+		})
 		Context("definition updated", func() {
 			updateDefinition := func(definition *api.RolloutDeviceSelection) {
 				fleet, err := fleetStore.Get(ctx, store.NullOrgId, FleetName)
@@ -243,7 +243,7 @@ var _ = Describe("Rollout disruption budget test", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		eventsSvc := events.NewServiceHandler(eventStore, mockWorkerClient, log)
-		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, eventsSvc, kvStore, "", log)
+		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, nil, eventsSvc, kvStore, "", log)
 		fleetSvc = fleetservice.NewServiceHandler(newFleetStore, eventsSvc, log)
 		eventSvc = eventservice.NewServiceHandler(eventStore, eventsSvc)
 		capturedEvents = make([]api.Event, 0)
@@ -3,9 +3,12 @@ package service_test
 import (
 	"context"
 	"net/http"
+	"time"
 
 	api "github.com/flightctl/flightctl/api/core/v1beta1"
 	"github.com/flightctl/flightctl/internal/consts"
+	"github.com/flightctl/flightctl/internal/watch"
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/samber/lo"
@@ -48,3 +51,64 @@ var _ = Describe("Fleet create", func() {
 		Entry("Non-internal request should nil owner", false, ""),
 	)
 })
+
+var _ = Describe("Fleet watch", func() {
+	var suite *ServiceTestSuite
+
+	BeforeEach(func() {
+		suite = NewServiceTestSuite()
+		suite.Setup()
+	})
+
+	AfterEach(func() {
+		suite.Teardown()
+	})
+
+	// nextFleetEvent waits for the next notification about the named fleet, skipping those
+	// about fleets of other tests that share the organization and the pub/sub channel.
+	nextFleetEvent := func(w *watch.Watcher, name string) api.WatchEventType {
+		var eventType api.WatchEventType
+		Eventually(func() bool {
+			select {
+			case entry := <-w.ResultChan():
+				eventType = entry.Type
+				return entry.Name == name
+			default:
+				return false
+			}
+		}, 10*time.Second, 10*time.Millisecond).Should(BeTrue())
+		return eventType
+	}
+
+	It("notifies watchers of status-only updates", func() {
+		name := "watched-fleet-" + uuid.NewString()
+		fleet := api.Fleet{
+			Metadata: api.ObjectMeta{Name: lo.ToPtr(name)},
+			Spec:     api.FleetSpec{},
+		}
+
+		w, err := watch.Bus.Instance().Watch(suite.OrgID, api.FleetKind, "")
+		Expect(err).ToNot(HaveOccurred())
+		defer w.Stop()
+
+		created, status := suite.Fleet.CreateFleet(suite.Ctx, suite.OrgID, fleet)
+		Expect(status.Code).To(Equal(int32(http.StatusCreated)))
+		Expect(nextFleetEvent(w, name)).To(Equal(api.WatchEventTypeAdded))
+
+		created.Status = &api.FleetStatus{Conditions: []api.Condition{{
+			Type:   api.ConditionTypeFleetValid,
+			Status: api.ConditionStatusTrue,
+		}}}
+		_, status = suite.Fleet.ReplaceFleetStatus(suite.Ctx, suite.OrgID, name, *created)
+		Expect(status.Code).To(Equal(int32(http.StatusOK)))
+		Expect(nextFleetEvent(w, name)).To(Equal(api.WatchEventTypeModified))
+
+		status = suite.Fleet.UpdateFleetConditions(suite.Ctx, suite.OrgID, name, []api.Condition{{
+			Type:   api.ConditionTypeFleetValid,
+			Status: api.ConditionStatusFalse,
+			Reason: "Invalid",
+		}})
+		Expect(status.Code).To(Equal(int32(http.StatusOK)))
+		Expect(nextFleetEvent(w, name)).To(Equal(api.WatchEventTypeModified))
+	})
+})
@@ -24,6 +24,7 @@ import (
 	"github.com/flightctl/flightctl/internal/store"
 	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	certificaterevocationstore "github.com/flightctl/flightctl/internal/store/certificaterevocation"
 	certificatesigningrequeststore "github.com/flightctl/flightctl/internal/store/certificatesigningrequest"
 	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	enrollmentrequeststore "github.com/flightctl/flightctl/internal/store/enrollmentrequest"
@@ -32,6 +33,7 @@ import (
 	"github.com/flightctl/flightctl/internal/store/model"
 	organizationstore "github.com/flightctl/flightctl/internal/store/organization"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
+	"github.com/flightctl/flightctl/internal/watch"
 	"github.com/flightctl/flightctl/internal/worker_client"
 	flightlog "github.com/flightctl/flightctl/pkg/log"
 	"github.com/flightctl/flightctl/pkg/queues"
@@ -67,6 +69,13 @@ var _ = BeforeSuite(func() {
 	redisHost, redisPort, redisPassword, redisCleanup, err = testdb.CreateTestRedis(
 		suiteCtx, flightlog.InitLogs())
 	Expect(err).NotTo(HaveOccurred())
+
+	// The watch hub is a process-wide singleton, so it is set up before any service call publishes to it
+	log := flightlog.InitLogs()
+	queuesProvider, err := queues.NewRedisProvider(suiteCtx, log, "service-suite-"+uuid.NewString(), redisHost, redisPort, redisPassword, queues.DefaultRetryConfig())
+	Expect(err).NotTo(HaveOccurred())
+	Expect(watch.Bus.Initialize(suiteCtx, queuesProvider, log)).To(Succeed())
+	Expect(watch.Bus.Instance().Start(suiteCtx)).To(Succeed())
 })
 
 var _ = AfterSuite(func() {
@@ -128,6 +137,7 @@ func (s *ServiceTestSuite) Setup() {
 	catalogStore := catalogstore.NewCatalogStore(s.DB, s.Log.WithField("pkg", "catalog-store"))
 	csrStore := certificatesigningrequeststore.NewCertificateSigningRequestStore(s.DB, s.Log.WithField("pkg", "csr-store"))
 	fleetStore := fleetstore.NewFleetStore(s.DB, s.Log.WithField("pkg", "fleet-store"))
+	revocationStore := certificaterevocationstore.NewCertificateRevocationStore(s.DB, s.Log.WithField("pkg", "certificate-revocation-store"))
 	enrollmentRequestStore := enrollmentrequeststore.NewEnrollmentRequestStore(s.DB, s.Log.WithField("pkg", "enrollmentrequest-store"))
 	repositoryStore := repositorystore.NewRepositoryStore(s.DB, s.Log.WithField("pkg", "repository-store"))
 
@@ -165,7 +175,7 @@ func (s *ServiceTestSuite) Setup() {
 	s.AuthProvider = authproviderservice.NewServiceHandler(s.AuthProviderStore, eventsSvc, s.Log)
 	s.Catalog = catalogservice.NewServiceHandler(catalogStore, eventsSvc, s.Log)
 	s.CertificateSigningRequest = certificatesigningrequestservice.NewServiceHandler(csrStore, enrollmentRequestStore, s.caClient, eventsSvc, s.Log, "", "")
-	s.Device = deviceservice.NewDeviceServiceHandler(s.DeviceStore, fleetStore, eventsSvc, kvStore, "", s.Log)
+	s.Device = deviceservice.NewDeviceServiceHandler(s.DeviceStore, fleetStore, revocationStore, eventsSvc, kvStore, "", s.Log)
 	s.EnrollmentRequest = enrollmentrequestservice.NewServiceHandler(enrollmentRequestStore, s.DeviceStore, csrStore, s.caClient, kvStore, eventsSvc, s.Log, []string{}, "", "")
 	s.Fleet = fleetservice.NewServiceHandler(fleetStore, eventsSvc, s.Log)
 	s.Repository = repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, s.Log)
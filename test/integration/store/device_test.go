@@ -807,6 +807,34 @@ var _ = Describe("DeviceStore create", func() {
 			Expect(api.IsStatusConditionFalse(dev.Status.Conditions, api.ConditionTypeDeviceUpdating)).To(BeTrue())
 		})
 
+		It("UpdateIntegrityStatus", func() {
+			status := api.NewDeviceStatus()
+			status.Summary.Status = api.DeviceSummaryStatusOnline
+			device := api.Device{
+				Metadata: api.ObjectMeta{Name: lo.ToPtr("mydevice-1")},
+				Status:   &status,
+			}
+			_, err := devStore.UpdateStatus(ctx, orgId, &device, nil)
+			Expect(err).ToNot(HaveOccurred())
+			before, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			integrity := api.DeviceIntegrityStatus{
+				Status:       api.DeviceIntegrityStatusFailed,
+				MeasuredBoot: &api.DeviceIntegrityCheckStatus{Status: api.DeviceIntegrityCheckStatusFailed},
+			}
+			Expect(devStore.UpdateIntegrityStatus(ctx, orgId, "mydevice-1", integrity)).To(Succeed())
+
+			dev, err := devStore.Get(ctx, orgId, "mydevice-1")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dev.Status.Integrity.Status).To(Equal(api.DeviceIntegrityStatusFailed))
+			Expect(dev.Status.Integrity.MeasuredBoot.Status).To(Equal(api.DeviceIntegrityCheckStatusFailed))
+			Expect(dev.Status.Summary.Status).To(Equal(api.DeviceSummaryStatusOnline))
+			Expect(dev.Metadata.ResourceVersion).ToNot(Equal(before.Metadata.ResourceVersion))
+
+			Expect(devStore.UpdateIntegrityStatus(ctx, orgId, "missing", integrity)).To(MatchError(flterrors.ErrResourceNotFound))
+		})
+
 		It("UpdateOwner", func() {
 			dev, err := devStore.Get(ctx, orgId, "mydevice-1")
 			Expect(err).ToNot(HaveOccurred())
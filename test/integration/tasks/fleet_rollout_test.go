@@ -118,8 +118,8 @@ var _ = Describe("FleetRollout", func() {
 		Expect(err).ToNot(HaveOccurred())
 		eventsSvc := events.NewServiceHandler(eventStore, workerClient, log)
 		fleetSvc = fleetservice.NewServiceHandler(newFleetStore, eventsSvc, log)
-		templateVersionSvc = templateversionservice.NewServiceHandler(newTvStore, kvStore, eventsSvc, log)
-		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, eventsSvc, kvStore, "", log)
+		templateVersionSvc = templateversionservice.NewServiceHandler(newTvStore, fleetStore, kvStore, eventsSvc, log)
+		deviceSvc = deviceservice.NewDeviceServiceHandler(newDeviceStore, newFleetStore, nil, eventsSvc, kvStore, "", log)
 		dependencyrefSvc = dependencyrefservice.NewServiceHandler(dependencyrefStore, log)
 	})
 
@@ -83,8 +83,8 @@ var _ = Describe("FleetValidate", func() {
 		Expect(err).ToNot(HaveOccurred())
 		eventsSvc := events.NewServiceHandler(eventStore, workerClient, log)
 		fleetSvc = fleetservice.NewServiceHandler(newFleetStore, eventsSvc, log)
-		templateVersionSvc = templateversionservice.NewServiceHandler(templateVersionStore, kvStore, eventsSvc, log)
-		deviceSvc = deviceservice.NewDeviceServiceHandler(deviceStore, newFleetStore, eventsSvc, kvStore, "", log)
+		templateVersionSvc = templateversionservice.NewServiceHandler(templateVersionStore, fleetStore, kvStore, eventsSvc, log)
+		deviceSvc = deviceservice.NewDeviceServiceHandler(deviceStore, newFleetStore, nil, eventsSvc, kvStore, "", log)
 		repositorySvc = repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, log)
 
 		spec := api.RepositorySpec{}
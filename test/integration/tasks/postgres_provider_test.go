@@ -0,0 +1,226 @@
+package tasks_test
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/flightctl/flightctl/internal/config"
+	"github.com/flightctl/flightctl/internal/store"
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/flightctl/flightctl/test/util/testdb"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// PostgreSQL state helpers for the queue provider suite
+func countPostgresFailedMessages(db *gorm.DB, queueName string) int {
+	var count int
+	Expect(db.Raw("SELECT count(*) FROM queue_failed_messages WHERE queue_name = ?", queueName).Scan(&count).Error).To(Succeed())
+	return count
+}
+
+func countPostgresInFlightTasks(db *gorm.DB, queueName string) (int, int) {
+	var completed, incomplete int
+	Expect(db.Raw("SELECT count(*) FILTER (WHERE completed), count(*) FILTER (WHERE NOT completed) FROM queue_in_flight_tasks WHERE queue_name = ?", queueName).
+		Row().Scan(&completed, &incomplete)).To(Succeed())
+	return completed, incomplete
+}
+
+func countPostgresClaimedMessages(db *gorm.DB, queueName string) int {
+	var count int
+	Expect(db.Raw("SELECT count(*) FROM queue_messages WHERE queue_name = ? AND consumer IS NOT NULL", queueName).Scan(&count).Error).To(Succeed())
+	return count
+}
+
+var _ = Describe("Postgres Provider Integration Tests", FlakeAttempts(5), func() {
+	var (
+		cfg    *config.Config
+		dbName string
+		db     *gorm.DB
+	)
+
+	env := describeQueueProvider(queueProviderBackend{
+		newProvider: func(ctx context.Context, log *logrus.Logger, processID string, retryConfig queues.RetryConfig) queues.Provider {
+			var err error
+			cfg, dbName, db, err = testdb.CreateTestDB(ctx, log, "", store.InitDB)
+			Expect(err).ToNot(HaveOccurred())
+
+			cfg.Queues.Provider = config.QueuesProviderPostgres
+			provider, err := queues.NewProvider(ctx, log, processID, cfg, retryConfig)
+			Expect(err).ToNot(HaveOccurred())
+			return provider
+		},
+		cleanup: func(log *logrus.Logger) {
+			Expect(testdb.DeleteTestDB(context.Background(), log, cfg, db, dbName)).To(Succeed())
+		},
+		failedMessages: func(_ context.Context, queueName string) int {
+			return countPostgresFailedMessages(db, queueName)
+		},
+		pendingMessages: func(_ context.Context, queueName string) int {
+			return countPostgresClaimedMessages(db, queueName)
+		},
+		inFlightTasks: func(_ context.Context, queueName string) (int, int) {
+			return countPostgresInFlightTasks(db, queueName)
+		},
+		consumersStopped: func(context.Context, string, time.Duration) bool {
+			// Consumers keep no state in the database, they stop polling once their context is done
+			return true
+		},
+	})
+
+	Describe("Concurrent Operations Across Processes", func() {
+		It("should deliver each message to exactly one of multiple consumers", func() {
+			ctx, log, provider := env.ctx, env.log, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			// Consumers of different providers, as in separate worker processes
+			otherProvider, err := queues.NewPostgresProvider(ctx, log, env.processID+"-other", cfg.Database.DSN(cfg.Database.User, cfg.Database.Password), env.retryConfig)
+			Expect(err).ToNot(HaveOccurred())
+			defer func() {
+				otherProvider.Stop()
+				otherProvider.Wait()
+			}()
+
+			consumer1, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			consumer2, err := otherProvider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			var mu sync.Mutex
+			received := map[string]int{}
+			handler := func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				mu.Lock()
+				received[string(payload)]++
+				mu.Unlock()
+				return consumer.Complete(ctx, entryID, payload, nil)
+			}
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			Expect(consumer1.Consume(consumerCtx, handler)).To(Succeed())
+			Expect(consumer2.Consume(consumerCtx, handler)).To(Succeed())
+
+			messages := []string{"msg1", "msg2", "msg3", "msg4", "msg5"}
+			for _, msg := range messages {
+				Expect(producer.Enqueue(ctx, []byte(msg), time.Now().UnixMicro())).To(Succeed())
+			}
+
+			Eventually(func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(received)
+			}, 10*time.Second, 100*time.Millisecond).Should(Equal(len(messages)))
+
+			// Give a duplicate delivery a chance to show up
+			Consistently(func() bool {
+				mu.Lock()
+				defer mu.Unlock()
+				for _, count := range received {
+					if count != 1 {
+						return false
+					}
+				}
+				return true
+			}, 500*time.Millisecond, 100*time.Millisecond).Should(BeTrue())
+		})
+	})
+
+	Describe("Publish and Subscribe", func() {
+		It("When a message is published it should deliver to multiple subscribers", func() {
+			ctx, provider := env.ctx, env.provider
+			channel := fmt.Sprintf("test-pubsub-channel-%s", uuid.New().String())
+			payload := []byte("hello subscribers")
+
+			const numSubscribers = 3
+			received := make(chan []byte, numSubscribers)
+			for i := 0; i < numSubscribers; i++ {
+				subscriber, err := provider.NewPubSubSubscriber(ctx, channel)
+				Expect(err).ToNot(HaveOccurred())
+				DeferCleanup(subscriber.Close)
+
+				sub, err := subscriber.Subscribe(ctx, func(_ context.Context, p []byte, _ logrus.FieldLogger) error {
+					received <- p
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				DeferCleanup(sub.Close)
+			}
+
+			publisher, err := provider.NewPubSubPublisher(ctx, channel)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(publisher.Close)
+
+			Expect(publisher.Publish(ctx, payload)).To(Succeed())
+
+			for i := 0; i < numSubscribers; i++ {
+				Eventually(received, 5*time.Second).Should(Receive(Equal(payload)))
+			}
+		})
+
+		It("When a subscriber joins after publish it should not receive old messages", func() {
+			ctx, provider := env.ctx, env.provider
+			channel := fmt.Sprintf("test-pubsub-late-%s", uuid.New().String())
+
+			publisher, err := provider.NewPubSubPublisher(ctx, channel)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(publisher.Close)
+			Expect(publisher.Publish(ctx, []byte("early message"))).To(Succeed())
+
+			subscriber, err := provider.NewPubSubSubscriber(ctx, channel)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(subscriber.Close)
+
+			received := make(chan []byte, 1)
+			sub, err := subscriber.Subscribe(ctx, func(_ context.Context, p []byte, _ logrus.FieldLogger) error {
+				received <- p
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(sub.Close)
+
+			Consistently(received, 500*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("When the subscription is closed it should stop delivering messages", func() {
+			ctx, provider := env.ctx, env.provider
+			channel := fmt.Sprintf("test-pubsub-closed-%s", uuid.New().String())
+
+			subscriber, err := provider.NewPubSubSubscriber(ctx, channel)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(subscriber.Close)
+
+			received := make(chan []byte, 2)
+			sub, err := subscriber.Subscribe(ctx, func(_ context.Context, p []byte, _ logrus.FieldLogger) error {
+				received <- p
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			publisher, err := provider.NewPubSubPublisher(ctx, channel)
+			Expect(err).ToNot(HaveOccurred())
+			DeferCleanup(publisher.Close)
+
+			Expect(publisher.Publish(ctx, []byte("first"))).To(Succeed())
+			Eventually(received, 5*time.Second).Should(Receive(Equal([]byte("first"))))
+
+			sub.Close()
+			Expect(publisher.Publish(ctx, []byte("second"))).To(Succeed())
+			Consistently(received, 500*time.Millisecond).ShouldNot(Receive())
+		})
+
+		It("When the publisher is closed it should return an error on publish", func() {
+			ctx, provider := env.ctx, env.provider
+			publisher, err := provider.NewPubSubPublisher(ctx, fmt.Sprintf("test-pubsub-%s", uuid.New().String()))
+			Expect(err).ToNot(HaveOccurred())
+			publisher.Close()
+			Expect(publisher.Publish(ctx, []byte("message"))).ToNot(Succeed())
+		})
+	})
+})
@@ -0,0 +1,889 @@
+package tasks_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/flightctl/flightctl/pkg/queues"
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/sirupsen/logrus"
+)
+
+// queueProviderBackend holds the backend specific parts of the queue provider suite, so that the
+// same cases run against every provider.
+type queueProviderBackend struct {
+	// newProvider creates the provider under test on a clean backend state, skipping the test if
+	// the backend is not available
+	newProvider func(ctx context.Context, log *logrus.Logger, processID string, retryConfig queues.RetryConfig) queues.Provider
+	// cleanup releases the backend state once the provider has stopped, may be nil
+	cleanup func(log *logrus.Logger)
+	// failedMessages returns the number of messages of the queue waiting to be retried
+	failedMessages func(ctx context.Context, queueName string) int
+	// pendingMessages returns the number of messages of the queue delivered but not completed
+	pendingMessages func(ctx context.Context, queueName string) int
+	// inFlightTasks returns the number of completed and incomplete in-flight tasks of the queue
+	inFlightTasks func(ctx context.Context, queueName string) (completed int, incomplete int)
+	// consumersStopped reports whether the consumers of the queue stopped reading from it
+	consumersStopped func(ctx context.Context, queueName string, timeout time.Duration) bool
+}
+
+// queueProviderEnv is the state of the running queue provider test, for backend specific cases.
+type queueProviderEnv struct {
+	ctx         context.Context
+	log         *logrus.Logger
+	processID   string
+	provider    queues.Provider
+	retryConfig queues.RetryConfig
+}
+
+// describeQueueProvider registers the queue provider cases shared by all backends in the
+// enclosing container.
+func describeQueueProvider(backend queueProviderBackend) *queueProviderEnv {
+	env := &queueProviderEnv{
+		retryConfig: queues.RetryConfig{
+			BaseDelay:    100 * time.Millisecond, // Short delays for testing
+			MaxRetries:   3,
+			MaxDelay:     500 * time.Millisecond,
+			JitterFactor: 0.0,
+		},
+	}
+	var cancel context.CancelFunc
+
+	// checkpointMicros returns the checkpoint in microseconds, 0 for the zero checkpoint
+	checkpointMicros := func() int64 {
+		checkpoint, err := env.provider.GetLatestProcessedTimestamp(env.ctx)
+		Expect(err).ToNot(HaveOccurred())
+		if checkpoint.IsZero() {
+			return 0
+		}
+		return checkpoint.UnixMicro()
+	}
+
+	inFlightTasks := func(queueName string) [2]int {
+		completed, incomplete := backend.inFlightTasks(env.ctx, queueName)
+		return [2]int{completed, incomplete}
+	}
+
+	BeforeEach(func() {
+		env.ctx, cancel = context.WithCancel(context.Background())
+		env.log = logrus.New()
+		env.log.SetLevel(logrus.DebugLevel)
+		env.processID = fmt.Sprintf("test-process-%s", uuid.New().String())
+		env.provider = backend.newProvider(env.ctx, env.log, env.processID, env.retryConfig)
+	})
+
+	AfterEach(func() {
+		// Stop and drain consumers BEFORE cancelling context
+		// This ensures in-flight operations complete before context is cancelled
+		if env.provider != nil {
+			env.provider.Stop()
+			env.provider.Wait()
+		}
+		if cancel != nil {
+			cancel()
+		}
+		if backend.cleanup != nil {
+			backend.cleanup(env.log)
+		}
+	})
+
+	Describe("Basic Queue Operations", func() {
+		It("should Enqueue and consume messages", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			testPayload := []byte("test message")
+			messageReceived := make(chan []byte, 1)
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				if err := consumer.Complete(ctx, entryID, payload, nil); err != nil {
+					return err
+				}
+				messageReceived <- payload
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, testPayload, time.Now().UnixMicro())).To(Succeed())
+
+			Eventually(messageReceived, 5*time.Second).Should(Receive(Equal(testPayload)))
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal([2]int{1, 0}))
+		})
+
+		It("should handle multiple messages in order", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			messages := []string{"message1", "message2", "message3"}
+			receivedMessages := make(chan string, len(messages))
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				if err := consumer.Complete(ctx, entryID, payload, nil); err != nil {
+					return err
+				}
+				receivedMessages <- string(payload)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, msg := range messages {
+				Expect(producer.Enqueue(ctx, []byte(msg), time.Now().UnixMicro())).To(Succeed())
+			}
+
+			for _, msg := range messages {
+				Eventually(receivedMessages, 5*time.Second).Should(Receive(Equal(msg)))
+			}
+		})
+	})
+
+	Describe("In-Flight Message Tracking", func() {
+		It("should track in-flight messages and handle completion", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			entryIDs := make(chan string, 1)
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				if err := consumer.Complete(ctx, entryID, payload, nil); err != nil {
+					return err
+				}
+				entryIDs <- entryID
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			Eventually(entryIDs, 5*time.Second).Should(Receive(Not(BeEmpty())))
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal([2]int{1, 0}))
+			Expect(backend.pendingMessages(ctx, queueName)).To(Equal(0))
+		})
+
+		It("should handle message completion with errors", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			completeErrs := make(chan error, 1)
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				completeErrs <- consumer.Complete(ctx, entryID, payload, fmt.Errorf("test processing error"))
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			Eventually(completeErrs, 5*time.Second).Should(Receive(BeNil()))
+			// Failed tasks remain incomplete so they block the checkpoint
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal([2]int{0, 1}))
+		})
+	})
+
+	Describe("Concurrent Operations", func() {
+		It("should handle multiple consumers on the same queue", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer1, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer1.Close()
+
+			consumer2, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer2.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			var mu sync.Mutex
+			received := map[string]int{}
+			handler := func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				mu.Lock()
+				received[string(payload)]++
+				mu.Unlock()
+				return consumer.Complete(ctx, entryID, payload, nil)
+			}
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			Expect(consumer1.Consume(consumerCtx, handler)).To(Succeed())
+			Expect(consumer2.Consume(consumerCtx, handler)).To(Succeed())
+
+			messages := []string{"msg1", "msg2", "msg3", "msg4", "msg5"}
+			for _, msg := range messages {
+				Expect(producer.Enqueue(ctx, []byte(msg), time.Now().UnixMicro())).To(Succeed())
+			}
+
+			Eventually(func() int {
+				mu.Lock()
+				defer mu.Unlock()
+				return len(received)
+			}, 10*time.Second, 100*time.Millisecond).Should(Equal(len(messages)))
+
+			consumerCancel()
+			Eventually(func() bool {
+				return backend.consumersStopped(ctx, queueName, 1*time.Second)
+			}, 5*time.Second, 100*time.Millisecond).Should(BeTrue())
+
+			mu.Lock()
+			defer mu.Unlock()
+			for msg, count := range received {
+				Expect(count).To(Equal(1), "message %s delivered more than once", msg)
+			}
+		})
+	})
+
+	Describe("Provider Lifecycle", func() {
+		It("should stop gracefully", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			// Close consumer and producer first
+			consumer.Close()
+			producer.Close()
+
+			done := make(chan struct{})
+			go func() {
+				provider.Stop()
+				provider.Wait()
+				close(done)
+			}()
+			Eventually(done, 10*time.Second).Should(BeClosed())
+
+			_, err = provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should report health", func() {
+			Expect(env.provider.CheckHealth(env.ctx)).To(Succeed())
+		})
+	})
+
+	Describe("Error Handling", func() {
+		It("should handle consumer handler errors gracefully", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			messages := make(chan []byte, 2)
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				messages <- payload
+				if string(payload) == "failing message" {
+					return fmt.Errorf("handler error")
+				}
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			// The consumer keeps consuming after a handler error
+			Expect(producer.Enqueue(ctx, []byte("failing message"), time.Now().UnixMicro())).To(Succeed())
+			Eventually(messages, 5*time.Second).Should(Receive(Equal([]byte("failing message"))))
+			Expect(producer.Enqueue(ctx, []byte("next message"), time.Now().UnixMicro())).To(Succeed())
+			Eventually(messages, 5*time.Second).Should(Receive(Equal([]byte("next message"))))
+		})
+	})
+
+	Describe("Retry and Backoff Functionality", func() {
+		It("should add failed messages to failed set with exponential backoff", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			completeErrs := make(chan error, 1)
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				completeErrs <- consumer.Complete(ctx, entryID, payload, fmt.Errorf("test processing error"))
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			Eventually(completeErrs, 5*time.Second).Should(Receive(BeNil()))
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal(1))
+			Expect(inFlightTasks(queueName)).To(Equal([2]int{0, 1}))
+		})
+
+		It("should retry failed messages with exponential backoff", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			testPayload := []byte("test retry message")
+			messageReceived := make(chan []byte, 2) // Expect original + retry
+			var attempts sync.Map
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				messageReceived <- payload
+				// Fail the first delivery only
+				if _, retried := attempts.LoadOrStore(string(payload), true); !retried {
+					return consumer.Complete(ctx, entryID, payload, fmt.Errorf("processing error"))
+				}
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, testPayload, time.Now().UnixMicro())).To(Succeed())
+			Eventually(messageReceived, 5*time.Second).Should(Receive(Equal(testPayload)))
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			// Wait for the backoff to expire before retrying
+			Eventually(func() int {
+				retried, err := provider.RetryFailedMessages(ctx, queueName, env.retryConfig, nil)
+				Expect(err).ToNot(HaveOccurred())
+				return retried
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal(1))
+
+			Eventually(messageReceived, 5*time.Second).Should(Receive(Equal(testPayload)))
+
+			// The retry completes the in-flight task of the original delivery
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal([2]int{1, 0}))
+			Expect(backend.failedMessages(ctx, queueName)).To(Equal(0))
+		})
+
+		It("should process timed out messages", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				// Intentionally do not Complete; leave the message pending
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			testPayload := []byte("test timeout message")
+			Expect(producer.Enqueue(ctx, testPayload, time.Now().UnixMicro())).To(Succeed())
+
+			Eventually(func() int {
+				return backend.pendingMessages(ctx, queueName)
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal(1))
+			consumerCancel()
+			Eventually(func() bool {
+				return backend.consumersStopped(ctx, queueName, 1*time.Second)
+			}, 3*time.Second, 100*time.Millisecond).Should(BeTrue())
+
+			timedOutBodies := make(chan []byte, 1)
+			Eventually(func() int {
+				count, err := provider.ProcessTimedOutMessages(ctx, queueName, 80*time.Millisecond, func(entryID string, body []byte) error {
+					timedOutBodies <- body
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return count
+			}, 2*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			Expect(timedOutBodies).To(Receive(Equal(testPayload)))
+			Expect(backend.pendingMessages(ctx, queueName)).To(Equal(0))
+			Expect(backend.failedMessages(ctx, queueName)).To(Equal(1))
+		})
+
+		It("should retry failed messages with custom config", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				// Complete with error to trigger retry
+				return consumer.Complete(ctx, entryID, payload, fmt.Errorf("processing error"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(producer.Enqueue(ctx, []byte("test custom retry message"), time.Now().UnixMicro())).To(Succeed())
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			// Stop consumer before retry operations
+			consumerCancel()
+			Eventually(func() bool {
+				return backend.consumersStopped(ctx, queueName, 1*time.Second)
+			}, 3*time.Second, 100*time.Millisecond).Should(BeTrue())
+
+			config := queues.RetryConfig{
+				BaseDelay:    10 * time.Millisecond, // Very short for testing
+				MaxRetries:   2,
+				MaxDelay:     100 * time.Millisecond,
+				JitterFactor: 0.1,
+			}
+			Eventually(func() int {
+				retried, err := provider.RetryFailedMessages(ctx, queueName, config, nil)
+				Expect(err).ToNot(HaveOccurred())
+				return retried
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal(1))
+
+			// The retried message is back in the queue rather than in the failed set
+			Expect(backend.failedMessages(ctx, queueName)).To(Equal(0))
+		})
+
+		It("should handle retry count tracking correctly", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			var deliveries atomic.Int32
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				deliveries.Add(1)
+				// Always fail to trigger retries
+				return consumer.Complete(ctx, entryID, payload, fmt.Errorf("processing error"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			testPayload := []byte("test retry count message")
+			Expect(producer.Enqueue(ctx, testPayload, time.Now().UnixMicro())).To(Succeed())
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			// Each failure increments the retry count, until the message exceeds the max retries
+			config := queues.RetryConfig{
+				BaseDelay:    10 * time.Millisecond,
+				MaxRetries:   2,
+				MaxDelay:     50 * time.Millisecond,
+				JitterFactor: 0.0,
+			}
+			permanentlyFailed := make(chan int, 1)
+			Eventually(func() int {
+				_, err := provider.RetryFailedMessages(ctx, queueName, config, func(entryID string, body []byte, retryCount int) error {
+					permanentlyFailed <- retryCount
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return len(permanentlyFailed)
+			}, 10*time.Second, 100*time.Millisecond).Should(Equal(1))
+
+			Expect(permanentlyFailed).To(Receive(Equal(2)))
+			Expect(deliveries.Load()).To(Equal(int32(2)))
+		})
+
+		It("should respect max retries configuration", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				// Always fail to trigger retries
+				return consumer.Complete(ctx, entryID, payload, fmt.Errorf("persistent error"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			testPayload := []byte("test max retries message")
+			Expect(producer.Enqueue(ctx, testPayload, time.Now().UnixMicro())).To(Succeed())
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			// Stop consumer before retry operations
+			consumerCancel()
+			Eventually(func() bool {
+				return backend.consumersStopped(ctx, queueName, 1*time.Second)
+			}, 3*time.Second, 100*time.Millisecond).Should(BeTrue())
+
+			permanentlyFailed := make(chan []byte, 1)
+			Eventually(func() int {
+				_, err := provider.RetryFailedMessages(ctx, queueName, queues.RetryConfig{
+					BaseDelay:    10 * time.Millisecond,
+					MaxRetries:   1, // The first failure already reaches it
+					MaxDelay:     50 * time.Millisecond,
+					JitterFactor: 0.0,
+				}, func(entryID string, body []byte, retryCount int) error {
+					permanentlyFailed <- body
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal(0))
+
+			Expect(permanentlyFailed).To(Receive(Equal(testPayload)))
+			// Marked completed so the checkpoint can advance past it
+			Expect(inFlightTasks(queueName)).To(Equal([2]int{1, 0}))
+		})
+	})
+
+	Describe("Checkpoint Advancement", func() {
+		It("should handle empty queue gracefully", func() {
+			ctx, provider := env.ctx, env.provider
+
+			// No checkpoint exists before one is set
+			Expect(errors.Is(provider.AdvanceCheckpointAndCleanup(ctx), queues.ErrCheckpointMissing)).To(BeTrue())
+			_, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(errors.Is(err, queues.ErrCheckpointMissing)).To(BeTrue())
+
+			// Without tasks the checkpoint stays where it is
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+			Expect(checkpointMicros()).To(Equal(int64(0)))
+		})
+
+		It("should advance checkpoint when all tasks complete successfully", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+
+			timestamps := make([]time.Time, 3)
+			for i := range timestamps {
+				timestamps[i] = time.Now().Add(time.Duration(i) * time.Millisecond)
+				Expect(producer.Enqueue(ctx, []byte(fmt.Sprintf("message%d", i)), timestamps[i].UnixMicro())).To(Succeed())
+			}
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal([2]int{3, 0}))
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpointTimestamp, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpointTimestamp.UnixMicro()).To(Equal(timestamps[2].UnixMicro()))
+
+			// Completed tasks up to the checkpoint are cleaned up
+			Expect(inFlightTasks(queueName)).To(Equal([2]int{0, 0}))
+		})
+
+		It("should not advance checkpoint past incomplete tasks", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+
+			baseTime := time.Now()
+			timestamps := []time.Time{
+				baseTime,
+				baseTime.Add(100 * time.Millisecond),
+				baseTime.Add(200 * time.Millisecond),
+			}
+			for i, ts := range timestamps {
+				Expect(producer.Enqueue(ctx, []byte(fmt.Sprintf("message%d", i)), ts.UnixMicro())).To(Succeed())
+			}
+
+			// Fail the second message so it stays incomplete
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				if string(payload) == "message1" {
+					return consumer.Complete(ctx, entryID, payload, fmt.Errorf("simulated failure"))
+				}
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal([2]int{2, 1}))
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpointTimestamp, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpointTimestamp.UnixMicro()).To(Equal(timestamps[0].UnixMicro()))
+		})
+
+		It("should advance checkpoint past permanently failed tasks", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+			Expect(producer.Enqueue(ctx, []byte("test message"), time.Now().UnixMicro())).To(Succeed())
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				return consumer.Complete(ctx, entryID, payload, fmt.Errorf("simulated failure"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() int {
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal(1))
+
+			// The failed task blocks the checkpoint
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+			Expect(checkpointMicros()).To(Equal(int64(0)))
+
+			// Exceeding the max retries marks the task as permanently failed and completed
+			config := queues.RetryConfig{
+				BaseDelay:    10 * time.Millisecond,
+				MaxRetries:   1,
+				MaxDelay:     50 * time.Millisecond,
+				JitterFactor: 0.0,
+			}
+			Eventually(func() int {
+				_, err := provider.RetryFailedMessages(ctx, queueName, config, nil)
+				Expect(err).ToNot(HaveOccurred())
+				return backend.failedMessages(ctx, queueName)
+			}, 5*time.Second, 100*time.Millisecond).Should(Equal(0))
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+			Expect(checkpointMicros()).ToNot(Equal(int64(0)), "Checkpoint should have advanced after permanent failure")
+		})
+
+		It("should handle mixed completion states correctly", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			Expect(provider.SetCheckpointTimestamp(ctx, time.Time{})).To(Succeed())
+
+			// Publish messages: success, success, fail, success
+			baseTime := time.Now()
+			timestamps := []time.Time{
+				baseTime,
+				baseTime.Add(100 * time.Millisecond),
+				baseTime.Add(200 * time.Millisecond), // This will fail
+				baseTime.Add(300 * time.Millisecond),
+			}
+			for i, ts := range timestamps {
+				Expect(producer.Enqueue(ctx, []byte(fmt.Sprintf("message%d", i)), ts.UnixMicro())).To(Succeed())
+			}
+
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				if string(payload) == "message2" {
+					return consumer.Complete(ctx, entryID, payload, fmt.Errorf("simulated failure"))
+				}
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal([2]int{3, 1}))
+
+			// Stop consumer to avoid race conditions with checkpoint operations
+			consumerCancel()
+			Eventually(func() bool {
+				return backend.consumersStopped(ctx, queueName, 1*time.Second)
+			}, 5*time.Second, 100*time.Millisecond).Should(BeTrue())
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			// The checkpoint advances to message1 but not past the failed message2
+			checkpointTimestamp, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpointTimestamp.UnixMicro()).To(Equal(timestamps[1].UnixMicro()))
+			Expect(inFlightTasks(queueName)).To(Equal([2]int{1, 1}))
+		})
+
+		It("should not advance checkpoint backwards", func() {
+			ctx, provider := env.ctx, env.provider
+			queueName := fmt.Sprintf("test-queue-%s", uuid.New().String())
+
+			consumer, err := provider.NewQueueConsumer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer consumer.Close()
+
+			producer, err := provider.NewQueueProducer(ctx, queueName)
+			Expect(err).ToNot(HaveOccurred())
+			defer producer.Close()
+
+			futureCheckpoint := time.Now().Add(time.Hour)
+			Expect(provider.SetCheckpointTimestamp(ctx, futureCheckpoint)).To(Succeed())
+
+			Expect(producer.Enqueue(ctx, []byte("old message"), time.Now().UnixMicro())).To(Succeed())
+			consumerCtx, consumerCancel := context.WithCancel(ctx)
+			defer consumerCancel()
+			err = consumer.Consume(consumerCtx, func(ctx context.Context, payload []byte, entryID string, consumer queues.QueueConsumer, log logrus.FieldLogger) error {
+				return consumer.Complete(ctx, entryID, payload, nil)
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Eventually(func() [2]int {
+				return inFlightTasks(queueName)
+			}, 5*time.Second, 50*time.Millisecond).Should(Equal([2]int{1, 0}))
+
+			Expect(provider.AdvanceCheckpointAndCleanup(ctx)).To(Succeed())
+
+			checkpointTimestamp, err := provider.GetLatestProcessedTimestamp(ctx)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checkpointTimestamp.UnixMicro()).To(Equal(futureCheckpoint.UnixMicro()))
+		})
+	})
+
+	return env
+}
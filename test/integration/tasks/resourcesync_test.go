@@ -10,13 +10,17 @@ import (
 	"github.com/flightctl/flightctl/internal/consts"
 	"github.com/flightctl/flightctl/internal/domain"
 	"github.com/flightctl/flightctl/internal/kvstore"
+	authproviderservice "github.com/flightctl/flightctl/internal/service/authprovider"
 	catalogservice "github.com/flightctl/flightctl/internal/service/catalog"
+	deviceservice "github.com/flightctl/flightctl/internal/service/device"
 	"github.com/flightctl/flightctl/internal/service/events"
 	fleetservice "github.com/flightctl/flightctl/internal/service/fleet"
 	repositoryservice "github.com/flightctl/flightctl/internal/service/repository"
 	resourcesyncservice "github.com/flightctl/flightctl/internal/service/resourcesync"
 	"github.com/flightctl/flightctl/internal/store"
+	authproviderstore "github.com/flightctl/flightctl/internal/store/authprovider"
 	catalogstore "github.com/flightctl/flightctl/internal/store/catalog"
+	devicestore "github.com/flightctl/flightctl/internal/store/device"
 	eventstore "github.com/flightctl/flightctl/internal/store/event"
 	fleetstore "github.com/flightctl/flightctl/internal/store/fleet"
 	repositorystore "github.com/flightctl/flightctl/internal/store/repository"
@@ -47,6 +51,8 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		fleetSvc          fleetservice.Service
 		resourcesyncSvc   resourcesyncservice.Service
 		catalogSvc        catalogservice.Service
+		authProviderSvc   authproviderservice.Service
+		deviceSvc         deviceservice.Service
 		cfg               *config.Config
 		dbName            string
 		db                *gorm.DB
@@ -68,18 +74,22 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		fleetStore := fleetstore.NewFleetStore(db, log.WithField("pkg", "fleet-store"))
 		resourcesyncStore := resourcesyncstore.NewResourceSyncStore(db, log.WithField("pkg", "resourcesync-store"))
 		catalogStore := catalogstore.NewCatalogStore(db, log.WithField("pkg", "catalog-store"))
+		authProviderStore := authproviderstore.NewAuthProviderStore(db, log.WithField("pkg", "authprovider-store"))
+		deviceStore := devicestore.NewDeviceStore(db, log.WithField("pkg", "device-store"))
 		eventStore = eventstore.NewEventStore(db, log.WithField("pkg", "event-store"))
 		ctrl = gomock.NewController(GinkgoT())
 		mockQueueProducer = queues.NewMockQueueProducer(ctrl)
 		workerClient = worker_client.NewWorkerClient(mockQueueProducer, log)
-		_, err = kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
+		kvStore, err := kvstore.NewKVStore(ctx, log, redisHost, redisPort, redisPassword)
 		Expect(err).ToNot(HaveOccurred())
 		eventsSvc := events.NewServiceHandler(eventStore, workerClient, log)
 		repositorySvc = repositoryservice.NewServiceHandler(repositoryStore, eventsSvc, log)
 		fleetSvc = fleetservice.NewServiceHandler(fleetStore, eventsSvc, log)
-		resourcesyncSvc = resourcesyncservice.NewServiceHandler(resourcesyncStore, catalogStore, fleetStore, eventsSvc, log)
+		resourcesyncSvc = resourcesyncservice.NewServiceHandler(resourcesyncStore, catalogStore, fleetStore, deviceStore, repositoryStore, authProviderStore, eventsSvc, nil, log)
 		catalogSvc = catalogservice.NewServiceHandler(catalogStore, eventsSvc, log)
-		resourceSync = tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, log, nil, nil)
+		authProviderSvc = authproviderservice.NewServiceHandler(authProviderStore, eventsSvc, log)
+		deviceSvc = deviceservice.NewDeviceServiceHandler(deviceStore, fleetStore, nil, eventsSvc, kvStore, "", log)
+		resourceSync = tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, authProviderSvc, deviceSvc, log, nil, nil)
 
 		// Set up mock expectations for the publisher
 		mockQueueProducer.EXPECT().Enqueue(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
@@ -535,7 +545,7 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		It("should remove ignored fields during resource parsing", func() {
 			// Create a ResourceSync with custom ignore fields
 			ignoreFields := []string{"/metadata/resourceVersion", "/metadata/labels/test-label"}
-			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, log, nil, ignoreFields)
+			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, authProviderSvc, deviceSvc, log, nil, ignoreFields)
 
 			// Create test resources with fields that should be ignored
 			resources := []tasks.GenericResourceMap{
@@ -621,7 +631,7 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 
 		It("should not remove fields when no ignore list is provided", func() {
 			// Create a ResourceSync without ignore fields
-			resourceSyncNoIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, log, nil, nil)
+			resourceSyncNoIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, authProviderSvc, deviceSvc, log, nil, nil)
 
 			// Create test resources with fields that would normally be ignored
 			resources := []tasks.GenericResourceMap{
@@ -755,7 +765,7 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		It("should handle non-existent paths gracefully", func() {
 			// Create a ResourceSync with ignore fields that don't exist in the resource
 			ignoreFields := []string{"/metadata/nonExistentField", "/spec/nonExistentField"}
-			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, log, nil, ignoreFields)
+			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, authProviderSvc, deviceSvc, log, nil, ignoreFields)
 
 			// Create test resources without the fields that should be ignored
 			resources := []tasks.GenericResourceMap{
@@ -799,7 +809,7 @@ var _ = Describe("ResourceSync Task Integration Tests", func() {
 		It("should apply field removal during full sync process", func() {
 			// Create a ResourceSync instance with ignore fields
 			ignoreFields := []string{"/metadata/resourceVersion"}
-			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, log, nil, ignoreFields)
+			resourceSyncWithIgnores := tasks.NewResourceSync(repositorySvc, fleetSvc, resourcesyncSvc, catalogSvc, authProviderSvc, deviceSvc, log, nil, ignoreFields)
 
 			// Create test resources with resourceVersion that should be removed
 			resources := []tasks.GenericResourceMap{
@@ -258,7 +258,7 @@ func NewTestApiServer(log logrus.FieldLogger, cfg *config.Config, db *gorm.DB, c
 		return nil, nil, fmt.Errorf("NewTLSListener: error creating TLS certs: %w", err)
 	}
 
-	return apiserver.New(log, cfg, db, ca, listener, queuesProvider, nil), listener, nil
+	return apiserver.New(log, cfg, db, ca, listener, queuesProvider, nil, nil), listener, nil
 }
 
 // NewTestAgentServer creates a new test server and returns the server and the listener listening on localhost's next available port.